@@ -0,0 +1,3460 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package multisigv1
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_EventScheduleExecuted                  protoreflect.MessageDescriptor
+	fd_EventScheduleExecuted_schedule_id      protoreflect.FieldDescriptor
+	fd_EventScheduleExecuted_multisig_address protoreflect.FieldDescriptor
+	fd_EventScheduleExecuted_run              protoreflect.FieldDescriptor
+	fd_EventScheduleExecuted_error            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_multisig_v1_events_proto_init()
+	md_EventScheduleExecuted = File_multisig_v1_events_proto.Messages().ByName("EventScheduleExecuted")
+	fd_EventScheduleExecuted_schedule_id = md_EventScheduleExecuted.Fields().ByName("schedule_id")
+	fd_EventScheduleExecuted_multisig_address = md_EventScheduleExecuted.Fields().ByName("multisig_address")
+	fd_EventScheduleExecuted_run = md_EventScheduleExecuted.Fields().ByName("run")
+	fd_EventScheduleExecuted_error = md_EventScheduleExecuted.Fields().ByName("error")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventScheduleExecuted)(nil)
+
+type fastReflection_EventScheduleExecuted EventScheduleExecuted
+
+func (x *EventScheduleExecuted) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventScheduleExecuted)(x)
+}
+
+func (x *EventScheduleExecuted) slowProtoReflect() protoreflect.Message {
+	mi := &file_multisig_v1_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventScheduleExecuted_messageType fastReflection_EventScheduleExecuted_messageType
+var _ protoreflect.MessageType = fastReflection_EventScheduleExecuted_messageType{}
+
+type fastReflection_EventScheduleExecuted_messageType struct{}
+
+func (x fastReflection_EventScheduleExecuted_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventScheduleExecuted)(nil)
+}
+func (x fastReflection_EventScheduleExecuted_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventScheduleExecuted)
+}
+func (x fastReflection_EventScheduleExecuted_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventScheduleExecuted
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventScheduleExecuted) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventScheduleExecuted
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventScheduleExecuted) Type() protoreflect.MessageType {
+	return _fastReflection_EventScheduleExecuted_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventScheduleExecuted) New() protoreflect.Message {
+	return new(fastReflection_EventScheduleExecuted)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventScheduleExecuted) Interface() protoreflect.ProtoMessage {
+	return (*EventScheduleExecuted)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventScheduleExecuted) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ScheduleId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ScheduleId)
+		if !f(fd_EventScheduleExecuted_schedule_id, value) {
+			return
+		}
+	}
+	if x.MultisigAddress != "" {
+		value := protoreflect.ValueOfString(x.MultisigAddress)
+		if !f(fd_EventScheduleExecuted_multisig_address, value) {
+			return
+		}
+	}
+	if x.Run != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Run)
+		if !f(fd_EventScheduleExecuted_run, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_EventScheduleExecuted_error, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventScheduleExecuted) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "multisig.v1.EventScheduleExecuted.schedule_id":
+		return x.ScheduleId != uint64(0)
+	case "multisig.v1.EventScheduleExecuted.multisig_address":
+		return x.MultisigAddress != ""
+	case "multisig.v1.EventScheduleExecuted.run":
+		return x.Run != uint64(0)
+	case "multisig.v1.EventScheduleExecuted.error":
+		return x.Error != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventScheduleExecuted"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventScheduleExecuted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventScheduleExecuted) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "multisig.v1.EventScheduleExecuted.schedule_id":
+		x.ScheduleId = uint64(0)
+	case "multisig.v1.EventScheduleExecuted.multisig_address":
+		x.MultisigAddress = ""
+	case "multisig.v1.EventScheduleExecuted.run":
+		x.Run = uint64(0)
+	case "multisig.v1.EventScheduleExecuted.error":
+		x.Error = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventScheduleExecuted"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventScheduleExecuted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventScheduleExecuted) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "multisig.v1.EventScheduleExecuted.schedule_id":
+		value := x.ScheduleId
+		return protoreflect.ValueOfUint64(value)
+	case "multisig.v1.EventScheduleExecuted.multisig_address":
+		value := x.MultisigAddress
+		return protoreflect.ValueOfString(value)
+	case "multisig.v1.EventScheduleExecuted.run":
+		value := x.Run
+		return protoreflect.ValueOfUint64(value)
+	case "multisig.v1.EventScheduleExecuted.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventScheduleExecuted"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventScheduleExecuted does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventScheduleExecuted) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "multisig.v1.EventScheduleExecuted.schedule_id":
+		x.ScheduleId = value.Uint()
+	case "multisig.v1.EventScheduleExecuted.multisig_address":
+		x.MultisigAddress = value.Interface().(string)
+	case "multisig.v1.EventScheduleExecuted.run":
+		x.Run = value.Uint()
+	case "multisig.v1.EventScheduleExecuted.error":
+		x.Error = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventScheduleExecuted"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventScheduleExecuted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventScheduleExecuted) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.EventScheduleExecuted.schedule_id":
+		panic(fmt.Errorf("field schedule_id of message multisig.v1.EventScheduleExecuted is not mutable"))
+	case "multisig.v1.EventScheduleExecuted.multisig_address":
+		panic(fmt.Errorf("field multisig_address of message multisig.v1.EventScheduleExecuted is not mutable"))
+	case "multisig.v1.EventScheduleExecuted.run":
+		panic(fmt.Errorf("field run of message multisig.v1.EventScheduleExecuted is not mutable"))
+	case "multisig.v1.EventScheduleExecuted.error":
+		panic(fmt.Errorf("field error of message multisig.v1.EventScheduleExecuted is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventScheduleExecuted"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventScheduleExecuted does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventScheduleExecuted) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.EventScheduleExecuted.schedule_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "multisig.v1.EventScheduleExecuted.multisig_address":
+		return protoreflect.ValueOfString("")
+	case "multisig.v1.EventScheduleExecuted.run":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "multisig.v1.EventScheduleExecuted.error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventScheduleExecuted"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventScheduleExecuted does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventScheduleExecuted) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.EventScheduleExecuted", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventScheduleExecuted) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventScheduleExecuted) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventScheduleExecuted) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventScheduleExecuted) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventScheduleExecuted)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ScheduleId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ScheduleId))
+		}
+		l = len(x.MultisigAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Run != 0 {
+			n += 1 + runtime.Sov(uint64(x.Run))
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventScheduleExecuted)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Run != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Run))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.MultisigAddress) > 0 {
+			i -= len(x.MultisigAddress)
+			copy(dAtA[i:], x.MultisigAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MultisigAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ScheduleId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ScheduleId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventScheduleExecuted)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventScheduleExecuted: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventScheduleExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+				}
+				x.ScheduleId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ScheduleId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MultisigAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Run", wireType)
+				}
+				x.Run = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Run |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventScheduleCancelled                  protoreflect.MessageDescriptor
+	fd_EventScheduleCancelled_schedule_id      protoreflect.FieldDescriptor
+	fd_EventScheduleCancelled_multisig_address protoreflect.FieldDescriptor
+	fd_EventScheduleCancelled_remaining_runs   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_multisig_v1_events_proto_init()
+	md_EventScheduleCancelled = File_multisig_v1_events_proto.Messages().ByName("EventScheduleCancelled")
+	fd_EventScheduleCancelled_schedule_id = md_EventScheduleCancelled.Fields().ByName("schedule_id")
+	fd_EventScheduleCancelled_multisig_address = md_EventScheduleCancelled.Fields().ByName("multisig_address")
+	fd_EventScheduleCancelled_remaining_runs = md_EventScheduleCancelled.Fields().ByName("remaining_runs")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventScheduleCancelled)(nil)
+
+type fastReflection_EventScheduleCancelled EventScheduleCancelled
+
+func (x *EventScheduleCancelled) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventScheduleCancelled)(x)
+}
+
+func (x *EventScheduleCancelled) slowProtoReflect() protoreflect.Message {
+	mi := &file_multisig_v1_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventScheduleCancelled_messageType fastReflection_EventScheduleCancelled_messageType
+var _ protoreflect.MessageType = fastReflection_EventScheduleCancelled_messageType{}
+
+type fastReflection_EventScheduleCancelled_messageType struct{}
+
+func (x fastReflection_EventScheduleCancelled_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventScheduleCancelled)(nil)
+}
+func (x fastReflection_EventScheduleCancelled_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventScheduleCancelled)
+}
+func (x fastReflection_EventScheduleCancelled_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventScheduleCancelled
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventScheduleCancelled) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventScheduleCancelled
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventScheduleCancelled) Type() protoreflect.MessageType {
+	return _fastReflection_EventScheduleCancelled_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventScheduleCancelled) New() protoreflect.Message {
+	return new(fastReflection_EventScheduleCancelled)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventScheduleCancelled) Interface() protoreflect.ProtoMessage {
+	return (*EventScheduleCancelled)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventScheduleCancelled) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ScheduleId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ScheduleId)
+		if !f(fd_EventScheduleCancelled_schedule_id, value) {
+			return
+		}
+	}
+	if x.MultisigAddress != "" {
+		value := protoreflect.ValueOfString(x.MultisigAddress)
+		if !f(fd_EventScheduleCancelled_multisig_address, value) {
+			return
+		}
+	}
+	if x.RemainingRuns != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RemainingRuns)
+		if !f(fd_EventScheduleCancelled_remaining_runs, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventScheduleCancelled) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "multisig.v1.EventScheduleCancelled.schedule_id":
+		return x.ScheduleId != uint64(0)
+	case "multisig.v1.EventScheduleCancelled.multisig_address":
+		return x.MultisigAddress != ""
+	case "multisig.v1.EventScheduleCancelled.remaining_runs":
+		return x.RemainingRuns != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventScheduleCancelled"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventScheduleCancelled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventScheduleCancelled) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "multisig.v1.EventScheduleCancelled.schedule_id":
+		x.ScheduleId = uint64(0)
+	case "multisig.v1.EventScheduleCancelled.multisig_address":
+		x.MultisigAddress = ""
+	case "multisig.v1.EventScheduleCancelled.remaining_runs":
+		x.RemainingRuns = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventScheduleCancelled"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventScheduleCancelled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventScheduleCancelled) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "multisig.v1.EventScheduleCancelled.schedule_id":
+		value := x.ScheduleId
+		return protoreflect.ValueOfUint64(value)
+	case "multisig.v1.EventScheduleCancelled.multisig_address":
+		value := x.MultisigAddress
+		return protoreflect.ValueOfString(value)
+	case "multisig.v1.EventScheduleCancelled.remaining_runs":
+		value := x.RemainingRuns
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventScheduleCancelled"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventScheduleCancelled does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventScheduleCancelled) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "multisig.v1.EventScheduleCancelled.schedule_id":
+		x.ScheduleId = value.Uint()
+	case "multisig.v1.EventScheduleCancelled.multisig_address":
+		x.MultisigAddress = value.Interface().(string)
+	case "multisig.v1.EventScheduleCancelled.remaining_runs":
+		x.RemainingRuns = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventScheduleCancelled"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventScheduleCancelled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventScheduleCancelled) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.EventScheduleCancelled.schedule_id":
+		panic(fmt.Errorf("field schedule_id of message multisig.v1.EventScheduleCancelled is not mutable"))
+	case "multisig.v1.EventScheduleCancelled.multisig_address":
+		panic(fmt.Errorf("field multisig_address of message multisig.v1.EventScheduleCancelled is not mutable"))
+	case "multisig.v1.EventScheduleCancelled.remaining_runs":
+		panic(fmt.Errorf("field remaining_runs of message multisig.v1.EventScheduleCancelled is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventScheduleCancelled"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventScheduleCancelled does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventScheduleCancelled) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.EventScheduleCancelled.schedule_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "multisig.v1.EventScheduleCancelled.multisig_address":
+		return protoreflect.ValueOfString("")
+	case "multisig.v1.EventScheduleCancelled.remaining_runs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventScheduleCancelled"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventScheduleCancelled does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventScheduleCancelled) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.EventScheduleCancelled", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventScheduleCancelled) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventScheduleCancelled) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventScheduleCancelled) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventScheduleCancelled) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventScheduleCancelled)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ScheduleId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ScheduleId))
+		}
+		l = len(x.MultisigAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RemainingRuns != 0 {
+			n += 1 + runtime.Sov(uint64(x.RemainingRuns))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventScheduleCancelled)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RemainingRuns != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RemainingRuns))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.MultisigAddress) > 0 {
+			i -= len(x.MultisigAddress)
+			copy(dAtA[i:], x.MultisigAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MultisigAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ScheduleId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ScheduleId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventScheduleCancelled)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventScheduleCancelled: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventScheduleCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+				}
+				x.ScheduleId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ScheduleId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MultisigAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemainingRuns", wireType)
+				}
+				x.RemainingRuns = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RemainingRuns |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventMultisigAccountFrozen                  protoreflect.MessageDescriptor
+	fd_EventMultisigAccountFrozen_multisig_address protoreflect.FieldDescriptor
+	fd_EventMultisigAccountFrozen_reason           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_multisig_v1_events_proto_init()
+	md_EventMultisigAccountFrozen = File_multisig_v1_events_proto.Messages().ByName("EventMultisigAccountFrozen")
+	fd_EventMultisigAccountFrozen_multisig_address = md_EventMultisigAccountFrozen.Fields().ByName("multisig_address")
+	fd_EventMultisigAccountFrozen_reason = md_EventMultisigAccountFrozen.Fields().ByName("reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventMultisigAccountFrozen)(nil)
+
+type fastReflection_EventMultisigAccountFrozen EventMultisigAccountFrozen
+
+func (x *EventMultisigAccountFrozen) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventMultisigAccountFrozen)(x)
+}
+
+func (x *EventMultisigAccountFrozen) slowProtoReflect() protoreflect.Message {
+	mi := &file_multisig_v1_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventMultisigAccountFrozen_messageType fastReflection_EventMultisigAccountFrozen_messageType
+var _ protoreflect.MessageType = fastReflection_EventMultisigAccountFrozen_messageType{}
+
+type fastReflection_EventMultisigAccountFrozen_messageType struct{}
+
+func (x fastReflection_EventMultisigAccountFrozen_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventMultisigAccountFrozen)(nil)
+}
+func (x fastReflection_EventMultisigAccountFrozen_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventMultisigAccountFrozen)
+}
+func (x fastReflection_EventMultisigAccountFrozen_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventMultisigAccountFrozen
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventMultisigAccountFrozen) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventMultisigAccountFrozen
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventMultisigAccountFrozen) Type() protoreflect.MessageType {
+	return _fastReflection_EventMultisigAccountFrozen_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventMultisigAccountFrozen) New() protoreflect.Message {
+	return new(fastReflection_EventMultisigAccountFrozen)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventMultisigAccountFrozen) Interface() protoreflect.ProtoMessage {
+	return (*EventMultisigAccountFrozen)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventMultisigAccountFrozen) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MultisigAddress != "" {
+		value := protoreflect.ValueOfString(x.MultisigAddress)
+		if !f(fd_EventMultisigAccountFrozen_multisig_address, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_EventMultisigAccountFrozen_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventMultisigAccountFrozen) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "multisig.v1.EventMultisigAccountFrozen.multisig_address":
+		return x.MultisigAddress != ""
+	case "multisig.v1.EventMultisigAccountFrozen.reason":
+		return x.Reason != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventMultisigAccountFrozen"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventMultisigAccountFrozen does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventMultisigAccountFrozen) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "multisig.v1.EventMultisigAccountFrozen.multisig_address":
+		x.MultisigAddress = ""
+	case "multisig.v1.EventMultisigAccountFrozen.reason":
+		x.Reason = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventMultisigAccountFrozen"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventMultisigAccountFrozen does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventMultisigAccountFrozen) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "multisig.v1.EventMultisigAccountFrozen.multisig_address":
+		value := x.MultisigAddress
+		return protoreflect.ValueOfString(value)
+	case "multisig.v1.EventMultisigAccountFrozen.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventMultisigAccountFrozen"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventMultisigAccountFrozen does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventMultisigAccountFrozen) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "multisig.v1.EventMultisigAccountFrozen.multisig_address":
+		x.MultisigAddress = value.Interface().(string)
+	case "multisig.v1.EventMultisigAccountFrozen.reason":
+		x.Reason = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventMultisigAccountFrozen"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventMultisigAccountFrozen does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventMultisigAccountFrozen) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.EventMultisigAccountFrozen.multisig_address":
+		panic(fmt.Errorf("field multisig_address of message multisig.v1.EventMultisigAccountFrozen is not mutable"))
+	case "multisig.v1.EventMultisigAccountFrozen.reason":
+		panic(fmt.Errorf("field reason of message multisig.v1.EventMultisigAccountFrozen is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventMultisigAccountFrozen"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventMultisigAccountFrozen does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventMultisigAccountFrozen) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.EventMultisigAccountFrozen.multisig_address":
+		return protoreflect.ValueOfString("")
+	case "multisig.v1.EventMultisigAccountFrozen.reason":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventMultisigAccountFrozen"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventMultisigAccountFrozen does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventMultisigAccountFrozen) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.EventMultisigAccountFrozen", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventMultisigAccountFrozen) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventMultisigAccountFrozen) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventMultisigAccountFrozen) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventMultisigAccountFrozen) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventMultisigAccountFrozen)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MultisigAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventMultisigAccountFrozen)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MultisigAddress) > 0 {
+			i -= len(x.MultisigAddress)
+			copy(dAtA[i:], x.MultisigAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MultisigAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventMultisigAccountFrozen)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventMultisigAccountFrozen: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventMultisigAccountFrozen: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MultisigAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventMultisigAccountRecovered_2_list)(nil)
+
+type _EventMultisigAccountRecovered_2_list struct {
+	list *[]string
+}
+
+func (x *_EventMultisigAccountRecovered_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventMultisigAccountRecovered_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EventMultisigAccountRecovered_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventMultisigAccountRecovered_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventMultisigAccountRecovered_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventMultisigAccountRecovered at list field Signers as it is not of Message kind"))
+}
+
+func (x *_EventMultisigAccountRecovered_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventMultisigAccountRecovered_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EventMultisigAccountRecovered_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventMultisigAccountRecovered                      protoreflect.MessageDescriptor
+	fd_EventMultisigAccountRecovered_multisig_address     protoreflect.FieldDescriptor
+	fd_EventMultisigAccountRecovered_signers              protoreflect.FieldDescriptor
+	fd_EventMultisigAccountRecovered_threshold            protoreflect.FieldDescriptor
+	fd_EventMultisigAccountRecovered_threshold_percentage protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_multisig_v1_events_proto_init()
+	md_EventMultisigAccountRecovered = File_multisig_v1_events_proto.Messages().ByName("EventMultisigAccountRecovered")
+	fd_EventMultisigAccountRecovered_multisig_address = md_EventMultisigAccountRecovered.Fields().ByName("multisig_address")
+	fd_EventMultisigAccountRecovered_signers = md_EventMultisigAccountRecovered.Fields().ByName("signers")
+	fd_EventMultisigAccountRecovered_threshold = md_EventMultisigAccountRecovered.Fields().ByName("threshold")
+	fd_EventMultisigAccountRecovered_threshold_percentage = md_EventMultisigAccountRecovered.Fields().ByName("threshold_percentage")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventMultisigAccountRecovered)(nil)
+
+type fastReflection_EventMultisigAccountRecovered EventMultisigAccountRecovered
+
+func (x *EventMultisigAccountRecovered) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventMultisigAccountRecovered)(x)
+}
+
+func (x *EventMultisigAccountRecovered) slowProtoReflect() protoreflect.Message {
+	mi := &file_multisig_v1_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventMultisigAccountRecovered_messageType fastReflection_EventMultisigAccountRecovered_messageType
+var _ protoreflect.MessageType = fastReflection_EventMultisigAccountRecovered_messageType{}
+
+type fastReflection_EventMultisigAccountRecovered_messageType struct{}
+
+func (x fastReflection_EventMultisigAccountRecovered_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventMultisigAccountRecovered)(nil)
+}
+func (x fastReflection_EventMultisigAccountRecovered_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventMultisigAccountRecovered)
+}
+func (x fastReflection_EventMultisigAccountRecovered_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventMultisigAccountRecovered
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventMultisigAccountRecovered) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventMultisigAccountRecovered
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventMultisigAccountRecovered) Type() protoreflect.MessageType {
+	return _fastReflection_EventMultisigAccountRecovered_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventMultisigAccountRecovered) New() protoreflect.Message {
+	return new(fastReflection_EventMultisigAccountRecovered)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventMultisigAccountRecovered) Interface() protoreflect.ProtoMessage {
+	return (*EventMultisigAccountRecovered)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventMultisigAccountRecovered) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MultisigAddress != "" {
+		value := protoreflect.ValueOfString(x.MultisigAddress)
+		if !f(fd_EventMultisigAccountRecovered_multisig_address, value) {
+			return
+		}
+	}
+	if len(x.Signers) != 0 {
+		value := protoreflect.ValueOfList(&_EventMultisigAccountRecovered_2_list{list: &x.Signers})
+		if !f(fd_EventMultisigAccountRecovered_signers, value) {
+			return
+		}
+	}
+	if x.Threshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Threshold)
+		if !f(fd_EventMultisigAccountRecovered_threshold, value) {
+			return
+		}
+	}
+	if x.ThresholdPercentage != "" {
+		value := protoreflect.ValueOfString(x.ThresholdPercentage)
+		if !f(fd_EventMultisigAccountRecovered_threshold_percentage, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventMultisigAccountRecovered) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "multisig.v1.EventMultisigAccountRecovered.multisig_address":
+		return x.MultisigAddress != ""
+	case "multisig.v1.EventMultisigAccountRecovered.signers":
+		return len(x.Signers) != 0
+	case "multisig.v1.EventMultisigAccountRecovered.threshold":
+		return x.Threshold != uint32(0)
+	case "multisig.v1.EventMultisigAccountRecovered.threshold_percentage":
+		return x.ThresholdPercentage != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventMultisigAccountRecovered"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventMultisigAccountRecovered does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventMultisigAccountRecovered) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "multisig.v1.EventMultisigAccountRecovered.multisig_address":
+		x.MultisigAddress = ""
+	case "multisig.v1.EventMultisigAccountRecovered.signers":
+		x.Signers = nil
+	case "multisig.v1.EventMultisigAccountRecovered.threshold":
+		x.Threshold = uint32(0)
+	case "multisig.v1.EventMultisigAccountRecovered.threshold_percentage":
+		x.ThresholdPercentage = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventMultisigAccountRecovered"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventMultisigAccountRecovered does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventMultisigAccountRecovered) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "multisig.v1.EventMultisigAccountRecovered.multisig_address":
+		value := x.MultisigAddress
+		return protoreflect.ValueOfString(value)
+	case "multisig.v1.EventMultisigAccountRecovered.signers":
+		if len(x.Signers) == 0 {
+			return protoreflect.ValueOfList(&_EventMultisigAccountRecovered_2_list{})
+		}
+		listValue := &_EventMultisigAccountRecovered_2_list{list: &x.Signers}
+		return protoreflect.ValueOfList(listValue)
+	case "multisig.v1.EventMultisigAccountRecovered.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfUint32(value)
+	case "multisig.v1.EventMultisigAccountRecovered.threshold_percentage":
+		value := x.ThresholdPercentage
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventMultisigAccountRecovered"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventMultisigAccountRecovered does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventMultisigAccountRecovered) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "multisig.v1.EventMultisigAccountRecovered.multisig_address":
+		x.MultisigAddress = value.Interface().(string)
+	case "multisig.v1.EventMultisigAccountRecovered.signers":
+		lv := value.List()
+		clv := lv.(*_EventMultisigAccountRecovered_2_list)
+		x.Signers = *clv.list
+	case "multisig.v1.EventMultisigAccountRecovered.threshold":
+		x.Threshold = uint32(value.Uint())
+	case "multisig.v1.EventMultisigAccountRecovered.threshold_percentage":
+		x.ThresholdPercentage = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventMultisigAccountRecovered"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventMultisigAccountRecovered does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventMultisigAccountRecovered) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.EventMultisigAccountRecovered.signers":
+		if x.Signers == nil {
+			x.Signers = []string{}
+		}
+		value := &_EventMultisigAccountRecovered_2_list{list: &x.Signers}
+		return protoreflect.ValueOfList(value)
+	case "multisig.v1.EventMultisigAccountRecovered.multisig_address":
+		panic(fmt.Errorf("field multisig_address of message multisig.v1.EventMultisigAccountRecovered is not mutable"))
+	case "multisig.v1.EventMultisigAccountRecovered.threshold":
+		panic(fmt.Errorf("field threshold of message multisig.v1.EventMultisigAccountRecovered is not mutable"))
+	case "multisig.v1.EventMultisigAccountRecovered.threshold_percentage":
+		panic(fmt.Errorf("field threshold_percentage of message multisig.v1.EventMultisigAccountRecovered is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventMultisigAccountRecovered"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventMultisigAccountRecovered does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventMultisigAccountRecovered) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.EventMultisigAccountRecovered.multisig_address":
+		return protoreflect.ValueOfString("")
+	case "multisig.v1.EventMultisigAccountRecovered.signers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventMultisigAccountRecovered_2_list{list: &list})
+	case "multisig.v1.EventMultisigAccountRecovered.threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "multisig.v1.EventMultisigAccountRecovered.threshold_percentage":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventMultisigAccountRecovered"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventMultisigAccountRecovered does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventMultisigAccountRecovered) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.EventMultisigAccountRecovered", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventMultisigAccountRecovered) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventMultisigAccountRecovered) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventMultisigAccountRecovered) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventMultisigAccountRecovered) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventMultisigAccountRecovered)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MultisigAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Signers) > 0 {
+			for _, s := range x.Signers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Threshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.Threshold))
+		}
+		l = len(x.ThresholdPercentage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventMultisigAccountRecovered)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ThresholdPercentage) > 0 {
+			i -= len(x.ThresholdPercentage)
+			copy(dAtA[i:], x.ThresholdPercentage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThresholdPercentage)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Threshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Threshold))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Signers) > 0 {
+			for iNdEx := len(x.Signers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Signers[iNdEx])
+				copy(dAtA[i:], x.Signers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signers[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.MultisigAddress) > 0 {
+			i -= len(x.MultisigAddress)
+			copy(dAtA[i:], x.MultisigAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MultisigAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventMultisigAccountRecovered)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventMultisigAccountRecovered: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventMultisigAccountRecovered: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MultisigAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signers = append(x.Signers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				x.Threshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Threshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThresholdPercentage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ThresholdPercentage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventDepositForfeited                  protoreflect.MessageDescriptor
+	fd_EventDepositForfeited_proposal_id      protoreflect.FieldDescriptor
+	fd_EventDepositForfeited_multisig_address protoreflect.FieldDescriptor
+	fd_EventDepositForfeited_depositor        protoreflect.FieldDescriptor
+	fd_EventDepositForfeited_amount           protoreflect.FieldDescriptor
+	fd_EventDepositForfeited_destination      protoreflect.FieldDescriptor
+	fd_EventDepositForfeited_expired          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_multisig_v1_events_proto_init()
+	md_EventDepositForfeited = File_multisig_v1_events_proto.Messages().ByName("EventDepositForfeited")
+	fd_EventDepositForfeited_proposal_id = md_EventDepositForfeited.Fields().ByName("proposal_id")
+	fd_EventDepositForfeited_multisig_address = md_EventDepositForfeited.Fields().ByName("multisig_address")
+	fd_EventDepositForfeited_depositor = md_EventDepositForfeited.Fields().ByName("depositor")
+	fd_EventDepositForfeited_amount = md_EventDepositForfeited.Fields().ByName("amount")
+	fd_EventDepositForfeited_destination = md_EventDepositForfeited.Fields().ByName("destination")
+	fd_EventDepositForfeited_expired = md_EventDepositForfeited.Fields().ByName("expired")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventDepositForfeited)(nil)
+
+type fastReflection_EventDepositForfeited EventDepositForfeited
+
+func (x *EventDepositForfeited) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventDepositForfeited)(x)
+}
+
+func (x *EventDepositForfeited) slowProtoReflect() protoreflect.Message {
+	mi := &file_multisig_v1_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventDepositForfeited_messageType fastReflection_EventDepositForfeited_messageType
+var _ protoreflect.MessageType = fastReflection_EventDepositForfeited_messageType{}
+
+type fastReflection_EventDepositForfeited_messageType struct{}
+
+func (x fastReflection_EventDepositForfeited_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventDepositForfeited)(nil)
+}
+func (x fastReflection_EventDepositForfeited_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventDepositForfeited)
+}
+func (x fastReflection_EventDepositForfeited_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventDepositForfeited
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventDepositForfeited) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventDepositForfeited
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventDepositForfeited) Type() protoreflect.MessageType {
+	return _fastReflection_EventDepositForfeited_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventDepositForfeited) New() protoreflect.Message {
+	return new(fastReflection_EventDepositForfeited)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventDepositForfeited) Interface() protoreflect.ProtoMessage {
+	return (*EventDepositForfeited)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventDepositForfeited) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_EventDepositForfeited_proposal_id, value) {
+			return
+		}
+	}
+	if x.MultisigAddress != "" {
+		value := protoreflect.ValueOfString(x.MultisigAddress)
+		if !f(fd_EventDepositForfeited_multisig_address, value) {
+			return
+		}
+	}
+	if x.Depositor != "" {
+		value := protoreflect.ValueOfString(x.Depositor)
+		if !f(fd_EventDepositForfeited_depositor, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_EventDepositForfeited_amount, value) {
+			return
+		}
+	}
+	if x.Destination != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Destination))
+		if !f(fd_EventDepositForfeited_destination, value) {
+			return
+		}
+	}
+	if x.Expired != false {
+		value := protoreflect.ValueOfBool(x.Expired)
+		if !f(fd_EventDepositForfeited_expired, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventDepositForfeited) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "multisig.v1.EventDepositForfeited.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "multisig.v1.EventDepositForfeited.multisig_address":
+		return x.MultisigAddress != ""
+	case "multisig.v1.EventDepositForfeited.depositor":
+		return x.Depositor != ""
+	case "multisig.v1.EventDepositForfeited.amount":
+		return x.Amount != ""
+	case "multisig.v1.EventDepositForfeited.destination":
+		return x.Destination != 0
+	case "multisig.v1.EventDepositForfeited.expired":
+		return x.Expired != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventDepositForfeited"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventDepositForfeited does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDepositForfeited) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "multisig.v1.EventDepositForfeited.proposal_id":
+		x.ProposalId = uint64(0)
+	case "multisig.v1.EventDepositForfeited.multisig_address":
+		x.MultisigAddress = ""
+	case "multisig.v1.EventDepositForfeited.depositor":
+		x.Depositor = ""
+	case "multisig.v1.EventDepositForfeited.amount":
+		x.Amount = ""
+	case "multisig.v1.EventDepositForfeited.destination":
+		x.Destination = 0
+	case "multisig.v1.EventDepositForfeited.expired":
+		x.Expired = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventDepositForfeited"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventDepositForfeited does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventDepositForfeited) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "multisig.v1.EventDepositForfeited.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "multisig.v1.EventDepositForfeited.multisig_address":
+		value := x.MultisigAddress
+		return protoreflect.ValueOfString(value)
+	case "multisig.v1.EventDepositForfeited.depositor":
+		value := x.Depositor
+		return protoreflect.ValueOfString(value)
+	case "multisig.v1.EventDepositForfeited.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "multisig.v1.EventDepositForfeited.destination":
+		value := x.Destination
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "multisig.v1.EventDepositForfeited.expired":
+		value := x.Expired
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventDepositForfeited"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventDepositForfeited does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDepositForfeited) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "multisig.v1.EventDepositForfeited.proposal_id":
+		x.ProposalId = value.Uint()
+	case "multisig.v1.EventDepositForfeited.multisig_address":
+		x.MultisigAddress = value.Interface().(string)
+	case "multisig.v1.EventDepositForfeited.depositor":
+		x.Depositor = value.Interface().(string)
+	case "multisig.v1.EventDepositForfeited.amount":
+		x.Amount = value.Interface().(string)
+	case "multisig.v1.EventDepositForfeited.destination":
+		x.Destination = (DepositForfeitDestination)(value.Enum())
+	case "multisig.v1.EventDepositForfeited.expired":
+		x.Expired = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventDepositForfeited"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventDepositForfeited does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDepositForfeited) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.EventDepositForfeited.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message multisig.v1.EventDepositForfeited is not mutable"))
+	case "multisig.v1.EventDepositForfeited.multisig_address":
+		panic(fmt.Errorf("field multisig_address of message multisig.v1.EventDepositForfeited is not mutable"))
+	case "multisig.v1.EventDepositForfeited.depositor":
+		panic(fmt.Errorf("field depositor of message multisig.v1.EventDepositForfeited is not mutable"))
+	case "multisig.v1.EventDepositForfeited.amount":
+		panic(fmt.Errorf("field amount of message multisig.v1.EventDepositForfeited is not mutable"))
+	case "multisig.v1.EventDepositForfeited.destination":
+		panic(fmt.Errorf("field destination of message multisig.v1.EventDepositForfeited is not mutable"))
+	case "multisig.v1.EventDepositForfeited.expired":
+		panic(fmt.Errorf("field expired of message multisig.v1.EventDepositForfeited is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventDepositForfeited"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventDepositForfeited does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventDepositForfeited) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.EventDepositForfeited.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "multisig.v1.EventDepositForfeited.multisig_address":
+		return protoreflect.ValueOfString("")
+	case "multisig.v1.EventDepositForfeited.depositor":
+		return protoreflect.ValueOfString("")
+	case "multisig.v1.EventDepositForfeited.amount":
+		return protoreflect.ValueOfString("")
+	case "multisig.v1.EventDepositForfeited.destination":
+		return protoreflect.ValueOfEnum(0)
+	case "multisig.v1.EventDepositForfeited.expired":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.EventDepositForfeited"))
+		}
+		panic(fmt.Errorf("message multisig.v1.EventDepositForfeited does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventDepositForfeited) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.EventDepositForfeited", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventDepositForfeited) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDepositForfeited) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventDepositForfeited) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventDepositForfeited) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventDepositForfeited)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		l = len(x.MultisigAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Depositor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Destination != 0 {
+			n += 1 + runtime.Sov(uint64(x.Destination))
+		}
+		if x.Expired {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventDepositForfeited)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Expired {
+			i--
+			if x.Expired {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Destination != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Destination))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Depositor) > 0 {
+			i -= len(x.Depositor)
+			copy(dAtA[i:], x.Depositor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Depositor)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.MultisigAddress) > 0 {
+			i -= len(x.MultisigAddress)
+			copy(dAtA[i:], x.MultisigAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MultisigAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventDepositForfeited)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventDepositForfeited: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventDepositForfeited: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MultisigAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Depositor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				}
+				x.Destination = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Destination |= DepositForfeitDestination(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Expired = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: multisig/v1/events.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventScheduleExecuted is emitted on every execution of a schedule by the EndBlocker
+type EventScheduleExecuted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduleId      uint64 `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	MultisigAddress string `protobuf:"bytes,2,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	Run             uint64 `protobuf:"varint,3,opt,name=run,proto3" json:"run,omitempty"`
+	// error is the reason of a failed execution, empty on success
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *EventScheduleExecuted) Reset() {
+	*x = EventScheduleExecuted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_v1_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventScheduleExecuted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventScheduleExecuted) ProtoMessage() {}
+
+// Deprecated: Use EventScheduleExecuted.ProtoReflect.Descriptor instead.
+func (*EventScheduleExecuted) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventScheduleExecuted) GetScheduleId() uint64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *EventScheduleExecuted) GetMultisigAddress() string {
+	if x != nil {
+		return x.MultisigAddress
+	}
+	return ""
+}
+
+func (x *EventScheduleExecuted) GetRun() uint64 {
+	if x != nil {
+		return x.Run
+	}
+	return 0
+}
+
+func (x *EventScheduleExecuted) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// EventScheduleCancelled is emitted on Msg/CancelMultisigSchedule
+type EventScheduleCancelled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduleId      uint64 `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	MultisigAddress string `protobuf:"bytes,2,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	// remaining_runs is the number of executions cancelled
+	RemainingRuns uint64 `protobuf:"varint,3,opt,name=remaining_runs,json=remainingRuns,proto3" json:"remaining_runs,omitempty"`
+}
+
+func (x *EventScheduleCancelled) Reset() {
+	*x = EventScheduleCancelled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_v1_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventScheduleCancelled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventScheduleCancelled) ProtoMessage() {}
+
+// Deprecated: Use EventScheduleCancelled.ProtoReflect.Descriptor instead.
+func (*EventScheduleCancelled) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventScheduleCancelled) GetScheduleId() uint64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *EventScheduleCancelled) GetMultisigAddress() string {
+	if x != nil {
+		return x.MultisigAddress
+	}
+	return ""
+}
+
+func (x *EventScheduleCancelled) GetRemainingRuns() uint64 {
+	if x != nil {
+		return x.RemainingRuns
+	}
+	return 0
+}
+
+// EventMultisigAccountFrozen is emitted on Msg/FreezeMultisigAccount
+type EventMultisigAccountFrozen struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MultisigAddress string `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	Reason          string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *EventMultisigAccountFrozen) Reset() {
+	*x = EventMultisigAccountFrozen{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_v1_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventMultisigAccountFrozen) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventMultisigAccountFrozen) ProtoMessage() {}
+
+// Deprecated: Use EventMultisigAccountFrozen.ProtoReflect.Descriptor instead.
+func (*EventMultisigAccountFrozen) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventMultisigAccountFrozen) GetMultisigAddress() string {
+	if x != nil {
+		return x.MultisigAddress
+	}
+	return ""
+}
+
+func (x *EventMultisigAccountFrozen) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// EventMultisigAccountRecovered is emitted on Msg/RecoverMultisigAccount
+type EventMultisigAccountRecovered struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MultisigAddress     string   `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	Signers             []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	Threshold           uint32   `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	ThresholdPercentage string   `protobuf:"bytes,4,opt,name=threshold_percentage,json=thresholdPercentage,proto3" json:"threshold_percentage,omitempty"`
+}
+
+func (x *EventMultisigAccountRecovered) Reset() {
+	*x = EventMultisigAccountRecovered{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_v1_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventMultisigAccountRecovered) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventMultisigAccountRecovered) ProtoMessage() {}
+
+// Deprecated: Use EventMultisigAccountRecovered.ProtoReflect.Descriptor instead.
+func (*EventMultisigAccountRecovered) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventMultisigAccountRecovered) GetMultisigAddress() string {
+	if x != nil {
+		return x.MultisigAddress
+	}
+	return ""
+}
+
+func (x *EventMultisigAccountRecovered) GetSigners() []string {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
+}
+
+func (x *EventMultisigAccountRecovered) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *EventMultisigAccountRecovered) GetThresholdPercentage() string {
+	if x != nil {
+		return x.ThresholdPercentage
+	}
+	return ""
+}
+
+// EventDepositForfeited is emitted when the deposit of a rejected or expired proposal is forfeited
+type EventDepositForfeited struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProposalId      uint64                    `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	MultisigAddress string                    `protobuf:"bytes,2,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	Depositor       string                    `protobuf:"bytes,3,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Amount          string                    `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Destination     DepositForfeitDestination `protobuf:"varint,5,opt,name=destination,proto3,enum=multisig.v1.DepositForfeitDestination" json:"destination,omitempty"`
+	// expired is set for expired proposals, unset for rejected ones
+	Expired bool `protobuf:"varint,6,opt,name=expired,proto3" json:"expired,omitempty"`
+}
+
+func (x *EventDepositForfeited) Reset() {
+	*x = EventDepositForfeited{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_v1_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventDepositForfeited) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventDepositForfeited) ProtoMessage() {}
+
+// Deprecated: Use EventDepositForfeited.ProtoReflect.Descriptor instead.
+func (*EventDepositForfeited) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventDepositForfeited) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *EventDepositForfeited) GetMultisigAddress() string {
+	if x != nil {
+		return x.MultisigAddress
+	}
+	return ""
+}
+
+func (x *EventDepositForfeited) GetDepositor() string {
+	if x != nil {
+		return x.Depositor
+	}
+	return ""
+}
+
+func (x *EventDepositForfeited) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *EventDepositForfeited) GetDestination() DepositForfeitDestination {
+	if x != nil {
+		return x.Destination
+	}
+	return DepositForfeitDestination_DEPOSIT_FORFEIT_DESTINATION_UNSPECIFIED
+}
+
+func (x *EventDepositForfeited) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+var File_multisig_v1_events_proto protoreflect.FileDescriptor
+
+var file_multisig_v1_events_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x17, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa5, 0x01, 0x0a, 0x15,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73,
+	0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72,
+	0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x72, 0x75, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0xa5, 0x01, 0x0a, 0x16, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12,
+	0x43, 0x0a, 0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6e, 0x73, 0x22, 0x79, 0x0a, 0x1a, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x12, 0x43, 0x0a, 0x10, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xf9, 0x01, 0x0a, 0x1d, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x43, 0x0a, 0x10, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x73, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a,
+	0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12,
+	0x41, 0x0a, 0x14, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x13, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x22, 0xb1, 0x02, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x43, 0x0a,
+	0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x48, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73,
+	0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x46, 0x6f, 0x72,
+	0x66, 0x65, 0x69, 0x74, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0xa6, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x61, 0x65, 0x76, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x61,
+	0x6e, 0x2f, 0x64, 0x6d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73,
+	0x69, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x58, 0x58, 0xaa, 0x02, 0x0b, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0b, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x73, 0x69, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x17, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
+	0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x0c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_multisig_v1_events_proto_rawDescOnce sync.Once
+	file_multisig_v1_events_proto_rawDescData = file_multisig_v1_events_proto_rawDesc
+)
+
+func file_multisig_v1_events_proto_rawDescGZIP() []byte {
+	file_multisig_v1_events_proto_rawDescOnce.Do(func() {
+		file_multisig_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_multisig_v1_events_proto_rawDescData)
+	})
+	return file_multisig_v1_events_proto_rawDescData
+}
+
+var file_multisig_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_multisig_v1_events_proto_goTypes = []interface{}{
+	(*EventScheduleExecuted)(nil),         // 0: multisig.v1.EventScheduleExecuted
+	(*EventScheduleCancelled)(nil),        // 1: multisig.v1.EventScheduleCancelled
+	(*EventMultisigAccountFrozen)(nil),    // 2: multisig.v1.EventMultisigAccountFrozen
+	(*EventMultisigAccountRecovered)(nil), // 3: multisig.v1.EventMultisigAccountRecovered
+	(*EventDepositForfeited)(nil),         // 4: multisig.v1.EventDepositForfeited
+	(DepositForfeitDestination)(0),        // 5: multisig.v1.DepositForfeitDestination
+}
+var file_multisig_v1_events_proto_depIdxs = []int32{
+	5, // 0: multisig.v1.EventDepositForfeited.destination:type_name -> multisig.v1.DepositForfeitDestination
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_multisig_v1_events_proto_init() }
+func file_multisig_v1_events_proto_init() {
+	if File_multisig_v1_events_proto != nil {
+		return
+	}
+	file_multisig_v1_state_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_multisig_v1_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventScheduleExecuted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_multisig_v1_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventScheduleCancelled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_multisig_v1_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventMultisigAccountFrozen); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_multisig_v1_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventMultisigAccountRecovered); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_multisig_v1_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventDepositForfeited); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_multisig_v1_events_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_multisig_v1_events_proto_goTypes,
+		DependencyIndexes: file_multisig_v1_events_proto_depIdxs,
+		MessageInfos:      file_multisig_v1_events_proto_msgTypes,
+	}.Build()
+	File_multisig_v1_events_proto = out.File
+	file_multisig_v1_events_proto_rawDesc = nil
+	file_multisig_v1_events_proto_goTypes = nil
+	file_multisig_v1_events_proto_depIdxs = nil
+}
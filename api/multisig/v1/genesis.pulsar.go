@@ -3,7 +3,9 @@ package multisigv1
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -14,15 +16,284 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_GenesisState_2_list)(nil)
+
+type _GenesisState_2_list struct {
+	list *[]*GenesisMultisigAccount
+}
+
+func (x *_GenesisState_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GenesisMultisigAccount)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GenesisMultisigAccount)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_2_list) AppendMutable() protoreflect.Value {
+	v := new(GenesisMultisigAccount)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_2_list) NewElement() protoreflect.Value {
+	v := new(GenesisMultisigAccount)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_3_list)(nil)
+
+type _GenesisState_3_list struct {
+	list *[]*Proposal
+}
+
+func (x *_GenesisState_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Proposal)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Proposal)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_3_list) AppendMutable() protoreflect.Value {
+	v := new(Proposal)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_3_list) NewElement() protoreflect.Value {
+	v := new(Proposal)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*Schedule
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Schedule)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Schedule)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(Schedule)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(Schedule)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*ScheduleFailure
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScheduleFailure)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScheduleFailure)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(ScheduleFailure)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(ScheduleFailure)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*GenesisFeeBudgetSpend
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GenesisFeeBudgetSpend)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GenesisFeeBudgetSpend)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(GenesisFeeBudgetSpend)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(GenesisFeeBudgetSpend)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState        protoreflect.MessageDescriptor
-	fd_GenesisState_params protoreflect.FieldDescriptor
+	md_GenesisState                   protoreflect.MessageDescriptor
+	fd_GenesisState_params            protoreflect.FieldDescriptor
+	fd_GenesisState_accounts          protoreflect.FieldDescriptor
+	fd_GenesisState_proposals         protoreflect.FieldDescriptor
+	fd_GenesisState_proposal_sequence protoreflect.FieldDescriptor
+	fd_GenesisState_schedules         protoreflect.FieldDescriptor
+	fd_GenesisState_schedule_sequence protoreflect.FieldDescriptor
+	fd_GenesisState_schedule_failures protoreflect.FieldDescriptor
+	fd_GenesisState_fee_budget_spends protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_multisig_v1_genesis_proto_init()
 	md_GenesisState = File_multisig_v1_genesis_proto.Messages().ByName("GenesisState")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_accounts = md_GenesisState.Fields().ByName("accounts")
+	fd_GenesisState_proposals = md_GenesisState.Fields().ByName("proposals")
+	fd_GenesisState_proposal_sequence = md_GenesisState.Fields().ByName("proposal_sequence")
+	fd_GenesisState_schedules = md_GenesisState.Fields().ByName("schedules")
+	fd_GenesisState_schedule_sequence = md_GenesisState.Fields().ByName("schedule_sequence")
+	fd_GenesisState_schedule_failures = md_GenesisState.Fields().ByName("schedule_failures")
+	fd_GenesisState_fee_budget_spends = md_GenesisState.Fields().ByName("fee_budget_spends")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -96,6 +367,48 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.Accounts) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.Accounts})
+		if !f(fd_GenesisState_accounts, value) {
+			return
+		}
+	}
+	if len(x.Proposals) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.Proposals})
+		if !f(fd_GenesisState_proposals, value) {
+			return
+		}
+	}
+	if x.ProposalSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalSequence)
+		if !f(fd_GenesisState_proposal_sequence, value) {
+			return
+		}
+	}
+	if len(x.Schedules) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.Schedules})
+		if !f(fd_GenesisState_schedules, value) {
+			return
+		}
+	}
+	if x.ScheduleSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ScheduleSequence)
+		if !f(fd_GenesisState_schedule_sequence, value) {
+			return
+		}
+	}
+	if len(x.ScheduleFailures) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.ScheduleFailures})
+		if !f(fd_GenesisState_schedule_failures, value) {
+			return
+		}
+	}
+	if len(x.FeeBudgetSpends) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.FeeBudgetSpends})
+		if !f(fd_GenesisState_fee_budget_spends, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -113,6 +426,20 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 	switch fd.FullName() {
 	case "multisig.v1.GenesisState.params":
 		return x.Params != nil
+	case "multisig.v1.GenesisState.accounts":
+		return len(x.Accounts) != 0
+	case "multisig.v1.GenesisState.proposals":
+		return len(x.Proposals) != 0
+	case "multisig.v1.GenesisState.proposal_sequence":
+		return x.ProposalSequence != uint64(0)
+	case "multisig.v1.GenesisState.schedules":
+		return len(x.Schedules) != 0
+	case "multisig.v1.GenesisState.schedule_sequence":
+		return x.ScheduleSequence != uint64(0)
+	case "multisig.v1.GenesisState.schedule_failures":
+		return len(x.ScheduleFailures) != 0
+	case "multisig.v1.GenesisState.fee_budget_spends":
+		return len(x.FeeBudgetSpends) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.GenesisState"))
@@ -131,6 +458,20 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "multisig.v1.GenesisState.params":
 		x.Params = nil
+	case "multisig.v1.GenesisState.accounts":
+		x.Accounts = nil
+	case "multisig.v1.GenesisState.proposals":
+		x.Proposals = nil
+	case "multisig.v1.GenesisState.proposal_sequence":
+		x.ProposalSequence = uint64(0)
+	case "multisig.v1.GenesisState.schedules":
+		x.Schedules = nil
+	case "multisig.v1.GenesisState.schedule_sequence":
+		x.ScheduleSequence = uint64(0)
+	case "multisig.v1.GenesisState.schedule_failures":
+		x.ScheduleFailures = nil
+	case "multisig.v1.GenesisState.fee_budget_spends":
+		x.FeeBudgetSpends = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.GenesisState"))
@@ -150,6 +491,42 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "multisig.v1.GenesisState.params":
 		value := x.Params
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "multisig.v1.GenesisState.accounts":
+		if len(x.Accounts) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.Accounts}
+		return protoreflect.ValueOfList(listValue)
+	case "multisig.v1.GenesisState.proposals":
+		if len(x.Proposals) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.Proposals}
+		return protoreflect.ValueOfList(listValue)
+	case "multisig.v1.GenesisState.proposal_sequence":
+		value := x.ProposalSequence
+		return protoreflect.ValueOfUint64(value)
+	case "multisig.v1.GenesisState.schedules":
+		if len(x.Schedules) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.Schedules}
+		return protoreflect.ValueOfList(listValue)
+	case "multisig.v1.GenesisState.schedule_sequence":
+		value := x.ScheduleSequence
+		return protoreflect.ValueOfUint64(value)
+	case "multisig.v1.GenesisState.schedule_failures":
+		if len(x.ScheduleFailures) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.ScheduleFailures}
+		return protoreflect.ValueOfList(listValue)
+	case "multisig.v1.GenesisState.fee_budget_spends":
+		if len(x.FeeBudgetSpends) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.FeeBudgetSpends}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.GenesisState"))
@@ -172,6 +549,30 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 	switch fd.FullName() {
 	case "multisig.v1.GenesisState.params":
 		x.Params = value.Message().Interface().(*Params)
+	case "multisig.v1.GenesisState.accounts":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.Accounts = *clv.list
+	case "multisig.v1.GenesisState.proposals":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.Proposals = *clv.list
+	case "multisig.v1.GenesisState.proposal_sequence":
+		x.ProposalSequence = value.Uint()
+	case "multisig.v1.GenesisState.schedules":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.Schedules = *clv.list
+	case "multisig.v1.GenesisState.schedule_sequence":
+		x.ScheduleSequence = value.Uint()
+	case "multisig.v1.GenesisState.schedule_failures":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.ScheduleFailures = *clv.list
+	case "multisig.v1.GenesisState.fee_budget_spends":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.FeeBudgetSpends = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.GenesisState"))
@@ -197,6 +598,40 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Params = new(Params)
 		}
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "multisig.v1.GenesisState.accounts":
+		if x.Accounts == nil {
+			x.Accounts = []*GenesisMultisigAccount{}
+		}
+		value := &_GenesisState_2_list{list: &x.Accounts}
+		return protoreflect.ValueOfList(value)
+	case "multisig.v1.GenesisState.proposals":
+		if x.Proposals == nil {
+			x.Proposals = []*Proposal{}
+		}
+		value := &_GenesisState_3_list{list: &x.Proposals}
+		return protoreflect.ValueOfList(value)
+	case "multisig.v1.GenesisState.schedules":
+		if x.Schedules == nil {
+			x.Schedules = []*Schedule{}
+		}
+		value := &_GenesisState_5_list{list: &x.Schedules}
+		return protoreflect.ValueOfList(value)
+	case "multisig.v1.GenesisState.schedule_failures":
+		if x.ScheduleFailures == nil {
+			x.ScheduleFailures = []*ScheduleFailure{}
+		}
+		value := &_GenesisState_7_list{list: &x.ScheduleFailures}
+		return protoreflect.ValueOfList(value)
+	case "multisig.v1.GenesisState.fee_budget_spends":
+		if x.FeeBudgetSpends == nil {
+			x.FeeBudgetSpends = []*GenesisFeeBudgetSpend{}
+		}
+		value := &_GenesisState_8_list{list: &x.FeeBudgetSpends}
+		return protoreflect.ValueOfList(value)
+	case "multisig.v1.GenesisState.proposal_sequence":
+		panic(fmt.Errorf("field proposal_sequence of message multisig.v1.GenesisState is not mutable"))
+	case "multisig.v1.GenesisState.schedule_sequence":
+		panic(fmt.Errorf("field schedule_sequence of message multisig.v1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.GenesisState"))
@@ -213,6 +648,25 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "multisig.v1.GenesisState.params":
 		m := new(Params)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "multisig.v1.GenesisState.accounts":
+		list := []*GenesisMultisigAccount{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "multisig.v1.GenesisState.proposals":
+		list := []*Proposal{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "multisig.v1.GenesisState.proposal_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "multisig.v1.GenesisState.schedules":
+		list := []*Schedule{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "multisig.v1.GenesisState.schedule_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "multisig.v1.GenesisState.schedule_failures":
+		list := []*ScheduleFailure{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "multisig.v1.GenesisState.fee_budget_spends":
+		list := []*GenesisFeeBudgetSpend{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.GenesisState"))
@@ -286,6 +740,42 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Accounts) > 0 {
+			for _, e := range x.Accounts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Proposals) > 0 {
+			for _, e := range x.Proposals {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ProposalSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalSequence))
+		}
+		if len(x.Schedules) > 0 {
+			for _, e := range x.Schedules {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ScheduleSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.ScheduleSequence))
+		}
+		if len(x.ScheduleFailures) > 0 {
+			for _, e := range x.ScheduleFailures {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FeeBudgetSpends) > 0 {
+			for _, e := range x.FeeBudgetSpends {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -315,6 +805,96 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.FeeBudgetSpends) > 0 {
+			for iNdEx := len(x.FeeBudgetSpends) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeBudgetSpends[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.ScheduleFailures) > 0 {
+			for iNdEx := len(x.ScheduleFailures) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ScheduleFailures[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.ScheduleSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ScheduleSequence))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Schedules) > 0 {
+			for iNdEx := len(x.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Schedules[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.ProposalSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalSequence))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Proposals) > 0 {
+			for iNdEx := len(x.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Proposals[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Accounts) > 0 {
+			for iNdEx := len(x.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Accounts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
 		if x.Params != nil {
 			encoded, err := options.Marshal(x.Params)
 			if err != nil {
@@ -414,61 +994,271 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Accounts = append(x.Accounts, &GenesisMultisigAccount{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Accounts[len(x.Accounts)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Proposals = append(x.Proposals, &Proposal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proposals[len(x.Proposals)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_Params            protoreflect.MessageDescriptor
-	fd_Params_some_value protoreflect.FieldDescriptor
-)
-
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalSequence", wireType)
+				}
+				x.ProposalSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Schedules = append(x.Schedules, &Schedule{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Schedules[len(x.Schedules)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScheduleSequence", wireType)
+				}
+				x.ScheduleSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ScheduleSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScheduleFailures", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ScheduleFailures = append(x.ScheduleFailures, &ScheduleFailure{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ScheduleFailures[len(x.ScheduleFailures)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeBudgetSpends", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeBudgetSpends = append(x.FeeBudgetSpends, &GenesisFeeBudgetSpend{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeBudgetSpends[len(x.FeeBudgetSpends)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_GenesisMultisigAccount         protoreflect.MessageDescriptor
+	fd_GenesisMultisigAccount_address protoreflect.FieldDescriptor
+	fd_GenesisMultisigAccount_details protoreflect.FieldDescriptor
+)
+
 func init() {
 	file_multisig_v1_genesis_proto_init()
-	md_Params = File_multisig_v1_genesis_proto.Messages().ByName("Params")
-	fd_Params_some_value = md_Params.Fields().ByName("some_value")
+	md_GenesisMultisigAccount = File_multisig_v1_genesis_proto.Messages().ByName("GenesisMultisigAccount")
+	fd_GenesisMultisigAccount_address = md_GenesisMultisigAccount.Fields().ByName("address")
+	fd_GenesisMultisigAccount_details = md_GenesisMultisigAccount.Fields().ByName("details")
 }
 
-var _ protoreflect.Message = (*fastReflection_Params)(nil)
+var _ protoreflect.Message = (*fastReflection_GenesisMultisigAccount)(nil)
 
-type fastReflection_Params Params
+type fastReflection_GenesisMultisigAccount GenesisMultisigAccount
 
-func (x *Params) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Params)(x)
+func (x *GenesisMultisigAccount) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisMultisigAccount)(x)
 }
 
-func (x *Params) slowProtoReflect() protoreflect.Message {
+func (x *GenesisMultisigAccount) slowProtoReflect() protoreflect.Message {
 	mi := &file_multisig_v1_genesis_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -480,43 +1270,43 @@ func (x *Params) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Params_messageType fastReflection_Params_messageType
-var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+var _fastReflection_GenesisMultisigAccount_messageType fastReflection_GenesisMultisigAccount_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisMultisigAccount_messageType{}
 
-type fastReflection_Params_messageType struct{}
+type fastReflection_GenesisMultisigAccount_messageType struct{}
 
-func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Params)(nil)
+func (x fastReflection_GenesisMultisigAccount_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisMultisigAccount)(nil)
 }
-func (x fastReflection_Params_messageType) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x fastReflection_GenesisMultisigAccount_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisMultisigAccount)
 }
-func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x fastReflection_GenesisMultisigAccount_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisMultisigAccount
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x *fastReflection_GenesisMultisigAccount) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisMultisigAccount
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Params) Type() protoreflect.MessageType {
-	return _fastReflection_Params_messageType
+func (x *fastReflection_GenesisMultisigAccount) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisMultisigAccount_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Params) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x *fastReflection_GenesisMultisigAccount) New() protoreflect.Message {
+	return new(fastReflection_GenesisMultisigAccount)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
-	return (*Params)(x)
+func (x *fastReflection_GenesisMultisigAccount) Interface() protoreflect.ProtoMessage {
+	return (*GenesisMultisigAccount)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -524,10 +1314,16 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.SomeValue != false {
-		value := protoreflect.ValueOfBool(x.SomeValue)
-		if !f(fd_Params_some_value, value) {
+func (x *fastReflection_GenesisMultisigAccount) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_GenesisMultisigAccount_address, value) {
+			return
+		}
+	}
+	if x.Details != nil {
+		value := protoreflect.ValueOfMessage(x.Details.ProtoReflect())
+		if !f(fd_GenesisMultisigAccount_details, value) {
 			return
 		}
 	}
@@ -544,15 +1340,17 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GenesisMultisigAccount) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "multisig.v1.Params.some_value":
-		return x.SomeValue != false
+	case "multisig.v1.GenesisMultisigAccount.address":
+		return x.Address != ""
+	case "multisig.v1.GenesisMultisigAccount.details":
+		return x.Details != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.GenesisMultisigAccount"))
 		}
-		panic(fmt.Errorf("message multisig.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.GenesisMultisigAccount does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -562,15 +1360,17 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GenesisMultisigAccount) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "multisig.v1.Params.some_value":
-		x.SomeValue = false
+	case "multisig.v1.GenesisMultisigAccount.address":
+		x.Address = ""
+	case "multisig.v1.GenesisMultisigAccount.details":
+		x.Details = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.GenesisMultisigAccount"))
 		}
-		panic(fmt.Errorf("message multisig.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.GenesisMultisigAccount does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -580,16 +1380,19 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GenesisMultisigAccount) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "multisig.v1.Params.some_value":
-		value := x.SomeValue
-		return protoreflect.ValueOfBool(value)
+	case "multisig.v1.GenesisMultisigAccount.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "multisig.v1.GenesisMultisigAccount.details":
+		value := x.Details
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.GenesisMultisigAccount"))
 		}
-		panic(fmt.Errorf("message multisig.v1.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message multisig.v1.GenesisMultisigAccount does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -603,15 +1406,17 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GenesisMultisigAccount) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "multisig.v1.Params.some_value":
-		x.SomeValue = value.Bool()
+	case "multisig.v1.GenesisMultisigAccount.address":
+		x.Address = value.Interface().(string)
+	case "multisig.v1.GenesisMultisigAccount.details":
+		x.Details = value.Message().Interface().(*MultisigAccountDetails)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.GenesisMultisigAccount"))
 		}
-		panic(fmt.Errorf("message multisig.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.GenesisMultisigAccount does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -625,40 +1430,48 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GenesisMultisigAccount) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "multisig.v1.Params.some_value":
-		panic(fmt.Errorf("field some_value of message multisig.v1.Params is not mutable"))
+	case "multisig.v1.GenesisMultisigAccount.details":
+		if x.Details == nil {
+			x.Details = new(MultisigAccountDetails)
+		}
+		return protoreflect.ValueOfMessage(x.Details.ProtoReflect())
+	case "multisig.v1.GenesisMultisigAccount.address":
+		panic(fmt.Errorf("field address of message multisig.v1.GenesisMultisigAccount is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.GenesisMultisigAccount"))
 		}
-		panic(fmt.Errorf("message multisig.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.GenesisMultisigAccount does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GenesisMultisigAccount) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "multisig.v1.Params.some_value":
-		return protoreflect.ValueOfBool(false)
+	case "multisig.v1.GenesisMultisigAccount.address":
+		return protoreflect.ValueOfString("")
+	case "multisig.v1.GenesisMultisigAccount.details":
+		m := new(MultisigAccountDetails)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.GenesisMultisigAccount"))
 		}
-		panic(fmt.Errorf("message multisig.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.GenesisMultisigAccount does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GenesisMultisigAccount) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.GenesisMultisigAccount", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -666,7 +1479,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GenesisMultisigAccount) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -677,7 +1490,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GenesisMultisigAccount) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -689,7 +1502,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_GenesisMultisigAccount) IsValid() bool {
 	return x != nil
 }
 
@@ -699,9 +1512,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GenesisMultisigAccount) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*GenesisMultisigAccount)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -713,8 +1526,13 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.SomeValue {
-			n += 2
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Details != nil {
+			l = options.Size(x.Details)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -726,7 +1544,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*GenesisMultisigAccount)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -745,15 +1563,26 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.SomeValue {
-			i--
-			if x.SomeValue {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+		if x.Details != nil {
+			encoded, err := options.Marshal(x.Details)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -766,7 +1595,1285 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*GenesisMultisigAccount)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisMultisigAccount: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisMultisigAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Details == nil {
+					x.Details = &MultisigAccountDetails{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Details); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_GenesisFeeBudgetSpend         protoreflect.MessageDescriptor
+	fd_GenesisFeeBudgetSpend_address protoreflect.FieldDescriptor
+	fd_GenesisFeeBudgetSpend_spend   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_multisig_v1_genesis_proto_init()
+	md_GenesisFeeBudgetSpend = File_multisig_v1_genesis_proto.Messages().ByName("GenesisFeeBudgetSpend")
+	fd_GenesisFeeBudgetSpend_address = md_GenesisFeeBudgetSpend.Fields().ByName("address")
+	fd_GenesisFeeBudgetSpend_spend = md_GenesisFeeBudgetSpend.Fields().ByName("spend")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisFeeBudgetSpend)(nil)
+
+type fastReflection_GenesisFeeBudgetSpend GenesisFeeBudgetSpend
+
+func (x *GenesisFeeBudgetSpend) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisFeeBudgetSpend)(x)
+}
+
+func (x *GenesisFeeBudgetSpend) slowProtoReflect() protoreflect.Message {
+	mi := &file_multisig_v1_genesis_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisFeeBudgetSpend_messageType fastReflection_GenesisFeeBudgetSpend_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisFeeBudgetSpend_messageType{}
+
+type fastReflection_GenesisFeeBudgetSpend_messageType struct{}
+
+func (x fastReflection_GenesisFeeBudgetSpend_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisFeeBudgetSpend)(nil)
+}
+func (x fastReflection_GenesisFeeBudgetSpend_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisFeeBudgetSpend)
+}
+func (x fastReflection_GenesisFeeBudgetSpend_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisFeeBudgetSpend
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisFeeBudgetSpend) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisFeeBudgetSpend
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisFeeBudgetSpend) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisFeeBudgetSpend_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisFeeBudgetSpend) New() protoreflect.Message {
+	return new(fastReflection_GenesisFeeBudgetSpend)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisFeeBudgetSpend) Interface() protoreflect.ProtoMessage {
+	return (*GenesisFeeBudgetSpend)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisFeeBudgetSpend) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_GenesisFeeBudgetSpend_address, value) {
+			return
+		}
+	}
+	if x.Spend != nil {
+		value := protoreflect.ValueOfMessage(x.Spend.ProtoReflect())
+		if !f(fd_GenesisFeeBudgetSpend_spend, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisFeeBudgetSpend) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "multisig.v1.GenesisFeeBudgetSpend.address":
+		return x.Address != ""
+	case "multisig.v1.GenesisFeeBudgetSpend.spend":
+		return x.Spend != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.GenesisFeeBudgetSpend"))
+		}
+		panic(fmt.Errorf("message multisig.v1.GenesisFeeBudgetSpend does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisFeeBudgetSpend) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "multisig.v1.GenesisFeeBudgetSpend.address":
+		x.Address = ""
+	case "multisig.v1.GenesisFeeBudgetSpend.spend":
+		x.Spend = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.GenesisFeeBudgetSpend"))
+		}
+		panic(fmt.Errorf("message multisig.v1.GenesisFeeBudgetSpend does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisFeeBudgetSpend) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "multisig.v1.GenesisFeeBudgetSpend.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "multisig.v1.GenesisFeeBudgetSpend.spend":
+		value := x.Spend
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.GenesisFeeBudgetSpend"))
+		}
+		panic(fmt.Errorf("message multisig.v1.GenesisFeeBudgetSpend does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisFeeBudgetSpend) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "multisig.v1.GenesisFeeBudgetSpend.address":
+		x.Address = value.Interface().(string)
+	case "multisig.v1.GenesisFeeBudgetSpend.spend":
+		x.Spend = value.Message().Interface().(*FeeBudgetSpend)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.GenesisFeeBudgetSpend"))
+		}
+		panic(fmt.Errorf("message multisig.v1.GenesisFeeBudgetSpend does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisFeeBudgetSpend) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.GenesisFeeBudgetSpend.spend":
+		if x.Spend == nil {
+			x.Spend = new(FeeBudgetSpend)
+		}
+		return protoreflect.ValueOfMessage(x.Spend.ProtoReflect())
+	case "multisig.v1.GenesisFeeBudgetSpend.address":
+		panic(fmt.Errorf("field address of message multisig.v1.GenesisFeeBudgetSpend is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.GenesisFeeBudgetSpend"))
+		}
+		panic(fmt.Errorf("message multisig.v1.GenesisFeeBudgetSpend does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisFeeBudgetSpend) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.GenesisFeeBudgetSpend.address":
+		return protoreflect.ValueOfString("")
+	case "multisig.v1.GenesisFeeBudgetSpend.spend":
+		m := new(FeeBudgetSpend)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.GenesisFeeBudgetSpend"))
+		}
+		panic(fmt.Errorf("message multisig.v1.GenesisFeeBudgetSpend does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisFeeBudgetSpend) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.GenesisFeeBudgetSpend", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisFeeBudgetSpend) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisFeeBudgetSpend) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisFeeBudgetSpend) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisFeeBudgetSpend) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisFeeBudgetSpend)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Spend != nil {
+			l = options.Size(x.Spend)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisFeeBudgetSpend)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Spend != nil {
+			encoded, err := options.Marshal(x.Spend)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisFeeBudgetSpend)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisFeeBudgetSpend: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisFeeBudgetSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Spend", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Spend == nil {
+					x.Spend = &FeeBudgetSpend{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Spend); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Params_5_list)(nil)
+
+type _Params_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Params_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Params                                    protoreflect.MessageDescriptor
+	fd_Params_some_value                         protoreflect.FieldDescriptor
+	fd_Params_max_nesting_depth                  protoreflect.FieldDescriptor
+	fd_Params_max_scheduled_executions_per_block protoreflect.FieldDescriptor
+	fd_Params_fee_budget                         protoreflect.FieldDescriptor
+	fd_Params_fee_budget_period                  protoreflect.FieldDescriptor
+	fd_Params_deposit_forfeit_destination        protoreflect.FieldDescriptor
+	fd_Params_proposal_expiry_blocks             protoreflect.FieldDescriptor
+	fd_Params_max_open_proposals                 protoreflect.FieldDescriptor
+	fd_Params_deposit                            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_multisig_v1_genesis_proto_init()
+	md_Params = File_multisig_v1_genesis_proto.Messages().ByName("Params")
+	fd_Params_some_value = md_Params.Fields().ByName("some_value")
+	fd_Params_max_nesting_depth = md_Params.Fields().ByName("max_nesting_depth")
+	fd_Params_max_scheduled_executions_per_block = md_Params.Fields().ByName("max_scheduled_executions_per_block")
+	fd_Params_fee_budget = md_Params.Fields().ByName("fee_budget")
+	fd_Params_fee_budget_period = md_Params.Fields().ByName("fee_budget_period")
+	fd_Params_deposit_forfeit_destination = md_Params.Fields().ByName("deposit_forfeit_destination")
+	fd_Params_proposal_expiry_blocks = md_Params.Fields().ByName("proposal_expiry_blocks")
+	fd_Params_max_open_proposals = md_Params.Fields().ByName("max_open_proposals")
+	fd_Params_deposit = md_Params.Fields().ByName("deposit")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_multisig_v1_genesis_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SomeValue != false {
+		value := protoreflect.ValueOfBool(x.SomeValue)
+		if !f(fd_Params_some_value, value) {
+			return
+		}
+	}
+	if x.MaxNestingDepth != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxNestingDepth)
+		if !f(fd_Params_max_nesting_depth, value) {
+			return
+		}
+	}
+	if x.MaxScheduledExecutionsPerBlock != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxScheduledExecutionsPerBlock)
+		if !f(fd_Params_max_scheduled_executions_per_block, value) {
+			return
+		}
+	}
+	if len(x.FeeBudget) != 0 {
+		value := protoreflect.ValueOfList(&_Params_5_list{list: &x.FeeBudget})
+		if !f(fd_Params_fee_budget, value) {
+			return
+		}
+	}
+	if x.FeeBudgetPeriod != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FeeBudgetPeriod)
+		if !f(fd_Params_fee_budget_period, value) {
+			return
+		}
+	}
+	if x.DepositForfeitDestination != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.DepositForfeitDestination))
+		if !f(fd_Params_deposit_forfeit_destination, value) {
+			return
+		}
+	}
+	if x.ProposalExpiryBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalExpiryBlocks)
+		if !f(fd_Params_proposal_expiry_blocks, value) {
+			return
+		}
+	}
+	if x.MaxOpenProposals != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxOpenProposals)
+		if !f(fd_Params_max_open_proposals, value) {
+			return
+		}
+	}
+	if x.Deposit != nil {
+		value := protoreflect.ValueOfMessage(x.Deposit.ProtoReflect())
+		if !f(fd_Params_deposit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "multisig.v1.Params.some_value":
+		return x.SomeValue != false
+	case "multisig.v1.Params.max_nesting_depth":
+		return x.MaxNestingDepth != uint32(0)
+	case "multisig.v1.Params.max_scheduled_executions_per_block":
+		return x.MaxScheduledExecutionsPerBlock != uint32(0)
+	case "multisig.v1.Params.fee_budget":
+		return len(x.FeeBudget) != 0
+	case "multisig.v1.Params.fee_budget_period":
+		return x.FeeBudgetPeriod != uint64(0)
+	case "multisig.v1.Params.deposit_forfeit_destination":
+		return x.DepositForfeitDestination != 0
+	case "multisig.v1.Params.proposal_expiry_blocks":
+		return x.ProposalExpiryBlocks != uint64(0)
+	case "multisig.v1.Params.max_open_proposals":
+		return x.MaxOpenProposals != uint32(0)
+	case "multisig.v1.Params.deposit":
+		return x.Deposit != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.Params"))
+		}
+		panic(fmt.Errorf("message multisig.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "multisig.v1.Params.some_value":
+		x.SomeValue = false
+	case "multisig.v1.Params.max_nesting_depth":
+		x.MaxNestingDepth = uint32(0)
+	case "multisig.v1.Params.max_scheduled_executions_per_block":
+		x.MaxScheduledExecutionsPerBlock = uint32(0)
+	case "multisig.v1.Params.fee_budget":
+		x.FeeBudget = nil
+	case "multisig.v1.Params.fee_budget_period":
+		x.FeeBudgetPeriod = uint64(0)
+	case "multisig.v1.Params.deposit_forfeit_destination":
+		x.DepositForfeitDestination = 0
+	case "multisig.v1.Params.proposal_expiry_blocks":
+		x.ProposalExpiryBlocks = uint64(0)
+	case "multisig.v1.Params.max_open_proposals":
+		x.MaxOpenProposals = uint32(0)
+	case "multisig.v1.Params.deposit":
+		x.Deposit = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.Params"))
+		}
+		panic(fmt.Errorf("message multisig.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "multisig.v1.Params.some_value":
+		value := x.SomeValue
+		return protoreflect.ValueOfBool(value)
+	case "multisig.v1.Params.max_nesting_depth":
+		value := x.MaxNestingDepth
+		return protoreflect.ValueOfUint32(value)
+	case "multisig.v1.Params.max_scheduled_executions_per_block":
+		value := x.MaxScheduledExecutionsPerBlock
+		return protoreflect.ValueOfUint32(value)
+	case "multisig.v1.Params.fee_budget":
+		if len(x.FeeBudget) == 0 {
+			return protoreflect.ValueOfList(&_Params_5_list{})
+		}
+		listValue := &_Params_5_list{list: &x.FeeBudget}
+		return protoreflect.ValueOfList(listValue)
+	case "multisig.v1.Params.fee_budget_period":
+		value := x.FeeBudgetPeriod
+		return protoreflect.ValueOfUint64(value)
+	case "multisig.v1.Params.deposit_forfeit_destination":
+		value := x.DepositForfeitDestination
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "multisig.v1.Params.proposal_expiry_blocks":
+		value := x.ProposalExpiryBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "multisig.v1.Params.max_open_proposals":
+		value := x.MaxOpenProposals
+		return protoreflect.ValueOfUint32(value)
+	case "multisig.v1.Params.deposit":
+		value := x.Deposit
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.Params"))
+		}
+		panic(fmt.Errorf("message multisig.v1.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "multisig.v1.Params.some_value":
+		x.SomeValue = value.Bool()
+	case "multisig.v1.Params.max_nesting_depth":
+		x.MaxNestingDepth = uint32(value.Uint())
+	case "multisig.v1.Params.max_scheduled_executions_per_block":
+		x.MaxScheduledExecutionsPerBlock = uint32(value.Uint())
+	case "multisig.v1.Params.fee_budget":
+		lv := value.List()
+		clv := lv.(*_Params_5_list)
+		x.FeeBudget = *clv.list
+	case "multisig.v1.Params.fee_budget_period":
+		x.FeeBudgetPeriod = value.Uint()
+	case "multisig.v1.Params.deposit_forfeit_destination":
+		x.DepositForfeitDestination = (DepositForfeitDestination)(value.Enum())
+	case "multisig.v1.Params.proposal_expiry_blocks":
+		x.ProposalExpiryBlocks = value.Uint()
+	case "multisig.v1.Params.max_open_proposals":
+		x.MaxOpenProposals = uint32(value.Uint())
+	case "multisig.v1.Params.deposit":
+		x.Deposit = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.Params"))
+		}
+		panic(fmt.Errorf("message multisig.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.Params.fee_budget":
+		if x.FeeBudget == nil {
+			x.FeeBudget = []*v1beta1.Coin{}
+		}
+		value := &_Params_5_list{list: &x.FeeBudget}
+		return protoreflect.ValueOfList(value)
+	case "multisig.v1.Params.deposit":
+		if x.Deposit == nil {
+			x.Deposit = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Deposit.ProtoReflect())
+	case "multisig.v1.Params.some_value":
+		panic(fmt.Errorf("field some_value of message multisig.v1.Params is not mutable"))
+	case "multisig.v1.Params.max_nesting_depth":
+		panic(fmt.Errorf("field max_nesting_depth of message multisig.v1.Params is not mutable"))
+	case "multisig.v1.Params.max_scheduled_executions_per_block":
+		panic(fmt.Errorf("field max_scheduled_executions_per_block of message multisig.v1.Params is not mutable"))
+	case "multisig.v1.Params.fee_budget_period":
+		panic(fmt.Errorf("field fee_budget_period of message multisig.v1.Params is not mutable"))
+	case "multisig.v1.Params.deposit_forfeit_destination":
+		panic(fmt.Errorf("field deposit_forfeit_destination of message multisig.v1.Params is not mutable"))
+	case "multisig.v1.Params.proposal_expiry_blocks":
+		panic(fmt.Errorf("field proposal_expiry_blocks of message multisig.v1.Params is not mutable"))
+	case "multisig.v1.Params.max_open_proposals":
+		panic(fmt.Errorf("field max_open_proposals of message multisig.v1.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.Params"))
+		}
+		panic(fmt.Errorf("message multisig.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.Params.some_value":
+		return protoreflect.ValueOfBool(false)
+	case "multisig.v1.Params.max_nesting_depth":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "multisig.v1.Params.max_scheduled_executions_per_block":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "multisig.v1.Params.fee_budget":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_5_list{list: &list})
+	case "multisig.v1.Params.fee_budget_period":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "multisig.v1.Params.deposit_forfeit_destination":
+		return protoreflect.ValueOfEnum(0)
+	case "multisig.v1.Params.proposal_expiry_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "multisig.v1.Params.max_open_proposals":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "multisig.v1.Params.deposit":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.Params"))
+		}
+		panic(fmt.Errorf("message multisig.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SomeValue {
+			n += 2
+		}
+		if x.MaxNestingDepth != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxNestingDepth))
+		}
+		if x.MaxScheduledExecutionsPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxScheduledExecutionsPerBlock))
+		}
+		if len(x.FeeBudget) > 0 {
+			for _, e := range x.FeeBudget {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.FeeBudgetPeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.FeeBudgetPeriod))
+		}
+		if x.DepositForfeitDestination != 0 {
+			n += 1 + runtime.Sov(uint64(x.DepositForfeitDestination))
+		}
+		if x.ProposalExpiryBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalExpiryBlocks))
+		}
+		if x.MaxOpenProposals != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxOpenProposals))
+		}
+		if x.Deposit != nil {
+			l = options.Size(x.Deposit)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Deposit != nil {
+			encoded, err := options.Marshal(x.Deposit)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.MaxOpenProposals != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxOpenProposals))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.ProposalExpiryBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalExpiryBlocks))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.DepositForfeitDestination != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DepositForfeitDestination))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.FeeBudgetPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FeeBudgetPeriod))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.FeeBudget) > 0 {
+			for iNdEx := len(x.FeeBudget) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeBudget[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.MaxScheduledExecutionsPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxScheduledExecutionsPerBlock))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MaxNestingDepth != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxNestingDepth))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.SomeValue {
+			i--
+			if x.SomeValue {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -806,9 +2913,101 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			switch fieldNum {
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SomeValue", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SomeValue", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SomeValue = bool(v != 0)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxNestingDepth", wireType)
+				}
+				x.MaxNestingDepth = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxNestingDepth |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxScheduledExecutionsPerBlock", wireType)
+				}
+				x.MaxScheduledExecutionsPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxScheduledExecutionsPerBlock |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeBudget", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeBudget = append(x.FeeBudget, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeBudget[len(x.FeeBudget)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeBudgetPeriod", wireType)
 				}
-				var v int
+				x.FeeBudgetPeriod = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -818,12 +3017,104 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					x.FeeBudgetPeriod |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.SomeValue = bool(v != 0)
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositForfeitDestination", wireType)
+				}
+				x.DepositForfeitDestination = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DepositForfeitDestination |= DepositForfeitDestination(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalExpiryBlocks", wireType)
+				}
+				x.ProposalExpiryBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalExpiryBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxOpenProposals", wireType)
+				}
+				x.MaxOpenProposals = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxOpenProposals |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Deposit == nil {
+					x.Deposit = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Deposit); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -880,6 +3171,20 @@ type GenesisState struct {
 
 	// Params defines all the parameters of the module.
 	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// accounts defines the multisig accounts.
+	Accounts []*GenesisMultisigAccount `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// proposals defines the open multisig proposals.
+	Proposals []*Proposal `protobuf:"bytes,3,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	// proposal_sequence is the last proposal id assigned by the proposal table.
+	ProposalSequence uint64 `protobuf:"varint,4,opt,name=proposal_sequence,json=proposalSequence,proto3" json:"proposal_sequence,omitempty"`
+	// schedules defines the pending scheduled calls.
+	Schedules []*Schedule `protobuf:"bytes,5,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	// schedule_sequence is the last schedule id assigned.
+	ScheduleSequence uint64 `protobuf:"varint,6,opt,name=schedule_sequence,json=scheduleSequence,proto3" json:"schedule_sequence,omitempty"`
+	// schedule_failures defines the recorded failed executions of schedules.
+	ScheduleFailures []*ScheduleFailure `protobuf:"bytes,7,rep,name=schedule_failures,json=scheduleFailures,proto3" json:"schedule_failures,omitempty"`
+	// fee_budget_spends defines the fees paid by multisig accounts in their current budget period.
+	FeeBudgetSpends []*GenesisFeeBudgetSpend `protobuf:"bytes,8,rep,name=fee_budget_spends,json=feeBudgetSpends,proto3" json:"fee_budget_spends,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -909,6 +3214,143 @@ func (x *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (x *GenesisState) GetAccounts() []*GenesisMultisigAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *GenesisState) GetProposals() []*Proposal {
+	if x != nil {
+		return x.Proposals
+	}
+	return nil
+}
+
+func (x *GenesisState) GetProposalSequence() uint64 {
+	if x != nil {
+		return x.ProposalSequence
+	}
+	return 0
+}
+
+func (x *GenesisState) GetSchedules() []*Schedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+func (x *GenesisState) GetScheduleSequence() uint64 {
+	if x != nil {
+		return x.ScheduleSequence
+	}
+	return 0
+}
+
+func (x *GenesisState) GetScheduleFailures() []*ScheduleFailure {
+	if x != nil {
+		return x.ScheduleFailures
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFeeBudgetSpends() []*GenesisFeeBudgetSpend {
+	if x != nil {
+		return x.FeeBudgetSpends
+	}
+	return nil
+}
+
+// GenesisMultisigAccount defines a multisig account and its details.
+type GenesisMultisigAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string                  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Details *MultisigAccountDetails `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+}
+
+func (x *GenesisMultisigAccount) Reset() {
+	*x = GenesisMultisigAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_v1_genesis_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisMultisigAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisMultisigAccount) ProtoMessage() {}
+
+// Deprecated: Use GenesisMultisigAccount.ProtoReflect.Descriptor instead.
+func (*GenesisMultisigAccount) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_genesis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GenesisMultisigAccount) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GenesisMultisigAccount) GetDetails() *MultisigAccountDetails {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+// GenesisFeeBudgetSpend defines the fees paid by a multisig account in a budget period.
+type GenesisFeeBudgetSpend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Spend   *FeeBudgetSpend `protobuf:"bytes,2,opt,name=spend,proto3" json:"spend,omitempty"`
+}
+
+func (x *GenesisFeeBudgetSpend) Reset() {
+	*x = GenesisFeeBudgetSpend{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_v1_genesis_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisFeeBudgetSpend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisFeeBudgetSpend) ProtoMessage() {}
+
+// Deprecated: Use GenesisFeeBudgetSpend.ProtoReflect.Descriptor instead.
+func (*GenesisFeeBudgetSpend) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GenesisFeeBudgetSpend) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GenesisFeeBudgetSpend) GetSpend() *FeeBudgetSpend {
+	if x != nil {
+		return x.Spend
+	}
+	return nil
+}
+
 // Params defines the set of module parameters.
 type Params struct {
 	state         protoimpl.MessageState
@@ -916,12 +3358,33 @@ type Params struct {
 	unknownFields protoimpl.UnknownFields
 
 	SomeValue bool `protobuf:"varint,2,opt,name=some_value,json=someValue,proto3" json:"some_value,omitempty"`
+	// max_nesting_depth is the maximum number of nested multisig levels, i.e. multisig
+	// accounts acting as signers of other multisig accounts, including the outermost one.
+	MaxNestingDepth uint32 `protobuf:"varint,3,opt,name=max_nesting_depth,json=maxNestingDepth,proto3" json:"max_nesting_depth,omitempty"`
+	// max_scheduled_executions_per_block is the maximum number of scheduled calls executed
+	// by the EndBlocker in a block. Due calls above the cap are deferred to the next blocks.
+	MaxScheduledExecutionsPerBlock uint32 `protobuf:"varint,4,opt,name=max_scheduled_executions_per_block,json=maxScheduledExecutionsPerBlock,proto3" json:"max_scheduled_executions_per_block,omitempty"`
+	// fee_budget is the maximum amount of fees a multisig account pays per fee_budget_period for
+	// txs of its signers that only approve or execute its proposals. An empty budget disables it.
+	FeeBudget []*v1beta1.Coin `protobuf:"bytes,5,rep,name=fee_budget,json=feeBudget,proto3" json:"fee_budget,omitempty"`
+	// fee_budget_period is the number of blocks after which the fee budgets are renewed.
+	FeeBudgetPeriod uint64 `protobuf:"varint,6,opt,name=fee_budget_period,json=feeBudgetPeriod,proto3" json:"fee_budget_period,omitempty"`
+	// deposit_forfeit_destination is where the deposits of rejected and expired proposals go.
+	// Deposits are refunded when proposals are executed or cancelled by their depositor.
+	DepositForfeitDestination DepositForfeitDestination `protobuf:"varint,7,opt,name=deposit_forfeit_destination,json=depositForfeitDestination,proto3,enum=multisig.v1.DepositForfeitDestination" json:"deposit_forfeit_destination,omitempty"`
+	// proposal_expiry_blocks is the number of blocks after which an open proposal expires,
+	// zero for proposals that do not expire.
+	ProposalExpiryBlocks uint64 `protobuf:"varint,8,opt,name=proposal_expiry_blocks,json=proposalExpiryBlocks,proto3" json:"proposal_expiry_blocks,omitempty"`
+	// max_open_proposals is the maximum number of proposals open at once per multisig account.
+	MaxOpenProposals uint32 `protobuf:"varint,9,opt,name=max_open_proposals,json=maxOpenProposals,proto3" json:"max_open_proposals,omitempty"`
+	// deposit is the amount held in reserve of the proposer of a multisig proposal.
+	Deposit *v1beta1.Coin `protobuf:"bytes,10,opt,name=deposit,proto3" json:"deposit,omitempty"`
 }
 
 func (x *Params) Reset() {
 	*x = Params{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_v1_genesis_proto_msgTypes[1]
+		mi := &file_multisig_v1_genesis_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -935,7 +3398,7 @@ func (*Params) ProtoMessage() {}
 
 // Deprecated: Use Params.ProtoReflect.Descriptor instead.
 func (*Params) Descriptor() ([]byte, []int) {
-	return file_multisig_v1_genesis_proto_rawDescGZIP(), []int{1}
+	return file_multisig_v1_genesis_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Params) GetSomeValue() bool {
@@ -945,6 +3408,62 @@ func (x *Params) GetSomeValue() bool {
 	return false
 }
 
+func (x *Params) GetMaxNestingDepth() uint32 {
+	if x != nil {
+		return x.MaxNestingDepth
+	}
+	return 0
+}
+
+func (x *Params) GetMaxScheduledExecutionsPerBlock() uint32 {
+	if x != nil {
+		return x.MaxScheduledExecutionsPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetFeeBudget() []*v1beta1.Coin {
+	if x != nil {
+		return x.FeeBudget
+	}
+	return nil
+}
+
+func (x *Params) GetFeeBudgetPeriod() uint64 {
+	if x != nil {
+		return x.FeeBudgetPeriod
+	}
+	return 0
+}
+
+func (x *Params) GetDepositForfeitDestination() DepositForfeitDestination {
+	if x != nil {
+		return x.DepositForfeitDestination
+	}
+	return DepositForfeitDestination_DEPOSIT_FORFEIT_DESTINATION_UNSPECIFIED
+}
+
+func (x *Params) GetProposalExpiryBlocks() uint64 {
+	if x != nil {
+		return x.ProposalExpiryBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetMaxOpenProposals() uint32 {
+	if x != nil {
+		return x.MaxOpenProposals
+	}
+	return 0
+}
+
+func (x *Params) GetDeposit() *v1beta1.Coin {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
 var File_multisig_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_multisig_v1_genesis_proto_rawDesc = []byte{
@@ -953,26 +3472,114 @@ var file_multisig_v1_genesis_proto_rawDesc = []byte{
 	0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11,
 	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x41, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x22, 0x45, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x6f, 0x6d, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x73, 0x6f, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x1c, 0x98,
-	0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x0f, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x73, 0x69, 0x67, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xa7, 0x01, 0x0a, 0x0f,
-	0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x42,
-	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x61, 0x65, 0x76,
-	0x4d, 0x69, 0x74, 0x68, 0x72, 0x61, 0x6e, 0x2f, 0x64, 0x6d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x3b,
-	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x58, 0x58,
-	0xaa, 0x02, 0x0b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02,
-	0x0b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x17, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
-	0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xff, 0x03, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x45, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73,
+	0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x09,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73,
+	0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x2b, 0x0a, 0x11, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x11,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73,
+	0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x54, 0x0a,
+	0x11, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x6e,
+	0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x46, 0x65,
+	0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65,
+	0x6e, 0x64, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x43, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x46, 0x65, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e,
+	0x64, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e,
+	0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x22, 0xf8,
+	0x04, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x6d,
+	0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73,
+	0x6f, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f,
+	0x6e, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4e, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x44,
+	0x65, 0x70, 0x74, 0x68, 0x12, 0x4a, 0x0a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x1e, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x80, 0x01, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f,
+	0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x66, 0x65, 0x65, 0x42, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x66, 0x65, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x66, 0x0a, 0x1b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x66, 0x65,
+	0x69, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69,
+	0x74, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x19, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x2c, 0x0a,
+	0x12, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x4f, 0x70,
+	0x65, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x3e, 0x0a, 0x07, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x3a, 0x1c, 0x98, 0xa0, 0x1f,
+	0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73,
+	0x69, 0x67, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xa7, 0x01, 0x0a, 0x0f, 0x63, 0x6f,
+	0x6d, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x61, 0x65, 0x76, 0x4d, 0x69,
+	0x74, 0x68, 0x72, 0x61, 0x6e, 0x2f, 0x64, 0x6d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x58, 0x58, 0xaa, 0x02,
+	0x0b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0b, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x17, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x73, 0x69, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -987,18 +3594,37 @@ func file_multisig_v1_genesis_proto_rawDescGZIP() []byte {
 	return file_multisig_v1_genesis_proto_rawDescData
 }
 
-var file_multisig_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_multisig_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_multisig_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: multisig.v1.GenesisState
-	(*Params)(nil),       // 1: multisig.v1.Params
+	(*GenesisState)(nil),           // 0: multisig.v1.GenesisState
+	(*GenesisMultisigAccount)(nil), // 1: multisig.v1.GenesisMultisigAccount
+	(*GenesisFeeBudgetSpend)(nil),  // 2: multisig.v1.GenesisFeeBudgetSpend
+	(*Params)(nil),                 // 3: multisig.v1.Params
+	(*Proposal)(nil),               // 4: multisig.v1.Proposal
+	(*Schedule)(nil),               // 5: multisig.v1.Schedule
+	(*ScheduleFailure)(nil),        // 6: multisig.v1.ScheduleFailure
+	(*MultisigAccountDetails)(nil), // 7: multisig.v1.MultisigAccountDetails
+	(*FeeBudgetSpend)(nil),         // 8: multisig.v1.FeeBudgetSpend
+	(*v1beta1.Coin)(nil),           // 9: cosmos.base.v1beta1.Coin
+	(DepositForfeitDestination)(0), // 10: multisig.v1.DepositForfeitDestination
 }
 var file_multisig_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: multisig.v1.GenesisState.params:type_name -> multisig.v1.Params
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3,  // 0: multisig.v1.GenesisState.params:type_name -> multisig.v1.Params
+	1,  // 1: multisig.v1.GenesisState.accounts:type_name -> multisig.v1.GenesisMultisigAccount
+	4,  // 2: multisig.v1.GenesisState.proposals:type_name -> multisig.v1.Proposal
+	5,  // 3: multisig.v1.GenesisState.schedules:type_name -> multisig.v1.Schedule
+	6,  // 4: multisig.v1.GenesisState.schedule_failures:type_name -> multisig.v1.ScheduleFailure
+	2,  // 5: multisig.v1.GenesisState.fee_budget_spends:type_name -> multisig.v1.GenesisFeeBudgetSpend
+	7,  // 6: multisig.v1.GenesisMultisigAccount.details:type_name -> multisig.v1.MultisigAccountDetails
+	8,  // 7: multisig.v1.GenesisFeeBudgetSpend.spend:type_name -> multisig.v1.FeeBudgetSpend
+	9,  // 8: multisig.v1.Params.fee_budget:type_name -> cosmos.base.v1beta1.Coin
+	10, // 9: multisig.v1.Params.deposit_forfeit_destination:type_name -> multisig.v1.DepositForfeitDestination
+	9,  // 10: multisig.v1.Params.deposit:type_name -> cosmos.base.v1beta1.Coin
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_multisig_v1_genesis_proto_init() }
@@ -1006,6 +3632,8 @@ func file_multisig_v1_genesis_proto_init() {
 	if File_multisig_v1_genesis_proto != nil {
 		return
 	}
+	file_multisig_v1_state_proto_init()
+	file_multisig_v1_schedule_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_multisig_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -1020,6 +3648,30 @@ func file_multisig_v1_genesis_proto_init() {
 			}
 		}
 		file_multisig_v1_genesis_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisMultisigAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_multisig_v1_genesis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisFeeBudgetSpend); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_multisig_v1_genesis_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Params); i {
 			case 0:
 				return &v.state
@@ -1038,7 +3690,7 @@ func file_multisig_v1_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_multisig_v1_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
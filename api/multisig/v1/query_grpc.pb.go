@@ -19,7 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName = "/multisig.v1.Query/Params"
+	Query_Params_FullMethodName                    = "/multisig.v1.Query/Params"
+	Query_MultisigAccount_FullMethodName           = "/multisig.v1.Query/MultisigAccount"
+	Query_InterchainAccount_FullMethodName         = "/multisig.v1.Query/InterchainAccount"
+	Query_MultisigAccountsByCreator_FullMethodName = "/multisig.v1.Query/MultisigAccountsByCreator"
+	Query_FrozenMultisigAccounts_FullMethodName    = "/multisig.v1.Query/FrozenMultisigAccounts"
+	Query_Schedule_FullMethodName                  = "/multisig.v1.Query/Schedule"
 )
 
 // QueryClient is the client API for Query service.
@@ -28,6 +33,16 @@ const (
 type QueryClient interface {
 	// Params queries all parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// MultisigAccount queries a multisig account and its registered interchain accounts.
+	MultisigAccount(ctx context.Context, in *QueryMultisigAccountRequest, opts ...grpc.CallOption) (*QueryMultisigAccountResponse, error)
+	// InterchainAccount queries the interchain account owned by a multisig account on a connection.
+	InterchainAccount(ctx context.Context, in *QueryInterchainAccountRequest, opts ...grpc.CallOption) (*QueryInterchainAccountResponse, error)
+	// MultisigAccountsByCreator queries the multisig accounts created by an account.
+	MultisigAccountsByCreator(ctx context.Context, in *QueryMultisigAccountsByCreatorRequest, opts ...grpc.CallOption) (*QueryMultisigAccountsByCreatorResponse, error)
+	// FrozenMultisigAccounts queries the multisig accounts frozen by governance.
+	FrozenMultisigAccounts(ctx context.Context, in *QueryFrozenMultisigAccountsRequest, opts ...grpc.CallOption) (*QueryFrozenMultisigAccountsResponse, error)
+	// Schedule queries a pending scheduled call and its failed executions.
+	Schedule(ctx context.Context, in *QueryScheduleRequest, opts ...grpc.CallOption) (*QueryScheduleResponse, error)
 }
 
 type queryClient struct {
@@ -47,12 +62,67 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) MultisigAccount(ctx context.Context, in *QueryMultisigAccountRequest, opts ...grpc.CallOption) (*QueryMultisigAccountResponse, error) {
+	out := new(QueryMultisigAccountResponse)
+	err := c.cc.Invoke(ctx, Query_MultisigAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InterchainAccount(ctx context.Context, in *QueryInterchainAccountRequest, opts ...grpc.CallOption) (*QueryInterchainAccountResponse, error) {
+	out := new(QueryInterchainAccountResponse)
+	err := c.cc.Invoke(ctx, Query_InterchainAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MultisigAccountsByCreator(ctx context.Context, in *QueryMultisigAccountsByCreatorRequest, opts ...grpc.CallOption) (*QueryMultisigAccountsByCreatorResponse, error) {
+	out := new(QueryMultisigAccountsByCreatorResponse)
+	err := c.cc.Invoke(ctx, Query_MultisigAccountsByCreator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FrozenMultisigAccounts(ctx context.Context, in *QueryFrozenMultisigAccountsRequest, opts ...grpc.CallOption) (*QueryFrozenMultisigAccountsResponse, error) {
+	out := new(QueryFrozenMultisigAccountsResponse)
+	err := c.cc.Invoke(ctx, Query_FrozenMultisigAccounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Schedule(ctx context.Context, in *QueryScheduleRequest, opts ...grpc.CallOption) (*QueryScheduleResponse, error) {
+	out := new(QueryScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_Schedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// Params queries all parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// MultisigAccount queries a multisig account and its registered interchain accounts.
+	MultisigAccount(context.Context, *QueryMultisigAccountRequest) (*QueryMultisigAccountResponse, error)
+	// InterchainAccount queries the interchain account owned by a multisig account on a connection.
+	InterchainAccount(context.Context, *QueryInterchainAccountRequest) (*QueryInterchainAccountResponse, error)
+	// MultisigAccountsByCreator queries the multisig accounts created by an account.
+	MultisigAccountsByCreator(context.Context, *QueryMultisigAccountsByCreatorRequest) (*QueryMultisigAccountsByCreatorResponse, error)
+	// FrozenMultisigAccounts queries the multisig accounts frozen by governance.
+	FrozenMultisigAccounts(context.Context, *QueryFrozenMultisigAccountsRequest) (*QueryFrozenMultisigAccountsResponse, error)
+	// Schedule queries a pending scheduled call and its failed executions.
+	Schedule(context.Context, *QueryScheduleRequest) (*QueryScheduleResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -63,6 +133,21 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) MultisigAccount(context.Context, *QueryMultisigAccountRequest) (*QueryMultisigAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultisigAccount not implemented")
+}
+func (UnimplementedQueryServer) InterchainAccount(context.Context, *QueryInterchainAccountRequest) (*QueryInterchainAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccount not implemented")
+}
+func (UnimplementedQueryServer) MultisigAccountsByCreator(context.Context, *QueryMultisigAccountsByCreatorRequest) (*QueryMultisigAccountsByCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultisigAccountsByCreator not implemented")
+}
+func (UnimplementedQueryServer) FrozenMultisigAccounts(context.Context, *QueryFrozenMultisigAccountsRequest) (*QueryFrozenMultisigAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenMultisigAccounts not implemented")
+}
+func (UnimplementedQueryServer) Schedule(context.Context, *QueryScheduleRequest) (*QueryScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Schedule not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +179,96 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MultisigAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMultisigAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MultisigAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MultisigAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MultisigAccount(ctx, req.(*QueryMultisigAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InterchainAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterchainAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterchainAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InterchainAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterchainAccount(ctx, req.(*QueryInterchainAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MultisigAccountsByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMultisigAccountsByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MultisigAccountsByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MultisigAccountsByCreator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MultisigAccountsByCreator(ctx, req.(*QueryMultisigAccountsByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenMultisigAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenMultisigAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenMultisigAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FrozenMultisigAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenMultisigAccounts(ctx, req.(*QueryFrozenMultisigAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Schedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Schedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Schedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Schedule(ctx, req.(*QueryScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +280,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "MultisigAccount",
+			Handler:    _Query_MultisigAccount_Handler,
+		},
+		{
+			MethodName: "InterchainAccount",
+			Handler:    _Query_InterchainAccount_Handler,
+		},
+		{
+			MethodName: "MultisigAccountsByCreator",
+			Handler:    _Query_MultisigAccountsByCreator_Handler,
+		},
+		{
+			MethodName: "FrozenMultisigAccounts",
+			Handler:    _Query_FrozenMultisigAccounts_Handler,
+		},
+		{
+			MethodName: "Schedule",
+			Handler:    _Query_Schedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "multisig/v1/query.proto",
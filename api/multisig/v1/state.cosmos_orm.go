@@ -9,6 +9,146 @@ import (
 	ormerrors "cosmossdk.io/orm/types/ormerrors"
 )
 
+type MultisigAccountTable interface {
+	Insert(ctx context.Context, multisigAccount *MultisigAccount) error
+	Update(ctx context.Context, multisigAccount *MultisigAccount) error
+	Save(ctx context.Context, multisigAccount *MultisigAccount) error
+	Delete(ctx context.Context, multisigAccount *MultisigAccount) error
+	Has(ctx context.Context, address []byte) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, address []byte) (*MultisigAccount, error)
+	List(ctx context.Context, prefixKey MultisigAccountIndexKey, opts ...ormlist.Option) (MultisigAccountIterator, error)
+	ListRange(ctx context.Context, from, to MultisigAccountIndexKey, opts ...ormlist.Option) (MultisigAccountIterator, error)
+	DeleteBy(ctx context.Context, prefixKey MultisigAccountIndexKey) error
+	DeleteRange(ctx context.Context, from, to MultisigAccountIndexKey) error
+
+	doNotImplement()
+}
+
+type MultisigAccountIterator struct {
+	ormtable.Iterator
+}
+
+func (i MultisigAccountIterator) Value() (*MultisigAccount, error) {
+	var multisigAccount MultisigAccount
+	err := i.UnmarshalMessage(&multisigAccount)
+	return &multisigAccount, err
+}
+
+type MultisigAccountIndexKey interface {
+	id() uint32
+	values() []interface{}
+	multisigAccountIndexKey()
+}
+
+// primary key starting index..
+type MultisigAccountPrimaryKey = MultisigAccountAddressIndexKey
+
+type MultisigAccountAddressIndexKey struct {
+	vs []interface{}
+}
+
+func (x MultisigAccountAddressIndexKey) id() uint32               { return 0 }
+func (x MultisigAccountAddressIndexKey) values() []interface{}    { return x.vs }
+func (x MultisigAccountAddressIndexKey) multisigAccountIndexKey() {}
+
+func (this MultisigAccountAddressIndexKey) WithAddress(address []byte) MultisigAccountAddressIndexKey {
+	this.vs = []interface{}{address}
+	return this
+}
+
+type MultisigAccountCreatorIndexKey struct {
+	vs []interface{}
+}
+
+func (x MultisigAccountCreatorIndexKey) id() uint32               { return 1 }
+func (x MultisigAccountCreatorIndexKey) values() []interface{}    { return x.vs }
+func (x MultisigAccountCreatorIndexKey) multisigAccountIndexKey() {}
+
+func (this MultisigAccountCreatorIndexKey) WithCreator(creator []byte) MultisigAccountCreatorIndexKey {
+	this.vs = []interface{}{creator}
+	return this
+}
+
+type MultisigAccountFrozenIndexKey struct {
+	vs []interface{}
+}
+
+func (x MultisigAccountFrozenIndexKey) id() uint32               { return 2 }
+func (x MultisigAccountFrozenIndexKey) values() []interface{}    { return x.vs }
+func (x MultisigAccountFrozenIndexKey) multisigAccountIndexKey() {}
+
+func (this MultisigAccountFrozenIndexKey) WithFrozen(frozen bool) MultisigAccountFrozenIndexKey {
+	this.vs = []interface{}{frozen}
+	return this
+}
+
+type multisigAccountTable struct {
+	table ormtable.Table
+}
+
+func (this multisigAccountTable) Insert(ctx context.Context, multisigAccount *MultisigAccount) error {
+	return this.table.Insert(ctx, multisigAccount)
+}
+
+func (this multisigAccountTable) Update(ctx context.Context, multisigAccount *MultisigAccount) error {
+	return this.table.Update(ctx, multisigAccount)
+}
+
+func (this multisigAccountTable) Save(ctx context.Context, multisigAccount *MultisigAccount) error {
+	return this.table.Save(ctx, multisigAccount)
+}
+
+func (this multisigAccountTable) Delete(ctx context.Context, multisigAccount *MultisigAccount) error {
+	return this.table.Delete(ctx, multisigAccount)
+}
+
+func (this multisigAccountTable) Has(ctx context.Context, address []byte) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, address)
+}
+
+func (this multisigAccountTable) Get(ctx context.Context, address []byte) (*MultisigAccount, error) {
+	var multisigAccount MultisigAccount
+	found, err := this.table.PrimaryKey().Get(ctx, &multisigAccount, address)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &multisigAccount, nil
+}
+
+func (this multisigAccountTable) List(ctx context.Context, prefixKey MultisigAccountIndexKey, opts ...ormlist.Option) (MultisigAccountIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return MultisigAccountIterator{it}, err
+}
+
+func (this multisigAccountTable) ListRange(ctx context.Context, from, to MultisigAccountIndexKey, opts ...ormlist.Option) (MultisigAccountIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return MultisigAccountIterator{it}, err
+}
+
+func (this multisigAccountTable) DeleteBy(ctx context.Context, prefixKey MultisigAccountIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this multisigAccountTable) DeleteRange(ctx context.Context, from, to MultisigAccountIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this multisigAccountTable) doNotImplement() {}
+
+var _ MultisigAccountTable = multisigAccountTable{}
+
+func NewMultisigAccountTable(db ormtable.Schema) (MultisigAccountTable, error) {
+	table := db.GetTable(&MultisigAccount{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&MultisigAccount{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return multisigAccountTable{table}, nil
+}
+
 type ProposalTable interface {
 	Insert(ctx context.Context, proposal *Proposal) error
 	InsertReturningId(ctx context.Context, proposal *Proposal) (uint64, error)
@@ -93,6 +233,19 @@ func (this ProposalDepositorIndexKey) WithDepositor(depositor []byte) ProposalDe
 	return this
 }
 
+type ProposalExpiryHeightIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProposalExpiryHeightIndexKey) id() uint32            { return 3 }
+func (x ProposalExpiryHeightIndexKey) values() []interface{} { return x.vs }
+func (x ProposalExpiryHeightIndexKey) proposalIndexKey()     {}
+
+func (this ProposalExpiryHeightIndexKey) WithExpiryHeight(expiry_height int64) ProposalExpiryHeightIndexKey {
+	this.vs = []interface{}{expiry_height}
+	return this
+}
+
 type proposalTable struct {
 	table ormtable.AutoIncrementTable
 }
@@ -190,13 +343,19 @@ func NewProposalTable(db ormtable.Schema) (ProposalTable, error) {
 }
 
 type StateStore interface {
+	MultisigAccountTable() MultisigAccountTable
 	ProposalTable() ProposalTable
 
 	doNotImplement()
 }
 
 type stateStore struct {
-	proposal ProposalTable
+	multisigAccount MultisigAccountTable
+	proposal        ProposalTable
+}
+
+func (x stateStore) MultisigAccountTable() MultisigAccountTable {
+	return x.multisigAccount
 }
 
 func (x stateStore) ProposalTable() ProposalTable {
@@ -208,12 +367,18 @@ func (stateStore) doNotImplement() {}
 var _ StateStore = stateStore{}
 
 func NewStateStore(db ormtable.Schema) (StateStore, error) {
+	multisigAccountTable, err := NewMultisigAccountTable(db)
+	if err != nil {
+		return nil, err
+	}
+
 	proposalTable, err := NewProposalTable(db)
 	if err != nil {
 		return nil, err
 	}
 
 	return stateStore{
+		multisigAccountTable,
 		proposalTable,
 	}, nil
 }
@@ -2,9 +2,12 @@
 package multisigv1
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	_ "cosmossdk.io/api/cosmos/orm/v1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -60,10 +63,13 @@ func (x *_MultisigAccountDetails_1_list) IsValid() bool {
 }
 
 var (
-	md_MultisigAccountDetails            protoreflect.MessageDescriptor
-	fd_MultisigAccountDetails_signers    protoreflect.FieldDescriptor
-	fd_MultisigAccountDetails_threshold  protoreflect.FieldDescriptor
-	fd_MultisigAccountDetails_permission protoreflect.FieldDescriptor
+	md_MultisigAccountDetails                      protoreflect.MessageDescriptor
+	fd_MultisigAccountDetails_signers              protoreflect.FieldDescriptor
+	fd_MultisigAccountDetails_threshold            protoreflect.FieldDescriptor
+	fd_MultisigAccountDetails_permission           protoreflect.FieldDescriptor
+	fd_MultisigAccountDetails_frozen               protoreflect.FieldDescriptor
+	fd_MultisigAccountDetails_creator              protoreflect.FieldDescriptor
+	fd_MultisigAccountDetails_threshold_percentage protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -72,6 +78,9 @@ func init() {
 	fd_MultisigAccountDetails_signers = md_MultisigAccountDetails.Fields().ByName("signers")
 	fd_MultisigAccountDetails_threshold = md_MultisigAccountDetails.Fields().ByName("threshold")
 	fd_MultisigAccountDetails_permission = md_MultisigAccountDetails.Fields().ByName("permission")
+	fd_MultisigAccountDetails_frozen = md_MultisigAccountDetails.Fields().ByName("frozen")
+	fd_MultisigAccountDetails_creator = md_MultisigAccountDetails.Fields().ByName("creator")
+	fd_MultisigAccountDetails_threshold_percentage = md_MultisigAccountDetails.Fields().ByName("threshold_percentage")
 }
 
 var _ protoreflect.Message = (*fastReflection_MultisigAccountDetails)(nil)
@@ -157,6 +166,24 @@ func (x *fastReflection_MultisigAccountDetails) Range(f func(protoreflect.FieldD
 			return
 		}
 	}
+	if x.Frozen != false {
+		value := protoreflect.ValueOfBool(x.Frozen)
+		if !f(fd_MultisigAccountDetails_frozen, value) {
+			return
+		}
+	}
+	if len(x.Creator) != 0 {
+		value := protoreflect.ValueOfBytes(x.Creator)
+		if !f(fd_MultisigAccountDetails_creator, value) {
+			return
+		}
+	}
+	if x.ThresholdPercentage != "" {
+		value := protoreflect.ValueOfString(x.ThresholdPercentage)
+		if !f(fd_MultisigAccountDetails_threshold_percentage, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -178,6 +205,12 @@ func (x *fastReflection_MultisigAccountDetails) Has(fd protoreflect.FieldDescrip
 		return x.Threshold != uint32(0)
 	case "multisig.v1.MultisigAccountDetails.permission":
 		return x.Permission != 0
+	case "multisig.v1.MultisigAccountDetails.frozen":
+		return x.Frozen != false
+	case "multisig.v1.MultisigAccountDetails.creator":
+		return len(x.Creator) != 0
+	case "multisig.v1.MultisigAccountDetails.threshold_percentage":
+		return x.ThresholdPercentage != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MultisigAccountDetails"))
@@ -200,6 +233,12 @@ func (x *fastReflection_MultisigAccountDetails) Clear(fd protoreflect.FieldDescr
 		x.Threshold = uint32(0)
 	case "multisig.v1.MultisigAccountDetails.permission":
 		x.Permission = 0
+	case "multisig.v1.MultisigAccountDetails.frozen":
+		x.Frozen = false
+	case "multisig.v1.MultisigAccountDetails.creator":
+		x.Creator = nil
+	case "multisig.v1.MultisigAccountDetails.threshold_percentage":
+		x.ThresholdPercentage = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MultisigAccountDetails"))
@@ -228,6 +267,15 @@ func (x *fastReflection_MultisigAccountDetails) Get(descriptor protoreflect.Fiel
 	case "multisig.v1.MultisigAccountDetails.permission":
 		value := x.Permission
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "multisig.v1.MultisigAccountDetails.frozen":
+		value := x.Frozen
+		return protoreflect.ValueOfBool(value)
+	case "multisig.v1.MultisigAccountDetails.creator":
+		value := x.Creator
+		return protoreflect.ValueOfBytes(value)
+	case "multisig.v1.MultisigAccountDetails.threshold_percentage":
+		value := x.ThresholdPercentage
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MultisigAccountDetails"))
@@ -256,6 +304,12 @@ func (x *fastReflection_MultisigAccountDetails) Set(fd protoreflect.FieldDescrip
 		x.Threshold = uint32(value.Uint())
 	case "multisig.v1.MultisigAccountDetails.permission":
 		x.Permission = (MultisigProposalType)(value.Enum())
+	case "multisig.v1.MultisigAccountDetails.frozen":
+		x.Frozen = value.Bool()
+	case "multisig.v1.MultisigAccountDetails.creator":
+		x.Creator = value.Bytes()
+	case "multisig.v1.MultisigAccountDetails.threshold_percentage":
+		x.ThresholdPercentage = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MultisigAccountDetails"))
@@ -286,6 +340,12 @@ func (x *fastReflection_MultisigAccountDetails) Mutable(fd protoreflect.FieldDes
 		panic(fmt.Errorf("field threshold of message multisig.v1.MultisigAccountDetails is not mutable"))
 	case "multisig.v1.MultisigAccountDetails.permission":
 		panic(fmt.Errorf("field permission of message multisig.v1.MultisigAccountDetails is not mutable"))
+	case "multisig.v1.MultisigAccountDetails.frozen":
+		panic(fmt.Errorf("field frozen of message multisig.v1.MultisigAccountDetails is not mutable"))
+	case "multisig.v1.MultisigAccountDetails.creator":
+		panic(fmt.Errorf("field creator of message multisig.v1.MultisigAccountDetails is not mutable"))
+	case "multisig.v1.MultisigAccountDetails.threshold_percentage":
+		panic(fmt.Errorf("field threshold_percentage of message multisig.v1.MultisigAccountDetails is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MultisigAccountDetails"))
@@ -306,6 +366,12 @@ func (x *fastReflection_MultisigAccountDetails) NewField(fd protoreflect.FieldDe
 		return protoreflect.ValueOfUint32(uint32(0))
 	case "multisig.v1.MultisigAccountDetails.permission":
 		return protoreflect.ValueOfEnum(0)
+	case "multisig.v1.MultisigAccountDetails.frozen":
+		return protoreflect.ValueOfBool(false)
+	case "multisig.v1.MultisigAccountDetails.creator":
+		return protoreflect.ValueOfBytes(nil)
+	case "multisig.v1.MultisigAccountDetails.threshold_percentage":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MultisigAccountDetails"))
@@ -387,6 +453,17 @@ func (x *fastReflection_MultisigAccountDetails) ProtoMethods() *protoiface.Metho
 		if x.Permission != 0 {
 			n += 1 + runtime.Sov(uint64(x.Permission))
 		}
+		if x.Frozen {
+			n += 2
+		}
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ThresholdPercentage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -416,6 +493,30 @@ func (x *fastReflection_MultisigAccountDetails) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ThresholdPercentage) > 0 {
+			i -= len(x.ThresholdPercentage)
+			copy(dAtA[i:], x.ThresholdPercentage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThresholdPercentage)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Frozen {
+			i--
+			if x.Frozen {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
 		if x.Permission != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Permission))
 			i--
@@ -554,6 +655,92 @@ func (x *fastReflection_MultisigAccountDetails) ProtoMethods() *protoiface.Metho
 						break
 					}
 				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Frozen = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = append(x.Creator[:0], dAtA[iNdEx:postIndex]...)
+				if x.Creator == nil {
+					x.Creator = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThresholdPercentage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ThresholdPercentage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -589,82 +776,84 @@ func (x *fastReflection_MultisigAccountDetails) ProtoMethods() *protoiface.Metho
 	}
 }
 
-var _ protoreflect.List = (*_Proposal_6_list)(nil)
+var _ protoreflect.List = (*_MultisigAccount_3_list)(nil)
 
-type _Proposal_6_list struct {
+type _MultisigAccount_3_list struct {
 	list *[][]byte
 }
 
-func (x *_Proposal_6_list) Len() int {
+func (x *_MultisigAccount_3_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Proposal_6_list) Get(i int) protoreflect.Value {
+func (x *_MultisigAccount_3_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfBytes((*x.list)[i])
 }
 
-func (x *_Proposal_6_list) Set(i int, value protoreflect.Value) {
+func (x *_MultisigAccount_3_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Bytes()
 	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Proposal_6_list) Append(value protoreflect.Value) {
+func (x *_MultisigAccount_3_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Bytes()
 	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Proposal_6_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message Proposal at list field Approvals as it is not of Message kind"))
+func (x *_MultisigAccount_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MultisigAccount at list field Signers as it is not of Message kind"))
 }
 
-func (x *_Proposal_6_list) Truncate(n int) {
+func (x *_MultisigAccount_3_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Proposal_6_list) NewElement() protoreflect.Value {
+func (x *_MultisigAccount_3_list) NewElement() protoreflect.Value {
 	var v []byte
 	return protoreflect.ValueOfBytes(v)
 }
 
-func (x *_Proposal_6_list) IsValid() bool {
+func (x *_MultisigAccount_3_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_Proposal                  protoreflect.MessageDescriptor
-	fd_Proposal_id               protoreflect.FieldDescriptor
-	fd_Proposal_multisig_address protoreflect.FieldDescriptor
-	fd_Proposal_call_hash        protoreflect.FieldDescriptor
-	fd_Proposal_depositor        protoreflect.FieldDescriptor
-	fd_Proposal_deposit          protoreflect.FieldDescriptor
-	fd_Proposal_approvals        protoreflect.FieldDescriptor
+	md_MultisigAccount                      protoreflect.MessageDescriptor
+	fd_MultisigAccount_address              protoreflect.FieldDescriptor
+	fd_MultisigAccount_creator              protoreflect.FieldDescriptor
+	fd_MultisigAccount_signers              protoreflect.FieldDescriptor
+	fd_MultisigAccount_threshold            protoreflect.FieldDescriptor
+	fd_MultisigAccount_permission           protoreflect.FieldDescriptor
+	fd_MultisigAccount_frozen               protoreflect.FieldDescriptor
+	fd_MultisigAccount_threshold_percentage protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_multisig_v1_state_proto_init()
-	md_Proposal = File_multisig_v1_state_proto.Messages().ByName("Proposal")
-	fd_Proposal_id = md_Proposal.Fields().ByName("id")
-	fd_Proposal_multisig_address = md_Proposal.Fields().ByName("multisig_address")
-	fd_Proposal_call_hash = md_Proposal.Fields().ByName("call_hash")
-	fd_Proposal_depositor = md_Proposal.Fields().ByName("depositor")
-	fd_Proposal_deposit = md_Proposal.Fields().ByName("deposit")
-	fd_Proposal_approvals = md_Proposal.Fields().ByName("approvals")
+	md_MultisigAccount = File_multisig_v1_state_proto.Messages().ByName("MultisigAccount")
+	fd_MultisigAccount_address = md_MultisigAccount.Fields().ByName("address")
+	fd_MultisigAccount_creator = md_MultisigAccount.Fields().ByName("creator")
+	fd_MultisigAccount_signers = md_MultisigAccount.Fields().ByName("signers")
+	fd_MultisigAccount_threshold = md_MultisigAccount.Fields().ByName("threshold")
+	fd_MultisigAccount_permission = md_MultisigAccount.Fields().ByName("permission")
+	fd_MultisigAccount_frozen = md_MultisigAccount.Fields().ByName("frozen")
+	fd_MultisigAccount_threshold_percentage = md_MultisigAccount.Fields().ByName("threshold_percentage")
 }
 
-var _ protoreflect.Message = (*fastReflection_Proposal)(nil)
+var _ protoreflect.Message = (*fastReflection_MultisigAccount)(nil)
 
-type fastReflection_Proposal Proposal
+type fastReflection_MultisigAccount MultisigAccount
 
-func (x *Proposal) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Proposal)(x)
+func (x *MultisigAccount) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MultisigAccount)(x)
 }
 
-func (x *Proposal) slowProtoReflect() protoreflect.Message {
+func (x *MultisigAccount) slowProtoReflect() protoreflect.Message {
 	mi := &file_multisig_v1_state_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -676,43 +865,43 @@ func (x *Proposal) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Proposal_messageType fastReflection_Proposal_messageType
-var _ protoreflect.MessageType = fastReflection_Proposal_messageType{}
+var _fastReflection_MultisigAccount_messageType fastReflection_MultisigAccount_messageType
+var _ protoreflect.MessageType = fastReflection_MultisigAccount_messageType{}
 
-type fastReflection_Proposal_messageType struct{}
+type fastReflection_MultisigAccount_messageType struct{}
 
-func (x fastReflection_Proposal_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Proposal)(nil)
+func (x fastReflection_MultisigAccount_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MultisigAccount)(nil)
 }
-func (x fastReflection_Proposal_messageType) New() protoreflect.Message {
-	return new(fastReflection_Proposal)
+func (x fastReflection_MultisigAccount_messageType) New() protoreflect.Message {
+	return new(fastReflection_MultisigAccount)
 }
-func (x fastReflection_Proposal_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Proposal
+func (x fastReflection_MultisigAccount_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MultisigAccount
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Proposal) Descriptor() protoreflect.MessageDescriptor {
-	return md_Proposal
+func (x *fastReflection_MultisigAccount) Descriptor() protoreflect.MessageDescriptor {
+	return md_MultisigAccount
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Proposal) Type() protoreflect.MessageType {
-	return _fastReflection_Proposal_messageType
+func (x *fastReflection_MultisigAccount) Type() protoreflect.MessageType {
+	return _fastReflection_MultisigAccount_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Proposal) New() protoreflect.Message {
-	return new(fastReflection_Proposal)
+func (x *fastReflection_MultisigAccount) New() protoreflect.Message {
+	return new(fastReflection_MultisigAccount)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Proposal) Interface() protoreflect.ProtoMessage {
-	return (*Proposal)(x)
+func (x *fastReflection_MultisigAccount) Interface() protoreflect.ProtoMessage {
+	return (*MultisigAccount)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -720,40 +909,46 @@ func (x *fastReflection_Proposal) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Proposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_Proposal_id, value) {
+func (x *fastReflection_MultisigAccount) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Address) != 0 {
+		value := protoreflect.ValueOfBytes(x.Address)
+		if !f(fd_MultisigAccount_address, value) {
 			return
 		}
 	}
-	if len(x.MultisigAddress) != 0 {
-		value := protoreflect.ValueOfBytes(x.MultisigAddress)
-		if !f(fd_Proposal_multisig_address, value) {
+	if len(x.Creator) != 0 {
+		value := protoreflect.ValueOfBytes(x.Creator)
+		if !f(fd_MultisigAccount_creator, value) {
 			return
 		}
 	}
-	if len(x.CallHash) != 0 {
-		value := protoreflect.ValueOfBytes(x.CallHash)
-		if !f(fd_Proposal_call_hash, value) {
+	if len(x.Signers) != 0 {
+		value := protoreflect.ValueOfList(&_MultisigAccount_3_list{list: &x.Signers})
+		if !f(fd_MultisigAccount_signers, value) {
 			return
 		}
 	}
-	if len(x.Depositor) != 0 {
-		value := protoreflect.ValueOfBytes(x.Depositor)
-		if !f(fd_Proposal_depositor, value) {
+	if x.Threshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Threshold)
+		if !f(fd_MultisigAccount_threshold, value) {
 			return
 		}
 	}
-	if x.Deposit != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Deposit)
-		if !f(fd_Proposal_deposit, value) {
+	if x.Permission != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Permission))
+		if !f(fd_MultisigAccount_permission, value) {
 			return
 		}
 	}
-	if len(x.Approvals) != 0 {
-		value := protoreflect.ValueOfList(&_Proposal_6_list{list: &x.Approvals})
-		if !f(fd_Proposal_approvals, value) {
+	if x.Frozen != false {
+		value := protoreflect.ValueOfBool(x.Frozen)
+		if !f(fd_MultisigAccount_frozen, value) {
+			return
+		}
+	}
+	if x.ThresholdPercentage != "" {
+		value := protoreflect.ValueOfString(x.ThresholdPercentage)
+		if !f(fd_MultisigAccount_threshold_percentage, value) {
 			return
 		}
 	}
@@ -770,25 +965,27 @@ func (x *fastReflection_Proposal) Range(f func(protoreflect.FieldDescriptor, pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Proposal) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MultisigAccount) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "multisig.v1.Proposal.id":
-		return x.Id != uint64(0)
-	case "multisig.v1.Proposal.multisig_address":
-		return len(x.MultisigAddress) != 0
-	case "multisig.v1.Proposal.call_hash":
-		return len(x.CallHash) != 0
-	case "multisig.v1.Proposal.depositor":
-		return len(x.Depositor) != 0
-	case "multisig.v1.Proposal.deposit":
-		return x.Deposit != uint64(0)
-	case "multisig.v1.Proposal.approvals":
-		return len(x.Approvals) != 0
+	case "multisig.v1.MultisigAccount.address":
+		return len(x.Address) != 0
+	case "multisig.v1.MultisigAccount.creator":
+		return len(x.Creator) != 0
+	case "multisig.v1.MultisigAccount.signers":
+		return len(x.Signers) != 0
+	case "multisig.v1.MultisigAccount.threshold":
+		return x.Threshold != uint32(0)
+	case "multisig.v1.MultisigAccount.permission":
+		return x.Permission != 0
+	case "multisig.v1.MultisigAccount.frozen":
+		return x.Frozen != false
+	case "multisig.v1.MultisigAccount.threshold_percentage":
+		return x.ThresholdPercentage != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MultisigAccount"))
 		}
-		panic(fmt.Errorf("message multisig.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.MultisigAccount does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -798,25 +995,27 @@ func (x *fastReflection_Proposal) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Proposal) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MultisigAccount) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "multisig.v1.Proposal.id":
-		x.Id = uint64(0)
-	case "multisig.v1.Proposal.multisig_address":
-		x.MultisigAddress = nil
-	case "multisig.v1.Proposal.call_hash":
-		x.CallHash = nil
-	case "multisig.v1.Proposal.depositor":
-		x.Depositor = nil
-	case "multisig.v1.Proposal.deposit":
-		x.Deposit = uint64(0)
-	case "multisig.v1.Proposal.approvals":
-		x.Approvals = nil
+	case "multisig.v1.MultisigAccount.address":
+		x.Address = nil
+	case "multisig.v1.MultisigAccount.creator":
+		x.Creator = nil
+	case "multisig.v1.MultisigAccount.signers":
+		x.Signers = nil
+	case "multisig.v1.MultisigAccount.threshold":
+		x.Threshold = uint32(0)
+	case "multisig.v1.MultisigAccount.permission":
+		x.Permission = 0
+	case "multisig.v1.MultisigAccount.frozen":
+		x.Frozen = false
+	case "multisig.v1.MultisigAccount.threshold_percentage":
+		x.ThresholdPercentage = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MultisigAccount"))
 		}
-		panic(fmt.Errorf("message multisig.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.MultisigAccount does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -826,34 +1025,37 @@ func (x *fastReflection_Proposal) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Proposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MultisigAccount) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "multisig.v1.Proposal.id":
-		value := x.Id
-		return protoreflect.ValueOfUint64(value)
-	case "multisig.v1.Proposal.multisig_address":
-		value := x.MultisigAddress
-		return protoreflect.ValueOfBytes(value)
-	case "multisig.v1.Proposal.call_hash":
-		value := x.CallHash
+	case "multisig.v1.MultisigAccount.address":
+		value := x.Address
 		return protoreflect.ValueOfBytes(value)
-	case "multisig.v1.Proposal.depositor":
-		value := x.Depositor
+	case "multisig.v1.MultisigAccount.creator":
+		value := x.Creator
 		return protoreflect.ValueOfBytes(value)
-	case "multisig.v1.Proposal.deposit":
-		value := x.Deposit
-		return protoreflect.ValueOfUint64(value)
-	case "multisig.v1.Proposal.approvals":
-		if len(x.Approvals) == 0 {
-			return protoreflect.ValueOfList(&_Proposal_6_list{})
+	case "multisig.v1.MultisigAccount.signers":
+		if len(x.Signers) == 0 {
+			return protoreflect.ValueOfList(&_MultisigAccount_3_list{})
 		}
-		listValue := &_Proposal_6_list{list: &x.Approvals}
+		listValue := &_MultisigAccount_3_list{list: &x.Signers}
 		return protoreflect.ValueOfList(listValue)
+	case "multisig.v1.MultisigAccount.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfUint32(value)
+	case "multisig.v1.MultisigAccount.permission":
+		value := x.Permission
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "multisig.v1.MultisigAccount.frozen":
+		value := x.Frozen
+		return protoreflect.ValueOfBool(value)
+	case "multisig.v1.MultisigAccount.threshold_percentage":
+		value := x.ThresholdPercentage
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MultisigAccount"))
 		}
-		panic(fmt.Errorf("message multisig.v1.Proposal does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message multisig.v1.MultisigAccount does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -867,27 +1069,29 @@ func (x *fastReflection_Proposal) Get(descriptor protoreflect.FieldDescriptor) p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Proposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MultisigAccount) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "multisig.v1.Proposal.id":
-		x.Id = value.Uint()
-	case "multisig.v1.Proposal.multisig_address":
-		x.MultisigAddress = value.Bytes()
-	case "multisig.v1.Proposal.call_hash":
-		x.CallHash = value.Bytes()
-	case "multisig.v1.Proposal.depositor":
-		x.Depositor = value.Bytes()
-	case "multisig.v1.Proposal.deposit":
-		x.Deposit = value.Uint()
-	case "multisig.v1.Proposal.approvals":
+	case "multisig.v1.MultisigAccount.address":
+		x.Address = value.Bytes()
+	case "multisig.v1.MultisigAccount.creator":
+		x.Creator = value.Bytes()
+	case "multisig.v1.MultisigAccount.signers":
 		lv := value.List()
-		clv := lv.(*_Proposal_6_list)
-		x.Approvals = *clv.list
+		clv := lv.(*_MultisigAccount_3_list)
+		x.Signers = *clv.list
+	case "multisig.v1.MultisigAccount.threshold":
+		x.Threshold = uint32(value.Uint())
+	case "multisig.v1.MultisigAccount.permission":
+		x.Permission = (MultisigProposalType)(value.Enum())
+	case "multisig.v1.MultisigAccount.frozen":
+		x.Frozen = value.Bool()
+	case "multisig.v1.MultisigAccount.threshold_percentage":
+		x.ThresholdPercentage = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MultisigAccount"))
 		}
-		panic(fmt.Errorf("message multisig.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.MultisigAccount does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -901,65 +1105,69 @@ func (x *fastReflection_Proposal) Set(fd protoreflect.FieldDescriptor, value pro
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Proposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MultisigAccount) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "multisig.v1.Proposal.approvals":
-		if x.Approvals == nil {
-			x.Approvals = [][]byte{}
+	case "multisig.v1.MultisigAccount.signers":
+		if x.Signers == nil {
+			x.Signers = [][]byte{}
 		}
-		value := &_Proposal_6_list{list: &x.Approvals}
+		value := &_MultisigAccount_3_list{list: &x.Signers}
 		return protoreflect.ValueOfList(value)
-	case "multisig.v1.Proposal.id":
-		panic(fmt.Errorf("field id of message multisig.v1.Proposal is not mutable"))
-	case "multisig.v1.Proposal.multisig_address":
-		panic(fmt.Errorf("field multisig_address of message multisig.v1.Proposal is not mutable"))
-	case "multisig.v1.Proposal.call_hash":
-		panic(fmt.Errorf("field call_hash of message multisig.v1.Proposal is not mutable"))
-	case "multisig.v1.Proposal.depositor":
-		panic(fmt.Errorf("field depositor of message multisig.v1.Proposal is not mutable"))
-	case "multisig.v1.Proposal.deposit":
-		panic(fmt.Errorf("field deposit of message multisig.v1.Proposal is not mutable"))
+	case "multisig.v1.MultisigAccount.address":
+		panic(fmt.Errorf("field address of message multisig.v1.MultisigAccount is not mutable"))
+	case "multisig.v1.MultisigAccount.creator":
+		panic(fmt.Errorf("field creator of message multisig.v1.MultisigAccount is not mutable"))
+	case "multisig.v1.MultisigAccount.threshold":
+		panic(fmt.Errorf("field threshold of message multisig.v1.MultisigAccount is not mutable"))
+	case "multisig.v1.MultisigAccount.permission":
+		panic(fmt.Errorf("field permission of message multisig.v1.MultisigAccount is not mutable"))
+	case "multisig.v1.MultisigAccount.frozen":
+		panic(fmt.Errorf("field frozen of message multisig.v1.MultisigAccount is not mutable"))
+	case "multisig.v1.MultisigAccount.threshold_percentage":
+		panic(fmt.Errorf("field threshold_percentage of message multisig.v1.MultisigAccount is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MultisigAccount"))
 		}
-		panic(fmt.Errorf("message multisig.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.MultisigAccount does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Proposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MultisigAccount) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "multisig.v1.Proposal.id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "multisig.v1.Proposal.multisig_address":
+	case "multisig.v1.MultisigAccount.address":
 		return protoreflect.ValueOfBytes(nil)
-	case "multisig.v1.Proposal.call_hash":
-		return protoreflect.ValueOfBytes(nil)
-	case "multisig.v1.Proposal.depositor":
+	case "multisig.v1.MultisigAccount.creator":
 		return protoreflect.ValueOfBytes(nil)
-	case "multisig.v1.Proposal.deposit":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "multisig.v1.Proposal.approvals":
+	case "multisig.v1.MultisigAccount.signers":
 		list := [][]byte{}
-		return protoreflect.ValueOfList(&_Proposal_6_list{list: &list})
+		return protoreflect.ValueOfList(&_MultisigAccount_3_list{list: &list})
+	case "multisig.v1.MultisigAccount.threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "multisig.v1.MultisigAccount.permission":
+		return protoreflect.ValueOfEnum(0)
+	case "multisig.v1.MultisigAccount.frozen":
+		return protoreflect.ValueOfBool(false)
+	case "multisig.v1.MultisigAccount.threshold_percentage":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MultisigAccount"))
 		}
-		panic(fmt.Errorf("message multisig.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.MultisigAccount does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Proposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MultisigAccount) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.Proposal", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.MultisigAccount", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -967,7 +1175,7 @@ func (x *fastReflection_Proposal) WhichOneof(d protoreflect.OneofDescriptor) pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Proposal) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MultisigAccount) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -978,7 +1186,7 @@ func (x *fastReflection_Proposal) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Proposal) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MultisigAccount) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -990,7 +1198,7 @@ func (x *fastReflection_Proposal) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Proposal) IsValid() bool {
+func (x *fastReflection_MultisigAccount) IsValid() bool {
 	return x != nil
 }
 
@@ -1000,9 +1208,9 @@ func (x *fastReflection_Proposal) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MultisigAccount) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Proposal)
+		x := input.Message.Interface().(*MultisigAccount)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1014,30 +1222,33 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
-		}
-		l = len(x.MultisigAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.CallHash)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Depositor)
+		l = len(x.Creator)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Deposit != 0 {
-			n += 1 + runtime.Sov(uint64(x.Deposit))
-		}
-		if len(x.Approvals) > 0 {
-			for _, b := range x.Approvals {
+		if len(x.Signers) > 0 {
+			for _, b := range x.Signers {
 				l = len(b)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.Threshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.Threshold))
+		}
+		if x.Permission != 0 {
+			n += 1 + runtime.Sov(uint64(x.Permission))
+		}
+		if x.Frozen {
+			n += 2
+		}
+		l = len(x.ThresholdPercentage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1048,7 +1259,7 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Proposal)
+		x := input.Message.Interface().(*MultisigAccount)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1067,45 +1278,55 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Approvals) > 0 {
-			for iNdEx := len(x.Approvals) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Approvals[iNdEx])
-				copy(dAtA[i:], x.Approvals[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Approvals[iNdEx])))
-				i--
-				dAtA[i] = 0x32
+		if len(x.ThresholdPercentage) > 0 {
+			i -= len(x.ThresholdPercentage)
+			copy(dAtA[i:], x.ThresholdPercentage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThresholdPercentage)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Frozen {
+			i--
+			if x.Frozen {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
 			}
+			i--
+			dAtA[i] = 0x30
 		}
-		if x.Deposit != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Deposit))
+		if x.Permission != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Permission))
 			i--
 			dAtA[i] = 0x28
 		}
-		if len(x.Depositor) > 0 {
-			i -= len(x.Depositor)
-			copy(dAtA[i:], x.Depositor)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Depositor)))
+		if x.Threshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Threshold))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x20
 		}
-		if len(x.CallHash) > 0 {
-			i -= len(x.CallHash)
-			copy(dAtA[i:], x.CallHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CallHash)))
-			i--
-			dAtA[i] = 0x1a
+		if len(x.Signers) > 0 {
+			for iNdEx := len(x.Signers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Signers[iNdEx])
+				copy(dAtA[i:], x.Signers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signers[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
 		}
-		if len(x.MultisigAddress) > 0 {
-			i -= len(x.MultisigAddress)
-			copy(dAtA[i:], x.MultisigAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MultisigAddress)))
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1118,7 +1339,7 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Proposal)
+		x := input.Message.Interface().(*MultisigAccount)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1150,17 +1371,17 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultisigAccount: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MultisigAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
-				x.Id = 0
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1170,14 +1391,29 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = append(x.Address[:0], dAtA[iNdEx:postIndex]...)
+				if x.Address == nil {
+					x.Address = []byte{}
+				}
+				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -1204,14 +1440,14 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MultisigAddress = append(x.MultisigAddress[:0], dAtA[iNdEx:postIndex]...)
-				if x.MultisigAddress == nil {
-					x.MultisigAddress = []byte{}
+				x.Creator = append(x.Creator[:0], dAtA[iNdEx:postIndex]...)
+				if x.Creator == nil {
+					x.Creator = []byte{}
 				}
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CallHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -1238,16 +1474,14 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CallHash = append(x.CallHash[:0], dAtA[iNdEx:postIndex]...)
-				if x.CallHash == nil {
-					x.CallHash = []byte{}
-				}
+				x.Signers = append(x.Signers, make([]byte, postIndex-iNdEx))
+				copy(x.Signers[len(x.Signers)-1], dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 				}
-				var byteLen int
+				x.Threshold = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1257,31 +1491,16 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					x.Threshold |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Depositor = append(x.Depositor[:0], dAtA[iNdEx:postIndex]...)
-				if x.Depositor == nil {
-					x.Depositor = []byte{}
-				}
-				iNdEx = postIndex
 			case 5:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
 				}
-				x.Deposit = 0
+				x.Permission = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1291,16 +1510,36 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Deposit |= uint64(b&0x7F) << shift
+					x.Permission |= MultisigProposalType(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Frozen = bool(v != 0)
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThresholdPercentage", wireType)
 				}
-				var byteLen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1310,23 +1549,23 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Approvals = append(x.Approvals, make([]byte, postIndex-iNdEx))
-				copy(x.Approvals[len(x.Approvals)-1], dAtA[iNdEx:postIndex])
+				x.ThresholdPercentage = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1363,75 +1602,1695 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: multisig/v1/state.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// Types of multisig proposal permissions
-type MultisigProposalType int32
+var _ protoreflect.List = (*_Proposal_6_list)(nil)
 
-const (
-	// Allow ALL operations by default
-	MultisigProposalType_MULTISIG_PROPOSAL_TYPE_UNSPECIFIED MultisigProposalType = 0
-	// Allow only transfer operations
-	MultisigProposalType_MULTISIG_PROPOSAL_TYPE_TRANSFER_ONLY MultisigProposalType = 1
-	// Allow all operations other than transfers
-	MultisigProposalType_MULTISIG_PROPOSAL_TYPE_EXCEPT_TRANSFER MultisigProposalType = 2
-)
+type _Proposal_6_list struct {
+	list *[][]byte
+}
 
-// Enum value maps for MultisigProposalType.
-var (
-	MultisigProposalType_name = map[int32]string{
-		0: "MULTISIG_PROPOSAL_TYPE_UNSPECIFIED",
-		1: "MULTISIG_PROPOSAL_TYPE_TRANSFER_ONLY",
-		2: "MULTISIG_PROPOSAL_TYPE_EXCEPT_TRANSFER",
-	}
-	MultisigProposalType_value = map[string]int32{
-		"MULTISIG_PROPOSAL_TYPE_UNSPECIFIED":     0,
-		"MULTISIG_PROPOSAL_TYPE_TRANSFER_ONLY":   1,
-		"MULTISIG_PROPOSAL_TYPE_EXCEPT_TRANSFER": 2,
+func (x *_Proposal_6_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-)
+	return len(*x.list)
+}
 
-func (x MultisigProposalType) Enum() *MultisigProposalType {
-	p := new(MultisigProposalType)
-	*p = x
-	return p
+func (x *_Proposal_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
 }
 
-func (x MultisigProposalType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *_Proposal_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-func (MultisigProposalType) Descriptor() protoreflect.EnumDescriptor {
-	return file_multisig_v1_state_proto_enumTypes[0].Descriptor()
+func (x *_Proposal_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (MultisigProposalType) Type() protoreflect.EnumType {
-	return &file_multisig_v1_state_proto_enumTypes[0]
+func (x *_Proposal_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Proposal at list field Approvals as it is not of Message kind"))
 }
 
-func (x MultisigProposalType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *_Proposal_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
 }
 
-// Deprecated: Use MultisigProposalType.Descriptor instead.
-func (MultisigProposalType) EnumDescriptor() ([]byte, []int) {
-	return file_multisig_v1_state_proto_rawDescGZIP(), []int{0}
+func (x *_Proposal_6_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
 }
 
-// Details of a multisig account - using Collections approach
-type MultisigAccountDetails struct {
-	state         protoimpl.MessageState
+func (x *_Proposal_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Proposal_8_list)(nil)
+
+type _Proposal_8_list struct {
+	list *[][]byte
+}
+
+func (x *_Proposal_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Proposal_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_Proposal_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Proposal_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Proposal_8_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Proposal at list field Rejections as it is not of Message kind"))
+}
+
+func (x *_Proposal_8_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Proposal_8_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_Proposal_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Proposal                  protoreflect.MessageDescriptor
+	fd_Proposal_id               protoreflect.FieldDescriptor
+	fd_Proposal_multisig_address protoreflect.FieldDescriptor
+	fd_Proposal_call_hash        protoreflect.FieldDescriptor
+	fd_Proposal_depositor        protoreflect.FieldDescriptor
+	fd_Proposal_legacy_deposit   protoreflect.FieldDescriptor
+	fd_Proposal_approvals        protoreflect.FieldDescriptor
+	fd_Proposal_expiry_height    protoreflect.FieldDescriptor
+	fd_Proposal_rejections       protoreflect.FieldDescriptor
+	fd_Proposal_deposit          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_multisig_v1_state_proto_init()
+	md_Proposal = File_multisig_v1_state_proto.Messages().ByName("Proposal")
+	fd_Proposal_id = md_Proposal.Fields().ByName("id")
+	fd_Proposal_multisig_address = md_Proposal.Fields().ByName("multisig_address")
+	fd_Proposal_call_hash = md_Proposal.Fields().ByName("call_hash")
+	fd_Proposal_depositor = md_Proposal.Fields().ByName("depositor")
+	fd_Proposal_legacy_deposit = md_Proposal.Fields().ByName("legacy_deposit")
+	fd_Proposal_approvals = md_Proposal.Fields().ByName("approvals")
+	fd_Proposal_expiry_height = md_Proposal.Fields().ByName("expiry_height")
+	fd_Proposal_rejections = md_Proposal.Fields().ByName("rejections")
+	fd_Proposal_deposit = md_Proposal.Fields().ByName("deposit")
+}
+
+var _ protoreflect.Message = (*fastReflection_Proposal)(nil)
+
+type fastReflection_Proposal Proposal
+
+func (x *Proposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Proposal)(x)
+}
+
+func (x *Proposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_multisig_v1_state_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Proposal_messageType fastReflection_Proposal_messageType
+var _ protoreflect.MessageType = fastReflection_Proposal_messageType{}
+
+type fastReflection_Proposal_messageType struct{}
+
+func (x fastReflection_Proposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Proposal)(nil)
+}
+func (x fastReflection_Proposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_Proposal)
+}
+func (x fastReflection_Proposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Proposal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Proposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_Proposal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Proposal) Type() protoreflect.MessageType {
+	return _fastReflection_Proposal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Proposal) New() protoreflect.Message {
+	return new(fastReflection_Proposal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Proposal) Interface() protoreflect.ProtoMessage {
+	return (*Proposal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Proposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Proposal_id, value) {
+			return
+		}
+	}
+	if len(x.MultisigAddress) != 0 {
+		value := protoreflect.ValueOfBytes(x.MultisigAddress)
+		if !f(fd_Proposal_multisig_address, value) {
+			return
+		}
+	}
+	if len(x.CallHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.CallHash)
+		if !f(fd_Proposal_call_hash, value) {
+			return
+		}
+	}
+	if len(x.Depositor) != 0 {
+		value := protoreflect.ValueOfBytes(x.Depositor)
+		if !f(fd_Proposal_depositor, value) {
+			return
+		}
+	}
+	if x.LegacyDeposit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LegacyDeposit)
+		if !f(fd_Proposal_legacy_deposit, value) {
+			return
+		}
+	}
+	if len(x.Approvals) != 0 {
+		value := protoreflect.ValueOfList(&_Proposal_6_list{list: &x.Approvals})
+		if !f(fd_Proposal_approvals, value) {
+			return
+		}
+	}
+	if x.ExpiryHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiryHeight)
+		if !f(fd_Proposal_expiry_height, value) {
+			return
+		}
+	}
+	if len(x.Rejections) != 0 {
+		value := protoreflect.ValueOfList(&_Proposal_8_list{list: &x.Rejections})
+		if !f(fd_Proposal_rejections, value) {
+			return
+		}
+	}
+	if x.Deposit != nil {
+		value := protoreflect.ValueOfMessage(x.Deposit.ProtoReflect())
+		if !f(fd_Proposal_deposit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Proposal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "multisig.v1.Proposal.id":
+		return x.Id != uint64(0)
+	case "multisig.v1.Proposal.multisig_address":
+		return len(x.MultisigAddress) != 0
+	case "multisig.v1.Proposal.call_hash":
+		return len(x.CallHash) != 0
+	case "multisig.v1.Proposal.depositor":
+		return len(x.Depositor) != 0
+	case "multisig.v1.Proposal.legacy_deposit":
+		return x.LegacyDeposit != uint64(0)
+	case "multisig.v1.Proposal.approvals":
+		return len(x.Approvals) != 0
+	case "multisig.v1.Proposal.expiry_height":
+		return x.ExpiryHeight != int64(0)
+	case "multisig.v1.Proposal.rejections":
+		return len(x.Rejections) != 0
+	case "multisig.v1.Proposal.deposit":
+		return x.Deposit != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.Proposal"))
+		}
+		panic(fmt.Errorf("message multisig.v1.Proposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Proposal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "multisig.v1.Proposal.id":
+		x.Id = uint64(0)
+	case "multisig.v1.Proposal.multisig_address":
+		x.MultisigAddress = nil
+	case "multisig.v1.Proposal.call_hash":
+		x.CallHash = nil
+	case "multisig.v1.Proposal.depositor":
+		x.Depositor = nil
+	case "multisig.v1.Proposal.legacy_deposit":
+		x.LegacyDeposit = uint64(0)
+	case "multisig.v1.Proposal.approvals":
+		x.Approvals = nil
+	case "multisig.v1.Proposal.expiry_height":
+		x.ExpiryHeight = int64(0)
+	case "multisig.v1.Proposal.rejections":
+		x.Rejections = nil
+	case "multisig.v1.Proposal.deposit":
+		x.Deposit = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.Proposal"))
+		}
+		panic(fmt.Errorf("message multisig.v1.Proposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Proposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "multisig.v1.Proposal.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "multisig.v1.Proposal.multisig_address":
+		value := x.MultisigAddress
+		return protoreflect.ValueOfBytes(value)
+	case "multisig.v1.Proposal.call_hash":
+		value := x.CallHash
+		return protoreflect.ValueOfBytes(value)
+	case "multisig.v1.Proposal.depositor":
+		value := x.Depositor
+		return protoreflect.ValueOfBytes(value)
+	case "multisig.v1.Proposal.legacy_deposit":
+		value := x.LegacyDeposit
+		return protoreflect.ValueOfUint64(value)
+	case "multisig.v1.Proposal.approvals":
+		if len(x.Approvals) == 0 {
+			return protoreflect.ValueOfList(&_Proposal_6_list{})
+		}
+		listValue := &_Proposal_6_list{list: &x.Approvals}
+		return protoreflect.ValueOfList(listValue)
+	case "multisig.v1.Proposal.expiry_height":
+		value := x.ExpiryHeight
+		return protoreflect.ValueOfInt64(value)
+	case "multisig.v1.Proposal.rejections":
+		if len(x.Rejections) == 0 {
+			return protoreflect.ValueOfList(&_Proposal_8_list{})
+		}
+		listValue := &_Proposal_8_list{list: &x.Rejections}
+		return protoreflect.ValueOfList(listValue)
+	case "multisig.v1.Proposal.deposit":
+		value := x.Deposit
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.Proposal"))
+		}
+		panic(fmt.Errorf("message multisig.v1.Proposal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Proposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "multisig.v1.Proposal.id":
+		x.Id = value.Uint()
+	case "multisig.v1.Proposal.multisig_address":
+		x.MultisigAddress = value.Bytes()
+	case "multisig.v1.Proposal.call_hash":
+		x.CallHash = value.Bytes()
+	case "multisig.v1.Proposal.depositor":
+		x.Depositor = value.Bytes()
+	case "multisig.v1.Proposal.legacy_deposit":
+		x.LegacyDeposit = value.Uint()
+	case "multisig.v1.Proposal.approvals":
+		lv := value.List()
+		clv := lv.(*_Proposal_6_list)
+		x.Approvals = *clv.list
+	case "multisig.v1.Proposal.expiry_height":
+		x.ExpiryHeight = value.Int()
+	case "multisig.v1.Proposal.rejections":
+		lv := value.List()
+		clv := lv.(*_Proposal_8_list)
+		x.Rejections = *clv.list
+	case "multisig.v1.Proposal.deposit":
+		x.Deposit = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.Proposal"))
+		}
+		panic(fmt.Errorf("message multisig.v1.Proposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Proposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.Proposal.approvals":
+		if x.Approvals == nil {
+			x.Approvals = [][]byte{}
+		}
+		value := &_Proposal_6_list{list: &x.Approvals}
+		return protoreflect.ValueOfList(value)
+	case "multisig.v1.Proposal.rejections":
+		if x.Rejections == nil {
+			x.Rejections = [][]byte{}
+		}
+		value := &_Proposal_8_list{list: &x.Rejections}
+		return protoreflect.ValueOfList(value)
+	case "multisig.v1.Proposal.deposit":
+		if x.Deposit == nil {
+			x.Deposit = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Deposit.ProtoReflect())
+	case "multisig.v1.Proposal.id":
+		panic(fmt.Errorf("field id of message multisig.v1.Proposal is not mutable"))
+	case "multisig.v1.Proposal.multisig_address":
+		panic(fmt.Errorf("field multisig_address of message multisig.v1.Proposal is not mutable"))
+	case "multisig.v1.Proposal.call_hash":
+		panic(fmt.Errorf("field call_hash of message multisig.v1.Proposal is not mutable"))
+	case "multisig.v1.Proposal.depositor":
+		panic(fmt.Errorf("field depositor of message multisig.v1.Proposal is not mutable"))
+	case "multisig.v1.Proposal.legacy_deposit":
+		panic(fmt.Errorf("field legacy_deposit of message multisig.v1.Proposal is not mutable"))
+	case "multisig.v1.Proposal.expiry_height":
+		panic(fmt.Errorf("field expiry_height of message multisig.v1.Proposal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.Proposal"))
+		}
+		panic(fmt.Errorf("message multisig.v1.Proposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Proposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.Proposal.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "multisig.v1.Proposal.multisig_address":
+		return protoreflect.ValueOfBytes(nil)
+	case "multisig.v1.Proposal.call_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "multisig.v1.Proposal.depositor":
+		return protoreflect.ValueOfBytes(nil)
+	case "multisig.v1.Proposal.legacy_deposit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "multisig.v1.Proposal.approvals":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_Proposal_6_list{list: &list})
+	case "multisig.v1.Proposal.expiry_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "multisig.v1.Proposal.rejections":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_Proposal_8_list{list: &list})
+	case "multisig.v1.Proposal.deposit":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.Proposal"))
+		}
+		panic(fmt.Errorf("message multisig.v1.Proposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Proposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.Proposal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Proposal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Proposal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Proposal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Proposal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.MultisigAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CallHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Depositor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LegacyDeposit != 0 {
+			n += 1 + runtime.Sov(uint64(x.LegacyDeposit))
+		}
+		if len(x.Approvals) > 0 {
+			for _, b := range x.Approvals {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ExpiryHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiryHeight))
+		}
+		if len(x.Rejections) > 0 {
+			for _, b := range x.Rejections {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Deposit != nil {
+			l = options.Size(x.Deposit)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Proposal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Deposit != nil {
+			encoded, err := options.Marshal(x.Deposit)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.Rejections) > 0 {
+			for iNdEx := len(x.Rejections) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Rejections[iNdEx])
+				copy(dAtA[i:], x.Rejections[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Rejections[iNdEx])))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.ExpiryHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiryHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Approvals) > 0 {
+			for iNdEx := len(x.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Approvals[iNdEx])
+				copy(dAtA[i:], x.Approvals[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Approvals[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.LegacyDeposit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LegacyDeposit))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Depositor) > 0 {
+			i -= len(x.Depositor)
+			copy(dAtA[i:], x.Depositor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Depositor)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.CallHash) > 0 {
+			i -= len(x.CallHash)
+			copy(dAtA[i:], x.CallHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CallHash)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.MultisigAddress) > 0 {
+			i -= len(x.MultisigAddress)
+			copy(dAtA[i:], x.MultisigAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MultisigAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Proposal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MultisigAddress = append(x.MultisigAddress[:0], dAtA[iNdEx:postIndex]...)
+				if x.MultisigAddress == nil {
+					x.MultisigAddress = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CallHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CallHash = append(x.CallHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.CallHash == nil {
+					x.CallHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Depositor = append(x.Depositor[:0], dAtA[iNdEx:postIndex]...)
+				if x.Depositor == nil {
+					x.Depositor = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LegacyDeposit", wireType)
+				}
+				x.LegacyDeposit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LegacyDeposit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Approvals = append(x.Approvals, make([]byte, postIndex-iNdEx))
+				copy(x.Approvals[len(x.Approvals)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+				}
+				x.ExpiryHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiryHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rejections", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Rejections = append(x.Rejections, make([]byte, postIndex-iNdEx))
+				copy(x.Rejections[len(x.Rejections)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Deposit == nil {
+					x.Deposit = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Deposit); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_FeeBudgetSpend_2_list)(nil)
+
+type _FeeBudgetSpend_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FeeBudgetSpend_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeBudgetSpend_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeBudgetSpend_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeBudgetSpend_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeBudgetSpend_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeBudgetSpend_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeBudgetSpend_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeBudgetSpend_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_FeeBudgetSpend        protoreflect.MessageDescriptor
+	fd_FeeBudgetSpend_period protoreflect.FieldDescriptor
+	fd_FeeBudgetSpend_spent  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_multisig_v1_state_proto_init()
+	md_FeeBudgetSpend = File_multisig_v1_state_proto.Messages().ByName("FeeBudgetSpend")
+	fd_FeeBudgetSpend_period = md_FeeBudgetSpend.Fields().ByName("period")
+	fd_FeeBudgetSpend_spent = md_FeeBudgetSpend.Fields().ByName("spent")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeBudgetSpend)(nil)
+
+type fastReflection_FeeBudgetSpend FeeBudgetSpend
+
+func (x *FeeBudgetSpend) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeBudgetSpend)(x)
+}
+
+func (x *FeeBudgetSpend) slowProtoReflect() protoreflect.Message {
+	mi := &file_multisig_v1_state_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeBudgetSpend_messageType fastReflection_FeeBudgetSpend_messageType
+var _ protoreflect.MessageType = fastReflection_FeeBudgetSpend_messageType{}
+
+type fastReflection_FeeBudgetSpend_messageType struct{}
+
+func (x fastReflection_FeeBudgetSpend_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeBudgetSpend)(nil)
+}
+func (x fastReflection_FeeBudgetSpend_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeBudgetSpend)
+}
+func (x fastReflection_FeeBudgetSpend_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeBudgetSpend
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeBudgetSpend) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeBudgetSpend
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeBudgetSpend) Type() protoreflect.MessageType {
+	return _fastReflection_FeeBudgetSpend_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeBudgetSpend) New() protoreflect.Message {
+	return new(fastReflection_FeeBudgetSpend)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeBudgetSpend) Interface() protoreflect.ProtoMessage {
+	return (*FeeBudgetSpend)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeBudgetSpend) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Period != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Period)
+		if !f(fd_FeeBudgetSpend_period, value) {
+			return
+		}
+	}
+	if len(x.Spent) != 0 {
+		value := protoreflect.ValueOfList(&_FeeBudgetSpend_2_list{list: &x.Spent})
+		if !f(fd_FeeBudgetSpend_spent, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeBudgetSpend) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "multisig.v1.FeeBudgetSpend.period":
+		return x.Period != uint64(0)
+	case "multisig.v1.FeeBudgetSpend.spent":
+		return len(x.Spent) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.FeeBudgetSpend"))
+		}
+		panic(fmt.Errorf("message multisig.v1.FeeBudgetSpend does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeBudgetSpend) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "multisig.v1.FeeBudgetSpend.period":
+		x.Period = uint64(0)
+	case "multisig.v1.FeeBudgetSpend.spent":
+		x.Spent = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.FeeBudgetSpend"))
+		}
+		panic(fmt.Errorf("message multisig.v1.FeeBudgetSpend does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeBudgetSpend) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "multisig.v1.FeeBudgetSpend.period":
+		value := x.Period
+		return protoreflect.ValueOfUint64(value)
+	case "multisig.v1.FeeBudgetSpend.spent":
+		if len(x.Spent) == 0 {
+			return protoreflect.ValueOfList(&_FeeBudgetSpend_2_list{})
+		}
+		listValue := &_FeeBudgetSpend_2_list{list: &x.Spent}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.FeeBudgetSpend"))
+		}
+		panic(fmt.Errorf("message multisig.v1.FeeBudgetSpend does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeBudgetSpend) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "multisig.v1.FeeBudgetSpend.period":
+		x.Period = value.Uint()
+	case "multisig.v1.FeeBudgetSpend.spent":
+		lv := value.List()
+		clv := lv.(*_FeeBudgetSpend_2_list)
+		x.Spent = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.FeeBudgetSpend"))
+		}
+		panic(fmt.Errorf("message multisig.v1.FeeBudgetSpend does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeBudgetSpend) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.FeeBudgetSpend.spent":
+		if x.Spent == nil {
+			x.Spent = []*v1beta1.Coin{}
+		}
+		value := &_FeeBudgetSpend_2_list{list: &x.Spent}
+		return protoreflect.ValueOfList(value)
+	case "multisig.v1.FeeBudgetSpend.period":
+		panic(fmt.Errorf("field period of message multisig.v1.FeeBudgetSpend is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.FeeBudgetSpend"))
+		}
+		panic(fmt.Errorf("message multisig.v1.FeeBudgetSpend does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeBudgetSpend) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.FeeBudgetSpend.period":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "multisig.v1.FeeBudgetSpend.spent":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeeBudgetSpend_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.FeeBudgetSpend"))
+		}
+		panic(fmt.Errorf("message multisig.v1.FeeBudgetSpend does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeBudgetSpend) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.FeeBudgetSpend", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeBudgetSpend) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeBudgetSpend) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeBudgetSpend) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeBudgetSpend) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeBudgetSpend)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Period != 0 {
+			n += 1 + runtime.Sov(uint64(x.Period))
+		}
+		if len(x.Spent) > 0 {
+			for _, e := range x.Spent {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeBudgetSpend)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Spent) > 0 {
+			for iNdEx := len(x.Spent) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Spent[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Period != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Period))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeBudgetSpend)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeBudgetSpend: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeBudgetSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				}
+				x.Period = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Period |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Spent = append(x.Spent, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Spent[len(x.Spent)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: multisig/v1/state.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Types of multisig proposal permissions
+type MultisigProposalType int32
+
+const (
+	// Allow ALL operations by default
+	MultisigProposalType_MULTISIG_PROPOSAL_TYPE_UNSPECIFIED MultisigProposalType = 0
+	// Allow only transfer operations
+	MultisigProposalType_MULTISIG_PROPOSAL_TYPE_TRANSFER_ONLY MultisigProposalType = 1
+	// Allow all operations other than transfers
+	MultisigProposalType_MULTISIG_PROPOSAL_TYPE_EXCEPT_TRANSFER MultisigProposalType = 2
+)
+
+// Enum value maps for MultisigProposalType.
+var (
+	MultisigProposalType_name = map[int32]string{
+		0: "MULTISIG_PROPOSAL_TYPE_UNSPECIFIED",
+		1: "MULTISIG_PROPOSAL_TYPE_TRANSFER_ONLY",
+		2: "MULTISIG_PROPOSAL_TYPE_EXCEPT_TRANSFER",
+	}
+	MultisigProposalType_value = map[string]int32{
+		"MULTISIG_PROPOSAL_TYPE_UNSPECIFIED":     0,
+		"MULTISIG_PROPOSAL_TYPE_TRANSFER_ONLY":   1,
+		"MULTISIG_PROPOSAL_TYPE_EXCEPT_TRANSFER": 2,
+	}
+)
+
+func (x MultisigProposalType) Enum() *MultisigProposalType {
+	p := new(MultisigProposalType)
+	*p = x
+	return p
+}
+
+func (x MultisigProposalType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MultisigProposalType) Descriptor() protoreflect.EnumDescriptor {
+	return file_multisig_v1_state_proto_enumTypes[0].Descriptor()
+}
+
+func (MultisigProposalType) Type() protoreflect.EnumType {
+	return &file_multisig_v1_state_proto_enumTypes[0]
+}
+
+func (x MultisigProposalType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MultisigProposalType.Descriptor instead.
+func (MultisigProposalType) EnumDescriptor() ([]byte, []int) {
+	return file_multisig_v1_state_proto_rawDescGZIP(), []int{0}
+}
+
+// Where the deposits of rejected and expired proposals go
+type DepositForfeitDestination int32
+
+const (
+	// Invalid destination
+	DepositForfeitDestination_DEPOSIT_FORFEIT_DESTINATION_UNSPECIFIED DepositForfeitDestination = 0
+	// Burn forfeited deposits
+	DepositForfeitDestination_DEPOSIT_FORFEIT_DESTINATION_BURN DepositForfeitDestination = 1
+	// Send forfeited deposits to the community pool
+	DepositForfeitDestination_DEPOSIT_FORFEIT_DESTINATION_COMMUNITY_POOL DepositForfeitDestination = 2
+)
+
+// Enum value maps for DepositForfeitDestination.
+var (
+	DepositForfeitDestination_name = map[int32]string{
+		0: "DEPOSIT_FORFEIT_DESTINATION_UNSPECIFIED",
+		1: "DEPOSIT_FORFEIT_DESTINATION_BURN",
+		2: "DEPOSIT_FORFEIT_DESTINATION_COMMUNITY_POOL",
+	}
+	DepositForfeitDestination_value = map[string]int32{
+		"DEPOSIT_FORFEIT_DESTINATION_UNSPECIFIED":    0,
+		"DEPOSIT_FORFEIT_DESTINATION_BURN":           1,
+		"DEPOSIT_FORFEIT_DESTINATION_COMMUNITY_POOL": 2,
+	}
+)
+
+func (x DepositForfeitDestination) Enum() *DepositForfeitDestination {
+	p := new(DepositForfeitDestination)
+	*p = x
+	return p
+}
+
+func (x DepositForfeitDestination) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DepositForfeitDestination) Descriptor() protoreflect.EnumDescriptor {
+	return file_multisig_v1_state_proto_enumTypes[1].Descriptor()
+}
+
+func (DepositForfeitDestination) Type() protoreflect.EnumType {
+	return &file_multisig_v1_state_proto_enumTypes[1]
+}
+
+func (x DepositForfeitDestination) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DepositForfeitDestination.Descriptor instead.
+func (DepositForfeitDestination) EnumDescriptor() ([]byte, []int) {
+	return file_multisig_v1_state_proto_rawDescGZIP(), []int{1}
+}
+
+// Details of a multisig account
+type MultisigAccountDetails struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
@@ -1441,6 +3300,14 @@ type MultisigAccountDetails struct {
 	Threshold uint32 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
 	// Permission type for this multisig account
 	Permission MultisigProposalType `protobuf:"varint,3,opt,name=permission,proto3,enum=multisig.v1.MultisigProposalType" json:"permission,omitempty"`
+	// Frozen accounts can not open, approve or dispatch proposals until recovered by governance.
+	Frozen bool `protobuf:"varint,4,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// The account which created the multisig account, empty for accounts created before the
+	// accounts were moved to the account table.
+	Creator []byte `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	// The fraction of the signers required to approve a call, e.g. "0.5", evaluated at approval
+	// time. When set it replaces the absolute threshold.
+	ThresholdPercentage string `protobuf:"bytes,6,opt,name=threshold_percentage,json=thresholdPercentage,proto3" json:"threshold_percentage,omitempty"`
 }
 
 func (x *MultisigAccountDetails) Reset() {
@@ -1484,6 +3351,119 @@ func (x *MultisigAccountDetails) GetPermission() MultisigProposalType {
 	return MultisigProposalType_MULTISIG_PROPOSAL_TYPE_UNSPECIFIED
 }
 
+func (x *MultisigAccountDetails) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+func (x *MultisigAccountDetails) GetCreator() []byte {
+	if x != nil {
+		return x.Creator
+	}
+	return nil
+}
+
+func (x *MultisigAccountDetails) GetThresholdPercentage() string {
+	if x != nil {
+		return x.ThresholdPercentage
+	}
+	return ""
+}
+
+// A multisig account. The primary key is the address derived from the seed the account
+// was created with, so that a seed creates at most one account.
+type MultisigAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The address of the multisig account
+	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The account which created the multisig account
+	Creator []byte `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// List of signers for this multisig account
+	Signers [][]byte `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+	// The threshold of approvers required for the multisig account to be able to execute a call.
+	Threshold uint32 `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Permission type for this multisig account
+	Permission MultisigProposalType `protobuf:"varint,5,opt,name=permission,proto3,enum=multisig.v1.MultisigProposalType" json:"permission,omitempty"`
+	// Frozen accounts can not open, approve or dispatch proposals until recovered by governance.
+	Frozen bool `protobuf:"varint,6,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// The fraction of the signers required to approve a call, replacing threshold when set.
+	ThresholdPercentage string `protobuf:"bytes,7,opt,name=threshold_percentage,json=thresholdPercentage,proto3" json:"threshold_percentage,omitempty"`
+}
+
+func (x *MultisigAccount) Reset() {
+	*x = MultisigAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_v1_state_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultisigAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultisigAccount) ProtoMessage() {}
+
+// Deprecated: Use MultisigAccount.ProtoReflect.Descriptor instead.
+func (*MultisigAccount) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_state_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MultisigAccount) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *MultisigAccount) GetCreator() []byte {
+	if x != nil {
+		return x.Creator
+	}
+	return nil
+}
+
+func (x *MultisigAccount) GetSigners() [][]byte {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
+}
+
+func (x *MultisigAccount) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *MultisigAccount) GetPermission() MultisigProposalType {
+	if x != nil {
+		return x.Permission
+	}
+	return MultisigProposalType_MULTISIG_PROPOSAL_TYPE_UNSPECIFIED
+}
+
+func (x *MultisigAccount) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+func (x *MultisigAccount) GetThresholdPercentage() string {
+	if x != nil {
+		return x.ThresholdPercentage
+	}
+	return ""
+}
+
 // An open multisig operation.
 type Proposal struct {
 	state         protoimpl.MessageState
@@ -1498,16 +3478,27 @@ type Proposal struct {
 	CallHash []byte `protobuf:"bytes,3,opt,name=call_hash,json=callHash,proto3" json:"call_hash,omitempty"`
 	// The account who opened it (i.e. the first to approve it).
 	Depositor []byte `protobuf:"bytes,4,opt,name=depositor,proto3" json:"depositor,omitempty"`
-	// The amount held in reserve of the `depositor`, to be returned once the operation ends.
-	Deposit uint64 `protobuf:"varint,5,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	// The amount of uom held in reserve of the `depositor` by proposals opened before consensus
+	// version 2. It is moved to `deposit` by the v1 to v2 migration.
+	//
+	// Deprecated: Do not use.
+	LegacyDeposit uint64 `protobuf:"varint,5,opt,name=legacy_deposit,json=legacyDeposit,proto3" json:"legacy_deposit,omitempty"`
 	// The approvals achieved so far, including the depositor.
 	Approvals [][]byte `protobuf:"bytes,6,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// The height at the end of which the proposal expires and its deposit is forfeited, zero
+	// when the proposal does not expire.
+	ExpiryHeight int64 `protobuf:"varint,7,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// The signers who voted against the proposal. The proposal is rejected and its deposit
+	// forfeited once the rejections reach the threshold of the multisig account.
+	Rejections [][]byte `protobuf:"bytes,8,rep,name=rejections,proto3" json:"rejections,omitempty"`
+	// The deposit held in reserve of the `depositor`, to be returned once the operation ends.
+	Deposit *v1beta1.Coin `protobuf:"bytes,9,opt,name=deposit,proto3" json:"deposit,omitempty"`
 }
 
 func (x *Proposal) Reset() {
 	*x = Proposal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_v1_state_proto_msgTypes[1]
+		mi := &file_multisig_v1_state_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1521,7 +3512,7 @@ func (*Proposal) ProtoMessage() {}
 
 // Deprecated: Use Proposal.ProtoReflect.Descriptor instead.
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return file_multisig_v1_state_proto_rawDescGZIP(), []int{1}
+	return file_multisig_v1_state_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Proposal) GetId() uint64 {
@@ -1552,9 +3543,10 @@ func (x *Proposal) GetDepositor() []byte {
 	return nil
 }
 
-func (x *Proposal) GetDeposit() uint64 {
+// Deprecated: Do not use.
+func (x *Proposal) GetLegacyDeposit() uint64 {
 	if x != nil {
-		return x.Deposit
+		return x.LegacyDeposit
 	}
 	return 0
 }
@@ -1566,59 +3558,188 @@ func (x *Proposal) GetApprovals() [][]byte {
 	return nil
 }
 
+func (x *Proposal) GetExpiryHeight() int64 {
+	if x != nil {
+		return x.ExpiryHeight
+	}
+	return 0
+}
+
+func (x *Proposal) GetRejections() [][]byte {
+	if x != nil {
+		return x.Rejections
+	}
+	return nil
+}
+
+func (x *Proposal) GetDeposit() *v1beta1.Coin {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+// The fees paid by a multisig account for the txs of its signers in a budget period.
+type FeeBudgetSpend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The budget period, i.e. the block height divided by the fee budget period
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// The fees paid in the period
+	Spent []*v1beta1.Coin `protobuf:"bytes,2,rep,name=spent,proto3" json:"spent,omitempty"`
+}
+
+func (x *FeeBudgetSpend) Reset() {
+	*x = FeeBudgetSpend{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_v1_state_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeBudgetSpend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeBudgetSpend) ProtoMessage() {}
+
+// Deprecated: Use FeeBudgetSpend.ProtoReflect.Descriptor instead.
+func (*FeeBudgetSpend) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_state_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FeeBudgetSpend) GetPeriod() uint64 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *FeeBudgetSpend) GetSpent() []*v1beta1.Coin {
+	if x != nil {
+		return x.Spent
+	}
+	return nil
+}
+
 var File_multisig_v1_state_proto protoreflect.FileDescriptor
 
 var file_multisig_v1_state_proto_rawDesc = []byte{
 	0x0a, 0x17, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74,
 	0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x6d, 0x75, 0x6c, 0x74, 0x69,
 	0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6f,
-	0x72, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x93, 0x01, 0x0a, 0x16, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69,
-	0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x69, 0x67,
-	0x6e, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
-	0x6c, 0x64, 0x12, 0x41, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
-	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xfb, 0x01, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x6d, 0x75,
-	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a,
-	0x09, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73,
-	0x3a, 0x41, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x3b, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01,
-	0x12, 0x20, 0x0a, 0x1a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x2c, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x10, 0x01,
-	0x18, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x10,
-	0x02, 0x18, 0x01, 0x2a, 0x94, 0x01, 0x0a, 0x14, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
-	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x22,
-	0x4d, 0x55, 0x4c, 0x54, 0x49, 0x53, 0x49, 0x47, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41,
-	0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x53, 0x49, 0x47,
-	0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54,
-	0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x2a,
-	0x0a, 0x26, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x53, 0x49, 0x47, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f,
-	0x53, 0x41, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x50, 0x54, 0x5f,
-	0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x10, 0x02, 0x42, 0xa5, 0x01, 0x0a, 0x0f, 0x63,
-	0x6f, 0x6d, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0a,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x61, 0x65, 0x76, 0x4d, 0x69, 0x74,
-	0x68, 0x72, 0x61, 0x6e, 0x2f, 0x64, 0x6d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x75, 0x6c,
-	0x74, 0x69, 0x73, 0x69, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x58, 0x58, 0xaa, 0x02, 0x0b,
-	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0b, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x17, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x73, 0x69, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x88, 0x02, 0x0a, 0x16, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x41, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73,
+	0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x41, 0x0a, 0x14, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x13, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0xc9, 0x02, 0x0a, 0x0f,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x41, 0x0a, 0x0a, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x21, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x12, 0x41, 0x0a, 0x14, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x13, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x3a, 0x2c, 0xf2, 0x9e, 0xd3, 0x8e, 0x03,
+	0x26, 0x0a, 0x09, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0b, 0x0a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x66, 0x72, 0x6f,
+	0x7a, 0x65, 0x6e, 0x10, 0x02, 0x18, 0x02, 0x22, 0x9f, 0x03, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x0e, 0x6c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x3a, 0x54, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x4e, 0x0a, 0x06, 0x0a, 0x02, 0x69,
+	0x64, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2c, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x10, 0x01, 0x18, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x10, 0x03, 0x18, 0x01, 0x22, 0x8b, 0x01, 0x0a, 0x0e, 0x46, 0x65,
+	0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x61, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30,
+	0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73,
+	0x52, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x2a, 0x94, 0x01, 0x0a, 0x14, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x26, 0x0a, 0x22, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x53, 0x49, 0x47, 0x5f, 0x50, 0x52, 0x4f,
+	0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x4d, 0x55, 0x4c, 0x54,
+	0x49, 0x53, 0x49, 0x47, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x4f, 0x4e, 0x4c, 0x59,
+	0x10, 0x01, 0x12, 0x2a, 0x0a, 0x26, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x53, 0x49, 0x47, 0x5f, 0x50,
+	0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x58, 0x43,
+	0x45, 0x50, 0x54, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x10, 0x02, 0x2a, 0x9e,
+	0x01, 0x0a, 0x19, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69,
+	0x74, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x27,
+	0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x46, 0x45, 0x49, 0x54, 0x5f,
+	0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x44, 0x45, 0x50,
+	0x4f, 0x53, 0x49, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x46, 0x45, 0x49, 0x54, 0x5f, 0x44, 0x45, 0x53,
+	0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x52, 0x4e, 0x10, 0x01, 0x12,
+	0x2e, 0x0a, 0x2a, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x46, 0x45,
+	0x49, 0x54, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43,
+	0x4f, 0x4d, 0x4d, 0x55, 0x4e, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x4f, 0x4f, 0x4c, 0x10, 0x02, 0x42,
+	0xa5, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
+	0x2e, 0x76, 0x31, 0x42, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x61,
+	0x65, 0x76, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x61, 0x6e, 0x2f, 0x64, 0x6d, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f, 0x76,
+	0x31, 0x3b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d,
+	0x58, 0x58, 0xaa, 0x02, 0x0b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x0b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x17, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x73, 0x69, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1633,20 +3754,27 @@ func file_multisig_v1_state_proto_rawDescGZIP() []byte {
 	return file_multisig_v1_state_proto_rawDescData
 }
 
-var file_multisig_v1_state_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_multisig_v1_state_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_multisig_v1_state_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_multisig_v1_state_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_multisig_v1_state_proto_goTypes = []interface{}{
 	(MultisigProposalType)(0),      // 0: multisig.v1.MultisigProposalType
-	(*MultisigAccountDetails)(nil), // 1: multisig.v1.MultisigAccountDetails
-	(*Proposal)(nil),               // 2: multisig.v1.Proposal
+	(DepositForfeitDestination)(0), // 1: multisig.v1.DepositForfeitDestination
+	(*MultisigAccountDetails)(nil), // 2: multisig.v1.MultisigAccountDetails
+	(*MultisigAccount)(nil),        // 3: multisig.v1.MultisigAccount
+	(*Proposal)(nil),               // 4: multisig.v1.Proposal
+	(*FeeBudgetSpend)(nil),         // 5: multisig.v1.FeeBudgetSpend
+	(*v1beta1.Coin)(nil),           // 6: cosmos.base.v1beta1.Coin
 }
 var file_multisig_v1_state_proto_depIdxs = []int32{
 	0, // 0: multisig.v1.MultisigAccountDetails.permission:type_name -> multisig.v1.MultisigProposalType
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0, // 1: multisig.v1.MultisigAccount.permission:type_name -> multisig.v1.MultisigProposalType
+	6, // 2: multisig.v1.Proposal.deposit:type_name -> cosmos.base.v1beta1.Coin
+	6, // 3: multisig.v1.FeeBudgetSpend.spent:type_name -> cosmos.base.v1beta1.Coin
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_multisig_v1_state_proto_init() }
@@ -1668,6 +3796,18 @@ func file_multisig_v1_state_proto_init() {
 			}
 		}
 		file_multisig_v1_state_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultisigAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_multisig_v1_state_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Proposal); i {
 			case 0:
 				return &v.state
@@ -1679,14 +3819,26 @@ func file_multisig_v1_state_proto_init() {
 				return nil
 			}
 		}
+		file_multisig_v1_state_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeBudgetSpend); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_multisig_v1_state_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   2,
+			NumEnums:      2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -2,6 +2,7 @@
 package multisigv1
 
 import (
+	_ "cosmossdk.io/api/amino"
 	_ "cosmossdk.io/api/cosmos/msg/v1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
@@ -11,6 +12,7 @@ import (
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	anypb "google.golang.org/protobuf/types/known/anypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -918,12 +920,13 @@ func (x *_MsgCreateMultisigAccountParams_4_list) IsValid() bool {
 }
 
 var (
-	md_MsgCreateMultisigAccountParams            protoreflect.MessageDescriptor
-	fd_MsgCreateMultisigAccountParams_authority  protoreflect.FieldDescriptor
-	fd_MsgCreateMultisigAccountParams_seed       protoreflect.FieldDescriptor
-	fd_MsgCreateMultisigAccountParams_threshold  protoreflect.FieldDescriptor
-	fd_MsgCreateMultisigAccountParams_signers    protoreflect.FieldDescriptor
-	fd_MsgCreateMultisigAccountParams_permission protoreflect.FieldDescriptor
+	md_MsgCreateMultisigAccountParams                      protoreflect.MessageDescriptor
+	fd_MsgCreateMultisigAccountParams_authority            protoreflect.FieldDescriptor
+	fd_MsgCreateMultisigAccountParams_seed                 protoreflect.FieldDescriptor
+	fd_MsgCreateMultisigAccountParams_threshold            protoreflect.FieldDescriptor
+	fd_MsgCreateMultisigAccountParams_signers              protoreflect.FieldDescriptor
+	fd_MsgCreateMultisigAccountParams_permission           protoreflect.FieldDescriptor
+	fd_MsgCreateMultisigAccountParams_threshold_percentage protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -934,6 +937,7 @@ func init() {
 	fd_MsgCreateMultisigAccountParams_threshold = md_MsgCreateMultisigAccountParams.Fields().ByName("threshold")
 	fd_MsgCreateMultisigAccountParams_signers = md_MsgCreateMultisigAccountParams.Fields().ByName("signers")
 	fd_MsgCreateMultisigAccountParams_permission = md_MsgCreateMultisigAccountParams.Fields().ByName("permission")
+	fd_MsgCreateMultisigAccountParams_threshold_percentage = md_MsgCreateMultisigAccountParams.Fields().ByName("threshold_percentage")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgCreateMultisigAccountParams)(nil)
@@ -1031,6 +1035,12 @@ func (x *fastReflection_MsgCreateMultisigAccountParams) Range(f func(protoreflec
 			return
 		}
 	}
+	if x.ThresholdPercentage != "" {
+		value := protoreflect.ValueOfString(x.ThresholdPercentage)
+		if !f(fd_MsgCreateMultisigAccountParams_threshold_percentage, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1056,6 +1066,8 @@ func (x *fastReflection_MsgCreateMultisigAccountParams) Has(fd protoreflect.Fiel
 		return len(x.Signers) != 0
 	case "multisig.v1.MsgCreateMultisigAccountParams.permission":
 		return x.Permission != 0
+	case "multisig.v1.MsgCreateMultisigAccountParams.threshold_percentage":
+		return x.ThresholdPercentage != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCreateMultisigAccountParams"))
@@ -1082,6 +1094,8 @@ func (x *fastReflection_MsgCreateMultisigAccountParams) Clear(fd protoreflect.Fi
 		x.Signers = nil
 	case "multisig.v1.MsgCreateMultisigAccountParams.permission":
 		x.Permission = 0
+	case "multisig.v1.MsgCreateMultisigAccountParams.threshold_percentage":
+		x.ThresholdPercentage = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCreateMultisigAccountParams"))
@@ -1116,6 +1130,9 @@ func (x *fastReflection_MsgCreateMultisigAccountParams) Get(descriptor protorefl
 	case "multisig.v1.MsgCreateMultisigAccountParams.permission":
 		value := x.Permission
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "multisig.v1.MsgCreateMultisigAccountParams.threshold_percentage":
+		value := x.ThresholdPercentage
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCreateMultisigAccountParams"))
@@ -1148,6 +1165,8 @@ func (x *fastReflection_MsgCreateMultisigAccountParams) Set(fd protoreflect.Fiel
 		x.Signers = *clv.list
 	case "multisig.v1.MsgCreateMultisigAccountParams.permission":
 		x.Permission = (MultisigProposalType)(value.Enum())
+	case "multisig.v1.MsgCreateMultisigAccountParams.threshold_percentage":
+		x.ThresholdPercentage = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCreateMultisigAccountParams"))
@@ -1182,6 +1201,8 @@ func (x *fastReflection_MsgCreateMultisigAccountParams) Mutable(fd protoreflect.
 		panic(fmt.Errorf("field threshold of message multisig.v1.MsgCreateMultisigAccountParams is not mutable"))
 	case "multisig.v1.MsgCreateMultisigAccountParams.permission":
 		panic(fmt.Errorf("field permission of message multisig.v1.MsgCreateMultisigAccountParams is not mutable"))
+	case "multisig.v1.MsgCreateMultisigAccountParams.threshold_percentage":
+		panic(fmt.Errorf("field threshold_percentage of message multisig.v1.MsgCreateMultisigAccountParams is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCreateMultisigAccountParams"))
@@ -1206,6 +1227,8 @@ func (x *fastReflection_MsgCreateMultisigAccountParams) NewField(fd protoreflect
 		return protoreflect.ValueOfList(&_MsgCreateMultisigAccountParams_4_list{list: &list})
 	case "multisig.v1.MsgCreateMultisigAccountParams.permission":
 		return protoreflect.ValueOfEnum(0)
+	case "multisig.v1.MsgCreateMultisigAccountParams.threshold_percentage":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCreateMultisigAccountParams"))
@@ -1294,6 +1317,10 @@ func (x *fastReflection_MsgCreateMultisigAccountParams) ProtoMethods() *protoifa
 		if x.Permission != 0 {
 			n += 1 + runtime.Sov(uint64(x.Permission))
 		}
+		l = len(x.ThresholdPercentage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1323,6 +1350,13 @@ func (x *fastReflection_MsgCreateMultisigAccountParams) ProtoMethods() *protoifa
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ThresholdPercentage) > 0 {
+			i -= len(x.ThresholdPercentage)
+			copy(dAtA[i:], x.ThresholdPercentage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThresholdPercentage)))
+			i--
+			dAtA[i] = 0x32
+		}
 		if x.Permission != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Permission))
 			i--
@@ -1524,6 +1558,38 @@ func (x *fastReflection_MsgCreateMultisigAccountParams) ProtoMethods() *protoifa
 						break
 					}
 				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThresholdPercentage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ThresholdPercentage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7481,30 +7547,85 @@ func (x *fastReflection_MsgApproveAndDispatchMultisigProposalResponse) ProtoMeth
 	}
 }
 
+var _ protoreflect.List = (*_MsgBatchApproveMultisigProposalParams_4_list)(nil)
+
+type _MsgBatchApproveMultisigProposalParams_4_list struct {
+	list *[]*ApprovalSignature
+}
+
+func (x *_MsgBatchApproveMultisigProposalParams_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgBatchApproveMultisigProposalParams_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgBatchApproveMultisigProposalParams_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ApprovalSignature)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgBatchApproveMultisigProposalParams_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ApprovalSignature)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgBatchApproveMultisigProposalParams_4_list) AppendMutable() protoreflect.Value {
+	v := new(ApprovalSignature)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgBatchApproveMultisigProposalParams_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgBatchApproveMultisigProposalParams_4_list) NewElement() protoreflect.Value {
+	v := new(ApprovalSignature)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgBatchApproveMultisigProposalParams_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgCancelMultisigProposalParams                  protoreflect.MessageDescriptor
-	fd_MsgCancelMultisigProposalParams_multisig_address protoreflect.FieldDescriptor
-	fd_MsgCancelMultisigProposalParams_proposal_id      protoreflect.FieldDescriptor
-	fd_MsgCancelMultisigProposalParams_rejecter         protoreflect.FieldDescriptor
+	md_MsgBatchApproveMultisigProposalParams                  protoreflect.MessageDescriptor
+	fd_MsgBatchApproveMultisigProposalParams_submitter        protoreflect.FieldDescriptor
+	fd_MsgBatchApproveMultisigProposalParams_multisig_address protoreflect.FieldDescriptor
+	fd_MsgBatchApproveMultisigProposalParams_proposal_id      protoreflect.FieldDescriptor
+	fd_MsgBatchApproveMultisigProposalParams_signatures       protoreflect.FieldDescriptor
+	fd_MsgBatchApproveMultisigProposalParams_message          protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_multisig_v1_tx_proto_init()
-	md_MsgCancelMultisigProposalParams = File_multisig_v1_tx_proto.Messages().ByName("MsgCancelMultisigProposalParams")
-	fd_MsgCancelMultisigProposalParams_multisig_address = md_MsgCancelMultisigProposalParams.Fields().ByName("multisig_address")
-	fd_MsgCancelMultisigProposalParams_proposal_id = md_MsgCancelMultisigProposalParams.Fields().ByName("proposal_id")
-	fd_MsgCancelMultisigProposalParams_rejecter = md_MsgCancelMultisigProposalParams.Fields().ByName("rejecter")
+	md_MsgBatchApproveMultisigProposalParams = File_multisig_v1_tx_proto.Messages().ByName("MsgBatchApproveMultisigProposalParams")
+	fd_MsgBatchApproveMultisigProposalParams_submitter = md_MsgBatchApproveMultisigProposalParams.Fields().ByName("submitter")
+	fd_MsgBatchApproveMultisigProposalParams_multisig_address = md_MsgBatchApproveMultisigProposalParams.Fields().ByName("multisig_address")
+	fd_MsgBatchApproveMultisigProposalParams_proposal_id = md_MsgBatchApproveMultisigProposalParams.Fields().ByName("proposal_id")
+	fd_MsgBatchApproveMultisigProposalParams_signatures = md_MsgBatchApproveMultisigProposalParams.Fields().ByName("signatures")
+	fd_MsgBatchApproveMultisigProposalParams_message = md_MsgBatchApproveMultisigProposalParams.Fields().ByName("message")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgCancelMultisigProposalParams)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgBatchApproveMultisigProposalParams)(nil)
 
-type fastReflection_MsgCancelMultisigProposalParams MsgCancelMultisigProposalParams
+type fastReflection_MsgBatchApproveMultisigProposalParams MsgBatchApproveMultisigProposalParams
 
-func (x *MsgCancelMultisigProposalParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgCancelMultisigProposalParams)(x)
+func (x *MsgBatchApproveMultisigProposalParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgBatchApproveMultisigProposalParams)(x)
 }
 
-func (x *MsgCancelMultisigProposalParams) slowProtoReflect() protoreflect.Message {
+func (x *MsgBatchApproveMultisigProposalParams) slowProtoReflect() protoreflect.Message {
 	mi := &file_multisig_v1_tx_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7516,43 +7637,43 @@ func (x *MsgCancelMultisigProposalParams) slowProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgCancelMultisigProposalParams_messageType fastReflection_MsgCancelMultisigProposalParams_messageType
-var _ protoreflect.MessageType = fastReflection_MsgCancelMultisigProposalParams_messageType{}
+var _fastReflection_MsgBatchApproveMultisigProposalParams_messageType fastReflection_MsgBatchApproveMultisigProposalParams_messageType
+var _ protoreflect.MessageType = fastReflection_MsgBatchApproveMultisigProposalParams_messageType{}
 
-type fastReflection_MsgCancelMultisigProposalParams_messageType struct{}
+type fastReflection_MsgBatchApproveMultisigProposalParams_messageType struct{}
 
-func (x fastReflection_MsgCancelMultisigProposalParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgCancelMultisigProposalParams)(nil)
+func (x fastReflection_MsgBatchApproveMultisigProposalParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgBatchApproveMultisigProposalParams)(nil)
 }
-func (x fastReflection_MsgCancelMultisigProposalParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgCancelMultisigProposalParams)
+func (x fastReflection_MsgBatchApproveMultisigProposalParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgBatchApproveMultisigProposalParams)
 }
-func (x fastReflection_MsgCancelMultisigProposalParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCancelMultisigProposalParams
+func (x fastReflection_MsgBatchApproveMultisigProposalParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBatchApproveMultisigProposalParams
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgCancelMultisigProposalParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCancelMultisigProposalParams
+func (x *fastReflection_MsgBatchApproveMultisigProposalParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBatchApproveMultisigProposalParams
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgCancelMultisigProposalParams) Type() protoreflect.MessageType {
-	return _fastReflection_MsgCancelMultisigProposalParams_messageType
+func (x *fastReflection_MsgBatchApproveMultisigProposalParams) Type() protoreflect.MessageType {
+	return _fastReflection_MsgBatchApproveMultisigProposalParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgCancelMultisigProposalParams) New() protoreflect.Message {
-	return new(fastReflection_MsgCancelMultisigProposalParams)
+func (x *fastReflection_MsgBatchApproveMultisigProposalParams) New() protoreflect.Message {
+	return new(fastReflection_MsgBatchApproveMultisigProposalParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgCancelMultisigProposalParams) Interface() protoreflect.ProtoMessage {
-	return (*MsgCancelMultisigProposalParams)(x)
+func (x *fastReflection_MsgBatchApproveMultisigProposalParams) Interface() protoreflect.ProtoMessage {
+	return (*MsgBatchApproveMultisigProposalParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7560,22 +7681,34 @@ func (x *fastReflection_MsgCancelMultisigProposalParams) Interface() protoreflec
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgCancelMultisigProposalParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgBatchApproveMultisigProposalParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Submitter != "" {
+		value := protoreflect.ValueOfString(x.Submitter)
+		if !f(fd_MsgBatchApproveMultisigProposalParams_submitter, value) {
+			return
+		}
+	}
 	if x.MultisigAddress != "" {
 		value := protoreflect.ValueOfString(x.MultisigAddress)
-		if !f(fd_MsgCancelMultisigProposalParams_multisig_address, value) {
+		if !f(fd_MsgBatchApproveMultisigProposalParams_multisig_address, value) {
 			return
 		}
 	}
 	if x.ProposalId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_MsgCancelMultisigProposalParams_proposal_id, value) {
+		if !f(fd_MsgBatchApproveMultisigProposalParams_proposal_id, value) {
 			return
 		}
 	}
-	if x.Rejecter != "" {
-		value := protoreflect.ValueOfString(x.Rejecter)
-		if !f(fd_MsgCancelMultisigProposalParams_rejecter, value) {
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_MsgBatchApproveMultisigProposalParams_4_list{list: &x.Signatures})
+		if !f(fd_MsgBatchApproveMultisigProposalParams_signatures, value) {
+			return
+		}
+	}
+	if x.Message != nil {
+		value := protoreflect.ValueOfMessage(x.Message.ProtoReflect())
+		if !f(fd_MsgBatchApproveMultisigProposalParams_message, value) {
 			return
 		}
 	}
@@ -7592,19 +7725,23 @@ func (x *fastReflection_MsgCancelMultisigProposalParams) Range(f func(protorefle
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgCancelMultisigProposalParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgBatchApproveMultisigProposalParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "multisig.v1.MsgCancelMultisigProposalParams.multisig_address":
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.submitter":
+		return x.Submitter != ""
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.multisig_address":
 		return x.MultisigAddress != ""
-	case "multisig.v1.MsgCancelMultisigProposalParams.proposal_id":
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.proposal_id":
 		return x.ProposalId != uint64(0)
-	case "multisig.v1.MsgCancelMultisigProposalParams.rejecter":
-		return x.Rejecter != ""
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.signatures":
+		return len(x.Signatures) != 0
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.message":
+		return x.Message != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigProposalParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgBatchApproveMultisigProposalParams"))
 		}
-		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigProposalParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.MsgBatchApproveMultisigProposalParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7614,19 +7751,23 @@ func (x *fastReflection_MsgCancelMultisigProposalParams) Has(fd protoreflect.Fie
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCancelMultisigProposalParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgBatchApproveMultisigProposalParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "multisig.v1.MsgCancelMultisigProposalParams.multisig_address":
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.submitter":
+		x.Submitter = ""
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.multisig_address":
 		x.MultisigAddress = ""
-	case "multisig.v1.MsgCancelMultisigProposalParams.proposal_id":
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.proposal_id":
 		x.ProposalId = uint64(0)
-	case "multisig.v1.MsgCancelMultisigProposalParams.rejecter":
-		x.Rejecter = ""
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.signatures":
+		x.Signatures = nil
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.message":
+		x.Message = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigProposalParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgBatchApproveMultisigProposalParams"))
 		}
-		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigProposalParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.MsgBatchApproveMultisigProposalParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7636,22 +7777,31 @@ func (x *fastReflection_MsgCancelMultisigProposalParams) Clear(fd protoreflect.F
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgCancelMultisigProposalParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgBatchApproveMultisigProposalParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "multisig.v1.MsgCancelMultisigProposalParams.multisig_address":
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.submitter":
+		value := x.Submitter
+		return protoreflect.ValueOfString(value)
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.multisig_address":
 		value := x.MultisigAddress
 		return protoreflect.ValueOfString(value)
-	case "multisig.v1.MsgCancelMultisigProposalParams.proposal_id":
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.proposal_id":
 		value := x.ProposalId
 		return protoreflect.ValueOfUint64(value)
-	case "multisig.v1.MsgCancelMultisigProposalParams.rejecter":
-		value := x.Rejecter
-		return protoreflect.ValueOfString(value)
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_MsgBatchApproveMultisigProposalParams_4_list{})
+		}
+		listValue := &_MsgBatchApproveMultisigProposalParams_4_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(listValue)
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.message":
+		value := x.Message
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigProposalParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgBatchApproveMultisigProposalParams"))
 		}
-		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigProposalParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message multisig.v1.MsgBatchApproveMultisigProposalParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7665,19 +7815,25 @@ func (x *fastReflection_MsgCancelMultisigProposalParams) Get(descriptor protoref
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCancelMultisigProposalParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgBatchApproveMultisigProposalParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "multisig.v1.MsgCancelMultisigProposalParams.multisig_address":
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.submitter":
+		x.Submitter = value.Interface().(string)
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.multisig_address":
 		x.MultisigAddress = value.Interface().(string)
-	case "multisig.v1.MsgCancelMultisigProposalParams.proposal_id":
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.proposal_id":
 		x.ProposalId = value.Uint()
-	case "multisig.v1.MsgCancelMultisigProposalParams.rejecter":
-		x.Rejecter = value.Interface().(string)
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.signatures":
+		lv := value.List()
+		clv := lv.(*_MsgBatchApproveMultisigProposalParams_4_list)
+		x.Signatures = *clv.list
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.message":
+		x.Message = value.Message().Interface().(*anypb.Any)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigProposalParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgBatchApproveMultisigProposalParams"))
 		}
-		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigProposalParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.MsgBatchApproveMultisigProposalParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7691,48 +7847,65 @@ func (x *fastReflection_MsgCancelMultisigProposalParams) Set(fd protoreflect.Fie
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCancelMultisigProposalParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgBatchApproveMultisigProposalParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "multisig.v1.MsgCancelMultisigProposalParams.multisig_address":
-		panic(fmt.Errorf("field multisig_address of message multisig.v1.MsgCancelMultisigProposalParams is not mutable"))
-	case "multisig.v1.MsgCancelMultisigProposalParams.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message multisig.v1.MsgCancelMultisigProposalParams is not mutable"))
-	case "multisig.v1.MsgCancelMultisigProposalParams.rejecter":
-		panic(fmt.Errorf("field rejecter of message multisig.v1.MsgCancelMultisigProposalParams is not mutable"))
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []*ApprovalSignature{}
+		}
+		value := &_MsgBatchApproveMultisigProposalParams_4_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(value)
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.message":
+		if x.Message == nil {
+			x.Message = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.Message.ProtoReflect())
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.submitter":
+		panic(fmt.Errorf("field submitter of message multisig.v1.MsgBatchApproveMultisigProposalParams is not mutable"))
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.multisig_address":
+		panic(fmt.Errorf("field multisig_address of message multisig.v1.MsgBatchApproveMultisigProposalParams is not mutable"))
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message multisig.v1.MsgBatchApproveMultisigProposalParams is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigProposalParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgBatchApproveMultisigProposalParams"))
 		}
-		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigProposalParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.MsgBatchApproveMultisigProposalParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgCancelMultisigProposalParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgBatchApproveMultisigProposalParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "multisig.v1.MsgCancelMultisigProposalParams.multisig_address":
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.submitter":
 		return protoreflect.ValueOfString("")
-	case "multisig.v1.MsgCancelMultisigProposalParams.proposal_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "multisig.v1.MsgCancelMultisigProposalParams.rejecter":
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.multisig_address":
 		return protoreflect.ValueOfString("")
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.signatures":
+		list := []*ApprovalSignature{}
+		return protoreflect.ValueOfList(&_MsgBatchApproveMultisigProposalParams_4_list{list: &list})
+	case "multisig.v1.MsgBatchApproveMultisigProposalParams.message":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigProposalParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgBatchApproveMultisigProposalParams"))
 		}
-		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigProposalParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.MsgBatchApproveMultisigProposalParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgCancelMultisigProposalParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgBatchApproveMultisigProposalParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.MsgCancelMultisigProposalParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.MsgBatchApproveMultisigProposalParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7740,7 +7913,7 @@ func (x *fastReflection_MsgCancelMultisigProposalParams) WhichOneof(d protorefle
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgCancelMultisigProposalParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgBatchApproveMultisigProposalParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7751,7 +7924,7 @@ func (x *fastReflection_MsgCancelMultisigProposalParams) GetUnknown() protorefle
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCancelMultisigProposalParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgBatchApproveMultisigProposalParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7763,7 +7936,7 @@ func (x *fastReflection_MsgCancelMultisigProposalParams) SetUnknown(fields proto
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgCancelMultisigProposalParams) IsValid() bool {
+func (x *fastReflection_MsgBatchApproveMultisigProposalParams) IsValid() bool {
 	return x != nil
 }
 
@@ -7773,9 +7946,9 @@ func (x *fastReflection_MsgCancelMultisigProposalParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgCancelMultisigProposalParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgBatchApproveMultisigProposalParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgCancelMultisigProposalParams)
+		x := input.Message.Interface().(*MsgBatchApproveMultisigProposalParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7787,6 +7960,10 @@ func (x *fastReflection_MsgCancelMultisigProposalParams) ProtoMethods() *protoif
 		var n int
 		var l int
 		_ = l
+		l = len(x.Submitter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		l = len(x.MultisigAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
@@ -7794,8 +7971,14 @@ func (x *fastReflection_MsgCancelMultisigProposalParams) ProtoMethods() *protoif
 		if x.ProposalId != 0 {
 			n += 1 + runtime.Sov(uint64(x.ProposalId))
 		}
-		l = len(x.Rejecter)
-		if l > 0 {
+		if len(x.Signatures) > 0 {
+			for _, e := range x.Signatures {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Message != nil {
+			l = options.Size(x.Message)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -7808,7 +7991,7 @@ func (x *fastReflection_MsgCancelMultisigProposalParams) ProtoMethods() *protoif
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCancelMultisigProposalParams)
+		x := input.Message.Interface().(*MsgBatchApproveMultisigProposalParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7827,23 +8010,53 @@ func (x *fastReflection_MsgCancelMultisigProposalParams) ProtoMethods() *protoif
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Rejecter) > 0 {
-			i -= len(x.Rejecter)
-			copy(dAtA[i:], x.Rejecter)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Rejecter)))
+		if x.Message != nil {
+			encoded, err := options.Marshal(x.Message)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x2a
+		}
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Signatures[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
 		}
 		if x.ProposalId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x18
 		}
 		if len(x.MultisigAddress) > 0 {
 			i -= len(x.MultisigAddress)
 			copy(dAtA[i:], x.MultisigAddress)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MultisigAddress)))
 			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Submitter) > 0 {
+			i -= len(x.Submitter)
+			copy(dAtA[i:], x.Submitter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Submitter)))
+			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -7857,7 +8070,7 @@ func (x *fastReflection_MsgCancelMultisigProposalParams) ProtoMethods() *protoif
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCancelMultisigProposalParams)
+		x := input.Message.Interface().(*MsgBatchApproveMultisigProposalParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7889,15 +8102,15 @@ func (x *fastReflection_MsgCancelMultisigProposalParams) ProtoMethods() *protoif
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelMultisigProposalParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBatchApproveMultisigProposalParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelMultisigProposalParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBatchApproveMultisigProposalParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7925,30 +8138,11 @@ func (x *fastReflection_MsgCancelMultisigProposalParams) ProtoMethods() *protoif
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MultisigAddress = string(dAtA[iNdEx:postIndex])
+				x.Submitter = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-				}
-				x.ProposalId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ProposalId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rejecter", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7976,15 +8170,104 @@ func (x *fastReflection_MsgCancelMultisigProposalParams) ProtoMethods() *protoif
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Rejecter = string(dAtA[iNdEx:postIndex])
+				x.MultisigAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signatures = append(x.Signatures, &ApprovalSignature{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signatures[len(x.Signatures)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Message == nil {
+					x.Message = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Message); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if (iNdEx + skippy) > l {
@@ -8014,23 +8297,27 @@ func (x *fastReflection_MsgCancelMultisigProposalParams) ProtoMethods() *protoif
 }
 
 var (
-	md_MsgCancelMultisigProposalResponse protoreflect.MessageDescriptor
+	md_ApprovalSignature           protoreflect.MessageDescriptor
+	fd_ApprovalSignature_signer    protoreflect.FieldDescriptor
+	fd_ApprovalSignature_signature protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_multisig_v1_tx_proto_init()
-	md_MsgCancelMultisigProposalResponse = File_multisig_v1_tx_proto.Messages().ByName("MsgCancelMultisigProposalResponse")
+	md_ApprovalSignature = File_multisig_v1_tx_proto.Messages().ByName("ApprovalSignature")
+	fd_ApprovalSignature_signer = md_ApprovalSignature.Fields().ByName("signer")
+	fd_ApprovalSignature_signature = md_ApprovalSignature.Fields().ByName("signature")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgCancelMultisigProposalResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_ApprovalSignature)(nil)
 
-type fastReflection_MsgCancelMultisigProposalResponse MsgCancelMultisigProposalResponse
+type fastReflection_ApprovalSignature ApprovalSignature
 
-func (x *MsgCancelMultisigProposalResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgCancelMultisigProposalResponse)(x)
+func (x *ApprovalSignature) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ApprovalSignature)(x)
 }
 
-func (x *MsgCancelMultisigProposalResponse) slowProtoReflect() protoreflect.Message {
+func (x *ApprovalSignature) slowProtoReflect() protoreflect.Message {
 	mi := &file_multisig_v1_tx_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8042,43 +8329,43 @@ func (x *MsgCancelMultisigProposalResponse) slowProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgCancelMultisigProposalResponse_messageType fastReflection_MsgCancelMultisigProposalResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgCancelMultisigProposalResponse_messageType{}
+var _fastReflection_ApprovalSignature_messageType fastReflection_ApprovalSignature_messageType
+var _ protoreflect.MessageType = fastReflection_ApprovalSignature_messageType{}
 
-type fastReflection_MsgCancelMultisigProposalResponse_messageType struct{}
+type fastReflection_ApprovalSignature_messageType struct{}
 
-func (x fastReflection_MsgCancelMultisigProposalResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgCancelMultisigProposalResponse)(nil)
+func (x fastReflection_ApprovalSignature_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ApprovalSignature)(nil)
 }
-func (x fastReflection_MsgCancelMultisigProposalResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgCancelMultisigProposalResponse)
+func (x fastReflection_ApprovalSignature_messageType) New() protoreflect.Message {
+	return new(fastReflection_ApprovalSignature)
 }
-func (x fastReflection_MsgCancelMultisigProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCancelMultisigProposalResponse
+func (x fastReflection_ApprovalSignature_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ApprovalSignature
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgCancelMultisigProposalResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCancelMultisigProposalResponse
+func (x *fastReflection_ApprovalSignature) Descriptor() protoreflect.MessageDescriptor {
+	return md_ApprovalSignature
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgCancelMultisigProposalResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgCancelMultisigProposalResponse_messageType
+func (x *fastReflection_ApprovalSignature) Type() protoreflect.MessageType {
+	return _fastReflection_ApprovalSignature_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgCancelMultisigProposalResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgCancelMultisigProposalResponse)
+func (x *fastReflection_ApprovalSignature) New() protoreflect.Message {
+	return new(fastReflection_ApprovalSignature)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgCancelMultisigProposalResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgCancelMultisigProposalResponse)(x)
+func (x *fastReflection_ApprovalSignature) Interface() protoreflect.ProtoMessage {
+	return (*ApprovalSignature)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8086,7 +8373,19 @@ func (x *fastReflection_MsgCancelMultisigProposalResponse) Interface() protorefl
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgCancelMultisigProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_ApprovalSignature) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_ApprovalSignature_signer, value) {
+			return
+		}
+	}
+	if len(x.Signature) != 0 {
+		value := protoreflect.ValueOfBytes(x.Signature)
+		if !f(fd_ApprovalSignature_signature, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -8100,13 +8399,17 @@ func (x *fastReflection_MsgCancelMultisigProposalResponse) Range(f func(protoref
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgCancelMultisigProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ApprovalSignature) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "multisig.v1.ApprovalSignature.signer":
+		return x.Signer != ""
+	case "multisig.v1.ApprovalSignature.signature":
+		return len(x.Signature) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.ApprovalSignature"))
 		}
-		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.ApprovalSignature does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8116,13 +8419,17 @@ func (x *fastReflection_MsgCancelMultisigProposalResponse) Has(fd protoreflect.F
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCancelMultisigProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ApprovalSignature) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "multisig.v1.ApprovalSignature.signer":
+		x.Signer = ""
+	case "multisig.v1.ApprovalSignature.signature":
+		x.Signature = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.ApprovalSignature"))
 		}
-		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.ApprovalSignature does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8132,13 +8439,19 @@ func (x *fastReflection_MsgCancelMultisigProposalResponse) Clear(fd protoreflect
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgCancelMultisigProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ApprovalSignature) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "multisig.v1.ApprovalSignature.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "multisig.v1.ApprovalSignature.signature":
+		value := x.Signature
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.ApprovalSignature"))
 		}
-		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigProposalResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message multisig.v1.ApprovalSignature does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8152,13 +8465,17 @@ func (x *fastReflection_MsgCancelMultisigProposalResponse) Get(descriptor protor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCancelMultisigProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ApprovalSignature) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "multisig.v1.ApprovalSignature.signer":
+		x.Signer = value.Interface().(string)
+	case "multisig.v1.ApprovalSignature.signature":
+		x.Signature = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.ApprovalSignature"))
 		}
-		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.ApprovalSignature does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8172,36 +8489,44 @@ func (x *fastReflection_MsgCancelMultisigProposalResponse) Set(fd protoreflect.F
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCancelMultisigProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ApprovalSignature) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "multisig.v1.ApprovalSignature.signer":
+		panic(fmt.Errorf("field signer of message multisig.v1.ApprovalSignature is not mutable"))
+	case "multisig.v1.ApprovalSignature.signature":
+		panic(fmt.Errorf("field signature of message multisig.v1.ApprovalSignature is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.ApprovalSignature"))
 		}
-		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.ApprovalSignature does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgCancelMultisigProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ApprovalSignature) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "multisig.v1.ApprovalSignature.signer":
+		return protoreflect.ValueOfString("")
+	case "multisig.v1.ApprovalSignature.signature":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.ApprovalSignature"))
 		}
-		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.ApprovalSignature does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgCancelMultisigProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ApprovalSignature) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.MsgCancelMultisigProposalResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.ApprovalSignature", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8209,7 +8534,7 @@ func (x *fastReflection_MsgCancelMultisigProposalResponse) WhichOneof(d protoref
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgCancelMultisigProposalResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ApprovalSignature) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8220,7 +8545,7 @@ func (x *fastReflection_MsgCancelMultisigProposalResponse) GetUnknown() protoref
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCancelMultisigProposalResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ApprovalSignature) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8232,7 +8557,7 @@ func (x *fastReflection_MsgCancelMultisigProposalResponse) SetUnknown(fields pro
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgCancelMultisigProposalResponse) IsValid() bool {
+func (x *fastReflection_ApprovalSignature) IsValid() bool {
 	return x != nil
 }
 
@@ -8242,9 +8567,9 @@ func (x *fastReflection_MsgCancelMultisigProposalResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgCancelMultisigProposalResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ApprovalSignature) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgCancelMultisigProposalResponse)
+		x := input.Message.Interface().(*ApprovalSignature)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8256,6 +8581,14 @@ func (x *fastReflection_MsgCancelMultisigProposalResponse) ProtoMethods() *proto
 		var n int
 		var l int
 		_ = l
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8266,7 +8599,7 @@ func (x *fastReflection_MsgCancelMultisigProposalResponse) ProtoMethods() *proto
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCancelMultisigProposalResponse)
+		x := input.Message.Interface().(*ApprovalSignature)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8285,6 +8618,20 @@ func (x *fastReflection_MsgCancelMultisigProposalResponse) ProtoMethods() *proto
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -8296,7 +8643,7 @@ func (x *fastReflection_MsgCancelMultisigProposalResponse) ProtoMethods() *proto
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCancelMultisigProposalResponse)
+		x := input.Message.Interface().(*ApprovalSignature)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8328,12 +8675,78 @@ func (x *fastReflection_MsgCancelMultisigProposalResponse) ProtoMethods() *proto
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelMultisigProposalResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ApprovalSignature: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelMultisigProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ApprovalSignature: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signature = append(x.Signature[:0], dAtA[iNdEx:postIndex]...)
+				if x.Signature == nil {
+					x.Signature = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8370,29 +8783,31 @@ func (x *fastReflection_MsgCancelMultisigProposalResponse) ProtoMethods() *proto
 }
 
 var (
-	md_MsgCleanupMultisigProposalParams                  protoreflect.MessageDescriptor
-	fd_MsgCleanupMultisigProposalParams_multisig_address protoreflect.FieldDescriptor
-	fd_MsgCleanupMultisigProposalParams_proposal_id      protoreflect.FieldDescriptor
-	fd_MsgCleanupMultisigProposalParams_remover          protoreflect.FieldDescriptor
+	md_ApprovalSignDoc                  protoreflect.MessageDescriptor
+	fd_ApprovalSignDoc_chain_id         protoreflect.FieldDescriptor
+	fd_ApprovalSignDoc_multisig_address protoreflect.FieldDescriptor
+	fd_ApprovalSignDoc_proposal_id      protoreflect.FieldDescriptor
+	fd_ApprovalSignDoc_call_hash        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_multisig_v1_tx_proto_init()
-	md_MsgCleanupMultisigProposalParams = File_multisig_v1_tx_proto.Messages().ByName("MsgCleanupMultisigProposalParams")
-	fd_MsgCleanupMultisigProposalParams_multisig_address = md_MsgCleanupMultisigProposalParams.Fields().ByName("multisig_address")
-	fd_MsgCleanupMultisigProposalParams_proposal_id = md_MsgCleanupMultisigProposalParams.Fields().ByName("proposal_id")
-	fd_MsgCleanupMultisigProposalParams_remover = md_MsgCleanupMultisigProposalParams.Fields().ByName("remover")
+	md_ApprovalSignDoc = File_multisig_v1_tx_proto.Messages().ByName("ApprovalSignDoc")
+	fd_ApprovalSignDoc_chain_id = md_ApprovalSignDoc.Fields().ByName("chain_id")
+	fd_ApprovalSignDoc_multisig_address = md_ApprovalSignDoc.Fields().ByName("multisig_address")
+	fd_ApprovalSignDoc_proposal_id = md_ApprovalSignDoc.Fields().ByName("proposal_id")
+	fd_ApprovalSignDoc_call_hash = md_ApprovalSignDoc.Fields().ByName("call_hash")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgCleanupMultisigProposalParams)(nil)
+var _ protoreflect.Message = (*fastReflection_ApprovalSignDoc)(nil)
 
-type fastReflection_MsgCleanupMultisigProposalParams MsgCleanupMultisigProposalParams
+type fastReflection_ApprovalSignDoc ApprovalSignDoc
 
-func (x *MsgCleanupMultisigProposalParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgCleanupMultisigProposalParams)(x)
+func (x *ApprovalSignDoc) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ApprovalSignDoc)(x)
 }
 
-func (x *MsgCleanupMultisigProposalParams) slowProtoReflect() protoreflect.Message {
+func (x *ApprovalSignDoc) slowProtoReflect() protoreflect.Message {
 	mi := &file_multisig_v1_tx_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8404,43 +8819,43 @@ func (x *MsgCleanupMultisigProposalParams) slowProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgCleanupMultisigProposalParams_messageType fastReflection_MsgCleanupMultisigProposalParams_messageType
-var _ protoreflect.MessageType = fastReflection_MsgCleanupMultisigProposalParams_messageType{}
+var _fastReflection_ApprovalSignDoc_messageType fastReflection_ApprovalSignDoc_messageType
+var _ protoreflect.MessageType = fastReflection_ApprovalSignDoc_messageType{}
 
-type fastReflection_MsgCleanupMultisigProposalParams_messageType struct{}
+type fastReflection_ApprovalSignDoc_messageType struct{}
 
-func (x fastReflection_MsgCleanupMultisigProposalParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgCleanupMultisigProposalParams)(nil)
+func (x fastReflection_ApprovalSignDoc_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ApprovalSignDoc)(nil)
 }
-func (x fastReflection_MsgCleanupMultisigProposalParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgCleanupMultisigProposalParams)
+func (x fastReflection_ApprovalSignDoc_messageType) New() protoreflect.Message {
+	return new(fastReflection_ApprovalSignDoc)
 }
-func (x fastReflection_MsgCleanupMultisigProposalParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCleanupMultisigProposalParams
+func (x fastReflection_ApprovalSignDoc_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ApprovalSignDoc
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgCleanupMultisigProposalParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCleanupMultisigProposalParams
+func (x *fastReflection_ApprovalSignDoc) Descriptor() protoreflect.MessageDescriptor {
+	return md_ApprovalSignDoc
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgCleanupMultisigProposalParams) Type() protoreflect.MessageType {
-	return _fastReflection_MsgCleanupMultisigProposalParams_messageType
+func (x *fastReflection_ApprovalSignDoc) Type() protoreflect.MessageType {
+	return _fastReflection_ApprovalSignDoc_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgCleanupMultisigProposalParams) New() protoreflect.Message {
-	return new(fastReflection_MsgCleanupMultisigProposalParams)
+func (x *fastReflection_ApprovalSignDoc) New() protoreflect.Message {
+	return new(fastReflection_ApprovalSignDoc)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgCleanupMultisigProposalParams) Interface() protoreflect.ProtoMessage {
-	return (*MsgCleanupMultisigProposalParams)(x)
+func (x *fastReflection_ApprovalSignDoc) Interface() protoreflect.ProtoMessage {
+	return (*ApprovalSignDoc)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8448,22 +8863,28 @@ func (x *fastReflection_MsgCleanupMultisigProposalParams) Interface() protorefle
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgCleanupMultisigProposalParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_ApprovalSignDoc) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChainId != "" {
+		value := protoreflect.ValueOfString(x.ChainId)
+		if !f(fd_ApprovalSignDoc_chain_id, value) {
+			return
+		}
+	}
 	if x.MultisigAddress != "" {
 		value := protoreflect.ValueOfString(x.MultisigAddress)
-		if !f(fd_MsgCleanupMultisigProposalParams_multisig_address, value) {
+		if !f(fd_ApprovalSignDoc_multisig_address, value) {
 			return
 		}
 	}
 	if x.ProposalId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_MsgCleanupMultisigProposalParams_proposal_id, value) {
+		if !f(fd_ApprovalSignDoc_proposal_id, value) {
 			return
 		}
 	}
-	if x.Remover != "" {
-		value := protoreflect.ValueOfString(x.Remover)
-		if !f(fd_MsgCleanupMultisigProposalParams_remover, value) {
+	if len(x.CallHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.CallHash)
+		if !f(fd_ApprovalSignDoc_call_hash, value) {
 			return
 		}
 	}
@@ -8480,19 +8901,21 @@ func (x *fastReflection_MsgCleanupMultisigProposalParams) Range(f func(protorefl
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgCleanupMultisigProposalParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ApprovalSignDoc) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "multisig.v1.MsgCleanupMultisigProposalParams.multisig_address":
+	case "multisig.v1.ApprovalSignDoc.chain_id":
+		return x.ChainId != ""
+	case "multisig.v1.ApprovalSignDoc.multisig_address":
 		return x.MultisigAddress != ""
-	case "multisig.v1.MsgCleanupMultisigProposalParams.proposal_id":
+	case "multisig.v1.ApprovalSignDoc.proposal_id":
 		return x.ProposalId != uint64(0)
-	case "multisig.v1.MsgCleanupMultisigProposalParams.remover":
-		return x.Remover != ""
+	case "multisig.v1.ApprovalSignDoc.call_hash":
+		return len(x.CallHash) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCleanupMultisigProposalParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.ApprovalSignDoc"))
 		}
-		panic(fmt.Errorf("message multisig.v1.MsgCleanupMultisigProposalParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.ApprovalSignDoc does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8502,19 +8925,21 @@ func (x *fastReflection_MsgCleanupMultisigProposalParams) Has(fd protoreflect.Fi
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCleanupMultisigProposalParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ApprovalSignDoc) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "multisig.v1.MsgCleanupMultisigProposalParams.multisig_address":
+	case "multisig.v1.ApprovalSignDoc.chain_id":
+		x.ChainId = ""
+	case "multisig.v1.ApprovalSignDoc.multisig_address":
 		x.MultisigAddress = ""
-	case "multisig.v1.MsgCleanupMultisigProposalParams.proposal_id":
+	case "multisig.v1.ApprovalSignDoc.proposal_id":
 		x.ProposalId = uint64(0)
-	case "multisig.v1.MsgCleanupMultisigProposalParams.remover":
-		x.Remover = ""
+	case "multisig.v1.ApprovalSignDoc.call_hash":
+		x.CallHash = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCleanupMultisigProposalParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.ApprovalSignDoc"))
 		}
-		panic(fmt.Errorf("message multisig.v1.MsgCleanupMultisigProposalParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.ApprovalSignDoc does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8524,22 +8949,25 @@ func (x *fastReflection_MsgCleanupMultisigProposalParams) Clear(fd protoreflect.
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgCleanupMultisigProposalParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ApprovalSignDoc) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "multisig.v1.MsgCleanupMultisigProposalParams.multisig_address":
+	case "multisig.v1.ApprovalSignDoc.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfString(value)
+	case "multisig.v1.ApprovalSignDoc.multisig_address":
 		value := x.MultisigAddress
 		return protoreflect.ValueOfString(value)
-	case "multisig.v1.MsgCleanupMultisigProposalParams.proposal_id":
+	case "multisig.v1.ApprovalSignDoc.proposal_id":
 		value := x.ProposalId
 		return protoreflect.ValueOfUint64(value)
-	case "multisig.v1.MsgCleanupMultisigProposalParams.remover":
-		value := x.Remover
-		return protoreflect.ValueOfString(value)
+	case "multisig.v1.ApprovalSignDoc.call_hash":
+		value := x.CallHash
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCleanupMultisigProposalParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.ApprovalSignDoc"))
 		}
-		panic(fmt.Errorf("message multisig.v1.MsgCleanupMultisigProposalParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message multisig.v1.ApprovalSignDoc does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8553,19 +8981,21 @@ func (x *fastReflection_MsgCleanupMultisigProposalParams) Get(descriptor protore
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCleanupMultisigProposalParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ApprovalSignDoc) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "multisig.v1.MsgCleanupMultisigProposalParams.multisig_address":
+	case "multisig.v1.ApprovalSignDoc.chain_id":
+		x.ChainId = value.Interface().(string)
+	case "multisig.v1.ApprovalSignDoc.multisig_address":
 		x.MultisigAddress = value.Interface().(string)
-	case "multisig.v1.MsgCleanupMultisigProposalParams.proposal_id":
+	case "multisig.v1.ApprovalSignDoc.proposal_id":
 		x.ProposalId = value.Uint()
-	case "multisig.v1.MsgCleanupMultisigProposalParams.remover":
-		x.Remover = value.Interface().(string)
+	case "multisig.v1.ApprovalSignDoc.call_hash":
+		x.CallHash = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCleanupMultisigProposalParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.ApprovalSignDoc"))
 		}
-		panic(fmt.Errorf("message multisig.v1.MsgCleanupMultisigProposalParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.ApprovalSignDoc does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8579,48 +9009,52 @@ func (x *fastReflection_MsgCleanupMultisigProposalParams) Set(fd protoreflect.Fi
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCleanupMultisigProposalParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ApprovalSignDoc) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "multisig.v1.MsgCleanupMultisigProposalParams.multisig_address":
-		panic(fmt.Errorf("field multisig_address of message multisig.v1.MsgCleanupMultisigProposalParams is not mutable"))
-	case "multisig.v1.MsgCleanupMultisigProposalParams.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message multisig.v1.MsgCleanupMultisigProposalParams is not mutable"))
-	case "multisig.v1.MsgCleanupMultisigProposalParams.remover":
-		panic(fmt.Errorf("field remover of message multisig.v1.MsgCleanupMultisigProposalParams is not mutable"))
+	case "multisig.v1.ApprovalSignDoc.chain_id":
+		panic(fmt.Errorf("field chain_id of message multisig.v1.ApprovalSignDoc is not mutable"))
+	case "multisig.v1.ApprovalSignDoc.multisig_address":
+		panic(fmt.Errorf("field multisig_address of message multisig.v1.ApprovalSignDoc is not mutable"))
+	case "multisig.v1.ApprovalSignDoc.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message multisig.v1.ApprovalSignDoc is not mutable"))
+	case "multisig.v1.ApprovalSignDoc.call_hash":
+		panic(fmt.Errorf("field call_hash of message multisig.v1.ApprovalSignDoc is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCleanupMultisigProposalParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.ApprovalSignDoc"))
 		}
-		panic(fmt.Errorf("message multisig.v1.MsgCleanupMultisigProposalParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.ApprovalSignDoc does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgCleanupMultisigProposalParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ApprovalSignDoc) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "multisig.v1.MsgCleanupMultisigProposalParams.multisig_address":
+	case "multisig.v1.ApprovalSignDoc.chain_id":
 		return protoreflect.ValueOfString("")
-	case "multisig.v1.MsgCleanupMultisigProposalParams.proposal_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "multisig.v1.MsgCleanupMultisigProposalParams.remover":
+	case "multisig.v1.ApprovalSignDoc.multisig_address":
 		return protoreflect.ValueOfString("")
+	case "multisig.v1.ApprovalSignDoc.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "multisig.v1.ApprovalSignDoc.call_hash":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCleanupMultisigProposalParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.ApprovalSignDoc"))
 		}
-		panic(fmt.Errorf("message multisig.v1.MsgCleanupMultisigProposalParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.ApprovalSignDoc does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgCleanupMultisigProposalParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ApprovalSignDoc) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.MsgCleanupMultisigProposalParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.ApprovalSignDoc", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8628,7 +9062,7 @@ func (x *fastReflection_MsgCleanupMultisigProposalParams) WhichOneof(d protorefl
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgCleanupMultisigProposalParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ApprovalSignDoc) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8639,7 +9073,7 @@ func (x *fastReflection_MsgCleanupMultisigProposalParams) GetUnknown() protorefl
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCleanupMultisigProposalParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ApprovalSignDoc) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8651,7 +9085,7 @@ func (x *fastReflection_MsgCleanupMultisigProposalParams) SetUnknown(fields prot
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgCleanupMultisigProposalParams) IsValid() bool {
+func (x *fastReflection_ApprovalSignDoc) IsValid() bool {
 	return x != nil
 }
 
@@ -8661,9 +9095,9 @@ func (x *fastReflection_MsgCleanupMultisigProposalParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgCleanupMultisigProposalParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ApprovalSignDoc) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgCleanupMultisigProposalParams)
+		x := input.Message.Interface().(*ApprovalSignDoc)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8675,6 +9109,10 @@ func (x *fastReflection_MsgCleanupMultisigProposalParams) ProtoMethods() *protoi
 		var n int
 		var l int
 		_ = l
+		l = len(x.ChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		l = len(x.MultisigAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
@@ -8682,7 +9120,7 @@ func (x *fastReflection_MsgCleanupMultisigProposalParams) ProtoMethods() *protoi
 		if x.ProposalId != 0 {
 			n += 1 + runtime.Sov(uint64(x.ProposalId))
 		}
-		l = len(x.Remover)
+		l = len(x.CallHash)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -8696,7 +9134,7 @@ func (x *fastReflection_MsgCleanupMultisigProposalParams) ProtoMethods() *protoi
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCleanupMultisigProposalParams)
+		x := input.Message.Interface().(*ApprovalSignDoc)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8715,23 +9153,30 @@ func (x *fastReflection_MsgCleanupMultisigProposalParams) ProtoMethods() *protoi
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Remover) > 0 {
-			i -= len(x.Remover)
-			copy(dAtA[i:], x.Remover)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Remover)))
+		if len(x.CallHash) > 0 {
+			i -= len(x.CallHash)
+			copy(dAtA[i:], x.CallHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CallHash)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x22
 		}
 		if x.ProposalId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x18
 		}
 		if len(x.MultisigAddress) > 0 {
 			i -= len(x.MultisigAddress)
 			copy(dAtA[i:], x.MultisigAddress)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MultisigAddress)))
 			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ChainId) > 0 {
+			i -= len(x.ChainId)
+			copy(dAtA[i:], x.ChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
+			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -8745,7 +9190,7 @@ func (x *fastReflection_MsgCleanupMultisigProposalParams) ProtoMethods() *protoi
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCleanupMultisigProposalParams)
+		x := input.Message.Interface().(*ApprovalSignDoc)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8777,13 +9222,45 @@ func (x *fastReflection_MsgCleanupMultisigProposalParams) ProtoMethods() *protoi
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCleanupMultisigProposalParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ApprovalSignDoc: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCleanupMultisigProposalParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ApprovalSignDoc: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChainId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
 				}
@@ -8815,7 +9292,7 @@ func (x *fastReflection_MsgCleanupMultisigProposalParams) ProtoMethods() *protoi
 				}
 				x.MultisigAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 3:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 				}
@@ -8834,11 +9311,11 @@ func (x *fastReflection_MsgCleanupMultisigProposalParams) ProtoMethods() *protoi
 						break
 					}
 				}
-			case 3:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Remover", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CallHash", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8848,23 +9325,25 @@ func (x *fastReflection_MsgCleanupMultisigProposalParams) ProtoMethods() *protoi
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Remover = string(dAtA[iNdEx:postIndex])
+				x.CallHash = append(x.CallHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.CallHash == nil {
+					x.CallHash = []byte{}
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -8902,23 +9381,27 @@ func (x *fastReflection_MsgCleanupMultisigProposalParams) ProtoMethods() *protoi
 }
 
 var (
-	md_MsgCleanupMultisigProposalResponse protoreflect.MessageDescriptor
+	md_MsgBatchApproveMultisigProposalResponse                  protoreflect.MessageDescriptor
+	fd_MsgBatchApproveMultisigProposalResponse_dispatched       protoreflect.FieldDescriptor
+	fd_MsgBatchApproveMultisigProposalResponse_transaction_hash protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_multisig_v1_tx_proto_init()
-	md_MsgCleanupMultisigProposalResponse = File_multisig_v1_tx_proto.Messages().ByName("MsgCleanupMultisigProposalResponse")
+	md_MsgBatchApproveMultisigProposalResponse = File_multisig_v1_tx_proto.Messages().ByName("MsgBatchApproveMultisigProposalResponse")
+	fd_MsgBatchApproveMultisigProposalResponse_dispatched = md_MsgBatchApproveMultisigProposalResponse.Fields().ByName("dispatched")
+	fd_MsgBatchApproveMultisigProposalResponse_transaction_hash = md_MsgBatchApproveMultisigProposalResponse.Fields().ByName("transaction_hash")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgCleanupMultisigProposalResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgBatchApproveMultisigProposalResponse)(nil)
 
-type fastReflection_MsgCleanupMultisigProposalResponse MsgCleanupMultisigProposalResponse
+type fastReflection_MsgBatchApproveMultisigProposalResponse MsgBatchApproveMultisigProposalResponse
 
-func (x *MsgCleanupMultisigProposalResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgCleanupMultisigProposalResponse)(x)
+func (x *MsgBatchApproveMultisigProposalResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgBatchApproveMultisigProposalResponse)(x)
 }
 
-func (x *MsgCleanupMultisigProposalResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgBatchApproveMultisigProposalResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_multisig_v1_tx_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8930,43 +9413,43 @@ func (x *MsgCleanupMultisigProposalResponse) slowProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgCleanupMultisigProposalResponse_messageType fastReflection_MsgCleanupMultisigProposalResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgCleanupMultisigProposalResponse_messageType{}
+var _fastReflection_MsgBatchApproveMultisigProposalResponse_messageType fastReflection_MsgBatchApproveMultisigProposalResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgBatchApproveMultisigProposalResponse_messageType{}
 
-type fastReflection_MsgCleanupMultisigProposalResponse_messageType struct{}
+type fastReflection_MsgBatchApproveMultisigProposalResponse_messageType struct{}
 
-func (x fastReflection_MsgCleanupMultisigProposalResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgCleanupMultisigProposalResponse)(nil)
+func (x fastReflection_MsgBatchApproveMultisigProposalResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgBatchApproveMultisigProposalResponse)(nil)
 }
-func (x fastReflection_MsgCleanupMultisigProposalResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgCleanupMultisigProposalResponse)
+func (x fastReflection_MsgBatchApproveMultisigProposalResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgBatchApproveMultisigProposalResponse)
 }
-func (x fastReflection_MsgCleanupMultisigProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCleanupMultisigProposalResponse
+func (x fastReflection_MsgBatchApproveMultisigProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBatchApproveMultisigProposalResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgCleanupMultisigProposalResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCleanupMultisigProposalResponse
+func (x *fastReflection_MsgBatchApproveMultisigProposalResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBatchApproveMultisigProposalResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgCleanupMultisigProposalResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgCleanupMultisigProposalResponse_messageType
+func (x *fastReflection_MsgBatchApproveMultisigProposalResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgBatchApproveMultisigProposalResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgCleanupMultisigProposalResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgCleanupMultisigProposalResponse)
+func (x *fastReflection_MsgBatchApproveMultisigProposalResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgBatchApproveMultisigProposalResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgCleanupMultisigProposalResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgCleanupMultisigProposalResponse)(x)
+func (x *fastReflection_MsgBatchApproveMultisigProposalResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgBatchApproveMultisigProposalResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8974,7 +9457,19 @@ func (x *fastReflection_MsgCleanupMultisigProposalResponse) Interface() protoref
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgCleanupMultisigProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgBatchApproveMultisigProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Dispatched != false {
+		value := protoreflect.ValueOfBool(x.Dispatched)
+		if !f(fd_MsgBatchApproveMultisigProposalResponse_dispatched, value) {
+			return
+		}
+	}
+	if x.TransactionHash != "" {
+		value := protoreflect.ValueOfString(x.TransactionHash)
+		if !f(fd_MsgBatchApproveMultisigProposalResponse_transaction_hash, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -8988,13 +9483,17 @@ func (x *fastReflection_MsgCleanupMultisigProposalResponse) Range(f func(protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgCleanupMultisigProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgBatchApproveMultisigProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "multisig.v1.MsgBatchApproveMultisigProposalResponse.dispatched":
+		return x.Dispatched != false
+	case "multisig.v1.MsgBatchApproveMultisigProposalResponse.transaction_hash":
+		return x.TransactionHash != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCleanupMultisigProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgBatchApproveMultisigProposalResponse"))
 		}
-		panic(fmt.Errorf("message multisig.v1.MsgCleanupMultisigProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.MsgBatchApproveMultisigProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9004,13 +9503,17 @@ func (x *fastReflection_MsgCleanupMultisigProposalResponse) Has(fd protoreflect.
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCleanupMultisigProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgBatchApproveMultisigProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "multisig.v1.MsgBatchApproveMultisigProposalResponse.dispatched":
+		x.Dispatched = false
+	case "multisig.v1.MsgBatchApproveMultisigProposalResponse.transaction_hash":
+		x.TransactionHash = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCleanupMultisigProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgBatchApproveMultisigProposalResponse"))
 		}
-		panic(fmt.Errorf("message multisig.v1.MsgCleanupMultisigProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.MsgBatchApproveMultisigProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9020,13 +9523,19 @@ func (x *fastReflection_MsgCleanupMultisigProposalResponse) Clear(fd protoreflec
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgCleanupMultisigProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgBatchApproveMultisigProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "multisig.v1.MsgBatchApproveMultisigProposalResponse.dispatched":
+		value := x.Dispatched
+		return protoreflect.ValueOfBool(value)
+	case "multisig.v1.MsgBatchApproveMultisigProposalResponse.transaction_hash":
+		value := x.TransactionHash
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCleanupMultisigProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgBatchApproveMultisigProposalResponse"))
 		}
-		panic(fmt.Errorf("message multisig.v1.MsgCleanupMultisigProposalResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message multisig.v1.MsgBatchApproveMultisigProposalResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9040,13 +9549,17 @@ func (x *fastReflection_MsgCleanupMultisigProposalResponse) Get(descriptor proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCleanupMultisigProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgBatchApproveMultisigProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "multisig.v1.MsgBatchApproveMultisigProposalResponse.dispatched":
+		x.Dispatched = value.Bool()
+	case "multisig.v1.MsgBatchApproveMultisigProposalResponse.transaction_hash":
+		x.TransactionHash = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCleanupMultisigProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgBatchApproveMultisigProposalResponse"))
 		}
-		panic(fmt.Errorf("message multisig.v1.MsgCleanupMultisigProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.MsgBatchApproveMultisigProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9060,36 +9573,44 @@ func (x *fastReflection_MsgCleanupMultisigProposalResponse) Set(fd protoreflect.
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCleanupMultisigProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgBatchApproveMultisigProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "multisig.v1.MsgBatchApproveMultisigProposalResponse.dispatched":
+		panic(fmt.Errorf("field dispatched of message multisig.v1.MsgBatchApproveMultisigProposalResponse is not mutable"))
+	case "multisig.v1.MsgBatchApproveMultisigProposalResponse.transaction_hash":
+		panic(fmt.Errorf("field transaction_hash of message multisig.v1.MsgBatchApproveMultisigProposalResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCleanupMultisigProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgBatchApproveMultisigProposalResponse"))
 		}
-		panic(fmt.Errorf("message multisig.v1.MsgCleanupMultisigProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.MsgBatchApproveMultisigProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgCleanupMultisigProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgBatchApproveMultisigProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "multisig.v1.MsgBatchApproveMultisigProposalResponse.dispatched":
+		return protoreflect.ValueOfBool(false)
+	case "multisig.v1.MsgBatchApproveMultisigProposalResponse.transaction_hash":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCleanupMultisigProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgBatchApproveMultisigProposalResponse"))
 		}
-		panic(fmt.Errorf("message multisig.v1.MsgCleanupMultisigProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message multisig.v1.MsgBatchApproveMultisigProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgCleanupMultisigProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgBatchApproveMultisigProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.MsgCleanupMultisigProposalResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.MsgBatchApproveMultisigProposalResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9097,7 +9618,7 @@ func (x *fastReflection_MsgCleanupMultisigProposalResponse) WhichOneof(d protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgCleanupMultisigProposalResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgBatchApproveMultisigProposalResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9108,7 +9629,7 @@ func (x *fastReflection_MsgCleanupMultisigProposalResponse) GetUnknown() protore
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCleanupMultisigProposalResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgBatchApproveMultisigProposalResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9120,7 +9641,7 @@ func (x *fastReflection_MsgCleanupMultisigProposalResponse) SetUnknown(fields pr
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgCleanupMultisigProposalResponse) IsValid() bool {
+func (x *fastReflection_MsgBatchApproveMultisigProposalResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -9130,9 +9651,9 @@ func (x *fastReflection_MsgCleanupMultisigProposalResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgCleanupMultisigProposalResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgBatchApproveMultisigProposalResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgCleanupMultisigProposalResponse)
+		x := input.Message.Interface().(*MsgBatchApproveMultisigProposalResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9144,6 +9665,13 @@ func (x *fastReflection_MsgCleanupMultisigProposalResponse) ProtoMethods() *prot
 		var n int
 		var l int
 		_ = l
+		if x.Dispatched {
+			n += 2
+		}
+		l = len(x.TransactionHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -9154,7 +9682,7 @@ func (x *fastReflection_MsgCleanupMultisigProposalResponse) ProtoMethods() *prot
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCleanupMultisigProposalResponse)
+		x := input.Message.Interface().(*MsgBatchApproveMultisigProposalResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9173,6 +9701,23 @@ func (x *fastReflection_MsgCleanupMultisigProposalResponse) ProtoMethods() *prot
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.TransactionHash) > 0 {
+			i -= len(x.TransactionHash)
+			copy(dAtA[i:], x.TransactionHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TransactionHash)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Dispatched {
+			i--
+			if x.Dispatched {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -9184,7 +9729,7 @@ func (x *fastReflection_MsgCleanupMultisigProposalResponse) ProtoMethods() *prot
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCleanupMultisigProposalResponse)
+		x := input.Message.Interface().(*MsgBatchApproveMultisigProposalResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9216,734 +9761,7202 @@ func (x *fastReflection_MsgCleanupMultisigProposalResponse) ProtoMethods() *prot
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCleanupMultisigProposalResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBatchApproveMultisigProposalResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCleanupMultisigProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBatchApproveMultisigProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dispatched", wireType)
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Dispatched = bool(v != 0)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransactionHash", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TransactionHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
 			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 		}
 		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgScheduleMultisigCallParams                  protoreflect.MessageDescriptor
+	fd_MsgScheduleMultisigCallParams_multisig_address protoreflect.FieldDescriptor
+	fd_MsgScheduleMultisigCallParams_message          protoreflect.FieldDescriptor
+	fd_MsgScheduleMultisigCallParams_start_height     protoreflect.FieldDescriptor
+	fd_MsgScheduleMultisigCallParams_start_time       protoreflect.FieldDescriptor
+	fd_MsgScheduleMultisigCallParams_interval_blocks  protoreflect.FieldDescriptor
+	fd_MsgScheduleMultisigCallParams_max_runs         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_multisig_v1_tx_proto_init()
+	md_MsgScheduleMultisigCallParams = File_multisig_v1_tx_proto.Messages().ByName("MsgScheduleMultisigCallParams")
+	fd_MsgScheduleMultisigCallParams_multisig_address = md_MsgScheduleMultisigCallParams.Fields().ByName("multisig_address")
+	fd_MsgScheduleMultisigCallParams_message = md_MsgScheduleMultisigCallParams.Fields().ByName("message")
+	fd_MsgScheduleMultisigCallParams_start_height = md_MsgScheduleMultisigCallParams.Fields().ByName("start_height")
+	fd_MsgScheduleMultisigCallParams_start_time = md_MsgScheduleMultisigCallParams.Fields().ByName("start_time")
+	fd_MsgScheduleMultisigCallParams_interval_blocks = md_MsgScheduleMultisigCallParams.Fields().ByName("interval_blocks")
+	fd_MsgScheduleMultisigCallParams_max_runs = md_MsgScheduleMultisigCallParams.Fields().ByName("max_runs")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgScheduleMultisigCallParams)(nil)
+
+type fastReflection_MsgScheduleMultisigCallParams MsgScheduleMultisigCallParams
+
+func (x *MsgScheduleMultisigCallParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgScheduleMultisigCallParams)(x)
+}
+
+func (x *MsgScheduleMultisigCallParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_multisig_v1_tx_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgScheduleMultisigCallParams_messageType fastReflection_MsgScheduleMultisigCallParams_messageType
+var _ protoreflect.MessageType = fastReflection_MsgScheduleMultisigCallParams_messageType{}
+
+type fastReflection_MsgScheduleMultisigCallParams_messageType struct{}
+
+func (x fastReflection_MsgScheduleMultisigCallParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgScheduleMultisigCallParams)(nil)
+}
+func (x fastReflection_MsgScheduleMultisigCallParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgScheduleMultisigCallParams)
+}
+func (x fastReflection_MsgScheduleMultisigCallParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgScheduleMultisigCallParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgScheduleMultisigCallParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgScheduleMultisigCallParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgScheduleMultisigCallParams) Type() protoreflect.MessageType {
+	return _fastReflection_MsgScheduleMultisigCallParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgScheduleMultisigCallParams) New() protoreflect.Message {
+	return new(fastReflection_MsgScheduleMultisigCallParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgScheduleMultisigCallParams) Interface() protoreflect.ProtoMessage {
+	return (*MsgScheduleMultisigCallParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgScheduleMultisigCallParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MultisigAddress != "" {
+		value := protoreflect.ValueOfString(x.MultisigAddress)
+		if !f(fd_MsgScheduleMultisigCallParams_multisig_address, value) {
+			return
+		}
+	}
+	if x.Message != nil {
+		value := protoreflect.ValueOfMessage(x.Message.ProtoReflect())
+		if !f(fd_MsgScheduleMultisigCallParams_message, value) {
+			return
+		}
+	}
+	if x.StartHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartHeight)
+		if !f(fd_MsgScheduleMultisigCallParams_start_height, value) {
+			return
+		}
+	}
+	if x.StartTime != nil {
+		value := protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+		if !f(fd_MsgScheduleMultisigCallParams_start_time, value) {
+			return
+		}
+	}
+	if x.IntervalBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IntervalBlocks)
+		if !f(fd_MsgScheduleMultisigCallParams_interval_blocks, value) {
+			return
+		}
+	}
+	if x.MaxRuns != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRuns)
+		if !f(fd_MsgScheduleMultisigCallParams_max_runs, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgScheduleMultisigCallParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "multisig.v1.MsgScheduleMultisigCallParams.multisig_address":
+		return x.MultisigAddress != ""
+	case "multisig.v1.MsgScheduleMultisigCallParams.message":
+		return x.Message != nil
+	case "multisig.v1.MsgScheduleMultisigCallParams.start_height":
+		return x.StartHeight != int64(0)
+	case "multisig.v1.MsgScheduleMultisigCallParams.start_time":
+		return x.StartTime != nil
+	case "multisig.v1.MsgScheduleMultisigCallParams.interval_blocks":
+		return x.IntervalBlocks != uint64(0)
+	case "multisig.v1.MsgScheduleMultisigCallParams.max_runs":
+		return x.MaxRuns != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgScheduleMultisigCallParams"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgScheduleMultisigCallParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleMultisigCallParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "multisig.v1.MsgScheduleMultisigCallParams.multisig_address":
+		x.MultisigAddress = ""
+	case "multisig.v1.MsgScheduleMultisigCallParams.message":
+		x.Message = nil
+	case "multisig.v1.MsgScheduleMultisigCallParams.start_height":
+		x.StartHeight = int64(0)
+	case "multisig.v1.MsgScheduleMultisigCallParams.start_time":
+		x.StartTime = nil
+	case "multisig.v1.MsgScheduleMultisigCallParams.interval_blocks":
+		x.IntervalBlocks = uint64(0)
+	case "multisig.v1.MsgScheduleMultisigCallParams.max_runs":
+		x.MaxRuns = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgScheduleMultisigCallParams"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgScheduleMultisigCallParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgScheduleMultisigCallParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "multisig.v1.MsgScheduleMultisigCallParams.multisig_address":
+		value := x.MultisigAddress
+		return protoreflect.ValueOfString(value)
+	case "multisig.v1.MsgScheduleMultisigCallParams.message":
+		value := x.Message
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "multisig.v1.MsgScheduleMultisigCallParams.start_height":
+		value := x.StartHeight
+		return protoreflect.ValueOfInt64(value)
+	case "multisig.v1.MsgScheduleMultisigCallParams.start_time":
+		value := x.StartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "multisig.v1.MsgScheduleMultisigCallParams.interval_blocks":
+		value := x.IntervalBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "multisig.v1.MsgScheduleMultisigCallParams.max_runs":
+		value := x.MaxRuns
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgScheduleMultisigCallParams"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgScheduleMultisigCallParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleMultisigCallParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "multisig.v1.MsgScheduleMultisigCallParams.multisig_address":
+		x.MultisigAddress = value.Interface().(string)
+	case "multisig.v1.MsgScheduleMultisigCallParams.message":
+		x.Message = value.Message().Interface().(*anypb.Any)
+	case "multisig.v1.MsgScheduleMultisigCallParams.start_height":
+		x.StartHeight = value.Int()
+	case "multisig.v1.MsgScheduleMultisigCallParams.start_time":
+		x.StartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "multisig.v1.MsgScheduleMultisigCallParams.interval_blocks":
+		x.IntervalBlocks = value.Uint()
+	case "multisig.v1.MsgScheduleMultisigCallParams.max_runs":
+		x.MaxRuns = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgScheduleMultisigCallParams"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgScheduleMultisigCallParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleMultisigCallParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.MsgScheduleMultisigCallParams.message":
+		if x.Message == nil {
+			x.Message = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.Message.ProtoReflect())
+	case "multisig.v1.MsgScheduleMultisigCallParams.start_time":
+		if x.StartTime == nil {
+			x.StartTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+	case "multisig.v1.MsgScheduleMultisigCallParams.multisig_address":
+		panic(fmt.Errorf("field multisig_address of message multisig.v1.MsgScheduleMultisigCallParams is not mutable"))
+	case "multisig.v1.MsgScheduleMultisigCallParams.start_height":
+		panic(fmt.Errorf("field start_height of message multisig.v1.MsgScheduleMultisigCallParams is not mutable"))
+	case "multisig.v1.MsgScheduleMultisigCallParams.interval_blocks":
+		panic(fmt.Errorf("field interval_blocks of message multisig.v1.MsgScheduleMultisigCallParams is not mutable"))
+	case "multisig.v1.MsgScheduleMultisigCallParams.max_runs":
+		panic(fmt.Errorf("field max_runs of message multisig.v1.MsgScheduleMultisigCallParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgScheduleMultisigCallParams"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgScheduleMultisigCallParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgScheduleMultisigCallParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.MsgScheduleMultisigCallParams.multisig_address":
+		return protoreflect.ValueOfString("")
+	case "multisig.v1.MsgScheduleMultisigCallParams.message":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "multisig.v1.MsgScheduleMultisigCallParams.start_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "multisig.v1.MsgScheduleMultisigCallParams.start_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "multisig.v1.MsgScheduleMultisigCallParams.interval_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "multisig.v1.MsgScheduleMultisigCallParams.max_runs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgScheduleMultisigCallParams"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgScheduleMultisigCallParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgScheduleMultisigCallParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.MsgScheduleMultisigCallParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgScheduleMultisigCallParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleMultisigCallParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgScheduleMultisigCallParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgScheduleMultisigCallParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgScheduleMultisigCallParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MultisigAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Message != nil {
+			l = options.Size(x.Message)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartHeight))
+		}
+		if x.StartTime != nil {
+			l = options.Size(x.StartTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IntervalBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.IntervalBlocks))
+		}
+		if x.MaxRuns != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxRuns))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgScheduleMultisigCallParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxRuns != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRuns))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.IntervalBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IntervalBlocks))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.StartTime != nil {
+			encoded, err := options.Marshal(x.StartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Message != nil {
+			encoded, err := options.Marshal(x.Message)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MultisigAddress) > 0 {
+			i -= len(x.MultisigAddress)
+			copy(dAtA[i:], x.MultisigAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MultisigAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgScheduleMultisigCallParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgScheduleMultisigCallParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgScheduleMultisigCallParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MultisigAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Message == nil {
+					x.Message = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Message); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StartTime == nil {
+					x.StartTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StartTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IntervalBlocks", wireType)
+				}
+				x.IntervalBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IntervalBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRuns", wireType)
+				}
+				x.MaxRuns = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRuns |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgScheduleMultisigCallResponse             protoreflect.MessageDescriptor
+	fd_MsgScheduleMultisigCallResponse_schedule_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_multisig_v1_tx_proto_init()
+	md_MsgScheduleMultisigCallResponse = File_multisig_v1_tx_proto.Messages().ByName("MsgScheduleMultisigCallResponse")
+	fd_MsgScheduleMultisigCallResponse_schedule_id = md_MsgScheduleMultisigCallResponse.Fields().ByName("schedule_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgScheduleMultisigCallResponse)(nil)
+
+type fastReflection_MsgScheduleMultisigCallResponse MsgScheduleMultisigCallResponse
+
+func (x *MsgScheduleMultisigCallResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgScheduleMultisigCallResponse)(x)
+}
+
+func (x *MsgScheduleMultisigCallResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_multisig_v1_tx_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgScheduleMultisigCallResponse_messageType fastReflection_MsgScheduleMultisigCallResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgScheduleMultisigCallResponse_messageType{}
+
+type fastReflection_MsgScheduleMultisigCallResponse_messageType struct{}
+
+func (x fastReflection_MsgScheduleMultisigCallResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgScheduleMultisigCallResponse)(nil)
+}
+func (x fastReflection_MsgScheduleMultisigCallResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgScheduleMultisigCallResponse)
+}
+func (x fastReflection_MsgScheduleMultisigCallResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgScheduleMultisigCallResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgScheduleMultisigCallResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgScheduleMultisigCallResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgScheduleMultisigCallResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgScheduleMultisigCallResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgScheduleMultisigCallResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgScheduleMultisigCallResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgScheduleMultisigCallResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgScheduleMultisigCallResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgScheduleMultisigCallResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ScheduleId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ScheduleId)
+		if !f(fd_MsgScheduleMultisigCallResponse_schedule_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgScheduleMultisigCallResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "multisig.v1.MsgScheduleMultisigCallResponse.schedule_id":
+		return x.ScheduleId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgScheduleMultisigCallResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgScheduleMultisigCallResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleMultisigCallResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "multisig.v1.MsgScheduleMultisigCallResponse.schedule_id":
+		x.ScheduleId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgScheduleMultisigCallResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgScheduleMultisigCallResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgScheduleMultisigCallResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "multisig.v1.MsgScheduleMultisigCallResponse.schedule_id":
+		value := x.ScheduleId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgScheduleMultisigCallResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgScheduleMultisigCallResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleMultisigCallResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "multisig.v1.MsgScheduleMultisigCallResponse.schedule_id":
+		x.ScheduleId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgScheduleMultisigCallResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgScheduleMultisigCallResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleMultisigCallResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.MsgScheduleMultisigCallResponse.schedule_id":
+		panic(fmt.Errorf("field schedule_id of message multisig.v1.MsgScheduleMultisigCallResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgScheduleMultisigCallResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgScheduleMultisigCallResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgScheduleMultisigCallResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.MsgScheduleMultisigCallResponse.schedule_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgScheduleMultisigCallResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgScheduleMultisigCallResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgScheduleMultisigCallResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.MsgScheduleMultisigCallResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgScheduleMultisigCallResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleMultisigCallResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgScheduleMultisigCallResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgScheduleMultisigCallResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgScheduleMultisigCallResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ScheduleId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ScheduleId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgScheduleMultisigCallResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ScheduleId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ScheduleId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgScheduleMultisigCallResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgScheduleMultisigCallResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgScheduleMultisigCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+				}
+				x.ScheduleId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ScheduleId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCancelMultisigScheduleParams                  protoreflect.MessageDescriptor
+	fd_MsgCancelMultisigScheduleParams_multisig_address protoreflect.FieldDescriptor
+	fd_MsgCancelMultisigScheduleParams_schedule_id      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_multisig_v1_tx_proto_init()
+	md_MsgCancelMultisigScheduleParams = File_multisig_v1_tx_proto.Messages().ByName("MsgCancelMultisigScheduleParams")
+	fd_MsgCancelMultisigScheduleParams_multisig_address = md_MsgCancelMultisigScheduleParams.Fields().ByName("multisig_address")
+	fd_MsgCancelMultisigScheduleParams_schedule_id = md_MsgCancelMultisigScheduleParams.Fields().ByName("schedule_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCancelMultisigScheduleParams)(nil)
+
+type fastReflection_MsgCancelMultisigScheduleParams MsgCancelMultisigScheduleParams
+
+func (x *MsgCancelMultisigScheduleParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCancelMultisigScheduleParams)(x)
+}
+
+func (x *MsgCancelMultisigScheduleParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_multisig_v1_tx_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCancelMultisigScheduleParams_messageType fastReflection_MsgCancelMultisigScheduleParams_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCancelMultisigScheduleParams_messageType{}
+
+type fastReflection_MsgCancelMultisigScheduleParams_messageType struct{}
+
+func (x fastReflection_MsgCancelMultisigScheduleParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCancelMultisigScheduleParams)(nil)
+}
+func (x fastReflection_MsgCancelMultisigScheduleParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelMultisigScheduleParams)
+}
+func (x fastReflection_MsgCancelMultisigScheduleParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelMultisigScheduleParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCancelMultisigScheduleParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelMultisigScheduleParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCancelMultisigScheduleParams) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCancelMultisigScheduleParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCancelMultisigScheduleParams) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelMultisigScheduleParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCancelMultisigScheduleParams) Interface() protoreflect.ProtoMessage {
+	return (*MsgCancelMultisigScheduleParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCancelMultisigScheduleParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MultisigAddress != "" {
+		value := protoreflect.ValueOfString(x.MultisigAddress)
+		if !f(fd_MsgCancelMultisigScheduleParams_multisig_address, value) {
+			return
+		}
+	}
+	if x.ScheduleId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ScheduleId)
+		if !f(fd_MsgCancelMultisigScheduleParams_schedule_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCancelMultisigScheduleParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCancelMultisigScheduleParams.multisig_address":
+		return x.MultisigAddress != ""
+	case "multisig.v1.MsgCancelMultisigScheduleParams.schedule_id":
+		return x.ScheduleId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigScheduleParams"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigScheduleParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelMultisigScheduleParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCancelMultisigScheduleParams.multisig_address":
+		x.MultisigAddress = ""
+	case "multisig.v1.MsgCancelMultisigScheduleParams.schedule_id":
+		x.ScheduleId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigScheduleParams"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigScheduleParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCancelMultisigScheduleParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "multisig.v1.MsgCancelMultisigScheduleParams.multisig_address":
+		value := x.MultisigAddress
+		return protoreflect.ValueOfString(value)
+	case "multisig.v1.MsgCancelMultisigScheduleParams.schedule_id":
+		value := x.ScheduleId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigScheduleParams"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigScheduleParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelMultisigScheduleParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCancelMultisigScheduleParams.multisig_address":
+		x.MultisigAddress = value.Interface().(string)
+	case "multisig.v1.MsgCancelMultisigScheduleParams.schedule_id":
+		x.ScheduleId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigScheduleParams"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigScheduleParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelMultisigScheduleParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCancelMultisigScheduleParams.multisig_address":
+		panic(fmt.Errorf("field multisig_address of message multisig.v1.MsgCancelMultisigScheduleParams is not mutable"))
+	case "multisig.v1.MsgCancelMultisigScheduleParams.schedule_id":
+		panic(fmt.Errorf("field schedule_id of message multisig.v1.MsgCancelMultisigScheduleParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigScheduleParams"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigScheduleParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCancelMultisigScheduleParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCancelMultisigScheduleParams.multisig_address":
+		return protoreflect.ValueOfString("")
+	case "multisig.v1.MsgCancelMultisigScheduleParams.schedule_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigScheduleParams"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigScheduleParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCancelMultisigScheduleParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.MsgCancelMultisigScheduleParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCancelMultisigScheduleParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelMultisigScheduleParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCancelMultisigScheduleParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCancelMultisigScheduleParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCancelMultisigScheduleParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MultisigAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ScheduleId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ScheduleId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelMultisigScheduleParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ScheduleId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ScheduleId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.MultisigAddress) > 0 {
+			i -= len(x.MultisigAddress)
+			copy(dAtA[i:], x.MultisigAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MultisigAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelMultisigScheduleParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelMultisigScheduleParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelMultisigScheduleParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MultisigAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+				}
+				x.ScheduleId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ScheduleId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCancelMultisigScheduleResponse                protoreflect.MessageDescriptor
+	fd_MsgCancelMultisigScheduleResponse_remaining_runs protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_multisig_v1_tx_proto_init()
+	md_MsgCancelMultisigScheduleResponse = File_multisig_v1_tx_proto.Messages().ByName("MsgCancelMultisigScheduleResponse")
+	fd_MsgCancelMultisigScheduleResponse_remaining_runs = md_MsgCancelMultisigScheduleResponse.Fields().ByName("remaining_runs")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCancelMultisigScheduleResponse)(nil)
+
+type fastReflection_MsgCancelMultisigScheduleResponse MsgCancelMultisigScheduleResponse
+
+func (x *MsgCancelMultisigScheduleResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCancelMultisigScheduleResponse)(x)
+}
+
+func (x *MsgCancelMultisigScheduleResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_multisig_v1_tx_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCancelMultisigScheduleResponse_messageType fastReflection_MsgCancelMultisigScheduleResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCancelMultisigScheduleResponse_messageType{}
+
+type fastReflection_MsgCancelMultisigScheduleResponse_messageType struct{}
+
+func (x fastReflection_MsgCancelMultisigScheduleResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCancelMultisigScheduleResponse)(nil)
+}
+func (x fastReflection_MsgCancelMultisigScheduleResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelMultisigScheduleResponse)
+}
+func (x fastReflection_MsgCancelMultisigScheduleResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelMultisigScheduleResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCancelMultisigScheduleResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelMultisigScheduleResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCancelMultisigScheduleResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCancelMultisigScheduleResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCancelMultisigScheduleResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelMultisigScheduleResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCancelMultisigScheduleResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCancelMultisigScheduleResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCancelMultisigScheduleResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RemainingRuns != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RemainingRuns)
+		if !f(fd_MsgCancelMultisigScheduleResponse_remaining_runs, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCancelMultisigScheduleResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCancelMultisigScheduleResponse.remaining_runs":
+		return x.RemainingRuns != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigScheduleResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelMultisigScheduleResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCancelMultisigScheduleResponse.remaining_runs":
+		x.RemainingRuns = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigScheduleResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCancelMultisigScheduleResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "multisig.v1.MsgCancelMultisigScheduleResponse.remaining_runs":
+		value := x.RemainingRuns
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigScheduleResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigScheduleResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelMultisigScheduleResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCancelMultisigScheduleResponse.remaining_runs":
+		x.RemainingRuns = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigScheduleResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelMultisigScheduleResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCancelMultisigScheduleResponse.remaining_runs":
+		panic(fmt.Errorf("field remaining_runs of message multisig.v1.MsgCancelMultisigScheduleResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigScheduleResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCancelMultisigScheduleResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCancelMultisigScheduleResponse.remaining_runs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigScheduleResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCancelMultisigScheduleResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.MsgCancelMultisigScheduleResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCancelMultisigScheduleResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelMultisigScheduleResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCancelMultisigScheduleResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCancelMultisigScheduleResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCancelMultisigScheduleResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.RemainingRuns != 0 {
+			n += 1 + runtime.Sov(uint64(x.RemainingRuns))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelMultisigScheduleResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RemainingRuns != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RemainingRuns))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelMultisigScheduleResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelMultisigScheduleResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelMultisigScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemainingRuns", wireType)
+				}
+				x.RemainingRuns = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RemainingRuns |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgFreezeMultisigAccount                  protoreflect.MessageDescriptor
+	fd_MsgFreezeMultisigAccount_authority        protoreflect.FieldDescriptor
+	fd_MsgFreezeMultisigAccount_multisig_address protoreflect.FieldDescriptor
+	fd_MsgFreezeMultisigAccount_reason           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_multisig_v1_tx_proto_init()
+	md_MsgFreezeMultisigAccount = File_multisig_v1_tx_proto.Messages().ByName("MsgFreezeMultisigAccount")
+	fd_MsgFreezeMultisigAccount_authority = md_MsgFreezeMultisigAccount.Fields().ByName("authority")
+	fd_MsgFreezeMultisigAccount_multisig_address = md_MsgFreezeMultisigAccount.Fields().ByName("multisig_address")
+	fd_MsgFreezeMultisigAccount_reason = md_MsgFreezeMultisigAccount.Fields().ByName("reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgFreezeMultisigAccount)(nil)
+
+type fastReflection_MsgFreezeMultisigAccount MsgFreezeMultisigAccount
+
+func (x *MsgFreezeMultisigAccount) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgFreezeMultisigAccount)(x)
+}
+
+func (x *MsgFreezeMultisigAccount) slowProtoReflect() protoreflect.Message {
+	mi := &file_multisig_v1_tx_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgFreezeMultisigAccount_messageType fastReflection_MsgFreezeMultisigAccount_messageType
+var _ protoreflect.MessageType = fastReflection_MsgFreezeMultisigAccount_messageType{}
+
+type fastReflection_MsgFreezeMultisigAccount_messageType struct{}
+
+func (x fastReflection_MsgFreezeMultisigAccount_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgFreezeMultisigAccount)(nil)
+}
+func (x fastReflection_MsgFreezeMultisigAccount_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgFreezeMultisigAccount)
+}
+func (x fastReflection_MsgFreezeMultisigAccount_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgFreezeMultisigAccount
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgFreezeMultisigAccount) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgFreezeMultisigAccount
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgFreezeMultisigAccount) Type() protoreflect.MessageType {
+	return _fastReflection_MsgFreezeMultisigAccount_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgFreezeMultisigAccount) New() protoreflect.Message {
+	return new(fastReflection_MsgFreezeMultisigAccount)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgFreezeMultisigAccount) Interface() protoreflect.ProtoMessage {
+	return (*MsgFreezeMultisigAccount)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgFreezeMultisigAccount) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgFreezeMultisigAccount_authority, value) {
+			return
+		}
+	}
+	if x.MultisigAddress != "" {
+		value := protoreflect.ValueOfString(x.MultisigAddress)
+		if !f(fd_MsgFreezeMultisigAccount_multisig_address, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_MsgFreezeMultisigAccount_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgFreezeMultisigAccount) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "multisig.v1.MsgFreezeMultisigAccount.authority":
+		return x.Authority != ""
+	case "multisig.v1.MsgFreezeMultisigAccount.multisig_address":
+		return x.MultisigAddress != ""
+	case "multisig.v1.MsgFreezeMultisigAccount.reason":
+		return x.Reason != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgFreezeMultisigAccount"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgFreezeMultisigAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFreezeMultisigAccount) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "multisig.v1.MsgFreezeMultisigAccount.authority":
+		x.Authority = ""
+	case "multisig.v1.MsgFreezeMultisigAccount.multisig_address":
+		x.MultisigAddress = ""
+	case "multisig.v1.MsgFreezeMultisigAccount.reason":
+		x.Reason = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgFreezeMultisigAccount"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgFreezeMultisigAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgFreezeMultisigAccount) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "multisig.v1.MsgFreezeMultisigAccount.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "multisig.v1.MsgFreezeMultisigAccount.multisig_address":
+		value := x.MultisigAddress
+		return protoreflect.ValueOfString(value)
+	case "multisig.v1.MsgFreezeMultisigAccount.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgFreezeMultisigAccount"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgFreezeMultisigAccount does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFreezeMultisigAccount) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "multisig.v1.MsgFreezeMultisigAccount.authority":
+		x.Authority = value.Interface().(string)
+	case "multisig.v1.MsgFreezeMultisigAccount.multisig_address":
+		x.MultisigAddress = value.Interface().(string)
+	case "multisig.v1.MsgFreezeMultisigAccount.reason":
+		x.Reason = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgFreezeMultisigAccount"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgFreezeMultisigAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFreezeMultisigAccount) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.MsgFreezeMultisigAccount.authority":
+		panic(fmt.Errorf("field authority of message multisig.v1.MsgFreezeMultisigAccount is not mutable"))
+	case "multisig.v1.MsgFreezeMultisigAccount.multisig_address":
+		panic(fmt.Errorf("field multisig_address of message multisig.v1.MsgFreezeMultisigAccount is not mutable"))
+	case "multisig.v1.MsgFreezeMultisigAccount.reason":
+		panic(fmt.Errorf("field reason of message multisig.v1.MsgFreezeMultisigAccount is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgFreezeMultisigAccount"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgFreezeMultisigAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgFreezeMultisigAccount) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.MsgFreezeMultisigAccount.authority":
+		return protoreflect.ValueOfString("")
+	case "multisig.v1.MsgFreezeMultisigAccount.multisig_address":
+		return protoreflect.ValueOfString("")
+	case "multisig.v1.MsgFreezeMultisigAccount.reason":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgFreezeMultisigAccount"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgFreezeMultisigAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgFreezeMultisigAccount) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.MsgFreezeMultisigAccount", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgFreezeMultisigAccount) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFreezeMultisigAccount) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgFreezeMultisigAccount) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgFreezeMultisigAccount) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgFreezeMultisigAccount)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MultisigAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgFreezeMultisigAccount)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.MultisigAddress) > 0 {
+			i -= len(x.MultisigAddress)
+			copy(dAtA[i:], x.MultisigAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MultisigAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgFreezeMultisigAccount)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFreezeMultisigAccount: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFreezeMultisigAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MultisigAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgFreezeMultisigAccountResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_multisig_v1_tx_proto_init()
+	md_MsgFreezeMultisigAccountResponse = File_multisig_v1_tx_proto.Messages().ByName("MsgFreezeMultisigAccountResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgFreezeMultisigAccountResponse)(nil)
+
+type fastReflection_MsgFreezeMultisigAccountResponse MsgFreezeMultisigAccountResponse
+
+func (x *MsgFreezeMultisigAccountResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgFreezeMultisigAccountResponse)(x)
+}
+
+func (x *MsgFreezeMultisigAccountResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_multisig_v1_tx_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgFreezeMultisigAccountResponse_messageType fastReflection_MsgFreezeMultisigAccountResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgFreezeMultisigAccountResponse_messageType{}
+
+type fastReflection_MsgFreezeMultisigAccountResponse_messageType struct{}
+
+func (x fastReflection_MsgFreezeMultisigAccountResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgFreezeMultisigAccountResponse)(nil)
+}
+func (x fastReflection_MsgFreezeMultisigAccountResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgFreezeMultisigAccountResponse)
+}
+func (x fastReflection_MsgFreezeMultisigAccountResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgFreezeMultisigAccountResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgFreezeMultisigAccountResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgFreezeMultisigAccountResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgFreezeMultisigAccountResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgFreezeMultisigAccountResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgFreezeMultisigAccountResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgFreezeMultisigAccountResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgFreezeMultisigAccountResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgFreezeMultisigAccountResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgFreezeMultisigAccountResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgFreezeMultisigAccountResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgFreezeMultisigAccountResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgFreezeMultisigAccountResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFreezeMultisigAccountResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgFreezeMultisigAccountResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgFreezeMultisigAccountResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgFreezeMultisigAccountResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgFreezeMultisigAccountResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgFreezeMultisigAccountResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFreezeMultisigAccountResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgFreezeMultisigAccountResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgFreezeMultisigAccountResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFreezeMultisigAccountResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgFreezeMultisigAccountResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgFreezeMultisigAccountResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgFreezeMultisigAccountResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgFreezeMultisigAccountResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgFreezeMultisigAccountResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgFreezeMultisigAccountResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.MsgFreezeMultisigAccountResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgFreezeMultisigAccountResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFreezeMultisigAccountResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgFreezeMultisigAccountResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgFreezeMultisigAccountResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgFreezeMultisigAccountResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgFreezeMultisigAccountResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgFreezeMultisigAccountResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFreezeMultisigAccountResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFreezeMultisigAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgRecoverMultisigAccount_3_list)(nil)
+
+type _MsgRecoverMultisigAccount_3_list struct {
+	list *[]string
+}
+
+func (x *_MsgRecoverMultisigAccount_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgRecoverMultisigAccount_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgRecoverMultisigAccount_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgRecoverMultisigAccount_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgRecoverMultisigAccount_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgRecoverMultisigAccount at list field Signers as it is not of Message kind"))
+}
+
+func (x *_MsgRecoverMultisigAccount_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgRecoverMultisigAccount_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgRecoverMultisigAccount_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgRecoverMultisigAccount                      protoreflect.MessageDescriptor
+	fd_MsgRecoverMultisigAccount_authority            protoreflect.FieldDescriptor
+	fd_MsgRecoverMultisigAccount_multisig_address     protoreflect.FieldDescriptor
+	fd_MsgRecoverMultisigAccount_signers              protoreflect.FieldDescriptor
+	fd_MsgRecoverMultisigAccount_threshold            protoreflect.FieldDescriptor
+	fd_MsgRecoverMultisigAccount_threshold_percentage protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_multisig_v1_tx_proto_init()
+	md_MsgRecoverMultisigAccount = File_multisig_v1_tx_proto.Messages().ByName("MsgRecoverMultisigAccount")
+	fd_MsgRecoverMultisigAccount_authority = md_MsgRecoverMultisigAccount.Fields().ByName("authority")
+	fd_MsgRecoverMultisigAccount_multisig_address = md_MsgRecoverMultisigAccount.Fields().ByName("multisig_address")
+	fd_MsgRecoverMultisigAccount_signers = md_MsgRecoverMultisigAccount.Fields().ByName("signers")
+	fd_MsgRecoverMultisigAccount_threshold = md_MsgRecoverMultisigAccount.Fields().ByName("threshold")
+	fd_MsgRecoverMultisigAccount_threshold_percentage = md_MsgRecoverMultisigAccount.Fields().ByName("threshold_percentage")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRecoverMultisigAccount)(nil)
+
+type fastReflection_MsgRecoverMultisigAccount MsgRecoverMultisigAccount
+
+func (x *MsgRecoverMultisigAccount) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRecoverMultisigAccount)(x)
+}
+
+func (x *MsgRecoverMultisigAccount) slowProtoReflect() protoreflect.Message {
+	mi := &file_multisig_v1_tx_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRecoverMultisigAccount_messageType fastReflection_MsgRecoverMultisigAccount_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRecoverMultisigAccount_messageType{}
+
+type fastReflection_MsgRecoverMultisigAccount_messageType struct{}
+
+func (x fastReflection_MsgRecoverMultisigAccount_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRecoverMultisigAccount)(nil)
+}
+func (x fastReflection_MsgRecoverMultisigAccount_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRecoverMultisigAccount)
+}
+func (x fastReflection_MsgRecoverMultisigAccount_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRecoverMultisigAccount
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRecoverMultisigAccount) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRecoverMultisigAccount
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRecoverMultisigAccount) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRecoverMultisigAccount_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRecoverMultisigAccount) New() protoreflect.Message {
+	return new(fastReflection_MsgRecoverMultisigAccount)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRecoverMultisigAccount) Interface() protoreflect.ProtoMessage {
+	return (*MsgRecoverMultisigAccount)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRecoverMultisigAccount) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgRecoverMultisigAccount_authority, value) {
+			return
+		}
+	}
+	if x.MultisigAddress != "" {
+		value := protoreflect.ValueOfString(x.MultisigAddress)
+		if !f(fd_MsgRecoverMultisigAccount_multisig_address, value) {
+			return
+		}
+	}
+	if len(x.Signers) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRecoverMultisigAccount_3_list{list: &x.Signers})
+		if !f(fd_MsgRecoverMultisigAccount_signers, value) {
+			return
+		}
+	}
+	if x.Threshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Threshold)
+		if !f(fd_MsgRecoverMultisigAccount_threshold, value) {
+			return
+		}
+	}
+	if x.ThresholdPercentage != "" {
+		value := protoreflect.ValueOfString(x.ThresholdPercentage)
+		if !f(fd_MsgRecoverMultisigAccount_threshold_percentage, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRecoverMultisigAccount) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "multisig.v1.MsgRecoverMultisigAccount.authority":
+		return x.Authority != ""
+	case "multisig.v1.MsgRecoverMultisigAccount.multisig_address":
+		return x.MultisigAddress != ""
+	case "multisig.v1.MsgRecoverMultisigAccount.signers":
+		return len(x.Signers) != 0
+	case "multisig.v1.MsgRecoverMultisigAccount.threshold":
+		return x.Threshold != uint32(0)
+	case "multisig.v1.MsgRecoverMultisigAccount.threshold_percentage":
+		return x.ThresholdPercentage != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgRecoverMultisigAccount"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgRecoverMultisigAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRecoverMultisigAccount) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "multisig.v1.MsgRecoverMultisigAccount.authority":
+		x.Authority = ""
+	case "multisig.v1.MsgRecoverMultisigAccount.multisig_address":
+		x.MultisigAddress = ""
+	case "multisig.v1.MsgRecoverMultisigAccount.signers":
+		x.Signers = nil
+	case "multisig.v1.MsgRecoverMultisigAccount.threshold":
+		x.Threshold = uint32(0)
+	case "multisig.v1.MsgRecoverMultisigAccount.threshold_percentage":
+		x.ThresholdPercentage = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgRecoverMultisigAccount"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgRecoverMultisigAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRecoverMultisigAccount) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "multisig.v1.MsgRecoverMultisigAccount.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "multisig.v1.MsgRecoverMultisigAccount.multisig_address":
+		value := x.MultisigAddress
+		return protoreflect.ValueOfString(value)
+	case "multisig.v1.MsgRecoverMultisigAccount.signers":
+		if len(x.Signers) == 0 {
+			return protoreflect.ValueOfList(&_MsgRecoverMultisigAccount_3_list{})
+		}
+		listValue := &_MsgRecoverMultisigAccount_3_list{list: &x.Signers}
+		return protoreflect.ValueOfList(listValue)
+	case "multisig.v1.MsgRecoverMultisigAccount.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfUint32(value)
+	case "multisig.v1.MsgRecoverMultisigAccount.threshold_percentage":
+		value := x.ThresholdPercentage
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgRecoverMultisigAccount"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgRecoverMultisigAccount does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRecoverMultisigAccount) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "multisig.v1.MsgRecoverMultisigAccount.authority":
+		x.Authority = value.Interface().(string)
+	case "multisig.v1.MsgRecoverMultisigAccount.multisig_address":
+		x.MultisigAddress = value.Interface().(string)
+	case "multisig.v1.MsgRecoverMultisigAccount.signers":
+		lv := value.List()
+		clv := lv.(*_MsgRecoverMultisigAccount_3_list)
+		x.Signers = *clv.list
+	case "multisig.v1.MsgRecoverMultisigAccount.threshold":
+		x.Threshold = uint32(value.Uint())
+	case "multisig.v1.MsgRecoverMultisigAccount.threshold_percentage":
+		x.ThresholdPercentage = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgRecoverMultisigAccount"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgRecoverMultisigAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRecoverMultisigAccount) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.MsgRecoverMultisigAccount.signers":
+		if x.Signers == nil {
+			x.Signers = []string{}
+		}
+		value := &_MsgRecoverMultisigAccount_3_list{list: &x.Signers}
+		return protoreflect.ValueOfList(value)
+	case "multisig.v1.MsgRecoverMultisigAccount.authority":
+		panic(fmt.Errorf("field authority of message multisig.v1.MsgRecoverMultisigAccount is not mutable"))
+	case "multisig.v1.MsgRecoverMultisigAccount.multisig_address":
+		panic(fmt.Errorf("field multisig_address of message multisig.v1.MsgRecoverMultisigAccount is not mutable"))
+	case "multisig.v1.MsgRecoverMultisigAccount.threshold":
+		panic(fmt.Errorf("field threshold of message multisig.v1.MsgRecoverMultisigAccount is not mutable"))
+	case "multisig.v1.MsgRecoverMultisigAccount.threshold_percentage":
+		panic(fmt.Errorf("field threshold_percentage of message multisig.v1.MsgRecoverMultisigAccount is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgRecoverMultisigAccount"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgRecoverMultisigAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRecoverMultisigAccount) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.MsgRecoverMultisigAccount.authority":
+		return protoreflect.ValueOfString("")
+	case "multisig.v1.MsgRecoverMultisigAccount.multisig_address":
+		return protoreflect.ValueOfString("")
+	case "multisig.v1.MsgRecoverMultisigAccount.signers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgRecoverMultisigAccount_3_list{list: &list})
+	case "multisig.v1.MsgRecoverMultisigAccount.threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "multisig.v1.MsgRecoverMultisigAccount.threshold_percentage":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgRecoverMultisigAccount"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgRecoverMultisigAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRecoverMultisigAccount) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.MsgRecoverMultisigAccount", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRecoverMultisigAccount) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRecoverMultisigAccount) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRecoverMultisigAccount) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRecoverMultisigAccount) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRecoverMultisigAccount)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MultisigAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Signers) > 0 {
+			for _, s := range x.Signers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Threshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.Threshold))
+		}
+		l = len(x.ThresholdPercentage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRecoverMultisigAccount)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ThresholdPercentage) > 0 {
+			i -= len(x.ThresholdPercentage)
+			copy(dAtA[i:], x.ThresholdPercentage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThresholdPercentage)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Threshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Threshold))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Signers) > 0 {
+			for iNdEx := len(x.Signers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Signers[iNdEx])
+				copy(dAtA[i:], x.Signers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signers[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.MultisigAddress) > 0 {
+			i -= len(x.MultisigAddress)
+			copy(dAtA[i:], x.MultisigAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MultisigAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRecoverMultisigAccount)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRecoverMultisigAccount: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRecoverMultisigAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MultisigAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signers = append(x.Signers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				x.Threshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Threshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThresholdPercentage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ThresholdPercentage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRecoverMultisigAccountResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_multisig_v1_tx_proto_init()
+	md_MsgRecoverMultisigAccountResponse = File_multisig_v1_tx_proto.Messages().ByName("MsgRecoverMultisigAccountResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRecoverMultisigAccountResponse)(nil)
+
+type fastReflection_MsgRecoverMultisigAccountResponse MsgRecoverMultisigAccountResponse
+
+func (x *MsgRecoverMultisigAccountResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRecoverMultisigAccountResponse)(x)
+}
+
+func (x *MsgRecoverMultisigAccountResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_multisig_v1_tx_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRecoverMultisigAccountResponse_messageType fastReflection_MsgRecoverMultisigAccountResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRecoverMultisigAccountResponse_messageType{}
+
+type fastReflection_MsgRecoverMultisigAccountResponse_messageType struct{}
+
+func (x fastReflection_MsgRecoverMultisigAccountResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRecoverMultisigAccountResponse)(nil)
+}
+func (x fastReflection_MsgRecoverMultisigAccountResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRecoverMultisigAccountResponse)
+}
+func (x fastReflection_MsgRecoverMultisigAccountResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRecoverMultisigAccountResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRecoverMultisigAccountResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRecoverMultisigAccountResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRecoverMultisigAccountResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRecoverMultisigAccountResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRecoverMultisigAccountResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRecoverMultisigAccountResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRecoverMultisigAccountResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRecoverMultisigAccountResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRecoverMultisigAccountResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRecoverMultisigAccountResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgRecoverMultisigAccountResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgRecoverMultisigAccountResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRecoverMultisigAccountResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgRecoverMultisigAccountResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgRecoverMultisigAccountResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRecoverMultisigAccountResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgRecoverMultisigAccountResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgRecoverMultisigAccountResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRecoverMultisigAccountResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgRecoverMultisigAccountResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgRecoverMultisigAccountResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRecoverMultisigAccountResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgRecoverMultisigAccountResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgRecoverMultisigAccountResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRecoverMultisigAccountResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgRecoverMultisigAccountResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgRecoverMultisigAccountResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRecoverMultisigAccountResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.MsgRecoverMultisigAccountResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRecoverMultisigAccountResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRecoverMultisigAccountResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRecoverMultisigAccountResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRecoverMultisigAccountResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRecoverMultisigAccountResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRecoverMultisigAccountResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRecoverMultisigAccountResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRecoverMultisigAccountResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRecoverMultisigAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCancelMultisigProposalParams                  protoreflect.MessageDescriptor
+	fd_MsgCancelMultisigProposalParams_multisig_address protoreflect.FieldDescriptor
+	fd_MsgCancelMultisigProposalParams_proposal_id      protoreflect.FieldDescriptor
+	fd_MsgCancelMultisigProposalParams_rejecter         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_multisig_v1_tx_proto_init()
+	md_MsgCancelMultisigProposalParams = File_multisig_v1_tx_proto.Messages().ByName("MsgCancelMultisigProposalParams")
+	fd_MsgCancelMultisigProposalParams_multisig_address = md_MsgCancelMultisigProposalParams.Fields().ByName("multisig_address")
+	fd_MsgCancelMultisigProposalParams_proposal_id = md_MsgCancelMultisigProposalParams.Fields().ByName("proposal_id")
+	fd_MsgCancelMultisigProposalParams_rejecter = md_MsgCancelMultisigProposalParams.Fields().ByName("rejecter")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCancelMultisigProposalParams)(nil)
+
+type fastReflection_MsgCancelMultisigProposalParams MsgCancelMultisigProposalParams
+
+func (x *MsgCancelMultisigProposalParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCancelMultisigProposalParams)(x)
+}
+
+func (x *MsgCancelMultisigProposalParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_multisig_v1_tx_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCancelMultisigProposalParams_messageType fastReflection_MsgCancelMultisigProposalParams_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCancelMultisigProposalParams_messageType{}
+
+type fastReflection_MsgCancelMultisigProposalParams_messageType struct{}
+
+func (x fastReflection_MsgCancelMultisigProposalParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCancelMultisigProposalParams)(nil)
+}
+func (x fastReflection_MsgCancelMultisigProposalParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelMultisigProposalParams)
+}
+func (x fastReflection_MsgCancelMultisigProposalParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelMultisigProposalParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCancelMultisigProposalParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelMultisigProposalParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCancelMultisigProposalParams) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCancelMultisigProposalParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCancelMultisigProposalParams) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelMultisigProposalParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCancelMultisigProposalParams) Interface() protoreflect.ProtoMessage {
+	return (*MsgCancelMultisigProposalParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCancelMultisigProposalParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MultisigAddress != "" {
+		value := protoreflect.ValueOfString(x.MultisigAddress)
+		if !f(fd_MsgCancelMultisigProposalParams_multisig_address, value) {
+			return
+		}
+	}
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_MsgCancelMultisigProposalParams_proposal_id, value) {
+			return
+		}
+	}
+	if x.Rejecter != "" {
+		value := protoreflect.ValueOfString(x.Rejecter)
+		if !f(fd_MsgCancelMultisigProposalParams_rejecter, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCancelMultisigProposalParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCancelMultisigProposalParams.multisig_address":
+		return x.MultisigAddress != ""
+	case "multisig.v1.MsgCancelMultisigProposalParams.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "multisig.v1.MsgCancelMultisigProposalParams.rejecter":
+		return x.Rejecter != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigProposalParams"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigProposalParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelMultisigProposalParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCancelMultisigProposalParams.multisig_address":
+		x.MultisigAddress = ""
+	case "multisig.v1.MsgCancelMultisigProposalParams.proposal_id":
+		x.ProposalId = uint64(0)
+	case "multisig.v1.MsgCancelMultisigProposalParams.rejecter":
+		x.Rejecter = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigProposalParams"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigProposalParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCancelMultisigProposalParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "multisig.v1.MsgCancelMultisigProposalParams.multisig_address":
+		value := x.MultisigAddress
+		return protoreflect.ValueOfString(value)
+	case "multisig.v1.MsgCancelMultisigProposalParams.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "multisig.v1.MsgCancelMultisigProposalParams.rejecter":
+		value := x.Rejecter
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigProposalParams"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigProposalParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelMultisigProposalParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCancelMultisigProposalParams.multisig_address":
+		x.MultisigAddress = value.Interface().(string)
+	case "multisig.v1.MsgCancelMultisigProposalParams.proposal_id":
+		x.ProposalId = value.Uint()
+	case "multisig.v1.MsgCancelMultisigProposalParams.rejecter":
+		x.Rejecter = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigProposalParams"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigProposalParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelMultisigProposalParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCancelMultisigProposalParams.multisig_address":
+		panic(fmt.Errorf("field multisig_address of message multisig.v1.MsgCancelMultisigProposalParams is not mutable"))
+	case "multisig.v1.MsgCancelMultisigProposalParams.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message multisig.v1.MsgCancelMultisigProposalParams is not mutable"))
+	case "multisig.v1.MsgCancelMultisigProposalParams.rejecter":
+		panic(fmt.Errorf("field rejecter of message multisig.v1.MsgCancelMultisigProposalParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigProposalParams"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigProposalParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCancelMultisigProposalParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCancelMultisigProposalParams.multisig_address":
+		return protoreflect.ValueOfString("")
+	case "multisig.v1.MsgCancelMultisigProposalParams.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "multisig.v1.MsgCancelMultisigProposalParams.rejecter":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigProposalParams"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigProposalParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCancelMultisigProposalParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.MsgCancelMultisigProposalParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCancelMultisigProposalParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelMultisigProposalParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCancelMultisigProposalParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCancelMultisigProposalParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCancelMultisigProposalParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MultisigAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		l = len(x.Rejecter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelMultisigProposalParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Rejecter) > 0 {
+			i -= len(x.Rejecter)
+			copy(dAtA[i:], x.Rejecter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Rejecter)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.MultisigAddress) > 0 {
+			i -= len(x.MultisigAddress)
+			copy(dAtA[i:], x.MultisigAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MultisigAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelMultisigProposalParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelMultisigProposalParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelMultisigProposalParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MultisigAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rejecter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Rejecter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCancelMultisigProposalResponse          protoreflect.MessageDescriptor
+	fd_MsgCancelMultisigProposalResponse_rejected protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_multisig_v1_tx_proto_init()
+	md_MsgCancelMultisigProposalResponse = File_multisig_v1_tx_proto.Messages().ByName("MsgCancelMultisigProposalResponse")
+	fd_MsgCancelMultisigProposalResponse_rejected = md_MsgCancelMultisigProposalResponse.Fields().ByName("rejected")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCancelMultisigProposalResponse)(nil)
+
+type fastReflection_MsgCancelMultisigProposalResponse MsgCancelMultisigProposalResponse
+
+func (x *MsgCancelMultisigProposalResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCancelMultisigProposalResponse)(x)
+}
+
+func (x *MsgCancelMultisigProposalResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_multisig_v1_tx_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCancelMultisigProposalResponse_messageType fastReflection_MsgCancelMultisigProposalResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCancelMultisigProposalResponse_messageType{}
+
+type fastReflection_MsgCancelMultisigProposalResponse_messageType struct{}
+
+func (x fastReflection_MsgCancelMultisigProposalResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCancelMultisigProposalResponse)(nil)
+}
+func (x fastReflection_MsgCancelMultisigProposalResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelMultisigProposalResponse)
+}
+func (x fastReflection_MsgCancelMultisigProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelMultisigProposalResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCancelMultisigProposalResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelMultisigProposalResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCancelMultisigProposalResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCancelMultisigProposalResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCancelMultisigProposalResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelMultisigProposalResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCancelMultisigProposalResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCancelMultisigProposalResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCancelMultisigProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Rejected != false {
+		value := protoreflect.ValueOfBool(x.Rejected)
+		if !f(fd_MsgCancelMultisigProposalResponse_rejected, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCancelMultisigProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCancelMultisigProposalResponse.rejected":
+		return x.Rejected != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigProposalResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelMultisigProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCancelMultisigProposalResponse.rejected":
+		x.Rejected = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigProposalResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCancelMultisigProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "multisig.v1.MsgCancelMultisigProposalResponse.rejected":
+		value := x.Rejected
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigProposalResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigProposalResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelMultisigProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCancelMultisigProposalResponse.rejected":
+		x.Rejected = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigProposalResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelMultisigProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCancelMultisigProposalResponse.rejected":
+		panic(fmt.Errorf("field rejected of message multisig.v1.MsgCancelMultisigProposalResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigProposalResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCancelMultisigProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCancelMultisigProposalResponse.rejected":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCancelMultisigProposalResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCancelMultisigProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCancelMultisigProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.MsgCancelMultisigProposalResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCancelMultisigProposalResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelMultisigProposalResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCancelMultisigProposalResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCancelMultisigProposalResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCancelMultisigProposalResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Rejected {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelMultisigProposalResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Rejected {
+			i--
+			if x.Rejected {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelMultisigProposalResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelMultisigProposalResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelMultisigProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rejected", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Rejected = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCleanupMultisigProposalParams                  protoreflect.MessageDescriptor
+	fd_MsgCleanupMultisigProposalParams_multisig_address protoreflect.FieldDescriptor
+	fd_MsgCleanupMultisigProposalParams_proposal_id      protoreflect.FieldDescriptor
+	fd_MsgCleanupMultisigProposalParams_remover          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_multisig_v1_tx_proto_init()
+	md_MsgCleanupMultisigProposalParams = File_multisig_v1_tx_proto.Messages().ByName("MsgCleanupMultisigProposalParams")
+	fd_MsgCleanupMultisigProposalParams_multisig_address = md_MsgCleanupMultisigProposalParams.Fields().ByName("multisig_address")
+	fd_MsgCleanupMultisigProposalParams_proposal_id = md_MsgCleanupMultisigProposalParams.Fields().ByName("proposal_id")
+	fd_MsgCleanupMultisigProposalParams_remover = md_MsgCleanupMultisigProposalParams.Fields().ByName("remover")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCleanupMultisigProposalParams)(nil)
+
+type fastReflection_MsgCleanupMultisigProposalParams MsgCleanupMultisigProposalParams
+
+func (x *MsgCleanupMultisigProposalParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCleanupMultisigProposalParams)(x)
+}
+
+func (x *MsgCleanupMultisigProposalParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_multisig_v1_tx_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCleanupMultisigProposalParams_messageType fastReflection_MsgCleanupMultisigProposalParams_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCleanupMultisigProposalParams_messageType{}
+
+type fastReflection_MsgCleanupMultisigProposalParams_messageType struct{}
+
+func (x fastReflection_MsgCleanupMultisigProposalParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCleanupMultisigProposalParams)(nil)
+}
+func (x fastReflection_MsgCleanupMultisigProposalParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCleanupMultisigProposalParams)
+}
+func (x fastReflection_MsgCleanupMultisigProposalParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCleanupMultisigProposalParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCleanupMultisigProposalParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCleanupMultisigProposalParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCleanupMultisigProposalParams) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCleanupMultisigProposalParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCleanupMultisigProposalParams) New() protoreflect.Message {
+	return new(fastReflection_MsgCleanupMultisigProposalParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCleanupMultisigProposalParams) Interface() protoreflect.ProtoMessage {
+	return (*MsgCleanupMultisigProposalParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCleanupMultisigProposalParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MultisigAddress != "" {
+		value := protoreflect.ValueOfString(x.MultisigAddress)
+		if !f(fd_MsgCleanupMultisigProposalParams_multisig_address, value) {
+			return
+		}
+	}
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_MsgCleanupMultisigProposalParams_proposal_id, value) {
+			return
+		}
+	}
+	if x.Remover != "" {
+		value := protoreflect.ValueOfString(x.Remover)
+		if !f(fd_MsgCleanupMultisigProposalParams_remover, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCleanupMultisigProposalParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCleanupMultisigProposalParams.multisig_address":
+		return x.MultisigAddress != ""
+	case "multisig.v1.MsgCleanupMultisigProposalParams.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "multisig.v1.MsgCleanupMultisigProposalParams.remover":
+		return x.Remover != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCleanupMultisigProposalParams"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCleanupMultisigProposalParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCleanupMultisigProposalParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCleanupMultisigProposalParams.multisig_address":
+		x.MultisigAddress = ""
+	case "multisig.v1.MsgCleanupMultisigProposalParams.proposal_id":
+		x.ProposalId = uint64(0)
+	case "multisig.v1.MsgCleanupMultisigProposalParams.remover":
+		x.Remover = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCleanupMultisigProposalParams"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCleanupMultisigProposalParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCleanupMultisigProposalParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "multisig.v1.MsgCleanupMultisigProposalParams.multisig_address":
+		value := x.MultisigAddress
+		return protoreflect.ValueOfString(value)
+	case "multisig.v1.MsgCleanupMultisigProposalParams.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "multisig.v1.MsgCleanupMultisigProposalParams.remover":
+		value := x.Remover
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCleanupMultisigProposalParams"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCleanupMultisigProposalParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCleanupMultisigProposalParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCleanupMultisigProposalParams.multisig_address":
+		x.MultisigAddress = value.Interface().(string)
+	case "multisig.v1.MsgCleanupMultisigProposalParams.proposal_id":
+		x.ProposalId = value.Uint()
+	case "multisig.v1.MsgCleanupMultisigProposalParams.remover":
+		x.Remover = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCleanupMultisigProposalParams"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCleanupMultisigProposalParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCleanupMultisigProposalParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCleanupMultisigProposalParams.multisig_address":
+		panic(fmt.Errorf("field multisig_address of message multisig.v1.MsgCleanupMultisigProposalParams is not mutable"))
+	case "multisig.v1.MsgCleanupMultisigProposalParams.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message multisig.v1.MsgCleanupMultisigProposalParams is not mutable"))
+	case "multisig.v1.MsgCleanupMultisigProposalParams.remover":
+		panic(fmt.Errorf("field remover of message multisig.v1.MsgCleanupMultisigProposalParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCleanupMultisigProposalParams"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCleanupMultisigProposalParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCleanupMultisigProposalParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "multisig.v1.MsgCleanupMultisigProposalParams.multisig_address":
+		return protoreflect.ValueOfString("")
+	case "multisig.v1.MsgCleanupMultisigProposalParams.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "multisig.v1.MsgCleanupMultisigProposalParams.remover":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCleanupMultisigProposalParams"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCleanupMultisigProposalParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCleanupMultisigProposalParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.MsgCleanupMultisigProposalParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCleanupMultisigProposalParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCleanupMultisigProposalParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCleanupMultisigProposalParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCleanupMultisigProposalParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCleanupMultisigProposalParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MultisigAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		l = len(x.Remover)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCleanupMultisigProposalParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Remover) > 0 {
+			i -= len(x.Remover)
+			copy(dAtA[i:], x.Remover)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Remover)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.MultisigAddress) > 0 {
+			i -= len(x.MultisigAddress)
+			copy(dAtA[i:], x.MultisigAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MultisigAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCleanupMultisigProposalParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCleanupMultisigProposalParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCleanupMultisigProposalParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MultisigAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Remover", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Remover = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCleanupMultisigProposalResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_multisig_v1_tx_proto_init()
+	md_MsgCleanupMultisigProposalResponse = File_multisig_v1_tx_proto.Messages().ByName("MsgCleanupMultisigProposalResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCleanupMultisigProposalResponse)(nil)
+
+type fastReflection_MsgCleanupMultisigProposalResponse MsgCleanupMultisigProposalResponse
+
+func (x *MsgCleanupMultisigProposalResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCleanupMultisigProposalResponse)(x)
+}
+
+func (x *MsgCleanupMultisigProposalResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_multisig_v1_tx_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCleanupMultisigProposalResponse_messageType fastReflection_MsgCleanupMultisigProposalResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCleanupMultisigProposalResponse_messageType{}
+
+type fastReflection_MsgCleanupMultisigProposalResponse_messageType struct{}
+
+func (x fastReflection_MsgCleanupMultisigProposalResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCleanupMultisigProposalResponse)(nil)
+}
+func (x fastReflection_MsgCleanupMultisigProposalResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCleanupMultisigProposalResponse)
+}
+func (x fastReflection_MsgCleanupMultisigProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCleanupMultisigProposalResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCleanupMultisigProposalResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCleanupMultisigProposalResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCleanupMultisigProposalResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCleanupMultisigProposalResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCleanupMultisigProposalResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCleanupMultisigProposalResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCleanupMultisigProposalResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCleanupMultisigProposalResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCleanupMultisigProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCleanupMultisigProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCleanupMultisigProposalResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCleanupMultisigProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCleanupMultisigProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCleanupMultisigProposalResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCleanupMultisigProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCleanupMultisigProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCleanupMultisigProposalResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCleanupMultisigProposalResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCleanupMultisigProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCleanupMultisigProposalResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCleanupMultisigProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCleanupMultisigProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCleanupMultisigProposalResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCleanupMultisigProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCleanupMultisigProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: multisig.v1.MsgCleanupMultisigProposalResponse"))
+		}
+		panic(fmt.Errorf("message multisig.v1.MsgCleanupMultisigProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCleanupMultisigProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in multisig.v1.MsgCleanupMultisigProposalResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCleanupMultisigProposalResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCleanupMultisigProposalResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCleanupMultisigProposalResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCleanupMultisigProposalResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCleanupMultisigProposalResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCleanupMultisigProposalResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCleanupMultisigProposalResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCleanupMultisigProposalResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCleanupMultisigProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: multisig/v1/tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *MsgUpdateParams) Reset() {
+	*x = MsgUpdateParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_v1_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParams) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgUpdateParams) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateParams) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateParamsResponse) Reset() {
+	*x = MsgUpdateParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_v1_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{1}
+}
+
+// MsgCreateMultisigAccountParams defines the request type to create a multisig account
+type MsgCreateMultisigAccountParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority  string               `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Seed       uint32               `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	Threshold  uint32               `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Signers    [][]byte             `protobuf:"bytes,4,rep,name=signers,proto3" json:"signers,omitempty"`
+	Permission MultisigProposalType `protobuf:"varint,5,opt,name=permission,proto3,enum=multisig.v1.MultisigProposalType" json:"permission,omitempty"`
+	// threshold_percentage is the fraction of the signers required to approve a call, in
+	// place of an absolute threshold. It is a decimal string such as "0.5", not annotated as
+	// cosmos.Dec since amino JSON would encode it as a scaled integer.
+	ThresholdPercentage string `protobuf:"bytes,6,opt,name=threshold_percentage,json=thresholdPercentage,proto3" json:"threshold_percentage,omitempty"`
+}
+
+func (x *MsgCreateMultisigAccountParams) Reset() {
+	*x = MsgCreateMultisigAccountParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_v1_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateMultisigAccountParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateMultisigAccountParams) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateMultisigAccountParams.ProtoReflect.Descriptor instead.
+func (*MsgCreateMultisigAccountParams) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgCreateMultisigAccountParams) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgCreateMultisigAccountParams) GetSeed() uint32 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *MsgCreateMultisigAccountParams) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *MsgCreateMultisigAccountParams) GetSigners() [][]byte {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
+}
+
+func (x *MsgCreateMultisigAccountParams) GetPermission() MultisigProposalType {
+	if x != nil {
+		return x.Permission
+	}
+	return MultisigProposalType_MULTISIG_PROPOSAL_TYPE_UNSPECIFIED
+}
+
+func (x *MsgCreateMultisigAccountParams) GetThresholdPercentage() string {
+	if x != nil {
+		return x.ThresholdPercentage
+	}
+	return ""
+}
+
+// MsgCreateMultisigAccountResponse defines the response structure of a created multisig account operation
+type MsgCreateMultisigAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MultisigAddress string `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+}
+
+func (x *MsgCreateMultisigAccountResponse) Reset() {
+	*x = MsgCreateMultisigAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_v1_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateMultisigAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateMultisigAccountResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateMultisigAccountResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateMultisigAccountResponse) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MsgCreateMultisigAccountResponse) GetMultisigAddress() string {
+	if x != nil {
+		return x.MultisigAddress
+	}
+	return ""
+}
+
+// MsgAddMultisigSignerParams defines the request type to add a signer to a multisig account
+type MsgAddMultisigSignerParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MultisigAddress string `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	Signer          string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	NewThreshold    uint32 `protobuf:"varint,3,opt,name=new_threshold,json=newThreshold,proto3" json:"new_threshold,omitempty"`
+}
+
+func (x *MsgAddMultisigSignerParams) Reset() {
+	*x = MsgAddMultisigSignerParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_v1_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAddMultisigSignerParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAddMultisigSignerParams) ProtoMessage() {}
+
+// Deprecated: Use MsgAddMultisigSignerParams.ProtoReflect.Descriptor instead.
+func (*MsgAddMultisigSignerParams) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgAddMultisigSignerParams) GetMultisigAddress() string {
+	if x != nil {
+		return x.MultisigAddress
+	}
+	return ""
+}
+
+func (x *MsgAddMultisigSignerParams) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *MsgAddMultisigSignerParams) GetNewThreshold() uint32 {
+	if x != nil {
+		return x.NewThreshold
+	}
+	return 0
+}
+
+// MsgAddMultisigSignerResponse defines the response structure of adding a signer to a multisig account
+type MsgAddMultisigSignerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgAddMultisigSignerResponse) Reset() {
+	*x = MsgAddMultisigSignerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_v1_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAddMultisigSignerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAddMultisigSignerResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgAddMultisigSignerResponse.ProtoReflect.Descriptor instead.
+func (*MsgAddMultisigSignerResponse) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{5}
+}
+
+// MsgCleanupMultisigAccountParams defines the request type to remove all proposals linked to a deleted multisig account
+type MsgCleanupMultisigAccountParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MultisigAddress string `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+}
+
+func (x *MsgCleanupMultisigAccountParams) Reset() {
+	*x = MsgCleanupMultisigAccountParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_v1_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCleanupMultisigAccountParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCleanupMultisigAccountParams) ProtoMessage() {}
+
+// Deprecated: Use MsgCleanupMultisigAccountParams.ProtoReflect.Descriptor instead.
+func (*MsgCleanupMultisigAccountParams) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgCleanupMultisigAccountParams) GetMultisigAddress() string {
+	if x != nil {
+		return x.MultisigAddress
+	}
+	return ""
+}
+
+// MsgCleanupMultisigAccountResponse defines the response structure of removing a set of proposals linked to a delted multisig account
+type MsgCleanupMultisigAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgCleanupMultisigAccountResponse) Reset() {
+	*x = MsgCleanupMultisigAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_v1_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCleanupMultisigAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCleanupMultisigAccountResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCleanupMultisigAccountResponse.ProtoReflect.Descriptor instead.
+func (*MsgCleanupMultisigAccountResponse) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{7}
+}
+
+// MsgSetMultisigThresholdParams defines the request type to set the threshold for a multisig account
+type MsgSetMultisigThresholdParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MultisigAddress string `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	Threshold       uint32 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (x *MsgSetMultisigThresholdParams) Reset() {
+	*x = MsgSetMultisigThresholdParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_v1_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetMultisigThresholdParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetMultisigThresholdParams) ProtoMessage() {}
+
+// Deprecated: Use MsgSetMultisigThresholdParams.ProtoReflect.Descriptor instead.
+func (*MsgSetMultisigThresholdParams) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgSetMultisigThresholdParams) GetMultisigAddress() string {
+	if x != nil {
+		return x.MultisigAddress
+	}
+	return ""
+}
+
+func (x *MsgSetMultisigThresholdParams) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+// MsgSetMultisigThresholdResponse defines the response structure of setting a threshold for a multisig account
+type MsgSetMultisigThresholdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSetMultisigThresholdResponse) Reset() {
+	*x = MsgSetMultisigThresholdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_v1_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetMultisigThresholdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetMultisigThresholdResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSetMultisigThresholdResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetMultisigThresholdResponse) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{9}
+}
+
+// MsgInitializeMultisigProposalParams defines the request type to initialize a multisig proposal
+type MsgInitializeMultisigProposalParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MultisigAddress string     `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	Proposer        string     `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Title           string     `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string     `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Message         *anypb.Any `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *MsgInitializeMultisigProposalParams) Reset() {
+	*x = MsgInitializeMultisigProposalParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_v1_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgInitializeMultisigProposalParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgInitializeMultisigProposalParams) ProtoMessage() {}
+
+// Deprecated: Use MsgInitializeMultisigProposalParams.ProtoReflect.Descriptor instead.
+func (*MsgInitializeMultisigProposalParams) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgInitializeMultisigProposalParams) GetMultisigAddress() string {
+	if x != nil {
+		return x.MultisigAddress
+	}
+	return ""
+}
+
+func (x *MsgInitializeMultisigProposalParams) GetProposer() string {
+	if x != nil {
+		return x.Proposer
+	}
+	return ""
+}
+
+func (x *MsgInitializeMultisigProposalParams) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MsgInitializeMultisigProposalParams) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MsgInitializeMultisigProposalParams) GetMessage() *anypb.Any {
+	if x != nil {
+		return x.Message
 	}
+	return nil
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: multisig/v1/tx.proto
+// MsgInitializeMultisigResponse defines the response structure of initializing a multisig proposal
+type MsgInitializeMultisigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
 
-// MsgUpdateParams is the Msg/UpdateParams request type.
-//
-// Since: cosmos-sdk 0.47
-type MsgUpdateParams struct {
+func (x *MsgInitializeMultisigResponse) Reset() {
+	*x = MsgInitializeMultisigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_v1_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgInitializeMultisigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgInitializeMultisigResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgInitializeMultisigResponse.ProtoReflect.Descriptor instead.
+func (*MsgInitializeMultisigResponse) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MsgInitializeMultisigResponse) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+// MsgApproveMultisigProposalParams defines the request type to approve a multisig proposal
+type MsgApproveMultisigProposalParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// authority is the address of the governance account.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the parameters to update.
-	//
-	// NOTE: All parameters must be supplied.
-	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	MultisigAddress string `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	ProposalId      uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Approver        string `protobuf:"bytes,3,opt,name=approver,proto3" json:"approver,omitempty"`
 }
 
-func (x *MsgUpdateParams) Reset() {
-	*x = MsgUpdateParams{}
+func (x *MsgApproveMultisigProposalParams) Reset() {
+	*x = MsgApproveMultisigProposalParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_v1_tx_proto_msgTypes[0]
+		mi := &file_multisig_v1_tx_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateParams) String() string {
+func (x *MsgApproveMultisigProposalParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateParams) ProtoMessage() {}
+func (*MsgApproveMultisigProposalParams) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use MsgApproveMultisigProposalParams.ProtoReflect.Descriptor instead.
+func (*MsgApproveMultisigProposalParams) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *MsgUpdateParams) GetAuthority() string {
+func (x *MsgApproveMultisigProposalParams) GetMultisigAddress() string {
 	if x != nil {
-		return x.Authority
+		return x.MultisigAddress
 	}
 	return ""
 }
 
-func (x *MsgUpdateParams) GetParams() *Params {
+func (x *MsgApproveMultisigProposalParams) GetProposalId() uint64 {
 	if x != nil {
-		return x.Params
+		return x.ProposalId
 	}
-	return nil
+	return 0
 }
 
-// MsgUpdateParamsResponse defines the response structure for executing a
-// MsgUpdateParams message.
-//
-// Since: cosmos-sdk 0.47
-type MsgUpdateParamsResponse struct {
+func (x *MsgApproveMultisigProposalParams) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+// MsgApproveMultisigProposalResponse defines the response structure of approving a multisig proposal
+type MsgApproveMultisigProposalResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgUpdateParamsResponse) Reset() {
-	*x = MsgUpdateParamsResponse{}
+func (x *MsgApproveMultisigProposalResponse) Reset() {
+	*x = MsgApproveMultisigProposalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_v1_tx_proto_msgTypes[1]
+		mi := &file_multisig_v1_tx_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateParamsResponse) String() string {
+func (x *MsgApproveMultisigProposalResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateParamsResponse) ProtoMessage() {}
+func (*MsgApproveMultisigProposalResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use MsgApproveMultisigProposalResponse.ProtoReflect.Descriptor instead.
+func (*MsgApproveMultisigProposalResponse) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{13}
 }
 
-// MsgCreateMultisigAccountParams defines the request type to create a multisig account
-type MsgCreateMultisigAccountParams struct {
+// MsgApproveMultisigProposalParams defines the request type to approve a multisig proposal
+type MsgApproveAndDispatchMultisigProposalParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authority  string               `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	Seed       uint32               `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`
-	Threshold  uint32               `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	Signers    [][]byte             `protobuf:"bytes,4,rep,name=signers,proto3" json:"signers,omitempty"`
-	Permission MultisigProposalType `protobuf:"varint,5,opt,name=permission,proto3,enum=multisig.v1.MultisigProposalType" json:"permission,omitempty"`
+	MultisigAddress string     `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	ProposalId      uint64     `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Approver        string     `protobuf:"bytes,3,opt,name=approver,proto3" json:"approver,omitempty"`
+	Message         *anypb.Any `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *MsgCreateMultisigAccountParams) Reset() {
-	*x = MsgCreateMultisigAccountParams{}
+func (x *MsgApproveAndDispatchMultisigProposalParams) Reset() {
+	*x = MsgApproveAndDispatchMultisigProposalParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_v1_tx_proto_msgTypes[2]
+		mi := &file_multisig_v1_tx_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateMultisigAccountParams) String() string {
+func (x *MsgApproveAndDispatchMultisigProposalParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateMultisigAccountParams) ProtoMessage() {}
+func (*MsgApproveAndDispatchMultisigProposalParams) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateMultisigAccountParams.ProtoReflect.Descriptor instead.
-func (*MsgCreateMultisigAccountParams) Descriptor() ([]byte, []int) {
-	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgApproveAndDispatchMultisigProposalParams.ProtoReflect.Descriptor instead.
+func (*MsgApproveAndDispatchMultisigProposalParams) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *MsgCreateMultisigAccountParams) GetAuthority() string {
+func (x *MsgApproveAndDispatchMultisigProposalParams) GetMultisigAddress() string {
 	if x != nil {
-		return x.Authority
+		return x.MultisigAddress
 	}
 	return ""
 }
 
-func (x *MsgCreateMultisigAccountParams) GetSeed() uint32 {
+func (x *MsgApproveAndDispatchMultisigProposalParams) GetProposalId() uint64 {
 	if x != nil {
-		return x.Seed
+		return x.ProposalId
 	}
 	return 0
 }
 
-func (x *MsgCreateMultisigAccountParams) GetThreshold() uint32 {
+func (x *MsgApproveAndDispatchMultisigProposalParams) GetApprover() string {
 	if x != nil {
-		return x.Threshold
+		return x.Approver
+	}
+	return ""
+}
+
+func (x *MsgApproveAndDispatchMultisigProposalParams) GetMessage() *anypb.Any {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// MsgApproveMultisigProposalResponse defines the response structure of approving a multisig proposal
+type MsgApproveAndDispatchMultisigProposalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionHash string `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+}
+
+func (x *MsgApproveAndDispatchMultisigProposalResponse) Reset() {
+	*x = MsgApproveAndDispatchMultisigProposalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_v1_tx_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgApproveAndDispatchMultisigProposalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgApproveAndDispatchMultisigProposalResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgApproveAndDispatchMultisigProposalResponse.ProtoReflect.Descriptor instead.
+func (*MsgApproveAndDispatchMultisigProposalResponse) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MsgApproveAndDispatchMultisigProposalResponse) GetTransactionHash() string {
+	if x != nil {
+		return x.TransactionHash
+	}
+	return ""
+}
+
+// MsgBatchApproveMultisigProposalParams defines the request type to approve a multisig proposal with
+// signatures of its signers collected off-chain
+type MsgBatchApproveMultisigProposalParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// submitter is the account submitting the approvals, it does not need to be a signer
+	Submitter       string               `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	MultisigAddress string               `protobuf:"bytes,2,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	ProposalId      uint64               `protobuf:"varint,3,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Signatures      []*ApprovalSignature `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	// message is the call of the proposal, dispatched once the approvals meet the threshold
+	// when set
+	Message *anypb.Any `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *MsgBatchApproveMultisigProposalParams) Reset() {
+	*x = MsgBatchApproveMultisigProposalParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_v1_tx_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgBatchApproveMultisigProposalParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgBatchApproveMultisigProposalParams) ProtoMessage() {}
+
+// Deprecated: Use MsgBatchApproveMultisigProposalParams.ProtoReflect.Descriptor instead.
+func (*MsgBatchApproveMultisigProposalParams) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MsgBatchApproveMultisigProposalParams) GetSubmitter() string {
+	if x != nil {
+		return x.Submitter
+	}
+	return ""
+}
+
+func (x *MsgBatchApproveMultisigProposalParams) GetMultisigAddress() string {
+	if x != nil {
+		return x.MultisigAddress
+	}
+	return ""
+}
+
+func (x *MsgBatchApproveMultisigProposalParams) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
 	}
 	return 0
 }
 
-func (x *MsgCreateMultisigAccountParams) GetSigners() [][]byte {
+func (x *MsgBatchApproveMultisigProposalParams) GetSignatures() []*ApprovalSignature {
 	if x != nil {
-		return x.Signers
+		return x.Signatures
 	}
 	return nil
 }
 
-func (x *MsgCreateMultisigAccountParams) GetPermission() MultisigProposalType {
+func (x *MsgBatchApproveMultisigProposalParams) GetMessage() *anypb.Any {
 	if x != nil {
-		return x.Permission
+		return x.Message
 	}
-	return MultisigProposalType_MULTISIG_PROPOSAL_TYPE_UNSPECIFIED
+	return nil
 }
 
-// MsgCreateMultisigAccountResponse defines the response structure of a created multisig account operation
-type MsgCreateMultisigAccountResponse struct {
+// ApprovalSignature defines the signature of a signer over the ApprovalSignDoc of a proposal
+type ApprovalSignature struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MultisigAddress string `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *MsgCreateMultisigAccountResponse) Reset() {
-	*x = MsgCreateMultisigAccountResponse{}
+func (x *ApprovalSignature) Reset() {
+	*x = ApprovalSignature{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_v1_tx_proto_msgTypes[3]
+		mi := &file_multisig_v1_tx_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateMultisigAccountResponse) String() string {
+func (x *ApprovalSignature) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateMultisigAccountResponse) ProtoMessage() {}
+func (*ApprovalSignature) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateMultisigAccountResponse.ProtoReflect.Descriptor instead.
-func (*MsgCreateMultisigAccountResponse) Descriptor() ([]byte, []int) {
-	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ApprovalSignature.ProtoReflect.Descriptor instead.
+func (*ApprovalSignature) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *MsgCreateMultisigAccountResponse) GetMultisigAddress() string {
+func (x *ApprovalSignature) GetSigner() string {
 	if x != nil {
-		return x.MultisigAddress
+		return x.Signer
 	}
 	return ""
 }
 
-// MsgAddMultisigSignerParams defines the request type to add a signer to a multisig account
-type MsgAddMultisigSignerParams struct {
+func (x *ApprovalSignature) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// ApprovalSignDoc defines the document signed off-chain to approve a multisig proposal
+type ApprovalSignDoc struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MultisigAddress string `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
-	Signer          string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
-	NewThreshold    uint32 `protobuf:"varint,3,opt,name=new_threshold,json=newThreshold,proto3" json:"new_threshold,omitempty"`
+	ChainId         string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	MultisigAddress string `protobuf:"bytes,2,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	ProposalId      uint64 `protobuf:"varint,3,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	CallHash        []byte `protobuf:"bytes,4,opt,name=call_hash,json=callHash,proto3" json:"call_hash,omitempty"`
 }
 
-func (x *MsgAddMultisigSignerParams) Reset() {
-	*x = MsgAddMultisigSignerParams{}
+func (x *ApprovalSignDoc) Reset() {
+	*x = ApprovalSignDoc{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_v1_tx_proto_msgTypes[4]
+		mi := &file_multisig_v1_tx_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddMultisigSignerParams) String() string {
+func (x *ApprovalSignDoc) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddMultisigSignerParams) ProtoMessage() {}
+func (*ApprovalSignDoc) ProtoMessage() {}
 
-// Deprecated: Use MsgAddMultisigSignerParams.ProtoReflect.Descriptor instead.
-func (*MsgAddMultisigSignerParams) Descriptor() ([]byte, []int) {
-	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ApprovalSignDoc.ProtoReflect.Descriptor instead.
+func (*ApprovalSignDoc) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *MsgAddMultisigSignerParams) GetMultisigAddress() string {
+func (x *ApprovalSignDoc) GetChainId() string {
 	if x != nil {
-		return x.MultisigAddress
+		return x.ChainId
 	}
 	return ""
 }
 
-func (x *MsgAddMultisigSignerParams) GetSigner() string {
+func (x *ApprovalSignDoc) GetMultisigAddress() string {
 	if x != nil {
-		return x.Signer
+		return x.MultisigAddress
 	}
 	return ""
 }
 
-func (x *MsgAddMultisigSignerParams) GetNewThreshold() uint32 {
+func (x *ApprovalSignDoc) GetProposalId() uint64 {
 	if x != nil {
-		return x.NewThreshold
+		return x.ProposalId
 	}
 	return 0
 }
 
-// MsgAddMultisigSignerResponse defines the response structure of adding a signer to a multisig account
-type MsgAddMultisigSignerResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *MsgAddMultisigSignerResponse) Reset() {
-	*x = MsgAddMultisigSignerResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_v1_tx_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ApprovalSignDoc) GetCallHash() []byte {
+	if x != nil {
+		return x.CallHash
 	}
+	return nil
 }
 
-func (x *MsgAddMultisigSignerResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MsgAddMultisigSignerResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgAddMultisigSignerResponse.ProtoReflect.Descriptor instead.
-func (*MsgAddMultisigSignerResponse) Descriptor() ([]byte, []int) {
-	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{5}
-}
-
-// MsgCleanupMultisigAccountParams defines the request type to remove all proposals linked to a deleted multisig account
-type MsgCleanupMultisigAccountParams struct {
+// MsgBatchApproveMultisigProposalResponse defines the response structure of approving a multisig proposal
+// with off-chain signatures
+type MsgBatchApproveMultisigProposalResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MultisigAddress string `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	Dispatched      bool   `protobuf:"varint,1,opt,name=dispatched,proto3" json:"dispatched,omitempty"`
+	TransactionHash string `protobuf:"bytes,2,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
 }
 
-func (x *MsgCleanupMultisigAccountParams) Reset() {
-	*x = MsgCleanupMultisigAccountParams{}
+func (x *MsgBatchApproveMultisigProposalResponse) Reset() {
+	*x = MsgBatchApproveMultisigProposalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_v1_tx_proto_msgTypes[6]
+		mi := &file_multisig_v1_tx_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCleanupMultisigAccountParams) String() string {
+func (x *MsgBatchApproveMultisigProposalResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCleanupMultisigAccountParams) ProtoMessage() {}
+func (*MsgBatchApproveMultisigProposalResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgCleanupMultisigAccountParams.ProtoReflect.Descriptor instead.
-func (*MsgCleanupMultisigAccountParams) Descriptor() ([]byte, []int) {
-	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MsgBatchApproveMultisigProposalResponse.ProtoReflect.Descriptor instead.
+func (*MsgBatchApproveMultisigProposalResponse) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *MsgCleanupMultisigAccountParams) GetMultisigAddress() string {
+func (x *MsgBatchApproveMultisigProposalResponse) GetDispatched() bool {
 	if x != nil {
-		return x.MultisigAddress
+		return x.Dispatched
+	}
+	return false
+}
+
+func (x *MsgBatchApproveMultisigProposalResponse) GetTransactionHash() string {
+	if x != nil {
+		return x.TransactionHash
 	}
 	return ""
 }
 
-// MsgCleanupMultisigAccountResponse defines the response structure of removing a set of proposals linked to a delted multisig account
-type MsgCleanupMultisigAccountResponse struct {
+// MsgScheduleMultisigCallParams defines the request type to execute a call of a multisig account on a schedule
+type MsgScheduleMultisigCallParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *MsgCleanupMultisigAccountResponse) Reset() {
-	*x = MsgCleanupMultisigAccountResponse{}
+	MultisigAddress string     `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	Message         *anypb.Any `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// start_height is the height of the first execution, the current block when zero
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// start_time is the earliest block time of the first execution, when set
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// interval_blocks is the number of blocks between executions, zero for a single execution
+	IntervalBlocks uint64 `protobuf:"varint,5,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
+	// max_runs is the number of executions of a recurring call
+	MaxRuns uint64 `protobuf:"varint,6,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`
+}
+
+func (x *MsgScheduleMultisigCallParams) Reset() {
+	*x = MsgScheduleMultisigCallParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_v1_tx_proto_msgTypes[7]
+		mi := &file_multisig_v1_tx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCleanupMultisigAccountResponse) String() string {
+func (x *MsgScheduleMultisigCallParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCleanupMultisigAccountResponse) ProtoMessage() {}
+func (*MsgScheduleMultisigCallParams) ProtoMessage() {}
 
-// Deprecated: Use MsgCleanupMultisigAccountResponse.ProtoReflect.Descriptor instead.
-func (*MsgCleanupMultisigAccountResponse) Descriptor() ([]byte, []int) {
-	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MsgScheduleMultisigCallParams.ProtoReflect.Descriptor instead.
+func (*MsgScheduleMultisigCallParams) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{20}
 }
 
-// MsgSetMultisigThresholdParams defines the request type to set the threshold for a multisig account
-type MsgSetMultisigThresholdParams struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	MultisigAddress string `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
-	Threshold       uint32 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+func (x *MsgScheduleMultisigCallParams) GetMultisigAddress() string {
+	if x != nil {
+		return x.MultisigAddress
+	}
+	return ""
 }
 
-func (x *MsgSetMultisigThresholdParams) Reset() {
-	*x = MsgSetMultisigThresholdParams{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_v1_tx_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *MsgScheduleMultisigCallParams) GetMessage() *anypb.Any {
+	if x != nil {
+		return x.Message
 	}
+	return nil
 }
 
-func (x *MsgSetMultisigThresholdParams) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MsgScheduleMultisigCallParams) GetStartHeight() int64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
 }
 
-func (*MsgSetMultisigThresholdParams) ProtoMessage() {}
-
-// Deprecated: Use MsgSetMultisigThresholdParams.ProtoReflect.Descriptor instead.
-func (*MsgSetMultisigThresholdParams) Descriptor() ([]byte, []int) {
-	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{8}
+func (x *MsgScheduleMultisigCallParams) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
 }
 
-func (x *MsgSetMultisigThresholdParams) GetMultisigAddress() string {
+func (x *MsgScheduleMultisigCallParams) GetIntervalBlocks() uint64 {
 	if x != nil {
-		return x.MultisigAddress
+		return x.IntervalBlocks
 	}
-	return ""
+	return 0
 }
 
-func (x *MsgSetMultisigThresholdParams) GetThreshold() uint32 {
+func (x *MsgScheduleMultisigCallParams) GetMaxRuns() uint64 {
 	if x != nil {
-		return x.Threshold
+		return x.MaxRuns
 	}
 	return 0
 }
 
-// MsgSetMultisigThresholdResponse defines the response structure of setting a threshold for a multisig account
-type MsgSetMultisigThresholdResponse struct {
+// MsgScheduleMultisigCallResponse defines the response structure of scheduling a multisig call
+type MsgScheduleMultisigCallResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ScheduleId uint64 `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
 }
 
-func (x *MsgSetMultisigThresholdResponse) Reset() {
-	*x = MsgSetMultisigThresholdResponse{}
+func (x *MsgScheduleMultisigCallResponse) Reset() {
+	*x = MsgScheduleMultisigCallResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_v1_tx_proto_msgTypes[9]
+		mi := &file_multisig_v1_tx_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSetMultisigThresholdResponse) String() string {
+func (x *MsgScheduleMultisigCallResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSetMultisigThresholdResponse) ProtoMessage() {}
+func (*MsgScheduleMultisigCallResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgSetMultisigThresholdResponse.ProtoReflect.Descriptor instead.
-func (*MsgSetMultisigThresholdResponse) Descriptor() ([]byte, []int) {
-	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use MsgScheduleMultisigCallResponse.ProtoReflect.Descriptor instead.
+func (*MsgScheduleMultisigCallResponse) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{21}
 }
 
-// MsgInitializeMultisigProposalParams defines the request type to initialize a multisig proposal
-type MsgInitializeMultisigProposalParams struct {
+func (x *MsgScheduleMultisigCallResponse) GetScheduleId() uint64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+// MsgCancelMultisigScheduleParams defines the request type to cancel the remaining executions of a schedule
+type MsgCancelMultisigScheduleParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MultisigAddress string     `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
-	Proposer        string     `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
-	Title           string     `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description     string     `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Message         *anypb.Any `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	MultisigAddress string `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	ScheduleId      uint64 `protobuf:"varint,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
 }
 
-func (x *MsgInitializeMultisigProposalParams) Reset() {
-	*x = MsgInitializeMultisigProposalParams{}
+func (x *MsgCancelMultisigScheduleParams) Reset() {
+	*x = MsgCancelMultisigScheduleParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_v1_tx_proto_msgTypes[10]
+		mi := &file_multisig_v1_tx_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgInitializeMultisigProposalParams) String() string {
+func (x *MsgCancelMultisigScheduleParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgInitializeMultisigProposalParams) ProtoMessage() {}
+func (*MsgCancelMultisigScheduleParams) ProtoMessage() {}
 
-// Deprecated: Use MsgInitializeMultisigProposalParams.ProtoReflect.Descriptor instead.
-func (*MsgInitializeMultisigProposalParams) Descriptor() ([]byte, []int) {
-	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use MsgCancelMultisigScheduleParams.ProtoReflect.Descriptor instead.
+func (*MsgCancelMultisigScheduleParams) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *MsgInitializeMultisigProposalParams) GetMultisigAddress() string {
+func (x *MsgCancelMultisigScheduleParams) GetMultisigAddress() string {
 	if x != nil {
 		return x.MultisigAddress
 	}
 	return ""
 }
 
-func (x *MsgInitializeMultisigProposalParams) GetProposer() string {
-	if x != nil {
-		return x.Proposer
-	}
-	return ""
-}
-
-func (x *MsgInitializeMultisigProposalParams) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *MsgInitializeMultisigProposalParams) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *MsgInitializeMultisigProposalParams) GetMessage() *anypb.Any {
+func (x *MsgCancelMultisigScheduleParams) GetScheduleId() uint64 {
 	if x != nil {
-		return x.Message
+		return x.ScheduleId
 	}
-	return nil
+	return 0
 }
 
-// MsgInitializeMultisigResponse defines the response structure of initializing a multisig proposal
-type MsgInitializeMultisigResponse struct {
+// MsgCancelMultisigScheduleResponse defines the response structure of cancelling a schedule
+type MsgCancelMultisigScheduleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	RemainingRuns uint64 `protobuf:"varint,1,opt,name=remaining_runs,json=remainingRuns,proto3" json:"remaining_runs,omitempty"`
 }
 
-func (x *MsgInitializeMultisigResponse) Reset() {
-	*x = MsgInitializeMultisigResponse{}
+func (x *MsgCancelMultisigScheduleResponse) Reset() {
+	*x = MsgCancelMultisigScheduleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_v1_tx_proto_msgTypes[11]
+		mi := &file_multisig_v1_tx_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgInitializeMultisigResponse) String() string {
+func (x *MsgCancelMultisigScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgInitializeMultisigResponse) ProtoMessage() {}
+func (*MsgCancelMultisigScheduleResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgInitializeMultisigResponse.ProtoReflect.Descriptor instead.
-func (*MsgInitializeMultisigResponse) Descriptor() ([]byte, []int) {
-	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use MsgCancelMultisigScheduleResponse.ProtoReflect.Descriptor instead.
+func (*MsgCancelMultisigScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *MsgInitializeMultisigResponse) GetProposalId() uint64 {
+func (x *MsgCancelMultisigScheduleResponse) GetRemainingRuns() uint64 {
 	if x != nil {
-		return x.ProposalId
+		return x.RemainingRuns
 	}
 	return 0
 }
 
-// MsgApproveMultisigProposalParams defines the request type to approve a multisig proposal
-type MsgApproveMultisigProposalParams struct {
+// MsgFreezeMultisigAccount is the Msg/FreezeMultisigAccount request type.
+type MsgFreezeMultisigAccount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MultisigAddress string `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
-	ProposalId      uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	Approver        string `protobuf:"bytes,3,opt,name=approver,proto3" json:"approver,omitempty"`
+	// authority is the address of the governance account.
+	Authority       string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	MultisigAddress string `protobuf:"bytes,2,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	Reason          string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *MsgApproveMultisigProposalParams) Reset() {
-	*x = MsgApproveMultisigProposalParams{}
+func (x *MsgFreezeMultisigAccount) Reset() {
+	*x = MsgFreezeMultisigAccount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_v1_tx_proto_msgTypes[12]
+		mi := &file_multisig_v1_tx_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgApproveMultisigProposalParams) String() string {
+func (x *MsgFreezeMultisigAccount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgApproveMultisigProposalParams) ProtoMessage() {}
+func (*MsgFreezeMultisigAccount) ProtoMessage() {}
 
-// Deprecated: Use MsgApproveMultisigProposalParams.ProtoReflect.Descriptor instead.
-func (*MsgApproveMultisigProposalParams) Descriptor() ([]byte, []int) {
-	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use MsgFreezeMultisigAccount.ProtoReflect.Descriptor instead.
+func (*MsgFreezeMultisigAccount) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *MsgApproveMultisigProposalParams) GetMultisigAddress() string {
+func (x *MsgFreezeMultisigAccount) GetAuthority() string {
 	if x != nil {
-		return x.MultisigAddress
+		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgApproveMultisigProposalParams) GetProposalId() uint64 {
+func (x *MsgFreezeMultisigAccount) GetMultisigAddress() string {
 	if x != nil {
-		return x.ProposalId
+		return x.MultisigAddress
 	}
-	return 0
+	return ""
 }
 
-func (x *MsgApproveMultisigProposalParams) GetApprover() string {
+func (x *MsgFreezeMultisigAccount) GetReason() string {
 	if x != nil {
-		return x.Approver
+		return x.Reason
 	}
 	return ""
 }
 
-// MsgApproveMultisigProposalResponse defines the response structure of approving a multisig proposal
-type MsgApproveMultisigProposalResponse struct {
+// MsgFreezeMultisigAccountResponse defines the response structure of freezing a multisig account
+type MsgFreezeMultisigAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgApproveMultisigProposalResponse) Reset() {
-	*x = MsgApproveMultisigProposalResponse{}
+func (x *MsgFreezeMultisigAccountResponse) Reset() {
+	*x = MsgFreezeMultisigAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_v1_tx_proto_msgTypes[13]
+		mi := &file_multisig_v1_tx_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgApproveMultisigProposalResponse) String() string {
+func (x *MsgFreezeMultisigAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgApproveMultisigProposalResponse) ProtoMessage() {}
+func (*MsgFreezeMultisigAccountResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgApproveMultisigProposalResponse.ProtoReflect.Descriptor instead.
-func (*MsgApproveMultisigProposalResponse) Descriptor() ([]byte, []int) {
-	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use MsgFreezeMultisigAccountResponse.ProtoReflect.Descriptor instead.
+func (*MsgFreezeMultisigAccountResponse) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{25}
 }
-
-// MsgApproveMultisigProposalParams defines the request type to approve a multisig proposal
-type MsgApproveAndDispatchMultisigProposalParams struct {
+
+// MsgRecoverMultisigAccount is the Msg/RecoverMultisigAccount request type.
+type MsgRecoverMultisigAccount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MultisigAddress string     `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
-	ProposalId      uint64     `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	Approver        string     `protobuf:"bytes,3,opt,name=approver,proto3" json:"approver,omitempty"`
-	Message         *anypb.Any `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
-}
-
-func (x *MsgApproveAndDispatchMultisigProposalParams) Reset() {
-	*x = MsgApproveAndDispatchMultisigProposalParams{}
+	// authority is the address of the governance account.
+	Authority       string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	MultisigAddress string `protobuf:"bytes,2,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	// signers replace the signer set of the account
+	Signers   []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+	Threshold uint32   `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// threshold_percentage is the fraction of the signers required to approve a call, in
+	// place of an absolute threshold. It is a decimal string such as "0.5", not annotated as
+	// cosmos.Dec since amino JSON would encode it as a scaled integer.
+	ThresholdPercentage string `protobuf:"bytes,5,opt,name=threshold_percentage,json=thresholdPercentage,proto3" json:"threshold_percentage,omitempty"`
+}
+
+func (x *MsgRecoverMultisigAccount) Reset() {
+	*x = MsgRecoverMultisigAccount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_v1_tx_proto_msgTypes[14]
+		mi := &file_multisig_v1_tx_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgApproveAndDispatchMultisigProposalParams) String() string {
+func (x *MsgRecoverMultisigAccount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgApproveAndDispatchMultisigProposalParams) ProtoMessage() {}
+func (*MsgRecoverMultisigAccount) ProtoMessage() {}
 
-// Deprecated: Use MsgApproveAndDispatchMultisigProposalParams.ProtoReflect.Descriptor instead.
-func (*MsgApproveAndDispatchMultisigProposalParams) Descriptor() ([]byte, []int) {
-	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use MsgRecoverMultisigAccount.ProtoReflect.Descriptor instead.
+func (*MsgRecoverMultisigAccount) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *MsgApproveAndDispatchMultisigProposalParams) GetMultisigAddress() string {
+func (x *MsgRecoverMultisigAccount) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgRecoverMultisigAccount) GetMultisigAddress() string {
 	if x != nil {
 		return x.MultisigAddress
 	}
 	return ""
 }
 
-func (x *MsgApproveAndDispatchMultisigProposalParams) GetProposalId() uint64 {
+func (x *MsgRecoverMultisigAccount) GetSigners() []string {
 	if x != nil {
-		return x.ProposalId
+		return x.Signers
 	}
-	return 0
+	return nil
 }
 
-func (x *MsgApproveAndDispatchMultisigProposalParams) GetApprover() string {
+func (x *MsgRecoverMultisigAccount) GetThreshold() uint32 {
 	if x != nil {
-		return x.Approver
+		return x.Threshold
 	}
-	return ""
+	return 0
 }
 
-func (x *MsgApproveAndDispatchMultisigProposalParams) GetMessage() *anypb.Any {
+func (x *MsgRecoverMultisigAccount) GetThresholdPercentage() string {
 	if x != nil {
-		return x.Message
+		return x.ThresholdPercentage
 	}
-	return nil
+	return ""
 }
 
-// MsgApproveMultisigProposalResponse defines the response structure of approving a multisig proposal
-type MsgApproveAndDispatchMultisigProposalResponse struct {
+// MsgRecoverMultisigAccountResponse defines the response structure of recovering a multisig account
+type MsgRecoverMultisigAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	TransactionHash string `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
 }
 
-func (x *MsgApproveAndDispatchMultisigProposalResponse) Reset() {
-	*x = MsgApproveAndDispatchMultisigProposalResponse{}
+func (x *MsgRecoverMultisigAccountResponse) Reset() {
+	*x = MsgRecoverMultisigAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_v1_tx_proto_msgTypes[15]
+		mi := &file_multisig_v1_tx_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgApproveAndDispatchMultisigProposalResponse) String() string {
+func (x *MsgRecoverMultisigAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgApproveAndDispatchMultisigProposalResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgApproveAndDispatchMultisigProposalResponse.ProtoReflect.Descriptor instead.
-func (*MsgApproveAndDispatchMultisigProposalResponse) Descriptor() ([]byte, []int) {
-	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{15}
-}
+func (*MsgRecoverMultisigAccountResponse) ProtoMessage() {}
 
-func (x *MsgApproveAndDispatchMultisigProposalResponse) GetTransactionHash() string {
-	if x != nil {
-		return x.TransactionHash
-	}
-	return ""
+// Deprecated: Use MsgRecoverMultisigAccountResponse.ProtoReflect.Descriptor instead.
+func (*MsgRecoverMultisigAccountResponse) Descriptor() ([]byte, []int) {
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{27}
 }
 
 // MsgCancelMultisigProposalParams defines the request type to reject a multisig proposal
@@ -9960,7 +16973,7 @@ type MsgCancelMultisigProposalParams struct {
 func (x *MsgCancelMultisigProposalParams) Reset() {
 	*x = MsgCancelMultisigProposalParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_v1_tx_proto_msgTypes[16]
+		mi := &file_multisig_v1_tx_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9974,7 +16987,7 @@ func (*MsgCancelMultisigProposalParams) ProtoMessage() {}
 
 // Deprecated: Use MsgCancelMultisigProposalParams.ProtoReflect.Descriptor instead.
 func (*MsgCancelMultisigProposalParams) Descriptor() ([]byte, []int) {
-	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{16}
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *MsgCancelMultisigProposalParams) GetMultisigAddress() string {
@@ -10003,12 +17016,15 @@ type MsgCancelMultisigProposalResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// rejected is true when the rejections reached the threshold and the proposal was removed.
+	Rejected bool `protobuf:"varint,1,opt,name=rejected,proto3" json:"rejected,omitempty"`
 }
 
 func (x *MsgCancelMultisigProposalResponse) Reset() {
 	*x = MsgCancelMultisigProposalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_v1_tx_proto_msgTypes[17]
+		mi := &file_multisig_v1_tx_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10022,7 +17038,14 @@ func (*MsgCancelMultisigProposalResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgCancelMultisigProposalResponse.ProtoReflect.Descriptor instead.
 func (*MsgCancelMultisigProposalResponse) Descriptor() ([]byte, []int) {
-	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{17}
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *MsgCancelMultisigProposalResponse) GetRejected() bool {
+	if x != nil {
+		return x.Rejected
+	}
+	return false
 }
 
 // MsgCleanupMultisigProposalParams defines the request type to clear all multisig proposals after account deletion
@@ -10039,7 +17062,7 @@ type MsgCleanupMultisigProposalParams struct {
 func (x *MsgCleanupMultisigProposalParams) Reset() {
 	*x = MsgCleanupMultisigProposalParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_v1_tx_proto_msgTypes[18]
+		mi := &file_multisig_v1_tx_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10053,7 +17076,7 @@ func (*MsgCleanupMultisigProposalParams) ProtoMessage() {}
 
 // Deprecated: Use MsgCleanupMultisigProposalParams.ProtoReflect.Descriptor instead.
 func (*MsgCleanupMultisigProposalParams) Descriptor() ([]byte, []int) {
-	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{18}
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *MsgCleanupMultisigProposalParams) GetMultisigAddress() string {
@@ -10087,7 +17110,7 @@ type MsgCleanupMultisigProposalResponse struct {
 func (x *MsgCleanupMultisigProposalResponse) Reset() {
 	*x = MsgCleanupMultisigProposalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_v1_tx_proto_msgTypes[19]
+		mi := &file_multisig_v1_tx_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10101,7 +17124,7 @@ func (*MsgCleanupMultisigProposalResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgCleanupMultisigProposalResponse.ProtoReflect.Descriptor instead.
 func (*MsgCleanupMultisigProposalResponse) Descriptor() ([]byte, []int) {
-	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{19}
+	return file_multisig_v1_tx_proto_rawDescGZIP(), []int{31}
 }
 
 var File_multisig_v1_tx_proto protoreflect.FileDescriptor
@@ -10109,257 +17132,468 @@ var File_multisig_v1_tx_proto protoreflect.FileDescriptor
 var file_multisig_v1_tx_proto_rawDesc = []byte{
 	0x0a, 0x14, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x78,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
-	0x2e, 0x76, 0x31, 0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6d, 0x73, 0x67, 0x2f,
-	0x76, 0x31, 0x2f, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e,
-	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
-	0x67, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x17, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x2f,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67,
-	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8c, 0x01, 0x0a,
-	0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
+	0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6d,
+	0x73, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x73, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xae, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x3a, 0x2b, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
+	0x67, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe2, 0x02, 0x0a,
+	0x1e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73,
+	0x69, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x41, 0x0a,
+	0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x21, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x31, 0x0a, 0x14, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x3a, 0x2c, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
+	0x2f, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x67, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
+	0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xe9, 0x01, 0x0a, 0x1a, 0x4d,
+	0x73, 0x67, 0x41, 0x64, 0x64, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x43, 0x0a, 0x10, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69,
-	0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8,
-	0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x0e, 0x82, 0xe7, 0xb0,
-	0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x19, 0x0a, 0x17, 0x4d,
-	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x81, 0x02, 0x0a, 0x1e, 0x4d, 0x73, 0x67, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
-	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
-	0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
-	0x6f, 0x6c, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0c, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6d, 0x75,
-	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73,
-	0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a,
-	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x67, 0x0a, 0x20, 0x4d, 0x73,
-	0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30,
+	0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x3a, 0x2f, 0x82, 0xe7, 0xb0, 0x2a, 0x10, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x73, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7, 0xb0, 0x2a,
+	0x15, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64,
+	0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x1e, 0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x43, 0x6c,
+	0x65, 0x61, 0x6e, 0x75, 0x70, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x43, 0x0a, 0x10, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a,
+	0x34, 0x82, 0xe7, 0xb0, 0x2a, 0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x73, 0x69, 0x67, 0x2f, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x23, 0x0a, 0x21, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x65, 0x61,
+	0x6e, 0x75, 0x70, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb6, 0x01, 0x0a, 0x1d, 0x4d,
+	0x73, 0x67, 0x53, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x43, 0x0a, 0x10,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x3a,
+	0x32, 0x82, 0xe7, 0xb0, 0x2a, 0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x73, 0x69, 0x67, 0x2f, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x22, 0x21, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x73, 0x69, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd7, 0x02, 0x0a, 0x23, 0x4d, 0x73, 0x67, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x43,
 	0x0a, 0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
 	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
 	0x6e, 0x67, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x43, 0x0a, 0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
-	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x65, 0x77,
-	0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0c, 0x6e, 0x65, 0x77, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x1e,
-	0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
-	0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x66,
-	0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x43, 0x0a, 0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
-	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x23, 0x0a, 0x21, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x65,
-	0x61, 0x6e, 0x75, 0x70, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x1d,
-	0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x54, 0x68,
-	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x43, 0x0a,
-	0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
-	0x22, 0x21, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73,
-	0x69, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0xa5, 0x02, 0x0a, 0x23, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x43, 0x0a, 0x10, 0x6d,
-	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x4b, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x1b, 0xca, 0xb4, 0x2d, 0x17, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x30,
+	0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x1e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f, 0x4d, 0x73, 0x67, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x22, 0x40, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x49, 0x64, 0x22, 0xed, 0x01, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x43, 0x0a, 0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x73, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x34, 0x0a,
+	0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x72, 0x3a, 0x2d, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f,
+	0x4d, 0x73, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x22, 0x24, 0x0a, 0x22, 0x4d, 0x73, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc8, 0x02, 0x0a, 0x2b, 0x4d, 0x73, 0x67,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x6e, 0x64, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x43, 0x0a, 0x10, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x73, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x70, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4b,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x1b, 0xca, 0xb4, 0x2d, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x40, 0x0a, 0x1d, 0x4d,
-	0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x73, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x22, 0xbe, 0x01,
-	0x0a, 0x20, 0x4d, 0x73, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x43, 0x0a, 0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
-	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x22, 0x24,
-	0x0a, 0x22, 0x4d, 0x73, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x34,
+	0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x1b, 0xca, 0xb4, 0x2d,
+	0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x3a, 0x30, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f, 0x4d, 0x73,
+	0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x6e, 0x64, 0x44, 0x69, 0x73, 0x70, 0x61,
+	0x74, 0x63, 0x68, 0x22, 0x5a, 0x0a, 0x2d, 0x4d, 0x73, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x41, 0x6e, 0x64, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x75, 0x6c, 0x74,
 	0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x96, 0x02, 0x0a, 0x2b, 0x4d, 0x73, 0x67, 0x41, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x65, 0x41, 0x6e, 0x64, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x50, 0x61,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x22,
+	0x8d, 0x03, 0x0a, 0x25, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x12, 0x43, 0x0a, 0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x4b, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x41, 0x6e, 0x79, 0x42, 0x1b, 0xca, 0xb4, 0x2d, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x33, 0x82, 0xe7, 0xb0, 0x2a,
+	0x09, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22,
+	0x63, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x53, 0x69, 0x67, 0x6e, 0x44, 0x6f, 0x63, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
+	0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x6c,
+	0x6c, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61,
+	0x6c, 0x6c, 0x48, 0x61, 0x73, 0x68, 0x22, 0x74, 0x0a, 0x27, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
+	0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x22, 0x8d, 0x03, 0x0a,
+	0x1d, 0x4d, 0x73, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x73, 0x69, 0x67, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x43,
+	0x0a, 0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x4b, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x1b, 0xca, 0xb4, 0x2d, 0x17,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x3f, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x6d, 0x61, 0x78, 0x52, 0x75, 0x6e, 0x73, 0x3a, 0x32, 0x82, 0xe7, 0xb0, 0x2a, 0x10, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x8a,
+	0xe7, 0xb0, 0x2a, 0x18, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f, 0x4d, 0x73, 0x67,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x22, 0x42, 0x0a, 0x1f,
+	0x4d, 0x73, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x73, 0x69, 0x67, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64,
+	0x22, 0xbd, 0x01, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x61,
 	0x72, 0x61, 0x6d, 0x73, 0x12, 0x43, 0x0a, 0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
 	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
 	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
 	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73,
-	0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x61, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
-	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72,
-	0x12, 0x4b, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x1b, 0xca, 0xb4, 0x2d, 0x17, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a, 0x0a,
-	0x2d, 0x4d, 0x73, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x6e, 0x64, 0x44, 0x69,
-	0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29,
-	0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x61,
-	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x22, 0xbd, 0x01, 0x0a, 0x1f, 0x4d, 0x73,
-	0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x43, 0x0a,
+	0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x3a, 0x34, 0x82, 0xe7, 0xb0, 0x2a,
 	0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x72, 0x22, 0x23, 0x0a, 0x21, 0x4d, 0x73, 0x67,
-	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbc,
-	0x01, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x43, 0x0a, 0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
-	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
-	0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x72, 0x22, 0x24, 0x0a,
-	0x22, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x4d, 0x75, 0x6c, 0x74, 0x69,
-	0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x32, 0xa6, 0x09, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x52, 0x0a, 0x0c, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x2e, 0x6d, 0x75,
-	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x24, 0x2e, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x73, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
-	0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69,
-	0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2d, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x4d, 0x75, 0x6c, 0x74, 0x69,
-	0x73, 0x69, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x1a, 0x29, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53,
-	0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a,
-	0x15, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
-	0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x2c, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
-	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2e, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x4d, 0x75, 0x6c,
+	0x73, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f, 0x4d,
+	0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x22, 0x4a, 0x0a, 0x21, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6e, 0x73, 0x22, 0xdd, 0x01, 0x0a,
+	0x18, 0x4d, 0x73, 0x67, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73,
+	0x69, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x43, 0x0a, 0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x3a, 0x2c,
+	0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7,
+	0xb0, 0x2a, 0x19, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f, 0x4d, 0x73, 0x67, 0x46,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x22, 0x0a, 0x20,
+	0x4d, 0x73, 0x67, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
+	0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xcc, 0x02, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36,
+	0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x10, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73,
+	0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x31, 0x0a,
+	0x14, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x3a, 0x2d, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f, 0x4d, 0x73,
+	0x67, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x23, 0x0a, 0x21, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4d, 0x75, 0x6c,
 	0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73,
-	0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2a, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
-	0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x1a, 0x2c, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x53, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x54, 0x68, 0x72,
-	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a,
-	0x0a, 0x1a, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x30, 0x2e, 0x6d,
-	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
-	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2a,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xeb, 0x01, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x43, 0x0a, 0x10, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x73, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x34,
+	0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x65, 0x72, 0x3a, 0x2c, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
+	0x2f, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x22, 0x3f, 0x0a, 0x21, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x22, 0xea, 0x01, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x65, 0x61, 0x6e,
+	0x75, 0x70, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x43, 0x0a, 0x10, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x73, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x32,
+	0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x72, 0x3a, 0x2c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x72,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x1b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f, 0x4d, 0x73,
+	0x67, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x22, 0x24, 0x0a, 0x22, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xfc, 0x0d, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x52,
+	0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c,
 	0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73,
-	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x17, 0x41, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2d, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2f, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x22, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x65, 0x41, 0x6e, 0x64, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x38, 0x2e, 0x6d,
-	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x6e, 0x64, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x24, 0x2e, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x73, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2d, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64,
+	0x64, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x29, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73,
+	0x69, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x75, 0x0a, 0x15, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x73, 0x69, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x2c, 0x2e, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x65, 0x61, 0x6e,
+	0x75, 0x70, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2e, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73,
+	0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2a, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73,
+	0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x73, 0x69, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x1a, 0x2c, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x7a, 0x0a, 0x1a, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12,
+	0x30, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x1a, 0x2a, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x73, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a,
+	0x17, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2d, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2f, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73,
+	0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
 	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x3a, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
-	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41,
-	0x6e, 0x64, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73,
-	0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x22, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x41, 0x6e, 0x64, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12,
+	0x38, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x6e, 0x64, 0x44, 0x69, 0x73, 0x70, 0x61,
+	0x74, 0x63, 0x68, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x3a, 0x2e, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x41, 0x6e, 0x64, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x1c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x32, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x34, 0x2e, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x70, 0x0a, 0x14, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x73, 0x69, 0x67, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x2a, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2c, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x12, 0x76, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2c, 0x2e, 0x6d,
+	0x69, 0x73, 0x69, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x2c, 0x2e, 0x6d,
 	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2e, 0x2e, 0x6d, 0x75, 0x6c,
+	0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2e, 0x2e, 0x6d, 0x75, 0x6c,
 	0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x17, 0x43, 0x6c,
-	0x65, 0x61, 0x6e, 0x75, 0x70, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2d, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2f, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xa2, 0x01, 0x0a,
-	0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31,
-	0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x61, 0x65, 0x76, 0x4d, 0x69, 0x74, 0x68,
-	0x72, 0x61, 0x6e, 0x2f, 0x64, 0x6d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x73, 0x69, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x58, 0x58, 0xaa, 0x02, 0x0b, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0b, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x73, 0x69, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x17, 0x4d, 0x75, 0x6c, 0x74, 0x69,
-	0x73, 0x69, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x0c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x3a, 0x3a, 0x56,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x6c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x15, 0x46, 0x72,
+	0x65, 0x65, 0x7a, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x16, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x26, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x2e, 0x2e, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x16, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2c, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x1a, 0x2e, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x17, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2d,
+	0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2f, 0x2e,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43,
+	0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05,
+	0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xa2, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x44, 0x61, 0x65, 0x76, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x61, 0x6e, 0x2f, 0x64, 0x6d, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
+	0x67, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x4d, 0x58, 0x58, 0xaa, 0x02, 0x0b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x0b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x17, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5c, 0x56, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -10374,7 +17608,7 @@ func file_multisig_v1_tx_proto_rawDescGZIP() []byte {
 	return file_multisig_v1_tx_proto_rawDescData
 }
 
-var file_multisig_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_multisig_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_multisig_v1_tx_proto_goTypes = []interface{}{
 	(*MsgUpdateParams)(nil),                               // 0: multisig.v1.MsgUpdateParams
 	(*MsgUpdateParamsResponse)(nil),                       // 1: multisig.v1.MsgUpdateParamsResponse
@@ -10392,44 +17626,71 @@ var file_multisig_v1_tx_proto_goTypes = []interface{}{
 	(*MsgApproveMultisigProposalResponse)(nil),            // 13: multisig.v1.MsgApproveMultisigProposalResponse
 	(*MsgApproveAndDispatchMultisigProposalParams)(nil),   // 14: multisig.v1.MsgApproveAndDispatchMultisigProposalParams
 	(*MsgApproveAndDispatchMultisigProposalResponse)(nil), // 15: multisig.v1.MsgApproveAndDispatchMultisigProposalResponse
-	(*MsgCancelMultisigProposalParams)(nil),               // 16: multisig.v1.MsgCancelMultisigProposalParams
-	(*MsgCancelMultisigProposalResponse)(nil),             // 17: multisig.v1.MsgCancelMultisigProposalResponse
-	(*MsgCleanupMultisigProposalParams)(nil),              // 18: multisig.v1.MsgCleanupMultisigProposalParams
-	(*MsgCleanupMultisigProposalResponse)(nil),            // 19: multisig.v1.MsgCleanupMultisigProposalResponse
-	(*Params)(nil),            // 20: multisig.v1.Params
-	(MultisigProposalType)(0), // 21: multisig.v1.MultisigProposalType
-	(*anypb.Any)(nil),         // 22: google.protobuf.Any
+	(*MsgBatchApproveMultisigProposalParams)(nil),         // 16: multisig.v1.MsgBatchApproveMultisigProposalParams
+	(*ApprovalSignature)(nil),                             // 17: multisig.v1.ApprovalSignature
+	(*ApprovalSignDoc)(nil),                               // 18: multisig.v1.ApprovalSignDoc
+	(*MsgBatchApproveMultisigProposalResponse)(nil),       // 19: multisig.v1.MsgBatchApproveMultisigProposalResponse
+	(*MsgScheduleMultisigCallParams)(nil),                 // 20: multisig.v1.MsgScheduleMultisigCallParams
+	(*MsgScheduleMultisigCallResponse)(nil),               // 21: multisig.v1.MsgScheduleMultisigCallResponse
+	(*MsgCancelMultisigScheduleParams)(nil),               // 22: multisig.v1.MsgCancelMultisigScheduleParams
+	(*MsgCancelMultisigScheduleResponse)(nil),             // 23: multisig.v1.MsgCancelMultisigScheduleResponse
+	(*MsgFreezeMultisigAccount)(nil),                      // 24: multisig.v1.MsgFreezeMultisigAccount
+	(*MsgFreezeMultisigAccountResponse)(nil),              // 25: multisig.v1.MsgFreezeMultisigAccountResponse
+	(*MsgRecoverMultisigAccount)(nil),                     // 26: multisig.v1.MsgRecoverMultisigAccount
+	(*MsgRecoverMultisigAccountResponse)(nil),             // 27: multisig.v1.MsgRecoverMultisigAccountResponse
+	(*MsgCancelMultisigProposalParams)(nil),               // 28: multisig.v1.MsgCancelMultisigProposalParams
+	(*MsgCancelMultisigProposalResponse)(nil),             // 29: multisig.v1.MsgCancelMultisigProposalResponse
+	(*MsgCleanupMultisigProposalParams)(nil),              // 30: multisig.v1.MsgCleanupMultisigProposalParams
+	(*MsgCleanupMultisigProposalResponse)(nil),            // 31: multisig.v1.MsgCleanupMultisigProposalResponse
+	(*Params)(nil),                // 32: multisig.v1.Params
+	(MultisigProposalType)(0),     // 33: multisig.v1.MultisigProposalType
+	(*anypb.Any)(nil),             // 34: google.protobuf.Any
+	(*timestamppb.Timestamp)(nil), // 35: google.protobuf.Timestamp
 }
 var file_multisig_v1_tx_proto_depIdxs = []int32{
-	20, // 0: multisig.v1.MsgUpdateParams.params:type_name -> multisig.v1.Params
-	21, // 1: multisig.v1.MsgCreateMultisigAccountParams.permission:type_name -> multisig.v1.MultisigProposalType
-	22, // 2: multisig.v1.MsgInitializeMultisigProposalParams.message:type_name -> google.protobuf.Any
-	22, // 3: multisig.v1.MsgApproveAndDispatchMultisigProposalParams.message:type_name -> google.protobuf.Any
-	0,  // 4: multisig.v1.Msg.UpdateParams:input_type -> multisig.v1.MsgUpdateParams
-	2,  // 5: multisig.v1.Msg.CreateMultisigAccount:input_type -> multisig.v1.MsgCreateMultisigAccountParams
-	4,  // 6: multisig.v1.Msg.AddMultisigSigner:input_type -> multisig.v1.MsgAddMultisigSignerParams
-	6,  // 7: multisig.v1.Msg.CleanupMultisigSigner:input_type -> multisig.v1.MsgCleanupMultisigAccountParams
-	8,  // 8: multisig.v1.Msg.SetThreshold:input_type -> multisig.v1.MsgSetMultisigThresholdParams
-	10, // 9: multisig.v1.Msg.InitializeMultisigProposal:input_type -> multisig.v1.MsgInitializeMultisigProposalParams
-	12, // 10: multisig.v1.Msg.ApproveMultisigProposal:input_type -> multisig.v1.MsgApproveMultisigProposalParams
-	14, // 11: multisig.v1.Msg.ApproveAndDispatchMultisigProposal:input_type -> multisig.v1.MsgApproveAndDispatchMultisigProposalParams
-	16, // 12: multisig.v1.Msg.CancelMultisigProposal:input_type -> multisig.v1.MsgCancelMultisigProposalParams
-	18, // 13: multisig.v1.Msg.CleanupMultisigProposal:input_type -> multisig.v1.MsgCleanupMultisigProposalParams
-	1,  // 14: multisig.v1.Msg.UpdateParams:output_type -> multisig.v1.MsgUpdateParamsResponse
-	3,  // 15: multisig.v1.Msg.CreateMultisigAccount:output_type -> multisig.v1.MsgCreateMultisigAccountResponse
-	5,  // 16: multisig.v1.Msg.AddMultisigSigner:output_type -> multisig.v1.MsgAddMultisigSignerResponse
-	7,  // 17: multisig.v1.Msg.CleanupMultisigSigner:output_type -> multisig.v1.MsgCleanupMultisigAccountResponse
-	9,  // 18: multisig.v1.Msg.SetThreshold:output_type -> multisig.v1.MsgSetMultisigThresholdResponse
-	11, // 19: multisig.v1.Msg.InitializeMultisigProposal:output_type -> multisig.v1.MsgInitializeMultisigResponse
-	13, // 20: multisig.v1.Msg.ApproveMultisigProposal:output_type -> multisig.v1.MsgApproveMultisigProposalResponse
-	15, // 21: multisig.v1.Msg.ApproveAndDispatchMultisigProposal:output_type -> multisig.v1.MsgApproveAndDispatchMultisigProposalResponse
-	17, // 22: multisig.v1.Msg.CancelMultisigProposal:output_type -> multisig.v1.MsgCancelMultisigProposalResponse
-	19, // 23: multisig.v1.Msg.CleanupMultisigProposal:output_type -> multisig.v1.MsgCleanupMultisigProposalResponse
-	14, // [14:24] is the sub-list for method output_type
-	4,  // [4:14] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	32, // 0: multisig.v1.MsgUpdateParams.params:type_name -> multisig.v1.Params
+	33, // 1: multisig.v1.MsgCreateMultisigAccountParams.permission:type_name -> multisig.v1.MultisigProposalType
+	34, // 2: multisig.v1.MsgInitializeMultisigProposalParams.message:type_name -> google.protobuf.Any
+	34, // 3: multisig.v1.MsgApproveAndDispatchMultisigProposalParams.message:type_name -> google.protobuf.Any
+	17, // 4: multisig.v1.MsgBatchApproveMultisigProposalParams.signatures:type_name -> multisig.v1.ApprovalSignature
+	34, // 5: multisig.v1.MsgBatchApproveMultisigProposalParams.message:type_name -> google.protobuf.Any
+	34, // 6: multisig.v1.MsgScheduleMultisigCallParams.message:type_name -> google.protobuf.Any
+	35, // 7: multisig.v1.MsgScheduleMultisigCallParams.start_time:type_name -> google.protobuf.Timestamp
+	0,  // 8: multisig.v1.Msg.UpdateParams:input_type -> multisig.v1.MsgUpdateParams
+	2,  // 9: multisig.v1.Msg.CreateMultisigAccount:input_type -> multisig.v1.MsgCreateMultisigAccountParams
+	4,  // 10: multisig.v1.Msg.AddMultisigSigner:input_type -> multisig.v1.MsgAddMultisigSignerParams
+	6,  // 11: multisig.v1.Msg.CleanupMultisigSigner:input_type -> multisig.v1.MsgCleanupMultisigAccountParams
+	8,  // 12: multisig.v1.Msg.SetThreshold:input_type -> multisig.v1.MsgSetMultisigThresholdParams
+	10, // 13: multisig.v1.Msg.InitializeMultisigProposal:input_type -> multisig.v1.MsgInitializeMultisigProposalParams
+	12, // 14: multisig.v1.Msg.ApproveMultisigProposal:input_type -> multisig.v1.MsgApproveMultisigProposalParams
+	14, // 15: multisig.v1.Msg.ApproveAndDispatchMultisigProposal:input_type -> multisig.v1.MsgApproveAndDispatchMultisigProposalParams
+	16, // 16: multisig.v1.Msg.BatchApproveMultisigProposal:input_type -> multisig.v1.MsgBatchApproveMultisigProposalParams
+	20, // 17: multisig.v1.Msg.ScheduleMultisigCall:input_type -> multisig.v1.MsgScheduleMultisigCallParams
+	22, // 18: multisig.v1.Msg.CancelMultisigSchedule:input_type -> multisig.v1.MsgCancelMultisigScheduleParams
+	24, // 19: multisig.v1.Msg.FreezeMultisigAccount:input_type -> multisig.v1.MsgFreezeMultisigAccount
+	26, // 20: multisig.v1.Msg.RecoverMultisigAccount:input_type -> multisig.v1.MsgRecoverMultisigAccount
+	28, // 21: multisig.v1.Msg.CancelMultisigProposal:input_type -> multisig.v1.MsgCancelMultisigProposalParams
+	30, // 22: multisig.v1.Msg.CleanupMultisigProposal:input_type -> multisig.v1.MsgCleanupMultisigProposalParams
+	1,  // 23: multisig.v1.Msg.UpdateParams:output_type -> multisig.v1.MsgUpdateParamsResponse
+	3,  // 24: multisig.v1.Msg.CreateMultisigAccount:output_type -> multisig.v1.MsgCreateMultisigAccountResponse
+	5,  // 25: multisig.v1.Msg.AddMultisigSigner:output_type -> multisig.v1.MsgAddMultisigSignerResponse
+	7,  // 26: multisig.v1.Msg.CleanupMultisigSigner:output_type -> multisig.v1.MsgCleanupMultisigAccountResponse
+	9,  // 27: multisig.v1.Msg.SetThreshold:output_type -> multisig.v1.MsgSetMultisigThresholdResponse
+	11, // 28: multisig.v1.Msg.InitializeMultisigProposal:output_type -> multisig.v1.MsgInitializeMultisigResponse
+	13, // 29: multisig.v1.Msg.ApproveMultisigProposal:output_type -> multisig.v1.MsgApproveMultisigProposalResponse
+	15, // 30: multisig.v1.Msg.ApproveAndDispatchMultisigProposal:output_type -> multisig.v1.MsgApproveAndDispatchMultisigProposalResponse
+	19, // 31: multisig.v1.Msg.BatchApproveMultisigProposal:output_type -> multisig.v1.MsgBatchApproveMultisigProposalResponse
+	21, // 32: multisig.v1.Msg.ScheduleMultisigCall:output_type -> multisig.v1.MsgScheduleMultisigCallResponse
+	23, // 33: multisig.v1.Msg.CancelMultisigSchedule:output_type -> multisig.v1.MsgCancelMultisigScheduleResponse
+	25, // 34: multisig.v1.Msg.FreezeMultisigAccount:output_type -> multisig.v1.MsgFreezeMultisigAccountResponse
+	27, // 35: multisig.v1.Msg.RecoverMultisigAccount:output_type -> multisig.v1.MsgRecoverMultisigAccountResponse
+	29, // 36: multisig.v1.Msg.CancelMultisigProposal:output_type -> multisig.v1.MsgCancelMultisigProposalResponse
+	31, // 37: multisig.v1.Msg.CleanupMultisigProposal:output_type -> multisig.v1.MsgCleanupMultisigProposalResponse
+	23, // [23:38] is the sub-list for method output_type
+	8,  // [8:23] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_multisig_v1_tx_proto_init() }
@@ -10633,7 +17894,7 @@ func file_multisig_v1_tx_proto_init() {
 			}
 		}
 		file_multisig_v1_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCancelMultisigProposalParams); i {
+			switch v := v.(*MsgBatchApproveMultisigProposalParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10645,7 +17906,7 @@ func file_multisig_v1_tx_proto_init() {
 			}
 		}
 		file_multisig_v1_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCancelMultisigProposalResponse); i {
+			switch v := v.(*ApprovalSignature); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10657,7 +17918,7 @@ func file_multisig_v1_tx_proto_init() {
 			}
 		}
 		file_multisig_v1_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCleanupMultisigProposalParams); i {
+			switch v := v.(*ApprovalSignDoc); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10669,6 +17930,150 @@ func file_multisig_v1_tx_proto_init() {
 			}
 		}
 		file_multisig_v1_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgBatchApproveMultisigProposalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_multisig_v1_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgScheduleMultisigCallParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_multisig_v1_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgScheduleMultisigCallResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_multisig_v1_tx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCancelMultisigScheduleParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_multisig_v1_tx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCancelMultisigScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_multisig_v1_tx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgFreezeMultisigAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_multisig_v1_tx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgFreezeMultisigAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_multisig_v1_tx_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRecoverMultisigAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_multisig_v1_tx_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRecoverMultisigAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_multisig_v1_tx_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCancelMultisigProposalParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_multisig_v1_tx_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCancelMultisigProposalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_multisig_v1_tx_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCleanupMultisigProposalParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_multisig_v1_tx_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgCleanupMultisigProposalResponse); i {
 			case 0:
 				return &v.state
@@ -10687,7 +18092,7 @@ func file_multisig_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_multisig_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
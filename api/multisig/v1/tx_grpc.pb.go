@@ -27,6 +27,11 @@ const (
 	Msg_InitializeMultisigProposal_FullMethodName         = "/multisig.v1.Msg/InitializeMultisigProposal"
 	Msg_ApproveMultisigProposal_FullMethodName            = "/multisig.v1.Msg/ApproveMultisigProposal"
 	Msg_ApproveAndDispatchMultisigProposal_FullMethodName = "/multisig.v1.Msg/ApproveAndDispatchMultisigProposal"
+	Msg_BatchApproveMultisigProposal_FullMethodName       = "/multisig.v1.Msg/BatchApproveMultisigProposal"
+	Msg_ScheduleMultisigCall_FullMethodName               = "/multisig.v1.Msg/ScheduleMultisigCall"
+	Msg_CancelMultisigSchedule_FullMethodName             = "/multisig.v1.Msg/CancelMultisigSchedule"
+	Msg_FreezeMultisigAccount_FullMethodName              = "/multisig.v1.Msg/FreezeMultisigAccount"
+	Msg_RecoverMultisigAccount_FullMethodName             = "/multisig.v1.Msg/RecoverMultisigAccount"
 	Msg_CancelMultisigProposal_FullMethodName             = "/multisig.v1.Msg/CancelMultisigProposal"
 	Msg_CleanupMultisigProposal_FullMethodName            = "/multisig.v1.Msg/CleanupMultisigProposal"
 )
@@ -47,6 +52,24 @@ type MsgClient interface {
 	InitializeMultisigProposal(ctx context.Context, in *MsgInitializeMultisigProposalParams, opts ...grpc.CallOption) (*MsgInitializeMultisigResponse, error)
 	ApproveMultisigProposal(ctx context.Context, in *MsgApproveMultisigProposalParams, opts ...grpc.CallOption) (*MsgApproveMultisigProposalResponse, error)
 	ApproveAndDispatchMultisigProposal(ctx context.Context, in *MsgApproveAndDispatchMultisigProposalParams, opts ...grpc.CallOption) (*MsgApproveAndDispatchMultisigProposalResponse, error)
+	// BatchApproveMultisigProposal approves a proposal with signatures collected off-chain,
+	// and dispatches it once the threshold is met.
+	BatchApproveMultisigProposal(ctx context.Context, in *MsgBatchApproveMultisigProposalParams, opts ...grpc.CallOption) (*MsgBatchApproveMultisigProposalResponse, error)
+	// ScheduleMultisigCall schedules a call of a multisig account, executed by the EndBlocker.
+	// It is signed by the multisig account, i.e. dispatched by an approved proposal.
+	ScheduleMultisigCall(ctx context.Context, in *MsgScheduleMultisigCallParams, opts ...grpc.CallOption) (*MsgScheduleMultisigCallResponse, error)
+	// CancelMultisigSchedule cancels the remaining executions of a schedule. It is signed by
+	// the multisig account, i.e. dispatched by an approved follow-up proposal.
+	CancelMultisigSchedule(ctx context.Context, in *MsgCancelMultisigScheduleParams, opts ...grpc.CallOption) (*MsgCancelMultisigScheduleResponse, error)
+	// FreezeMultisigAccount blocks the proposals and dispatched calls of a compromised multisig
+	// account. It is a governance operation.
+	FreezeMultisigAccount(ctx context.Context, in *MsgFreezeMultisigAccount, opts ...grpc.CallOption) (*MsgFreezeMultisigAccountResponse, error)
+	// RecoverMultisigAccount replaces the signers and threshold of a multisig account and
+	// unfreezes it. It is a governance operation.
+	RecoverMultisigAccount(ctx context.Context, in *MsgRecoverMultisigAccount, opts ...grpc.CallOption) (*MsgRecoverMultisigAccountResponse, error)
+	// CancelMultisigProposal removes an open proposal and refunds its deposit when signed by the
+	// depositor. Another signer votes against the proposal, which is removed and its deposit
+	// forfeited once the rejections reach the threshold of the multisig account.
 	CancelMultisigProposal(ctx context.Context, in *MsgCancelMultisigProposalParams, opts ...grpc.CallOption) (*MsgCancelMultisigProposalResponse, error)
 	CleanupMultisigProposal(ctx context.Context, in *MsgCleanupMultisigProposalParams, opts ...grpc.CallOption) (*MsgCleanupMultisigProposalResponse, error)
 }
@@ -131,6 +154,51 @@ func (c *msgClient) ApproveAndDispatchMultisigProposal(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *msgClient) BatchApproveMultisigProposal(ctx context.Context, in *MsgBatchApproveMultisigProposalParams, opts ...grpc.CallOption) (*MsgBatchApproveMultisigProposalResponse, error) {
+	out := new(MsgBatchApproveMultisigProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_BatchApproveMultisigProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleMultisigCall(ctx context.Context, in *MsgScheduleMultisigCallParams, opts ...grpc.CallOption) (*MsgScheduleMultisigCallResponse, error) {
+	out := new(MsgScheduleMultisigCallResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleMultisigCall_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelMultisigSchedule(ctx context.Context, in *MsgCancelMultisigScheduleParams, opts ...grpc.CallOption) (*MsgCancelMultisigScheduleResponse, error) {
+	out := new(MsgCancelMultisigScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelMultisigSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezeMultisigAccount(ctx context.Context, in *MsgFreezeMultisigAccount, opts ...grpc.CallOption) (*MsgFreezeMultisigAccountResponse, error) {
+	out := new(MsgFreezeMultisigAccountResponse)
+	err := c.cc.Invoke(ctx, Msg_FreezeMultisigAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RecoverMultisigAccount(ctx context.Context, in *MsgRecoverMultisigAccount, opts ...grpc.CallOption) (*MsgRecoverMultisigAccountResponse, error) {
+	out := new(MsgRecoverMultisigAccountResponse)
+	err := c.cc.Invoke(ctx, Msg_RecoverMultisigAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CancelMultisigProposal(ctx context.Context, in *MsgCancelMultisigProposalParams, opts ...grpc.CallOption) (*MsgCancelMultisigProposalResponse, error) {
 	out := new(MsgCancelMultisigProposalResponse)
 	err := c.cc.Invoke(ctx, Msg_CancelMultisigProposal_FullMethodName, in, out, opts...)
@@ -165,6 +233,24 @@ type MsgServer interface {
 	InitializeMultisigProposal(context.Context, *MsgInitializeMultisigProposalParams) (*MsgInitializeMultisigResponse, error)
 	ApproveMultisigProposal(context.Context, *MsgApproveMultisigProposalParams) (*MsgApproveMultisigProposalResponse, error)
 	ApproveAndDispatchMultisigProposal(context.Context, *MsgApproveAndDispatchMultisigProposalParams) (*MsgApproveAndDispatchMultisigProposalResponse, error)
+	// BatchApproveMultisigProposal approves a proposal with signatures collected off-chain,
+	// and dispatches it once the threshold is met.
+	BatchApproveMultisigProposal(context.Context, *MsgBatchApproveMultisigProposalParams) (*MsgBatchApproveMultisigProposalResponse, error)
+	// ScheduleMultisigCall schedules a call of a multisig account, executed by the EndBlocker.
+	// It is signed by the multisig account, i.e. dispatched by an approved proposal.
+	ScheduleMultisigCall(context.Context, *MsgScheduleMultisigCallParams) (*MsgScheduleMultisigCallResponse, error)
+	// CancelMultisigSchedule cancels the remaining executions of a schedule. It is signed by
+	// the multisig account, i.e. dispatched by an approved follow-up proposal.
+	CancelMultisigSchedule(context.Context, *MsgCancelMultisigScheduleParams) (*MsgCancelMultisigScheduleResponse, error)
+	// FreezeMultisigAccount blocks the proposals and dispatched calls of a compromised multisig
+	// account. It is a governance operation.
+	FreezeMultisigAccount(context.Context, *MsgFreezeMultisigAccount) (*MsgFreezeMultisigAccountResponse, error)
+	// RecoverMultisigAccount replaces the signers and threshold of a multisig account and
+	// unfreezes it. It is a governance operation.
+	RecoverMultisigAccount(context.Context, *MsgRecoverMultisigAccount) (*MsgRecoverMultisigAccountResponse, error)
+	// CancelMultisigProposal removes an open proposal and refunds its deposit when signed by the
+	// depositor. Another signer votes against the proposal, which is removed and its deposit
+	// forfeited once the rejections reach the threshold of the multisig account.
 	CancelMultisigProposal(context.Context, *MsgCancelMultisigProposalParams) (*MsgCancelMultisigProposalResponse, error)
 	CleanupMultisigProposal(context.Context, *MsgCleanupMultisigProposalParams) (*MsgCleanupMultisigProposalResponse, error)
 	mustEmbedUnimplementedMsgServer()
@@ -198,6 +284,21 @@ func (UnimplementedMsgServer) ApproveMultisigProposal(context.Context, *MsgAppro
 func (UnimplementedMsgServer) ApproveAndDispatchMultisigProposal(context.Context, *MsgApproveAndDispatchMultisigProposalParams) (*MsgApproveAndDispatchMultisigProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApproveAndDispatchMultisigProposal not implemented")
 }
+func (UnimplementedMsgServer) BatchApproveMultisigProposal(context.Context, *MsgBatchApproveMultisigProposalParams) (*MsgBatchApproveMultisigProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchApproveMultisigProposal not implemented")
+}
+func (UnimplementedMsgServer) ScheduleMultisigCall(context.Context, *MsgScheduleMultisigCallParams) (*MsgScheduleMultisigCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMultisigCall not implemented")
+}
+func (UnimplementedMsgServer) CancelMultisigSchedule(context.Context, *MsgCancelMultisigScheduleParams) (*MsgCancelMultisigScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMultisigSchedule not implemented")
+}
+func (UnimplementedMsgServer) FreezeMultisigAccount(context.Context, *MsgFreezeMultisigAccount) (*MsgFreezeMultisigAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeMultisigAccount not implemented")
+}
+func (UnimplementedMsgServer) RecoverMultisigAccount(context.Context, *MsgRecoverMultisigAccount) (*MsgRecoverMultisigAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverMultisigAccount not implemented")
+}
 func (UnimplementedMsgServer) CancelMultisigProposal(context.Context, *MsgCancelMultisigProposalParams) (*MsgCancelMultisigProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelMultisigProposal not implemented")
 }
@@ -361,6 +462,96 @@ func _Msg_ApproveAndDispatchMultisigProposal_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BatchApproveMultisigProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchApproveMultisigProposalParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchApproveMultisigProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BatchApproveMultisigProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchApproveMultisigProposal(ctx, req.(*MsgBatchApproveMultisigProposalParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleMultisigCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleMultisigCallParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleMultisigCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleMultisigCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleMultisigCall(ctx, req.(*MsgScheduleMultisigCallParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelMultisigSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelMultisigScheduleParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelMultisigSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelMultisigSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelMultisigSchedule(ctx, req.(*MsgCancelMultisigScheduleParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeMultisigAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeMultisigAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeMultisigAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FreezeMultisigAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeMultisigAccount(ctx, req.(*MsgFreezeMultisigAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RecoverMultisigAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverMultisigAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverMultisigAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RecoverMultisigAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverMultisigAccount(ctx, req.(*MsgRecoverMultisigAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CancelMultisigProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCancelMultisigProposalParams)
 	if err := dec(in); err != nil {
@@ -436,6 +627,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ApproveAndDispatchMultisigProposal",
 			Handler:    _Msg_ApproveAndDispatchMultisigProposal_Handler,
 		},
+		{
+			MethodName: "BatchApproveMultisigProposal",
+			Handler:    _Msg_BatchApproveMultisigProposal_Handler,
+		},
+		{
+			MethodName: "ScheduleMultisigCall",
+			Handler:    _Msg_ScheduleMultisigCall_Handler,
+		},
+		{
+			MethodName: "CancelMultisigSchedule",
+			Handler:    _Msg_CancelMultisigSchedule_Handler,
+		},
+		{
+			MethodName: "FreezeMultisigAccount",
+			Handler:    _Msg_FreezeMultisigAccount_Handler,
+		},
+		{
+			MethodName: "RecoverMultisigAccount",
+			Handler:    _Msg_RecoverMultisigAccount_Handler,
+		},
 		{
 			MethodName: "CancelMultisigProposal",
 			Handler:    _Msg_CancelMultisigProposal_Handler,
@@ -9,6 +9,8 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -269,6 +271,205 @@ func (x *_Params_15_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_21_list)(nil)
+
+type _Params_21_list struct {
+	list *[]uint64
+}
+
+func (x *_Params_21_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_21_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_Params_21_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_21_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_21_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field CandleIntervals as it is not of Message kind"))
+}
+
+func (x *_Params_21_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_21_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_Params_21_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_24_list)(nil)
+
+type _Params_24_list struct {
+	list *[]*MaxPriceMove
+}
+
+func (x *_Params_24_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_24_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_24_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MaxPriceMove)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_24_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MaxPriceMove)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_24_list) AppendMutable() protoreflect.Value {
+	v := new(MaxPriceMove)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_24_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_24_list) NewElement() protoreflect.Value {
+	v := new(MaxPriceMove)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_24_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_25_list)(nil)
+
+type _Params_25_list struct {
+	list *[]*DenomOverride
+}
+
+func (x *_Params_25_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_25_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_25_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomOverride)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_25_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomOverride)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_25_list) AppendMutable() protoreflect.Value {
+	v := new(DenomOverride)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_25_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_25_list) NewElement() protoreflect.Value {
+	v := new(DenomOverride)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_25_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_26_list)(nil)
+
+type _Params_26_list struct {
+	list *[]*OutlierFilter
+}
+
+func (x *_Params_26_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_26_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_26_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OutlierFilter)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_26_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OutlierFilter)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_26_list) AppendMutable() protoreflect.Value {
+	v := new(OutlierFilter)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_26_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_26_list) NewElement() protoreflect.Value {
+	v := new(OutlierFilter)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_26_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Params                               protoreflect.MessageDescriptor
 	fd_Params_vote_period                   protoreflect.FieldDescriptor
@@ -289,6 +490,14 @@ var (
 	fd_Params_usdc_ibc_denom                protoreflect.FieldDescriptor
 	fd_Params_slashing_enabled              protoreflect.FieldDescriptor
 	fd_Params_averaging_window              protoreflect.FieldDescriptor
+	fd_Params_max_vote_extension_rates      protoreflect.FieldDescriptor
+	fd_Params_price_submission_mode         protoreflect.FieldDescriptor
+	fd_Params_candle_intervals              protoreflect.FieldDescriptor
+	fd_Params_maximum_candles               protoreflect.FieldDescriptor
+	fd_Params_maximum_rate_staleness        protoreflect.FieldDescriptor
+	fd_Params_max_price_moves               protoreflect.FieldDescriptor
+	fd_Params_denom_overrides               protoreflect.FieldDescriptor
+	fd_Params_outlier_filters               protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -312,6 +521,14 @@ func init() {
 	fd_Params_usdc_ibc_denom = md_Params.Fields().ByName("usdc_ibc_denom")
 	fd_Params_slashing_enabled = md_Params.Fields().ByName("slashing_enabled")
 	fd_Params_averaging_window = md_Params.Fields().ByName("averaging_window")
+	fd_Params_max_vote_extension_rates = md_Params.Fields().ByName("max_vote_extension_rates")
+	fd_Params_price_submission_mode = md_Params.Fields().ByName("price_submission_mode")
+	fd_Params_candle_intervals = md_Params.Fields().ByName("candle_intervals")
+	fd_Params_maximum_candles = md_Params.Fields().ByName("maximum_candles")
+	fd_Params_maximum_rate_staleness = md_Params.Fields().ByName("maximum_rate_staleness")
+	fd_Params_max_price_moves = md_Params.Fields().ByName("max_price_moves")
+	fd_Params_denom_overrides = md_Params.Fields().ByName("denom_overrides")
+	fd_Params_outlier_filters = md_Params.Fields().ByName("outlier_filters")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -487,6 +704,54 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MaxVoteExtensionRates != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxVoteExtensionRates)
+		if !f(fd_Params_max_vote_extension_rates, value) {
+			return
+		}
+	}
+	if x.PriceSubmissionMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.PriceSubmissionMode))
+		if !f(fd_Params_price_submission_mode, value) {
+			return
+		}
+	}
+	if len(x.CandleIntervals) != 0 {
+		value := protoreflect.ValueOfList(&_Params_21_list{list: &x.CandleIntervals})
+		if !f(fd_Params_candle_intervals, value) {
+			return
+		}
+	}
+	if x.MaximumCandles != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaximumCandles)
+		if !f(fd_Params_maximum_candles, value) {
+			return
+		}
+	}
+	if x.MaximumRateStaleness != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaximumRateStaleness)
+		if !f(fd_Params_maximum_rate_staleness, value) {
+			return
+		}
+	}
+	if len(x.MaxPriceMoves) != 0 {
+		value := protoreflect.ValueOfList(&_Params_24_list{list: &x.MaxPriceMoves})
+		if !f(fd_Params_max_price_moves, value) {
+			return
+		}
+	}
+	if len(x.DenomOverrides) != 0 {
+		value := protoreflect.ValueOfList(&_Params_25_list{list: &x.DenomOverrides})
+		if !f(fd_Params_denom_overrides, value) {
+			return
+		}
+	}
+	if len(x.OutlierFilters) != 0 {
+		value := protoreflect.ValueOfList(&_Params_26_list{list: &x.OutlierFilters})
+		if !f(fd_Params_outlier_filters, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -538,6 +803,22 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.SlashingEnabled != false
 	case "oracle.v1.Params.averaging_window":
 		return x.AveragingWindow != uint64(0)
+	case "oracle.v1.Params.max_vote_extension_rates":
+		return x.MaxVoteExtensionRates != uint64(0)
+	case "oracle.v1.Params.price_submission_mode":
+		return x.PriceSubmissionMode != 0
+	case "oracle.v1.Params.candle_intervals":
+		return len(x.CandleIntervals) != 0
+	case "oracle.v1.Params.maximum_candles":
+		return x.MaximumCandles != uint64(0)
+	case "oracle.v1.Params.maximum_rate_staleness":
+		return x.MaximumRateStaleness != uint64(0)
+	case "oracle.v1.Params.max_price_moves":
+		return len(x.MaxPriceMoves) != 0
+	case "oracle.v1.Params.denom_overrides":
+		return len(x.DenomOverrides) != 0
+	case "oracle.v1.Params.outlier_filters":
+		return len(x.OutlierFilters) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.Params"))
@@ -590,6 +871,22 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.SlashingEnabled = false
 	case "oracle.v1.Params.averaging_window":
 		x.AveragingWindow = uint64(0)
+	case "oracle.v1.Params.max_vote_extension_rates":
+		x.MaxVoteExtensionRates = uint64(0)
+	case "oracle.v1.Params.price_submission_mode":
+		x.PriceSubmissionMode = 0
+	case "oracle.v1.Params.candle_intervals":
+		x.CandleIntervals = nil
+	case "oracle.v1.Params.maximum_candles":
+		x.MaximumCandles = uint64(0)
+	case "oracle.v1.Params.maximum_rate_staleness":
+		x.MaximumRateStaleness = uint64(0)
+	case "oracle.v1.Params.max_price_moves":
+		x.MaxPriceMoves = nil
+	case "oracle.v1.Params.denom_overrides":
+		x.DenomOverrides = nil
+	case "oracle.v1.Params.outlier_filters":
+		x.OutlierFilters = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.Params"))
@@ -675,6 +972,42 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "oracle.v1.Params.averaging_window":
 		value := x.AveragingWindow
 		return protoreflect.ValueOfUint64(value)
+	case "oracle.v1.Params.max_vote_extension_rates":
+		value := x.MaxVoteExtensionRates
+		return protoreflect.ValueOfUint64(value)
+	case "oracle.v1.Params.price_submission_mode":
+		value := x.PriceSubmissionMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "oracle.v1.Params.candle_intervals":
+		if len(x.CandleIntervals) == 0 {
+			return protoreflect.ValueOfList(&_Params_21_list{})
+		}
+		listValue := &_Params_21_list{list: &x.CandleIntervals}
+		return protoreflect.ValueOfList(listValue)
+	case "oracle.v1.Params.maximum_candles":
+		value := x.MaximumCandles
+		return protoreflect.ValueOfUint64(value)
+	case "oracle.v1.Params.maximum_rate_staleness":
+		value := x.MaximumRateStaleness
+		return protoreflect.ValueOfUint64(value)
+	case "oracle.v1.Params.max_price_moves":
+		if len(x.MaxPriceMoves) == 0 {
+			return protoreflect.ValueOfList(&_Params_24_list{})
+		}
+		listValue := &_Params_24_list{list: &x.MaxPriceMoves}
+		return protoreflect.ValueOfList(listValue)
+	case "oracle.v1.Params.denom_overrides":
+		if len(x.DenomOverrides) == 0 {
+			return protoreflect.ValueOfList(&_Params_25_list{})
+		}
+		listValue := &_Params_25_list{list: &x.DenomOverrides}
+		return protoreflect.ValueOfList(listValue)
+	case "oracle.v1.Params.outlier_filters":
+		if len(x.OutlierFilters) == 0 {
+			return protoreflect.ValueOfList(&_Params_26_list{})
+		}
+		listValue := &_Params_26_list{list: &x.OutlierFilters}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.Params"))
@@ -741,6 +1074,30 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.SlashingEnabled = value.Bool()
 	case "oracle.v1.Params.averaging_window":
 		x.AveragingWindow = value.Uint()
+	case "oracle.v1.Params.max_vote_extension_rates":
+		x.MaxVoteExtensionRates = value.Uint()
+	case "oracle.v1.Params.price_submission_mode":
+		x.PriceSubmissionMode = (PriceSubmissionMode)(value.Enum())
+	case "oracle.v1.Params.candle_intervals":
+		lv := value.List()
+		clv := lv.(*_Params_21_list)
+		x.CandleIntervals = *clv.list
+	case "oracle.v1.Params.maximum_candles":
+		x.MaximumCandles = value.Uint()
+	case "oracle.v1.Params.maximum_rate_staleness":
+		x.MaximumRateStaleness = value.Uint()
+	case "oracle.v1.Params.max_price_moves":
+		lv := value.List()
+		clv := lv.(*_Params_24_list)
+		x.MaxPriceMoves = *clv.list
+	case "oracle.v1.Params.denom_overrides":
+		lv := value.List()
+		clv := lv.(*_Params_25_list)
+		x.DenomOverrides = *clv.list
+	case "oracle.v1.Params.outlier_filters":
+		lv := value.List()
+		clv := lv.(*_Params_26_list)
+		x.OutlierFilters = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.Params"))
@@ -791,6 +1148,30 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Params_15_list{list: &x.CurrencyDeviationThresholds}
 		return protoreflect.ValueOfList(value)
+	case "oracle.v1.Params.candle_intervals":
+		if x.CandleIntervals == nil {
+			x.CandleIntervals = []uint64{}
+		}
+		value := &_Params_21_list{list: &x.CandleIntervals}
+		return protoreflect.ValueOfList(value)
+	case "oracle.v1.Params.max_price_moves":
+		if x.MaxPriceMoves == nil {
+			x.MaxPriceMoves = []*MaxPriceMove{}
+		}
+		value := &_Params_24_list{list: &x.MaxPriceMoves}
+		return protoreflect.ValueOfList(value)
+	case "oracle.v1.Params.denom_overrides":
+		if x.DenomOverrides == nil {
+			x.DenomOverrides = []*DenomOverride{}
+		}
+		value := &_Params_25_list{list: &x.DenomOverrides}
+		return protoreflect.ValueOfList(value)
+	case "oracle.v1.Params.outlier_filters":
+		if x.OutlierFilters == nil {
+			x.OutlierFilters = []*OutlierFilter{}
+		}
+		value := &_Params_26_list{list: &x.OutlierFilters}
+		return protoreflect.ValueOfList(value)
 	case "oracle.v1.Params.vote_period":
 		panic(fmt.Errorf("field vote_period of message oracle.v1.Params is not mutable"))
 	case "oracle.v1.Params.vote_threshold":
@@ -817,6 +1198,14 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field slashing_enabled of message oracle.v1.Params is not mutable"))
 	case "oracle.v1.Params.averaging_window":
 		panic(fmt.Errorf("field averaging_window of message oracle.v1.Params is not mutable"))
+	case "oracle.v1.Params.max_vote_extension_rates":
+		panic(fmt.Errorf("field max_vote_extension_rates of message oracle.v1.Params is not mutable"))
+	case "oracle.v1.Params.price_submission_mode":
+		panic(fmt.Errorf("field price_submission_mode of message oracle.v1.Params is not mutable"))
+	case "oracle.v1.Params.maximum_candles":
+		panic(fmt.Errorf("field maximum_candles of message oracle.v1.Params is not mutable"))
+	case "oracle.v1.Params.maximum_rate_staleness":
+		panic(fmt.Errorf("field maximum_rate_staleness of message oracle.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.Params"))
@@ -871,6 +1260,26 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfBool(false)
 	case "oracle.v1.Params.averaging_window":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "oracle.v1.Params.max_vote_extension_rates":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "oracle.v1.Params.price_submission_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "oracle.v1.Params.candle_intervals":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_Params_21_list{list: &list})
+	case "oracle.v1.Params.maximum_candles":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "oracle.v1.Params.maximum_rate_staleness":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "oracle.v1.Params.max_price_moves":
+		list := []*MaxPriceMove{}
+		return protoreflect.ValueOfList(&_Params_24_list{list: &list})
+	case "oracle.v1.Params.denom_overrides":
+		list := []*DenomOverride{}
+		return protoreflect.ValueOfList(&_Params_25_list{list: &list})
+	case "oracle.v1.Params.outlier_filters":
+		list := []*OutlierFilter{}
+		return protoreflect.ValueOfList(&_Params_26_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.Params"))
@@ -1013,6 +1422,43 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.AveragingWindow != 0 {
 			n += 2 + runtime.Sov(uint64(x.AveragingWindow))
 		}
+		if x.MaxVoteExtensionRates != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxVoteExtensionRates))
+		}
+		if x.PriceSubmissionMode != 0 {
+			n += 2 + runtime.Sov(uint64(x.PriceSubmissionMode))
+		}
+		if len(x.CandleIntervals) > 0 {
+			l = 0
+			for _, e := range x.CandleIntervals {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 2 + runtime.Sov(uint64(l)) + l
+		}
+		if x.MaximumCandles != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaximumCandles))
+		}
+		if x.MaximumRateStaleness != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaximumRateStaleness))
+		}
+		if len(x.MaxPriceMoves) > 0 {
+			for _, e := range x.MaxPriceMoves {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DenomOverrides) > 0 {
+			for _, e := range x.DenomOverrides {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.OutlierFilters) > 0 {
+			for _, e := range x.OutlierFilters {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1042,37 +1488,27 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.AveragingWindow != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AveragingWindow))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x90
-		}
-		if x.SlashingEnabled {
-			i--
-			if x.SlashingEnabled {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+		if len(x.OutlierFilters) > 0 {
+			for iNdEx := len(x.OutlierFilters) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OutlierFilters[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xd2
 			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x88
-		}
-		if len(x.UsdcIbcDenom) > 0 {
-			i -= len(x.UsdcIbcDenom)
-			copy(dAtA[i:], x.UsdcIbcDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UsdcIbcDenom)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x82
 		}
-		if len(x.CurrencyDeviationThresholds) > 0 {
-			for iNdEx := len(x.CurrencyDeviationThresholds) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.CurrencyDeviationThresholds[iNdEx])
+		if len(x.DenomOverrides) > 0 {
+			for iNdEx := len(x.DenomOverrides) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DenomOverrides[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1083,12 +1519,14 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x7a
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xca
 			}
 		}
-		if len(x.CurrencyPairProviders) > 0 {
-			for iNdEx := len(x.CurrencyPairProviders) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.CurrencyPairProviders[iNdEx])
+		if len(x.MaxPriceMoves) > 0 {
+			for iNdEx := len(x.MaxPriceMoves) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MaxPriceMoves[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1099,18 +1537,130 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x72
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xc2
 			}
 		}
-		if x.MaximumMedianStamps != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaximumMedianStamps))
+		if x.MaximumRateStaleness != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaximumRateStaleness))
 			i--
-			dAtA[i] = 0x68
-		}
-		if x.MaximumPriceStamps != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaximumPriceStamps))
+			dAtA[i] = 0x1
 			i--
-			dAtA[i] = 0x60
+			dAtA[i] = 0xb8
+		}
+		if x.MaximumCandles != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaximumCandles))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb0
+		}
+		if len(x.CandleIntervals) > 0 {
+			var pksize2 int
+			for _, num := range x.CandleIntervals {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.CandleIntervals {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if x.PriceSubmissionMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PriceSubmissionMode))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
+		}
+		if x.MaxVoteExtensionRates != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxVoteExtensionRates))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
+		}
+		if x.AveragingWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AveragingWindow))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if x.SlashingEnabled {
+			i--
+			if x.SlashingEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if len(x.UsdcIbcDenom) > 0 {
+			i -= len(x.UsdcIbcDenom)
+			copy(dAtA[i:], x.UsdcIbcDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UsdcIbcDenom)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if len(x.CurrencyDeviationThresholds) > 0 {
+			for iNdEx := len(x.CurrencyDeviationThresholds) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CurrencyDeviationThresholds[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if len(x.CurrencyPairProviders) > 0 {
+			for iNdEx := len(x.CurrencyPairProviders) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CurrencyPairProviders[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if x.MaximumMedianStamps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaximumMedianStamps))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.MaximumPriceStamps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaximumPriceStamps))
+			i--
+			dAtA[i] = 0x60
 		}
 		if x.MedianStampPeriod != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.MedianStampPeriod))
@@ -1725,6 +2275,260 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxVoteExtensionRates", wireType)
+				}
+				x.MaxVoteExtensionRates = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxVoteExtensionRates |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceSubmissionMode", wireType)
+				}
+				x.PriceSubmissionMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PriceSubmissionMode |= PriceSubmissionMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 21:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.CandleIntervals = append(x.CandleIntervals, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.CandleIntervals) == 0 {
+						x.CandleIntervals = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.CandleIntervals = append(x.CandleIntervals, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CandleIntervals", wireType)
+				}
+			case 22:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaximumCandles", wireType)
+				}
+				x.MaximumCandles = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaximumCandles |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 23:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaximumRateStaleness", wireType)
+				}
+				x.MaximumRateStaleness = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaximumRateStaleness |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 24:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPriceMoves", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxPriceMoves = append(x.MaxPriceMoves, &MaxPriceMove{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxPriceMoves[len(x.MaxPriceMoves)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 25:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomOverrides", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomOverrides = append(x.DenomOverrides, &DenomOverride{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomOverrides[len(x.DenomOverrides)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 26:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutlierFilters", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OutlierFilters = append(x.OutlierFilters, &OutlierFilter{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OutlierFilters[len(x.OutlierFilters)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2777,29 +3581,27 @@ func (x *fastReflection_RewardBand) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_AggregateExchangeRatePrevote              protoreflect.MessageDescriptor
-	fd_AggregateExchangeRatePrevote_hash         protoreflect.FieldDescriptor
-	fd_AggregateExchangeRatePrevote_voter        protoreflect.FieldDescriptor
-	fd_AggregateExchangeRatePrevote_submit_block protoreflect.FieldDescriptor
+	md_MaxPriceMove              protoreflect.MessageDescriptor
+	fd_MaxPriceMove_symbol_denom protoreflect.FieldDescriptor
+	fd_MaxPriceMove_max_move     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_oracle_v1_oracle_proto_init()
-	md_AggregateExchangeRatePrevote = File_oracle_v1_oracle_proto.Messages().ByName("AggregateExchangeRatePrevote")
-	fd_AggregateExchangeRatePrevote_hash = md_AggregateExchangeRatePrevote.Fields().ByName("hash")
-	fd_AggregateExchangeRatePrevote_voter = md_AggregateExchangeRatePrevote.Fields().ByName("voter")
-	fd_AggregateExchangeRatePrevote_submit_block = md_AggregateExchangeRatePrevote.Fields().ByName("submit_block")
+	md_MaxPriceMove = File_oracle_v1_oracle_proto.Messages().ByName("MaxPriceMove")
+	fd_MaxPriceMove_symbol_denom = md_MaxPriceMove.Fields().ByName("symbol_denom")
+	fd_MaxPriceMove_max_move = md_MaxPriceMove.Fields().ByName("max_move")
 }
 
-var _ protoreflect.Message = (*fastReflection_AggregateExchangeRatePrevote)(nil)
+var _ protoreflect.Message = (*fastReflection_MaxPriceMove)(nil)
 
-type fastReflection_AggregateExchangeRatePrevote AggregateExchangeRatePrevote
+type fastReflection_MaxPriceMove MaxPriceMove
 
-func (x *AggregateExchangeRatePrevote) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AggregateExchangeRatePrevote)(x)
+func (x *MaxPriceMove) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MaxPriceMove)(x)
 }
 
-func (x *AggregateExchangeRatePrevote) slowProtoReflect() protoreflect.Message {
+func (x *MaxPriceMove) slowProtoReflect() protoreflect.Message {
 	mi := &file_oracle_v1_oracle_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2811,43 +3613,43 @@ func (x *AggregateExchangeRatePrevote) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_AggregateExchangeRatePrevote_messageType fastReflection_AggregateExchangeRatePrevote_messageType
-var _ protoreflect.MessageType = fastReflection_AggregateExchangeRatePrevote_messageType{}
+var _fastReflection_MaxPriceMove_messageType fastReflection_MaxPriceMove_messageType
+var _ protoreflect.MessageType = fastReflection_MaxPriceMove_messageType{}
 
-type fastReflection_AggregateExchangeRatePrevote_messageType struct{}
+type fastReflection_MaxPriceMove_messageType struct{}
 
-func (x fastReflection_AggregateExchangeRatePrevote_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AggregateExchangeRatePrevote)(nil)
+func (x fastReflection_MaxPriceMove_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MaxPriceMove)(nil)
 }
-func (x fastReflection_AggregateExchangeRatePrevote_messageType) New() protoreflect.Message {
-	return new(fastReflection_AggregateExchangeRatePrevote)
+func (x fastReflection_MaxPriceMove_messageType) New() protoreflect.Message {
+	return new(fastReflection_MaxPriceMove)
 }
-func (x fastReflection_AggregateExchangeRatePrevote_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AggregateExchangeRatePrevote
+func (x fastReflection_MaxPriceMove_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MaxPriceMove
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_AggregateExchangeRatePrevote) Descriptor() protoreflect.MessageDescriptor {
-	return md_AggregateExchangeRatePrevote
+func (x *fastReflection_MaxPriceMove) Descriptor() protoreflect.MessageDescriptor {
+	return md_MaxPriceMove
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AggregateExchangeRatePrevote) Type() protoreflect.MessageType {
-	return _fastReflection_AggregateExchangeRatePrevote_messageType
+func (x *fastReflection_MaxPriceMove) Type() protoreflect.MessageType {
+	return _fastReflection_MaxPriceMove_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AggregateExchangeRatePrevote) New() protoreflect.Message {
-	return new(fastReflection_AggregateExchangeRatePrevote)
+func (x *fastReflection_MaxPriceMove) New() protoreflect.Message {
+	return new(fastReflection_MaxPriceMove)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_AggregateExchangeRatePrevote) Interface() protoreflect.ProtoMessage {
-	return (*AggregateExchangeRatePrevote)(x)
+func (x *fastReflection_MaxPriceMove) Interface() protoreflect.ProtoMessage {
+	return (*MaxPriceMove)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2855,22 +3657,16 @@ func (x *fastReflection_AggregateExchangeRatePrevote) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_AggregateExchangeRatePrevote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Hash != "" {
-		value := protoreflect.ValueOfString(x.Hash)
-		if !f(fd_AggregateExchangeRatePrevote_hash, value) {
-			return
-		}
-	}
-	if x.Voter != "" {
-		value := protoreflect.ValueOfString(x.Voter)
-		if !f(fd_AggregateExchangeRatePrevote_voter, value) {
+func (x *fastReflection_MaxPriceMove) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SymbolDenom != "" {
+		value := protoreflect.ValueOfString(x.SymbolDenom)
+		if !f(fd_MaxPriceMove_symbol_denom, value) {
 			return
 		}
 	}
-	if x.SubmitBlock != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.SubmitBlock)
-		if !f(fd_AggregateExchangeRatePrevote_submit_block, value) {
+	if x.MaxMove != "" {
+		value := protoreflect.ValueOfString(x.MaxMove)
+		if !f(fd_MaxPriceMove_max_move, value) {
 			return
 		}
 	}
@@ -2887,19 +3683,17 @@ func (x *fastReflection_AggregateExchangeRatePrevote) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_AggregateExchangeRatePrevote) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MaxPriceMove) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "oracle.v1.AggregateExchangeRatePrevote.hash":
-		return x.Hash != ""
-	case "oracle.v1.AggregateExchangeRatePrevote.voter":
-		return x.Voter != ""
-	case "oracle.v1.AggregateExchangeRatePrevote.submit_block":
-		return x.SubmitBlock != uint64(0)
+	case "oracle.v1.MaxPriceMove.symbol_denom":
+		return x.SymbolDenom != ""
+	case "oracle.v1.MaxPriceMove.max_move":
+		return x.MaxMove != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.AggregateExchangeRatePrevote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.MaxPriceMove"))
 		}
-		panic(fmt.Errorf("message oracle.v1.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.MaxPriceMove does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2909,19 +3703,17 @@ func (x *fastReflection_AggregateExchangeRatePrevote) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AggregateExchangeRatePrevote) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MaxPriceMove) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "oracle.v1.AggregateExchangeRatePrevote.hash":
-		x.Hash = ""
-	case "oracle.v1.AggregateExchangeRatePrevote.voter":
-		x.Voter = ""
-	case "oracle.v1.AggregateExchangeRatePrevote.submit_block":
-		x.SubmitBlock = uint64(0)
+	case "oracle.v1.MaxPriceMove.symbol_denom":
+		x.SymbolDenom = ""
+	case "oracle.v1.MaxPriceMove.max_move":
+		x.MaxMove = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.AggregateExchangeRatePrevote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.MaxPriceMove"))
 		}
-		panic(fmt.Errorf("message oracle.v1.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.MaxPriceMove does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2931,22 +3723,19 @@ func (x *fastReflection_AggregateExchangeRatePrevote) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AggregateExchangeRatePrevote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MaxPriceMove) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "oracle.v1.AggregateExchangeRatePrevote.hash":
-		value := x.Hash
+	case "oracle.v1.MaxPriceMove.symbol_denom":
+		value := x.SymbolDenom
 		return protoreflect.ValueOfString(value)
-	case "oracle.v1.AggregateExchangeRatePrevote.voter":
-		value := x.Voter
+	case "oracle.v1.MaxPriceMove.max_move":
+		value := x.MaxMove
 		return protoreflect.ValueOfString(value)
-	case "oracle.v1.AggregateExchangeRatePrevote.submit_block":
-		value := x.SubmitBlock
-		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.AggregateExchangeRatePrevote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.MaxPriceMove"))
 		}
-		panic(fmt.Errorf("message oracle.v1.AggregateExchangeRatePrevote does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message oracle.v1.MaxPriceMove does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2960,19 +3749,17 @@ func (x *fastReflection_AggregateExchangeRatePrevote) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AggregateExchangeRatePrevote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MaxPriceMove) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "oracle.v1.AggregateExchangeRatePrevote.hash":
-		x.Hash = value.Interface().(string)
-	case "oracle.v1.AggregateExchangeRatePrevote.voter":
-		x.Voter = value.Interface().(string)
-	case "oracle.v1.AggregateExchangeRatePrevote.submit_block":
-		x.SubmitBlock = value.Uint()
+	case "oracle.v1.MaxPriceMove.symbol_denom":
+		x.SymbolDenom = value.Interface().(string)
+	case "oracle.v1.MaxPriceMove.max_move":
+		x.MaxMove = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.AggregateExchangeRatePrevote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.MaxPriceMove"))
 		}
-		panic(fmt.Errorf("message oracle.v1.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.MaxPriceMove does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2986,48 +3773,44 @@ func (x *fastReflection_AggregateExchangeRatePrevote) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AggregateExchangeRatePrevote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MaxPriceMove) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "oracle.v1.AggregateExchangeRatePrevote.hash":
-		panic(fmt.Errorf("field hash of message oracle.v1.AggregateExchangeRatePrevote is not mutable"))
-	case "oracle.v1.AggregateExchangeRatePrevote.voter":
-		panic(fmt.Errorf("field voter of message oracle.v1.AggregateExchangeRatePrevote is not mutable"))
-	case "oracle.v1.AggregateExchangeRatePrevote.submit_block":
-		panic(fmt.Errorf("field submit_block of message oracle.v1.AggregateExchangeRatePrevote is not mutable"))
+	case "oracle.v1.MaxPriceMove.symbol_denom":
+		panic(fmt.Errorf("field symbol_denom of message oracle.v1.MaxPriceMove is not mutable"))
+	case "oracle.v1.MaxPriceMove.max_move":
+		panic(fmt.Errorf("field max_move of message oracle.v1.MaxPriceMove is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.AggregateExchangeRatePrevote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.MaxPriceMove"))
 		}
-		panic(fmt.Errorf("message oracle.v1.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.MaxPriceMove does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AggregateExchangeRatePrevote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MaxPriceMove) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "oracle.v1.AggregateExchangeRatePrevote.hash":
+	case "oracle.v1.MaxPriceMove.symbol_denom":
 		return protoreflect.ValueOfString("")
-	case "oracle.v1.AggregateExchangeRatePrevote.voter":
+	case "oracle.v1.MaxPriceMove.max_move":
 		return protoreflect.ValueOfString("")
-	case "oracle.v1.AggregateExchangeRatePrevote.submit_block":
-		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.AggregateExchangeRatePrevote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.MaxPriceMove"))
 		}
-		panic(fmt.Errorf("message oracle.v1.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.MaxPriceMove does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AggregateExchangeRatePrevote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MaxPriceMove) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.AggregateExchangeRatePrevote", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.MaxPriceMove", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3035,7 +3818,7 @@ func (x *fastReflection_AggregateExchangeRatePrevote) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AggregateExchangeRatePrevote) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MaxPriceMove) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3046,7 +3829,7 @@ func (x *fastReflection_AggregateExchangeRatePrevote) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AggregateExchangeRatePrevote) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MaxPriceMove) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3058,7 +3841,7 @@ func (x *fastReflection_AggregateExchangeRatePrevote) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AggregateExchangeRatePrevote) IsValid() bool {
+func (x *fastReflection_MaxPriceMove) IsValid() bool {
 	return x != nil
 }
 
@@ -3068,9 +3851,9 @@ func (x *fastReflection_AggregateExchangeRatePrevote) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MaxPriceMove) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AggregateExchangeRatePrevote)
+		x := input.Message.Interface().(*MaxPriceMove)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3082,17 +3865,14 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 		var n int
 		var l int
 		_ = l
-		l = len(x.Hash)
+		l = len(x.SymbolDenom)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Voter)
+		l = len(x.MaxMove)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.SubmitBlock != 0 {
-			n += 1 + runtime.Sov(uint64(x.SubmitBlock))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3103,7 +3883,7 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AggregateExchangeRatePrevote)
+		x := input.Message.Interface().(*MaxPriceMove)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3122,22 +3902,17 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.SubmitBlock != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubmitBlock))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.Voter) > 0 {
-			i -= len(x.Voter)
-			copy(dAtA[i:], x.Voter)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
+		if len(x.MaxMove) > 0 {
+			i -= len(x.MaxMove)
+			copy(dAtA[i:], x.MaxMove)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxMove)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Hash) > 0 {
-			i -= len(x.Hash)
-			copy(dAtA[i:], x.Hash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+		if len(x.SymbolDenom) > 0 {
+			i -= len(x.SymbolDenom)
+			copy(dAtA[i:], x.SymbolDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SymbolDenom)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3152,7 +3927,7 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AggregateExchangeRatePrevote)
+		x := input.Message.Interface().(*MaxPriceMove)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3184,15 +3959,15 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MaxPriceMove: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MaxPriceMove: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3220,11 +3995,11 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Hash = string(dAtA[iNdEx:postIndex])
+				x.SymbolDenom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMove", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3252,27 +4027,8 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Voter = string(dAtA[iNdEx:postIndex])
+				x.MaxMove = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
-				}
-				x.SubmitBlock = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.SubmitBlock |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3308,79 +4064,32 @@ func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface
 	}
 }
 
-var _ protoreflect.List = (*_AggregateExchangeRateVote_1_list)(nil)
-
-type _AggregateExchangeRateVote_1_list struct {
-	list *[]*v1beta1.DecCoin
-}
-
-func (x *_AggregateExchangeRateVote_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_AggregateExchangeRateVote_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_AggregateExchangeRateVote_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_AggregateExchangeRateVote_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_AggregateExchangeRateVote_1_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.DecCoin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_AggregateExchangeRateVote_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_AggregateExchangeRateVote_1_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.DecCoin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_AggregateExchangeRateVote_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_AggregateExchangeRateVote                protoreflect.MessageDescriptor
-	fd_AggregateExchangeRateVote_exchange_rates protoreflect.FieldDescriptor
-	fd_AggregateExchangeRateVote_voter          protoreflect.FieldDescriptor
+	md_DenomOverride                        protoreflect.MessageDescriptor
+	fd_DenomOverride_symbol_denom           protoreflect.FieldDescriptor
+	fd_DenomOverride_vote_threshold         protoreflect.FieldDescriptor
+	fd_DenomOverride_min_voters             protoreflect.FieldDescriptor
+	fd_DenomOverride_vote_period_multiplier protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_oracle_v1_oracle_proto_init()
-	md_AggregateExchangeRateVote = File_oracle_v1_oracle_proto.Messages().ByName("AggregateExchangeRateVote")
-	fd_AggregateExchangeRateVote_exchange_rates = md_AggregateExchangeRateVote.Fields().ByName("exchange_rates")
-	fd_AggregateExchangeRateVote_voter = md_AggregateExchangeRateVote.Fields().ByName("voter")
+	md_DenomOverride = File_oracle_v1_oracle_proto.Messages().ByName("DenomOverride")
+	fd_DenomOverride_symbol_denom = md_DenomOverride.Fields().ByName("symbol_denom")
+	fd_DenomOverride_vote_threshold = md_DenomOverride.Fields().ByName("vote_threshold")
+	fd_DenomOverride_min_voters = md_DenomOverride.Fields().ByName("min_voters")
+	fd_DenomOverride_vote_period_multiplier = md_DenomOverride.Fields().ByName("vote_period_multiplier")
 }
 
-var _ protoreflect.Message = (*fastReflection_AggregateExchangeRateVote)(nil)
+var _ protoreflect.Message = (*fastReflection_DenomOverride)(nil)
 
-type fastReflection_AggregateExchangeRateVote AggregateExchangeRateVote
+type fastReflection_DenomOverride DenomOverride
 
-func (x *AggregateExchangeRateVote) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AggregateExchangeRateVote)(x)
+func (x *DenomOverride) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DenomOverride)(x)
 }
 
-func (x *AggregateExchangeRateVote) slowProtoReflect() protoreflect.Message {
+func (x *DenomOverride) slowProtoReflect() protoreflect.Message {
 	mi := &file_oracle_v1_oracle_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3392,43 +4101,43 @@ func (x *AggregateExchangeRateVote) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_AggregateExchangeRateVote_messageType fastReflection_AggregateExchangeRateVote_messageType
-var _ protoreflect.MessageType = fastReflection_AggregateExchangeRateVote_messageType{}
+var _fastReflection_DenomOverride_messageType fastReflection_DenomOverride_messageType
+var _ protoreflect.MessageType = fastReflection_DenomOverride_messageType{}
 
-type fastReflection_AggregateExchangeRateVote_messageType struct{}
+type fastReflection_DenomOverride_messageType struct{}
 
-func (x fastReflection_AggregateExchangeRateVote_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AggregateExchangeRateVote)(nil)
+func (x fastReflection_DenomOverride_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DenomOverride)(nil)
 }
-func (x fastReflection_AggregateExchangeRateVote_messageType) New() protoreflect.Message {
-	return new(fastReflection_AggregateExchangeRateVote)
+func (x fastReflection_DenomOverride_messageType) New() protoreflect.Message {
+	return new(fastReflection_DenomOverride)
 }
-func (x fastReflection_AggregateExchangeRateVote_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AggregateExchangeRateVote
+func (x fastReflection_DenomOverride_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenomOverride
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_AggregateExchangeRateVote) Descriptor() protoreflect.MessageDescriptor {
-	return md_AggregateExchangeRateVote
+func (x *fastReflection_DenomOverride) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenomOverride
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AggregateExchangeRateVote) Type() protoreflect.MessageType {
-	return _fastReflection_AggregateExchangeRateVote_messageType
+func (x *fastReflection_DenomOverride) Type() protoreflect.MessageType {
+	return _fastReflection_DenomOverride_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AggregateExchangeRateVote) New() protoreflect.Message {
-	return new(fastReflection_AggregateExchangeRateVote)
+func (x *fastReflection_DenomOverride) New() protoreflect.Message {
+	return new(fastReflection_DenomOverride)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_AggregateExchangeRateVote) Interface() protoreflect.ProtoMessage {
-	return (*AggregateExchangeRateVote)(x)
+func (x *fastReflection_DenomOverride) Interface() protoreflect.ProtoMessage {
+	return (*DenomOverride)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3436,16 +4145,28 @@ func (x *fastReflection_AggregateExchangeRateVote) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_AggregateExchangeRateVote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.ExchangeRates) != 0 {
-		value := protoreflect.ValueOfList(&_AggregateExchangeRateVote_1_list{list: &x.ExchangeRates})
-		if !f(fd_AggregateExchangeRateVote_exchange_rates, value) {
+func (x *fastReflection_DenomOverride) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SymbolDenom != "" {
+		value := protoreflect.ValueOfString(x.SymbolDenom)
+		if !f(fd_DenomOverride_symbol_denom, value) {
 			return
 		}
 	}
-	if x.Voter != "" {
-		value := protoreflect.ValueOfString(x.Voter)
-		if !f(fd_AggregateExchangeRateVote_voter, value) {
+	if x.VoteThreshold != "" {
+		value := protoreflect.ValueOfString(x.VoteThreshold)
+		if !f(fd_DenomOverride_vote_threshold, value) {
+			return
+		}
+	}
+	if x.MinVoters != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinVoters)
+		if !f(fd_DenomOverride_min_voters, value) {
+			return
+		}
+	}
+	if x.VotePeriodMultiplier != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.VotePeriodMultiplier)
+		if !f(fd_DenomOverride_vote_period_multiplier, value) {
 			return
 		}
 	}
@@ -3462,17 +4183,21 @@ func (x *fastReflection_AggregateExchangeRateVote) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_AggregateExchangeRateVote) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DenomOverride) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "oracle.v1.AggregateExchangeRateVote.exchange_rates":
-		return len(x.ExchangeRates) != 0
-	case "oracle.v1.AggregateExchangeRateVote.voter":
-		return x.Voter != ""
+	case "oracle.v1.DenomOverride.symbol_denom":
+		return x.SymbolDenom != ""
+	case "oracle.v1.DenomOverride.vote_threshold":
+		return x.VoteThreshold != ""
+	case "oracle.v1.DenomOverride.min_voters":
+		return x.MinVoters != uint64(0)
+	case "oracle.v1.DenomOverride.vote_period_multiplier":
+		return x.VotePeriodMultiplier != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.AggregateExchangeRateVote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.DenomOverride"))
 		}
-		panic(fmt.Errorf("message oracle.v1.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.DenomOverride does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3482,17 +4207,21 @@ func (x *fastReflection_AggregateExchangeRateVote) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AggregateExchangeRateVote) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DenomOverride) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "oracle.v1.AggregateExchangeRateVote.exchange_rates":
-		x.ExchangeRates = nil
-	case "oracle.v1.AggregateExchangeRateVote.voter":
-		x.Voter = ""
+	case "oracle.v1.DenomOverride.symbol_denom":
+		x.SymbolDenom = ""
+	case "oracle.v1.DenomOverride.vote_threshold":
+		x.VoteThreshold = ""
+	case "oracle.v1.DenomOverride.min_voters":
+		x.MinVoters = uint64(0)
+	case "oracle.v1.DenomOverride.vote_period_multiplier":
+		x.VotePeriodMultiplier = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.AggregateExchangeRateVote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.DenomOverride"))
 		}
-		panic(fmt.Errorf("message oracle.v1.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.DenomOverride does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3502,22 +4231,25 @@ func (x *fastReflection_AggregateExchangeRateVote) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AggregateExchangeRateVote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DenomOverride) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "oracle.v1.AggregateExchangeRateVote.exchange_rates":
-		if len(x.ExchangeRates) == 0 {
-			return protoreflect.ValueOfList(&_AggregateExchangeRateVote_1_list{})
-		}
-		listValue := &_AggregateExchangeRateVote_1_list{list: &x.ExchangeRates}
-		return protoreflect.ValueOfList(listValue)
-	case "oracle.v1.AggregateExchangeRateVote.voter":
-		value := x.Voter
+	case "oracle.v1.DenomOverride.symbol_denom":
+		value := x.SymbolDenom
+		return protoreflect.ValueOfString(value)
+	case "oracle.v1.DenomOverride.vote_threshold":
+		value := x.VoteThreshold
 		return protoreflect.ValueOfString(value)
+	case "oracle.v1.DenomOverride.min_voters":
+		value := x.MinVoters
+		return protoreflect.ValueOfUint64(value)
+	case "oracle.v1.DenomOverride.vote_period_multiplier":
+		value := x.VotePeriodMultiplier
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.AggregateExchangeRateVote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.DenomOverride"))
 		}
-		panic(fmt.Errorf("message oracle.v1.AggregateExchangeRateVote does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message oracle.v1.DenomOverride does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3531,19 +4263,21 @@ func (x *fastReflection_AggregateExchangeRateVote) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AggregateExchangeRateVote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DenomOverride) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "oracle.v1.AggregateExchangeRateVote.exchange_rates":
-		lv := value.List()
-		clv := lv.(*_AggregateExchangeRateVote_1_list)
-		x.ExchangeRates = *clv.list
-	case "oracle.v1.AggregateExchangeRateVote.voter":
-		x.Voter = value.Interface().(string)
+	case "oracle.v1.DenomOverride.symbol_denom":
+		x.SymbolDenom = value.Interface().(string)
+	case "oracle.v1.DenomOverride.vote_threshold":
+		x.VoteThreshold = value.Interface().(string)
+	case "oracle.v1.DenomOverride.min_voters":
+		x.MinVoters = value.Uint()
+	case "oracle.v1.DenomOverride.vote_period_multiplier":
+		x.VotePeriodMultiplier = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.AggregateExchangeRateVote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.DenomOverride"))
 		}
-		panic(fmt.Errorf("message oracle.v1.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.DenomOverride does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3557,49 +4291,52 @@ func (x *fastReflection_AggregateExchangeRateVote) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AggregateExchangeRateVote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DenomOverride) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "oracle.v1.AggregateExchangeRateVote.exchange_rates":
-		if x.ExchangeRates == nil {
-			x.ExchangeRates = []*v1beta1.DecCoin{}
-		}
-		value := &_AggregateExchangeRateVote_1_list{list: &x.ExchangeRates}
-		return protoreflect.ValueOfList(value)
-	case "oracle.v1.AggregateExchangeRateVote.voter":
-		panic(fmt.Errorf("field voter of message oracle.v1.AggregateExchangeRateVote is not mutable"))
+	case "oracle.v1.DenomOverride.symbol_denom":
+		panic(fmt.Errorf("field symbol_denom of message oracle.v1.DenomOverride is not mutable"))
+	case "oracle.v1.DenomOverride.vote_threshold":
+		panic(fmt.Errorf("field vote_threshold of message oracle.v1.DenomOverride is not mutable"))
+	case "oracle.v1.DenomOverride.min_voters":
+		panic(fmt.Errorf("field min_voters of message oracle.v1.DenomOverride is not mutable"))
+	case "oracle.v1.DenomOverride.vote_period_multiplier":
+		panic(fmt.Errorf("field vote_period_multiplier of message oracle.v1.DenomOverride is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.AggregateExchangeRateVote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.DenomOverride"))
 		}
-		panic(fmt.Errorf("message oracle.v1.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.DenomOverride does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AggregateExchangeRateVote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DenomOverride) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "oracle.v1.AggregateExchangeRateVote.exchange_rates":
-		list := []*v1beta1.DecCoin{}
-		return protoreflect.ValueOfList(&_AggregateExchangeRateVote_1_list{list: &list})
-	case "oracle.v1.AggregateExchangeRateVote.voter":
+	case "oracle.v1.DenomOverride.symbol_denom":
 		return protoreflect.ValueOfString("")
+	case "oracle.v1.DenomOverride.vote_threshold":
+		return protoreflect.ValueOfString("")
+	case "oracle.v1.DenomOverride.min_voters":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "oracle.v1.DenomOverride.vote_period_multiplier":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.AggregateExchangeRateVote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.DenomOverride"))
 		}
-		panic(fmt.Errorf("message oracle.v1.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.DenomOverride does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AggregateExchangeRateVote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DenomOverride) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.AggregateExchangeRateVote", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.DenomOverride", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3607,7 +4344,7 @@ func (x *fastReflection_AggregateExchangeRateVote) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AggregateExchangeRateVote) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DenomOverride) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3618,7 +4355,7 @@ func (x *fastReflection_AggregateExchangeRateVote) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AggregateExchangeRateVote) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DenomOverride) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3630,7 +4367,7 @@ func (x *fastReflection_AggregateExchangeRateVote) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AggregateExchangeRateVote) IsValid() bool {
+func (x *fastReflection_DenomOverride) IsValid() bool {
 	return x != nil
 }
 
@@ -3640,9 +4377,9 @@ func (x *fastReflection_AggregateExchangeRateVote) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DenomOverride) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AggregateExchangeRateVote)
+		x := input.Message.Interface().(*DenomOverride)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3654,16 +4391,20 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		if len(x.ExchangeRates) > 0 {
-			for _, e := range x.ExchangeRates {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.SymbolDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Voter)
+		l = len(x.VoteThreshold)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.MinVoters != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinVoters))
+		}
+		if x.VotePeriodMultiplier != 0 {
+			n += 1 + runtime.Sov(uint64(x.VotePeriodMultiplier))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3674,7 +4415,7 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AggregateExchangeRateVote)
+		x := input.Message.Interface().(*DenomOverride)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3693,28 +4434,29 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Voter) > 0 {
-			i -= len(x.Voter)
-			copy(dAtA[i:], x.Voter)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
+		if x.VotePeriodMultiplier != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VotePeriodMultiplier))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MinVoters != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinVoters))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.VoteThreshold) > 0 {
+			i -= len(x.VoteThreshold)
+			copy(dAtA[i:], x.VoteThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VoteThreshold)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.ExchangeRates) > 0 {
-			for iNdEx := len(x.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ExchangeRates[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if len(x.SymbolDenom) > 0 {
+			i -= len(x.SymbolDenom)
+			copy(dAtA[i:], x.SymbolDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SymbolDenom)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3727,7 +4469,7 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AggregateExchangeRateVote)
+		x := input.Message.Interface().(*DenomOverride)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3759,17 +4501,17 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomOverride: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomOverride: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3779,29 +4521,27 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ExchangeRates = append(x.ExchangeRates, &v1beta1.DecCoin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExchangeRates[len(x.ExchangeRates)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.SymbolDenom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3829,8 +4569,46 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Voter = string(dAtA[iNdEx:postIndex])
+				x.VoteThreshold = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinVoters", wireType)
+				}
+				x.MinVoters = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinVoters |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotePeriodMultiplier", wireType)
+				}
+				x.VotePeriodMultiplier = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VotePeriodMultiplier |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3867,27 +4645,31 @@ func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Me
 }
 
 var (
-	md_PriceStamp               protoreflect.MessageDescriptor
-	fd_PriceStamp_exchange_rate protoreflect.FieldDescriptor
-	fd_PriceStamp_block_num     protoreflect.FieldDescriptor
+	md_OutlierFilter                  protoreflect.MessageDescriptor
+	fd_OutlierFilter_symbol_denom     protoreflect.FieldDescriptor
+	fd_OutlierFilter_mad_multiplier   protoreflect.FieldDescriptor
+	fd_OutlierFilter_lower_percentile protoreflect.FieldDescriptor
+	fd_OutlierFilter_upper_percentile protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_oracle_v1_oracle_proto_init()
-	md_PriceStamp = File_oracle_v1_oracle_proto.Messages().ByName("PriceStamp")
-	fd_PriceStamp_exchange_rate = md_PriceStamp.Fields().ByName("exchange_rate")
-	fd_PriceStamp_block_num = md_PriceStamp.Fields().ByName("block_num")
+	md_OutlierFilter = File_oracle_v1_oracle_proto.Messages().ByName("OutlierFilter")
+	fd_OutlierFilter_symbol_denom = md_OutlierFilter.Fields().ByName("symbol_denom")
+	fd_OutlierFilter_mad_multiplier = md_OutlierFilter.Fields().ByName("mad_multiplier")
+	fd_OutlierFilter_lower_percentile = md_OutlierFilter.Fields().ByName("lower_percentile")
+	fd_OutlierFilter_upper_percentile = md_OutlierFilter.Fields().ByName("upper_percentile")
 }
 
-var _ protoreflect.Message = (*fastReflection_PriceStamp)(nil)
+var _ protoreflect.Message = (*fastReflection_OutlierFilter)(nil)
 
-type fastReflection_PriceStamp PriceStamp
+type fastReflection_OutlierFilter OutlierFilter
 
-func (x *PriceStamp) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_PriceStamp)(x)
+func (x *OutlierFilter) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OutlierFilter)(x)
 }
 
-func (x *PriceStamp) slowProtoReflect() protoreflect.Message {
+func (x *OutlierFilter) slowProtoReflect() protoreflect.Message {
 	mi := &file_oracle_v1_oracle_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3899,43 +4681,43 @@ func (x *PriceStamp) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_PriceStamp_messageType fastReflection_PriceStamp_messageType
-var _ protoreflect.MessageType = fastReflection_PriceStamp_messageType{}
+var _fastReflection_OutlierFilter_messageType fastReflection_OutlierFilter_messageType
+var _ protoreflect.MessageType = fastReflection_OutlierFilter_messageType{}
 
-type fastReflection_PriceStamp_messageType struct{}
+type fastReflection_OutlierFilter_messageType struct{}
 
-func (x fastReflection_PriceStamp_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_PriceStamp)(nil)
+func (x fastReflection_OutlierFilter_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OutlierFilter)(nil)
 }
-func (x fastReflection_PriceStamp_messageType) New() protoreflect.Message {
-	return new(fastReflection_PriceStamp)
+func (x fastReflection_OutlierFilter_messageType) New() protoreflect.Message {
+	return new(fastReflection_OutlierFilter)
 }
-func (x fastReflection_PriceStamp_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_PriceStamp
+func (x fastReflection_OutlierFilter_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OutlierFilter
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_PriceStamp) Descriptor() protoreflect.MessageDescriptor {
-	return md_PriceStamp
+func (x *fastReflection_OutlierFilter) Descriptor() protoreflect.MessageDescriptor {
+	return md_OutlierFilter
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_PriceStamp) Type() protoreflect.MessageType {
-	return _fastReflection_PriceStamp_messageType
+func (x *fastReflection_OutlierFilter) Type() protoreflect.MessageType {
+	return _fastReflection_OutlierFilter_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_PriceStamp) New() protoreflect.Message {
-	return new(fastReflection_PriceStamp)
+func (x *fastReflection_OutlierFilter) New() protoreflect.Message {
+	return new(fastReflection_OutlierFilter)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_PriceStamp) Interface() protoreflect.ProtoMessage {
-	return (*PriceStamp)(x)
+func (x *fastReflection_OutlierFilter) Interface() protoreflect.ProtoMessage {
+	return (*OutlierFilter)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3943,16 +4725,28 @@ func (x *fastReflection_PriceStamp) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_PriceStamp) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ExchangeRate != nil {
-		value := protoreflect.ValueOfMessage(x.ExchangeRate.ProtoReflect())
-		if !f(fd_PriceStamp_exchange_rate, value) {
+func (x *fastReflection_OutlierFilter) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SymbolDenom != "" {
+		value := protoreflect.ValueOfString(x.SymbolDenom)
+		if !f(fd_OutlierFilter_symbol_denom, value) {
 			return
 		}
 	}
-	if x.BlockNum != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BlockNum)
-		if !f(fd_PriceStamp_block_num, value) {
+	if x.MadMultiplier != "" {
+		value := protoreflect.ValueOfString(x.MadMultiplier)
+		if !f(fd_OutlierFilter_mad_multiplier, value) {
+			return
+		}
+	}
+	if x.LowerPercentile != "" {
+		value := protoreflect.ValueOfString(x.LowerPercentile)
+		if !f(fd_OutlierFilter_lower_percentile, value) {
+			return
+		}
+	}
+	if x.UpperPercentile != "" {
+		value := protoreflect.ValueOfString(x.UpperPercentile)
+		if !f(fd_OutlierFilter_upper_percentile, value) {
 			return
 		}
 	}
@@ -3969,17 +4763,21 @@ func (x *fastReflection_PriceStamp) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_PriceStamp) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_OutlierFilter) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "oracle.v1.PriceStamp.exchange_rate":
-		return x.ExchangeRate != nil
-	case "oracle.v1.PriceStamp.block_num":
-		return x.BlockNum != uint64(0)
+	case "oracle.v1.OutlierFilter.symbol_denom":
+		return x.SymbolDenom != ""
+	case "oracle.v1.OutlierFilter.mad_multiplier":
+		return x.MadMultiplier != ""
+	case "oracle.v1.OutlierFilter.lower_percentile":
+		return x.LowerPercentile != ""
+	case "oracle.v1.OutlierFilter.upper_percentile":
+		return x.UpperPercentile != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PriceStamp"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.OutlierFilter"))
 		}
-		panic(fmt.Errorf("message oracle.v1.PriceStamp does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.OutlierFilter does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3989,17 +4787,21 @@ func (x *fastReflection_PriceStamp) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PriceStamp) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_OutlierFilter) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "oracle.v1.PriceStamp.exchange_rate":
-		x.ExchangeRate = nil
-	case "oracle.v1.PriceStamp.block_num":
-		x.BlockNum = uint64(0)
+	case "oracle.v1.OutlierFilter.symbol_denom":
+		x.SymbolDenom = ""
+	case "oracle.v1.OutlierFilter.mad_multiplier":
+		x.MadMultiplier = ""
+	case "oracle.v1.OutlierFilter.lower_percentile":
+		x.LowerPercentile = ""
+	case "oracle.v1.OutlierFilter.upper_percentile":
+		x.UpperPercentile = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PriceStamp"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.OutlierFilter"))
 		}
-		panic(fmt.Errorf("message oracle.v1.PriceStamp does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.OutlierFilter does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4009,19 +4811,25 @@ func (x *fastReflection_PriceStamp) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_PriceStamp) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OutlierFilter) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "oracle.v1.PriceStamp.exchange_rate":
-		value := x.ExchangeRate
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "oracle.v1.PriceStamp.block_num":
-		value := x.BlockNum
-		return protoreflect.ValueOfUint64(value)
+	case "oracle.v1.OutlierFilter.symbol_denom":
+		value := x.SymbolDenom
+		return protoreflect.ValueOfString(value)
+	case "oracle.v1.OutlierFilter.mad_multiplier":
+		value := x.MadMultiplier
+		return protoreflect.ValueOfString(value)
+	case "oracle.v1.OutlierFilter.lower_percentile":
+		value := x.LowerPercentile
+		return protoreflect.ValueOfString(value)
+	case "oracle.v1.OutlierFilter.upper_percentile":
+		value := x.UpperPercentile
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PriceStamp"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.OutlierFilter"))
 		}
-		panic(fmt.Errorf("message oracle.v1.PriceStamp does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message oracle.v1.OutlierFilter does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4035,17 +4843,21 @@ func (x *fastReflection_PriceStamp) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PriceStamp) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_OutlierFilter) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "oracle.v1.PriceStamp.exchange_rate":
-		x.ExchangeRate = value.Message().Interface().(*v1beta1.DecCoin)
-	case "oracle.v1.PriceStamp.block_num":
-		x.BlockNum = value.Uint()
+	case "oracle.v1.OutlierFilter.symbol_denom":
+		x.SymbolDenom = value.Interface().(string)
+	case "oracle.v1.OutlierFilter.mad_multiplier":
+		x.MadMultiplier = value.Interface().(string)
+	case "oracle.v1.OutlierFilter.lower_percentile":
+		x.LowerPercentile = value.Interface().(string)
+	case "oracle.v1.OutlierFilter.upper_percentile":
+		x.UpperPercentile = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PriceStamp"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.OutlierFilter"))
 		}
-		panic(fmt.Errorf("message oracle.v1.PriceStamp does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.OutlierFilter does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4059,48 +4871,52 @@ func (x *fastReflection_PriceStamp) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PriceStamp) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OutlierFilter) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "oracle.v1.PriceStamp.exchange_rate":
-		if x.ExchangeRate == nil {
-			x.ExchangeRate = new(v1beta1.DecCoin)
+	case "oracle.v1.OutlierFilter.symbol_denom":
+		panic(fmt.Errorf("field symbol_denom of message oracle.v1.OutlierFilter is not mutable"))
+	case "oracle.v1.OutlierFilter.mad_multiplier":
+		panic(fmt.Errorf("field mad_multiplier of message oracle.v1.OutlierFilter is not mutable"))
+	case "oracle.v1.OutlierFilter.lower_percentile":
+		panic(fmt.Errorf("field lower_percentile of message oracle.v1.OutlierFilter is not mutable"))
+	case "oracle.v1.OutlierFilter.upper_percentile":
+		panic(fmt.Errorf("field upper_percentile of message oracle.v1.OutlierFilter is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.OutlierFilter"))
 		}
-		return protoreflect.ValueOfMessage(x.ExchangeRate.ProtoReflect())
-	case "oracle.v1.PriceStamp.block_num":
-		panic(fmt.Errorf("field block_num of message oracle.v1.PriceStamp is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PriceStamp"))
-		}
-		panic(fmt.Errorf("message oracle.v1.PriceStamp does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.OutlierFilter does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_PriceStamp) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OutlierFilter) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "oracle.v1.PriceStamp.exchange_rate":
-		m := new(v1beta1.DecCoin)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "oracle.v1.PriceStamp.block_num":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "oracle.v1.OutlierFilter.symbol_denom":
+		return protoreflect.ValueOfString("")
+	case "oracle.v1.OutlierFilter.mad_multiplier":
+		return protoreflect.ValueOfString("")
+	case "oracle.v1.OutlierFilter.lower_percentile":
+		return protoreflect.ValueOfString("")
+	case "oracle.v1.OutlierFilter.upper_percentile":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PriceStamp"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.OutlierFilter"))
 		}
-		panic(fmt.Errorf("message oracle.v1.PriceStamp does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.OutlierFilter does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_PriceStamp) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_OutlierFilter) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.PriceStamp", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.OutlierFilter", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4108,7 +4924,7 @@ func (x *fastReflection_PriceStamp) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_PriceStamp) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_OutlierFilter) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4119,7 +4935,7 @@ func (x *fastReflection_PriceStamp) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PriceStamp) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_OutlierFilter) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4131,7 +4947,7 @@ func (x *fastReflection_PriceStamp) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_PriceStamp) IsValid() bool {
+func (x *fastReflection_OutlierFilter) IsValid() bool {
 	return x != nil
 }
 
@@ -4141,9 +4957,9 @@ func (x *fastReflection_PriceStamp) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_PriceStamp) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_OutlierFilter) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*PriceStamp)
+		x := input.Message.Interface().(*OutlierFilter)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4155,12 +4971,21 @@ func (x *fastReflection_PriceStamp) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.ExchangeRate != nil {
-			l = options.Size(x.ExchangeRate)
+		l = len(x.SymbolDenom)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.BlockNum != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockNum))
+		l = len(x.MadMultiplier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LowerPercentile)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.UpperPercentile)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4172,7 +4997,7 @@ func (x *fastReflection_PriceStamp) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*PriceStamp)
+		x := input.Message.Interface().(*OutlierFilter)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4191,22 +5016,31 @@ func (x *fastReflection_PriceStamp) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BlockNum != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNum))
+		if len(x.UpperPercentile) > 0 {
+			i -= len(x.UpperPercentile)
+			copy(dAtA[i:], x.UpperPercentile)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UpperPercentile)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x22
 		}
-		if x.ExchangeRate != nil {
-			encoded, err := options.Marshal(x.ExchangeRate)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.LowerPercentile) > 0 {
+			i -= len(x.LowerPercentile)
+			copy(dAtA[i:], x.LowerPercentile)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LowerPercentile)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.MadMultiplier) > 0 {
+			i -= len(x.MadMultiplier)
+			copy(dAtA[i:], x.MadMultiplier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MadMultiplier)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.SymbolDenom) > 0 {
+			i -= len(x.SymbolDenom)
+			copy(dAtA[i:], x.SymbolDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SymbolDenom)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4221,7 +5055,7 @@ func (x *fastReflection_PriceStamp) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*PriceStamp)
+		x := input.Message.Interface().(*OutlierFilter)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4253,17 +5087,17 @@ func (x *fastReflection_PriceStamp) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceStamp: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OutlierFilter: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceStamp: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OutlierFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4273,33 +5107,61 @@ func (x *fastReflection_PriceStamp) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ExchangeRate == nil {
-					x.ExchangeRate = &v1beta1.DecCoin{}
+				x.SymbolDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MadMultiplier", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExchangeRate); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
+				x.MadMultiplier = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNum", wireType)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LowerPercentile", wireType)
 				}
-				x.BlockNum = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4309,11 +5171,56 @@ func (x *fastReflection_PriceStamp) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BlockNum |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LowerPercentile = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpperPercentile", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UpperPercentile = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4349,72 +5256,30 @@ func (x *fastReflection_PriceStamp) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_ValidatorRewardSet_1_list)(nil)
-
-type _ValidatorRewardSet_1_list struct {
-	list *[]string
-}
-
-func (x *_ValidatorRewardSet_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_ValidatorRewardSet_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_ValidatorRewardSet_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_ValidatorRewardSet_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_ValidatorRewardSet_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message ValidatorRewardSet at list field ValidatorSet as it is not of Message kind"))
-}
-
-func (x *_ValidatorRewardSet_1_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_ValidatorRewardSet_1_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_ValidatorRewardSet_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_ValidatorRewardSet               protoreflect.MessageDescriptor
-	fd_ValidatorRewardSet_validator_set protoreflect.FieldDescriptor
+	md_AggregateExchangeRatePrevote              protoreflect.MessageDescriptor
+	fd_AggregateExchangeRatePrevote_hash         protoreflect.FieldDescriptor
+	fd_AggregateExchangeRatePrevote_voter        protoreflect.FieldDescriptor
+	fd_AggregateExchangeRatePrevote_submit_block protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_oracle_v1_oracle_proto_init()
-	md_ValidatorRewardSet = File_oracle_v1_oracle_proto.Messages().ByName("ValidatorRewardSet")
-	fd_ValidatorRewardSet_validator_set = md_ValidatorRewardSet.Fields().ByName("validator_set")
+	md_AggregateExchangeRatePrevote = File_oracle_v1_oracle_proto.Messages().ByName("AggregateExchangeRatePrevote")
+	fd_AggregateExchangeRatePrevote_hash = md_AggregateExchangeRatePrevote.Fields().ByName("hash")
+	fd_AggregateExchangeRatePrevote_voter = md_AggregateExchangeRatePrevote.Fields().ByName("voter")
+	fd_AggregateExchangeRatePrevote_submit_block = md_AggregateExchangeRatePrevote.Fields().ByName("submit_block")
 }
 
-var _ protoreflect.Message = (*fastReflection_ValidatorRewardSet)(nil)
+var _ protoreflect.Message = (*fastReflection_AggregateExchangeRatePrevote)(nil)
 
-type fastReflection_ValidatorRewardSet ValidatorRewardSet
+type fastReflection_AggregateExchangeRatePrevote AggregateExchangeRatePrevote
 
-func (x *ValidatorRewardSet) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ValidatorRewardSet)(x)
+func (x *AggregateExchangeRatePrevote) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AggregateExchangeRatePrevote)(x)
 }
 
-func (x *ValidatorRewardSet) slowProtoReflect() protoreflect.Message {
+func (x *AggregateExchangeRatePrevote) slowProtoReflect() protoreflect.Message {
 	mi := &file_oracle_v1_oracle_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4426,43 +5291,43 @@ func (x *ValidatorRewardSet) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ValidatorRewardSet_messageType fastReflection_ValidatorRewardSet_messageType
-var _ protoreflect.MessageType = fastReflection_ValidatorRewardSet_messageType{}
+var _fastReflection_AggregateExchangeRatePrevote_messageType fastReflection_AggregateExchangeRatePrevote_messageType
+var _ protoreflect.MessageType = fastReflection_AggregateExchangeRatePrevote_messageType{}
 
-type fastReflection_ValidatorRewardSet_messageType struct{}
+type fastReflection_AggregateExchangeRatePrevote_messageType struct{}
 
-func (x fastReflection_ValidatorRewardSet_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ValidatorRewardSet)(nil)
+func (x fastReflection_AggregateExchangeRatePrevote_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AggregateExchangeRatePrevote)(nil)
 }
-func (x fastReflection_ValidatorRewardSet_messageType) New() protoreflect.Message {
-	return new(fastReflection_ValidatorRewardSet)
+func (x fastReflection_AggregateExchangeRatePrevote_messageType) New() protoreflect.Message {
+	return new(fastReflection_AggregateExchangeRatePrevote)
 }
-func (x fastReflection_ValidatorRewardSet_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorRewardSet
+func (x fastReflection_AggregateExchangeRatePrevote_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AggregateExchangeRatePrevote
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ValidatorRewardSet) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorRewardSet
+func (x *fastReflection_AggregateExchangeRatePrevote) Descriptor() protoreflect.MessageDescriptor {
+	return md_AggregateExchangeRatePrevote
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ValidatorRewardSet) Type() protoreflect.MessageType {
-	return _fastReflection_ValidatorRewardSet_messageType
+func (x *fastReflection_AggregateExchangeRatePrevote) Type() protoreflect.MessageType {
+	return _fastReflection_AggregateExchangeRatePrevote_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ValidatorRewardSet) New() protoreflect.Message {
-	return new(fastReflection_ValidatorRewardSet)
+func (x *fastReflection_AggregateExchangeRatePrevote) New() protoreflect.Message {
+	return new(fastReflection_AggregateExchangeRatePrevote)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ValidatorRewardSet) Interface() protoreflect.ProtoMessage {
-	return (*ValidatorRewardSet)(x)
+func (x *fastReflection_AggregateExchangeRatePrevote) Interface() protoreflect.ProtoMessage {
+	return (*AggregateExchangeRatePrevote)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4470,10 +5335,22 @@ func (x *fastReflection_ValidatorRewardSet) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ValidatorRewardSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.ValidatorSet) != 0 {
-		value := protoreflect.ValueOfList(&_ValidatorRewardSet_1_list{list: &x.ValidatorSet})
-		if !f(fd_ValidatorRewardSet_validator_set, value) {
+func (x *fastReflection_AggregateExchangeRatePrevote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Hash != "" {
+		value := protoreflect.ValueOfString(x.Hash)
+		if !f(fd_AggregateExchangeRatePrevote_hash, value) {
+			return
+		}
+	}
+	if x.Voter != "" {
+		value := protoreflect.ValueOfString(x.Voter)
+		if !f(fd_AggregateExchangeRatePrevote_voter, value) {
+			return
+		}
+	}
+	if x.SubmitBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SubmitBlock)
+		if !f(fd_AggregateExchangeRatePrevote_submit_block, value) {
 			return
 		}
 	}
@@ -4490,15 +5367,19 @@ func (x *fastReflection_ValidatorRewardSet) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ValidatorRewardSet) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AggregateExchangeRatePrevote) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "oracle.v1.ValidatorRewardSet.validator_set":
-		return len(x.ValidatorSet) != 0
+	case "oracle.v1.AggregateExchangeRatePrevote.hash":
+		return x.Hash != ""
+	case "oracle.v1.AggregateExchangeRatePrevote.voter":
+		return x.Voter != ""
+	case "oracle.v1.AggregateExchangeRatePrevote.submit_block":
+		return x.SubmitBlock != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ValidatorRewardSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.AggregateExchangeRatePrevote"))
 		}
-		panic(fmt.Errorf("message oracle.v1.ValidatorRewardSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4508,15 +5389,19 @@ func (x *fastReflection_ValidatorRewardSet) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorRewardSet) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AggregateExchangeRatePrevote) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "oracle.v1.ValidatorRewardSet.validator_set":
-		x.ValidatorSet = nil
+	case "oracle.v1.AggregateExchangeRatePrevote.hash":
+		x.Hash = ""
+	case "oracle.v1.AggregateExchangeRatePrevote.voter":
+		x.Voter = ""
+	case "oracle.v1.AggregateExchangeRatePrevote.submit_block":
+		x.SubmitBlock = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ValidatorRewardSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.AggregateExchangeRatePrevote"))
 		}
-		panic(fmt.Errorf("message oracle.v1.ValidatorRewardSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4526,19 +5411,22 @@ func (x *fastReflection_ValidatorRewardSet) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ValidatorRewardSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AggregateExchangeRatePrevote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "oracle.v1.ValidatorRewardSet.validator_set":
-		if len(x.ValidatorSet) == 0 {
-			return protoreflect.ValueOfList(&_ValidatorRewardSet_1_list{})
-		}
-		listValue := &_ValidatorRewardSet_1_list{list: &x.ValidatorSet}
-		return protoreflect.ValueOfList(listValue)
+	case "oracle.v1.AggregateExchangeRatePrevote.hash":
+		value := x.Hash
+		return protoreflect.ValueOfString(value)
+	case "oracle.v1.AggregateExchangeRatePrevote.voter":
+		value := x.Voter
+		return protoreflect.ValueOfString(value)
+	case "oracle.v1.AggregateExchangeRatePrevote.submit_block":
+		value := x.SubmitBlock
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ValidatorRewardSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.AggregateExchangeRatePrevote"))
 		}
-		panic(fmt.Errorf("message oracle.v1.ValidatorRewardSet does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message oracle.v1.AggregateExchangeRatePrevote does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4552,17 +5440,19 @@ func (x *fastReflection_ValidatorRewardSet) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorRewardSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AggregateExchangeRatePrevote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "oracle.v1.ValidatorRewardSet.validator_set":
-		lv := value.List()
-		clv := lv.(*_ValidatorRewardSet_1_list)
-		x.ValidatorSet = *clv.list
+	case "oracle.v1.AggregateExchangeRatePrevote.hash":
+		x.Hash = value.Interface().(string)
+	case "oracle.v1.AggregateExchangeRatePrevote.voter":
+		x.Voter = value.Interface().(string)
+	case "oracle.v1.AggregateExchangeRatePrevote.submit_block":
+		x.SubmitBlock = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ValidatorRewardSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.AggregateExchangeRatePrevote"))
 		}
-		panic(fmt.Errorf("message oracle.v1.ValidatorRewardSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4576,45 +5466,48 @@ func (x *fastReflection_ValidatorRewardSet) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorRewardSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AggregateExchangeRatePrevote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "oracle.v1.ValidatorRewardSet.validator_set":
-		if x.ValidatorSet == nil {
-			x.ValidatorSet = []string{}
-		}
-		value := &_ValidatorRewardSet_1_list{list: &x.ValidatorSet}
-		return protoreflect.ValueOfList(value)
+	case "oracle.v1.AggregateExchangeRatePrevote.hash":
+		panic(fmt.Errorf("field hash of message oracle.v1.AggregateExchangeRatePrevote is not mutable"))
+	case "oracle.v1.AggregateExchangeRatePrevote.voter":
+		panic(fmt.Errorf("field voter of message oracle.v1.AggregateExchangeRatePrevote is not mutable"))
+	case "oracle.v1.AggregateExchangeRatePrevote.submit_block":
+		panic(fmt.Errorf("field submit_block of message oracle.v1.AggregateExchangeRatePrevote is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ValidatorRewardSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.AggregateExchangeRatePrevote"))
 		}
-		panic(fmt.Errorf("message oracle.v1.ValidatorRewardSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ValidatorRewardSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AggregateExchangeRatePrevote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "oracle.v1.ValidatorRewardSet.validator_set":
-		list := []string{}
-		return protoreflect.ValueOfList(&_ValidatorRewardSet_1_list{list: &list})
+	case "oracle.v1.AggregateExchangeRatePrevote.hash":
+		return protoreflect.ValueOfString("")
+	case "oracle.v1.AggregateExchangeRatePrevote.voter":
+		return protoreflect.ValueOfString("")
+	case "oracle.v1.AggregateExchangeRatePrevote.submit_block":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ValidatorRewardSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.AggregateExchangeRatePrevote"))
 		}
-		panic(fmt.Errorf("message oracle.v1.ValidatorRewardSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.AggregateExchangeRatePrevote does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ValidatorRewardSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AggregateExchangeRatePrevote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.ValidatorRewardSet", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.AggregateExchangeRatePrevote", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4622,7 +5515,7 @@ func (x *fastReflection_ValidatorRewardSet) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ValidatorRewardSet) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AggregateExchangeRatePrevote) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4633,7 +5526,7 @@ func (x *fastReflection_ValidatorRewardSet) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorRewardSet) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AggregateExchangeRatePrevote) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4645,7 +5538,7 @@ func (x *fastReflection_ValidatorRewardSet) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ValidatorRewardSet) IsValid() bool {
+func (x *fastReflection_AggregateExchangeRatePrevote) IsValid() bool {
 	return x != nil
 }
 
@@ -4655,9 +5548,9 @@ func (x *fastReflection_ValidatorRewardSet) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ValidatorRewardSet) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AggregateExchangeRatePrevote) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ValidatorRewardSet)
+		x := input.Message.Interface().(*AggregateExchangeRatePrevote)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4669,11 +5562,16 @@ func (x *fastReflection_ValidatorRewardSet) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.ValidatorSet) > 0 {
-			for _, s := range x.ValidatorSet {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Voter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SubmitBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.SubmitBlock))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4685,7 +5583,7 @@ func (x *fastReflection_ValidatorRewardSet) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorRewardSet)
+		x := input.Message.Interface().(*AggregateExchangeRatePrevote)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4704,14 +5602,24 @@ func (x *fastReflection_ValidatorRewardSet) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ValidatorSet) > 0 {
-			for iNdEx := len(x.ValidatorSet) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.ValidatorSet[iNdEx])
-				copy(dAtA[i:], x.ValidatorSet[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorSet[iNdEx])))
-				i--
-				dAtA[i] = 0xa
-			}
+		if x.SubmitBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubmitBlock))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Voter) > 0 {
+			i -= len(x.Voter)
+			copy(dAtA[i:], x.Voter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4724,7 +5632,7 @@ func (x *fastReflection_ValidatorRewardSet) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorRewardSet)
+		x := input.Message.Interface().(*AggregateExchangeRatePrevote)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4756,15 +5664,15 @@ func (x *fastReflection_ValidatorRewardSet) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorRewardSet: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorRewardSet: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4792,8 +5700,59 @@ func (x *fastReflection_ValidatorRewardSet) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ValidatorSet = append(x.ValidatorSet, string(dAtA[iNdEx:postIndex]))
+				x.Hash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Voter = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+				}
+				x.SubmitBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SubmitBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4829,129 +5788,79 @@ func (x *fastReflection_ValidatorRewardSet) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_CurrencyPairProviders_3_list)(nil)
+var _ protoreflect.List = (*_AggregateExchangeRateVote_1_list)(nil)
 
-type _CurrencyPairProviders_3_list struct {
-	list *[]*PairAddressProvider
+type _AggregateExchangeRateVote_1_list struct {
+	list *[]*v1beta1.DecCoin
 }
 
-func (x *_CurrencyPairProviders_3_list) Len() int {
+func (x *_AggregateExchangeRateVote_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_CurrencyPairProviders_3_list) Get(i int) protoreflect.Value {
+func (x *_AggregateExchangeRateVote_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_CurrencyPairProviders_3_list) Set(i int, value protoreflect.Value) {
+func (x *_AggregateExchangeRateVote_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*PairAddressProvider)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_CurrencyPairProviders_3_list) Append(value protoreflect.Value) {
+func (x *_AggregateExchangeRateVote_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*PairAddressProvider)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_CurrencyPairProviders_3_list) AppendMutable() protoreflect.Value {
-	v := new(PairAddressProvider)
+func (x *_AggregateExchangeRateVote_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_CurrencyPairProviders_3_list) Truncate(n int) {
+func (x *_AggregateExchangeRateVote_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_CurrencyPairProviders_3_list) NewElement() protoreflect.Value {
-	v := new(PairAddressProvider)
+func (x *_AggregateExchangeRateVote_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_CurrencyPairProviders_3_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_CurrencyPairProviders_4_list)(nil)
-
-type _CurrencyPairProviders_4_list struct {
-	list *[]string
-}
-
-func (x *_CurrencyPairProviders_4_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_CurrencyPairProviders_4_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_CurrencyPairProviders_4_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_CurrencyPairProviders_4_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_CurrencyPairProviders_4_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message CurrencyPairProviders at list field Providers as it is not of Message kind"))
-}
-
-func (x *_CurrencyPairProviders_4_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_CurrencyPairProviders_4_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_CurrencyPairProviders_4_list) IsValid() bool {
+func (x *_AggregateExchangeRateVote_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_CurrencyPairProviders              protoreflect.MessageDescriptor
-	fd_CurrencyPairProviders_base_denom   protoreflect.FieldDescriptor
-	fd_CurrencyPairProviders_quote_denom  protoreflect.FieldDescriptor
-	fd_CurrencyPairProviders_pair_address protoreflect.FieldDescriptor
-	fd_CurrencyPairProviders_providers    protoreflect.FieldDescriptor
+	md_AggregateExchangeRateVote                protoreflect.MessageDescriptor
+	fd_AggregateExchangeRateVote_exchange_rates protoreflect.FieldDescriptor
+	fd_AggregateExchangeRateVote_voter          protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_oracle_v1_oracle_proto_init()
-	md_CurrencyPairProviders = File_oracle_v1_oracle_proto.Messages().ByName("CurrencyPairProviders")
-	fd_CurrencyPairProviders_base_denom = md_CurrencyPairProviders.Fields().ByName("base_denom")
-	fd_CurrencyPairProviders_quote_denom = md_CurrencyPairProviders.Fields().ByName("quote_denom")
-	fd_CurrencyPairProviders_pair_address = md_CurrencyPairProviders.Fields().ByName("pair_address")
-	fd_CurrencyPairProviders_providers = md_CurrencyPairProviders.Fields().ByName("providers")
+	md_AggregateExchangeRateVote = File_oracle_v1_oracle_proto.Messages().ByName("AggregateExchangeRateVote")
+	fd_AggregateExchangeRateVote_exchange_rates = md_AggregateExchangeRateVote.Fields().ByName("exchange_rates")
+	fd_AggregateExchangeRateVote_voter = md_AggregateExchangeRateVote.Fields().ByName("voter")
 }
 
-var _ protoreflect.Message = (*fastReflection_CurrencyPairProviders)(nil)
+var _ protoreflect.Message = (*fastReflection_AggregateExchangeRateVote)(nil)
 
-type fastReflection_CurrencyPairProviders CurrencyPairProviders
+type fastReflection_AggregateExchangeRateVote AggregateExchangeRateVote
 
-func (x *CurrencyPairProviders) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CurrencyPairProviders)(x)
+func (x *AggregateExchangeRateVote) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AggregateExchangeRateVote)(x)
 }
 
-func (x *CurrencyPairProviders) slowProtoReflect() protoreflect.Message {
+func (x *AggregateExchangeRateVote) slowProtoReflect() protoreflect.Message {
 	mi := &file_oracle_v1_oracle_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4963,43 +5872,43 @@ func (x *CurrencyPairProviders) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CurrencyPairProviders_messageType fastReflection_CurrencyPairProviders_messageType
-var _ protoreflect.MessageType = fastReflection_CurrencyPairProviders_messageType{}
+var _fastReflection_AggregateExchangeRateVote_messageType fastReflection_AggregateExchangeRateVote_messageType
+var _ protoreflect.MessageType = fastReflection_AggregateExchangeRateVote_messageType{}
 
-type fastReflection_CurrencyPairProviders_messageType struct{}
+type fastReflection_AggregateExchangeRateVote_messageType struct{}
 
-func (x fastReflection_CurrencyPairProviders_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CurrencyPairProviders)(nil)
+func (x fastReflection_AggregateExchangeRateVote_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AggregateExchangeRateVote)(nil)
 }
-func (x fastReflection_CurrencyPairProviders_messageType) New() protoreflect.Message {
-	return new(fastReflection_CurrencyPairProviders)
+func (x fastReflection_AggregateExchangeRateVote_messageType) New() protoreflect.Message {
+	return new(fastReflection_AggregateExchangeRateVote)
 }
-func (x fastReflection_CurrencyPairProviders_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CurrencyPairProviders
+func (x fastReflection_AggregateExchangeRateVote_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AggregateExchangeRateVote
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CurrencyPairProviders) Descriptor() protoreflect.MessageDescriptor {
-	return md_CurrencyPairProviders
+func (x *fastReflection_AggregateExchangeRateVote) Descriptor() protoreflect.MessageDescriptor {
+	return md_AggregateExchangeRateVote
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CurrencyPairProviders) Type() protoreflect.MessageType {
-	return _fastReflection_CurrencyPairProviders_messageType
+func (x *fastReflection_AggregateExchangeRateVote) Type() protoreflect.MessageType {
+	return _fastReflection_AggregateExchangeRateVote_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CurrencyPairProviders) New() protoreflect.Message {
-	return new(fastReflection_CurrencyPairProviders)
+func (x *fastReflection_AggregateExchangeRateVote) New() protoreflect.Message {
+	return new(fastReflection_AggregateExchangeRateVote)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CurrencyPairProviders) Interface() protoreflect.ProtoMessage {
-	return (*CurrencyPairProviders)(x)
+func (x *fastReflection_AggregateExchangeRateVote) Interface() protoreflect.ProtoMessage {
+	return (*AggregateExchangeRateVote)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5007,28 +5916,16 @@ func (x *fastReflection_CurrencyPairProviders) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CurrencyPairProviders) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BaseDenom != "" {
-		value := protoreflect.ValueOfString(x.BaseDenom)
-		if !f(fd_CurrencyPairProviders_base_denom, value) {
-			return
-		}
-	}
-	if x.QuoteDenom != "" {
-		value := protoreflect.ValueOfString(x.QuoteDenom)
-		if !f(fd_CurrencyPairProviders_quote_denom, value) {
-			return
-		}
-	}
-	if len(x.PairAddress) != 0 {
-		value := protoreflect.ValueOfList(&_CurrencyPairProviders_3_list{list: &x.PairAddress})
-		if !f(fd_CurrencyPairProviders_pair_address, value) {
+func (x *fastReflection_AggregateExchangeRateVote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ExchangeRates) != 0 {
+		value := protoreflect.ValueOfList(&_AggregateExchangeRateVote_1_list{list: &x.ExchangeRates})
+		if !f(fd_AggregateExchangeRateVote_exchange_rates, value) {
 			return
 		}
 	}
-	if len(x.Providers) != 0 {
-		value := protoreflect.ValueOfList(&_CurrencyPairProviders_4_list{list: &x.Providers})
-		if !f(fd_CurrencyPairProviders_providers, value) {
+	if x.Voter != "" {
+		value := protoreflect.ValueOfString(x.Voter)
+		if !f(fd_AggregateExchangeRateVote_voter, value) {
 			return
 		}
 	}
@@ -5045,21 +5942,17 @@ func (x *fastReflection_CurrencyPairProviders) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CurrencyPairProviders) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AggregateExchangeRateVote) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "oracle.v1.CurrencyPairProviders.base_denom":
-		return x.BaseDenom != ""
-	case "oracle.v1.CurrencyPairProviders.quote_denom":
-		return x.QuoteDenom != ""
-	case "oracle.v1.CurrencyPairProviders.pair_address":
-		return len(x.PairAddress) != 0
-	case "oracle.v1.CurrencyPairProviders.providers":
-		return len(x.Providers) != 0
+	case "oracle.v1.AggregateExchangeRateVote.exchange_rates":
+		return len(x.ExchangeRates) != 0
+	case "oracle.v1.AggregateExchangeRateVote.voter":
+		return x.Voter != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.CurrencyPairProviders"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.AggregateExchangeRateVote"))
 		}
-		panic(fmt.Errorf("message oracle.v1.CurrencyPairProviders does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5069,21 +5962,17 @@ func (x *fastReflection_CurrencyPairProviders) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyPairProviders) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AggregateExchangeRateVote) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "oracle.v1.CurrencyPairProviders.base_denom":
-		x.BaseDenom = ""
-	case "oracle.v1.CurrencyPairProviders.quote_denom":
-		x.QuoteDenom = ""
-	case "oracle.v1.CurrencyPairProviders.pair_address":
-		x.PairAddress = nil
-	case "oracle.v1.CurrencyPairProviders.providers":
-		x.Providers = nil
+	case "oracle.v1.AggregateExchangeRateVote.exchange_rates":
+		x.ExchangeRates = nil
+	case "oracle.v1.AggregateExchangeRateVote.voter":
+		x.Voter = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.CurrencyPairProviders"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.AggregateExchangeRateVote"))
 		}
-		panic(fmt.Errorf("message oracle.v1.CurrencyPairProviders does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5093,31 +5982,22 @@ func (x *fastReflection_CurrencyPairProviders) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CurrencyPairProviders) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AggregateExchangeRateVote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "oracle.v1.CurrencyPairProviders.base_denom":
-		value := x.BaseDenom
-		return protoreflect.ValueOfString(value)
-	case "oracle.v1.CurrencyPairProviders.quote_denom":
-		value := x.QuoteDenom
-		return protoreflect.ValueOfString(value)
-	case "oracle.v1.CurrencyPairProviders.pair_address":
-		if len(x.PairAddress) == 0 {
-			return protoreflect.ValueOfList(&_CurrencyPairProviders_3_list{})
-		}
-		listValue := &_CurrencyPairProviders_3_list{list: &x.PairAddress}
-		return protoreflect.ValueOfList(listValue)
-	case "oracle.v1.CurrencyPairProviders.providers":
-		if len(x.Providers) == 0 {
-			return protoreflect.ValueOfList(&_CurrencyPairProviders_4_list{})
+	case "oracle.v1.AggregateExchangeRateVote.exchange_rates":
+		if len(x.ExchangeRates) == 0 {
+			return protoreflect.ValueOfList(&_AggregateExchangeRateVote_1_list{})
 		}
-		listValue := &_CurrencyPairProviders_4_list{list: &x.Providers}
+		listValue := &_AggregateExchangeRateVote_1_list{list: &x.ExchangeRates}
 		return protoreflect.ValueOfList(listValue)
+	case "oracle.v1.AggregateExchangeRateVote.voter":
+		value := x.Voter
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.CurrencyPairProviders"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.AggregateExchangeRateVote"))
 		}
-		panic(fmt.Errorf("message oracle.v1.CurrencyPairProviders does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message oracle.v1.AggregateExchangeRateVote does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5131,25 +6011,19 @@ func (x *fastReflection_CurrencyPairProviders) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyPairProviders) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AggregateExchangeRateVote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "oracle.v1.CurrencyPairProviders.base_denom":
-		x.BaseDenom = value.Interface().(string)
-	case "oracle.v1.CurrencyPairProviders.quote_denom":
-		x.QuoteDenom = value.Interface().(string)
-	case "oracle.v1.CurrencyPairProviders.pair_address":
-		lv := value.List()
-		clv := lv.(*_CurrencyPairProviders_3_list)
-		x.PairAddress = *clv.list
-	case "oracle.v1.CurrencyPairProviders.providers":
+	case "oracle.v1.AggregateExchangeRateVote.exchange_rates":
 		lv := value.List()
-		clv := lv.(*_CurrencyPairProviders_4_list)
-		x.Providers = *clv.list
+		clv := lv.(*_AggregateExchangeRateVote_1_list)
+		x.ExchangeRates = *clv.list
+	case "oracle.v1.AggregateExchangeRateVote.voter":
+		x.Voter = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.CurrencyPairProviders"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.AggregateExchangeRateVote"))
 		}
-		panic(fmt.Errorf("message oracle.v1.CurrencyPairProviders does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5163,62 +6037,49 @@ func (x *fastReflection_CurrencyPairProviders) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyPairProviders) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AggregateExchangeRateVote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "oracle.v1.CurrencyPairProviders.pair_address":
-		if x.PairAddress == nil {
-			x.PairAddress = []*PairAddressProvider{}
-		}
-		value := &_CurrencyPairProviders_3_list{list: &x.PairAddress}
-		return protoreflect.ValueOfList(value)
-	case "oracle.v1.CurrencyPairProviders.providers":
-		if x.Providers == nil {
-			x.Providers = []string{}
+	case "oracle.v1.AggregateExchangeRateVote.exchange_rates":
+		if x.ExchangeRates == nil {
+			x.ExchangeRates = []*v1beta1.DecCoin{}
 		}
-		value := &_CurrencyPairProviders_4_list{list: &x.Providers}
+		value := &_AggregateExchangeRateVote_1_list{list: &x.ExchangeRates}
 		return protoreflect.ValueOfList(value)
-	case "oracle.v1.CurrencyPairProviders.base_denom":
-		panic(fmt.Errorf("field base_denom of message oracle.v1.CurrencyPairProviders is not mutable"))
-	case "oracle.v1.CurrencyPairProviders.quote_denom":
-		panic(fmt.Errorf("field quote_denom of message oracle.v1.CurrencyPairProviders is not mutable"))
+	case "oracle.v1.AggregateExchangeRateVote.voter":
+		panic(fmt.Errorf("field voter of message oracle.v1.AggregateExchangeRateVote is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.CurrencyPairProviders"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.AggregateExchangeRateVote"))
 		}
-		panic(fmt.Errorf("message oracle.v1.CurrencyPairProviders does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CurrencyPairProviders) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AggregateExchangeRateVote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "oracle.v1.CurrencyPairProviders.base_denom":
-		return protoreflect.ValueOfString("")
-	case "oracle.v1.CurrencyPairProviders.quote_denom":
+	case "oracle.v1.AggregateExchangeRateVote.exchange_rates":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_AggregateExchangeRateVote_1_list{list: &list})
+	case "oracle.v1.AggregateExchangeRateVote.voter":
 		return protoreflect.ValueOfString("")
-	case "oracle.v1.CurrencyPairProviders.pair_address":
-		list := []*PairAddressProvider{}
-		return protoreflect.ValueOfList(&_CurrencyPairProviders_3_list{list: &list})
-	case "oracle.v1.CurrencyPairProviders.providers":
-		list := []string{}
-		return protoreflect.ValueOfList(&_CurrencyPairProviders_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.CurrencyPairProviders"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.AggregateExchangeRateVote"))
 		}
-		panic(fmt.Errorf("message oracle.v1.CurrencyPairProviders does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.AggregateExchangeRateVote does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CurrencyPairProviders) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AggregateExchangeRateVote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.CurrencyPairProviders", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.AggregateExchangeRateVote", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5226,7 +6087,7 @@ func (x *fastReflection_CurrencyPairProviders) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CurrencyPairProviders) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AggregateExchangeRateVote) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5237,7 +6098,7 @@ func (x *fastReflection_CurrencyPairProviders) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyPairProviders) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AggregateExchangeRateVote) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5249,7 +6110,7 @@ func (x *fastReflection_CurrencyPairProviders) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CurrencyPairProviders) IsValid() bool {
+func (x *fastReflection_AggregateExchangeRateVote) IsValid() bool {
 	return x != nil
 }
 
@@ -5259,9 +6120,9 @@ func (x *fastReflection_CurrencyPairProviders) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CurrencyPairProviders) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AggregateExchangeRateVote) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CurrencyPairProviders)
+		x := input.Message.Interface().(*AggregateExchangeRateVote)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5273,25 +6134,15 @@ func (x *fastReflection_CurrencyPairProviders) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.BaseDenom)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.QuoteDenom)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.PairAddress) > 0 {
-			for _, e := range x.PairAddress {
+		if len(x.ExchangeRates) > 0 {
+			for _, e := range x.ExchangeRates {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if len(x.Providers) > 0 {
-			for _, s := range x.Providers {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Voter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5303,7 +6154,7 @@ func (x *fastReflection_CurrencyPairProviders) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CurrencyPairProviders)
+		x := input.Message.Interface().(*AggregateExchangeRateVote)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5322,18 +6173,16 @@ func (x *fastReflection_CurrencyPairProviders) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Providers) > 0 {
-			for iNdEx := len(x.Providers) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Providers[iNdEx])
-				copy(dAtA[i:], x.Providers[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Providers[iNdEx])))
-				i--
-				dAtA[i] = 0x22
-			}
+		if len(x.Voter) > 0 {
+			i -= len(x.Voter)
+			copy(dAtA[i:], x.Voter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
+			i--
+			dAtA[i] = 0x12
 		}
-		if len(x.PairAddress) > 0 {
-			for iNdEx := len(x.PairAddress) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.PairAddress[iNdEx])
+		if len(x.ExchangeRates) > 0 {
+			for iNdEx := len(x.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExchangeRates[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5344,23 +6193,9 @@ func (x *fastReflection_CurrencyPairProviders) ProtoMethods() *protoiface.Method
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x1a
+				dAtA[i] = 0xa
 			}
 		}
-		if len(x.QuoteDenom) > 0 {
-			i -= len(x.QuoteDenom)
-			copy(dAtA[i:], x.QuoteDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.QuoteDenom)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.BaseDenom) > 0 {
-			i -= len(x.BaseDenom)
-			copy(dAtA[i:], x.BaseDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseDenom)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -5372,7 +6207,7 @@ func (x *fastReflection_CurrencyPairProviders) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CurrencyPairProviders)
+		x := input.Message.Interface().(*AggregateExchangeRateVote)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5404,79 +6239,15 @@ func (x *fastReflection_CurrencyPairProviders) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairProviders: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairProviders: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.BaseDenom = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.QuoteDenom = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PairAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5503,14 +6274,14 @@ func (x *fastReflection_CurrencyPairProviders) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PairAddress = append(x.PairAddress, &PairAddressProvider{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PairAddress[len(x.PairAddress)-1]); err != nil {
+				x.ExchangeRates = append(x.ExchangeRates, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExchangeRates[len(x.ExchangeRates)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5538,7 +6309,7 @@ func (x *fastReflection_CurrencyPairProviders) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Providers = append(x.Providers, string(dAtA[iNdEx:postIndex]))
+				x.Voter = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -5576,27 +6347,27 @@ func (x *fastReflection_CurrencyPairProviders) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_PairAddressProvider                  protoreflect.MessageDescriptor
-	fd_PairAddressProvider_address          protoreflect.FieldDescriptor
-	fd_PairAddressProvider_address_provider protoreflect.FieldDescriptor
+	md_PriceStamp               protoreflect.MessageDescriptor
+	fd_PriceStamp_exchange_rate protoreflect.FieldDescriptor
+	fd_PriceStamp_block_num     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_oracle_v1_oracle_proto_init()
-	md_PairAddressProvider = File_oracle_v1_oracle_proto.Messages().ByName("PairAddressProvider")
-	fd_PairAddressProvider_address = md_PairAddressProvider.Fields().ByName("address")
-	fd_PairAddressProvider_address_provider = md_PairAddressProvider.Fields().ByName("address_provider")
+	md_PriceStamp = File_oracle_v1_oracle_proto.Messages().ByName("PriceStamp")
+	fd_PriceStamp_exchange_rate = md_PriceStamp.Fields().ByName("exchange_rate")
+	fd_PriceStamp_block_num = md_PriceStamp.Fields().ByName("block_num")
 }
 
-var _ protoreflect.Message = (*fastReflection_PairAddressProvider)(nil)
+var _ protoreflect.Message = (*fastReflection_PriceStamp)(nil)
 
-type fastReflection_PairAddressProvider PairAddressProvider
+type fastReflection_PriceStamp PriceStamp
 
-func (x *PairAddressProvider) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_PairAddressProvider)(x)
+func (x *PriceStamp) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceStamp)(x)
 }
 
-func (x *PairAddressProvider) slowProtoReflect() protoreflect.Message {
+func (x *PriceStamp) slowProtoReflect() protoreflect.Message {
 	mi := &file_oracle_v1_oracle_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5608,43 +6379,43 @@ func (x *PairAddressProvider) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_PairAddressProvider_messageType fastReflection_PairAddressProvider_messageType
-var _ protoreflect.MessageType = fastReflection_PairAddressProvider_messageType{}
+var _fastReflection_PriceStamp_messageType fastReflection_PriceStamp_messageType
+var _ protoreflect.MessageType = fastReflection_PriceStamp_messageType{}
 
-type fastReflection_PairAddressProvider_messageType struct{}
+type fastReflection_PriceStamp_messageType struct{}
 
-func (x fastReflection_PairAddressProvider_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_PairAddressProvider)(nil)
+func (x fastReflection_PriceStamp_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceStamp)(nil)
 }
-func (x fastReflection_PairAddressProvider_messageType) New() protoreflect.Message {
-	return new(fastReflection_PairAddressProvider)
+func (x fastReflection_PriceStamp_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceStamp)
 }
-func (x fastReflection_PairAddressProvider_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_PairAddressProvider
+func (x fastReflection_PriceStamp_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceStamp
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_PairAddressProvider) Descriptor() protoreflect.MessageDescriptor {
-	return md_PairAddressProvider
+func (x *fastReflection_PriceStamp) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceStamp
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_PairAddressProvider) Type() protoreflect.MessageType {
-	return _fastReflection_PairAddressProvider_messageType
+func (x *fastReflection_PriceStamp) Type() protoreflect.MessageType {
+	return _fastReflection_PriceStamp_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_PairAddressProvider) New() protoreflect.Message {
-	return new(fastReflection_PairAddressProvider)
+func (x *fastReflection_PriceStamp) New() protoreflect.Message {
+	return new(fastReflection_PriceStamp)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_PairAddressProvider) Interface() protoreflect.ProtoMessage {
-	return (*PairAddressProvider)(x)
+func (x *fastReflection_PriceStamp) Interface() protoreflect.ProtoMessage {
+	return (*PriceStamp)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5652,16 +6423,16 @@ func (x *fastReflection_PairAddressProvider) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_PairAddressProvider) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_PairAddressProvider_address, value) {
+func (x *fastReflection_PriceStamp) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ExchangeRate != nil {
+		value := protoreflect.ValueOfMessage(x.ExchangeRate.ProtoReflect())
+		if !f(fd_PriceStamp_exchange_rate, value) {
 			return
 		}
 	}
-	if x.AddressProvider != "" {
-		value := protoreflect.ValueOfString(x.AddressProvider)
-		if !f(fd_PairAddressProvider_address_provider, value) {
+	if x.BlockNum != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockNum)
+		if !f(fd_PriceStamp_block_num, value) {
 			return
 		}
 	}
@@ -5678,17 +6449,17 @@ func (x *fastReflection_PairAddressProvider) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_PairAddressProvider) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PriceStamp) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "oracle.v1.PairAddressProvider.address":
-		return x.Address != ""
-	case "oracle.v1.PairAddressProvider.address_provider":
-		return x.AddressProvider != ""
+	case "oracle.v1.PriceStamp.exchange_rate":
+		return x.ExchangeRate != nil
+	case "oracle.v1.PriceStamp.block_num":
+		return x.BlockNum != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PairAddressProvider"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PriceStamp"))
 		}
-		panic(fmt.Errorf("message oracle.v1.PairAddressProvider does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.PriceStamp does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5698,17 +6469,17 @@ func (x *fastReflection_PairAddressProvider) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PairAddressProvider) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PriceStamp) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "oracle.v1.PairAddressProvider.address":
-		x.Address = ""
-	case "oracle.v1.PairAddressProvider.address_provider":
-		x.AddressProvider = ""
+	case "oracle.v1.PriceStamp.exchange_rate":
+		x.ExchangeRate = nil
+	case "oracle.v1.PriceStamp.block_num":
+		x.BlockNum = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PairAddressProvider"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PriceStamp"))
 		}
-		panic(fmt.Errorf("message oracle.v1.PairAddressProvider does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.PriceStamp does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5718,19 +6489,19 @@ func (x *fastReflection_PairAddressProvider) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_PairAddressProvider) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceStamp) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "oracle.v1.PairAddressProvider.address":
-		value := x.Address
-		return protoreflect.ValueOfString(value)
-	case "oracle.v1.PairAddressProvider.address_provider":
-		value := x.AddressProvider
-		return protoreflect.ValueOfString(value)
+	case "oracle.v1.PriceStamp.exchange_rate":
+		value := x.ExchangeRate
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "oracle.v1.PriceStamp.block_num":
+		value := x.BlockNum
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PairAddressProvider"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PriceStamp"))
 		}
-		panic(fmt.Errorf("message oracle.v1.PairAddressProvider does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message oracle.v1.PriceStamp does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5744,17 +6515,17 @@ func (x *fastReflection_PairAddressProvider) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PairAddressProvider) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PriceStamp) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "oracle.v1.PairAddressProvider.address":
-		x.Address = value.Interface().(string)
-	case "oracle.v1.PairAddressProvider.address_provider":
-		x.AddressProvider = value.Interface().(string)
+	case "oracle.v1.PriceStamp.exchange_rate":
+		x.ExchangeRate = value.Message().Interface().(*v1beta1.DecCoin)
+	case "oracle.v1.PriceStamp.block_num":
+		x.BlockNum = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PairAddressProvider"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PriceStamp"))
 		}
-		panic(fmt.Errorf("message oracle.v1.PairAddressProvider does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.PriceStamp does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5768,44 +6539,48 @@ func (x *fastReflection_PairAddressProvider) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PairAddressProvider) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceStamp) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "oracle.v1.PairAddressProvider.address":
-		panic(fmt.Errorf("field address of message oracle.v1.PairAddressProvider is not mutable"))
-	case "oracle.v1.PairAddressProvider.address_provider":
-		panic(fmt.Errorf("field address_provider of message oracle.v1.PairAddressProvider is not mutable"))
+	case "oracle.v1.PriceStamp.exchange_rate":
+		if x.ExchangeRate == nil {
+			x.ExchangeRate = new(v1beta1.DecCoin)
+		}
+		return protoreflect.ValueOfMessage(x.ExchangeRate.ProtoReflect())
+	case "oracle.v1.PriceStamp.block_num":
+		panic(fmt.Errorf("field block_num of message oracle.v1.PriceStamp is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PairAddressProvider"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PriceStamp"))
 		}
-		panic(fmt.Errorf("message oracle.v1.PairAddressProvider does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.PriceStamp does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_PairAddressProvider) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceStamp) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "oracle.v1.PairAddressProvider.address":
-		return protoreflect.ValueOfString("")
-	case "oracle.v1.PairAddressProvider.address_provider":
-		return protoreflect.ValueOfString("")
+	case "oracle.v1.PriceStamp.exchange_rate":
+		m := new(v1beta1.DecCoin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "oracle.v1.PriceStamp.block_num":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PairAddressProvider"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PriceStamp"))
 		}
-		panic(fmt.Errorf("message oracle.v1.PairAddressProvider does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.PriceStamp does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_PairAddressProvider) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PriceStamp) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.PairAddressProvider", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.PriceStamp", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5813,7 +6588,7 @@ func (x *fastReflection_PairAddressProvider) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_PairAddressProvider) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PriceStamp) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5824,7 +6599,7 @@ func (x *fastReflection_PairAddressProvider) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PairAddressProvider) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PriceStamp) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5836,7 +6611,7 @@ func (x *fastReflection_PairAddressProvider) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_PairAddressProvider) IsValid() bool {
+func (x *fastReflection_PriceStamp) IsValid() bool {
 	return x != nil
 }
 
@@ -5846,9 +6621,9 @@ func (x *fastReflection_PairAddressProvider) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_PairAddressProvider) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PriceStamp) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*PairAddressProvider)
+		x := input.Message.Interface().(*PriceStamp)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5860,13 +6635,12 @@ func (x *fastReflection_PairAddressProvider) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.Address)
-		if l > 0 {
+		if x.ExchangeRate != nil {
+			l = options.Size(x.ExchangeRate)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.AddressProvider)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.BlockNum != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNum))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5878,7 +6652,7 @@ func (x *fastReflection_PairAddressProvider) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*PairAddressProvider)
+		x := input.Message.Interface().(*PriceStamp)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5897,17 +6671,22 @@ func (x *fastReflection_PairAddressProvider) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AddressProvider) > 0 {
-			i -= len(x.AddressProvider)
-			copy(dAtA[i:], x.AddressProvider)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AddressProvider)))
+		if x.BlockNum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNum))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		if x.ExchangeRate != nil {
+			encoded, err := options.Marshal(x.ExchangeRate)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -5922,7 +6701,7 @@ func (x *fastReflection_PairAddressProvider) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*PairAddressProvider)
+		x := input.Message.Interface().(*PriceStamp)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5954,17 +6733,17 @@ func (x *fastReflection_PairAddressProvider) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PairAddressProvider: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceStamp: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PairAddressProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceStamp: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5974,29 +6753,33 @@ func (x *fastReflection_PairAddressProvider) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Address = string(dAtA[iNdEx:postIndex])
+				if x.ExchangeRate == nil {
+					x.ExchangeRate = &v1beta1.DecCoin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExchangeRate); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddressProvider", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNum", wireType)
 				}
-				var stringLen uint64
+				x.BlockNum = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6006,24 +6789,11 @@ func (x *fastReflection_PairAddressProvider) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.BlockNum |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.AddressProvider = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6060,27 +6830,31 @@ func (x *fastReflection_PairAddressProvider) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_CurrencyDeviationThreshold            protoreflect.MessageDescriptor
-	fd_CurrencyDeviationThreshold_base_denom protoreflect.FieldDescriptor
-	fd_CurrencyDeviationThreshold_threshold  protoreflect.FieldDescriptor
+	md_ExchangeRateUpdate               protoreflect.MessageDescriptor
+	fd_ExchangeRateUpdate_denom         protoreflect.FieldDescriptor
+	fd_ExchangeRateUpdate_exchange_rate protoreflect.FieldDescriptor
+	fd_ExchangeRateUpdate_block_num     protoreflect.FieldDescriptor
+	fd_ExchangeRateUpdate_block_time    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_oracle_v1_oracle_proto_init()
-	md_CurrencyDeviationThreshold = File_oracle_v1_oracle_proto.Messages().ByName("CurrencyDeviationThreshold")
-	fd_CurrencyDeviationThreshold_base_denom = md_CurrencyDeviationThreshold.Fields().ByName("base_denom")
-	fd_CurrencyDeviationThreshold_threshold = md_CurrencyDeviationThreshold.Fields().ByName("threshold")
+	md_ExchangeRateUpdate = File_oracle_v1_oracle_proto.Messages().ByName("ExchangeRateUpdate")
+	fd_ExchangeRateUpdate_denom = md_ExchangeRateUpdate.Fields().ByName("denom")
+	fd_ExchangeRateUpdate_exchange_rate = md_ExchangeRateUpdate.Fields().ByName("exchange_rate")
+	fd_ExchangeRateUpdate_block_num = md_ExchangeRateUpdate.Fields().ByName("block_num")
+	fd_ExchangeRateUpdate_block_time = md_ExchangeRateUpdate.Fields().ByName("block_time")
 }
 
-var _ protoreflect.Message = (*fastReflection_CurrencyDeviationThreshold)(nil)
+var _ protoreflect.Message = (*fastReflection_ExchangeRateUpdate)(nil)
 
-type fastReflection_CurrencyDeviationThreshold CurrencyDeviationThreshold
+type fastReflection_ExchangeRateUpdate ExchangeRateUpdate
 
-func (x *CurrencyDeviationThreshold) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CurrencyDeviationThreshold)(x)
+func (x *ExchangeRateUpdate) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ExchangeRateUpdate)(x)
 }
 
-func (x *CurrencyDeviationThreshold) slowProtoReflect() protoreflect.Message {
+func (x *ExchangeRateUpdate) slowProtoReflect() protoreflect.Message {
 	mi := &file_oracle_v1_oracle_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6092,43 +6866,43 @@ func (x *CurrencyDeviationThreshold) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CurrencyDeviationThreshold_messageType fastReflection_CurrencyDeviationThreshold_messageType
-var _ protoreflect.MessageType = fastReflection_CurrencyDeviationThreshold_messageType{}
+var _fastReflection_ExchangeRateUpdate_messageType fastReflection_ExchangeRateUpdate_messageType
+var _ protoreflect.MessageType = fastReflection_ExchangeRateUpdate_messageType{}
 
-type fastReflection_CurrencyDeviationThreshold_messageType struct{}
+type fastReflection_ExchangeRateUpdate_messageType struct{}
 
-func (x fastReflection_CurrencyDeviationThreshold_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CurrencyDeviationThreshold)(nil)
+func (x fastReflection_ExchangeRateUpdate_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ExchangeRateUpdate)(nil)
 }
-func (x fastReflection_CurrencyDeviationThreshold_messageType) New() protoreflect.Message {
-	return new(fastReflection_CurrencyDeviationThreshold)
+func (x fastReflection_ExchangeRateUpdate_messageType) New() protoreflect.Message {
+	return new(fastReflection_ExchangeRateUpdate)
 }
-func (x fastReflection_CurrencyDeviationThreshold_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CurrencyDeviationThreshold
+func (x fastReflection_ExchangeRateUpdate_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExchangeRateUpdate
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CurrencyDeviationThreshold) Descriptor() protoreflect.MessageDescriptor {
-	return md_CurrencyDeviationThreshold
+func (x *fastReflection_ExchangeRateUpdate) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExchangeRateUpdate
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CurrencyDeviationThreshold) Type() protoreflect.MessageType {
-	return _fastReflection_CurrencyDeviationThreshold_messageType
+func (x *fastReflection_ExchangeRateUpdate) Type() protoreflect.MessageType {
+	return _fastReflection_ExchangeRateUpdate_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CurrencyDeviationThreshold) New() protoreflect.Message {
-	return new(fastReflection_CurrencyDeviationThreshold)
+func (x *fastReflection_ExchangeRateUpdate) New() protoreflect.Message {
+	return new(fastReflection_ExchangeRateUpdate)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CurrencyDeviationThreshold) Interface() protoreflect.ProtoMessage {
-	return (*CurrencyDeviationThreshold)(x)
+func (x *fastReflection_ExchangeRateUpdate) Interface() protoreflect.ProtoMessage {
+	return (*ExchangeRateUpdate)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6136,16 +6910,28 @@ func (x *fastReflection_CurrencyDeviationThreshold) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CurrencyDeviationThreshold) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BaseDenom != "" {
-		value := protoreflect.ValueOfString(x.BaseDenom)
-		if !f(fd_CurrencyDeviationThreshold_base_denom, value) {
+func (x *fastReflection_ExchangeRateUpdate) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_ExchangeRateUpdate_denom, value) {
 			return
 		}
 	}
-	if x.Threshold != "" {
-		value := protoreflect.ValueOfString(x.Threshold)
-		if !f(fd_CurrencyDeviationThreshold_threshold, value) {
+	if x.ExchangeRate != "" {
+		value := protoreflect.ValueOfString(x.ExchangeRate)
+		if !f(fd_ExchangeRateUpdate_exchange_rate, value) {
+			return
+		}
+	}
+	if x.BlockNum != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockNum)
+		if !f(fd_ExchangeRateUpdate_block_num, value) {
+			return
+		}
+	}
+	if x.BlockTime != nil {
+		value := protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
+		if !f(fd_ExchangeRateUpdate_block_time, value) {
 			return
 		}
 	}
@@ -6162,17 +6948,21 @@ func (x *fastReflection_CurrencyDeviationThreshold) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CurrencyDeviationThreshold) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ExchangeRateUpdate) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "oracle.v1.CurrencyDeviationThreshold.base_denom":
-		return x.BaseDenom != ""
-	case "oracle.v1.CurrencyDeviationThreshold.threshold":
-		return x.Threshold != ""
+	case "oracle.v1.ExchangeRateUpdate.denom":
+		return x.Denom != ""
+	case "oracle.v1.ExchangeRateUpdate.exchange_rate":
+		return x.ExchangeRate != ""
+	case "oracle.v1.ExchangeRateUpdate.block_num":
+		return x.BlockNum != uint64(0)
+	case "oracle.v1.ExchangeRateUpdate.block_time":
+		return x.BlockTime != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.CurrencyDeviationThreshold"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ExchangeRateUpdate"))
 		}
-		panic(fmt.Errorf("message oracle.v1.CurrencyDeviationThreshold does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.ExchangeRateUpdate does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6182,17 +6972,21 @@ func (x *fastReflection_CurrencyDeviationThreshold) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyDeviationThreshold) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ExchangeRateUpdate) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "oracle.v1.CurrencyDeviationThreshold.base_denom":
-		x.BaseDenom = ""
-	case "oracle.v1.CurrencyDeviationThreshold.threshold":
-		x.Threshold = ""
+	case "oracle.v1.ExchangeRateUpdate.denom":
+		x.Denom = ""
+	case "oracle.v1.ExchangeRateUpdate.exchange_rate":
+		x.ExchangeRate = ""
+	case "oracle.v1.ExchangeRateUpdate.block_num":
+		x.BlockNum = uint64(0)
+	case "oracle.v1.ExchangeRateUpdate.block_time":
+		x.BlockTime = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.CurrencyDeviationThreshold"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ExchangeRateUpdate"))
 		}
-		panic(fmt.Errorf("message oracle.v1.CurrencyDeviationThreshold does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.ExchangeRateUpdate does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6202,19 +6996,25 @@ func (x *fastReflection_CurrencyDeviationThreshold) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CurrencyDeviationThreshold) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ExchangeRateUpdate) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "oracle.v1.CurrencyDeviationThreshold.base_denom":
-		value := x.BaseDenom
+	case "oracle.v1.ExchangeRateUpdate.denom":
+		value := x.Denom
 		return protoreflect.ValueOfString(value)
-	case "oracle.v1.CurrencyDeviationThreshold.threshold":
-		value := x.Threshold
+	case "oracle.v1.ExchangeRateUpdate.exchange_rate":
+		value := x.ExchangeRate
 		return protoreflect.ValueOfString(value)
+	case "oracle.v1.ExchangeRateUpdate.block_num":
+		value := x.BlockNum
+		return protoreflect.ValueOfUint64(value)
+	case "oracle.v1.ExchangeRateUpdate.block_time":
+		value := x.BlockTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.CurrencyDeviationThreshold"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ExchangeRateUpdate"))
 		}
-		panic(fmt.Errorf("message oracle.v1.CurrencyDeviationThreshold does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message oracle.v1.ExchangeRateUpdate does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6228,17 +7028,21 @@ func (x *fastReflection_CurrencyDeviationThreshold) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyDeviationThreshold) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ExchangeRateUpdate) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "oracle.v1.CurrencyDeviationThreshold.base_denom":
-		x.BaseDenom = value.Interface().(string)
-	case "oracle.v1.CurrencyDeviationThreshold.threshold":
-		x.Threshold = value.Interface().(string)
+	case "oracle.v1.ExchangeRateUpdate.denom":
+		x.Denom = value.Interface().(string)
+	case "oracle.v1.ExchangeRateUpdate.exchange_rate":
+		x.ExchangeRate = value.Interface().(string)
+	case "oracle.v1.ExchangeRateUpdate.block_num":
+		x.BlockNum = value.Uint()
+	case "oracle.v1.ExchangeRateUpdate.block_time":
+		x.BlockTime = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.CurrencyDeviationThreshold"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ExchangeRateUpdate"))
 		}
-		panic(fmt.Errorf("message oracle.v1.CurrencyDeviationThreshold does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.ExchangeRateUpdate does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6252,44 +7056,56 @@ func (x *fastReflection_CurrencyDeviationThreshold) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyDeviationThreshold) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ExchangeRateUpdate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "oracle.v1.CurrencyDeviationThreshold.base_denom":
-		panic(fmt.Errorf("field base_denom of message oracle.v1.CurrencyDeviationThreshold is not mutable"))
-	case "oracle.v1.CurrencyDeviationThreshold.threshold":
-		panic(fmt.Errorf("field threshold of message oracle.v1.CurrencyDeviationThreshold is not mutable"))
+	case "oracle.v1.ExchangeRateUpdate.block_time":
+		if x.BlockTime == nil {
+			x.BlockTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
+	case "oracle.v1.ExchangeRateUpdate.denom":
+		panic(fmt.Errorf("field denom of message oracle.v1.ExchangeRateUpdate is not mutable"))
+	case "oracle.v1.ExchangeRateUpdate.exchange_rate":
+		panic(fmt.Errorf("field exchange_rate of message oracle.v1.ExchangeRateUpdate is not mutable"))
+	case "oracle.v1.ExchangeRateUpdate.block_num":
+		panic(fmt.Errorf("field block_num of message oracle.v1.ExchangeRateUpdate is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.CurrencyDeviationThreshold"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ExchangeRateUpdate"))
 		}
-		panic(fmt.Errorf("message oracle.v1.CurrencyDeviationThreshold does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.ExchangeRateUpdate does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CurrencyDeviationThreshold) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ExchangeRateUpdate) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "oracle.v1.CurrencyDeviationThreshold.base_denom":
+	case "oracle.v1.ExchangeRateUpdate.denom":
 		return protoreflect.ValueOfString("")
-	case "oracle.v1.CurrencyDeviationThreshold.threshold":
+	case "oracle.v1.ExchangeRateUpdate.exchange_rate":
 		return protoreflect.ValueOfString("")
+	case "oracle.v1.ExchangeRateUpdate.block_num":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "oracle.v1.ExchangeRateUpdate.block_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.CurrencyDeviationThreshold"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ExchangeRateUpdate"))
 		}
-		panic(fmt.Errorf("message oracle.v1.CurrencyDeviationThreshold does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.ExchangeRateUpdate does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CurrencyDeviationThreshold) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ExchangeRateUpdate) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.CurrencyDeviationThreshold", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.ExchangeRateUpdate", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6297,7 +7113,7 @@ func (x *fastReflection_CurrencyDeviationThreshold) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CurrencyDeviationThreshold) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ExchangeRateUpdate) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6308,7 +7124,7 @@ func (x *fastReflection_CurrencyDeviationThreshold) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyDeviationThreshold) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ExchangeRateUpdate) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6320,7 +7136,7 @@ func (x *fastReflection_CurrencyDeviationThreshold) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CurrencyDeviationThreshold) IsValid() bool {
+func (x *fastReflection_ExchangeRateUpdate) IsValid() bool {
 	return x != nil
 }
 
@@ -6330,9 +7146,9 @@ func (x *fastReflection_CurrencyDeviationThreshold) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CurrencyDeviationThreshold) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ExchangeRateUpdate) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CurrencyDeviationThreshold)
+		x := input.Message.Interface().(*ExchangeRateUpdate)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6344,14 +7160,21 @@ func (x *fastReflection_CurrencyDeviationThreshold) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		l = len(x.BaseDenom)
+		l = len(x.Denom)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Threshold)
+		l = len(x.ExchangeRate)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.BlockNum != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNum))
+		}
+		if x.BlockTime != nil {
+			l = options.Size(x.BlockTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6362,7 +7185,7 @@ func (x *fastReflection_CurrencyDeviationThreshold) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CurrencyDeviationThreshold)
+		x := input.Message.Interface().(*ExchangeRateUpdate)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6381,17 +7204,36 @@ func (x *fastReflection_CurrencyDeviationThreshold) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Threshold) > 0 {
-			i -= len(x.Threshold)
-			copy(dAtA[i:], x.Threshold)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Threshold)))
+		if x.BlockTime != nil {
+			encoded, err := options.Marshal(x.BlockTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.BlockNum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNum))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.ExchangeRate) > 0 {
+			i -= len(x.ExchangeRate)
+			copy(dAtA[i:], x.ExchangeRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExchangeRate)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.BaseDenom) > 0 {
-			i -= len(x.BaseDenom)
-			copy(dAtA[i:], x.BaseDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseDenom)))
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6406,7 +7248,7 @@ func (x *fastReflection_CurrencyDeviationThreshold) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CurrencyDeviationThreshold)
+		x := input.Message.Interface().(*ExchangeRateUpdate)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6438,15 +7280,15 @@ func (x *fastReflection_CurrencyDeviationThreshold) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyDeviationThreshold: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExchangeRateUpdate: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyDeviationThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExchangeRateUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6474,11 +7316,11 @@ func (x *fastReflection_CurrencyDeviationThreshold) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BaseDenom = string(dAtA[iNdEx:postIndex])
+				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6506,7 +7348,62 @@ func (x *fastReflection_CurrencyDeviationThreshold) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Threshold = string(dAtA[iNdEx:postIndex])
+				x.ExchangeRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNum", wireType)
+				}
+				x.BlockNum = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockNum |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BlockTime == nil {
+					x.BlockTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6543,76 +7440,34 @@ func (x *fastReflection_CurrencyDeviationThreshold) ProtoMethods() *protoiface.M
 	}
 }
 
-var _ protoreflect.List = (*_ParamUpdatePlan_1_list)(nil)
-
-type _ParamUpdatePlan_1_list struct {
-	list *[]string
-}
-
-func (x *_ParamUpdatePlan_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_ParamUpdatePlan_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_ParamUpdatePlan_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_ParamUpdatePlan_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_ParamUpdatePlan_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message ParamUpdatePlan at list field Keys as it is not of Message kind"))
-}
-
-func (x *_ParamUpdatePlan_1_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_ParamUpdatePlan_1_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_ParamUpdatePlan_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_ParamUpdatePlan         protoreflect.MessageDescriptor
-	fd_ParamUpdatePlan_keys    protoreflect.FieldDescriptor
-	fd_ParamUpdatePlan_height  protoreflect.FieldDescriptor
-	fd_ParamUpdatePlan_changes protoreflect.FieldDescriptor
+	md_ExchangeRateStatus             protoreflect.MessageDescriptor
+	fd_ExchangeRateStatus_last_update protoreflect.FieldDescriptor
+	fd_ExchangeRateStatus_age_blocks  protoreflect.FieldDescriptor
+	fd_ExchangeRateStatus_age         protoreflect.FieldDescriptor
+	fd_ExchangeRateStatus_stale       protoreflect.FieldDescriptor
+	fd_ExchangeRateStatus_halted      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_oracle_v1_oracle_proto_init()
-	md_ParamUpdatePlan = File_oracle_v1_oracle_proto.Messages().ByName("ParamUpdatePlan")
-	fd_ParamUpdatePlan_keys = md_ParamUpdatePlan.Fields().ByName("keys")
-	fd_ParamUpdatePlan_height = md_ParamUpdatePlan.Fields().ByName("height")
-	fd_ParamUpdatePlan_changes = md_ParamUpdatePlan.Fields().ByName("changes")
+	md_ExchangeRateStatus = File_oracle_v1_oracle_proto.Messages().ByName("ExchangeRateStatus")
+	fd_ExchangeRateStatus_last_update = md_ExchangeRateStatus.Fields().ByName("last_update")
+	fd_ExchangeRateStatus_age_blocks = md_ExchangeRateStatus.Fields().ByName("age_blocks")
+	fd_ExchangeRateStatus_age = md_ExchangeRateStatus.Fields().ByName("age")
+	fd_ExchangeRateStatus_stale = md_ExchangeRateStatus.Fields().ByName("stale")
+	fd_ExchangeRateStatus_halted = md_ExchangeRateStatus.Fields().ByName("halted")
 }
 
-var _ protoreflect.Message = (*fastReflection_ParamUpdatePlan)(nil)
+var _ protoreflect.Message = (*fastReflection_ExchangeRateStatus)(nil)
 
-type fastReflection_ParamUpdatePlan ParamUpdatePlan
+type fastReflection_ExchangeRateStatus ExchangeRateStatus
 
-func (x *ParamUpdatePlan) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ParamUpdatePlan)(x)
+func (x *ExchangeRateStatus) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ExchangeRateStatus)(x)
 }
 
-func (x *ParamUpdatePlan) slowProtoReflect() protoreflect.Message {
+func (x *ExchangeRateStatus) slowProtoReflect() protoreflect.Message {
 	mi := &file_oracle_v1_oracle_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6624,43 +7479,43 @@ func (x *ParamUpdatePlan) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ParamUpdatePlan_messageType fastReflection_ParamUpdatePlan_messageType
-var _ protoreflect.MessageType = fastReflection_ParamUpdatePlan_messageType{}
+var _fastReflection_ExchangeRateStatus_messageType fastReflection_ExchangeRateStatus_messageType
+var _ protoreflect.MessageType = fastReflection_ExchangeRateStatus_messageType{}
 
-type fastReflection_ParamUpdatePlan_messageType struct{}
+type fastReflection_ExchangeRateStatus_messageType struct{}
 
-func (x fastReflection_ParamUpdatePlan_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ParamUpdatePlan)(nil)
+func (x fastReflection_ExchangeRateStatus_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ExchangeRateStatus)(nil)
 }
-func (x fastReflection_ParamUpdatePlan_messageType) New() protoreflect.Message {
-	return new(fastReflection_ParamUpdatePlan)
+func (x fastReflection_ExchangeRateStatus_messageType) New() protoreflect.Message {
+	return new(fastReflection_ExchangeRateStatus)
 }
-func (x fastReflection_ParamUpdatePlan_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ParamUpdatePlan
+func (x fastReflection_ExchangeRateStatus_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExchangeRateStatus
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ParamUpdatePlan) Descriptor() protoreflect.MessageDescriptor {
-	return md_ParamUpdatePlan
+func (x *fastReflection_ExchangeRateStatus) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExchangeRateStatus
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ParamUpdatePlan) Type() protoreflect.MessageType {
-	return _fastReflection_ParamUpdatePlan_messageType
+func (x *fastReflection_ExchangeRateStatus) Type() protoreflect.MessageType {
+	return _fastReflection_ExchangeRateStatus_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ParamUpdatePlan) New() protoreflect.Message {
-	return new(fastReflection_ParamUpdatePlan)
+func (x *fastReflection_ExchangeRateStatus) New() protoreflect.Message {
+	return new(fastReflection_ExchangeRateStatus)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ParamUpdatePlan) Interface() protoreflect.ProtoMessage {
-	return (*ParamUpdatePlan)(x)
+func (x *fastReflection_ExchangeRateStatus) Interface() protoreflect.ProtoMessage {
+	return (*ExchangeRateStatus)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6668,22 +7523,34 @@ func (x *fastReflection_ParamUpdatePlan) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ParamUpdatePlan) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Keys) != 0 {
-		value := protoreflect.ValueOfList(&_ParamUpdatePlan_1_list{list: &x.Keys})
-		if !f(fd_ParamUpdatePlan_keys, value) {
+func (x *fastReflection_ExchangeRateStatus) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LastUpdate != nil {
+		value := protoreflect.ValueOfMessage(x.LastUpdate.ProtoReflect())
+		if !f(fd_ExchangeRateStatus_last_update, value) {
 			return
 		}
 	}
-	if x.Height != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Height)
-		if !f(fd_ParamUpdatePlan_height, value) {
+	if x.AgeBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AgeBlocks)
+		if !f(fd_ExchangeRateStatus_age_blocks, value) {
 			return
 		}
 	}
-	if x.Changes != nil {
-		value := protoreflect.ValueOfMessage(x.Changes.ProtoReflect())
-		if !f(fd_ParamUpdatePlan_changes, value) {
+	if x.Age != nil {
+		value := protoreflect.ValueOfMessage(x.Age.ProtoReflect())
+		if !f(fd_ExchangeRateStatus_age, value) {
+			return
+		}
+	}
+	if x.Stale != false {
+		value := protoreflect.ValueOfBool(x.Stale)
+		if !f(fd_ExchangeRateStatus_stale, value) {
+			return
+		}
+	}
+	if x.Halted != false {
+		value := protoreflect.ValueOfBool(x.Halted)
+		if !f(fd_ExchangeRateStatus_halted, value) {
 			return
 		}
 	}
@@ -6700,19 +7567,23 @@ func (x *fastReflection_ParamUpdatePlan) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ParamUpdatePlan) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ExchangeRateStatus) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "oracle.v1.ParamUpdatePlan.keys":
-		return len(x.Keys) != 0
-	case "oracle.v1.ParamUpdatePlan.height":
-		return x.Height != int64(0)
-	case "oracle.v1.ParamUpdatePlan.changes":
-		return x.Changes != nil
+	case "oracle.v1.ExchangeRateStatus.last_update":
+		return x.LastUpdate != nil
+	case "oracle.v1.ExchangeRateStatus.age_blocks":
+		return x.AgeBlocks != uint64(0)
+	case "oracle.v1.ExchangeRateStatus.age":
+		return x.Age != nil
+	case "oracle.v1.ExchangeRateStatus.stale":
+		return x.Stale != false
+	case "oracle.v1.ExchangeRateStatus.halted":
+		return x.Halted != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ParamUpdatePlan"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ExchangeRateStatus"))
 		}
-		panic(fmt.Errorf("message oracle.v1.ParamUpdatePlan does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.ExchangeRateStatus does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6722,19 +7593,23 @@ func (x *fastReflection_ParamUpdatePlan) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParamUpdatePlan) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ExchangeRateStatus) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "oracle.v1.ParamUpdatePlan.keys":
-		x.Keys = nil
-	case "oracle.v1.ParamUpdatePlan.height":
-		x.Height = int64(0)
-	case "oracle.v1.ParamUpdatePlan.changes":
-		x.Changes = nil
+	case "oracle.v1.ExchangeRateStatus.last_update":
+		x.LastUpdate = nil
+	case "oracle.v1.ExchangeRateStatus.age_blocks":
+		x.AgeBlocks = uint64(0)
+	case "oracle.v1.ExchangeRateStatus.age":
+		x.Age = nil
+	case "oracle.v1.ExchangeRateStatus.stale":
+		x.Stale = false
+	case "oracle.v1.ExchangeRateStatus.halted":
+		x.Halted = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ParamUpdatePlan"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ExchangeRateStatus"))
 		}
-		panic(fmt.Errorf("message oracle.v1.ParamUpdatePlan does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.ExchangeRateStatus does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6744,25 +7619,28 @@ func (x *fastReflection_ParamUpdatePlan) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ParamUpdatePlan) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ExchangeRateStatus) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "oracle.v1.ParamUpdatePlan.keys":
-		if len(x.Keys) == 0 {
-			return protoreflect.ValueOfList(&_ParamUpdatePlan_1_list{})
-		}
-		listValue := &_ParamUpdatePlan_1_list{list: &x.Keys}
-		return protoreflect.ValueOfList(listValue)
-	case "oracle.v1.ParamUpdatePlan.height":
-		value := x.Height
-		return protoreflect.ValueOfInt64(value)
-	case "oracle.v1.ParamUpdatePlan.changes":
-		value := x.Changes
+	case "oracle.v1.ExchangeRateStatus.last_update":
+		value := x.LastUpdate
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "oracle.v1.ExchangeRateStatus.age_blocks":
+		value := x.AgeBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "oracle.v1.ExchangeRateStatus.age":
+		value := x.Age
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "oracle.v1.ExchangeRateStatus.stale":
+		value := x.Stale
+		return protoreflect.ValueOfBool(value)
+	case "oracle.v1.ExchangeRateStatus.halted":
+		value := x.Halted
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ParamUpdatePlan"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ExchangeRateStatus"))
 		}
-		panic(fmt.Errorf("message oracle.v1.ParamUpdatePlan does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message oracle.v1.ExchangeRateStatus does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6776,21 +7654,23 @@ func (x *fastReflection_ParamUpdatePlan) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParamUpdatePlan) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ExchangeRateStatus) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "oracle.v1.ParamUpdatePlan.keys":
-		lv := value.List()
-		clv := lv.(*_ParamUpdatePlan_1_list)
-		x.Keys = *clv.list
-	case "oracle.v1.ParamUpdatePlan.height":
-		x.Height = value.Int()
-	case "oracle.v1.ParamUpdatePlan.changes":
-		x.Changes = value.Message().Interface().(*Params)
+	case "oracle.v1.ExchangeRateStatus.last_update":
+		x.LastUpdate = value.Message().Interface().(*ExchangeRateUpdate)
+	case "oracle.v1.ExchangeRateStatus.age_blocks":
+		x.AgeBlocks = value.Uint()
+	case "oracle.v1.ExchangeRateStatus.age":
+		x.Age = value.Message().Interface().(*durationpb.Duration)
+	case "oracle.v1.ExchangeRateStatus.stale":
+		x.Stale = value.Bool()
+	case "oracle.v1.ExchangeRateStatus.halted":
+		x.Halted = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ParamUpdatePlan"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ExchangeRateStatus"))
 		}
-		panic(fmt.Errorf("message oracle.v1.ParamUpdatePlan does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.ExchangeRateStatus does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6804,57 +7684,64 @@ func (x *fastReflection_ParamUpdatePlan) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParamUpdatePlan) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ExchangeRateStatus) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "oracle.v1.ParamUpdatePlan.keys":
-		if x.Keys == nil {
-			x.Keys = []string{}
-		}
-		value := &_ParamUpdatePlan_1_list{list: &x.Keys}
-		return protoreflect.ValueOfList(value)
-	case "oracle.v1.ParamUpdatePlan.changes":
-		if x.Changes == nil {
-			x.Changes = new(Params)
-		}
-		return protoreflect.ValueOfMessage(x.Changes.ProtoReflect())
-	case "oracle.v1.ParamUpdatePlan.height":
-		panic(fmt.Errorf("field height of message oracle.v1.ParamUpdatePlan is not mutable"))
+	case "oracle.v1.ExchangeRateStatus.last_update":
+		if x.LastUpdate == nil {
+			x.LastUpdate = new(ExchangeRateUpdate)
+		}
+		return protoreflect.ValueOfMessage(x.LastUpdate.ProtoReflect())
+	case "oracle.v1.ExchangeRateStatus.age":
+		if x.Age == nil {
+			x.Age = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.Age.ProtoReflect())
+	case "oracle.v1.ExchangeRateStatus.age_blocks":
+		panic(fmt.Errorf("field age_blocks of message oracle.v1.ExchangeRateStatus is not mutable"))
+	case "oracle.v1.ExchangeRateStatus.stale":
+		panic(fmt.Errorf("field stale of message oracle.v1.ExchangeRateStatus is not mutable"))
+	case "oracle.v1.ExchangeRateStatus.halted":
+		panic(fmt.Errorf("field halted of message oracle.v1.ExchangeRateStatus is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ParamUpdatePlan"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ExchangeRateStatus"))
 		}
-		panic(fmt.Errorf("message oracle.v1.ParamUpdatePlan does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.ExchangeRateStatus does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ParamUpdatePlan) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ExchangeRateStatus) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "oracle.v1.ParamUpdatePlan.keys":
-		list := []string{}
-		return protoreflect.ValueOfList(&_ParamUpdatePlan_1_list{list: &list})
-	case "oracle.v1.ParamUpdatePlan.height":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "oracle.v1.ParamUpdatePlan.changes":
-		m := new(Params)
+	case "oracle.v1.ExchangeRateStatus.last_update":
+		m := new(ExchangeRateUpdate)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "oracle.v1.ExchangeRateStatus.age_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "oracle.v1.ExchangeRateStatus.age":
+		m := new(durationpb.Duration)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "oracle.v1.ExchangeRateStatus.stale":
+		return protoreflect.ValueOfBool(false)
+	case "oracle.v1.ExchangeRateStatus.halted":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ParamUpdatePlan"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ExchangeRateStatus"))
 		}
-		panic(fmt.Errorf("message oracle.v1.ParamUpdatePlan does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message oracle.v1.ExchangeRateStatus does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ParamUpdatePlan) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ExchangeRateStatus) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.ParamUpdatePlan", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.ExchangeRateStatus", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6862,7 +7749,7 @@ func (x *fastReflection_ParamUpdatePlan) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ParamUpdatePlan) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ExchangeRateStatus) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6873,7 +7760,7 @@ func (x *fastReflection_ParamUpdatePlan) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ParamUpdatePlan) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ExchangeRateStatus) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6885,7 +7772,7 @@ func (x *fastReflection_ParamUpdatePlan) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ParamUpdatePlan) IsValid() bool {
+func (x *fastReflection_ExchangeRateStatus) IsValid() bool {
 	return x != nil
 }
 
@@ -6895,9 +7782,9 @@ func (x *fastReflection_ParamUpdatePlan) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ParamUpdatePlan) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ExchangeRateStatus) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ParamUpdatePlan)
+		x := input.Message.Interface().(*ExchangeRateStatus)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6909,19 +7796,23 @@ func (x *fastReflection_ParamUpdatePlan) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Keys) > 0 {
-			for _, s := range x.Keys {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.LastUpdate != nil {
+			l = options.Size(x.LastUpdate)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Height != 0 {
-			n += 1 + runtime.Sov(uint64(x.Height))
+		if x.AgeBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.AgeBlocks))
 		}
-		if x.Changes != nil {
-			l = options.Size(x.Changes)
+		if x.Age != nil {
+			l = options.Size(x.Age)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Stale {
+			n += 2
+		}
+		if x.Halted {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6932,7 +7823,7 @@ func (x *fastReflection_ParamUpdatePlan) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ParamUpdatePlan)
+		x := input.Message.Interface().(*ExchangeRateStatus)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6951,8 +7842,28 @@ func (x *fastReflection_ParamUpdatePlan) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Changes != nil {
-			encoded, err := options.Marshal(x.Changes)
+		if x.Halted {
+			i--
+			if x.Halted {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Stale {
+			i--
+			if x.Stale {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Age != nil {
+			encoded, err := options.Marshal(x.Age)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6965,19 +7876,24 @@ func (x *fastReflection_ParamUpdatePlan) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x1a
 		}
-		if x.Height != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+		if x.AgeBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AgeBlocks))
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.Keys) > 0 {
-			for iNdEx := len(x.Keys) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Keys[iNdEx])
-				copy(dAtA[i:], x.Keys[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Keys[iNdEx])))
-				i--
-				dAtA[i] = 0xa
+		if x.LastUpdate != nil {
+			encoded, err := options.Marshal(x.LastUpdate)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6990,7 +7906,7 @@ func (x *fastReflection_ParamUpdatePlan) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ParamUpdatePlan)
+		x := input.Message.Interface().(*ExchangeRateStatus)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7022,17 +7938,17 @@ func (x *fastReflection_ParamUpdatePlan) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamUpdatePlan: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExchangeRateStatus: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamUpdatePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExchangeRateStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7042,29 +7958,33 @@ func (x *fastReflection_ParamUpdatePlan) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Keys = append(x.Keys, string(dAtA[iNdEx:postIndex]))
+				if x.LastUpdate == nil {
+					x.LastUpdate = &ExchangeRateUpdate{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LastUpdate); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AgeBlocks", wireType)
 				}
-				x.Height = 0
+				x.AgeBlocks = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7074,14 +7994,14 @@ func (x *fastReflection_ParamUpdatePlan) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Height |= int64(b&0x7F) << shift
+					x.AgeBlocks |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Age", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -7108,13 +8028,53 @@ func (x *fastReflection_ParamUpdatePlan) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Changes == nil {
-					x.Changes = &Params{}
+				if x.Age == nil {
+					x.Age = &durationpb.Duration{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Changes); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Age); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Stale = bool(v != 0)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Halted = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7150,445 +8110,6956 @@ func (x *fastReflection_ParamUpdatePlan) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: oracle/v1/oracle.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_PriceHalt               protoreflect.MessageDescriptor
+	fd_PriceHalt_denom         protoreflect.FieldDescriptor
+	fd_PriceHalt_halted_rate   protoreflect.FieldDescriptor
+	fd_PriceHalt_rejected_rate protoreflect.FieldDescriptor
+	fd_PriceHalt_block_num     protoreflect.FieldDescriptor
+	fd_PriceHalt_reason        protoreflect.FieldDescriptor
 )
 
-// Params defines the parameters for the oracle module.
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_oracle_v1_oracle_proto_init()
+	md_PriceHalt = File_oracle_v1_oracle_proto.Messages().ByName("PriceHalt")
+	fd_PriceHalt_denom = md_PriceHalt.Fields().ByName("denom")
+	fd_PriceHalt_halted_rate = md_PriceHalt.Fields().ByName("halted_rate")
+	fd_PriceHalt_rejected_rate = md_PriceHalt.Fields().ByName("rejected_rate")
+	fd_PriceHalt_block_num = md_PriceHalt.Fields().ByName("block_num")
+	fd_PriceHalt_reason = md_PriceHalt.Fields().ByName("reason")
+}
 
-	VotePeriod               uint64        `protobuf:"varint,1,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty"`
-	VoteThreshold            string        `protobuf:"bytes,2,opt,name=vote_threshold,json=voteThreshold,proto3" json:"vote_threshold,omitempty"`
-	RewardBands              []*RewardBand `protobuf:"bytes,3,rep,name=reward_bands,json=rewardBands,proto3" json:"reward_bands,omitempty"`
-	RewardDistributionWindow uint64        `protobuf:"varint,4,opt,name=reward_distribution_window,json=rewardDistributionWindow,proto3" json:"reward_distribution_window,omitempty"`
-	AcceptList               []*Denom      `protobuf:"bytes,5,rep,name=accept_list,json=acceptList,proto3" json:"accept_list,omitempty"`
-	SlashFraction            string        `protobuf:"bytes,6,opt,name=slash_fraction,json=slashFraction,proto3" json:"slash_fraction,omitempty"`
-	SlashWindow              uint64        `protobuf:"varint,7,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty"`
-	MinValidPerWindow        string        `protobuf:"bytes,8,opt,name=min_valid_per_window,json=minValidPerWindow,proto3" json:"min_valid_per_window,omitempty"`
-	MandatoryList            []*Denom      `protobuf:"bytes,9,rep,name=mandatory_list,json=mandatoryList,proto3" json:"mandatory_list,omitempty"`
-	// Historic Stamp Period represents the amount of blocks the oracle
-	// module waits before recording a new historic price.
-	HistoricStampPeriod uint64 `protobuf:"varint,10,opt,name=historic_stamp_period,json=historicStampPeriod,proto3" json:"historic_stamp_period,omitempty"`
-	// Median Stamp Period represents the amount blocks the oracle module
-	// waits between calculating and stamping a new median and standard
-	// deviation of that median.
-	MedianStampPeriod uint64 `protobuf:"varint,11,opt,name=median_stamp_period,json=medianStampPeriod,proto3" json:"median_stamp_period,omitempty"`
-	// Maximum Price Stamps represents the maximum amount of historic prices
-	// the oracle module will store before pruning via FIFO.
-	MaximumPriceStamps uint64 `protobuf:"varint,12,opt,name=maximum_price_stamps,json=maximumPriceStamps,proto3" json:"maximum_price_stamps,omitempty"`
-	// Maximum Median Stamps represents the maximum amount of medians the
-	// oracle module will store before pruning via FIFO.
-	MaximumMedianStamps uint64 `protobuf:"varint,13,opt,name=maximum_median_stamps,json=maximumMedianStamps,proto3" json:"maximum_median_stamps,omitempty"`
-	// Currency Pair Providers defines the currency provider pairs the
-	// price feeder uses upon start up for pricing data.
-	CurrencyPairProviders []*CurrencyPairProviders `protobuf:"bytes,14,rep,name=currency_pair_providers,json=currencyPairProviders,proto3" json:"currency_pair_providers,omitempty"`
-	// Currency Deviation Thresholds defines the deviation thresholds
-	// for each base currency the price feeder uses upon start up.
-	CurrencyDeviationThresholds []*CurrencyDeviationThreshold `protobuf:"bytes,15,rep,name=currency_deviation_thresholds,json=currencyDeviationThresholds,proto3" json:"currency_deviation_thresholds,omitempty"`
-	// usdc ibc denom
-	UsdcIbcDenom    string `protobuf:"bytes,16,opt,name=usdc_ibc_denom,json=usdcIbcDenom,proto3" json:"usdc_ibc_denom,omitempty"`
-	SlashingEnabled bool   `protobuf:"varint,17,opt,name=slashing_enabled,json=slashingEnabled,proto3" json:"slashing_enabled,omitempty"`
-	AveragingWindow uint64 `protobuf:"varint,18,opt,name=averaging_window,json=averagingWindow,proto3" json:"averaging_window,omitempty"`
+var _ protoreflect.Message = (*fastReflection_PriceHalt)(nil)
+
+type fastReflection_PriceHalt PriceHalt
+
+func (x *PriceHalt) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceHalt)(x)
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_v1_oracle_proto_msgTypes[0]
+func (x *PriceHalt) slowProtoReflect() protoreflect.Message {
+	mi := &file_oracle_v1_oracle_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_PriceHalt_messageType fastReflection_PriceHalt_messageType
+var _ protoreflect.MessageType = fastReflection_PriceHalt_messageType{}
 
-func (*Params) ProtoMessage() {}
+type fastReflection_PriceHalt_messageType struct{}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_PriceHalt_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceHalt)(nil)
 }
-
-func (x *Params) GetVotePeriod() uint64 {
-	if x != nil {
-		return x.VotePeriod
-	}
-	return 0
+func (x fastReflection_PriceHalt_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceHalt)
 }
-
-func (x *Params) GetVoteThreshold() string {
-	if x != nil {
-		return x.VoteThreshold
-	}
-	return ""
+func (x fastReflection_PriceHalt_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceHalt
 }
 
-func (x *Params) GetRewardBands() []*RewardBand {
-	if x != nil {
-		return x.RewardBands
-	}
-	return nil
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PriceHalt) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceHalt
 }
 
-func (x *Params) GetRewardDistributionWindow() uint64 {
-	if x != nil {
-		return x.RewardDistributionWindow
-	}
-	return 0
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PriceHalt) Type() protoreflect.MessageType {
+	return _fastReflection_PriceHalt_messageType
 }
 
-func (x *Params) GetAcceptList() []*Denom {
-	if x != nil {
-		return x.AcceptList
-	}
-	return nil
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PriceHalt) New() protoreflect.Message {
+	return new(fastReflection_PriceHalt)
 }
 
-func (x *Params) GetSlashFraction() string {
-	if x != nil {
-		return x.SlashFraction
-	}
-	return ""
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PriceHalt) Interface() protoreflect.ProtoMessage {
+	return (*PriceHalt)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PriceHalt) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_PriceHalt_denom, value) {
+			return
+		}
+	}
+	if x.HaltedRate != "" {
+		value := protoreflect.ValueOfString(x.HaltedRate)
+		if !f(fd_PriceHalt_halted_rate, value) {
+			return
+		}
+	}
+	if x.RejectedRate != "" {
+		value := protoreflect.ValueOfString(x.RejectedRate)
+		if !f(fd_PriceHalt_rejected_rate, value) {
+			return
+		}
+	}
+	if x.BlockNum != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockNum)
+		if !f(fd_PriceHalt_block_num, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_PriceHalt_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PriceHalt) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "oracle.v1.PriceHalt.denom":
+		return x.Denom != ""
+	case "oracle.v1.PriceHalt.halted_rate":
+		return x.HaltedRate != ""
+	case "oracle.v1.PriceHalt.rejected_rate":
+		return x.RejectedRate != ""
+	case "oracle.v1.PriceHalt.block_num":
+		return x.BlockNum != uint64(0)
+	case "oracle.v1.PriceHalt.reason":
+		return x.Reason != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PriceHalt"))
+		}
+		panic(fmt.Errorf("message oracle.v1.PriceHalt does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceHalt) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "oracle.v1.PriceHalt.denom":
+		x.Denom = ""
+	case "oracle.v1.PriceHalt.halted_rate":
+		x.HaltedRate = ""
+	case "oracle.v1.PriceHalt.rejected_rate":
+		x.RejectedRate = ""
+	case "oracle.v1.PriceHalt.block_num":
+		x.BlockNum = uint64(0)
+	case "oracle.v1.PriceHalt.reason":
+		x.Reason = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PriceHalt"))
+		}
+		panic(fmt.Errorf("message oracle.v1.PriceHalt does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PriceHalt) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "oracle.v1.PriceHalt.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "oracle.v1.PriceHalt.halted_rate":
+		value := x.HaltedRate
+		return protoreflect.ValueOfString(value)
+	case "oracle.v1.PriceHalt.rejected_rate":
+		value := x.RejectedRate
+		return protoreflect.ValueOfString(value)
+	case "oracle.v1.PriceHalt.block_num":
+		value := x.BlockNum
+		return protoreflect.ValueOfUint64(value)
+	case "oracle.v1.PriceHalt.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PriceHalt"))
+		}
+		panic(fmt.Errorf("message oracle.v1.PriceHalt does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceHalt) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "oracle.v1.PriceHalt.denom":
+		x.Denom = value.Interface().(string)
+	case "oracle.v1.PriceHalt.halted_rate":
+		x.HaltedRate = value.Interface().(string)
+	case "oracle.v1.PriceHalt.rejected_rate":
+		x.RejectedRate = value.Interface().(string)
+	case "oracle.v1.PriceHalt.block_num":
+		x.BlockNum = value.Uint()
+	case "oracle.v1.PriceHalt.reason":
+		x.Reason = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PriceHalt"))
+		}
+		panic(fmt.Errorf("message oracle.v1.PriceHalt does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceHalt) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "oracle.v1.PriceHalt.denom":
+		panic(fmt.Errorf("field denom of message oracle.v1.PriceHalt is not mutable"))
+	case "oracle.v1.PriceHalt.halted_rate":
+		panic(fmt.Errorf("field halted_rate of message oracle.v1.PriceHalt is not mutable"))
+	case "oracle.v1.PriceHalt.rejected_rate":
+		panic(fmt.Errorf("field rejected_rate of message oracle.v1.PriceHalt is not mutable"))
+	case "oracle.v1.PriceHalt.block_num":
+		panic(fmt.Errorf("field block_num of message oracle.v1.PriceHalt is not mutable"))
+	case "oracle.v1.PriceHalt.reason":
+		panic(fmt.Errorf("field reason of message oracle.v1.PriceHalt is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PriceHalt"))
+		}
+		panic(fmt.Errorf("message oracle.v1.PriceHalt does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PriceHalt) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "oracle.v1.PriceHalt.denom":
+		return protoreflect.ValueOfString("")
+	case "oracle.v1.PriceHalt.halted_rate":
+		return protoreflect.ValueOfString("")
+	case "oracle.v1.PriceHalt.rejected_rate":
+		return protoreflect.ValueOfString("")
+	case "oracle.v1.PriceHalt.block_num":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "oracle.v1.PriceHalt.reason":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PriceHalt"))
+		}
+		panic(fmt.Errorf("message oracle.v1.PriceHalt does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PriceHalt) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.PriceHalt", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PriceHalt) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceHalt) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PriceHalt) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PriceHalt) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PriceHalt)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.HaltedRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RejectedRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockNum != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNum))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PriceHalt)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.BlockNum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNum))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.RejectedRate) > 0 {
+			i -= len(x.RejectedRate)
+			copy(dAtA[i:], x.RejectedRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RejectedRate)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.HaltedRate) > 0 {
+			i -= len(x.HaltedRate)
+			copy(dAtA[i:], x.HaltedRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HaltedRate)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PriceHalt)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceHalt: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceHalt: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HaltedRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HaltedRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RejectedRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RejectedRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNum", wireType)
+				}
+				x.BlockNum = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockNum |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Candle             protoreflect.MessageDescriptor
+	fd_Candle_denom       protoreflect.FieldDescriptor
+	fd_Candle_interval    protoreflect.FieldDescriptor
+	fd_Candle_start_block protoreflect.FieldDescriptor
+	fd_Candle_open        protoreflect.FieldDescriptor
+	fd_Candle_high        protoreflect.FieldDescriptor
+	fd_Candle_low         protoreflect.FieldDescriptor
+	fd_Candle_close       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_oracle_v1_oracle_proto_init()
+	md_Candle = File_oracle_v1_oracle_proto.Messages().ByName("Candle")
+	fd_Candle_denom = md_Candle.Fields().ByName("denom")
+	fd_Candle_interval = md_Candle.Fields().ByName("interval")
+	fd_Candle_start_block = md_Candle.Fields().ByName("start_block")
+	fd_Candle_open = md_Candle.Fields().ByName("open")
+	fd_Candle_high = md_Candle.Fields().ByName("high")
+	fd_Candle_low = md_Candle.Fields().ByName("low")
+	fd_Candle_close = md_Candle.Fields().ByName("close")
+}
+
+var _ protoreflect.Message = (*fastReflection_Candle)(nil)
+
+type fastReflection_Candle Candle
+
+func (x *Candle) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Candle)(x)
+}
+
+func (x *Candle) slowProtoReflect() protoreflect.Message {
+	mi := &file_oracle_v1_oracle_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Candle_messageType fastReflection_Candle_messageType
+var _ protoreflect.MessageType = fastReflection_Candle_messageType{}
+
+type fastReflection_Candle_messageType struct{}
+
+func (x fastReflection_Candle_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Candle)(nil)
+}
+func (x fastReflection_Candle_messageType) New() protoreflect.Message {
+	return new(fastReflection_Candle)
+}
+func (x fastReflection_Candle_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Candle
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Candle) Descriptor() protoreflect.MessageDescriptor {
+	return md_Candle
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Candle) Type() protoreflect.MessageType {
+	return _fastReflection_Candle_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Candle) New() protoreflect.Message {
+	return new(fastReflection_Candle)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Candle) Interface() protoreflect.ProtoMessage {
+	return (*Candle)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Candle) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_Candle_denom, value) {
+			return
+		}
+	}
+	if x.Interval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Interval)
+		if !f(fd_Candle_interval, value) {
+			return
+		}
+	}
+	if x.StartBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StartBlock)
+		if !f(fd_Candle_start_block, value) {
+			return
+		}
+	}
+	if x.Open != "" {
+		value := protoreflect.ValueOfString(x.Open)
+		if !f(fd_Candle_open, value) {
+			return
+		}
+	}
+	if x.High != "" {
+		value := protoreflect.ValueOfString(x.High)
+		if !f(fd_Candle_high, value) {
+			return
+		}
+	}
+	if x.Low != "" {
+		value := protoreflect.ValueOfString(x.Low)
+		if !f(fd_Candle_low, value) {
+			return
+		}
+	}
+	if x.Close != "" {
+		value := protoreflect.ValueOfString(x.Close)
+		if !f(fd_Candle_close, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Candle) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "oracle.v1.Candle.denom":
+		return x.Denom != ""
+	case "oracle.v1.Candle.interval":
+		return x.Interval != uint64(0)
+	case "oracle.v1.Candle.start_block":
+		return x.StartBlock != uint64(0)
+	case "oracle.v1.Candle.open":
+		return x.Open != ""
+	case "oracle.v1.Candle.high":
+		return x.High != ""
+	case "oracle.v1.Candle.low":
+		return x.Low != ""
+	case "oracle.v1.Candle.close":
+		return x.Close != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.Candle"))
+		}
+		panic(fmt.Errorf("message oracle.v1.Candle does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Candle) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "oracle.v1.Candle.denom":
+		x.Denom = ""
+	case "oracle.v1.Candle.interval":
+		x.Interval = uint64(0)
+	case "oracle.v1.Candle.start_block":
+		x.StartBlock = uint64(0)
+	case "oracle.v1.Candle.open":
+		x.Open = ""
+	case "oracle.v1.Candle.high":
+		x.High = ""
+	case "oracle.v1.Candle.low":
+		x.Low = ""
+	case "oracle.v1.Candle.close":
+		x.Close = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.Candle"))
+		}
+		panic(fmt.Errorf("message oracle.v1.Candle does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Candle) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "oracle.v1.Candle.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "oracle.v1.Candle.interval":
+		value := x.Interval
+		return protoreflect.ValueOfUint64(value)
+	case "oracle.v1.Candle.start_block":
+		value := x.StartBlock
+		return protoreflect.ValueOfUint64(value)
+	case "oracle.v1.Candle.open":
+		value := x.Open
+		return protoreflect.ValueOfString(value)
+	case "oracle.v1.Candle.high":
+		value := x.High
+		return protoreflect.ValueOfString(value)
+	case "oracle.v1.Candle.low":
+		value := x.Low
+		return protoreflect.ValueOfString(value)
+	case "oracle.v1.Candle.close":
+		value := x.Close
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.Candle"))
+		}
+		panic(fmt.Errorf("message oracle.v1.Candle does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Candle) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "oracle.v1.Candle.denom":
+		x.Denom = value.Interface().(string)
+	case "oracle.v1.Candle.interval":
+		x.Interval = value.Uint()
+	case "oracle.v1.Candle.start_block":
+		x.StartBlock = value.Uint()
+	case "oracle.v1.Candle.open":
+		x.Open = value.Interface().(string)
+	case "oracle.v1.Candle.high":
+		x.High = value.Interface().(string)
+	case "oracle.v1.Candle.low":
+		x.Low = value.Interface().(string)
+	case "oracle.v1.Candle.close":
+		x.Close = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.Candle"))
+		}
+		panic(fmt.Errorf("message oracle.v1.Candle does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Candle) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "oracle.v1.Candle.denom":
+		panic(fmt.Errorf("field denom of message oracle.v1.Candle is not mutable"))
+	case "oracle.v1.Candle.interval":
+		panic(fmt.Errorf("field interval of message oracle.v1.Candle is not mutable"))
+	case "oracle.v1.Candle.start_block":
+		panic(fmt.Errorf("field start_block of message oracle.v1.Candle is not mutable"))
+	case "oracle.v1.Candle.open":
+		panic(fmt.Errorf("field open of message oracle.v1.Candle is not mutable"))
+	case "oracle.v1.Candle.high":
+		panic(fmt.Errorf("field high of message oracle.v1.Candle is not mutable"))
+	case "oracle.v1.Candle.low":
+		panic(fmt.Errorf("field low of message oracle.v1.Candle is not mutable"))
+	case "oracle.v1.Candle.close":
+		panic(fmt.Errorf("field close of message oracle.v1.Candle is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.Candle"))
+		}
+		panic(fmt.Errorf("message oracle.v1.Candle does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Candle) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "oracle.v1.Candle.denom":
+		return protoreflect.ValueOfString("")
+	case "oracle.v1.Candle.interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "oracle.v1.Candle.start_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "oracle.v1.Candle.open":
+		return protoreflect.ValueOfString("")
+	case "oracle.v1.Candle.high":
+		return protoreflect.ValueOfString("")
+	case "oracle.v1.Candle.low":
+		return protoreflect.ValueOfString("")
+	case "oracle.v1.Candle.close":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.Candle"))
+		}
+		panic(fmt.Errorf("message oracle.v1.Candle does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Candle) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.Candle", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Candle) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Candle) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Candle) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Candle) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Candle)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Interval != 0 {
+			n += 1 + runtime.Sov(uint64(x.Interval))
+		}
+		if x.StartBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartBlock))
+		}
+		l = len(x.Open)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.High)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Low)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Close)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Candle)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Close) > 0 {
+			i -= len(x.Close)
+			copy(dAtA[i:], x.Close)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Close)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Low) > 0 {
+			i -= len(x.Low)
+			copy(dAtA[i:], x.Low)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Low)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.High) > 0 {
+			i -= len(x.High)
+			copy(dAtA[i:], x.High)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.High)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Open) > 0 {
+			i -= len(x.Open)
+			copy(dAtA[i:], x.Open)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Open)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.StartBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartBlock))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Interval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Interval))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Candle)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Candle: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Candle: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				}
+				x.Interval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Interval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+				}
+				x.StartBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Open = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.High = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Low = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Close = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_PriceStatistics_9_list)(nil)
+
+type _PriceStatistics_9_list struct {
+	list *[]*PricePercentile
+}
+
+func (x *_PriceStatistics_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PriceStatistics_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PriceStatistics_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PricePercentile)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PriceStatistics_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PricePercentile)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PriceStatistics_9_list) AppendMutable() protoreflect.Value {
+	v := new(PricePercentile)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PriceStatistics_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PriceStatistics_9_list) NewElement() protoreflect.Value {
+	v := new(PricePercentile)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PriceStatistics_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PriceStatistics                     protoreflect.MessageDescriptor
+	fd_PriceStatistics_denom               protoreflect.FieldDescriptor
+	fd_PriceStatistics_num_stamps          protoreflect.FieldDescriptor
+	fd_PriceStatistics_min                 protoreflect.FieldDescriptor
+	fd_PriceStatistics_max                 protoreflect.FieldDescriptor
+	fd_PriceStatistics_mean                protoreflect.FieldDescriptor
+	fd_PriceStatistics_median              protoreflect.FieldDescriptor
+	fd_PriceStatistics_standard_deviation  protoreflect.FieldDescriptor
+	fd_PriceStatistics_realized_volatility protoreflect.FieldDescriptor
+	fd_PriceStatistics_percentiles         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_oracle_v1_oracle_proto_init()
+	md_PriceStatistics = File_oracle_v1_oracle_proto.Messages().ByName("PriceStatistics")
+	fd_PriceStatistics_denom = md_PriceStatistics.Fields().ByName("denom")
+	fd_PriceStatistics_num_stamps = md_PriceStatistics.Fields().ByName("num_stamps")
+	fd_PriceStatistics_min = md_PriceStatistics.Fields().ByName("min")
+	fd_PriceStatistics_max = md_PriceStatistics.Fields().ByName("max")
+	fd_PriceStatistics_mean = md_PriceStatistics.Fields().ByName("mean")
+	fd_PriceStatistics_median = md_PriceStatistics.Fields().ByName("median")
+	fd_PriceStatistics_standard_deviation = md_PriceStatistics.Fields().ByName("standard_deviation")
+	fd_PriceStatistics_realized_volatility = md_PriceStatistics.Fields().ByName("realized_volatility")
+	fd_PriceStatistics_percentiles = md_PriceStatistics.Fields().ByName("percentiles")
+}
+
+var _ protoreflect.Message = (*fastReflection_PriceStatistics)(nil)
+
+type fastReflection_PriceStatistics PriceStatistics
+
+func (x *PriceStatistics) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceStatistics)(x)
+}
+
+func (x *PriceStatistics) slowProtoReflect() protoreflect.Message {
+	mi := &file_oracle_v1_oracle_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PriceStatistics_messageType fastReflection_PriceStatistics_messageType
+var _ protoreflect.MessageType = fastReflection_PriceStatistics_messageType{}
+
+type fastReflection_PriceStatistics_messageType struct{}
+
+func (x fastReflection_PriceStatistics_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceStatistics)(nil)
+}
+func (x fastReflection_PriceStatistics_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceStatistics)
+}
+func (x fastReflection_PriceStatistics_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceStatistics
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PriceStatistics) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceStatistics
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PriceStatistics) Type() protoreflect.MessageType {
+	return _fastReflection_PriceStatistics_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PriceStatistics) New() protoreflect.Message {
+	return new(fastReflection_PriceStatistics)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PriceStatistics) Interface() protoreflect.ProtoMessage {
+	return (*PriceStatistics)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PriceStatistics) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_PriceStatistics_denom, value) {
+			return
+		}
+	}
+	if x.NumStamps != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumStamps)
+		if !f(fd_PriceStatistics_num_stamps, value) {
+			return
+		}
+	}
+	if x.Min != "" {
+		value := protoreflect.ValueOfString(x.Min)
+		if !f(fd_PriceStatistics_min, value) {
+			return
+		}
+	}
+	if x.Max != "" {
+		value := protoreflect.ValueOfString(x.Max)
+		if !f(fd_PriceStatistics_max, value) {
+			return
+		}
+	}
+	if x.Mean != "" {
+		value := protoreflect.ValueOfString(x.Mean)
+		if !f(fd_PriceStatistics_mean, value) {
+			return
+		}
+	}
+	if x.Median != "" {
+		value := protoreflect.ValueOfString(x.Median)
+		if !f(fd_PriceStatistics_median, value) {
+			return
+		}
+	}
+	if x.StandardDeviation != "" {
+		value := protoreflect.ValueOfString(x.StandardDeviation)
+		if !f(fd_PriceStatistics_standard_deviation, value) {
+			return
+		}
+	}
+	if x.RealizedVolatility != "" {
+		value := protoreflect.ValueOfString(x.RealizedVolatility)
+		if !f(fd_PriceStatistics_realized_volatility, value) {
+			return
+		}
+	}
+	if len(x.Percentiles) != 0 {
+		value := protoreflect.ValueOfList(&_PriceStatistics_9_list{list: &x.Percentiles})
+		if !f(fd_PriceStatistics_percentiles, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PriceStatistics) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "oracle.v1.PriceStatistics.denom":
+		return x.Denom != ""
+	case "oracle.v1.PriceStatistics.num_stamps":
+		return x.NumStamps != uint64(0)
+	case "oracle.v1.PriceStatistics.min":
+		return x.Min != ""
+	case "oracle.v1.PriceStatistics.max":
+		return x.Max != ""
+	case "oracle.v1.PriceStatistics.mean":
+		return x.Mean != ""
+	case "oracle.v1.PriceStatistics.median":
+		return x.Median != ""
+	case "oracle.v1.PriceStatistics.standard_deviation":
+		return x.StandardDeviation != ""
+	case "oracle.v1.PriceStatistics.realized_volatility":
+		return x.RealizedVolatility != ""
+	case "oracle.v1.PriceStatistics.percentiles":
+		return len(x.Percentiles) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PriceStatistics"))
+		}
+		panic(fmt.Errorf("message oracle.v1.PriceStatistics does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceStatistics) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "oracle.v1.PriceStatistics.denom":
+		x.Denom = ""
+	case "oracle.v1.PriceStatistics.num_stamps":
+		x.NumStamps = uint64(0)
+	case "oracle.v1.PriceStatistics.min":
+		x.Min = ""
+	case "oracle.v1.PriceStatistics.max":
+		x.Max = ""
+	case "oracle.v1.PriceStatistics.mean":
+		x.Mean = ""
+	case "oracle.v1.PriceStatistics.median":
+		x.Median = ""
+	case "oracle.v1.PriceStatistics.standard_deviation":
+		x.StandardDeviation = ""
+	case "oracle.v1.PriceStatistics.realized_volatility":
+		x.RealizedVolatility = ""
+	case "oracle.v1.PriceStatistics.percentiles":
+		x.Percentiles = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PriceStatistics"))
+		}
+		panic(fmt.Errorf("message oracle.v1.PriceStatistics does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PriceStatistics) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "oracle.v1.PriceStatistics.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "oracle.v1.PriceStatistics.num_stamps":
+		value := x.NumStamps
+		return protoreflect.ValueOfUint64(value)
+	case "oracle.v1.PriceStatistics.min":
+		value := x.Min
+		return protoreflect.ValueOfString(value)
+	case "oracle.v1.PriceStatistics.max":
+		value := x.Max
+		return protoreflect.ValueOfString(value)
+	case "oracle.v1.PriceStatistics.mean":
+		value := x.Mean
+		return protoreflect.ValueOfString(value)
+	case "oracle.v1.PriceStatistics.median":
+		value := x.Median
+		return protoreflect.ValueOfString(value)
+	case "oracle.v1.PriceStatistics.standard_deviation":
+		value := x.StandardDeviation
+		return protoreflect.ValueOfString(value)
+	case "oracle.v1.PriceStatistics.realized_volatility":
+		value := x.RealizedVolatility
+		return protoreflect.ValueOfString(value)
+	case "oracle.v1.PriceStatistics.percentiles":
+		if len(x.Percentiles) == 0 {
+			return protoreflect.ValueOfList(&_PriceStatistics_9_list{})
+		}
+		listValue := &_PriceStatistics_9_list{list: &x.Percentiles}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PriceStatistics"))
+		}
+		panic(fmt.Errorf("message oracle.v1.PriceStatistics does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceStatistics) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "oracle.v1.PriceStatistics.denom":
+		x.Denom = value.Interface().(string)
+	case "oracle.v1.PriceStatistics.num_stamps":
+		x.NumStamps = value.Uint()
+	case "oracle.v1.PriceStatistics.min":
+		x.Min = value.Interface().(string)
+	case "oracle.v1.PriceStatistics.max":
+		x.Max = value.Interface().(string)
+	case "oracle.v1.PriceStatistics.mean":
+		x.Mean = value.Interface().(string)
+	case "oracle.v1.PriceStatistics.median":
+		x.Median = value.Interface().(string)
+	case "oracle.v1.PriceStatistics.standard_deviation":
+		x.StandardDeviation = value.Interface().(string)
+	case "oracle.v1.PriceStatistics.realized_volatility":
+		x.RealizedVolatility = value.Interface().(string)
+	case "oracle.v1.PriceStatistics.percentiles":
+		lv := value.List()
+		clv := lv.(*_PriceStatistics_9_list)
+		x.Percentiles = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PriceStatistics"))
+		}
+		panic(fmt.Errorf("message oracle.v1.PriceStatistics does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceStatistics) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "oracle.v1.PriceStatistics.percentiles":
+		if x.Percentiles == nil {
+			x.Percentiles = []*PricePercentile{}
+		}
+		value := &_PriceStatistics_9_list{list: &x.Percentiles}
+		return protoreflect.ValueOfList(value)
+	case "oracle.v1.PriceStatistics.denom":
+		panic(fmt.Errorf("field denom of message oracle.v1.PriceStatistics is not mutable"))
+	case "oracle.v1.PriceStatistics.num_stamps":
+		panic(fmt.Errorf("field num_stamps of message oracle.v1.PriceStatistics is not mutable"))
+	case "oracle.v1.PriceStatistics.min":
+		panic(fmt.Errorf("field min of message oracle.v1.PriceStatistics is not mutable"))
+	case "oracle.v1.PriceStatistics.max":
+		panic(fmt.Errorf("field max of message oracle.v1.PriceStatistics is not mutable"))
+	case "oracle.v1.PriceStatistics.mean":
+		panic(fmt.Errorf("field mean of message oracle.v1.PriceStatistics is not mutable"))
+	case "oracle.v1.PriceStatistics.median":
+		panic(fmt.Errorf("field median of message oracle.v1.PriceStatistics is not mutable"))
+	case "oracle.v1.PriceStatistics.standard_deviation":
+		panic(fmt.Errorf("field standard_deviation of message oracle.v1.PriceStatistics is not mutable"))
+	case "oracle.v1.PriceStatistics.realized_volatility":
+		panic(fmt.Errorf("field realized_volatility of message oracle.v1.PriceStatistics is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PriceStatistics"))
+		}
+		panic(fmt.Errorf("message oracle.v1.PriceStatistics does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PriceStatistics) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "oracle.v1.PriceStatistics.denom":
+		return protoreflect.ValueOfString("")
+	case "oracle.v1.PriceStatistics.num_stamps":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "oracle.v1.PriceStatistics.min":
+		return protoreflect.ValueOfString("")
+	case "oracle.v1.PriceStatistics.max":
+		return protoreflect.ValueOfString("")
+	case "oracle.v1.PriceStatistics.mean":
+		return protoreflect.ValueOfString("")
+	case "oracle.v1.PriceStatistics.median":
+		return protoreflect.ValueOfString("")
+	case "oracle.v1.PriceStatistics.standard_deviation":
+		return protoreflect.ValueOfString("")
+	case "oracle.v1.PriceStatistics.realized_volatility":
+		return protoreflect.ValueOfString("")
+	case "oracle.v1.PriceStatistics.percentiles":
+		list := []*PricePercentile{}
+		return protoreflect.ValueOfList(&_PriceStatistics_9_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PriceStatistics"))
+		}
+		panic(fmt.Errorf("message oracle.v1.PriceStatistics does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PriceStatistics) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.PriceStatistics", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PriceStatistics) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceStatistics) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PriceStatistics) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PriceStatistics) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PriceStatistics)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NumStamps != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumStamps))
+		}
+		l = len(x.Min)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Max)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Mean)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Median)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.StandardDeviation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RealizedVolatility)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Percentiles) > 0 {
+			for _, e := range x.Percentiles {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PriceStatistics)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Percentiles) > 0 {
+			for iNdEx := len(x.Percentiles) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Percentiles[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.RealizedVolatility) > 0 {
+			i -= len(x.RealizedVolatility)
+			copy(dAtA[i:], x.RealizedVolatility)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RealizedVolatility)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.StandardDeviation) > 0 {
+			i -= len(x.StandardDeviation)
+			copy(dAtA[i:], x.StandardDeviation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StandardDeviation)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Median) > 0 {
+			i -= len(x.Median)
+			copy(dAtA[i:], x.Median)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Median)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Mean) > 0 {
+			i -= len(x.Mean)
+			copy(dAtA[i:], x.Mean)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Mean)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Max) > 0 {
+			i -= len(x.Max)
+			copy(dAtA[i:], x.Max)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Max)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Min) > 0 {
+			i -= len(x.Min)
+			copy(dAtA[i:], x.Min)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Min)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.NumStamps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumStamps))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PriceStatistics)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceStatistics: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumStamps", wireType)
+				}
+				x.NumStamps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumStamps |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Min = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Max = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Mean = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Median", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Median = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StandardDeviation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StandardDeviation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RealizedVolatility", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RealizedVolatility = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Percentiles", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Percentiles = append(x.Percentiles, &PricePercentile{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Percentiles[len(x.Percentiles)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PricePercentile            protoreflect.MessageDescriptor
+	fd_PricePercentile_percentile protoreflect.FieldDescriptor
+	fd_PricePercentile_value      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_oracle_v1_oracle_proto_init()
+	md_PricePercentile = File_oracle_v1_oracle_proto.Messages().ByName("PricePercentile")
+	fd_PricePercentile_percentile = md_PricePercentile.Fields().ByName("percentile")
+	fd_PricePercentile_value = md_PricePercentile.Fields().ByName("value")
+}
+
+var _ protoreflect.Message = (*fastReflection_PricePercentile)(nil)
+
+type fastReflection_PricePercentile PricePercentile
+
+func (x *PricePercentile) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PricePercentile)(x)
+}
+
+func (x *PricePercentile) slowProtoReflect() protoreflect.Message {
+	mi := &file_oracle_v1_oracle_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PricePercentile_messageType fastReflection_PricePercentile_messageType
+var _ protoreflect.MessageType = fastReflection_PricePercentile_messageType{}
+
+type fastReflection_PricePercentile_messageType struct{}
+
+func (x fastReflection_PricePercentile_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PricePercentile)(nil)
+}
+func (x fastReflection_PricePercentile_messageType) New() protoreflect.Message {
+	return new(fastReflection_PricePercentile)
+}
+func (x fastReflection_PricePercentile_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PricePercentile
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PricePercentile) Descriptor() protoreflect.MessageDescriptor {
+	return md_PricePercentile
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PricePercentile) Type() protoreflect.MessageType {
+	return _fastReflection_PricePercentile_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PricePercentile) New() protoreflect.Message {
+	return new(fastReflection_PricePercentile)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PricePercentile) Interface() protoreflect.ProtoMessage {
+	return (*PricePercentile)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PricePercentile) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Percentile != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Percentile)
+		if !f(fd_PricePercentile_percentile, value) {
+			return
+		}
+	}
+	if x.Value != "" {
+		value := protoreflect.ValueOfString(x.Value)
+		if !f(fd_PricePercentile_value, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PricePercentile) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "oracle.v1.PricePercentile.percentile":
+		return x.Percentile != uint32(0)
+	case "oracle.v1.PricePercentile.value":
+		return x.Value != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PricePercentile"))
+		}
+		panic(fmt.Errorf("message oracle.v1.PricePercentile does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PricePercentile) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "oracle.v1.PricePercentile.percentile":
+		x.Percentile = uint32(0)
+	case "oracle.v1.PricePercentile.value":
+		x.Value = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PricePercentile"))
+		}
+		panic(fmt.Errorf("message oracle.v1.PricePercentile does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PricePercentile) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "oracle.v1.PricePercentile.percentile":
+		value := x.Percentile
+		return protoreflect.ValueOfUint32(value)
+	case "oracle.v1.PricePercentile.value":
+		value := x.Value
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PricePercentile"))
+		}
+		panic(fmt.Errorf("message oracle.v1.PricePercentile does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PricePercentile) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "oracle.v1.PricePercentile.percentile":
+		x.Percentile = uint32(value.Uint())
+	case "oracle.v1.PricePercentile.value":
+		x.Value = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PricePercentile"))
+		}
+		panic(fmt.Errorf("message oracle.v1.PricePercentile does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PricePercentile) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "oracle.v1.PricePercentile.percentile":
+		panic(fmt.Errorf("field percentile of message oracle.v1.PricePercentile is not mutable"))
+	case "oracle.v1.PricePercentile.value":
+		panic(fmt.Errorf("field value of message oracle.v1.PricePercentile is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PricePercentile"))
+		}
+		panic(fmt.Errorf("message oracle.v1.PricePercentile does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PricePercentile) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "oracle.v1.PricePercentile.percentile":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "oracle.v1.PricePercentile.value":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PricePercentile"))
+		}
+		panic(fmt.Errorf("message oracle.v1.PricePercentile does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PricePercentile) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.PricePercentile", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PricePercentile) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PricePercentile) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PricePercentile) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PricePercentile) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PricePercentile)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Percentile != 0 {
+			n += 1 + runtime.Sov(uint64(x.Percentile))
+		}
+		l = len(x.Value)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PricePercentile)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Value) > 0 {
+			i -= len(x.Value)
+			copy(dAtA[i:], x.Value)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Percentile != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Percentile))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PricePercentile)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PricePercentile: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PricePercentile: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Percentile", wireType)
+				}
+				x.Percentile = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Percentile |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Value = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ValidatorRewardSet_1_list)(nil)
+
+type _ValidatorRewardSet_1_list struct {
+	list *[]string
+}
+
+func (x *_ValidatorRewardSet_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ValidatorRewardSet_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ValidatorRewardSet_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ValidatorRewardSet_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ValidatorRewardSet_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ValidatorRewardSet at list field ValidatorSet as it is not of Message kind"))
+}
+
+func (x *_ValidatorRewardSet_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ValidatorRewardSet_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ValidatorRewardSet_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ValidatorRewardSet               protoreflect.MessageDescriptor
+	fd_ValidatorRewardSet_validator_set protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_oracle_v1_oracle_proto_init()
+	md_ValidatorRewardSet = File_oracle_v1_oracle_proto.Messages().ByName("ValidatorRewardSet")
+	fd_ValidatorRewardSet_validator_set = md_ValidatorRewardSet.Fields().ByName("validator_set")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorRewardSet)(nil)
+
+type fastReflection_ValidatorRewardSet ValidatorRewardSet
+
+func (x *ValidatorRewardSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorRewardSet)(x)
+}
+
+func (x *ValidatorRewardSet) slowProtoReflect() protoreflect.Message {
+	mi := &file_oracle_v1_oracle_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorRewardSet_messageType fastReflection_ValidatorRewardSet_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorRewardSet_messageType{}
+
+type fastReflection_ValidatorRewardSet_messageType struct{}
+
+func (x fastReflection_ValidatorRewardSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorRewardSet)(nil)
+}
+func (x fastReflection_ValidatorRewardSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorRewardSet)
+}
+func (x fastReflection_ValidatorRewardSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorRewardSet
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorRewardSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorRewardSet
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorRewardSet) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorRewardSet_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorRewardSet) New() protoreflect.Message {
+	return new(fastReflection_ValidatorRewardSet)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorRewardSet) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorRewardSet)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorRewardSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ValidatorSet) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorRewardSet_1_list{list: &x.ValidatorSet})
+		if !f(fd_ValidatorRewardSet_validator_set, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorRewardSet) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "oracle.v1.ValidatorRewardSet.validator_set":
+		return len(x.ValidatorSet) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ValidatorRewardSet"))
+		}
+		panic(fmt.Errorf("message oracle.v1.ValidatorRewardSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorRewardSet) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "oracle.v1.ValidatorRewardSet.validator_set":
+		x.ValidatorSet = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ValidatorRewardSet"))
+		}
+		panic(fmt.Errorf("message oracle.v1.ValidatorRewardSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorRewardSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "oracle.v1.ValidatorRewardSet.validator_set":
+		if len(x.ValidatorSet) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorRewardSet_1_list{})
+		}
+		listValue := &_ValidatorRewardSet_1_list{list: &x.ValidatorSet}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ValidatorRewardSet"))
+		}
+		panic(fmt.Errorf("message oracle.v1.ValidatorRewardSet does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorRewardSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "oracle.v1.ValidatorRewardSet.validator_set":
+		lv := value.List()
+		clv := lv.(*_ValidatorRewardSet_1_list)
+		x.ValidatorSet = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ValidatorRewardSet"))
+		}
+		panic(fmt.Errorf("message oracle.v1.ValidatorRewardSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorRewardSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "oracle.v1.ValidatorRewardSet.validator_set":
+		if x.ValidatorSet == nil {
+			x.ValidatorSet = []string{}
+		}
+		value := &_ValidatorRewardSet_1_list{list: &x.ValidatorSet}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ValidatorRewardSet"))
+		}
+		panic(fmt.Errorf("message oracle.v1.ValidatorRewardSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorRewardSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "oracle.v1.ValidatorRewardSet.validator_set":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ValidatorRewardSet_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ValidatorRewardSet"))
+		}
+		panic(fmt.Errorf("message oracle.v1.ValidatorRewardSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorRewardSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.ValidatorRewardSet", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorRewardSet) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorRewardSet) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorRewardSet) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorRewardSet) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorRewardSet)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.ValidatorSet) > 0 {
+			for _, s := range x.ValidatorSet {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorRewardSet)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ValidatorSet) > 0 {
+			for iNdEx := len(x.ValidatorSet) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ValidatorSet[iNdEx])
+				copy(dAtA[i:], x.ValidatorSet[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorSet[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorRewardSet)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorRewardSet: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorRewardSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorSet = append(x.ValidatorSet, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_CurrencyPairProviders_3_list)(nil)
+
+type _CurrencyPairProviders_3_list struct {
+	list *[]*PairAddressProvider
+}
+
+func (x *_CurrencyPairProviders_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_CurrencyPairProviders_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_CurrencyPairProviders_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PairAddressProvider)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_CurrencyPairProviders_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PairAddressProvider)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_CurrencyPairProviders_3_list) AppendMutable() protoreflect.Value {
+	v := new(PairAddressProvider)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CurrencyPairProviders_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_CurrencyPairProviders_3_list) NewElement() protoreflect.Value {
+	v := new(PairAddressProvider)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CurrencyPairProviders_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_CurrencyPairProviders_4_list)(nil)
+
+type _CurrencyPairProviders_4_list struct {
+	list *[]string
+}
+
+func (x *_CurrencyPairProviders_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_CurrencyPairProviders_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_CurrencyPairProviders_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_CurrencyPairProviders_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_CurrencyPairProviders_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message CurrencyPairProviders at list field Providers as it is not of Message kind"))
+}
+
+func (x *_CurrencyPairProviders_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_CurrencyPairProviders_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_CurrencyPairProviders_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_CurrencyPairProviders              protoreflect.MessageDescriptor
+	fd_CurrencyPairProviders_base_denom   protoreflect.FieldDescriptor
+	fd_CurrencyPairProviders_quote_denom  protoreflect.FieldDescriptor
+	fd_CurrencyPairProviders_pair_address protoreflect.FieldDescriptor
+	fd_CurrencyPairProviders_providers    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_oracle_v1_oracle_proto_init()
+	md_CurrencyPairProviders = File_oracle_v1_oracle_proto.Messages().ByName("CurrencyPairProviders")
+	fd_CurrencyPairProviders_base_denom = md_CurrencyPairProviders.Fields().ByName("base_denom")
+	fd_CurrencyPairProviders_quote_denom = md_CurrencyPairProviders.Fields().ByName("quote_denom")
+	fd_CurrencyPairProviders_pair_address = md_CurrencyPairProviders.Fields().ByName("pair_address")
+	fd_CurrencyPairProviders_providers = md_CurrencyPairProviders.Fields().ByName("providers")
+}
+
+var _ protoreflect.Message = (*fastReflection_CurrencyPairProviders)(nil)
+
+type fastReflection_CurrencyPairProviders CurrencyPairProviders
+
+func (x *CurrencyPairProviders) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CurrencyPairProviders)(x)
+}
+
+func (x *CurrencyPairProviders) slowProtoReflect() protoreflect.Message {
+	mi := &file_oracle_v1_oracle_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CurrencyPairProviders_messageType fastReflection_CurrencyPairProviders_messageType
+var _ protoreflect.MessageType = fastReflection_CurrencyPairProviders_messageType{}
+
+type fastReflection_CurrencyPairProviders_messageType struct{}
+
+func (x fastReflection_CurrencyPairProviders_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CurrencyPairProviders)(nil)
+}
+func (x fastReflection_CurrencyPairProviders_messageType) New() protoreflect.Message {
+	return new(fastReflection_CurrencyPairProviders)
+}
+func (x fastReflection_CurrencyPairProviders_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrencyPairProviders
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CurrencyPairProviders) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrencyPairProviders
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CurrencyPairProviders) Type() protoreflect.MessageType {
+	return _fastReflection_CurrencyPairProviders_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CurrencyPairProviders) New() protoreflect.Message {
+	return new(fastReflection_CurrencyPairProviders)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CurrencyPairProviders) Interface() protoreflect.ProtoMessage {
+	return (*CurrencyPairProviders)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CurrencyPairProviders) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BaseDenom != "" {
+		value := protoreflect.ValueOfString(x.BaseDenom)
+		if !f(fd_CurrencyPairProviders_base_denom, value) {
+			return
+		}
+	}
+	if x.QuoteDenom != "" {
+		value := protoreflect.ValueOfString(x.QuoteDenom)
+		if !f(fd_CurrencyPairProviders_quote_denom, value) {
+			return
+		}
+	}
+	if len(x.PairAddress) != 0 {
+		value := protoreflect.ValueOfList(&_CurrencyPairProviders_3_list{list: &x.PairAddress})
+		if !f(fd_CurrencyPairProviders_pair_address, value) {
+			return
+		}
+	}
+	if len(x.Providers) != 0 {
+		value := protoreflect.ValueOfList(&_CurrencyPairProviders_4_list{list: &x.Providers})
+		if !f(fd_CurrencyPairProviders_providers, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CurrencyPairProviders) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "oracle.v1.CurrencyPairProviders.base_denom":
+		return x.BaseDenom != ""
+	case "oracle.v1.CurrencyPairProviders.quote_denom":
+		return x.QuoteDenom != ""
+	case "oracle.v1.CurrencyPairProviders.pair_address":
+		return len(x.PairAddress) != 0
+	case "oracle.v1.CurrencyPairProviders.providers":
+		return len(x.Providers) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.CurrencyPairProviders"))
+		}
+		panic(fmt.Errorf("message oracle.v1.CurrencyPairProviders does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairProviders) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "oracle.v1.CurrencyPairProviders.base_denom":
+		x.BaseDenom = ""
+	case "oracle.v1.CurrencyPairProviders.quote_denom":
+		x.QuoteDenom = ""
+	case "oracle.v1.CurrencyPairProviders.pair_address":
+		x.PairAddress = nil
+	case "oracle.v1.CurrencyPairProviders.providers":
+		x.Providers = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.CurrencyPairProviders"))
+		}
+		panic(fmt.Errorf("message oracle.v1.CurrencyPairProviders does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CurrencyPairProviders) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "oracle.v1.CurrencyPairProviders.base_denom":
+		value := x.BaseDenom
+		return protoreflect.ValueOfString(value)
+	case "oracle.v1.CurrencyPairProviders.quote_denom":
+		value := x.QuoteDenom
+		return protoreflect.ValueOfString(value)
+	case "oracle.v1.CurrencyPairProviders.pair_address":
+		if len(x.PairAddress) == 0 {
+			return protoreflect.ValueOfList(&_CurrencyPairProviders_3_list{})
+		}
+		listValue := &_CurrencyPairProviders_3_list{list: &x.PairAddress}
+		return protoreflect.ValueOfList(listValue)
+	case "oracle.v1.CurrencyPairProviders.providers":
+		if len(x.Providers) == 0 {
+			return protoreflect.ValueOfList(&_CurrencyPairProviders_4_list{})
+		}
+		listValue := &_CurrencyPairProviders_4_list{list: &x.Providers}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.CurrencyPairProviders"))
+		}
+		panic(fmt.Errorf("message oracle.v1.CurrencyPairProviders does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairProviders) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "oracle.v1.CurrencyPairProviders.base_denom":
+		x.BaseDenom = value.Interface().(string)
+	case "oracle.v1.CurrencyPairProviders.quote_denom":
+		x.QuoteDenom = value.Interface().(string)
+	case "oracle.v1.CurrencyPairProviders.pair_address":
+		lv := value.List()
+		clv := lv.(*_CurrencyPairProviders_3_list)
+		x.PairAddress = *clv.list
+	case "oracle.v1.CurrencyPairProviders.providers":
+		lv := value.List()
+		clv := lv.(*_CurrencyPairProviders_4_list)
+		x.Providers = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.CurrencyPairProviders"))
+		}
+		panic(fmt.Errorf("message oracle.v1.CurrencyPairProviders does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairProviders) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "oracle.v1.CurrencyPairProviders.pair_address":
+		if x.PairAddress == nil {
+			x.PairAddress = []*PairAddressProvider{}
+		}
+		value := &_CurrencyPairProviders_3_list{list: &x.PairAddress}
+		return protoreflect.ValueOfList(value)
+	case "oracle.v1.CurrencyPairProviders.providers":
+		if x.Providers == nil {
+			x.Providers = []string{}
+		}
+		value := &_CurrencyPairProviders_4_list{list: &x.Providers}
+		return protoreflect.ValueOfList(value)
+	case "oracle.v1.CurrencyPairProviders.base_denom":
+		panic(fmt.Errorf("field base_denom of message oracle.v1.CurrencyPairProviders is not mutable"))
+	case "oracle.v1.CurrencyPairProviders.quote_denom":
+		panic(fmt.Errorf("field quote_denom of message oracle.v1.CurrencyPairProviders is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.CurrencyPairProviders"))
+		}
+		panic(fmt.Errorf("message oracle.v1.CurrencyPairProviders does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CurrencyPairProviders) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "oracle.v1.CurrencyPairProviders.base_denom":
+		return protoreflect.ValueOfString("")
+	case "oracle.v1.CurrencyPairProviders.quote_denom":
+		return protoreflect.ValueOfString("")
+	case "oracle.v1.CurrencyPairProviders.pair_address":
+		list := []*PairAddressProvider{}
+		return protoreflect.ValueOfList(&_CurrencyPairProviders_3_list{list: &list})
+	case "oracle.v1.CurrencyPairProviders.providers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_CurrencyPairProviders_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.CurrencyPairProviders"))
+		}
+		panic(fmt.Errorf("message oracle.v1.CurrencyPairProviders does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CurrencyPairProviders) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.CurrencyPairProviders", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CurrencyPairProviders) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairProviders) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CurrencyPairProviders) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CurrencyPairProviders) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CurrencyPairProviders)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BaseDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.QuoteDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PairAddress) > 0 {
+			for _, e := range x.PairAddress {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Providers) > 0 {
+			for _, s := range x.Providers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CurrencyPairProviders)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Providers) > 0 {
+			for iNdEx := len(x.Providers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Providers[iNdEx])
+				copy(dAtA[i:], x.Providers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Providers[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.PairAddress) > 0 {
+			for iNdEx := len(x.PairAddress) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PairAddress[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.QuoteDenom) > 0 {
+			i -= len(x.QuoteDenom)
+			copy(dAtA[i:], x.QuoteDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.QuoteDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.BaseDenom) > 0 {
+			i -= len(x.BaseDenom)
+			copy(dAtA[i:], x.BaseDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CurrencyPairProviders)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairProviders: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairProviders: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.QuoteDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PairAddress", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PairAddress = append(x.PairAddress, &PairAddressProvider{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PairAddress[len(x.PairAddress)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Providers = append(x.Providers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PairAddressProvider                  protoreflect.MessageDescriptor
+	fd_PairAddressProvider_address          protoreflect.FieldDescriptor
+	fd_PairAddressProvider_address_provider protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_oracle_v1_oracle_proto_init()
+	md_PairAddressProvider = File_oracle_v1_oracle_proto.Messages().ByName("PairAddressProvider")
+	fd_PairAddressProvider_address = md_PairAddressProvider.Fields().ByName("address")
+	fd_PairAddressProvider_address_provider = md_PairAddressProvider.Fields().ByName("address_provider")
+}
+
+var _ protoreflect.Message = (*fastReflection_PairAddressProvider)(nil)
+
+type fastReflection_PairAddressProvider PairAddressProvider
+
+func (x *PairAddressProvider) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PairAddressProvider)(x)
+}
+
+func (x *PairAddressProvider) slowProtoReflect() protoreflect.Message {
+	mi := &file_oracle_v1_oracle_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PairAddressProvider_messageType fastReflection_PairAddressProvider_messageType
+var _ protoreflect.MessageType = fastReflection_PairAddressProvider_messageType{}
+
+type fastReflection_PairAddressProvider_messageType struct{}
+
+func (x fastReflection_PairAddressProvider_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PairAddressProvider)(nil)
+}
+func (x fastReflection_PairAddressProvider_messageType) New() protoreflect.Message {
+	return new(fastReflection_PairAddressProvider)
+}
+func (x fastReflection_PairAddressProvider_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PairAddressProvider
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PairAddressProvider) Descriptor() protoreflect.MessageDescriptor {
+	return md_PairAddressProvider
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PairAddressProvider) Type() protoreflect.MessageType {
+	return _fastReflection_PairAddressProvider_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PairAddressProvider) New() protoreflect.Message {
+	return new(fastReflection_PairAddressProvider)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PairAddressProvider) Interface() protoreflect.ProtoMessage {
+	return (*PairAddressProvider)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PairAddressProvider) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_PairAddressProvider_address, value) {
+			return
+		}
+	}
+	if x.AddressProvider != "" {
+		value := protoreflect.ValueOfString(x.AddressProvider)
+		if !f(fd_PairAddressProvider_address_provider, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PairAddressProvider) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "oracle.v1.PairAddressProvider.address":
+		return x.Address != ""
+	case "oracle.v1.PairAddressProvider.address_provider":
+		return x.AddressProvider != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PairAddressProvider"))
+		}
+		panic(fmt.Errorf("message oracle.v1.PairAddressProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairAddressProvider) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "oracle.v1.PairAddressProvider.address":
+		x.Address = ""
+	case "oracle.v1.PairAddressProvider.address_provider":
+		x.AddressProvider = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PairAddressProvider"))
+		}
+		panic(fmt.Errorf("message oracle.v1.PairAddressProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PairAddressProvider) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "oracle.v1.PairAddressProvider.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "oracle.v1.PairAddressProvider.address_provider":
+		value := x.AddressProvider
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PairAddressProvider"))
+		}
+		panic(fmt.Errorf("message oracle.v1.PairAddressProvider does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairAddressProvider) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "oracle.v1.PairAddressProvider.address":
+		x.Address = value.Interface().(string)
+	case "oracle.v1.PairAddressProvider.address_provider":
+		x.AddressProvider = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PairAddressProvider"))
+		}
+		panic(fmt.Errorf("message oracle.v1.PairAddressProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairAddressProvider) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "oracle.v1.PairAddressProvider.address":
+		panic(fmt.Errorf("field address of message oracle.v1.PairAddressProvider is not mutable"))
+	case "oracle.v1.PairAddressProvider.address_provider":
+		panic(fmt.Errorf("field address_provider of message oracle.v1.PairAddressProvider is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PairAddressProvider"))
+		}
+		panic(fmt.Errorf("message oracle.v1.PairAddressProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PairAddressProvider) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "oracle.v1.PairAddressProvider.address":
+		return protoreflect.ValueOfString("")
+	case "oracle.v1.PairAddressProvider.address_provider":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.PairAddressProvider"))
+		}
+		panic(fmt.Errorf("message oracle.v1.PairAddressProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PairAddressProvider) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.PairAddressProvider", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PairAddressProvider) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PairAddressProvider) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PairAddressProvider) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PairAddressProvider) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PairAddressProvider)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AddressProvider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PairAddressProvider)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AddressProvider) > 0 {
+			i -= len(x.AddressProvider)
+			copy(dAtA[i:], x.AddressProvider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AddressProvider)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PairAddressProvider)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PairAddressProvider: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PairAddressProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddressProvider", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AddressProvider = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CurrencyDeviationThreshold            protoreflect.MessageDescriptor
+	fd_CurrencyDeviationThreshold_base_denom protoreflect.FieldDescriptor
+	fd_CurrencyDeviationThreshold_threshold  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_oracle_v1_oracle_proto_init()
+	md_CurrencyDeviationThreshold = File_oracle_v1_oracle_proto.Messages().ByName("CurrencyDeviationThreshold")
+	fd_CurrencyDeviationThreshold_base_denom = md_CurrencyDeviationThreshold.Fields().ByName("base_denom")
+	fd_CurrencyDeviationThreshold_threshold = md_CurrencyDeviationThreshold.Fields().ByName("threshold")
+}
+
+var _ protoreflect.Message = (*fastReflection_CurrencyDeviationThreshold)(nil)
+
+type fastReflection_CurrencyDeviationThreshold CurrencyDeviationThreshold
+
+func (x *CurrencyDeviationThreshold) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CurrencyDeviationThreshold)(x)
+}
+
+func (x *CurrencyDeviationThreshold) slowProtoReflect() protoreflect.Message {
+	mi := &file_oracle_v1_oracle_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CurrencyDeviationThreshold_messageType fastReflection_CurrencyDeviationThreshold_messageType
+var _ protoreflect.MessageType = fastReflection_CurrencyDeviationThreshold_messageType{}
+
+type fastReflection_CurrencyDeviationThreshold_messageType struct{}
+
+func (x fastReflection_CurrencyDeviationThreshold_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CurrencyDeviationThreshold)(nil)
+}
+func (x fastReflection_CurrencyDeviationThreshold_messageType) New() protoreflect.Message {
+	return new(fastReflection_CurrencyDeviationThreshold)
+}
+func (x fastReflection_CurrencyDeviationThreshold_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrencyDeviationThreshold
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CurrencyDeviationThreshold) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrencyDeviationThreshold
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CurrencyDeviationThreshold) Type() protoreflect.MessageType {
+	return _fastReflection_CurrencyDeviationThreshold_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CurrencyDeviationThreshold) New() protoreflect.Message {
+	return new(fastReflection_CurrencyDeviationThreshold)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CurrencyDeviationThreshold) Interface() protoreflect.ProtoMessage {
+	return (*CurrencyDeviationThreshold)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CurrencyDeviationThreshold) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BaseDenom != "" {
+		value := protoreflect.ValueOfString(x.BaseDenom)
+		if !f(fd_CurrencyDeviationThreshold_base_denom, value) {
+			return
+		}
+	}
+	if x.Threshold != "" {
+		value := protoreflect.ValueOfString(x.Threshold)
+		if !f(fd_CurrencyDeviationThreshold_threshold, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CurrencyDeviationThreshold) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "oracle.v1.CurrencyDeviationThreshold.base_denom":
+		return x.BaseDenom != ""
+	case "oracle.v1.CurrencyDeviationThreshold.threshold":
+		return x.Threshold != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.CurrencyDeviationThreshold"))
+		}
+		panic(fmt.Errorf("message oracle.v1.CurrencyDeviationThreshold does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyDeviationThreshold) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "oracle.v1.CurrencyDeviationThreshold.base_denom":
+		x.BaseDenom = ""
+	case "oracle.v1.CurrencyDeviationThreshold.threshold":
+		x.Threshold = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.CurrencyDeviationThreshold"))
+		}
+		panic(fmt.Errorf("message oracle.v1.CurrencyDeviationThreshold does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CurrencyDeviationThreshold) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "oracle.v1.CurrencyDeviationThreshold.base_denom":
+		value := x.BaseDenom
+		return protoreflect.ValueOfString(value)
+	case "oracle.v1.CurrencyDeviationThreshold.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.CurrencyDeviationThreshold"))
+		}
+		panic(fmt.Errorf("message oracle.v1.CurrencyDeviationThreshold does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyDeviationThreshold) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "oracle.v1.CurrencyDeviationThreshold.base_denom":
+		x.BaseDenom = value.Interface().(string)
+	case "oracle.v1.CurrencyDeviationThreshold.threshold":
+		x.Threshold = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.CurrencyDeviationThreshold"))
+		}
+		panic(fmt.Errorf("message oracle.v1.CurrencyDeviationThreshold does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyDeviationThreshold) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "oracle.v1.CurrencyDeviationThreshold.base_denom":
+		panic(fmt.Errorf("field base_denom of message oracle.v1.CurrencyDeviationThreshold is not mutable"))
+	case "oracle.v1.CurrencyDeviationThreshold.threshold":
+		panic(fmt.Errorf("field threshold of message oracle.v1.CurrencyDeviationThreshold is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.CurrencyDeviationThreshold"))
+		}
+		panic(fmt.Errorf("message oracle.v1.CurrencyDeviationThreshold does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CurrencyDeviationThreshold) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "oracle.v1.CurrencyDeviationThreshold.base_denom":
+		return protoreflect.ValueOfString("")
+	case "oracle.v1.CurrencyDeviationThreshold.threshold":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.CurrencyDeviationThreshold"))
+		}
+		panic(fmt.Errorf("message oracle.v1.CurrencyDeviationThreshold does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CurrencyDeviationThreshold) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.CurrencyDeviationThreshold", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CurrencyDeviationThreshold) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyDeviationThreshold) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CurrencyDeviationThreshold) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CurrencyDeviationThreshold) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CurrencyDeviationThreshold)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BaseDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Threshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CurrencyDeviationThreshold)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Threshold) > 0 {
+			i -= len(x.Threshold)
+			copy(dAtA[i:], x.Threshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Threshold)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.BaseDenom) > 0 {
+			i -= len(x.BaseDenom)
+			copy(dAtA[i:], x.BaseDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CurrencyDeviationThreshold)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyDeviationThreshold: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyDeviationThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Threshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ParamUpdatePlan_1_list)(nil)
+
+type _ParamUpdatePlan_1_list struct {
+	list *[]string
+}
+
+func (x *_ParamUpdatePlan_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ParamUpdatePlan_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ParamUpdatePlan_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ParamUpdatePlan_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ParamUpdatePlan_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ParamUpdatePlan at list field Keys as it is not of Message kind"))
+}
+
+func (x *_ParamUpdatePlan_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ParamUpdatePlan_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ParamUpdatePlan_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ParamUpdatePlan         protoreflect.MessageDescriptor
+	fd_ParamUpdatePlan_keys    protoreflect.FieldDescriptor
+	fd_ParamUpdatePlan_height  protoreflect.FieldDescriptor
+	fd_ParamUpdatePlan_changes protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_oracle_v1_oracle_proto_init()
+	md_ParamUpdatePlan = File_oracle_v1_oracle_proto.Messages().ByName("ParamUpdatePlan")
+	fd_ParamUpdatePlan_keys = md_ParamUpdatePlan.Fields().ByName("keys")
+	fd_ParamUpdatePlan_height = md_ParamUpdatePlan.Fields().ByName("height")
+	fd_ParamUpdatePlan_changes = md_ParamUpdatePlan.Fields().ByName("changes")
+}
+
+var _ protoreflect.Message = (*fastReflection_ParamUpdatePlan)(nil)
+
+type fastReflection_ParamUpdatePlan ParamUpdatePlan
+
+func (x *ParamUpdatePlan) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParamUpdatePlan)(x)
+}
+
+func (x *ParamUpdatePlan) slowProtoReflect() protoreflect.Message {
+	mi := &file_oracle_v1_oracle_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ParamUpdatePlan_messageType fastReflection_ParamUpdatePlan_messageType
+var _ protoreflect.MessageType = fastReflection_ParamUpdatePlan_messageType{}
+
+type fastReflection_ParamUpdatePlan_messageType struct{}
+
+func (x fastReflection_ParamUpdatePlan_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParamUpdatePlan)(nil)
+}
+func (x fastReflection_ParamUpdatePlan_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParamUpdatePlan)
+}
+func (x fastReflection_ParamUpdatePlan_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParamUpdatePlan
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ParamUpdatePlan) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParamUpdatePlan
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ParamUpdatePlan) Type() protoreflect.MessageType {
+	return _fastReflection_ParamUpdatePlan_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ParamUpdatePlan) New() protoreflect.Message {
+	return new(fastReflection_ParamUpdatePlan)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ParamUpdatePlan) Interface() protoreflect.ProtoMessage {
+	return (*ParamUpdatePlan)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ParamUpdatePlan) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Keys) != 0 {
+		value := protoreflect.ValueOfList(&_ParamUpdatePlan_1_list{list: &x.Keys})
+		if !f(fd_ParamUpdatePlan_keys, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_ParamUpdatePlan_height, value) {
+			return
+		}
+	}
+	if x.Changes != nil {
+		value := protoreflect.ValueOfMessage(x.Changes.ProtoReflect())
+		if !f(fd_ParamUpdatePlan_changes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ParamUpdatePlan) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "oracle.v1.ParamUpdatePlan.keys":
+		return len(x.Keys) != 0
+	case "oracle.v1.ParamUpdatePlan.height":
+		return x.Height != int64(0)
+	case "oracle.v1.ParamUpdatePlan.changes":
+		return x.Changes != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ParamUpdatePlan"))
+		}
+		panic(fmt.Errorf("message oracle.v1.ParamUpdatePlan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParamUpdatePlan) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "oracle.v1.ParamUpdatePlan.keys":
+		x.Keys = nil
+	case "oracle.v1.ParamUpdatePlan.height":
+		x.Height = int64(0)
+	case "oracle.v1.ParamUpdatePlan.changes":
+		x.Changes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ParamUpdatePlan"))
+		}
+		panic(fmt.Errorf("message oracle.v1.ParamUpdatePlan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ParamUpdatePlan) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "oracle.v1.ParamUpdatePlan.keys":
+		if len(x.Keys) == 0 {
+			return protoreflect.ValueOfList(&_ParamUpdatePlan_1_list{})
+		}
+		listValue := &_ParamUpdatePlan_1_list{list: &x.Keys}
+		return protoreflect.ValueOfList(listValue)
+	case "oracle.v1.ParamUpdatePlan.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "oracle.v1.ParamUpdatePlan.changes":
+		value := x.Changes
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ParamUpdatePlan"))
+		}
+		panic(fmt.Errorf("message oracle.v1.ParamUpdatePlan does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParamUpdatePlan) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "oracle.v1.ParamUpdatePlan.keys":
+		lv := value.List()
+		clv := lv.(*_ParamUpdatePlan_1_list)
+		x.Keys = *clv.list
+	case "oracle.v1.ParamUpdatePlan.height":
+		x.Height = value.Int()
+	case "oracle.v1.ParamUpdatePlan.changes":
+		x.Changes = value.Message().Interface().(*Params)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ParamUpdatePlan"))
+		}
+		panic(fmt.Errorf("message oracle.v1.ParamUpdatePlan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParamUpdatePlan) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "oracle.v1.ParamUpdatePlan.keys":
+		if x.Keys == nil {
+			x.Keys = []string{}
+		}
+		value := &_ParamUpdatePlan_1_list{list: &x.Keys}
+		return protoreflect.ValueOfList(value)
+	case "oracle.v1.ParamUpdatePlan.changes":
+		if x.Changes == nil {
+			x.Changes = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Changes.ProtoReflect())
+	case "oracle.v1.ParamUpdatePlan.height":
+		panic(fmt.Errorf("field height of message oracle.v1.ParamUpdatePlan is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ParamUpdatePlan"))
+		}
+		panic(fmt.Errorf("message oracle.v1.ParamUpdatePlan does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ParamUpdatePlan) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "oracle.v1.ParamUpdatePlan.keys":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ParamUpdatePlan_1_list{list: &list})
+	case "oracle.v1.ParamUpdatePlan.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "oracle.v1.ParamUpdatePlan.changes":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: oracle.v1.ParamUpdatePlan"))
+		}
+		panic(fmt.Errorf("message oracle.v1.ParamUpdatePlan does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ParamUpdatePlan) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in oracle.v1.ParamUpdatePlan", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ParamUpdatePlan) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParamUpdatePlan) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ParamUpdatePlan) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ParamUpdatePlan) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ParamUpdatePlan)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Keys) > 0 {
+			for _, s := range x.Keys {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Changes != nil {
+			l = options.Size(x.Changes)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ParamUpdatePlan)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Changes != nil {
+			encoded, err := options.Marshal(x.Changes)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Keys) > 0 {
+			for iNdEx := len(x.Keys) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Keys[iNdEx])
+				copy(dAtA[i:], x.Keys[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Keys[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ParamUpdatePlan)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamUpdatePlan: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamUpdatePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Keys = append(x.Keys, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Changes == nil {
+					x.Changes = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Changes); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: oracle/v1/oracle.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PriceSubmissionMode defines how validators submit exchange rate votes.
+type PriceSubmissionMode int32
+
+const (
+	// PRICE_SUBMISSION_MODE_UNSPECIFIED is an invalid mode.
+	PriceSubmissionMode_PRICE_SUBMISSION_MODE_UNSPECIFIED PriceSubmissionMode = 0
+	// PRICE_SUBMISSION_MODE_COMMIT_REVEAL only accepts MsgAggregateExchangeRatePrevote
+	// and MsgAggregateExchangeRateVote.
+	PriceSubmissionMode_PRICE_SUBMISSION_MODE_COMMIT_REVEAL PriceSubmissionMode = 1
+	// PRICE_SUBMISSION_MODE_VOTE_EXTENSIONS only accepts the exchange rates of
+	// the vote extensions injected in the block.
+	PriceSubmissionMode_PRICE_SUBMISSION_MODE_VOTE_EXTENSIONS PriceSubmissionMode = 2
+	// PRICE_SUBMISSION_MODE_BOTH accepts both, a vote extension vote replacing
+	// the commit-reveal vote of the validator.
+	PriceSubmissionMode_PRICE_SUBMISSION_MODE_BOTH PriceSubmissionMode = 3
+)
+
+// Enum value maps for PriceSubmissionMode.
+var (
+	PriceSubmissionMode_name = map[int32]string{
+		0: "PRICE_SUBMISSION_MODE_UNSPECIFIED",
+		1: "PRICE_SUBMISSION_MODE_COMMIT_REVEAL",
+		2: "PRICE_SUBMISSION_MODE_VOTE_EXTENSIONS",
+		3: "PRICE_SUBMISSION_MODE_BOTH",
+	}
+	PriceSubmissionMode_value = map[string]int32{
+		"PRICE_SUBMISSION_MODE_UNSPECIFIED":     0,
+		"PRICE_SUBMISSION_MODE_COMMIT_REVEAL":   1,
+		"PRICE_SUBMISSION_MODE_VOTE_EXTENSIONS": 2,
+		"PRICE_SUBMISSION_MODE_BOTH":            3,
+	}
+)
+
+func (x PriceSubmissionMode) Enum() *PriceSubmissionMode {
+	p := new(PriceSubmissionMode)
+	*p = x
+	return p
+}
+
+func (x PriceSubmissionMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PriceSubmissionMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_oracle_v1_oracle_proto_enumTypes[0].Descriptor()
+}
+
+func (PriceSubmissionMode) Type() protoreflect.EnumType {
+	return &file_oracle_v1_oracle_proto_enumTypes[0]
+}
+
+func (x PriceSubmissionMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PriceSubmissionMode.Descriptor instead.
+func (PriceSubmissionMode) EnumDescriptor() ([]byte, []int) {
+	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{0}
+}
+
+// PriceSource defines the USD rate a cross rate is derived from.
+type PriceSource int32
+
+const (
+	// PRICE_SOURCE_UNSPECIFIED defaults to the spot exchange rate.
+	PriceSource_PRICE_SOURCE_UNSPECIFIED PriceSource = 0
+	// PRICE_SOURCE_SPOT is the latest exchange rate.
+	PriceSource_PRICE_SOURCE_SPOT PriceSource = 1
+	// PRICE_SOURCE_SMA is the simple moving average of the historic prices.
+	PriceSource_PRICE_SOURCE_SMA PriceSource = 2
+	// PRICE_SOURCE_EMA is the exponential moving average of the historic
+	// prices.
+	PriceSource_PRICE_SOURCE_EMA PriceSource = 3
+	// PRICE_SOURCE_TWAP is the time-weighted average of the historic prices.
+	PriceSource_PRICE_SOURCE_TWAP PriceSource = 4
+)
+
+// Enum value maps for PriceSource.
+var (
+	PriceSource_name = map[int32]string{
+		0: "PRICE_SOURCE_UNSPECIFIED",
+		1: "PRICE_SOURCE_SPOT",
+		2: "PRICE_SOURCE_SMA",
+		3: "PRICE_SOURCE_EMA",
+		4: "PRICE_SOURCE_TWAP",
+	}
+	PriceSource_value = map[string]int32{
+		"PRICE_SOURCE_UNSPECIFIED": 0,
+		"PRICE_SOURCE_SPOT":        1,
+		"PRICE_SOURCE_SMA":         2,
+		"PRICE_SOURCE_EMA":         3,
+		"PRICE_SOURCE_TWAP":        4,
+	}
+)
+
+func (x PriceSource) Enum() *PriceSource {
+	p := new(PriceSource)
+	*p = x
+	return p
+}
+
+func (x PriceSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PriceSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_oracle_v1_oracle_proto_enumTypes[1].Descriptor()
+}
+
+func (PriceSource) Type() protoreflect.EnumType {
+	return &file_oracle_v1_oracle_proto_enumTypes[1]
+}
+
+func (x PriceSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PriceSource.Descriptor instead.
+func (PriceSource) EnumDescriptor() ([]byte, []int) {
+	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{1}
+}
+
+// Params defines the parameters for the oracle module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VotePeriod               uint64        `protobuf:"varint,1,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty"`
+	VoteThreshold            string        `protobuf:"bytes,2,opt,name=vote_threshold,json=voteThreshold,proto3" json:"vote_threshold,omitempty"`
+	RewardBands              []*RewardBand `protobuf:"bytes,3,rep,name=reward_bands,json=rewardBands,proto3" json:"reward_bands,omitempty"`
+	RewardDistributionWindow uint64        `protobuf:"varint,4,opt,name=reward_distribution_window,json=rewardDistributionWindow,proto3" json:"reward_distribution_window,omitempty"`
+	AcceptList               []*Denom      `protobuf:"bytes,5,rep,name=accept_list,json=acceptList,proto3" json:"accept_list,omitempty"`
+	SlashFraction            string        `protobuf:"bytes,6,opt,name=slash_fraction,json=slashFraction,proto3" json:"slash_fraction,omitempty"`
+	SlashWindow              uint64        `protobuf:"varint,7,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty"`
+	MinValidPerWindow        string        `protobuf:"bytes,8,opt,name=min_valid_per_window,json=minValidPerWindow,proto3" json:"min_valid_per_window,omitempty"`
+	MandatoryList            []*Denom      `protobuf:"bytes,9,rep,name=mandatory_list,json=mandatoryList,proto3" json:"mandatory_list,omitempty"`
+	// Historic Stamp Period represents the amount of blocks the oracle
+	// module waits before recording a new historic price.
+	HistoricStampPeriod uint64 `protobuf:"varint,10,opt,name=historic_stamp_period,json=historicStampPeriod,proto3" json:"historic_stamp_period,omitempty"`
+	// Median Stamp Period represents the amount blocks the oracle module
+	// waits between calculating and stamping a new median and standard
+	// deviation of that median.
+	MedianStampPeriod uint64 `protobuf:"varint,11,opt,name=median_stamp_period,json=medianStampPeriod,proto3" json:"median_stamp_period,omitempty"`
+	// Maximum Price Stamps represents the maximum amount of historic prices
+	// the oracle module will store before pruning via FIFO.
+	MaximumPriceStamps uint64 `protobuf:"varint,12,opt,name=maximum_price_stamps,json=maximumPriceStamps,proto3" json:"maximum_price_stamps,omitempty"`
+	// Maximum Median Stamps represents the maximum amount of medians the
+	// oracle module will store before pruning via FIFO.
+	MaximumMedianStamps uint64 `protobuf:"varint,13,opt,name=maximum_median_stamps,json=maximumMedianStamps,proto3" json:"maximum_median_stamps,omitempty"`
+	// Currency Pair Providers defines the currency provider pairs the
+	// price feeder uses upon start up for pricing data.
+	CurrencyPairProviders []*CurrencyPairProviders `protobuf:"bytes,14,rep,name=currency_pair_providers,json=currencyPairProviders,proto3" json:"currency_pair_providers,omitempty"`
+	// Currency Deviation Thresholds defines the deviation thresholds
+	// for each base currency the price feeder uses upon start up.
+	CurrencyDeviationThresholds []*CurrencyDeviationThreshold `protobuf:"bytes,15,rep,name=currency_deviation_thresholds,json=currencyDeviationThresholds,proto3" json:"currency_deviation_thresholds,omitempty"`
+	// usdc ibc denom
+	UsdcIbcDenom    string `protobuf:"bytes,16,opt,name=usdc_ibc_denom,json=usdcIbcDenom,proto3" json:"usdc_ibc_denom,omitempty"`
+	SlashingEnabled bool   `protobuf:"varint,17,opt,name=slashing_enabled,json=slashingEnabled,proto3" json:"slashing_enabled,omitempty"`
+	AveragingWindow uint64 `protobuf:"varint,18,opt,name=averaging_window,json=averagingWindow,proto3" json:"averaging_window,omitempty"`
+	// Max Vote Extension Rates represents the maximum amount of exchange rates
+	// a validator vote extension may contain.
+	MaxVoteExtensionRates uint64 `protobuf:"varint,19,opt,name=max_vote_extension_rates,json=maxVoteExtensionRates,proto3" json:"max_vote_extension_rates,omitempty"`
+	// Price Submission Mode selects how validators submit exchange rates. It
+	// can only be changed by a ParamUpdatePlan scheduled at the last block of
+	// a vote period.
+	PriceSubmissionMode PriceSubmissionMode `protobuf:"varint,20,opt,name=price_submission_mode,json=priceSubmissionMode,proto3,enum=oracle.v1.PriceSubmissionMode" json:"price_submission_mode,omitempty"`
+	// Candle Intervals are the lengths in blocks of the OHLC candles updated
+	// at each historic price stamp. Candles are not recorded if empty.
+	CandleIntervals []uint64 `protobuf:"varint,21,rep,packed,name=candle_intervals,json=candleIntervals,proto3" json:"candle_intervals,omitempty"`
+	// Maximum Candles represents the maximum amount of candles the oracle
+	// module will keep for each denom and candle interval.
+	MaximumCandles uint64 `protobuf:"varint,22,opt,name=maximum_candles,json=maximumCandles,proto3" json:"maximum_candles,omitempty"`
+	// Maximum Rate Staleness represents the maximum amount of blocks since
+	// the last update of a denom's exchange rate before it is stale.
+	MaximumRateStaleness uint64 `protobuf:"varint,23,opt,name=maximum_rate_staleness,json=maximumRateStaleness,proto3" json:"maximum_rate_staleness,omitempty"`
+	// Max Price Moves are the largest relative moves of the exchange rates of
+	// denoms from their previous rate, or from their latest historic median
+	// beyond its deviation, before their circuit breaker halts them.
+	MaxPriceMoves []*MaxPriceMove `protobuf:"bytes,24,rep,name=max_price_moves,json=maxPriceMoves,proto3" json:"max_price_moves,omitempty"`
+	// Denom Overrides replace the voting rules of denoms whose ballots can not
+	// meet the global ones, such as long-tail assets.
+	DenomOverrides []*DenomOverride `protobuf:"bytes,25,rep,name=denom_overrides,json=denomOverrides,proto3" json:"denom_overrides,omitempty"`
+	// Outlier Filters remove outlying votes from the ballots of denoms before
+	// they are tallied.
+	OutlierFilters []*OutlierFilter `protobuf:"bytes,26,rep,name=outlier_filters,json=outlierFilters,proto3" json:"outlier_filters,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_v1_oracle_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetVotePeriod() uint64 {
+	if x != nil {
+		return x.VotePeriod
+	}
+	return 0
+}
+
+func (x *Params) GetVoteThreshold() string {
+	if x != nil {
+		return x.VoteThreshold
+	}
+	return ""
+}
+
+func (x *Params) GetRewardBands() []*RewardBand {
+	if x != nil {
+		return x.RewardBands
+	}
+	return nil
+}
+
+func (x *Params) GetRewardDistributionWindow() uint64 {
+	if x != nil {
+		return x.RewardDistributionWindow
+	}
+	return 0
+}
+
+func (x *Params) GetAcceptList() []*Denom {
+	if x != nil {
+		return x.AcceptList
+	}
+	return nil
+}
+
+func (x *Params) GetSlashFraction() string {
+	if x != nil {
+		return x.SlashFraction
+	}
+	return ""
 }
 
 func (x *Params) GetSlashWindow() uint64 {
 	if x != nil {
-		return x.SlashWindow
+		return x.SlashWindow
+	}
+	return 0
+}
+
+func (x *Params) GetMinValidPerWindow() string {
+	if x != nil {
+		return x.MinValidPerWindow
+	}
+	return ""
+}
+
+func (x *Params) GetMandatoryList() []*Denom {
+	if x != nil {
+		return x.MandatoryList
+	}
+	return nil
+}
+
+func (x *Params) GetHistoricStampPeriod() uint64 {
+	if x != nil {
+		return x.HistoricStampPeriod
+	}
+	return 0
+}
+
+func (x *Params) GetMedianStampPeriod() uint64 {
+	if x != nil {
+		return x.MedianStampPeriod
+	}
+	return 0
+}
+
+func (x *Params) GetMaximumPriceStamps() uint64 {
+	if x != nil {
+		return x.MaximumPriceStamps
+	}
+	return 0
+}
+
+func (x *Params) GetMaximumMedianStamps() uint64 {
+	if x != nil {
+		return x.MaximumMedianStamps
+	}
+	return 0
+}
+
+func (x *Params) GetCurrencyPairProviders() []*CurrencyPairProviders {
+	if x != nil {
+		return x.CurrencyPairProviders
+	}
+	return nil
+}
+
+func (x *Params) GetCurrencyDeviationThresholds() []*CurrencyDeviationThreshold {
+	if x != nil {
+		return x.CurrencyDeviationThresholds
+	}
+	return nil
+}
+
+func (x *Params) GetUsdcIbcDenom() string {
+	if x != nil {
+		return x.UsdcIbcDenom
+	}
+	return ""
+}
+
+func (x *Params) GetSlashingEnabled() bool {
+	if x != nil {
+		return x.SlashingEnabled
+	}
+	return false
+}
+
+func (x *Params) GetAveragingWindow() uint64 {
+	if x != nil {
+		return x.AveragingWindow
+	}
+	return 0
+}
+
+func (x *Params) GetMaxVoteExtensionRates() uint64 {
+	if x != nil {
+		return x.MaxVoteExtensionRates
+	}
+	return 0
+}
+
+func (x *Params) GetPriceSubmissionMode() PriceSubmissionMode {
+	if x != nil {
+		return x.PriceSubmissionMode
+	}
+	return PriceSubmissionMode_PRICE_SUBMISSION_MODE_UNSPECIFIED
+}
+
+func (x *Params) GetCandleIntervals() []uint64 {
+	if x != nil {
+		return x.CandleIntervals
+	}
+	return nil
+}
+
+func (x *Params) GetMaximumCandles() uint64 {
+	if x != nil {
+		return x.MaximumCandles
+	}
+	return 0
+}
+
+func (x *Params) GetMaximumRateStaleness() uint64 {
+	if x != nil {
+		return x.MaximumRateStaleness
+	}
+	return 0
+}
+
+func (x *Params) GetMaxPriceMoves() []*MaxPriceMove {
+	if x != nil {
+		return x.MaxPriceMoves
+	}
+	return nil
+}
+
+func (x *Params) GetDenomOverrides() []*DenomOverride {
+	if x != nil {
+		return x.DenomOverrides
+	}
+	return nil
+}
+
+func (x *Params) GetOutlierFilters() []*OutlierFilter {
+	if x != nil {
+		return x.OutlierFilters
+	}
+	return nil
+}
+
+// Denom - the object to hold configurations of each denom
+type Denom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseDenom   string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	SymbolDenom string `protobuf:"bytes,2,opt,name=symbol_denom,json=symbolDenom,proto3" json:"symbol_denom,omitempty"`
+	Exponent    uint32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+}
+
+func (x *Denom) Reset() {
+	*x = Denom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_v1_oracle_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Denom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Denom) ProtoMessage() {}
+
+// Deprecated: Use Denom.ProtoReflect.Descriptor instead.
+func (*Denom) Descriptor() ([]byte, []int) {
+	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Denom) GetBaseDenom() string {
+	if x != nil {
+		return x.BaseDenom
+	}
+	return ""
+}
+
+func (x *Denom) GetSymbolDenom() string {
+	if x != nil {
+		return x.SymbolDenom
+	}
+	return ""
+}
+
+func (x *Denom) GetExponent() uint32 {
+	if x != nil {
+		return x.Exponent
+	}
+	return 0
+}
+
+// RewardBand - the object to hold the reward band configuration for a given denom.
+type RewardBand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SymbolDenom string `protobuf:"bytes,1,opt,name=symbol_denom,json=symbolDenom,proto3" json:"symbol_denom,omitempty"`
+	RewardBand  string `protobuf:"bytes,2,opt,name=reward_band,json=rewardBand,proto3" json:"reward_band,omitempty"`
+}
+
+func (x *RewardBand) Reset() {
+	*x = RewardBand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_v1_oracle_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardBand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardBand) ProtoMessage() {}
+
+// Deprecated: Use RewardBand.ProtoReflect.Descriptor instead.
+func (*RewardBand) Descriptor() ([]byte, []int) {
+	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RewardBand) GetSymbolDenom() string {
+	if x != nil {
+		return x.SymbolDenom
+	}
+	return ""
+}
+
+func (x *RewardBand) GetRewardBand() string {
+	if x != nil {
+		return x.RewardBand
+	}
+	return ""
+}
+
+// MaxPriceMove - the largest relative move of the exchange rate of a denom
+// in a vote period before its circuit breaker halts it.
+type MaxPriceMove struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SymbolDenom string `protobuf:"bytes,1,opt,name=symbol_denom,json=symbolDenom,proto3" json:"symbol_denom,omitempty"`
+	MaxMove     string `protobuf:"bytes,2,opt,name=max_move,json=maxMove,proto3" json:"max_move,omitempty"`
+}
+
+func (x *MaxPriceMove) Reset() {
+	*x = MaxPriceMove{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_v1_oracle_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaxPriceMove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaxPriceMove) ProtoMessage() {}
+
+// Deprecated: Use MaxPriceMove.ProtoReflect.Descriptor instead.
+func (*MaxPriceMove) Descriptor() ([]byte, []int) {
+	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MaxPriceMove) GetSymbolDenom() string {
+	if x != nil {
+		return x.SymbolDenom
+	}
+	return ""
+}
+
+func (x *MaxPriceMove) GetMaxMove() string {
+	if x != nil {
+		return x.MaxMove
+	}
+	return ""
+}
+
+// DenomOverride - the voting rules of a denom replacing the global ones.
+type DenomOverride struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SymbolDenom string `protobuf:"bytes,1,opt,name=symbol_denom,json=symbolDenom,proto3" json:"symbol_denom,omitempty"`
+	// vote_threshold replaces the VoteThreshold param if positive.
+	VoteThreshold string `protobuf:"bytes,2,opt,name=vote_threshold,json=voteThreshold,proto3" json:"vote_threshold,omitempty"`
+	// min_voters is the minimum amount of distinct validators voting in a
+	// ballot of the denom.
+	MinVoters uint64 `protobuf:"varint,3,opt,name=min_voters,json=minVoters,proto3" json:"min_voters,omitempty"`
+	// vote_period_multiplier is the amount of vote periods between updates of
+	// the exchange rate of the denom, every vote period if zero.
+	VotePeriodMultiplier uint64 `protobuf:"varint,4,opt,name=vote_period_multiplier,json=votePeriodMultiplier,proto3" json:"vote_period_multiplier,omitempty"`
+}
+
+func (x *DenomOverride) Reset() {
+	*x = DenomOverride{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_v1_oracle_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DenomOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenomOverride) ProtoMessage() {}
+
+// Deprecated: Use DenomOverride.ProtoReflect.Descriptor instead.
+func (*DenomOverride) Descriptor() ([]byte, []int) {
+	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DenomOverride) GetSymbolDenom() string {
+	if x != nil {
+		return x.SymbolDenom
+	}
+	return ""
+}
+
+func (x *DenomOverride) GetVoteThreshold() string {
+	if x != nil {
+		return x.VoteThreshold
+	}
+	return ""
+}
+
+func (x *DenomOverride) GetMinVoters() uint64 {
+	if x != nil {
+		return x.MinVoters
+	}
+	return 0
+}
+
+func (x *DenomOverride) GetVotePeriodMultiplier() uint64 {
+	if x != nil {
+		return x.VotePeriodMultiplier
+	}
+	return 0
+}
+
+// OutlierFilter - the filter removing outlying votes from the ballots of a
+// denom, either further than mad_multiplier median absolute deviations from
+// the unweighted median, or outside the percentile band.
+type OutlierFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SymbolDenom string `protobuf:"bytes,1,opt,name=symbol_denom,json=symbolDenom,proto3" json:"symbol_denom,omitempty"`
+	// mad_multiplier is the k of the k x median absolute deviation filter.
+	MadMultiplier string `protobuf:"bytes,2,opt,name=mad_multiplier,json=madMultiplier,proto3" json:"mad_multiplier,omitempty"`
+	// lower_percentile is the lower bound of the percentile band filter.
+	LowerPercentile string `protobuf:"bytes,3,opt,name=lower_percentile,json=lowerPercentile,proto3" json:"lower_percentile,omitempty"`
+	// upper_percentile is the upper bound of the percentile band filter.
+	UpperPercentile string `protobuf:"bytes,4,opt,name=upper_percentile,json=upperPercentile,proto3" json:"upper_percentile,omitempty"`
+}
+
+func (x *OutlierFilter) Reset() {
+	*x = OutlierFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_v1_oracle_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutlierFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutlierFilter) ProtoMessage() {}
+
+// Deprecated: Use OutlierFilter.ProtoReflect.Descriptor instead.
+func (*OutlierFilter) Descriptor() ([]byte, []int) {
+	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OutlierFilter) GetSymbolDenom() string {
+	if x != nil {
+		return x.SymbolDenom
+	}
+	return ""
+}
+
+func (x *OutlierFilter) GetMadMultiplier() string {
+	if x != nil {
+		return x.MadMultiplier
+	}
+	return ""
+}
+
+func (x *OutlierFilter) GetLowerPercentile() string {
+	if x != nil {
+		return x.LowerPercentile
+	}
+	return ""
+}
+
+func (x *OutlierFilter) GetUpperPercentile() string {
+	if x != nil {
+		return x.UpperPercentile
+	}
+	return ""
+}
+
+// AggregateExchangeRatePrevote -
+// struct for aggregate prevoting on the ExchangeRateVote.
+// The purpose of aggregate prevote is to hide vote exchange rates with hash
+// which is formatted as hex string in SHA256("{salt}:{exchange
+// rate}{denom},...,{exchange rate}{denom}:{voter}")
+type AggregateExchangeRatePrevote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash        string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Voter       string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	SubmitBlock uint64 `protobuf:"varint,3,opt,name=submit_block,json=submitBlock,proto3" json:"submit_block,omitempty"`
+}
+
+func (x *AggregateExchangeRatePrevote) Reset() {
+	*x = AggregateExchangeRatePrevote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_v1_oracle_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateExchangeRatePrevote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateExchangeRatePrevote) ProtoMessage() {}
+
+// Deprecated: Use AggregateExchangeRatePrevote.ProtoReflect.Descriptor instead.
+func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
+	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AggregateExchangeRatePrevote) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *AggregateExchangeRatePrevote) GetVoter() string {
+	if x != nil {
+		return x.Voter
+	}
+	return ""
+}
+
+func (x *AggregateExchangeRatePrevote) GetSubmitBlock() uint64 {
+	if x != nil {
+		return x.SubmitBlock
 	}
 	return 0
 }
 
-func (x *Params) GetMinValidPerWindow() string {
+// AggregateExchangeRateVote - struct for voting on
+// the exchange rates of USD denominated in various assets.
+type AggregateExchangeRateVote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExchangeRates []*v1beta1.DecCoin `protobuf:"bytes,1,rep,name=exchange_rates,json=exchangeRates,proto3" json:"exchange_rates,omitempty"`
+	Voter         string             `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+}
+
+func (x *AggregateExchangeRateVote) Reset() {
+	*x = AggregateExchangeRateVote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_v1_oracle_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateExchangeRateVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateExchangeRateVote) ProtoMessage() {}
+
+// Deprecated: Use AggregateExchangeRateVote.ProtoReflect.Descriptor instead.
+func (*AggregateExchangeRateVote) Descriptor() ([]byte, []int) {
+	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AggregateExchangeRateVote) GetExchangeRates() []*v1beta1.DecCoin {
 	if x != nil {
-		return x.MinValidPerWindow
+		return x.ExchangeRates
+	}
+	return nil
+}
+
+func (x *AggregateExchangeRateVote) GetVoter() string {
+	if x != nil {
+		return x.Voter
 	}
 	return ""
 }
 
-func (x *Params) GetMandatoryList() []*Denom {
+// PriceStamp defines a stamp of a denom's exchange rate
+// at the block number it was calculated in.
+type PriceStamp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExchangeRate *v1beta1.DecCoin `protobuf:"bytes,1,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	BlockNum     uint64           `protobuf:"varint,2,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+}
+
+func (x *PriceStamp) Reset() {
+	*x = PriceStamp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_v1_oracle_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceStamp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceStamp) ProtoMessage() {}
+
+// Deprecated: Use PriceStamp.ProtoReflect.Descriptor instead.
+func (*PriceStamp) Descriptor() ([]byte, []int) {
+	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PriceStamp) GetExchangeRate() *v1beta1.DecCoin {
 	if x != nil {
-		return x.MandatoryList
+		return x.ExchangeRate
 	}
 	return nil
 }
 
-func (x *Params) GetHistoricStampPeriod() uint64 {
+func (x *PriceStamp) GetBlockNum() uint64 {
 	if x != nil {
-		return x.HistoricStampPeriod
+		return x.BlockNum
 	}
 	return 0
 }
 
-func (x *Params) GetMedianStampPeriod() uint64 {
+// ExchangeRateUpdate is the last exchange rate of a denom with the block it
+// was set in. It is retained when the ballot of the denom is dropped.
+type ExchangeRateUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom        string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	ExchangeRate string                 `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	BlockNum     uint64                 `protobuf:"varint,3,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	BlockTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+}
+
+func (x *ExchangeRateUpdate) Reset() {
+	*x = ExchangeRateUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_v1_oracle_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExchangeRateUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRateUpdate) ProtoMessage() {}
+
+// Deprecated: Use ExchangeRateUpdate.ProtoReflect.Descriptor instead.
+func (*ExchangeRateUpdate) Descriptor() ([]byte, []int) {
+	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExchangeRateUpdate) GetDenom() string {
 	if x != nil {
-		return x.MedianStampPeriod
+		return x.Denom
 	}
-	return 0
+	return ""
 }
 
-func (x *Params) GetMaximumPriceStamps() uint64 {
+func (x *ExchangeRateUpdate) GetExchangeRate() string {
 	if x != nil {
-		return x.MaximumPriceStamps
+		return x.ExchangeRate
 	}
-	return 0
+	return ""
 }
 
-func (x *Params) GetMaximumMedianStamps() uint64 {
+func (x *ExchangeRateUpdate) GetBlockNum() uint64 {
 	if x != nil {
-		return x.MaximumMedianStamps
+		return x.BlockNum
 	}
 	return 0
 }
 
-func (x *Params) GetCurrencyPairProviders() []*CurrencyPairProviders {
+func (x *ExchangeRateUpdate) GetBlockTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CurrencyPairProviders
+		return x.BlockTime
 	}
 	return nil
 }
 
-func (x *Params) GetCurrencyDeviationThresholds() []*CurrencyDeviationThreshold {
+// ExchangeRateStatus is the last exchange rate of a denom with its age at
+// the current block.
+type ExchangeRateStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LastUpdate *ExchangeRateUpdate `protobuf:"bytes,1,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
+	// age_blocks is the amount of blocks since the last update.
+	AgeBlocks uint64 `protobuf:"varint,2,opt,name=age_blocks,json=ageBlocks,proto3" json:"age_blocks,omitempty"`
+	// age is the block time since the last update.
+	Age *durationpb.Duration `protobuf:"bytes,3,opt,name=age,proto3" json:"age,omitempty"`
+	// stale is set if age_blocks is over the MaximumRateStaleness param.
+	Stale bool `protobuf:"varint,4,opt,name=stale,proto3" json:"stale,omitempty"`
+	// halted is set if the circuit breaker of the denom is tripped.
+	Halted bool `protobuf:"varint,5,opt,name=halted,proto3" json:"halted,omitempty"`
+}
+
+func (x *ExchangeRateStatus) Reset() {
+	*x = ExchangeRateStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_v1_oracle_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExchangeRateStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRateStatus) ProtoMessage() {}
+
+// Deprecated: Use ExchangeRateStatus.ProtoReflect.Descriptor instead.
+func (*ExchangeRateStatus) Descriptor() ([]byte, []int) {
+	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ExchangeRateStatus) GetLastUpdate() *ExchangeRateUpdate {
 	if x != nil {
-		return x.CurrencyDeviationThresholds
+		return x.LastUpdate
 	}
 	return nil
 }
 
-func (x *Params) GetUsdcIbcDenom() string {
+func (x *ExchangeRateStatus) GetAgeBlocks() uint64 {
 	if x != nil {
-		return x.UsdcIbcDenom
+		return x.AgeBlocks
 	}
-	return ""
+	return 0
 }
 
-func (x *Params) GetSlashingEnabled() bool {
+func (x *ExchangeRateStatus) GetAge() *durationpb.Duration {
 	if x != nil {
-		return x.SlashingEnabled
+		return x.Age
+	}
+	return nil
+}
+
+func (x *ExchangeRateStatus) GetStale() bool {
+	if x != nil {
+		return x.Stale
 	}
 	return false
 }
 
-func (x *Params) GetAveragingWindow() uint64 {
+func (x *ExchangeRateStatus) GetHalted() bool {
 	if x != nil {
-		return x.AveragingWindow
+		return x.Halted
 	}
-	return 0
+	return false
 }
 
-// Denom - the object to hold configurations of each denom
-type Denom struct {
+// PriceHalt is a tripped circuit breaker of a denom, which keeps its
+// exchange rate at halted_rate until it is reset by governance.
+type PriceHalt struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BaseDenom   string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
-	SymbolDenom string `protobuf:"bytes,2,opt,name=symbol_denom,json=symbolDenom,proto3" json:"symbol_denom,omitempty"`
-	Exponent    uint32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// halted_rate is the last exchange rate before the breach.
+	HaltedRate string `protobuf:"bytes,2,opt,name=halted_rate,json=haltedRate,proto3" json:"halted_rate,omitempty"`
+	// rejected_rate is the exchange rate which tripped the circuit breaker.
+	RejectedRate string `protobuf:"bytes,3,opt,name=rejected_rate,json=rejectedRate,proto3" json:"rejected_rate,omitempty"`
+	BlockNum     uint64 `protobuf:"varint,4,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	// reason is the reference price the move breached.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *Denom) Reset() {
-	*x = Denom{}
+func (x *PriceHalt) Reset() {
+	*x = PriceHalt{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_v1_oracle_proto_msgTypes[1]
+		mi := &file_oracle_v1_oracle_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Denom) String() string {
+func (x *PriceHalt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Denom) ProtoMessage() {}
+func (*PriceHalt) ProtoMessage() {}
 
-// Deprecated: Use Denom.ProtoReflect.Descriptor instead.
-func (*Denom) Descriptor() ([]byte, []int) {
-	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use PriceHalt.ProtoReflect.Descriptor instead.
+func (*PriceHalt) Descriptor() ([]byte, []int) {
+	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Denom) GetBaseDenom() string {
+func (x *PriceHalt) GetDenom() string {
 	if x != nil {
-		return x.BaseDenom
+		return x.Denom
 	}
 	return ""
 }
 
-func (x *Denom) GetSymbolDenom() string {
+func (x *PriceHalt) GetHaltedRate() string {
+	if x != nil {
+		return x.HaltedRate
+	}
+	return ""
+}
+
+func (x *PriceHalt) GetRejectedRate() string {
+	if x != nil {
+		return x.RejectedRate
+	}
+	return ""
+}
+
+func (x *PriceHalt) GetBlockNum() uint64 {
+	if x != nil {
+		return x.BlockNum
+	}
+	return 0
+}
+
+func (x *PriceHalt) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Candle is the open, high, low and close historic prices of a denom over
+// a candle interval starting at a block.
+type Candle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom      string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Interval   uint64 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	StartBlock uint64 `protobuf:"varint,3,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	Open       string `protobuf:"bytes,4,opt,name=open,proto3" json:"open,omitempty"`
+	High       string `protobuf:"bytes,5,opt,name=high,proto3" json:"high,omitempty"`
+	Low        string `protobuf:"bytes,6,opt,name=low,proto3" json:"low,omitempty"`
+	Close      string `protobuf:"bytes,7,opt,name=close,proto3" json:"close,omitempty"`
+}
+
+func (x *Candle) Reset() {
+	*x = Candle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_v1_oracle_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Candle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Candle) ProtoMessage() {}
+
+// Deprecated: Use Candle.ProtoReflect.Descriptor instead.
+func (*Candle) Descriptor() ([]byte, []int) {
+	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Candle) GetDenom() string {
 	if x != nil {
-		return x.SymbolDenom
+		return x.Denom
 	}
 	return ""
 }
 
-func (x *Denom) GetExponent() uint32 {
+func (x *Candle) GetInterval() uint64 {
 	if x != nil {
-		return x.Exponent
+		return x.Interval
 	}
 	return 0
 }
 
-// RewardBand - the object to hold the reward band configuration for a given denom.
-type RewardBand struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	SymbolDenom string `protobuf:"bytes,1,opt,name=symbol_denom,json=symbolDenom,proto3" json:"symbol_denom,omitempty"`
-	RewardBand  string `protobuf:"bytes,2,opt,name=reward_band,json=rewardBand,proto3" json:"reward_band,omitempty"`
-}
-
-func (x *RewardBand) Reset() {
-	*x = RewardBand{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_v1_oracle_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Candle) GetStartBlock() uint64 {
+	if x != nil {
+		return x.StartBlock
 	}
+	return 0
 }
 
-func (x *RewardBand) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Candle) GetOpen() string {
+	if x != nil {
+		return x.Open
+	}
+	return ""
 }
 
-func (*RewardBand) ProtoMessage() {}
-
-// Deprecated: Use RewardBand.ProtoReflect.Descriptor instead.
-func (*RewardBand) Descriptor() ([]byte, []int) {
-	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{2}
+func (x *Candle) GetHigh() string {
+	if x != nil {
+		return x.High
+	}
+	return ""
 }
 
-func (x *RewardBand) GetSymbolDenom() string {
+func (x *Candle) GetLow() string {
 	if x != nil {
-		return x.SymbolDenom
+		return x.Low
 	}
 	return ""
 }
 
-func (x *RewardBand) GetRewardBand() string {
+func (x *Candle) GetClose() string {
 	if x != nil {
-		return x.RewardBand
+		return x.Close
 	}
 	return ""
 }
 
-// AggregateExchangeRatePrevote -
-// struct for aggregate prevoting on the ExchangeRateVote.
-// The purpose of aggregate prevote is to hide vote exchange rates with hash
-// which is formatted as hex string in SHA256("{salt}:{exchange
-// rate}{denom},...,{exchange rate}{denom}:{voter}")
-type AggregateExchangeRatePrevote struct {
+// PriceStatistics are the statistics of the latest historic prices of a
+// denom.
+type PriceStatistics struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hash        string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	Voter       string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
-	SubmitBlock uint64 `protobuf:"varint,3,opt,name=submit_block,json=submitBlock,proto3" json:"submit_block,omitempty"`
-}
-
-func (x *AggregateExchangeRatePrevote) Reset() {
-	*x = AggregateExchangeRatePrevote{}
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// num_stamps is the amount of historic prices the statistics are of.
+	NumStamps uint64 `protobuf:"varint,2,opt,name=num_stamps,json=numStamps,proto3" json:"num_stamps,omitempty"`
+	Min       string `protobuf:"bytes,3,opt,name=min,proto3" json:"min,omitempty"`
+	Max       string `protobuf:"bytes,4,opt,name=max,proto3" json:"max,omitempty"`
+	Mean      string `protobuf:"bytes,5,opt,name=mean,proto3" json:"mean,omitempty"`
+	Median    string `protobuf:"bytes,6,opt,name=median,proto3" json:"median,omitempty"`
+	// standard_deviation is the population standard deviation of the prices.
+	StandardDeviation string `protobuf:"bytes,7,opt,name=standard_deviation,json=standardDeviation,proto3" json:"standard_deviation,omitempty"`
+	// realized_volatility is the square root of the sum of the squared log
+	// returns between consecutive prices.
+	RealizedVolatility string             `protobuf:"bytes,8,opt,name=realized_volatility,json=realizedVolatility,proto3" json:"realized_volatility,omitempty"`
+	Percentiles        []*PricePercentile `protobuf:"bytes,9,rep,name=percentiles,proto3" json:"percentiles,omitempty"`
+}
+
+func (x *PriceStatistics) Reset() {
+	*x = PriceStatistics{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_v1_oracle_proto_msgTypes[3]
+		mi := &file_oracle_v1_oracle_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AggregateExchangeRatePrevote) String() string {
+func (x *PriceStatistics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AggregateExchangeRatePrevote) ProtoMessage() {}
+func (*PriceStatistics) ProtoMessage() {}
 
-// Deprecated: Use AggregateExchangeRatePrevote.ProtoReflect.Descriptor instead.
-func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
-	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use PriceStatistics.ProtoReflect.Descriptor instead.
+func (*PriceStatistics) Descriptor() ([]byte, []int) {
+	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *AggregateExchangeRatePrevote) GetHash() string {
+func (x *PriceStatistics) GetDenom() string {
 	if x != nil {
-		return x.Hash
+		return x.Denom
 	}
 	return ""
 }
 
-func (x *AggregateExchangeRatePrevote) GetVoter() string {
+func (x *PriceStatistics) GetNumStamps() uint64 {
 	if x != nil {
-		return x.Voter
+		return x.NumStamps
 	}
-	return ""
+	return 0
 }
 
-func (x *AggregateExchangeRatePrevote) GetSubmitBlock() uint64 {
+func (x *PriceStatistics) GetMin() string {
 	if x != nil {
-		return x.SubmitBlock
+		return x.Min
 	}
-	return 0
+	return ""
 }
 
-// AggregateExchangeRateVote - struct for voting on
-// the exchange rates of USD denominated in various assets.
-type AggregateExchangeRateVote struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ExchangeRates []*v1beta1.DecCoin `protobuf:"bytes,1,rep,name=exchange_rates,json=exchangeRates,proto3" json:"exchange_rates,omitempty"`
-	Voter         string             `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+func (x *PriceStatistics) GetMax() string {
+	if x != nil {
+		return x.Max
+	}
+	return ""
 }
 
-func (x *AggregateExchangeRateVote) Reset() {
-	*x = AggregateExchangeRateVote{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_v1_oracle_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *PriceStatistics) GetMean() string {
+	if x != nil {
+		return x.Mean
 	}
+	return ""
 }
 
-func (x *AggregateExchangeRateVote) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PriceStatistics) GetMedian() string {
+	if x != nil {
+		return x.Median
+	}
+	return ""
 }
 
-func (*AggregateExchangeRateVote) ProtoMessage() {}
-
-// Deprecated: Use AggregateExchangeRateVote.ProtoReflect.Descriptor instead.
-func (*AggregateExchangeRateVote) Descriptor() ([]byte, []int) {
-	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{4}
+func (x *PriceStatistics) GetStandardDeviation() string {
+	if x != nil {
+		return x.StandardDeviation
+	}
+	return ""
 }
 
-func (x *AggregateExchangeRateVote) GetExchangeRates() []*v1beta1.DecCoin {
+func (x *PriceStatistics) GetRealizedVolatility() string {
 	if x != nil {
-		return x.ExchangeRates
+		return x.RealizedVolatility
 	}
-	return nil
+	return ""
 }
 
-func (x *AggregateExchangeRateVote) GetVoter() string {
+func (x *PriceStatistics) GetPercentiles() []*PricePercentile {
 	if x != nil {
-		return x.Voter
+		return x.Percentiles
 	}
-	return ""
+	return nil
 }
 
-// PriceStamp defines a stamp of a denom's exchange rate
-// at the block number it was calculated in.
-type PriceStamp struct {
+// PricePercentile is a percentile of historic prices.
+type PricePercentile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExchangeRate *v1beta1.DecCoin `protobuf:"bytes,1,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
-	BlockNum     uint64           `protobuf:"varint,2,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	// percentile is in whole percent, from 0 to 100.
+	Percentile uint32 `protobuf:"varint,1,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	Value      string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *PriceStamp) Reset() {
-	*x = PriceStamp{}
+func (x *PricePercentile) Reset() {
+	*x = PricePercentile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_v1_oracle_proto_msgTypes[5]
+		mi := &file_oracle_v1_oracle_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PriceStamp) String() string {
+func (x *PricePercentile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PriceStamp) ProtoMessage() {}
+func (*PricePercentile) ProtoMessage() {}
 
-// Deprecated: Use PriceStamp.ProtoReflect.Descriptor instead.
-func (*PriceStamp) Descriptor() ([]byte, []int) {
-	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use PricePercentile.ProtoReflect.Descriptor instead.
+func (*PricePercentile) Descriptor() ([]byte, []int) {
+	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *PriceStamp) GetExchangeRate() *v1beta1.DecCoin {
+func (x *PricePercentile) GetPercentile() uint32 {
 	if x != nil {
-		return x.ExchangeRate
+		return x.Percentile
 	}
-	return nil
+	return 0
 }
 
-func (x *PriceStamp) GetBlockNum() uint64 {
+func (x *PricePercentile) GetValue() string {
 	if x != nil {
-		return x.BlockNum
+		return x.Value
 	}
-	return 0
+	return ""
 }
 
 // ValidatorRewardSet is the set of validators that is earning
@@ -7605,7 +15076,7 @@ type ValidatorRewardSet struct {
 func (x *ValidatorRewardSet) Reset() {
 	*x = ValidatorRewardSet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_v1_oracle_proto_msgTypes[6]
+		mi := &file_oracle_v1_oracle_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7619,7 +15090,7 @@ func (*ValidatorRewardSet) ProtoMessage() {}
 
 // Deprecated: Use ValidatorRewardSet.ProtoReflect.Descriptor instead.
 func (*ValidatorRewardSet) Descriptor() ([]byte, []int) {
-	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{6}
+	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ValidatorRewardSet) GetValidatorSet() []string {
@@ -7645,7 +15116,7 @@ type CurrencyPairProviders struct {
 func (x *CurrencyPairProviders) Reset() {
 	*x = CurrencyPairProviders{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_v1_oracle_proto_msgTypes[7]
+		mi := &file_oracle_v1_oracle_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7659,7 +15130,7 @@ func (*CurrencyPairProviders) ProtoMessage() {}
 
 // Deprecated: Use CurrencyPairProviders.ProtoReflect.Descriptor instead.
 func (*CurrencyPairProviders) Descriptor() ([]byte, []int) {
-	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{7}
+	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CurrencyPairProviders) GetBaseDenom() string {
@@ -7704,7 +15175,7 @@ type PairAddressProvider struct {
 func (x *PairAddressProvider) Reset() {
 	*x = PairAddressProvider{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_v1_oracle_proto_msgTypes[8]
+		mi := &file_oracle_v1_oracle_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7718,7 +15189,7 @@ func (*PairAddressProvider) ProtoMessage() {}
 
 // Deprecated: Use PairAddressProvider.ProtoReflect.Descriptor instead.
 func (*PairAddressProvider) Descriptor() ([]byte, []int) {
-	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{8}
+	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *PairAddressProvider) GetAddress() string {
@@ -7749,7 +15220,7 @@ type CurrencyDeviationThreshold struct {
 func (x *CurrencyDeviationThreshold) Reset() {
 	*x = CurrencyDeviationThreshold{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_v1_oracle_proto_msgTypes[9]
+		mi := &file_oracle_v1_oracle_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7763,7 +15234,7 @@ func (*CurrencyDeviationThreshold) ProtoMessage() {}
 
 // Deprecated: Use CurrencyDeviationThreshold.ProtoReflect.Descriptor instead.
 func (*CurrencyDeviationThreshold) Descriptor() ([]byte, []int) {
-	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{9}
+	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CurrencyDeviationThreshold) GetBaseDenom() string {
@@ -7798,7 +15269,7 @@ type ParamUpdatePlan struct {
 func (x *ParamUpdatePlan) Reset() {
 	*x = ParamUpdatePlan{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_v1_oracle_proto_msgTypes[10]
+		mi := &file_oracle_v1_oracle_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7812,7 +15283,7 @@ func (*ParamUpdatePlan) ProtoMessage() {}
 
 // Deprecated: Use ParamUpdatePlan.ProtoReflect.Descriptor instead.
 func (*ParamUpdatePlan) Descriptor() ([]byte, []int) {
-	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{10}
+	return file_oracle_v1_oracle_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ParamUpdatePlan) GetKeys() []string {
@@ -7844,218 +15315,447 @@ var file_oracle_v1_oracle_proto_rawDesc = []byte{
 	0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65,
 	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
-	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf7, 0x0b, 0x0a, 0x06, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x12, 0x37, 0x0a, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x16, 0xf2, 0xde, 0x1f, 0x12, 0x79,
-	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x22, 0x52, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x63, 0x0a,
-	0x0e, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcf, 0x11, 0x0a, 0x06, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x37, 0x0a, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x16, 0xf2, 0xde, 0x1f, 0x12,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x22, 0x52, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x63,
+	0x0a, 0x0e, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x15, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x22, 0x52, 0x0d, 0x76, 0x6f, 0x74, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x67, 0x0a, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x62, 0x61,
+	0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x61, 0x6e, 0x64,
+	0x42, 0x2d, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x73, 0x22, 0xaa, 0xdf, 0x1f,
+	0x0e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x61, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x63, 0x0a, 0x1a,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x25, 0xf2, 0xde, 0x1f, 0x21, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x52, 0x18, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x12, 0x5a, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x42, 0x27, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde,
+	0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x22, 0xaa, 0xdf, 0x1f, 0x09, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x63, 0x0a,
+	0x0e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
 	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
-	0x6c, 0x64, 0x22, 0x52, 0x0d, 0x76, 0x6f, 0x74, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
-	0x6c, 0x64, 0x12, 0x67, 0x0a, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x62, 0x61, 0x6e,
-	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x61, 0x6e, 0x64, 0x42,
-	0x2d, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x0e,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x61, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0b,
-	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x63, 0x0a, 0x1a, 0x72,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x25, 0xf2, 0xde, 0x1f, 0x21, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77,
-	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x52, 0x18, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x69,
-	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
-	0x12, 0x5a, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x42, 0x27, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f,
-	0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x22, 0xaa, 0xdf, 0x1f, 0x09, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x63, 0x0a, 0x0e,
-	0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x52, 0x0d, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f,
-	0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22,
-	0x52, 0x0b, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x73, 0x0a,
-	0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77,
-	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x42, 0xc8, 0xde, 0x1f,
-	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
-	0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x52,
-	0x11, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x65, 0x72, 0x57, 0x69, 0x6e, 0x64,
-	0x6f, 0x77, 0x12, 0x63, 0x0a, 0x0e, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x42, 0x2a, 0xc8, 0xde,
-	0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x6e, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xaa, 0xdf, 0x1f, 0x09, 0x44,
-	0x65, 0x6e, 0x6f, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0d, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x68, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x69, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63,
-	0x53, 0x74, 0x61, 0x6d, 0x70, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6d,
-	0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e,
-	0x53, 0x74, 0x61, 0x6d, 0x70, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x6d,
-	0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x69, 0x6d,
-	0x75, 0x6d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x32, 0x0a,
-	0x15, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6d, 0x61,
-	0x78, 0x69, 0x6d, 0x75, 0x6d, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x6d, 0x70,
-	0x73, 0x12, 0x9d, 0x01, 0x0a, 0x17, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70,
-	0x61, 0x69, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x0e, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x73, 0x42, 0x43, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x1e, 0x79,
-	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61,
-	0x69, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0xaa, 0xdf, 0x1f,
-	0x19, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x15, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x73, 0x12, 0xb9, 0x01, 0x0a, 0x1d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x64,
-	0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
-	0x6c, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x65,
-	0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
-	0x42, 0x4e, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x24, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x22, 0xaa, 0xdf,
-	0x1f, 0x1e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x1b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x12, 0x24, 0x0a,
-	0x0e, 0x75, 0x73, 0x64, 0x63, 0x5f, 0x69, 0x62, 0x63, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
-	0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x73, 0x64, 0x63, 0x49, 0x62, 0x63, 0x44, 0x65,
-	0x6e, 0x6f, 0x6d, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73,
-	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x29,
-	0x0a, 0x10, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x69, 0x6e, 0x64,
-	0x6f, 0x77, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
-	0x69, 0x6e, 0x67, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x3a, 0x08, 0x98, 0xa0, 0x1f, 0x00, 0xe8,
-	0xa0, 0x1f, 0x01, 0x22, 0xb8, 0x01, 0x0a, 0x05, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x34, 0x0a,
-	0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x15, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x61, 0x73,
-	0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65,
-	0x6e, 0x6f, 0x6d, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x64, 0x65,
-	0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79,
-	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x64, 0x65, 0x6e, 0x6f,
-	0x6d, 0x22, 0x52, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12,
-	0x2f, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x42, 0x13, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x78, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x3a, 0x0c, 0x88, 0xa0, 0x1f, 0x00, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xb2,
-	0x01, 0x0a, 0x0a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x61, 0x6e, 0x64, 0x12, 0x3a, 0x0a,
+	0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x52, 0x0d, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x22, 0x52, 0x0b, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x73,
+	0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x42, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x69, 0x6e, 0x5f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22,
+	0x52, 0x11, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x65, 0x72, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x63, 0x0a, 0x0e, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x79,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x42, 0x2a, 0xc8,
+	0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x6e,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xaa, 0xdf, 0x1f, 0x09,
+	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0d, 0x6d, 0x61, 0x6e, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x69, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69,
+	0x63, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2e, 0x0a, 0x13,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x6e, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x30, 0x0a, 0x14,
+	0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x69,
+	0x6d, 0x75, 0x6d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x32,
+	0x0a, 0x15, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e,
+	0x5f, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6d,
+	0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x6d,
+	0x70, 0x73, 0x12, 0x9d, 0x01, 0x0a, 0x17, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x70, 0x61, 0x69, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x0e,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x42, 0x43, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x1e,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70,
+	0x61, 0x69, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0xaa, 0xdf,
+	0x1f, 0x19, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x15, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x12, 0xb9, 0x01, 0x0a, 0x1d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x44,
+	0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x42, 0x4e, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x24, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x22, 0xaa,
+	0xdf, 0x1f, 0x1e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x76, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x1b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x76, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x12, 0x24,
+	0x0a, 0x0e, 0x75, 0x73, 0x64, 0x63, 0x5f, 0x69, 0x62, 0x63, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x73, 0x64, 0x63, 0x49, 0x62, 0x63, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x29, 0x0a, 0x10, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x61, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x37, 0x0a, 0x18, 0x6d, 0x61,
+	0x78, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x6d, 0x61,
+	0x78, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x15, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x13, 0x70, 0x72, 0x69, 0x63, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x46, 0x0a, 0x10, 0x63, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28,
+	0x04, 0x42, 0x1b, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x22, 0x52, 0x0f,
+	0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x12,
+	0x43, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x63, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x63, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x73, 0x22, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x43, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x17,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x21, 0xf2, 0xde, 0x1f, 0x1d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x61,
+	0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x22, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d,
+	0x52, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x73, 0x0a,
+	0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x73,
+	0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x42,
+	0x32, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d,
+	0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x22, 0xaa,
+	0xdf, 0x1f, 0x10, 0x4d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4d, 0x6f, 0x76,
+	0x65, 0x73, 0x12, 0x76, 0x0a, 0x0f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x6f, 0x76, 0x65, 0x72,
+	0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x42, 0x33, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x16, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x65, 0x73, 0x22, 0xaa, 0xdf, 0x1f, 0x11, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0e, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x76, 0x0a, 0x0f, 0x6f, 0x75,
+	0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x1a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x33, 0xc8,
+	0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6f, 0x75, 0x74,
+	0x6c, 0x69, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0xaa, 0xdf, 0x1f,
+	0x11, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x3a, 0x08, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xb8, 0x01, 0x0a,
+	0x05, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xf2, 0xde, 0x1f, 0x11,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x22, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x3a, 0x0a, 0x0c,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x0b, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x13, 0xf2, 0xde, 0x1f, 0x0f,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22, 0x52,
+	0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x3a, 0x0c, 0x88, 0xa0, 0x1f, 0x00, 0x98,
+	0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xb2, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x42, 0x61, 0x6e, 0x64, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xf2, 0xde,
+	0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x44, 0x65, 0x6e,
+	0x6f, 0x6d, 0x12, 0x5a, 0x0a, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x62, 0x61, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x39, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x12,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x62, 0x61, 0x6e,
+	0x64, 0x22, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x0c,
+	0x88, 0xa0, 0x1f, 0x00, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xab, 0x01, 0x0a,
+	0x0c, 0x4d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x3a, 0x0a,
 	0x0c, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73,
 	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x0b, 0x73, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x5a, 0x0a, 0x0b, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x39,
+	0x6d, 0x62, 0x6f, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x51, 0x0a, 0x08, 0x6d, 0x61, 0x78,
+	0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x6f,
+	0x76, 0x65, 0x22, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x4d, 0x6f, 0x76, 0x65, 0x3a, 0x0c, 0x88, 0xa0,
+	0x1f, 0x00, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xcd, 0x02, 0x0a, 0x0d, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x0c,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x0b, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x63, 0x0a, 0x0e, 0x76, 0x6f, 0x74, 0x65,
+	0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76,
+	0x6f, 0x74, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x52, 0x0d,
+	0x76, 0x6f, 0x74, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a,
+	0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x15, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x69, 0x6e,
+	0x5f, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x22, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x56, 0x6f, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x57, 0x0a, 0x16, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x21, 0xf2, 0xde, 0x1f, 0x1d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76,
+	0x6f, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x69, 0x65, 0x72, 0x22, 0x52, 0x14, 0x76, 0x6f, 0x74, 0x65, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x3a, 0x0c, 0x88, 0xa0,
+	0x1f, 0x00, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x94, 0x03, 0x0a, 0x0d, 0x4f,
+	0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0c,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x0b, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x63, 0x0a, 0x0e, 0x6d, 0x61, 0x64, 0x5f,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d,
+	0x61, 0x64, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x22, 0x52, 0x0d,
+	0x6d, 0x61, 0x64, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x69, 0x0a,
+	0x10, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x17,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x22, 0x52, 0x0f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x69, 0x0a, 0x10, 0x75, 0x70, 0x70, 0x65,
+	0x72, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x3e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c,
+	0x65, 0x22, 0x52, 0x0f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x69, 0x6c, 0x65, 0x3a, 0x0c, 0x88, 0xa0, 0x1f, 0x00, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f,
+	0x00, 0x22, 0xb5, 0x01, 0x0a, 0x1c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x45,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76, 0x6f,
+	0x74, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0f, 0xf2, 0xde, 0x1f, 0x0b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x68, 0x61, 0x73, 0x68,
+	0x22, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x22, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x12,
+	0x3a, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x0c, 0x88, 0xa0, 0x1f,
+	0x00, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xcb, 0x01, 0x0a, 0x19, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x78, 0x0a, 0x0e, 0x65, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x33, 0xc8,
+	0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69,
+	0x6e, 0x73, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x10, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x65,
+	0x72, 0x22, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x3a, 0x0c, 0x88, 0xa0, 0x1f, 0x00, 0x98,
+	0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x6c, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x41, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x22, 0xd6, 0x01, 0x0a, 0x12, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x12, 0x48, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x0c,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x43, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90,
+	0xdf, 0x1f, 0x01, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xde,
+	0x01, 0x0a, 0x12, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x44, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
+	0x67, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x61, 0x67, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x35, 0x0a, 0x03, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x03, 0x61, 0x67,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x6c, 0x74, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x22,
+	0xe6, 0x01, 0x0a, 0x09, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x61, 0x6c, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x12, 0x44, 0x0a, 0x0b, 0x68, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x68,
+	0x61, 0x6c, 0x74, 0x65, 0x64, 0x52, 0x61, 0x74, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xbf, 0x02, 0x0a, 0x06, 0x43, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x37, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12,
+	0x37, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0x52, 0x04, 0x68, 0x69, 0x67, 0x68, 0x12, 0x35, 0x0a, 0x03, 0x6c, 0x6f, 0x77, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x03, 0x6c, 0x6f, 0x77, 0x12,
+	0x39, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23,
 	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
 	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
-	0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x22, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x42, 0x61, 0x6e, 0x64, 0x3a, 0x0c, 0x88, 0xa0, 0x1f, 0x00, 0x98, 0xa0, 0x1f, 0x00, 0xe8,
-	0xa0, 0x1f, 0x00, 0x22, 0xb5, 0x01, 0x0a, 0x1c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
-	0x65, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65,
-	0x76, 0x6f, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0f, 0xf2, 0xde, 0x1f, 0x0b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x68, 0x61,
-	0x73, 0x68, 0x22, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x6f, 0x74,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x22, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65,
-	0x72, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
-	0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x0c, 0x88,
-	0xa0, 0x1f, 0x00, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xcb, 0x01, 0x0a, 0x19,
-	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x78, 0x0a, 0x0e, 0x65, 0x78, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42,
-	0x33, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43,
-	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61,
-	0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x10, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f,
-	0x74, 0x65, 0x72, 0x22, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x3a, 0x0c, 0x88, 0xa0, 0x1f,
-	0x00, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x6c, 0x0a, 0x0a, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x41, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x0c, 0x65, 0x78,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x22, 0x53, 0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x65, 0x74, 0x12, 0x3d, 0x0a,
-	0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x22, 0x52, 0x0c,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x22, 0xa8, 0x02, 0x0a,
-	0x15, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64,
-	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xf2, 0xde, 0x1f, 0x11,
-	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
-	0x22, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x37, 0x0a, 0x0b,
-	0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x16, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x71, 0x75, 0x6f,
-	0x74, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x65,
-	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x5e, 0x0a, 0x0c, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42, 0x1b, 0xc8, 0xde, 0x1f,
-	0x00, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x61, 0x69, 0x72, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x0b, 0x70, 0x61, 0x69, 0x72, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x52, 0x09,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x3a, 0x0c, 0x88, 0xa0, 0x1f, 0x00, 0x98,
-	0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x8b, 0x01, 0x0a, 0x13, 0x50, 0x61, 0x69, 0x72,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
-	0x2c, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x12, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a,
-	0x10, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x22, 0x52, 0x0f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x94, 0x01, 0x0a, 0x1a, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73,
-	0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e,
-	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52,
-	0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x32, 0x0a, 0x09, 0x74, 0x68,
-	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xf2,
-	0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
-	0x6c, 0x64, 0x22, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x3a, 0x0c,
-	0x88, 0xa0, 0x1f, 0x00, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x7e, 0x0a, 0x0f,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12,
-	0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b,
-	0x65, 0x79, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x3a, 0x0c,
-	0x88, 0xa0, 0x1f, 0x00, 0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0x9c, 0x01, 0x0a,
-	0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b,
-	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x61, 0x65, 0x76, 0x4d, 0x69,
-	0x74, 0x68, 0x72, 0x61, 0x6e, 0x2f, 0x64, 0x6d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x58, 0x58, 0xaa, 0x02, 0x09, 0x4f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x09, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x15, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x4f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0xc8, 0xe1, 0x1e, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x44, 0x65, 0x63, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x22, 0x98, 0x04, 0x0a, 0x0f, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x61,
+	0x6d, 0x70, 0x73, 0x12, 0x35, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x35, 0x0a, 0x03, 0x6d, 0x61,
+	0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x03, 0x6d, 0x61,
+	0x78, 0x12, 0x37, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x12, 0x3b, 0x0a, 0x06, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52,
+	0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x12, 0x52, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x6e, 0x64,
+	0x61, 0x72, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x11, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61,
+	0x72, 0x64, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x54, 0x0a, 0x13, 0x72,
+	0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x12, 0x72,
+	0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x56, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x42, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69,
+	0x6c, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x6c, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x53, 0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x65, 0x74, 0x12, 0x3d, 0x0a, 0x0d, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x18, 0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x22, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x22, 0xa8, 0x02, 0x0a, 0x15, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x09, 0x62,
+	0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x37, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xf2,
+	0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x12, 0x5e, 0x0a, 0x0c, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42, 0x1b, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f,
+	0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0x52, 0x0b, 0x70, 0x61, 0x69, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x32, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x3a, 0x0c, 0x88, 0xa0, 0x1f, 0x00, 0x98, 0xa0, 0x1f, 0x00, 0xe8,
+	0xa0, 0x1f, 0x00, 0x22, 0x8b, 0x01, 0x0a, 0x13, 0x50, 0x61, 0x69, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xf2, 0xde,
+	0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x10, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1b, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22,
+	0x52, 0x0f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x22, 0x94, 0x01, 0x0a, 0x1a, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x65,
+	0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x12, 0x34, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x09, 0x62, 0x61, 0x73,
+	0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x32, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x52,
+	0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x3a, 0x0c, 0x88, 0xa0, 0x1f, 0x00,
+	0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x7e, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b,
+	0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x3a, 0x0c, 0x88, 0xa0, 0x1f, 0x00,
+	0x98, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x2a, 0xb0, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x25, 0x0a, 0x21, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x53,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23, 0x50, 0x52, 0x49, 0x43, 0x45,
+	0x5f, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x52, 0x45, 0x56, 0x45, 0x41, 0x4c, 0x10, 0x01,
+	0x12, 0x29, 0x0a, 0x25, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x53,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x45,
+	0x58, 0x54, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x50,
+	0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x42, 0x4f, 0x54, 0x48, 0x10, 0x03, 0x2a, 0x85, 0x01, 0x0a, 0x0b,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x50,
+	0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x49,
+	0x43, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53, 0x50, 0x4f, 0x54, 0x10, 0x01,
+	0x12, 0x14, 0x0a, 0x10, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x53, 0x4d, 0x41, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x45, 0x4d, 0x41, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11,
+	0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x57, 0x41,
+	0x50, 0x10, 0x04, 0x42, 0x9c, 0x01, 0xc8, 0xe1, 0x1e, 0x00, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x61, 0x65, 0x76, 0x4d, 0x69, 0x74, 0x68, 0x72, 0x61, 0x6e,
+	0x2f, 0x64, 0x6d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x4f, 0x58, 0x58, 0xaa, 0x02, 0x09, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56,
+	0x31, 0xca, 0x02, 0x09, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x15,
+	0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -8070,36 +15770,58 @@ func file_oracle_v1_oracle_proto_rawDescGZIP() []byte {
 	return file_oracle_v1_oracle_proto_rawDescData
 }
 
-var file_oracle_v1_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_oracle_v1_oracle_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_oracle_v1_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_oracle_v1_oracle_proto_goTypes = []interface{}{
-	(*Params)(nil),                       // 0: oracle.v1.Params
-	(*Denom)(nil),                        // 1: oracle.v1.Denom
-	(*RewardBand)(nil),                   // 2: oracle.v1.RewardBand
-	(*AggregateExchangeRatePrevote)(nil), // 3: oracle.v1.AggregateExchangeRatePrevote
-	(*AggregateExchangeRateVote)(nil),    // 4: oracle.v1.AggregateExchangeRateVote
-	(*PriceStamp)(nil),                   // 5: oracle.v1.PriceStamp
-	(*ValidatorRewardSet)(nil),           // 6: oracle.v1.ValidatorRewardSet
-	(*CurrencyPairProviders)(nil),        // 7: oracle.v1.CurrencyPairProviders
-	(*PairAddressProvider)(nil),          // 8: oracle.v1.PairAddressProvider
-	(*CurrencyDeviationThreshold)(nil),   // 9: oracle.v1.CurrencyDeviationThreshold
-	(*ParamUpdatePlan)(nil),              // 10: oracle.v1.ParamUpdatePlan
-	(*v1beta1.DecCoin)(nil),              // 11: cosmos.base.v1beta1.DecCoin
+	(PriceSubmissionMode)(0),             // 0: oracle.v1.PriceSubmissionMode
+	(PriceSource)(0),                     // 1: oracle.v1.PriceSource
+	(*Params)(nil),                       // 2: oracle.v1.Params
+	(*Denom)(nil),                        // 3: oracle.v1.Denom
+	(*RewardBand)(nil),                   // 4: oracle.v1.RewardBand
+	(*MaxPriceMove)(nil),                 // 5: oracle.v1.MaxPriceMove
+	(*DenomOverride)(nil),                // 6: oracle.v1.DenomOverride
+	(*OutlierFilter)(nil),                // 7: oracle.v1.OutlierFilter
+	(*AggregateExchangeRatePrevote)(nil), // 8: oracle.v1.AggregateExchangeRatePrevote
+	(*AggregateExchangeRateVote)(nil),    // 9: oracle.v1.AggregateExchangeRateVote
+	(*PriceStamp)(nil),                   // 10: oracle.v1.PriceStamp
+	(*ExchangeRateUpdate)(nil),           // 11: oracle.v1.ExchangeRateUpdate
+	(*ExchangeRateStatus)(nil),           // 12: oracle.v1.ExchangeRateStatus
+	(*PriceHalt)(nil),                    // 13: oracle.v1.PriceHalt
+	(*Candle)(nil),                       // 14: oracle.v1.Candle
+	(*PriceStatistics)(nil),              // 15: oracle.v1.PriceStatistics
+	(*PricePercentile)(nil),              // 16: oracle.v1.PricePercentile
+	(*ValidatorRewardSet)(nil),           // 17: oracle.v1.ValidatorRewardSet
+	(*CurrencyPairProviders)(nil),        // 18: oracle.v1.CurrencyPairProviders
+	(*PairAddressProvider)(nil),          // 19: oracle.v1.PairAddressProvider
+	(*CurrencyDeviationThreshold)(nil),   // 20: oracle.v1.CurrencyDeviationThreshold
+	(*ParamUpdatePlan)(nil),              // 21: oracle.v1.ParamUpdatePlan
+	(*v1beta1.DecCoin)(nil),              // 22: cosmos.base.v1beta1.DecCoin
+	(*timestamppb.Timestamp)(nil),        // 23: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),          // 24: google.protobuf.Duration
 }
 var file_oracle_v1_oracle_proto_depIdxs = []int32{
-	2,  // 0: oracle.v1.Params.reward_bands:type_name -> oracle.v1.RewardBand
-	1,  // 1: oracle.v1.Params.accept_list:type_name -> oracle.v1.Denom
-	1,  // 2: oracle.v1.Params.mandatory_list:type_name -> oracle.v1.Denom
-	7,  // 3: oracle.v1.Params.currency_pair_providers:type_name -> oracle.v1.CurrencyPairProviders
-	9,  // 4: oracle.v1.Params.currency_deviation_thresholds:type_name -> oracle.v1.CurrencyDeviationThreshold
-	11, // 5: oracle.v1.AggregateExchangeRateVote.exchange_rates:type_name -> cosmos.base.v1beta1.DecCoin
-	11, // 6: oracle.v1.PriceStamp.exchange_rate:type_name -> cosmos.base.v1beta1.DecCoin
-	8,  // 7: oracle.v1.CurrencyPairProviders.pair_address:type_name -> oracle.v1.PairAddressProvider
-	0,  // 8: oracle.v1.ParamUpdatePlan.changes:type_name -> oracle.v1.Params
-	9,  // [9:9] is the sub-list for method output_type
-	9,  // [9:9] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	4,  // 0: oracle.v1.Params.reward_bands:type_name -> oracle.v1.RewardBand
+	3,  // 1: oracle.v1.Params.accept_list:type_name -> oracle.v1.Denom
+	3,  // 2: oracle.v1.Params.mandatory_list:type_name -> oracle.v1.Denom
+	18, // 3: oracle.v1.Params.currency_pair_providers:type_name -> oracle.v1.CurrencyPairProviders
+	20, // 4: oracle.v1.Params.currency_deviation_thresholds:type_name -> oracle.v1.CurrencyDeviationThreshold
+	0,  // 5: oracle.v1.Params.price_submission_mode:type_name -> oracle.v1.PriceSubmissionMode
+	5,  // 6: oracle.v1.Params.max_price_moves:type_name -> oracle.v1.MaxPriceMove
+	6,  // 7: oracle.v1.Params.denom_overrides:type_name -> oracle.v1.DenomOverride
+	7,  // 8: oracle.v1.Params.outlier_filters:type_name -> oracle.v1.OutlierFilter
+	22, // 9: oracle.v1.AggregateExchangeRateVote.exchange_rates:type_name -> cosmos.base.v1beta1.DecCoin
+	22, // 10: oracle.v1.PriceStamp.exchange_rate:type_name -> cosmos.base.v1beta1.DecCoin
+	23, // 11: oracle.v1.ExchangeRateUpdate.block_time:type_name -> google.protobuf.Timestamp
+	11, // 12: oracle.v1.ExchangeRateStatus.last_update:type_name -> oracle.v1.ExchangeRateUpdate
+	24, // 13: oracle.v1.ExchangeRateStatus.age:type_name -> google.protobuf.Duration
+	16, // 14: oracle.v1.PriceStatistics.percentiles:type_name -> oracle.v1.PricePercentile
+	19, // 15: oracle.v1.CurrencyPairProviders.pair_address:type_name -> oracle.v1.PairAddressProvider
+	2,  // 16: oracle.v1.ParamUpdatePlan.changes:type_name -> oracle.v1.Params
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_oracle_v1_oracle_proto_init() }
@@ -8145,7 +15867,7 @@ func file_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_oracle_v1_oracle_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AggregateExchangeRatePrevote); i {
+			switch v := v.(*MaxPriceMove); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8157,7 +15879,7 @@ func file_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_oracle_v1_oracle_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AggregateExchangeRateVote); i {
+			switch v := v.(*DenomOverride); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8169,7 +15891,7 @@ func file_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_oracle_v1_oracle_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PriceStamp); i {
+			switch v := v.(*OutlierFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8181,7 +15903,7 @@ func file_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_oracle_v1_oracle_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorRewardSet); i {
+			switch v := v.(*AggregateExchangeRatePrevote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8193,7 +15915,7 @@ func file_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_oracle_v1_oracle_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CurrencyPairProviders); i {
+			switch v := v.(*AggregateExchangeRateVote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8205,7 +15927,7 @@ func file_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_oracle_v1_oracle_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PairAddressProvider); i {
+			switch v := v.(*PriceStamp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8217,7 +15939,7 @@ func file_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_oracle_v1_oracle_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CurrencyDeviationThreshold); i {
+			switch v := v.(*ExchangeRateUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8229,6 +15951,114 @@ func file_oracle_v1_oracle_proto_init() {
 			}
 		}
 		file_oracle_v1_oracle_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExchangeRateStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_v1_oracle_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceHalt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_v1_oracle_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Candle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_v1_oracle_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_v1_oracle_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PricePercentile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_v1_oracle_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorRewardSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_v1_oracle_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrencyPairProviders); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_v1_oracle_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairAddressProvider); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_v1_oracle_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrencyDeviationThreshold); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_v1_oracle_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ParamUpdatePlan); i {
 			case 0:
 				return &v.state
@@ -8246,13 +16076,14 @@ func file_oracle_v1_oracle_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_oracle_v1_oracle_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      2,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_oracle_v1_oracle_proto_goTypes,
 		DependencyIndexes: file_oracle_v1_oracle_proto_depIdxs,
+		EnumInfos:         file_oracle_v1_oracle_proto_enumTypes,
 		MessageInfos:      file_oracle_v1_oracle_proto_msgTypes,
 	}.Build()
 	File_oracle_v1_oracle_proto = out.File
@@ -35,6 +35,14 @@ const (
 	Query_EMA_FullMethodName                 = "/oracle.v1.Query/EMA"
 	Query_WMA_FullMethodName                 = "/oracle.v1.Query/WMA"
 	Query_SMA_FullMethodName                 = "/oracle.v1.Query/SMA"
+	Query_TWAP_FullMethodName                = "/oracle.v1.Query/TWAP"
+	Query_HistoricPrices_FullMethodName      = "/oracle.v1.Query/HistoricPrices"
+	Query_Candles_FullMethodName             = "/oracle.v1.Query/Candles"
+	Query_PriceStatistics_FullMethodName     = "/oracle.v1.Query/PriceStatistics"
+	Query_CrossRate_FullMethodName           = "/oracle.v1.Query/CrossRate"
+	Query_LastExchangeRate_FullMethodName    = "/oracle.v1.Query/LastExchangeRate"
+	Query_LastExchangeRates_FullMethodName   = "/oracle.v1.Query/LastExchangeRates"
+	Query_PriceHalts_FullMethodName          = "/oracle.v1.Query/PriceHalts"
 )
 
 // QueryClient is the client API for Query service.
@@ -75,6 +83,29 @@ type QueryClient interface {
 	EMA(ctx context.Context, in *QueryEMARequest, opts ...grpc.CallOption) (*QueryEMAResponse, error)
 	WMA(ctx context.Context, in *QueryWMARequest, opts ...grpc.CallOption) (*QueryWMAResponse, error)
 	SMA(ctx context.Context, in *QuerySMARequest, opts ...grpc.CallOption) (*QuerySMAResponse, error)
+	// TWAP returns the block-time-weighted average of the historic prices of a
+	// denom over a window ending at a given height
+	TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error)
+	// HistoricPrices returns a page of the historic prices of a denom,
+	// optionally within a range of blocks
+	HistoricPrices(ctx context.Context, in *QueryHistoricPricesRequest, opts ...grpc.CallOption) (*QueryHistoricPricesResponse, error)
+	// Candles returns a page of the OHLC candles of a denom for a candle
+	// interval
+	Candles(ctx context.Context, in *QueryCandlesRequest, opts ...grpc.CallOption) (*QueryCandlesResponse, error)
+	// PriceStatistics returns the statistics of the latest historic prices of
+	// a denom
+	PriceStatistics(ctx context.Context, in *QueryPriceStatisticsRequest, opts ...grpc.CallOption) (*QueryPriceStatisticsResponse, error)
+	// CrossRate returns the rate of a base denom in a quote denom derived from
+	// their USD rates
+	CrossRate(ctx context.Context, in *QueryCrossRateRequest, opts ...grpc.CallOption) (*QueryCrossRateResponse, error)
+	// LastExchangeRate returns the last exchange rate of a denom with its age,
+	// including a stale one
+	LastExchangeRate(ctx context.Context, in *QueryLastExchangeRateRequest, opts ...grpc.CallOption) (*QueryLastExchangeRateResponse, error)
+	// LastExchangeRates returns the last exchange rates of all denoms with
+	// their ages, including stale ones
+	LastExchangeRates(ctx context.Context, in *QueryLastExchangeRatesRequest, opts ...grpc.CallOption) (*QueryLastExchangeRatesResponse, error)
+	// PriceHalts returns the tripped circuit breakers of all denoms
+	PriceHalts(ctx context.Context, in *QueryPriceHaltsRequest, opts ...grpc.CallOption) (*QueryPriceHaltsResponse, error)
 }
 
 type queryClient struct {
@@ -229,6 +260,78 @@ func (c *queryClient) SMA(ctx context.Context, in *QuerySMARequest, opts ...grpc
 	return out, nil
 }
 
+func (c *queryClient) TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error) {
+	out := new(QueryTWAPResponse)
+	err := c.cc.Invoke(ctx, Query_TWAP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HistoricPrices(ctx context.Context, in *QueryHistoricPricesRequest, opts ...grpc.CallOption) (*QueryHistoricPricesResponse, error) {
+	out := new(QueryHistoricPricesResponse)
+	err := c.cc.Invoke(ctx, Query_HistoricPrices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Candles(ctx context.Context, in *QueryCandlesRequest, opts ...grpc.CallOption) (*QueryCandlesResponse, error) {
+	out := new(QueryCandlesResponse)
+	err := c.cc.Invoke(ctx, Query_Candles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceStatistics(ctx context.Context, in *QueryPriceStatisticsRequest, opts ...grpc.CallOption) (*QueryPriceStatisticsResponse, error) {
+	out := new(QueryPriceStatisticsResponse)
+	err := c.cc.Invoke(ctx, Query_PriceStatistics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CrossRate(ctx context.Context, in *QueryCrossRateRequest, opts ...grpc.CallOption) (*QueryCrossRateResponse, error) {
+	out := new(QueryCrossRateResponse)
+	err := c.cc.Invoke(ctx, Query_CrossRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LastExchangeRate(ctx context.Context, in *QueryLastExchangeRateRequest, opts ...grpc.CallOption) (*QueryLastExchangeRateResponse, error) {
+	out := new(QueryLastExchangeRateResponse)
+	err := c.cc.Invoke(ctx, Query_LastExchangeRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LastExchangeRates(ctx context.Context, in *QueryLastExchangeRatesRequest, opts ...grpc.CallOption) (*QueryLastExchangeRatesResponse, error) {
+	out := new(QueryLastExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, Query_LastExchangeRates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceHalts(ctx context.Context, in *QueryPriceHaltsRequest, opts ...grpc.CallOption) (*QueryPriceHaltsResponse, error) {
+	out := new(QueryPriceHaltsResponse)
+	err := c.cc.Invoke(ctx, Query_PriceHalts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -267,6 +370,29 @@ type QueryServer interface {
 	EMA(context.Context, *QueryEMARequest) (*QueryEMAResponse, error)
 	WMA(context.Context, *QueryWMARequest) (*QueryWMAResponse, error)
 	SMA(context.Context, *QuerySMARequest) (*QuerySMAResponse, error)
+	// TWAP returns the block-time-weighted average of the historic prices of a
+	// denom over a window ending at a given height
+	TWAP(context.Context, *QueryTWAPRequest) (*QueryTWAPResponse, error)
+	// HistoricPrices returns a page of the historic prices of a denom,
+	// optionally within a range of blocks
+	HistoricPrices(context.Context, *QueryHistoricPricesRequest) (*QueryHistoricPricesResponse, error)
+	// Candles returns a page of the OHLC candles of a denom for a candle
+	// interval
+	Candles(context.Context, *QueryCandlesRequest) (*QueryCandlesResponse, error)
+	// PriceStatistics returns the statistics of the latest historic prices of
+	// a denom
+	PriceStatistics(context.Context, *QueryPriceStatisticsRequest) (*QueryPriceStatisticsResponse, error)
+	// CrossRate returns the rate of a base denom in a quote denom derived from
+	// their USD rates
+	CrossRate(context.Context, *QueryCrossRateRequest) (*QueryCrossRateResponse, error)
+	// LastExchangeRate returns the last exchange rate of a denom with its age,
+	// including a stale one
+	LastExchangeRate(context.Context, *QueryLastExchangeRateRequest) (*QueryLastExchangeRateResponse, error)
+	// LastExchangeRates returns the last exchange rates of all denoms with
+	// their ages, including stale ones
+	LastExchangeRates(context.Context, *QueryLastExchangeRatesRequest) (*QueryLastExchangeRatesResponse, error)
+	// PriceHalts returns the tripped circuit breakers of all denoms
+	PriceHalts(context.Context, *QueryPriceHaltsRequest) (*QueryPriceHaltsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -322,6 +448,30 @@ func (UnimplementedQueryServer) WMA(context.Context, *QueryWMARequest) (*QueryWM
 func (UnimplementedQueryServer) SMA(context.Context, *QuerySMARequest) (*QuerySMAResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SMA not implemented")
 }
+func (UnimplementedQueryServer) TWAP(context.Context, *QueryTWAPRequest) (*QueryTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TWAP not implemented")
+}
+func (UnimplementedQueryServer) HistoricPrices(context.Context, *QueryHistoricPricesRequest) (*QueryHistoricPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricPrices not implemented")
+}
+func (UnimplementedQueryServer) Candles(context.Context, *QueryCandlesRequest) (*QueryCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Candles not implemented")
+}
+func (UnimplementedQueryServer) PriceStatistics(context.Context, *QueryPriceStatisticsRequest) (*QueryPriceStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceStatistics not implemented")
+}
+func (UnimplementedQueryServer) CrossRate(context.Context, *QueryCrossRateRequest) (*QueryCrossRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrossRate not implemented")
+}
+func (UnimplementedQueryServer) LastExchangeRate(context.Context, *QueryLastExchangeRateRequest) (*QueryLastExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastExchangeRate not implemented")
+}
+func (UnimplementedQueryServer) LastExchangeRates(context.Context, *QueryLastExchangeRatesRequest) (*QueryLastExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastExchangeRates not implemented")
+}
+func (UnimplementedQueryServer) PriceHalts(context.Context, *QueryPriceHaltsRequest) (*QueryPriceHaltsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceHalts not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -623,6 +773,150 @@ func _Query_SMA_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TWAP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TWAP(ctx, req.(*QueryTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HistoricPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_HistoricPrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricPrices(ctx, req.(*QueryHistoricPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Candles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCandlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Candles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Candles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Candles(ctx, req.(*QueryCandlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceStatistics(ctx, req.(*QueryPriceStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CrossRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCrossRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CrossRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CrossRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CrossRate(ctx, req.(*QueryCrossRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LastExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LastExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastExchangeRate(ctx, req.(*QueryLastExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LastExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LastExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastExchangeRates(ctx, req.(*QueryLastExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceHalts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceHaltsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceHalts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceHalts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceHalts(ctx, req.(*QueryPriceHaltsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -694,6 +988,38 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SMA",
 			Handler:    _Query_SMA_Handler,
 		},
+		{
+			MethodName: "TWAP",
+			Handler:    _Query_TWAP_Handler,
+		},
+		{
+			MethodName: "HistoricPrices",
+			Handler:    _Query_HistoricPrices_Handler,
+		},
+		{
+			MethodName: "Candles",
+			Handler:    _Query_Candles_Handler,
+		},
+		{
+			MethodName: "PriceStatistics",
+			Handler:    _Query_PriceStatistics_Handler,
+		},
+		{
+			MethodName: "CrossRate",
+			Handler:    _Query_CrossRate_Handler,
+		},
+		{
+			MethodName: "LastExchangeRate",
+			Handler:    _Query_LastExchangeRate_Handler,
+		},
+		{
+			MethodName: "LastExchangeRates",
+			Handler:    _Query_LastExchangeRates_Handler,
+		},
+		{
+			MethodName: "PriceHalts",
+			Handler:    _Query_PriceHalts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "oracle/v1/query.proto",
@@ -28,6 +28,8 @@ const (
 	Msg_GovRemoveCurrencyPairProviders_FullMethodName       = "/oracle.v1.Msg/GovRemoveCurrencyPairProviders"
 	Msg_GovRemoveCurrencyDeviationThresholds_FullMethodName = "/oracle.v1.Msg/GovRemoveCurrencyDeviationThresholds"
 	Msg_GovCancelUpdateParamPlan_FullMethodName             = "/oracle.v1.Msg/GovCancelUpdateParamPlan"
+	Msg_GovResetCircuitBreakers_FullMethodName              = "/oracle.v1.Msg/GovResetCircuitBreakers"
+	Msg_GovUpdateDenomOverrides_FullMethodName              = "/oracle.v1.Msg/GovUpdateDenomOverrides"
 )
 
 // MsgClient is the client API for Msg service.
@@ -56,6 +58,11 @@ type MsgClient interface {
 	GovRemoveCurrencyDeviationThresholds(ctx context.Context, in *MsgGovRemoveCurrencyDeviationThresholds, opts ...grpc.CallOption) (*MsgGovRemoveCurrencyDeviationThresholdsResponse, error)
 	// GovCancelUpdateParamPlan cancels a plan to update the oracle parameters.
 	GovCancelUpdateParamPlan(ctx context.Context, in *MsgGovCancelUpdateParamPlan, opts ...grpc.CallOption) (*MsgGovCancelUpdateParamPlanResponse, error)
+	// GovResetCircuitBreakers resets the tripped circuit breakers of denoms.
+	GovResetCircuitBreakers(ctx context.Context, in *MsgGovResetCircuitBreakers, opts ...grpc.CallOption) (*MsgGovResetCircuitBreakersResponse, error)
+	// GovUpdateDenomOverrides updates the oracle parameters to set or remove
+	// denom overrides.
+	GovUpdateDenomOverrides(ctx context.Context, in *MsgGovUpdateDenomOverrides, opts ...grpc.CallOption) (*MsgGovUpdateDenomOverridesResponse, error)
 }
 
 type msgClient struct {
@@ -147,6 +154,24 @@ func (c *msgClient) GovCancelUpdateParamPlan(ctx context.Context, in *MsgGovCanc
 	return out, nil
 }
 
+func (c *msgClient) GovResetCircuitBreakers(ctx context.Context, in *MsgGovResetCircuitBreakers, opts ...grpc.CallOption) (*MsgGovResetCircuitBreakersResponse, error) {
+	out := new(MsgGovResetCircuitBreakersResponse)
+	err := c.cc.Invoke(ctx, Msg_GovResetCircuitBreakers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GovUpdateDenomOverrides(ctx context.Context, in *MsgGovUpdateDenomOverrides, opts ...grpc.CallOption) (*MsgGovUpdateDenomOverridesResponse, error) {
+	out := new(MsgGovUpdateDenomOverridesResponse)
+	err := c.cc.Invoke(ctx, Msg_GovUpdateDenomOverrides_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -173,6 +198,11 @@ type MsgServer interface {
 	GovRemoveCurrencyDeviationThresholds(context.Context, *MsgGovRemoveCurrencyDeviationThresholds) (*MsgGovRemoveCurrencyDeviationThresholdsResponse, error)
 	// GovCancelUpdateParamPlan cancels a plan to update the oracle parameters.
 	GovCancelUpdateParamPlan(context.Context, *MsgGovCancelUpdateParamPlan) (*MsgGovCancelUpdateParamPlanResponse, error)
+	// GovResetCircuitBreakers resets the tripped circuit breakers of denoms.
+	GovResetCircuitBreakers(context.Context, *MsgGovResetCircuitBreakers) (*MsgGovResetCircuitBreakersResponse, error)
+	// GovUpdateDenomOverrides updates the oracle parameters to set or remove
+	// denom overrides.
+	GovUpdateDenomOverrides(context.Context, *MsgGovUpdateDenomOverrides) (*MsgGovUpdateDenomOverridesResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -207,6 +237,12 @@ func (UnimplementedMsgServer) GovRemoveCurrencyDeviationThresholds(context.Conte
 func (UnimplementedMsgServer) GovCancelUpdateParamPlan(context.Context, *MsgGovCancelUpdateParamPlan) (*MsgGovCancelUpdateParamPlanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GovCancelUpdateParamPlan not implemented")
 }
+func (UnimplementedMsgServer) GovResetCircuitBreakers(context.Context, *MsgGovResetCircuitBreakers) (*MsgGovResetCircuitBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovResetCircuitBreakers not implemented")
+}
+func (UnimplementedMsgServer) GovUpdateDenomOverrides(context.Context, *MsgGovUpdateDenomOverrides) (*MsgGovUpdateDenomOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovUpdateDenomOverrides not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -382,6 +418,42 @@ func _Msg_GovCancelUpdateParamPlan_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GovResetCircuitBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovResetCircuitBreakers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GovResetCircuitBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GovResetCircuitBreakers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovResetCircuitBreakers(ctx, req.(*MsgGovResetCircuitBreakers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GovUpdateDenomOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovUpdateDenomOverrides)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GovUpdateDenomOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GovUpdateDenomOverrides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovUpdateDenomOverrides(ctx, req.(*MsgGovUpdateDenomOverrides))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -425,6 +497,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GovCancelUpdateParamPlan",
 			Handler:    _Msg_GovCancelUpdateParamPlan_Handler,
 		},
+		{
+			MethodName: "GovResetCircuitBreakers",
+			Handler:    _Msg_GovResetCircuitBreakers_Handler,
+		},
+		{
+			MethodName: "GovUpdateDenomOverrides",
+			Handler:    _Msg_GovUpdateDenomOverrides_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "oracle/v1/tx.proto",
@@ -1,7 +1,9 @@
 package app
 
 import (
+	"context"
 	"errors"
+	"math"
 
 	ibcante "github.com/cosmos/ibc-go/v8/modules/core/ante"
 	"github.com/cosmos/ibc-go/v8/modules/core/keeper"
@@ -9,17 +11,29 @@ import (
 	circuitante "cosmossdk.io/x/circuit/ante"
 	circuitkeeper "cosmossdk.io/x/circuit/keeper"
 
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 )
 
+// MultisigKeeper defines the multisig keeper methods used to pay the fees of signers from
+// their multisig accounts.
+type MultisigKeeper interface {
+	FeeSponsor(ctx context.Context, msgs []sdk.Msg) (sdk.AccAddress, bool)
+	DeductFeeFromBudget(ctx context.Context, multisig sdk.AccAddress, fee sdk.Coins) error
+}
+
 // HandlerOptions extend the SDK's AnteHandler options by requiring the IBC
 // channel keeper.
 type HandlerOptions struct {
 	ante.HandlerOptions
 
-	IBCKeeper     *keeper.Keeper
-	CircuitKeeper *circuitkeeper.Keeper
+	IBCKeeper      *keeper.Keeper
+	CircuitKeeper  *circuitkeeper.Keeper
+	MultisigKeeper MultisigKeeper
 
 	BypassMinFeeMsgTypes []string
 }
@@ -38,6 +52,9 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	if options.CircuitKeeper == nil {
 		return nil, errors.New("circuit keeper is required for ante builder")
 	}
+	if options.MultisigKeeper == nil {
+		return nil, errors.New("multisig keeper is required for ante builder")
+	}
 
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
@@ -47,7 +64,11 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		NewMultisigFeeDecorator(
+			options.MultisigKeeper,
+			ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+			options.TxFeeChecker,
+		),
 		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
@@ -58,3 +79,115 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
 }
+
+// MultisigFeeDecorator deducts the fees of txs that only approve or execute proposals of a
+// multisig account from that account, so that its signers can operate with empty wallets.
+// The fees are paid within the fee budget of the multisig module params. Other txs, txs
+// with a fee granter and txs beyond the budget or balance of the multisig account are
+// handled by the wrapped DeductFeeDecorator.
+type MultisigFeeDecorator struct {
+	multisigKeeper MultisigKeeper
+	deductFee      ante.DeductFeeDecorator
+	txFeeChecker   ante.TxFeeChecker
+}
+
+// NewMultisigFeeDecorator constructor
+func NewMultisigFeeDecorator(mk MultisigKeeper, dfd ante.DeductFeeDecorator, tfc ante.TxFeeChecker) MultisigFeeDecorator {
+	if tfc == nil {
+		tfc = checkTxFeeWithValidatorMinGasPrices
+	}
+
+	return MultisigFeeDecorator{
+		multisigKeeper: mk,
+		deductFee:      dfd,
+		txFeeChecker:   tfc,
+	}
+}
+
+func (mfd MultisigFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.FeeGranter() != nil {
+		return mfd.deductFee.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	multisig, ok := mfd.multisigKeeper.FeeSponsor(ctx, tx.GetMsgs())
+	if !ok {
+		return mfd.deductFee.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	if !simulate && ctx.BlockHeight() > 0 && feeTx.GetGas() == 0 {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidGasLimit, "must provide positive gas")
+	}
+
+	var (
+		priority int64
+		err      error
+	)
+
+	fee := feeTx.GetFee()
+	if !simulate {
+		fee, priority, err = mfd.txFeeChecker(ctx, tx)
+		if err != nil {
+			return ctx, err
+		}
+	}
+
+	// the fee payer pays when the multisig account can not
+	cacheCtx, write := ctx.CacheContext()
+	if err := mfd.multisigKeeper.DeductFeeFromBudget(cacheCtx, multisig, fee); err != nil {
+		return mfd.deductFee.AnteHandle(ctx, tx, simulate, next)
+	}
+	write()
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeTx,
+		sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+		sdk.NewAttribute(sdk.AttributeKeyFeePayer, multisig.String()),
+	))
+
+	return next(ctx.WithPriority(priority), tx, simulate)
+}
+
+// checkTxFeeWithValidatorMinGasPrices mirrors the default fee checker of the SDK
+// DeductFeeDecorator, which is not exported.
+func checkTxFeeWithValidatorMinGasPrices(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	feeCoins := feeTx.GetFee()
+	gas := feeTx.GetGas()
+
+	if ctx.IsCheckTx() {
+		minGasPrices := ctx.MinGasPrices()
+		if !minGasPrices.IsZero() {
+			requiredFees := make(sdk.Coins, len(minGasPrices))
+
+			glDec := sdkmath.LegacyNewDec(int64(gas))
+			for i, gp := range minGasPrices {
+				fee := gp.Amount.Mul(glDec)
+				requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+			}
+
+			if !feeCoins.IsAnyGTE(requiredFees) {
+				return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+			}
+		}
+	}
+
+	// the priority is the smallest gas price among the fee coins
+	var priority int64
+	for _, c := range feeCoins {
+		p := int64(math.MaxInt64)
+		gasPrice := c.Amount.QuoRaw(int64(gas))
+		if gasPrice.IsInt64() {
+			p = gasPrice.Int64()
+		}
+		if priority == 0 || p < priority {
+			priority = p
+		}
+	}
+
+	return feeCoins, priority, nil
+}
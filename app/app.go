@@ -190,7 +190,8 @@ var maccPerms = map[string][]string{
 	ibctransfertypes.ModuleName: {authtypes.Minter, authtypes.Burner},
 	ibcfeetypes.ModuleName:      nil,
 	icatypes.ModuleName:         nil,
-	multisigtypes.ModuleName:    nil,
+	multisigtypes.ModuleName:    {authtypes.Burner},
+	oracletypes.ModuleName:      nil,
 }
 
 var (
@@ -257,6 +258,10 @@ type ChainApp struct {
 	// module configurator
 	configurator module.Configurator
 	once         sync.Once
+
+	// txs of the block being finalized, including the injected vote extension tx which is
+	// dropped from the txs executed by BaseApp
+	blockTxs [][]byte
 }
 
 // NewChainApp returns a reference to an initialized ChainApp.
@@ -291,7 +296,7 @@ func NewChainApp(
 
 	baseAppOptions = append(baseAppOptions, baseapp.SetOptimisticExecution())
 
-	bApp := baseapp.NewBaseApp(appName, logger, db, txConfig.TxDecoder(), baseAppOptions...)
+	bApp := baseapp.NewBaseApp(appName, logger, db, oracleabci.NewTxDecoder(txConfig.TxDecoder()), baseAppOptions...)
 	bApp.SetCommitMultiStoreTracer(traceStore)
 	bApp.SetVersion(version.Version)
 	bApp.SetInterfaceRegistry(interfaceRegistry)
@@ -582,17 +587,6 @@ func NewChainApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
-	// Create the multisig Keeper
-	app.MultisigKeeper = multisigkeeper.NewKeeper(
-		appCodec,
-		app.AccountKeeper.AddressCodec(),
-		app.MsgServiceRouter(),
-		runtime.NewKVStoreService(keys[multisigtypes.StoreKey]),
-		logger,
-		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-		app.BankKeeper,
-	)
-
 	// IBC Fee Module keeper
 	app.IBCFeeKeeper = ibcfeekeeper.NewKeeper(
 		appCodec, keys[ibcfeetypes.StoreKey],
@@ -643,6 +637,20 @@ func NewChainApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// Create the multisig Keeper
+	app.MultisigKeeper = multisigkeeper.NewKeeper(
+		appCodec,
+		app.AccountKeeper.AddressCodec(),
+		app.MsgServiceRouter(),
+		runtime.NewKVStoreService(keys[multisigtypes.StoreKey]),
+		logger,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		app.BankKeeper,
+		app.AccountKeeper,
+		app.DistrKeeper,
+		app.ICAControllerKeeper,
+	)
+
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
 
@@ -655,10 +663,11 @@ func NewChainApp(
 	// SendPacket, since it is originating from the application to core IBC:
 	// icaAuthModuleKeeper.SendTx -> icaController.SendPacket -> fee.SendPacket -> channel.SendPacket
 	var icaControllerStack porttypes.IBCModule
-	// integration point for custom authentication modules
+	// interchain accounts are owned through the controller Msg service rather than an
+	// underlying authentication module: multisig accounts dispatch MsgRegisterInterchainAccount
+	// and MsgSendTx through approved proposals, which leaves the middleware disabled for their ports.
 	// see https://medium.com/the-interchain-foundation/ibc-go-v6-changes-to-interchain-accounts-and-how-it-impacts-your-chain-806c185300d7
-	var noAuthzModule porttypes.IBCModule
-	icaControllerStack = icacontroller.NewIBCMiddleware(noAuthzModule, app.ICAControllerKeeper)
+	icaControllerStack = icacontroller.NewIBCMiddleware(nil, app.ICAControllerKeeper)
 	icaControllerStack = ibcfee.NewIBCMiddleware(icaControllerStack, app.IBCFeeKeeper)
 
 	// RecvPacket, message that originates from core IBC and goes down to app, the flow is:
@@ -718,7 +727,7 @@ func NewChainApp(
 		ibctm.NewAppModule(),
 		crisis.NewAppModule(app.CrisisKeeper, skipGenesisInvariants, app.GetSubspace(crisistypes.ModuleName)),
 		// custom
-		multisig.NewAppModule(appCodec, app.MultisigKeeper),
+		multisig.NewAppModule(appCodec, app.MultisigKeeper, app.AccountKeeper),
 
 		oracle.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper),
 	)
@@ -738,6 +747,7 @@ func NewChainApp(
 	// NOTE: upgrade module is required to be prioritized
 	app.ModuleManager.SetOrderPreBlockers(
 		upgradetypes.ModuleName,
+		oracletypes.ModuleName,
 	)
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
@@ -890,8 +900,9 @@ func NewChainApp(
 				FeegrantKeeper:  app.FeeGrantKeeper,
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 			},
-			IBCKeeper:     app.IBCKeeper,
-			CircuitKeeper: &app.CircuitKeeper,
+			IBCKeeper:      app.IBCKeeper,
+			CircuitKeeper:  &app.CircuitKeeper,
+			MultisigKeeper: app.MultisigKeeper,
 		},
 	)
 	if err != nil {
@@ -973,7 +984,20 @@ func (app *ChainApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.Respon
 		}
 	})
 
-	return app.BaseApp.FinalizeBlock(req)
+	// the injected vote extension tx is handled by the oracle PreBlocker and never executed
+	app.blockTxs = req.Txs
+	defer func() { app.blockTxs = nil }()
+
+	execReq := *req
+	execReq.Txs = oracleabci.StripInjectedVoteExtensionTx(req.Txs)
+	res, err := app.BaseApp.FinalizeBlock(&execReq)
+	if err != nil {
+		return nil, err
+	}
+
+	oracleabci.AddInjectedVoteExtensionTxResult(req.Txs, res)
+
+	return res, nil
 }
 
 func (app *ChainApp) setPostHandler() {
@@ -991,8 +1015,15 @@ func (app *ChainApp) setPostHandler() {
 func (app *ChainApp) Name() string { return app.BaseApp.Name() }
 
 // PreBlocker application updates every pre block
-func (app *ChainApp) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
-	return app.ModuleManager.PreBlock(ctx)
+func (app *ChainApp) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	// the oracle PreBlocker reads the vote extension tx injected in the block, which FinalizeBlock
+	// strips from req
+	txs := req.Txs
+	if app.blockTxs != nil {
+		txs = app.blockTxs
+	}
+
+	return app.ModuleManager.PreBlock(oracleabci.WithBlockTxs(ctx, txs))
 }
 
 // BeginBlocker application updates every begin block
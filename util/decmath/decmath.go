@@ -8,7 +8,14 @@ import (
 	"cosmossdk.io/math"
 )
 
-var ErrEmptyList = fmt.Errorf("empty price list passed in")
+var (
+	ErrEmptyList         = fmt.Errorf("empty price list passed in")
+	ErrInvalidPercentile = fmt.Errorf("percentile must be between 0 and 1")
+	ErrNonPositiveLog    = fmt.Errorf("logarithm of a non positive decimal")
+)
+
+// ln2 is the natural logarithm of 2 rounded to the precision of math.LegacyDec.
+var ln2 = math.LegacyMustNewDecFromStr("0.693147180559945309")
 
 // Median returns the median of a list of math.LegacyDec. Returns error
 // if ds is empty list.
@@ -101,6 +108,90 @@ func Min(ds []math.LegacyDec) (math.LegacyDec, error) {
 	return min, nil
 }
 
+// Percentile returns the p-th percentile of a list of math.LegacyDec, with p
+// between 0 and 1, interpolating linearly between the closest ranks. Returns
+// error if ds is empty list or p is out of range. ds is not reordered.
+func Percentile(ds []math.LegacyDec, p math.LegacyDec) (math.LegacyDec, error) {
+	if len(ds) == 0 {
+		return math.LegacyZeroDec(), ErrEmptyList
+	}
+	if p.IsNegative() || p.GT(math.LegacyOneDec()) {
+		return math.LegacyZeroDec(), ErrInvalidPercentile
+	}
+
+	sorted := make([]math.LegacyDec, len(ds))
+	copy(sorted, ds)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LT(sorted[j])
+	})
+
+	// rank is the zero-based position of the percentile in the sorted list
+	rank := p.MulInt64(int64(len(sorted) - 1))
+	lower := rank.TruncateInt64()
+	if lower == int64(len(sorted)-1) {
+		return sorted[lower], nil
+	}
+
+	fraction := rank.Sub(math.LegacyNewDec(lower))
+	return sorted[lower].Add(sorted[lower+1].Sub(sorted[lower]).Mul(fraction)), nil
+}
+
+// Variance returns the population variance of a list of math.LegacyDec.
+// Returns error if ds is empty list.
+// Variance = ∑((d - average)^2) / len(ds)
+func Variance(ds []math.LegacyDec) (math.LegacyDec, error) {
+	average, err := Average(ds)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	sumSquares := math.LegacyZeroDec()
+	for _, d := range ds {
+		sumSquares = sumSquares.Add(d.Sub(average).Power(2))
+	}
+
+	return sumSquares.QuoInt64(int64(len(ds))), nil
+}
+
+// Ln returns an approximation of the natural logarithm of d to the precision
+// of math.LegacyDec, using integer arithmetic only so that it is
+// deterministic. Returns error if d is not positive.
+//
+// d is reduced to m * 2^k with m in [1, 2), and ln(m) is computed with the
+// series ln(m) = 2 * ∑ y^(2i+1) / (2i+1), y = (m - 1) / (m + 1), which
+// converges quickly as y < 1/3.
+func Ln(d math.LegacyDec) (math.LegacyDec, error) {
+	if !d.IsPositive() {
+		return math.LegacyZeroDec(), ErrNonPositiveLog
+	}
+
+	one := math.LegacyOneDec()
+	two := math.LegacyNewDec(2)
+
+	var k int64
+	m := d
+	for m.GTE(two) {
+		m = m.QuoInt64(2)
+		k++
+	}
+	for m.LT(one) {
+		m = m.MulInt64(2)
+		k--
+	}
+
+	y := m.Sub(one).Quo(m.Add(one))
+	ySquared := y.Mul(y)
+
+	sum := math.LegacyZeroDec()
+	term := y
+	for i := int64(1); !term.IsZero(); i += 2 {
+		sum = sum.Add(term.QuoInt64(i))
+		term = term.Mul(ySquared)
+	}
+
+	return sum.MulInt64(2).Add(ln2.MulInt64(k)), nil
+}
+
 // NewDecFromFloat converts a float64 into a math.LegacyDec. Returns error
 // if float64 cannot be converted into a string, or into a subsequent
 // math.LegacyDec.
@@ -143,3 +143,133 @@ func TestNewDecFromFloat(t *testing.T) {
 		})
 	}
 }
+
+func TestPercentile(t *testing.T) {
+	require := require.New(t)
+	prices := []math.LegacyDec{
+		math.LegacyMustNewDecFromStr("4"),
+		math.LegacyMustNewDecFromStr("1"),
+		math.LegacyMustNewDecFromStr("3"),
+		math.LegacyMustNewDecFromStr("2"),
+	}
+
+	testCases := []struct {
+		percentile string
+		value      string
+	}{
+		{"0", "1"},
+		{"0.25", "1.75"},
+		{"0.5", "2.5"},
+		{"0.9", "3.7"},
+		{"1", "4"},
+	}
+	for _, tc := range testCases {
+		value, err := Percentile(prices, math.LegacyMustNewDecFromStr(tc.percentile))
+		require.NoError(err)
+		require.Equal(math.LegacyMustNewDecFromStr(tc.value), value, tc.percentile)
+	}
+
+	// the list is not reordered
+	require.Equal(math.LegacyMustNewDecFromStr("4"), prices[0])
+
+	_, err := Percentile(prices, math.LegacyMustNewDecFromStr("1.01"))
+	require.ErrorIs(err, ErrInvalidPercentile)
+	_, err = Percentile(prices, math.LegacyMustNewDecFromStr("-0.01"))
+	require.ErrorIs(err, ErrInvalidPercentile)
+
+	// test empty prices list
+	_, err = Percentile([]math.LegacyDec{}, math.LegacyMustNewDecFromStr("0.5"))
+	require.ErrorIs(err, ErrEmptyList)
+}
+
+func TestVariance(t *testing.T) {
+	require := require.New(t)
+	prices := []math.LegacyDec{
+		math.LegacyMustNewDecFromStr("1.12"),
+		math.LegacyMustNewDecFromStr("1.07"),
+		math.LegacyMustNewDecFromStr("1.11"),
+		math.LegacyMustNewDecFromStr("1.2"),
+	}
+
+	variance, err := Variance(prices)
+	require.NoError(err)
+	require.Equal(math.LegacyMustNewDecFromStr("0.002225"), variance)
+
+	// test empty prices list
+	_, err = Variance([]math.LegacyDec{})
+	require.ErrorIs(err, ErrEmptyList)
+}
+
+func TestLn(t *testing.T) {
+	require := require.New(t)
+	tolerance := math.LegacyNewDecWithPrec(1, 15)
+
+	testCases := []struct {
+		d  string
+		ln string
+	}{
+		{"1", "0"},
+		{"2", "0.693147180559945309"},
+		{"10", "2.302585092994045684"},
+		{"0.5", "-0.693147180559945309"},
+		{"1.5", "0.405465108108164382"},
+		{"123456.789", "11.723646487185880981"},
+		{"0.000001", "-13.815510557964274104"},
+	}
+	for _, tc := range testCases {
+		ln, err := Ln(math.LegacyMustNewDecFromStr(tc.d))
+		require.NoError(err)
+		diff := ln.Sub(math.LegacyMustNewDecFromStr(tc.ln)).Abs()
+		require.True(diff.LTE(tolerance), "ln(%s) = %s", tc.d, ln)
+	}
+
+	_, err := Ln(math.LegacyZeroDec())
+	require.ErrorIs(err, ErrNonPositiveLog)
+	_, err = Ln(math.LegacyMustNewDecFromStr("-1"))
+	require.ErrorIs(err, ErrNonPositiveLog)
+}
+
+// TestDeterminism checks that the helpers used in consensus return the exact
+// same results for the same inputs, whatever their order.
+func TestDeterminism(t *testing.T) {
+	require := require.New(t)
+	prices := []math.LegacyDec{
+		math.LegacyMustNewDecFromStr("1.12"),
+		math.LegacyMustNewDecFromStr("1.07"),
+		math.LegacyMustNewDecFromStr("1.11"),
+		math.LegacyMustNewDecFromStr("1.2"),
+		math.LegacyMustNewDecFromStr("0.98"),
+		math.LegacyMustNewDecFromStr("1.134"),
+	}
+	reversed := make([]math.LegacyDec, len(prices))
+	for i, price := range prices {
+		reversed[len(prices)-1-i] = price
+	}
+
+	percentile := math.LegacyMustNewDecFromStr("0.95")
+	expectedPercentile, err := Percentile(prices, percentile)
+	require.NoError(err)
+	expectedVariance, err := Variance(prices)
+	require.NoError(err)
+	expectedLn, err := Ln(prices[5])
+	require.NoError(err)
+
+	for i := 0; i < 100; i++ {
+		ds := prices
+		if i%2 == 1 {
+			ds = reversed
+		}
+
+		value, err := Percentile(ds, percentile)
+		require.NoError(err)
+		require.Equal(expectedPercentile.String(), value.String())
+
+		variance, err := Variance(ds)
+		require.NoError(err)
+		require.Equal(expectedVariance.String(), variance.String())
+
+		ln, err := Ln(ds[5-5*(i%2)])
+		require.NoError(err)
+		require.Equal(expectedLn.String(), ln.String())
+	}
+}
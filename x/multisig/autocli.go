@@ -16,6 +16,35 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Query the current consensus parameters",
 				},
+				{
+					RpcMethod:      "MultisigAccount",
+					Use:            "account [address]",
+					Short:          "Query a multisig account and its interchain accounts",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "InterchainAccount",
+					Use:            "interchain-account [multisig-address] [connection-id]",
+					Short:          "Query the interchain account of a multisig account on a connection",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "multisig_address"}, {ProtoField: "connection_id"}},
+				},
+				{
+					RpcMethod:      "MultisigAccountsByCreator",
+					Use:            "accounts-by-creator [creator]",
+					Short:          "Query the multisig accounts created by an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}},
+				},
+				{
+					RpcMethod: "FrozenMultisigAccounts",
+					Use:       "frozen-accounts",
+					Short:     "Query the multisig accounts frozen by governance",
+				},
+				{
+					RpcMethod:      "Schedule",
+					Use:            "schedule [id]",
+					Short:          "Query a scheduled multisig call and its failed executions",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -25,6 +54,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      false, // set to true if authority gated
 				},
+				{
+					RpcMethod: "FreezeMultisigAccount",
+					Skip:      true, // authority gated
+				},
+				{
+					RpcMethod: "RecoverMultisigAccount",
+					Skip:      true, // authority gated
+				},
 			},
 		},
 	}
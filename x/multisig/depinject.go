@@ -20,6 +20,7 @@ import (
 
 	modulev1 "github.com/DaevMithran/dmchain/api/multisig/module/v1"
 	"github.com/DaevMithran/dmchain/x/multisig/keeper"
+	"github.com/DaevMithran/dmchain/x/multisig/types"
 )
 
 var _ appmodule.AppModule = AppModule{}
@@ -45,9 +46,13 @@ type ModuleInputs struct {
 	AddressCodec address.Codec
 	MsgServiceRouter baseapp.MessageRouter
 
+	AccountKeeper  types.AccountKeeper
 	StakingKeeper  stakingkeeper.Keeper
 	SlashingKeeper slashingkeeper.Keeper
 	BankKeeper    bankkeeper.Keeper
+
+	DistrKeeper   types.DistributionKeeper
+	ICAControllerKeeper types.ICAControllerKeeper `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -60,8 +65,8 @@ type ModuleOutputs struct {
 func ProvideModule(in ModuleInputs) ModuleOutputs {
 	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
 
-	k := keeper.NewKeeper(in.Cdc, in.AddressCodec, in.MsgServiceRouter, in.StoreService, log.NewLogger(os.Stderr), govAddr, in.BankKeeper)
-	m := NewAppModule(in.Cdc, k)
+	k := keeper.NewKeeper(in.Cdc, in.AddressCodec, in.MsgServiceRouter, in.StoreService, log.NewLogger(os.Stderr), govAddr, in.BankKeeper, in.AccountKeeper, in.DistrKeeper, in.ICAControllerKeeper)
+	m := NewAppModule(in.Cdc, k, in.AccountKeeper)
 
 	return ModuleOutputs{Module: m, Keeper: k, Out: depinject.Out{}}
 }
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/orm/model/ormlist"
+
+	queryv1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	apiv1 "github.com/DaevMithran/dmchain/api/multisig/v1"
+	"github.com/DaevMithran/dmchain/x/multisig/types"
+)
+
+// GetMultisigAccount returns the details of the multisig account at address. It fails with
+// ormerrors.NotFound when there is no such account.
+func (k Keeper) GetMultisigAccount(ctx context.Context, address []byte) (types.MultisigAccountDetails, error) {
+	account, err := k.OrmDB.MultisigAccountTable().Get(ctx, address)
+	if err != nil {
+		return types.MultisigAccountDetails{}, err
+	}
+
+	return accountDetails(account), nil
+}
+
+// HasMultisigAccount returns whether there is a multisig account at address.
+func (k Keeper) HasMultisigAccount(ctx context.Context, address []byte) (bool, error) {
+	return k.OrmDB.MultisigAccountTable().Has(ctx, address)
+}
+
+// SetMultisigAccount inserts or updates the multisig account at address.
+func (k Keeper) SetMultisigAccount(ctx context.Context, address []byte, details types.MultisigAccountDetails) error {
+	return k.OrmDB.MultisigAccountTable().Save(ctx, &apiv1.MultisigAccount{
+		Address:             address,
+		Creator:             details.Creator,
+		Signers:             details.Signers,
+		Threshold:           details.Threshold,
+		Permission:          apiv1.MultisigProposalType(details.Permission),
+		Frozen:              details.Frozen,
+		ThresholdPercentage: details.ThresholdPercentage,
+	})
+}
+
+// WalkMultisigAccounts calls fn with every multisig account by address until fn returns true.
+func (k Keeper) WalkMultisigAccounts(ctx context.Context, fn func(address []byte, details types.MultisigAccountDetails) (bool, error)) error {
+	return k.walkAccounts(ctx, apiv1.MultisigAccountPrimaryKey{}, fn)
+}
+
+func (k Keeper) walkAccounts(ctx context.Context, prefixKey apiv1.MultisigAccountIndexKey, fn func(address []byte, details types.MultisigAccountDetails) (bool, error)) error {
+	it, err := k.OrmDB.MultisigAccountTable().List(ctx, prefixKey)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for it.Next() {
+		account, err := it.Value()
+		if err != nil {
+			return err
+		}
+
+		stop, err := fn(account.Address, accountDetails(account))
+		if err != nil || stop {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// paginateAccounts returns a page of the bech32 addresses of the multisig accounts matching
+// prefixKey.
+func (k Keeper) paginateAccounts(ctx context.Context, prefixKey apiv1.MultisigAccountIndexKey, pageReq *query.PageRequest) ([]string, *query.PageResponse, error) {
+	var opts []ormlist.Option
+	if pageReq != nil {
+		opts = append(opts, ormlist.Paginate(&queryv1beta1.PageRequest{
+			Key:        pageReq.Key,
+			Offset:     pageReq.Offset,
+			Limit:      pageReq.Limit,
+			CountTotal: pageReq.CountTotal,
+			Reverse:    pageReq.Reverse,
+		}))
+	}
+
+	it, err := k.OrmDB.MultisigAccountTable().List(ctx, prefixKey, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+
+	var addresses []string
+	for it.Next() {
+		account, err := it.Value()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		address, err := k.ac.BytesToString(account.Address)
+		if err != nil {
+			return nil, nil, err
+		}
+		addresses = append(addresses, address)
+	}
+
+	var pageRes *query.PageResponse
+	if res := it.PageResponse(); res != nil {
+		pageRes = &query.PageResponse{
+			NextKey: res.NextKey,
+			Total:   res.Total,
+		}
+	}
+
+	return addresses, pageRes, nil
+}
+
+func accountDetails(account *apiv1.MultisigAccount) types.MultisigAccountDetails {
+	return types.MultisigAccountDetails{
+		Signers:             account.Signers,
+		Threshold:           account.Threshold,
+		Permission:          types.MultisigProposalType(account.Permission),
+		Frozen:              account.Frozen,
+		Creator:             account.Creator,
+		ThresholdPercentage: account.ThresholdPercentage,
+	}
+}
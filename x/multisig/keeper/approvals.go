@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/DaevMithran/dmchain/x/multisig/types"
+)
+
+// VerifyApprovalSignature verifies the off-chain signature of signer over the sign bytes of
+// an approval against the public key of its on-chain account. Signers without a public key,
+// e.g. accounts that never sent a transaction or nested multisig accounts, must approve
+// on-chain instead.
+func (k Keeper) VerifyApprovalSignature(ctx context.Context, signer sdk.AccAddress, signBytes, signature []byte) error {
+	account := k.AccountKeeper.GetAccount(ctx, signer)
+	if account == nil {
+		return errors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", signer)
+	}
+
+	pubKey := account.GetPubKey()
+	if pubKey == nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidPubKey, "account %s has no public key", signer)
+	}
+
+	if !pubKey.VerifySignature(signBytes, signature) {
+		return errors.Wrapf(types.ErrInvalidApproval, "signature of %s", signer)
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/DaevMithran/dmchain/x/multisig/keeper"
+	"github.com/DaevMithran/dmchain/x/multisig/types"
+)
+
+// setupSignerAccount stores an account with the public key of a new private key.
+func setupSignerAccount(t *testing.T, f *testFixture) cryptotypes.PrivKey {
+	t.Helper()
+
+	priv := secp256k1.GenPrivKey()
+	account := f.accountkeeper.NewAccountWithAddress(f.ctx, sdk.AccAddress(priv.PubKey().Address()))
+	require.NoError(t, account.SetPubKey(priv.PubKey()))
+	f.accountkeeper.SetAccount(f.ctx, account)
+
+	return priv
+}
+
+func TestBatchApproveMultisigProposal(t *testing.T) {
+	const chainID = "dmchain-1"
+
+	testCases := []struct {
+		name     string
+		malleate func(f *testFixture, msg *types.MsgBatchApproveMultisigProposalParams, keys []cryptotypes.PrivKey)
+		err      error
+	}{
+		{
+			name:     "success; approve and dispatch",
+			malleate: func(*testFixture, *types.MsgBatchApproveMultisigProposalParams, []cryptotypes.PrivKey) {},
+		},
+		{
+			name: "success; approve only",
+			malleate: func(_ *testFixture, msg *types.MsgBatchApproveMultisigProposalParams, _ []cryptotypes.PrivKey) {
+				msg.Message = nil
+			},
+		},
+		{
+			name: "fail; threshold not met",
+			malleate: func(_ *testFixture, msg *types.MsgBatchApproveMultisigProposalParams, _ []cryptotypes.PrivKey) {
+				msg.Signatures = msg.Signatures[:1]
+			},
+			err: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name: "fail; signature for another chain",
+			malleate: func(f *testFixture, msg *types.MsgBatchApproveMultisigProposalParams, keys []cryptotypes.PrivKey) {
+				proposal, err := f.k.OrmDB.ProposalTable().Get(f.ctx, msg.ProposalId)
+				require.NoError(t, err)
+
+				sig, err := keys[1].Sign(types.ApprovalSignBytes("other-chain", msg.MultisigAddress, msg.ProposalId, proposal.CallHash))
+				require.NoError(t, err)
+				msg.Signatures[1].Signature = sig
+			},
+			err: types.ErrInvalidApproval,
+		},
+		{
+			name: "fail; signature of another signer",
+			malleate: func(_ *testFixture, msg *types.MsgBatchApproveMultisigProposalParams, _ []cryptotypes.PrivKey) {
+				msg.Signatures[1].Signature = msg.Signatures[0].Signature
+			},
+			err: types.ErrInvalidApproval,
+		},
+		{
+			name: "fail; signer without public key",
+			malleate: func(f *testFixture, _ *types.MsgBatchApproveMultisigProposalParams, _ []cryptotypes.PrivKey) {
+				account := f.accountkeeper.GetAccount(f.ctx, f.addrs[0])
+				require.NoError(t, account.SetPubKey(nil))
+				f.accountkeeper.SetAccount(f.ctx, account)
+			},
+			err: sdkerrors.ErrInvalidPubKey,
+		},
+		{
+			name: "fail; not a signer",
+			malleate: func(_ *testFixture, msg *types.MsgBatchApproveMultisigProposalParams, _ []cryptotypes.PrivKey) {
+				msg.Signatures[1].Signer = bech32(t, []byte("outsider____________"))
+			},
+			err: sdkerrors.ErrConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := SetupTest(t)
+			f.ctx = f.ctx.WithChainID(chainID)
+
+			// a 2 of 3 multisig whose signers have on-chain public keys
+			keys := []cryptotypes.PrivKey{setupSignerAccount(t, f), setupSignerAccount(t, f)}
+			f.addrs[0] = sdk.AccAddress(keys[0].PubKey().Address())
+			f.addrs[1] = sdk.AccAddress(keys[1].PubKey().Address())
+
+			multisig := setupProposal(t, f)
+			proposal, err := f.k.OrmDB.ProposalTable().Get(f.ctx, 1)
+			require.NoError(t, err)
+
+			call, err := codectypes.NewAnyWithValue(&types.MsgAddMultisigSignerParams{
+				MultisigAddress: bech32(t, multisig),
+				Signer:          bech32(t, []byte("new_signer__________")),
+			})
+			require.NoError(t, err)
+
+			msg := &types.MsgBatchApproveMultisigProposalParams{
+				Submitter:       bech32(t, f.addrs[2]),
+				MultisigAddress: bech32(t, multisig),
+				ProposalId:      proposal.Id,
+				Message:         call,
+			}
+			signBytes := types.ApprovalSignBytes(chainID, msg.MultisigAddress, proposal.Id, proposal.CallHash)
+			for _, key := range keys {
+				sig, err := key.Sign(signBytes)
+				require.NoError(t, err)
+				msg.Signatures = append(msg.Signatures, types.ApprovalSignature{
+					Signer:    bech32(t, key.PubKey().Address()),
+					Signature: sig,
+				})
+			}
+
+			// drop the on-chain approval of the proposer
+			proposal.Approvals = nil
+			require.NoError(t, f.k.OrmDB.ProposalTable().Update(f.ctx, proposal))
+
+			tc.malleate(f, msg, keys)
+
+			res, err := f.msgServer.BatchApproveMultisigProposal(f.ctx, msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, msg.Message != nil, res.Dispatched)
+
+			if res.Dispatched {
+				has, err := f.k.OrmDB.ProposalTable().Has(f.ctx, proposal.Id)
+				require.NoError(t, err)
+				require.False(t, has)
+
+				details, err := f.k.GetMultisigAccount(f.ctx, keeper.DeriveMultisigAccountID(1))
+				require.NoError(t, err)
+				require.Contains(t, details.Signers, []byte("new_signer__________"))
+				require.Equal(t, math.NewInt(100), f.bankkeeper.GetBalance(f.ctx, f.addrs[0], sdk.DefaultBondDenom).Amount)
+			} else {
+				proposal, err := f.k.OrmDB.ProposalTable().Get(f.ctx, proposal.Id)
+				require.NoError(t, err)
+				require.Len(t, proposal.Approvals, 2)
+			}
+		})
+	}
+}
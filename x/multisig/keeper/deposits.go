@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"context"
+
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	multisigv1 "github.com/DaevMithran/dmchain/api/multisig/v1"
+	"github.com/DaevMithran/dmchain/x/multisig/types"
+)
+
+// proposalDeposit returns the deposit held in reserve of the depositor of proposal.
+func proposalDeposit(proposal *multisigv1.Proposal) (sdk.Coin, error) {
+	amount, ok := math.NewIntFromString(proposal.GetDeposit().GetAmount())
+	if !ok {
+		return sdk.Coin{}, errors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid deposit of proposal %d", proposal.Id)
+	}
+
+	return sdk.Coin{Denom: proposal.GetDeposit().GetDenom(), Amount: amount}, nil
+}
+
+// depositToAPI converts deposit to the coin type of the proposal table.
+func depositToAPI(deposit sdk.Coin) *basev1beta1.Coin {
+	return &basev1beta1.Coin{Denom: deposit.Denom, Amount: deposit.Amount.String()}
+}
+
+// refundDeposit returns the deposit of proposal to its depositor.
+func (k Keeper) refundDeposit(ctx context.Context, proposal *multisigv1.Proposal) error {
+	deposit, err := proposalDeposit(proposal)
+	if err != nil {
+		return err
+	}
+
+	return k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, proposal.Depositor, sdk.NewCoins(deposit))
+}
+
+// forfeitDeposit burns the deposit of a rejected or expired proposal, or sends it to the
+// community pool, as set by the DepositForfeitDestination param.
+func (k Keeper) forfeitDeposit(ctx context.Context, proposal *multisigv1.Proposal, expired bool) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	coin, err := proposalDeposit(proposal)
+	if err != nil {
+		return err
+	}
+
+	deposit := sdk.NewCoins(coin)
+	if !deposit.IsZero() {
+		switch params.DepositForfeitDestination {
+		case types.DepositForfeitDestination_DEPOSIT_FORFEIT_DESTINATION_COMMUNITY_POOL:
+			err = k.DistrKeeper.FundCommunityPool(ctx, deposit, authtypes.NewModuleAddress(types.ModuleName))
+		default:
+			err = k.BankKeeper.BurnCoins(ctx, types.ModuleName, deposit)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	multisigAddress, err := k.ac.BytesToString(proposal.MultisigAddress)
+	if err != nil {
+		return err
+	}
+
+	depositor, err := k.ac.BytesToString(proposal.Depositor)
+	if err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventDepositForfeited{
+		ProposalId:      proposal.Id,
+		MultisigAddress: multisigAddress,
+		Depositor:       depositor,
+		Amount:          deposit.String(),
+		Destination:     params.DepositForfeitDestination,
+		Expired:         expired,
+	})
+}
+
+// countOpenProposals returns the number of open proposals of the multisig account.
+func (k Keeper) countOpenProposals(ctx context.Context, multisigAddress []byte) (uint64, error) {
+	it, err := k.OrmDB.ProposalTable().List(ctx, multisigv1.ProposalMultisigAddressCallHashIndexKey{}.WithMultisigAddress(multisigAddress))
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	var count uint64
+	for it.Next() {
+		count++
+	}
+
+	return count, nil
+}
+
+// ExpireProposals removes the proposals whose expiry height has been reached and forfeits
+// their deposits. It is called in EndBlock.
+func (k Keeper) ExpireProposals(ctx context.Context) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	// proposals without an expiry height are stored with 0 and never expire, and the
+	// range bounds are inclusive and must differ
+	var (
+		it  multisigv1.ProposalIterator
+		err error
+	)
+	switch {
+	case height < 1:
+		return nil
+	case height == 1:
+		it, err = k.OrmDB.ProposalTable().List(ctx, multisigv1.ProposalExpiryHeightIndexKey{}.WithExpiryHeight(1))
+	default:
+		it, err = k.OrmDB.ProposalTable().ListRange(
+			ctx,
+			multisigv1.ProposalExpiryHeightIndexKey{}.WithExpiryHeight(1),
+			multisigv1.ProposalExpiryHeightIndexKey{}.WithExpiryHeight(height),
+		)
+	}
+	if err != nil {
+		return err
+	}
+
+	var expired []*multisigv1.Proposal
+	for it.Next() {
+		proposal, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		expired = append(expired, proposal)
+	}
+	it.Close()
+
+	for _, proposal := range expired {
+		if err := k.OrmDB.ProposalTable().Delete(ctx, proposal); err != nil {
+			return err
+		}
+
+		if err := k.forfeitDeposit(ctx, proposal, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/DaevMithran/dmchain/x/multisig/types"
+)
+
+func TestCancelMultisigProposal(t *testing.T) {
+	testCases := []struct {
+		name        string
+		rejecters   []int
+		destination types.DepositForfeitDestination
+		open        bool
+		balance     int64
+		burned      bool
+		pool        int64
+	}{
+		{
+			name:      "success; depositor cancels with a refund",
+			rejecters: []int{0},
+			balance:   100,
+		},
+		{
+			name:      "success; a lone signer only votes against",
+			rejecters: []int{1},
+			open:      true,
+			balance:   90,
+		},
+		{
+			name:        "success; threshold rejects and the deposit is burned",
+			rejecters:   []int{1, 2},
+			destination: types.DepositForfeitDestination_DEPOSIT_FORFEIT_DESTINATION_BURN,
+			balance:     90,
+			burned:      true,
+		},
+		{
+			name:        "success; threshold rejects and the deposit goes to the community pool",
+			rejecters:   []int{2, 1},
+			destination: types.DepositForfeitDestination_DEPOSIT_FORFEIT_DESTINATION_COMMUNITY_POOL,
+			balance:     90,
+			pool:        10,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := SetupTest(t)
+			if tc.destination != types.DepositForfeitDestination_DEPOSIT_FORFEIT_DESTINATION_UNSPECIFIED {
+				params := types.DefaultParams()
+				params.DepositForfeitDestination = tc.destination
+				require.NoError(t, f.k.Params.Set(f.ctx, params))
+			}
+
+			multisig := bech32(t, setupProposal(t, f))
+			supply := f.bankkeeper.GetSupply(f.ctx, sdk.DefaultBondDenom).Amount
+
+			var res *types.MsgCancelMultisigProposalResponse
+			for _, rejecter := range tc.rejecters {
+				var err error
+				res, err = f.msgServer.CancelMultisigProposal(f.ctx, &types.MsgCancelMultisigProposalParams{
+					MultisigAddress: multisig,
+					ProposalId:      1,
+					Rejecter:        bech32(t, f.addrs[rejecter]),
+				})
+				require.NoError(t, err)
+			}
+			forfeit := !tc.open && tc.rejecters[0] != 0
+			require.Equal(t, forfeit, res.Rejected)
+
+			has, err := f.k.OrmDB.ProposalTable().Has(f.ctx, 1)
+			require.NoError(t, err)
+			require.Equal(t, tc.open, has)
+
+			require.Equal(t, math.NewInt(tc.balance), f.bankkeeper.GetBalance(f.ctx, f.addrs[0], sdk.DefaultBondDenom).Amount)
+			moduleAddress := authtypes.NewModuleAddress(types.ModuleName)
+			held := f.bankkeeper.GetBalance(f.ctx, moduleAddress, sdk.DefaultBondDenom).Amount
+			require.Equal(t, tc.open, held.Equal(math.NewInt(10)))
+			require.Equal(t, !tc.open, held.IsZero())
+
+			burned := supply.Sub(f.bankkeeper.GetSupply(f.ctx, sdk.DefaultBondDenom).Amount)
+			require.Equal(t, tc.burned, burned.IsPositive())
+
+			poolAddress := authtypes.NewModuleAddress(distrtypes.ModuleName)
+			require.Equal(t, math.NewInt(tc.pool), f.bankkeeper.GetBalance(f.ctx, poolAddress, sdk.DefaultBondDenom).Amount)
+
+			events := f.ctx.EventManager().Events()
+			forfeited := sdk.MsgTypeURL(&types.EventDepositForfeited{})[1:]
+			require.Equal(t, forfeit, events[len(events)-1].Type == forfeited)
+		})
+	}
+}
+
+func TestCancelMultisigProposalRejectedTwice(t *testing.T) {
+	f := SetupTest(t)
+	multisig := bech32(t, setupProposal(t, f))
+
+	msg := &types.MsgCancelMultisigProposalParams{
+		MultisigAddress: multisig,
+		ProposalId:      1,
+		Rejecter:        bech32(t, f.addrs[1]),
+	}
+	_, err := f.msgServer.CancelMultisigProposal(f.ctx, msg)
+	require.NoError(t, err)
+
+	_, err = f.msgServer.CancelMultisigProposal(f.ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrConflict)
+
+	proposal, err := f.k.OrmDB.ProposalTable().Get(f.ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{f.addrs[1]}, proposal.Rejections)
+}
+
+func TestCancelMultisigProposalNotSigner(t *testing.T) {
+	f := SetupTest(t)
+	multisig := bech32(t, setupProposal(t, f))
+
+	_, err := f.msgServer.CancelMultisigProposal(f.ctx, &types.MsgCancelMultisigProposalParams{
+		MultisigAddress: multisig,
+		ProposalId:      1,
+		Rejecter:        bech32(t, []byte("not_a_signer________")),
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrConflict)
+
+	_, err = f.msgServer.CancelMultisigProposal(f.ctx, &types.MsgCancelMultisigProposalParams{
+		MultisigAddress: multisig,
+		ProposalId:      2,
+		Rejecter:        bech32(t, f.addrs[1]),
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+}
+
+func TestExpireProposals(t *testing.T) {
+	f := SetupTest(t)
+	f.ctx = f.ctx.WithBlockHeight(10)
+
+	params := types.DefaultParams()
+	params.ProposalExpiryBlocks = 5
+	require.NoError(t, f.k.Params.Set(f.ctx, params))
+
+	setupProposal(t, f)
+
+	proposal, err := f.k.OrmDB.ProposalTable().Get(f.ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(15), proposal.ExpiryHeight)
+
+	f.ctx = f.ctx.WithBlockHeight(14)
+	_, err = f.appModule.EndBlock(f.ctx)
+	require.NoError(t, err)
+
+	has, err := f.k.OrmDB.ProposalTable().Has(f.ctx, 1)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	f.ctx = f.ctx.WithBlockHeight(15)
+	_, err = f.appModule.EndBlock(f.ctx)
+	require.NoError(t, err)
+
+	has, err = f.k.OrmDB.ProposalTable().Has(f.ctx, 1)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.Equal(t, math.NewInt(90), f.bankkeeper.GetBalance(f.ctx, f.addrs[0], sdk.DefaultBondDenom).Amount)
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName)
+	require.True(t, f.bankkeeper.GetBalance(f.ctx, moduleAddress, sdk.DefaultBondDenom).IsZero())
+}
+
+func TestMaxOpenProposals(t *testing.T) {
+	f := SetupTest(t)
+
+	params := types.DefaultParams()
+	params.MaxOpenProposals = 1
+	require.NoError(t, f.k.Params.Set(f.ctx, params))
+
+	multisig := bech32(t, setupProposal(t, f))
+
+	call, err := codectypes.NewAnyWithValue(&types.MsgAddMultisigSignerParams{
+		MultisigAddress: multisig,
+		Signer:          bech32(t, []byte("other_signer________")),
+	})
+	require.NoError(t, err)
+
+	_, err = f.msgServer.InitializeMultisigProposal(f.ctx, &types.MsgInitializeMultisigProposalParams{
+		MultisigAddress: multisig,
+		Proposer:        bech32(t, f.addrs[0]),
+		Message:         call,
+	})
+	require.ErrorIs(t, err, types.ErrTooManyProposals)
+
+	// cancelling the open proposal makes room for a new one
+	_, err = f.msgServer.CancelMultisigProposal(f.ctx, &types.MsgCancelMultisigProposalParams{
+		MultisigAddress: multisig,
+		ProposalId:      1,
+		Rejecter:        bech32(t, f.addrs[0]),
+	})
+	require.NoError(t, err)
+
+	_, err = f.msgServer.InitializeMultisigProposal(f.ctx, &types.MsgInitializeMultisigProposalParams{
+		MultisigAddress: multisig,
+		Proposer:        bech32(t, f.addrs[0]),
+		Message:         call,
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/DaevMithran/dmchain/x/multisig/types"
+)
+
+// FeeSponsor returns the multisig account paying the fees of a tx made of msgs, which is the
+// case when msgs only approve or execute proposals of that account and are all sent by its
+// signers. Frozen accounts do not sponsor fees.
+func (k Keeper) FeeSponsor(ctx context.Context, msgs []sdk.Msg) (sdk.AccAddress, bool) {
+	var multisig []byte
+	for _, msg := range msgs {
+		var multisigAddress, sender string
+		switch msg := msg.(type) {
+		case *types.MsgApproveMultisigProposalParams:
+			multisigAddress, sender = msg.MultisigAddress, msg.Approver
+		case *types.MsgApproveAndDispatchMultisigProposalParams:
+			multisigAddress, sender = msg.MultisigAddress, msg.Approver
+		case *types.MsgBatchApproveMultisigProposalParams:
+			multisigAddress, sender = msg.MultisigAddress, msg.Submitter
+		default:
+			return nil, false
+		}
+
+		address, err := k.ac.StringToBytes(multisigAddress)
+		if err != nil || (multisig != nil && !bytes.Equal(multisig, address)) {
+			return nil, false
+		}
+		multisig = address
+
+		signer, err := k.ac.StringToBytes(sender)
+		if err != nil {
+			return nil, false
+		}
+
+		details, err := k.GetMultisigAccount(ctx, multisig)
+		if err != nil || details.Frozen || !contains(details.Signers, signer) {
+			return nil, false
+		}
+	}
+
+	return multisig, multisig != nil
+}
+
+// DeductFeeFromBudget sends fee from the multisig account to the fee collector, failing with
+// ErrFeeBudget when the fees paid by the account in the current budget period would exceed
+// the fee budget.
+func (k Keeper) DeductFeeFromBudget(ctx context.Context, multisig sdk.AccAddress, fee sdk.Coins) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.FeeBudget.IsZero() {
+		return errors.Wrap(types.ErrFeeBudget, "no fee budget")
+	}
+
+	period := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) / params.FeeBudgetPeriod
+
+	spend, err := k.FeeBudgetSpends.Get(ctx, multisig)
+	if err != nil && !errors.IsOf(err, collections.ErrNotFound) {
+		return err
+	}
+	if spend.Period != period {
+		spend = types.FeeBudgetSpend{Period: period}
+	}
+
+	spent := spend.Spent.Add(fee...)
+	if !spent.IsAllLTE(params.FeeBudget) {
+		return errors.Wrapf(types.ErrFeeBudget, "%s spent of %s", spent, params.FeeBudget)
+	}
+
+	if !fee.IsZero() {
+		if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, multisig, authtypes.FeeCollectorName, fee); err != nil {
+			return err
+		}
+	}
+
+	spend.Spent = spent
+	return k.FeeBudgetSpends.Set(ctx, multisig, spend)
+}
@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/DaevMithran/dmchain/x/multisig/keeper"
+	"github.com/DaevMithran/dmchain/x/multisig/types"
+)
+
+func TestFeeSponsor(t *testing.T) {
+	f := SetupTest(t)
+
+	for seed := uint32(1); seed <= 2; seed++ {
+		_, err := f.msgServer.CreateMultisigAccount(f.ctx, &types.MsgCreateMultisigAccountParams{
+			Authority: bech32(t, f.addrs[0]),
+			Seed:      seed,
+			Threshold: 2,
+			Signers:   [][]byte{f.addrs[1]},
+		})
+		require.NoError(t, err)
+	}
+	multisig := bech32(t, keeper.DeriveMultisigAccountID(1))
+	other := bech32(t, keeper.DeriveMultisigAccountID(2))
+
+	testCases := []struct {
+		name    string
+		msgs    []sdk.Msg
+		sponsor bool
+	}{
+		{
+			name: "success; approvals by signers",
+			msgs: []sdk.Msg{
+				&types.MsgApproveMultisigProposalParams{MultisigAddress: multisig, Approver: bech32(t, f.addrs[0])},
+				&types.MsgApproveAndDispatchMultisigProposalParams{MultisigAddress: multisig, Approver: bech32(t, f.addrs[1])},
+			},
+			sponsor: true,
+		},
+		{
+			name: "success; batch approval submitted by a signer",
+			msgs: []sdk.Msg{
+				&types.MsgBatchApproveMultisigProposalParams{MultisigAddress: multisig, Submitter: bech32(t, f.addrs[1])},
+			},
+			sponsor: true,
+		},
+		{
+			name: "fail; approvals of different accounts",
+			msgs: []sdk.Msg{
+				&types.MsgApproveMultisigProposalParams{MultisigAddress: multisig, Approver: bech32(t, f.addrs[0])},
+				&types.MsgApproveMultisigProposalParams{MultisigAddress: other, Approver: bech32(t, f.addrs[0])},
+			},
+		},
+		{
+			name: "fail; approval by a non signer",
+			msgs: []sdk.Msg{
+				&types.MsgApproveMultisigProposalParams{MultisigAddress: multisig, Approver: bech32(t, f.addrs[2])},
+			},
+		},
+		{
+			name: "fail; other messages",
+			msgs: []sdk.Msg{
+				&types.MsgApproveMultisigProposalParams{MultisigAddress: multisig, Approver: bech32(t, f.addrs[0])},
+				&banktypes.MsgSend{FromAddress: bech32(t, f.addrs[0])},
+			},
+		},
+		{
+			name: "fail; no messages",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			sponsor, ok := f.k.FeeSponsor(f.ctx, tc.msgs)
+			require.Equal(t, tc.sponsor, ok)
+			if tc.sponsor {
+				require.Equal(t, sdk.AccAddress(keeper.DeriveMultisigAccountID(1)), sponsor)
+			}
+		})
+	}
+}
+
+func TestDeductFeeFromBudget(t *testing.T) {
+	f := SetupTest(t)
+	f.ctx = f.ctx.WithBlockHeight(10)
+
+	multisig := sdk.AccAddress(keeper.DeriveMultisigAccountID(1))
+	balance := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000)))
+	require.NoError(t, f.bankkeeper.MintCoins(f.ctx, minttypes.ModuleName, balance))
+	require.NoError(t, f.bankkeeper.SendCoinsFromModuleToAccount(f.ctx, minttypes.ModuleName, multisig, balance))
+
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(40)))
+
+	// fees are not sponsored without a budget
+	require.ErrorIs(t, f.k.DeductFeeFromBudget(f.ctx, multisig, fee), types.ErrFeeBudget)
+
+	params := types.DefaultParams()
+	params.FeeBudget = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	params.FeeBudgetPeriod = 100
+	require.NoError(t, f.k.Params.Set(f.ctx, params))
+
+	require.NoError(t, f.k.DeductFeeFromBudget(f.ctx, multisig, fee))
+	require.NoError(t, f.k.DeductFeeFromBudget(f.ctx, multisig, fee))
+	require.ErrorIs(t, f.k.DeductFeeFromBudget(f.ctx, multisig, fee), types.ErrFeeBudget)
+
+	feeCollector := f.accountkeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.Equal(t, math.NewInt(80), f.bankkeeper.GetBalance(f.ctx, feeCollector, sdk.DefaultBondDenom).Amount)
+	require.Equal(t, math.NewInt(920), f.bankkeeper.GetBalance(f.ctx, multisig, sdk.DefaultBondDenom).Amount)
+
+	// the budget is renewed in the next period
+	f.ctx = f.ctx.WithBlockHeight(100)
+	require.NoError(t, f.k.DeductFeeFromBudget(f.ctx, multisig, fee))
+
+	spend, err := f.k.FeeBudgetSpends.Get(f.ctx, multisig)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), spend.Period)
+	require.Equal(t, fee, spend.Spent)
+}
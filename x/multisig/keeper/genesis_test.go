@@ -3,8 +3,13 @@ package keeper_test
 import (
 	"testing"
 
-	"github.com/DaevMithran/dmchain/x/multisig/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/DaevMithran/dmchain/api/multisig/v1"
+	"github.com/DaevMithran/dmchain/x/multisig/keeper"
+	"github.com/DaevMithran/dmchain/x/multisig/types"
 )
 
 func TestGenesis(t *testing.T) {
@@ -20,3 +25,91 @@ func TestGenesis(t *testing.T) {
 	require.NotNil(t, got)
 
 }
+
+func TestGenesisAccountsAndProposals(t *testing.T) {
+	f := SetupTest(t)
+
+	multisigAddress := keeper.DeriveMultisigAccountID(1)
+	address, err := sdk.Bech32ifyAddressBytes("cosmos", multisigAddress)
+	require.NoError(t, err)
+
+	genesisState := &types.GenesisState{
+		Params: types.DefaultParams(),
+		Accounts: []types.GenesisMultisigAccount{
+			{
+				Address: address,
+				Details: types.MultisigAccountDetails{
+					Signers:   [][]byte{f.addrs[0], f.addrs[1]},
+					Threshold: 2,
+				},
+			},
+		},
+		Proposals: []types.Proposal{
+			{
+				Id:              3,
+				MultisigAddress: multisigAddress,
+				CallHash:        []byte("call"),
+				Depositor:       f.addrs[0],
+				Deposit:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 10),
+				Rejections:      [][]byte{f.addrs[1]},
+				Approvals:       [][]byte{f.addrs[0]},
+			},
+		},
+		ProposalSequence: 5,
+	}
+
+	require.NoError(t, f.k.InitGenesis(f.ctx, genesisState))
+	require.Equal(t, genesisState, f.k.ExportGenesis(f.ctx))
+
+	// new proposals continue from the imported sequence
+	id, err := f.k.OrmDB.ProposalTable().InsertReturningId(f.ctx, &apiv1.Proposal{
+		MultisigAddress: multisigAddress,
+		CallHash:        []byte("other call"),
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 6, id)
+}
+
+func TestGenesisSchedules(t *testing.T) {
+	f := SetupTest(t)
+
+	multisigAddress := keeper.DeriveMultisigAccountID(1)
+	address, err := sdk.Bech32ifyAddressBytes("cosmos", multisigAddress)
+	require.NoError(t, err)
+
+	genesisState := &types.GenesisState{
+		Params: types.DefaultParams(),
+		Accounts: []types.GenesisMultisigAccount{
+			{
+				Address: address,
+				Details: types.MultisigAccountDetails{
+					Signers:   [][]byte{f.addrs[0], f.addrs[1]},
+					Threshold: 2,
+				},
+			},
+		},
+		Proposals: []types.Proposal{},
+		Schedules: []types.Schedule{
+			{
+				Id:              2,
+				MultisigAddress: multisigAddress,
+				Message:         &codectypes.Any{TypeUrl: sdk.MsgTypeURL(&types.MsgAddMultisigSignerParams{})},
+				NextHeight:      20,
+				IntervalBlocks:  10,
+				MaxRuns:         3,
+				Runs:            1,
+			},
+		},
+		ScheduleSequence: 2,
+		ScheduleFailures: []types.ScheduleFailure{
+			{ScheduleId: 1, Run: 1, Height: 5, Error: "failed"},
+		},
+	}
+	require.NoError(t, f.k.InitGenesis(f.ctx, genesisState))
+	require.Equal(t, genesisState, f.k.ExportGenesis(f.ctx))
+
+	// new schedules continue from the imported sequence
+	seq, err := f.k.ScheduleSequence.Next(f.ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, seq+1)
+}
@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	dbm "github.com/cosmos/cosmos-db"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/DaevMithran/dmchain/app"
+	"github.com/DaevMithran/dmchain/x/multisig/keeper"
+	"github.com/DaevMithran/dmchain/x/multisig/types"
+)
+
+func setupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	chainApp := app.NewChainApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+	return chainApp, chainApp.DefaultGenesis()
+}
+
+// dispatch proposes msg from the first signer and dispatches it with the second one.
+func dispatch(t *testing.T, chain *ibctesting.TestChain, multisigAddress string, signers []string, msg sdk.Msg) sdk.Events {
+	t.Helper()
+
+	chainApp := chain.App.(*app.ChainApp)
+	msgServer := keeper.NewMsgServerImpl(chainApp.MultisigKeeper)
+	ctx := chain.GetContext()
+
+	call, err := codectypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+
+	proposal, err := msgServer.InitializeMultisigProposal(ctx, &types.MsgInitializeMultisigProposalParams{
+		MultisigAddress: multisigAddress,
+		Proposer:        signers[0],
+		Message:         call,
+	})
+	require.NoError(t, err)
+
+	_, err = msgServer.ApproveAndDispatchMultisigProposal(ctx, &types.MsgApproveAndDispatchMultisigProposalParams{
+		MultisigAddress: multisigAddress,
+		ProposalId:      proposal.ProposalId,
+		Approver:        signers[1],
+		Message:         call,
+	})
+	require.NoError(t, err)
+
+	return ctx.EventManager().Events()
+}
+
+func TestMultisigInterchainAccount(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+	appA := chainA.App.(*app.ChainApp)
+	appB := chainB.App.(*app.ChainApp)
+
+	version := string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
+		Version:                icatypes.Version,
+		ControllerConnectionId: ibctesting.FirstConnectionID,
+		HostConnectionId:       ibctesting.FirstConnectionID,
+		Encoding:               icatypes.EncodingProtobuf,
+		TxType:                 icatypes.TxTypeSDKMultiMsg,
+	}))
+
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = icatypes.HostPortID
+	path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Version = version
+	coordinator.SetupConnections(path)
+
+	// create a 2 of 2 multisig and fund the proposal deposits of its signers
+	signers := []string{chainA.SenderAccounts[0].SenderAccount.GetAddress().String(), chainA.SenderAccounts[1].SenderAccount.GetAddress().String()}
+	ctx := chainA.GetContext()
+	res, err := keeper.NewMsgServerImpl(appA.MultisigKeeper).CreateMultisigAccount(ctx, &types.MsgCreateMultisigAccountParams{
+		Authority: signers[0],
+		Seed:      1,
+		Threshold: 2,
+		Signers:   [][]byte{chainA.SenderAccounts[1].SenderAccount.GetAddress()},
+	})
+	require.NoError(t, err)
+	multisigAddress := res.MultisigAddress
+
+	deposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	require.NoError(t, appA.BankKeeper.MintCoins(ctx, minttypes.ModuleName, deposit))
+	require.NoError(t, appA.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, chainA.SenderAccounts[0].SenderAccount.GetAddress(), deposit))
+
+	// register the interchain account through a multisig proposal
+	dispatch(t, chainA, multisigAddress, signers, &icacontrollertypes.MsgRegisterInterchainAccount{
+		Owner:        multisigAddress,
+		ConnectionId: path.EndpointA.ConnectionID,
+		Version:      version,
+		Ordering:     channeltypes.ORDERED,
+	})
+	chainA.NextBlock()
+
+	portID, err := icatypes.NewControllerPortID(multisigAddress)
+	require.NoError(t, err)
+	path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(0)
+	path.EndpointA.ChannelConfig.PortID = portID
+	require.NoError(t, path.EndpointB.ChanOpenTry())
+	require.NoError(t, path.EndpointA.ChanOpenAck())
+	require.NoError(t, path.EndpointB.ChanOpenConfirm())
+
+	// the interchain account is reported by the multisig queries
+	querier := keeper.NewQuerier(appA.MultisigKeeper)
+	account, err := querier.MultisigAccount(chainA.GetContext(), &types.QueryMultisigAccountRequest{Address: multisigAddress})
+	require.NoError(t, err)
+	require.Len(t, account.InterchainAccounts, 1)
+	require.Equal(t, path.EndpointA.ConnectionID, account.InterchainAccounts[0].ConnectionId)
+	require.Equal(t, portID, account.InterchainAccounts[0].PortId)
+
+	ica, err := querier.InterchainAccount(chainA.GetContext(), &types.QueryInterchainAccountRequest{
+		MultisigAddress: multisigAddress,
+		ConnectionId:    path.EndpointA.ConnectionID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, account.InterchainAccounts[0].Address, ica.InterchainAccountAddress)
+
+	hostAddress, found := appB.ICAHostKeeper.GetInterchainAccountAddress(chainB.GetContext(), path.EndpointB.ConnectionID, portID)
+	require.True(t, found)
+	require.Equal(t, hostAddress, ica.InterchainAccountAddress)
+
+	// fund the interchain account on the host chain
+	icaAddress := sdk.MustAccAddressFromBech32(ica.InterchainAccountAddress)
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000)))
+	require.NoError(t, appB.BankKeeper.SendCoins(chainB.GetContext(), chainB.SenderAccount.GetAddress(), icaAddress, amount))
+
+	// send a bank transfer from the interchain account through a multisig proposal
+	recipient := chainB.SenderAccounts[1].SenderAccount.GetAddress()
+	data, err := icatypes.SerializeCosmosTx(appA.AppCodec(), []proto.Message{&banktypes.MsgSend{
+		FromAddress: ica.InterchainAccountAddress,
+		ToAddress:   recipient.String(),
+		Amount:      amount,
+	}}, icatypes.EncodingProtobuf)
+	require.NoError(t, err)
+
+	events := dispatch(t, chainA, multisigAddress, signers, &icacontrollertypes.MsgSendTx{
+		Owner:        multisigAddress,
+		ConnectionId: path.EndpointA.ConnectionID,
+		PacketData: icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: data,
+		},
+		RelativeTimeout: uint64(time.Hour.Nanoseconds()),
+	})
+	chainA.NextBlock()
+
+	packet, err := ibctesting.ParsePacketFromEvents(events.ToABCIEvents())
+	require.NoError(t, err)
+
+	before := appB.BankKeeper.GetBalance(chainB.GetContext(), recipient, sdk.DefaultBondDenom)
+	require.NoError(t, path.RelayPacket(packet))
+
+	require.True(t, appB.BankKeeper.GetBalance(chainB.GetContext(), icaAddress, sdk.DefaultBondDenom).IsZero())
+	after := appB.BankKeeper.GetBalance(chainB.GetContext(), recipient, sdk.DefaultBondDenom)
+	require.Equal(t, before.Add(amount[0]), after)
+}
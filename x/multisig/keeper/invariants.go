@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	apiv1 "github.com/DaevMithran/dmchain/api/multisig/v1"
+	"github.com/DaevMithran/dmchain/x/multisig/types"
+)
+
+// RegisterInvariants registers all multisig invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "deposits", DepositsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "proposal-accounts", ProposalAccountsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "thresholds", ThresholdsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "unique-signers", UniqueSignersInvariant(k))
+}
+
+// AllInvariants runs all invariants of the multisig module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			DepositsInvariant(k),
+			ProposalAccountsInvariant(k),
+			ThresholdsInvariant(k),
+			UniqueSignersInvariant(k),
+		} {
+			if res, stop := invariant(ctx); stop {
+				return res, stop
+			}
+		}
+
+		return "", false
+	}
+}
+
+// DepositsInvariant checks that the module account holds exactly the deposits of the
+// open proposals.
+func DepositsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		proposals, err := k.allProposals(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "deposits", err.Error()), true
+		}
+
+		deposits := sdk.NewCoins()
+		for _, proposal := range proposals {
+			deposit, err := proposalDeposit(proposal)
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, "deposits", err.Error()), true
+			}
+			deposits = deposits.Add(deposit)
+		}
+
+		balance := k.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+		broken := !balance.Equal(deposits)
+
+		return sdk.FormatInvariant(types.ModuleName, "deposits", fmt.Sprintf(
+			"\tmodule account balance: %s\n\tsum of open proposal deposits: %s\n",
+			balance, deposits,
+		)), broken
+	}
+}
+
+// ProposalAccountsInvariant checks that every open proposal references an existing
+// multisig account.
+func ProposalAccountsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		proposals, err := k.allProposals(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "proposal-accounts", err.Error()), true
+		}
+
+		var (
+			msg   string
+			count int
+		)
+		for _, proposal := range proposals {
+			has, err := k.HasMultisigAccount(ctx, proposal.MultisigAddress)
+			if err != nil || !has {
+				count++
+				msg += fmt.Sprintf("\tproposal %d references unknown multisig account %s\n", proposal.Id, sdk.AccAddress(proposal.MultisigAddress))
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "proposal-accounts", fmt.Sprintf(
+			"found %d proposals without a multisig account\n%s", count, msg,
+		)), count != 0
+	}
+}
+
+// ThresholdsInvariant checks that the threshold of every multisig account can be
+// reached by its signers.
+func ThresholdsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+		err := k.WalkMultisigAccounts(ctx, func(address []byte, details types.MultisigAccountDetails) (bool, error) {
+			threshold, err := details.EffectiveThreshold()
+			if err != nil || int(threshold) > len(details.Signers) {
+				count++
+				msg += fmt.Sprintf("\tmultisig account %s has threshold %d for %d signers\n", sdk.AccAddress(address), threshold, len(details.Signers))
+			}
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "thresholds", err.Error()), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "thresholds", fmt.Sprintf(
+			"found %d multisig accounts with an unreachable threshold\n%s", count, msg,
+		)), count != 0
+	}
+}
+
+// UniqueSignersInvariant checks that no multisig account lists a signer twice.
+func UniqueSignersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+		err := k.WalkMultisigAccounts(ctx, func(address []byte, details types.MultisigAccountDetails) (bool, error) {
+			for i, signer := range details.Signers {
+				if contains(details.Signers[i+1:], signer) {
+					count++
+					msg += fmt.Sprintf("\tmultisig account %s lists signer %s more than once\n", sdk.AccAddress(address), sdk.AccAddress(signer))
+					break
+				}
+			}
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "unique-signers", err.Error()), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "unique-signers", fmt.Sprintf(
+			"found %d multisig accounts with duplicate signers\n%s", count, msg,
+		)), count != 0
+	}
+}
+
+// allProposals returns all open proposals.
+func (k Keeper) allProposals(ctx context.Context) ([]*apiv1.Proposal, error) {
+	it, err := k.OrmDB.ProposalTable().List(ctx, apiv1.ProposalPrimaryKey{})
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var proposals []*apiv1.Proposal
+	for it.Next() {
+		proposal, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		proposals = append(proposals, proposal)
+	}
+
+	return proposals, nil
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/DaevMithran/dmchain/x/multisig/keeper"
+	"github.com/DaevMithran/dmchain/x/multisig/types"
+)
+
+// bech32 encodes addr with the address codec of the multisig keeper under test.
+func bech32(t *testing.T, addr []byte) string {
+	t.Helper()
+
+	s, err := sdk.Bech32ifyAddressBytes("cosmos", addr)
+	require.NoError(t, err)
+	return s
+}
+
+// setupProposal creates a 2 of 3 multisig account with one open proposal.
+func setupProposal(t *testing.T, f *testFixture) []byte {
+	t.Helper()
+
+	deposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	require.NoError(t, f.bankkeeper.MintCoins(f.ctx, minttypes.ModuleName, deposit))
+	require.NoError(t, f.bankkeeper.SendCoinsFromModuleToAccount(f.ctx, minttypes.ModuleName, f.addrs[0], deposit))
+
+	res, err := f.msgServer.CreateMultisigAccount(f.ctx, &types.MsgCreateMultisigAccountParams{
+		Authority: bech32(t, f.addrs[0]),
+		Seed:      1,
+		Threshold: 2,
+		Signers:   [][]byte{f.addrs[1], f.addrs[2]},
+	})
+	require.NoError(t, err)
+
+	call, err := codectypes.NewAnyWithValue(&types.MsgAddMultisigSignerParams{
+		MultisigAddress: res.MultisigAddress,
+		Signer:          bech32(t, []byte("new_signer__________")),
+	})
+	require.NoError(t, err)
+
+	_, err = f.msgServer.InitializeMultisigProposal(f.ctx, &types.MsgInitializeMultisigProposalParams{
+		MultisigAddress: res.MultisigAddress,
+		Proposer:        bech32(t, f.addrs[0]),
+		Message:         call,
+	})
+	require.NoError(t, err)
+
+	return keeper.DeriveMultisigAccountID(1)
+}
+
+func TestInvariants(t *testing.T) {
+	testCases := []struct {
+		name      string
+		invariant func(k keeper.Keeper) sdk.Invariant
+		malleate  func(f *testFixture, multisig []byte)
+	}{
+		{
+			name:      "deposits; module balance exceeds open deposits",
+			invariant: keeper.DepositsInvariant,
+			malleate: func(f *testFixture, _ []byte) {
+				coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1)))
+				require.NoError(t, f.bankkeeper.MintCoins(f.ctx, minttypes.ModuleName, coins))
+				require.NoError(t, f.bankkeeper.SendCoinsFromModuleToModule(f.ctx, minttypes.ModuleName, types.ModuleName, coins))
+			},
+		},
+		{
+			name:      "proposal-accounts; account removed",
+			invariant: keeper.ProposalAccountsInvariant,
+			malleate: func(f *testFixture, multisig []byte) {
+				account, err := f.k.OrmDB.MultisigAccountTable().Get(f.ctx, multisig)
+				require.NoError(t, err)
+				require.NoError(t, f.k.OrmDB.MultisigAccountTable().Delete(f.ctx, account))
+			},
+		},
+		{
+			name:      "thresholds; threshold above signer count",
+			invariant: keeper.ThresholdsInvariant,
+			malleate: func(f *testFixture, multisig []byte) {
+				details, err := f.k.GetMultisigAccount(f.ctx, multisig)
+				require.NoError(t, err)
+				details.Threshold = 4
+				require.NoError(t, f.k.SetMultisigAccount(f.ctx, multisig, details))
+			},
+		},
+		{
+			name:      "unique-signers; duplicate signer",
+			invariant: keeper.UniqueSignersInvariant,
+			malleate: func(f *testFixture, multisig []byte) {
+				details, err := f.k.GetMultisigAccount(f.ctx, multisig)
+				require.NoError(t, err)
+				details.Signers = append(details.Signers, f.addrs[1])
+				require.NoError(t, f.k.SetMultisigAccount(f.ctx, multisig, details))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := SetupTest(t)
+			multisig := setupProposal(t, f)
+
+			_, broken := keeper.AllInvariants(f.k)(f.ctx)
+			require.False(t, broken)
+
+			tc.malleate(f, multisig)
+
+			msg, broken := tc.invariant(f.k)(f.ctx)
+			require.True(t, broken, msg)
+
+			_, broken = keeper.AllInvariants(f.k)(f.ctx)
+			require.True(t, broken)
+		})
+	}
+}
@@ -1,10 +1,16 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"strconv"
+
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -14,6 +20,7 @@ import (
 	storetypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"cosmossdk.io/orm/model/ormdb"
+	"cosmossdk.io/orm/model/ormtable"
 
 	apiv1 "github.com/DaevMithran/dmchain/api/multisig/v1"
 	"github.com/DaevMithran/dmchain/x/multisig/types"
@@ -21,7 +28,7 @@ import (
 )
 
 type Keeper struct {
-	cdc codec.BinaryCodec
+	cdc codec.Codec
 	ac  address.Codec
 	router       baseapp.MessageRouter
 
@@ -30,23 +37,43 @@ type Keeper struct {
 	// state management
 	Schema collections.Schema
 	Params collections.Item[types.Params]
-	MultisigAccounts collections.Map[[]byte, types.MultisigAccountDetails]
+	// legacyAccounts holds the multisig accounts of consensus version 1, moved to the
+	// account table by Migrate1to2.
+	legacyAccounts   collections.Map[[]byte, types.MultisigAccountDetails]
+	ScheduleSequence collections.Sequence
+	Schedules        collections.Map[uint64, types.Schedule]
+	ScheduleQueue    collections.KeySet[collections.Pair[int64, uint64]]
+	ScheduleFailures collections.Map[collections.Pair[uint64, uint64], types.ScheduleFailure]
+	FeeBudgetSpends  collections.Map[[]byte, types.FeeBudgetSpend]
 	OrmDB  apiv1.StateStore
+	db     ormdb.ModuleDB
 
 	authority string
 
 	BankKeeper bankkeeper.Keeper
+
+	// AccountKeeper resolves the public keys of signers approving proposals off-chain.
+	AccountKeeper types.AccountKeeper
+
+	// DistrKeeper receives the forfeited deposits sent to the community pool.
+	DistrKeeper types.DistributionKeeper
+
+	// ICAControllerKeeper resolves the interchain accounts owned by multisig accounts.
+	ICAControllerKeeper types.ICAControllerKeeper
 }
 
 // NewKeeper creates a new Keeper instance
 func NewKeeper(
-	cdc codec.BinaryCodec,
+	cdc codec.Codec,
 	ac address.Codec,
 	router baseapp.MessageRouter,
 	storeService storetypes.KVStoreService,
 	logger log.Logger,
 	authority string,
 	bankKeeper bankkeeper.Keeper,
+	accountKeeper types.AccountKeeper,
+	distrKeeper types.DistributionKeeper,
+	icaControllerKeeper types.ICAControllerKeeper,
 ) Keeper {
 	logger = logger.With(log.ModuleKey, "x/"+types.ModuleName)
 
@@ -73,11 +100,20 @@ func NewKeeper(
 		logger: logger,
 
 		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		MultisigAccounts: collections.NewMap(sb, types.AccountsKey, "multisig_account_mapping", collections.BytesKey ,codec.CollValue[types.MultisigAccountDetails](cdc)),
+		legacyAccounts:   collections.NewMap(sb, types.AccountsKey, "multisig_account_mapping", collections.BytesKey, codec.CollValue[types.MultisigAccountDetails](cdc)),
+		ScheduleSequence: collections.NewSequence(sb, types.ScheduleSequenceKey, "schedule_sequence"),
+		Schedules:        collections.NewMap(sb, types.SchedulesKey, "schedules", collections.Uint64Key, codec.CollValue[types.Schedule](cdc)),
+		ScheduleQueue:    collections.NewKeySet(sb, types.ScheduleQueueKey, "schedule_queue", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		ScheduleFailures: collections.NewMap(sb, types.ScheduleFailuresKey, "schedule_failures", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.ScheduleFailure](cdc)),
+		FeeBudgetSpends:  collections.NewMap(sb, types.FeeBudgetSpendsKey, "fee_budget_spends", collections.BytesKey, codec.CollValue[types.FeeBudgetSpend](cdc)),
 		OrmDB:  store,
+		db:     db,
 
 		authority: authority,
 		BankKeeper: bankKeeper,
+		AccountKeeper: accountKeeper,
+		DistrKeeper: distrKeeper,
+		ICAControllerKeeper: icaControllerKeeper,
 	}
 
 	schema, err := sb.Build()
@@ -101,7 +137,41 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 		return err
 	}
 
-	return k.Params.Set(ctx, data.Params)
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	for _, account := range data.Accounts {
+		address, err := k.ac.StringToBytes(account.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.SetMultisigAccount(ctx, address, account.Details); err != nil {
+			return err
+		}
+	}
+
+	if err := k.importProposals(ctx, data.Proposals, data.ProposalSequence); err != nil {
+		return err
+	}
+
+	if err := k.importSchedules(ctx, data.Schedules, data.ScheduleFailures, data.ScheduleSequence); err != nil {
+		return err
+	}
+
+	for _, spend := range data.FeeBudgetSpends {
+		address, err := k.ac.StringToBytes(spend.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.FeeBudgetSpends.Set(ctx, address, spend.Spend); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // ExportGenesis exports the module's state to a genesis state.
@@ -111,7 +181,149 @@ func (k *Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	var accounts []types.GenesisMultisigAccount
+	err = k.WalkMultisigAccounts(ctx, func(address []byte, details types.MultisigAccountDetails) (bool, error) {
+		addr, err := k.ac.BytesToString(address)
+		if err != nil {
+			return true, err
+		}
+
+		accounts = append(accounts, types.GenesisMultisigAccount{
+			Address: addr,
+			Details: details,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	proposals, sequence, err := k.exportProposals(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	schedules, failures, scheduleSequence, err := k.exportSchedules(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	var spends []types.GenesisFeeBudgetSpend
+	err = k.FeeBudgetSpends.Walk(ctx, nil, func(address []byte, spend types.FeeBudgetSpend) (bool, error) {
+		addr, err := k.ac.BytesToString(address)
+		if err != nil {
+			return true, err
+		}
+
+		spends = append(spends, types.GenesisFeeBudgetSpend{
+			Address: addr,
+			Spend:   spend,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Params: params,
+		Params:           params,
+		Accounts:         accounts,
+		Proposals:        proposals,
+		ProposalSequence: sequence,
+		Schedules:        schedules,
+		ScheduleSequence: scheduleSequence,
+		ScheduleFailures: failures,
+		FeeBudgetSpends:  spends,
+	}
+}
+
+// importProposals restores the proposal table. Auto-increment tables refuse inserts with
+// a preset primary key, so the proposals go through the table's JSON import which also
+// restores the id sequence.
+func (k *Keeper) importProposals(ctx context.Context, proposals []types.Proposal, sequence uint64) error {
+	var buf bytes.Buffer
+	buf.WriteString("[" + strconv.FormatUint(sequence, 10))
+	for _, proposal := range proposals {
+		bz, err := protojson.Marshal(&apiv1.Proposal{
+			Id:              proposal.Id,
+			MultisigAddress: proposal.MultisigAddress,
+			CallHash:        proposal.CallHash,
+			Depositor:       proposal.Depositor,
+			Deposit:         depositToAPI(proposal.Deposit),
+			Approvals:       proposal.Approvals,
+			ExpiryHeight:    proposal.ExpiryHeight,
+			Rejections:      proposal.Rejections,
+		})
+		if err != nil {
+			return err
+		}
+
+		buf.WriteString(",")
+		buf.Write(bz)
+	}
+	buf.WriteString("]")
+
+	return k.db.GetTable(&apiv1.Proposal{}).ImportJSON(ctx, &buf)
+}
+
+// exportProposals returns all open proposals along with the last assigned proposal id.
+func (k *Keeper) exportProposals(ctx context.Context) ([]types.Proposal, uint64, error) {
+	open, err := k.allProposals(ctx)
+	if err != nil {
+		return nil, 0, err
 	}
+
+	proposals := make([]types.Proposal, 0, len(open))
+	for _, proposal := range open {
+		deposit, err := proposalDeposit(proposal)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		proposals = append(proposals, types.Proposal{
+			Id:              proposal.Id,
+			MultisigAddress: proposal.MultisigAddress,
+			CallHash:        proposal.CallHash,
+			Depositor:       proposal.Depositor,
+			Deposit:         deposit,
+			Approvals:       proposal.Approvals,
+			ExpiryHeight:    proposal.ExpiryHeight,
+			Rejections:      proposal.Rejections,
+		})
+	}
+
+	sequence, err := k.db.GetTable(&apiv1.Proposal{}).(ormtable.AutoIncrementTable).LastInsertedSequence(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return proposals, sequence, nil
+}
+
+// GetInterchainAccounts returns the interchain accounts registered by the multisig account
+// through the interchain accounts controller.
+func (k Keeper) GetInterchainAccounts(ctx context.Context, multisigAddress string) ([]*types.InterchainAccount, error) {
+	if k.ICAControllerKeeper == nil {
+		return nil, nil
+	}
+
+	portID, err := icatypes.NewControllerPortID(multisigAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts []*types.InterchainAccount
+	for _, account := range k.ICAControllerKeeper.GetAllInterchainAccounts(sdk.UnwrapSDKContext(ctx)) {
+		if account.PortId != portID {
+			continue
+		}
+
+		accounts = append(accounts, &types.InterchainAccount{
+			ConnectionId: account.ConnectionId,
+			PortId:       account.PortId,
+			Address:      account.AccountAddress,
+		})
+	}
+
+	return accounts, nil
 }
@@ -1,11 +1,13 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 
@@ -24,6 +26,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
@@ -42,21 +45,25 @@ var maccPerms = map[string][]string{
 	stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 	minttypes.ModuleName:           {authtypes.Minter},
 	govtypes.ModuleName:            {authtypes.Burner},
+	distrtypes.ModuleName:          nil,
+	types.ModuleName:               {authtypes.Burner},
 }
 
 type testFixture struct {
 	suite.Suite
 
-	ctx         sdk.Context
-	k           keeper.Keeper
-	msgServer   types.MsgServer
-	queryServer types.QueryServer
-	appModule   *module.AppModule
+	ctx          sdk.Context
+	k            keeper.Keeper
+	storeService store.KVStoreService
+	msgServer    types.MsgServer
+	queryServer  types.QueryServer
+	appModule    *module.AppModule
 
 	accountkeeper authkeeper.AccountKeeper
 	bankkeeper    bankkeeper.BaseKeeper
 	stakingKeeper *stakingkeeper.Keeper
 	mintkeeper    mintkeeper.Keeper
+	distrkeeper   communityPoolKeeper
 	baseApp       *baseapp.BaseApp
 
 	addrs      []sdk.AccAddress
@@ -96,17 +103,27 @@ func SetupTest(t *testing.T) *testFixture {
 	f.govModAddr = authtypes.NewModuleAddress(govtypes.ModuleName).String()
 	f.addrs = simtestutil.CreateIncrementalAccounts(3)
 
-	keys := storetypes.NewKVStoreKeys(authtypes.ModuleName, banktypes.ModuleName, stakingtypes.ModuleName, minttypes.ModuleName, types.ModuleName)
+	keys := storetypes.NewKVStoreKeys(authtypes.StoreKey, banktypes.ModuleName, stakingtypes.ModuleName, minttypes.ModuleName, types.ModuleName)
 	f.ctx = sdk.NewContext(integration.CreateMultiStore(keys, logger), cmtproto.Header{}, false, logger)
 
 	// Register SDK modules.
 	registerBaseSDKModules(logger, f, encCfg, keys, accountAddressCodec, validatorAddressCodec, consensusAddressCodec)
 
+	f.distrkeeper = communityPoolKeeper{bankKeeper: f.bankkeeper}
+
 	// Setup Keeper.
-	f.k = keeper.NewKeeper(encCfg.Codec, addresscodec.NewBech32Codec("cosmos"), f.baseApp.MsgServiceRouter(),runtime.NewKVStoreService(keys[types.ModuleName]),logger, f.govModAddr, f.bankkeeper)
+	f.storeService = runtime.NewKVStoreService(keys[types.ModuleName])
+	f.k = keeper.NewKeeper(encCfg.Codec, addresscodec.NewBech32Codec("cosmos"), f.baseApp.MsgServiceRouter(),runtime.NewKVStoreService(keys[types.ModuleName]),logger, f.govModAddr, f.bankkeeper, f.accountkeeper, f.distrkeeper, nil)
 	f.msgServer = keeper.NewMsgServerImpl(f.k)
 	f.queryServer = keeper.NewQuerier(f.k)
-	f.appModule = module.NewAppModule(encCfg.Codec, f.k)
+	f.appModule = module.NewAppModule(encCfg.Codec, f.k, f.accountkeeper)
+
+	// Register the multisig Msg service for calls dispatched by nested multisig accounts.
+	types.RegisterMsgServer(f.baseApp.MsgServiceRouter(), f.msgServer)
+
+	if err := f.k.Params.Set(f.ctx, types.DefaultParams()); err != nil {
+		t.Fatal(err)
+	}
 
 	return f
 }
@@ -163,3 +180,12 @@ func registerBaseSDKModules(
 		authtypes.FeeCollectorName, f.govModAddr,
 	)
 }
+
+// communityPoolKeeper funds the community pool by sending to the distribution module account.
+type communityPoolKeeper struct {
+	bankKeeper bankkeeper.BaseKeeper
+}
+
+func (k communityPoolKeeper) FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, distrtypes.ModuleName, amount)
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/DaevMithran/dmchain/x/multisig/types"
+)
+
+// legacyDepositDenom is the denom of the deposits collected before the deposit param.
+const legacyDepositDenom = "uom"
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator creates a Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 moves the multisig accounts from the collections map to the account table, and
+// sets the params added since version 1 to their defaults. The creator of the migrated
+// accounts is not known and is left empty. The uom deposits of open proposals are moved to
+// their deposit coin, and the pending schedules are queued by their next height.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	var addresses [][]byte
+	err := m.keeper.legacyAccounts.Walk(ctx, nil, func(address []byte, details types.MultisigAccountDetails) (bool, error) {
+		addresses = append(addresses, address)
+		return false, m.keeper.SetMultisigAccount(ctx, address, details)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, address := range addresses {
+		if err := m.keeper.legacyAccounts.Remove(ctx, address); err != nil {
+			return err
+		}
+	}
+
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil && !errors.IsOf(err, collections.ErrNotFound) {
+		return err
+	}
+
+	defaults := types.DefaultParams()
+	params.MaxNestingDepth = defaults.MaxNestingDepth
+	params.MaxScheduledExecutionsPerBlock = defaults.MaxScheduledExecutionsPerBlock
+	params.FeeBudget = defaults.FeeBudget
+	params.FeeBudgetPeriod = defaults.FeeBudgetPeriod
+	params.DepositForfeitDestination = defaults.DepositForfeitDestination
+	params.ProposalExpiryBlocks = defaults.ProposalExpiryBlocks
+	params.MaxOpenProposals = defaults.MaxOpenProposals
+	params.Deposit = defaults.Deposit
+
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	err = m.keeper.Schedules.Walk(ctx, nil, func(id uint64, schedule types.Schedule) (bool, error) {
+		return false, m.keeper.ScheduleQueue.Set(ctx, collections.Join(schedule.NextHeight, id))
+	})
+	if err != nil {
+		return err
+	}
+
+	proposals, err := m.keeper.allProposals(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, proposal := range proposals {
+		if proposal.Deposit != nil {
+			continue
+		}
+
+		//nolint:staticcheck // the legacy deposit is only read to migrate it
+		proposal.Deposit = depositToAPI(sdk.NewCoin(legacyDepositDenom, math.NewIntFromUint64(proposal.LegacyDeposit)))
+		proposal.LegacyDeposit = 0 //nolint:staticcheck
+		if err := m.keeper.OrmDB.ProposalTable().Update(ctx, proposal); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
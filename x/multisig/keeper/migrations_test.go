@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	apiv1 "github.com/DaevMithran/dmchain/api/multisig/v1"
+	"github.com/DaevMithran/dmchain/x/multisig/keeper"
+	"github.com/DaevMithran/dmchain/x/multisig/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := SetupTest(t)
+
+	// pre-populate the accounts map of consensus version 1
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	legacyAccounts := collections.NewMap(
+		collections.NewSchemaBuilder(f.storeService), types.AccountsKey, "multisig_account_mapping",
+		collections.BytesKey, codec.CollValue[types.MultisigAccountDetails](cdc),
+	)
+
+	accounts := map[uint32]types.MultisigAccountDetails{
+		1: {Signers: [][]byte{f.addrs[0], f.addrs[1]}, Threshold: 2},
+		2: {Signers: [][]byte{f.addrs[1], f.addrs[2]}, Threshold: 1, Permission: types.MultisigProposalType_MULTISIG_PROPOSAL_TYPE_TRANSFER_ONLY},
+		3: {Signers: [][]byte{f.addrs[2]}, Threshold: 1, Frozen: true},
+	}
+	for seed, details := range accounts {
+		require.NoError(t, legacyAccounts.Set(f.ctx, keeper.DeriveMultisigAccountID(seed), details))
+	}
+
+	// params of consensus version 1
+	require.NoError(t, f.k.Params.Set(f.ctx, types.Params{SomeValue: true}))
+
+	// a proposal holding a deposit of consensus version 1
+	id, err := f.k.OrmDB.ProposalTable().InsertReturningId(f.ctx, &apiv1.Proposal{
+		MultisigAddress: keeper.DeriveMultisigAccountID(1),
+		CallHash:        []byte("call"),
+		Depositor:       f.addrs[0],
+		LegacyDeposit:   5,
+	})
+	require.NoError(t, err)
+
+	// a schedule of consensus version 1, stored without a queue entry
+	schedule := types.Schedule{Id: 1, MultisigAddress: keeper.DeriveMultisigAccountID(1), NextHeight: 20, MaxRuns: 1}
+	require.NoError(t, f.k.Schedules.Set(f.ctx, schedule.Id, schedule))
+
+	require.NoError(t, keeper.NewMigrator(f.k).Migrate1to2(f.ctx))
+
+	// the schedule is queued at its next height
+	queued, err := f.k.ScheduleQueue.Has(f.ctx, collections.Join(schedule.NextHeight, schedule.Id))
+	require.NoError(t, err)
+	require.True(t, queued)
+
+	// the params added since version 1 are set to their defaults
+	params, err := f.k.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), params)
+	require.NoError(t, params.Validate())
+
+	// the legacy deposit is moved to the deposit coin
+	proposal, err := f.k.OrmDB.ProposalTable().Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, "uom", proposal.Deposit.Denom)
+	require.Equal(t, "5", proposal.Deposit.Amount)
+	require.Zero(t, proposal.LegacyDeposit) //nolint:staticcheck
+
+	for seed, details := range accounts {
+		address := keeper.DeriveMultisigAccountID(seed)
+
+		got, err := f.k.GetMultisigAccount(f.ctx, address)
+		require.NoError(t, err)
+		require.Equal(t, details, got)
+
+		has, err := legacyAccounts.Has(f.ctx, address)
+		require.NoError(t, err)
+		require.False(t, has)
+	}
+
+	// the indexes are populated for the migrated accounts
+	res, err := f.queryServer.FrozenMultisigAccounts(f.ctx, &types.QueryFrozenMultisigAccountsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{bech32(t, keeper.DeriveMultisigAccountID(3))}, res.Addresses)
+
+	// new accounts can not reuse the seed of a migrated account
+	_, err = f.msgServer.CreateMultisigAccount(f.ctx, &types.MsgCreateMultisigAccountParams{
+		Authority: bech32(t, f.addrs[0]),
+		Seed:      1,
+		Threshold: 1,
+		Signers:   [][]byte{f.addrs[1]},
+	})
+	require.Error(t, err)
+}
+
+func TestMultisigAccountsByCreator(t *testing.T) {
+	f := SetupTest(t)
+
+	for seed := uint32(1); seed <= 3; seed++ {
+		creator := f.addrs[0]
+		if seed == 3 {
+			creator = f.addrs[1]
+		}
+
+		_, err := f.msgServer.CreateMultisigAccount(f.ctx, &types.MsgCreateMultisigAccountParams{
+			Authority: bech32(t, creator),
+			Seed:      seed,
+			Threshold: 1,
+			Signers:   [][]byte{f.addrs[2]},
+		})
+		require.NoError(t, err)
+	}
+
+	res, err := f.queryServer.MultisigAccountsByCreator(f.ctx, &types.QueryMultisigAccountsByCreatorRequest{
+		Creator: bech32(t, f.addrs[0]),
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{
+		bech32(t, keeper.DeriveMultisigAccountID(1)),
+		bech32(t, keeper.DeriveMultisigAccountID(2)),
+	}, res.Addresses)
+
+	details, err := f.k.GetMultisigAccount(f.ctx, keeper.DeriveMultisigAccountID(3))
+	require.NoError(t, err)
+	require.Equal(t, []byte(f.addrs[1]), details.Creator)
+}
@@ -9,7 +9,7 @@ import (
 	"golang.org/x/crypto/blake2b"
 
 	"cosmossdk.io/errors"
-	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	multisigv1 "github.com/DaevMithran/dmchain/api/multisig/v1"
 	"github.com/DaevMithran/dmchain/x/multisig/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -32,6 +32,10 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, msg.Authority)
 	}
 
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
 	return nil, ms.k.Params.Set(ctx, msg.Params)
 }
 
@@ -44,7 +48,7 @@ func (ms msgServer) CreateMultisigAccount(ctx context.Context, msg *types.MsgCre
 	}
 
 	// validate threshold
-	if msg.Threshold < 1 {
+	if msg.ThresholdPercentage == "" && msg.Threshold < 1 {
 		return nil, errors.Wrapf(sdkerrors.ErrInsufficientFee, "invalid threshold")
 	}
 
@@ -57,20 +61,49 @@ func (ms msgServer) CreateMultisigAccount(ctx context.Context, msg *types.MsgCre
 	multisig_address := DeriveMultisigAccountID(msg.Seed)
 
 	// check for existing account
-	_, err = ms.k.MultisigAccounts.Get(ctx, multisig_address)
+	_, err = ms.k.GetMultisigAccount(ctx, multisig_address)
 	if err == nil {
 		return nil, errors.Wrapf(sdkerrors.ErrUnknownAddress, "Duplicate seed: Account already exists")
 	}
 
+	signers := append(msg.Signers, sender)
+
+	// validate signer list
+	for i, signer := range signers {
+		if contains(signers[i+1:], signer) {
+			return nil, errors.Wrap(sdkerrors.ErrConflict, "Duplicate signer")
+		}
+	}
+
+	if msg.ThresholdPercentage != "" {
+		if err := types.ValidateThreshold(msg.Threshold, msg.ThresholdPercentage, len(signers)); err != nil {
+			return nil, err
+		}
+	} else if int(msg.Threshold) > len(signers) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid threshold: exceeds signer count %d", len(signers))
+	}
+
+	// validate nested multisig signers
+	if err := ms.k.ValidateNestedSigners(ctx, multisig_address, signers); err != nil {
+		return nil, err
+	}
+
 	// insert multisig acount
-	ms.k.MultisigAccounts.Set(ctx, multisig_address, types.MultisigAccountDetails{
-		Threshold:  msg.Threshold,
-		Signers:    append(msg.Signers, sender),
-		Permission: msg.Permission,
+	ms.k.SetMultisigAccount(ctx, multisig_address, types.MultisigAccountDetails{
+		Threshold:           msg.Threshold,
+		ThresholdPercentage: msg.ThresholdPercentage,
+		Signers:             signers,
+		Permission:          msg.Permission,
+		Creator:             sender,
 	})
 
+	address, err := ms.k.ac.BytesToString(multisig_address)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.MsgCreateMultisigAccountResponse{
-		MultisigAddress: string(multisig_address),
+		MultisigAddress: address,
 	}, nil
 }
 
@@ -88,7 +121,7 @@ func (ms msgServer) AddMultisigSigner(ctx context.Context, msg *types.MsgAddMult
 	}
 
 	// validate multisig_address
-	multisig_account_details, err := ms.k.MultisigAccounts.Get(ctx, multisig_address)
+	multisig_account_details, err := ms.k.GetMultisigAccount(ctx, multisig_address)
 	if err != nil {
 		return nil, errors.Wrapf(sdkerrors.ErrConflict, "Invalid multisig: Account not found")
 	}
@@ -98,20 +131,31 @@ func (ms msgServer) AddMultisigSigner(ctx context.Context, msg *types.MsgAddMult
 		return nil, errors.Wrap(sdkerrors.ErrConflict, "Duplicate signer")
 	}
 
+	// validate nested multisig signer
+	if err := ms.k.ValidateNestedSigners(ctx, multisig_address, [][]byte{new_signer}); err != nil {
+		return nil, err
+	}
+
 	// check if new threshold is provided
 	if msg.NewThreshold != 0 {
 		if msg.GetNewThreshold() < 1 {
 			return nil, errors.Wrapf(sdkerrors.ErrInsufficientFee, "invalid threshold")
 		}
 
+		if int(msg.GetNewThreshold()) > len(multisig_account_details.Signers)+1 {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid threshold: exceeds signer count %d", len(multisig_account_details.Signers)+1)
+		}
+
+		// an absolute threshold replaces the threshold percentage
 		multisig_account_details.Threshold = msg.GetNewThreshold()
+		multisig_account_details.ThresholdPercentage = ""
 	}
 
 	// Update the signer list
 	multisig_account_details.Signers = append(multisig_account_details.Signers, new_signer)
 
 	// Update the multisig account
-	ms.k.MultisigAccounts.Set(ctx, multisig_address, multisig_account_details)
+	ms.k.SetMultisigAccount(ctx, multisig_address, multisig_account_details)
 
 	return &types.MsgAddMultisigSignerResponse{}, nil
 }
@@ -142,27 +186,54 @@ func (ms msgServer) InitializeMultisigProposal(ctx context.Context, msg *types.M
 	}
 
 	// validate account
-	multisig_account_details, err := ms.k.MultisigAccounts.Get(ctx, multisig_address)
+	multisig_account_details, err := ms.k.GetMultisigAccount(ctx, multisig_address)
 	if err != nil {
 		return nil, errors.Wrapf(sdkerrors.ErrConflict, "Invalid multisig: Account not found")
 	}
 
+	if multisig_account_details.Frozen {
+		return nil, errors.Wrapf(types.ErrAccountFrozen, "%s", msg.MultisigAddress)
+	}
+
 	// validate proposer
 	if contains(multisig_account_details.Signers, proposer) == false {
 		return nil, errors.Wrap(sdkerrors.ErrConflict, "Invalid proposer: Permission Denied")
 	}
 
+	if msg.Message == nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid call: message is empty")
+	}
+
 	// Compute call hash
 	call_hash := blake2b.Sum256(msg.Message.Value)
 
 	// validate call
 	existing_proposal, err := ms.k.OrmDB.ProposalTable().GetByMultisigAddressCallHash(ctx, multisig_address, call_hash[:])
-	if err != nil {
+	if err == nil {
 		return &types.MsgInitializeMultisigResponse{
 			ProposalId: existing_proposal.Id,
 		}, nil
 	}
 
+	params, err := ms.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// cap open proposals
+	open_proposals, err := ms.k.countOpenProposals(ctx, multisig_address)
+	if err != nil {
+		return nil, err
+	}
+	if open_proposals >= uint64(params.MaxOpenProposals) {
+		return nil, errors.Wrapf(types.ErrTooManyProposals, "%d open proposals", open_proposals)
+	}
+
+	var expiry_height int64
+	if params.ProposalExpiryBlocks > 0 {
+		expiry_height = sdk.UnwrapSDKContext(ctx).BlockHeight() + int64(params.ProposalExpiryBlocks)
+	}
+
 	// approvals
 	approvals := [][]byte{proposer}
 
@@ -170,10 +241,11 @@ func (ms msgServer) InitializeMultisigProposal(ctx context.Context, msg *types.M
 		ctx,
 		&multisigv1.Proposal{
 			Depositor:       proposer,
-			Deposit:         10,
+			Deposit:         depositToAPI(params.Deposit),
 			MultisigAddress: multisig_address,
 			Approvals:       approvals,
 			CallHash:        call_hash[:],
+			ExpiryHeight:    expiry_height,
 		},
 	)
 	if err != nil {
@@ -181,8 +253,7 @@ func (ms msgServer) InitializeMultisigProposal(ctx context.Context, msg *types.M
 	}
 
 	// collect deposit
-	deposit := sdk.NewCoins(sdk.NewCoin("uom", math.NewInt(int64(10))))
-	err = ms.k.BankKeeper.SendCoinsFromAccountToModule(ctx, proposer, types.ModuleName, deposit)
+	err = ms.k.BankKeeper.SendCoinsFromAccountToModule(ctx, proposer, types.ModuleName, sdk.NewCoins(params.Deposit))
 	if err != nil {
 		return nil, err
 	}
@@ -206,11 +277,15 @@ func (ms msgServer) ApproveMultisigProposal(ctx context.Context, msg *types.MsgA
 	}
 
 	// validate account
-	multisig_account_details, err := ms.k.MultisigAccounts.Get(ctx, multisig_address)
+	multisig_account_details, err := ms.k.GetMultisigAccount(ctx, multisig_address)
 	if err != nil {
 		return nil, errors.Wrapf(sdkerrors.ErrConflict, "Invalid multisig: Account not found")
 	}
 
+	if multisig_account_details.Frozen {
+		return nil, errors.Wrapf(types.ErrAccountFrozen, "%s", msg.MultisigAddress)
+	}
+
 	// validate approver
 	if contains(multisig_account_details.Signers, approver) == false {
 		return nil, errors.Wrap(sdkerrors.ErrConflict, "Invalid proposer: Permission Denied")
@@ -218,17 +293,33 @@ func (ms msgServer) ApproveMultisigProposal(ctx context.Context, msg *types.MsgA
 
 	// validate proposal
 	proposal, err := ms.k.OrmDB.ProposalTable().Get(ctx, msg.GetProposalId())
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(proposal.MultisigAddress, multisig_address) {
+		return nil, errors.Wrap(sdkerrors.ErrConflict, "Invalid proposal: Multisig address mismatch")
+	}
+
 	if contains(proposal.Approvals, approver) {
 		return &types.MsgApproveMultisigProposalResponse{}, nil
 	}
 
 	// Add approval if needed
-	if len(proposal.Approvals) < int(multisig_account_details.Threshold) {
+	threshold, err := multisig_account_details.EffectiveThreshold()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(proposal.Approvals) < int(threshold) {
 		// approve proposal
 		proposal.Approvals = append(proposal.Approvals, approver)
+		proposal.Rejections = remove(proposal.Rejections, approver)
 
 		// update proposal
-		ms.k.OrmDB.ProposalTable().Update(ctx, proposal)
+		if err := ms.k.OrmDB.ProposalTable().Update(ctx, proposal); err != nil {
+			return nil, err
+		}
 	}
 
 	return &types.MsgApproveMultisigProposalResponse{}, nil
@@ -248,16 +339,24 @@ func (ms msgServer) ApproveAndDispatchMultisigProposal(ctx context.Context, msg
 	}
 
 	// validate account
-	multisig_account_details, err := ms.k.MultisigAccounts.Get(ctx, multisig_address)
+	multisig_account_details, err := ms.k.GetMultisigAccount(ctx, multisig_address)
 	if err != nil {
 		return nil, errors.Wrapf(sdkerrors.ErrConflict, "Invalid multisig: Account not found")
 	}
 
+	if multisig_account_details.Frozen {
+		return nil, errors.Wrapf(types.ErrAccountFrozen, "%s", msg.MultisigAddress)
+	}
+
 	// validate approver
 	if contains(multisig_account_details.Signers, approver) == false {
 		return nil, errors.Wrap(sdkerrors.ErrConflict, "Invalid proposer: Permission Denied")
 	}
 
+	if msg.Message == nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid call: message is empty")
+	}
+
 	// Compute call hash
 	call_hash := blake2b.Sum256(msg.Message.Value)
 
@@ -278,42 +377,468 @@ func (ms msgServer) ApproveAndDispatchMultisigProposal(ctx context.Context, msg
 	}
 
 	// check threshold
-	if approvals_len < int(multisig_account_details.Threshold) {
-		return nil, errors.Wrap(sdkerrors.ErrInsufficientFee, "Cannot dispatch proposal, threshold not met")
+	threshold, err := multisig_account_details.EffectiveThreshold()
+	if err != nil {
+		return nil, err
 	}
 
-	// dispatch call
-	res, err := ms.k.DispatchActions(ctx, multisig_address, msg)
+	if approvals_len < int(threshold) {
+		return nil, errors.Wrap(sdkerrors.ErrInsufficientFee, "Cannot dispatch proposal, threshold not met")
+	}
 
-	// remove proposal
-	ms.k.OrmDB.ProposalTable().Delete(ctx, proposal)
+	res, err := ms.k.executeProposal(ctx, proposal, msg.Message)
+	if err != nil {
+		return nil, err
+	}
 
 	return &types.MsgApproveAndDispatchMultisigProposalResponse{
 		TransactionHash: string(res.Data),
 	}, nil
 }
 
+// BatchApproveMultisigProposal implements types.MsgServer.
+func (ms msgServer) BatchApproveMultisigProposal(ctx context.Context, msg *types.MsgBatchApproveMultisigProposalParams) (*types.MsgBatchApproveMultisigProposalResponse, error) {
+	if _, err := ms.k.ac.StringToBytes(msg.Submitter); err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid submitter address (%s)", msg.Submitter)
+	}
+
+	multisig_address, err := ms.k.ac.StringToBytes(msg.MultisigAddress)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid multisig address (%s)", msg.MultisigAddress)
+	}
+
+	// validate account
+	multisig_account_details, err := ms.k.GetMultisigAccount(ctx, multisig_address)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrConflict, "Invalid multisig: Account not found")
+	}
+
+	if multisig_account_details.Frozen {
+		return nil, errors.Wrapf(types.ErrAccountFrozen, "%s", msg.MultisigAddress)
+	}
+
+	// validate proposal
+	proposal, err := ms.k.OrmDB.ProposalTable().Get(ctx, msg.GetProposalId())
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(proposal.MultisigAddress, multisig_address) {
+		return nil, errors.Wrap(sdkerrors.ErrConflict, "Invalid proposal: Multisig address mismatch")
+	}
+
+	// verify approvals
+	signBytes := types.ApprovalSignBytes(sdk.UnwrapSDKContext(ctx).ChainID(), msg.MultisigAddress, proposal.Id, proposal.CallHash)
+	for _, sig := range msg.Signatures {
+		approver, err := ms.k.ac.StringToBytes(sig.Signer)
+		if err != nil {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid signer address (%s)", sig.Signer)
+		}
+
+		if contains(multisig_account_details.Signers, approver) == false {
+			return nil, errors.Wrap(sdkerrors.ErrConflict, "Invalid approver: Permission Denied")
+		}
+
+		if err := ms.k.VerifyApprovalSignature(ctx, approver, signBytes, sig.Signature); err != nil {
+			return nil, err
+		}
+
+		if !contains(proposal.Approvals, approver) {
+			proposal.Approvals = append(proposal.Approvals, approver)
+			proposal.Rejections = remove(proposal.Rejections, approver)
+		}
+	}
+
+	// update proposal
+	if err := ms.k.OrmDB.ProposalTable().Update(ctx, proposal); err != nil {
+		return nil, err
+	}
+
+	if msg.Message == nil {
+		return &types.MsgBatchApproveMultisigProposalResponse{}, nil
+	}
+
+	// validate call
+	call_hash := blake2b.Sum256(msg.Message.Value)
+	if !bytes.Equal(call_hash[:], proposal.CallHash) {
+		return nil, errors.Wrap(sdkerrors.ErrConflict, "Proposal Id does not match with call_hash")
+	}
+
+	// check threshold
+	threshold, err := multisig_account_details.EffectiveThreshold()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(proposal.Approvals) < int(threshold) {
+		return nil, errors.Wrap(sdkerrors.ErrInsufficientFee, "Cannot dispatch proposal, threshold not met")
+	}
+
+	res, err := ms.k.executeProposal(ctx, proposal, msg.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBatchApproveMultisigProposalResponse{
+		Dispatched:      true,
+		TransactionHash: string(res.Data),
+	}, nil
+}
+
+// executeProposal dispatches the call of an approved proposal, then removes the proposal
+// and returns its deposit.
+func (k Keeper) executeProposal(ctx context.Context, proposal *multisigv1.Proposal, message *codectypes.Any) (*sdk.Result, error) {
+	// unpack call
+	var call sdk.Msg
+	if err := k.cdc.UnpackAny(message, &call); err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "Invalid call: %s", err)
+	}
+
+	// dispatch call
+	res, err := k.DispatchActions(ctx, proposal.MultisigAddress, call)
+	if err != nil {
+		return nil, err
+	}
+
+	// remove proposal
+	if err := k.OrmDB.ProposalTable().Delete(ctx, proposal); err != nil {
+		return nil, err
+	}
+
+	// return deposit
+	if err := k.refundDeposit(ctx, proposal); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DispatchActions executes msg on behalf of the multisig account. The message must
+// be signed by the multisig account only, e.g. an interchain accounts controller
+// MsgRegisterInterchainAccount or MsgSendTx owned by the multisig, or an approval
+// of a proposal of another multisig account the multisig is a signer of.
 func (k Keeper) DispatchActions(ctx context.Context, multisig_address sdk.AccAddress, msg sdk.Msg) (*sdk.Result, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
+	if err := k.validateCallSigners(multisig_address, msg); err != nil {
+		return nil, err
+	}
+
+	// frozen accounts can not dispatch calls, including the scheduled ones
+	if details, err := k.GetMultisigAccount(ctx, multisig_address); err == nil && details.Frozen {
+		return nil, errors.Wrapf(types.ErrAccountFrozen, "%s", multisig_address)
+	}
+
 	handler := k.router.Handler(msg)
 	if handler == nil {
 		return nil, sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", sdk.MsgTypeURL(msg))
 	}
 
-	msgResp, err := handler(sdkCtx, msg)
+	// bound the calls dispatched by nested multisig accounts
+	dispatchCtx, err := k.enterDispatch(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	msgResp, err := handler(dispatchCtx, msg)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to execute message; message %v", msg)
 	}
 
+	// propagate the events of the dispatched call, e.g. send_packet for relayers
+	sdkCtx.EventManager().EmitEvents(msgResp.GetEvents())
+
 	return msgResp, nil
 }
 
-// CancelMultisigProposal implements types.MsgServer.
+// validateCallSigners checks that msg is signed by the multisig account only.
+func (k Keeper) validateCallSigners(multisig_address sdk.AccAddress, msg sdk.Msg) error {
+	signers, _, err := k.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "Invalid call: %s", err)
+	}
+
+	for _, signer := range signers {
+		if !bytes.Equal(signer, multisig_address) {
+			return errors.Wrapf(sdkerrors.ErrUnauthorized, "Invalid call: signer must be the multisig account (%s)", multisig_address)
+		}
+	}
+
+	return nil
+}
+
+// ScheduleMultisigCall implements types.MsgServer.
+func (ms msgServer) ScheduleMultisigCall(ctx context.Context, msg *types.MsgScheduleMultisigCallParams) (*types.MsgScheduleMultisigCallResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	multisig_address, err := ms.k.ac.StringToBytes(msg.MultisigAddress)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid multisig address (%s)", msg.MultisigAddress)
+	}
+
+	// validate account
+	if has, err := ms.k.HasMultisigAccount(ctx, multisig_address); err != nil || !has {
+		return nil, errors.Wrapf(sdkerrors.ErrConflict, "Invalid multisig: Account not found")
+	}
+
+	if msg.Message == nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid call: message is empty")
+	}
+
+	// validate call
+	var call sdk.Msg
+	if err := ms.k.cdc.UnpackAny(msg.Message, &call); err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "Invalid call: %s", err)
+	}
+
+	if err := ms.k.validateCallSigners(multisig_address, call); err != nil {
+		return nil, err
+	}
+
+	// validate schedule
+	max_runs := msg.MaxRuns
+	if msg.IntervalBlocks == 0 {
+		if max_runs > 1 {
+			return nil, errors.Wrap(types.ErrInvalidSchedule, "multiple runs require an interval")
+		}
+		max_runs = 1
+	} else if max_runs == 0 {
+		return nil, errors.Wrap(types.ErrInvalidSchedule, "recurring calls require a maximum number of runs")
+	}
+
+	next_height := msg.StartHeight
+	if next_height == 0 {
+		next_height = sdkCtx.BlockHeight()
+	}
+	if next_height < sdkCtx.BlockHeight() {
+		return nil, errors.Wrapf(types.ErrInvalidSchedule, "start height %d is in the past", next_height)
+	}
+
+	seq, err := ms.k.ScheduleSequence.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := types.Schedule{
+		Id:              seq + 1,
+		MultisigAddress: multisig_address,
+		Message:         msg.Message,
+		NextHeight:      next_height,
+		StartTime:       msg.StartTime,
+		IntervalBlocks:  msg.IntervalBlocks,
+		MaxRuns:         max_runs,
+	}
+	if err := ms.k.setSchedule(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleMultisigCallResponse{
+		ScheduleId: schedule.Id,
+	}, nil
+}
+
+// CancelMultisigSchedule implements types.MsgServer.
+func (ms msgServer) CancelMultisigSchedule(ctx context.Context, msg *types.MsgCancelMultisigScheduleParams) (*types.MsgCancelMultisigScheduleResponse, error) {
+	multisig_address, err := ms.k.ac.StringToBytes(msg.MultisigAddress)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid multisig address (%s)", msg.MultisigAddress)
+	}
+
+	schedule, err := ms.k.Schedules.Get(ctx, msg.ScheduleId)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "Invalid schedule: %d not found", msg.ScheduleId)
+	}
+
+	if !bytes.Equal(schedule.MultisigAddress, multisig_address) {
+		return nil, errors.Wrap(sdkerrors.ErrUnauthorized, "Invalid schedule: Multisig address mismatch")
+	}
+
+	if err := ms.k.removeSchedule(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	remaining := schedule.MaxRuns - schedule.Runs
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventScheduleCancelled{
+		ScheduleId:      schedule.Id,
+		MultisigAddress: msg.MultisigAddress,
+		RemainingRuns:   remaining,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelMultisigScheduleResponse{
+		RemainingRuns: remaining,
+	}, nil
+}
+
+// FreezeMultisigAccount implements types.MsgServer.
+func (ms msgServer) FreezeMultisigAccount(ctx context.Context, msg *types.MsgFreezeMultisigAccount) (*types.MsgFreezeMultisigAccountResponse, error) {
+	if ms.k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, msg.Authority)
+	}
+
+	multisig_address, err := ms.k.ac.StringToBytes(msg.MultisigAddress)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid multisig address (%s)", msg.MultisigAddress)
+	}
+
+	multisig_account_details, err := ms.k.GetMultisigAccount(ctx, multisig_address)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "Invalid multisig: Account not found")
+	}
+
+	if multisig_account_details.Frozen {
+		return nil, errors.Wrapf(types.ErrAccountFrozen, "%s", msg.MultisigAddress)
+	}
+
+	multisig_account_details.Frozen = true
+	if err := ms.k.SetMultisigAccount(ctx, multisig_address, multisig_account_details); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventMultisigAccountFrozen{
+		MultisigAddress: msg.MultisigAddress,
+		Reason:          msg.Reason,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFreezeMultisigAccountResponse{}, nil
+}
+
+// RecoverMultisigAccount implements types.MsgServer.
+func (ms msgServer) RecoverMultisigAccount(ctx context.Context, msg *types.MsgRecoverMultisigAccount) (*types.MsgRecoverMultisigAccountResponse, error) {
+	if ms.k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, msg.Authority)
+	}
+
+	multisig_address, err := ms.k.ac.StringToBytes(msg.MultisigAddress)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid multisig address (%s)", msg.MultisigAddress)
+	}
+
+	multisig_account_details, err := ms.k.GetMultisigAccount(ctx, multisig_address)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "Invalid multisig: Account not found")
+	}
+
+	// validate signer list
+	signers := make([][]byte, 0, len(msg.Signers))
+	for _, s := range msg.Signers {
+		signer, err := ms.k.ac.StringToBytes(s)
+		if err != nil {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid signer address (%s)", s)
+		}
+
+		if contains(signers, signer) {
+			return nil, errors.Wrap(sdkerrors.ErrConflict, "Duplicate signer")
+		}
+		signers = append(signers, signer)
+	}
+
+	if err := types.ValidateThreshold(msg.Threshold, msg.ThresholdPercentage, len(signers)); err != nil {
+		return nil, err
+	}
+
+	// validate nested multisig signers
+	if err := ms.k.ValidateNestedSigners(ctx, multisig_address, signers); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.pruneApprovals(ctx, multisig_address, signers); err != nil {
+		return nil, err
+	}
+
+	multisig_account_details.Signers = signers
+	multisig_account_details.Threshold = msg.Threshold
+	multisig_account_details.ThresholdPercentage = msg.ThresholdPercentage
+	multisig_account_details.Frozen = false
+	if err := ms.k.SetMultisigAccount(ctx, multisig_address, multisig_account_details); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventMultisigAccountRecovered{
+		MultisigAddress:     msg.MultisigAddress,
+		Signers:             msg.Signers,
+		Threshold:           msg.Threshold,
+		ThresholdPercentage: msg.ThresholdPercentage,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRecoverMultisigAccountResponse{}, nil
+}
+
+// CancelMultisigProposal implements types.MsgServer. The deposit is refunded when the
+// depositor cancels the proposal and forfeited when another signer rejects it.
 func (ms msgServer) CancelMultisigProposal(ctx context.Context, msg *types.MsgCancelMultisigProposalParams) (*types.MsgCancelMultisigProposalResponse, error) {
+	rejecter, err := ms.k.ac.StringToBytes(msg.Rejecter)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid signer address (%s)", msg.Rejecter)
+	}
 
-	panic("CancelMultisigProposal is unimplemented")
-	return &types.MsgCancelMultisigProposalResponse{}, nil
+	multisig_address, err := ms.k.ac.StringToBytes(msg.MultisigAddress)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid multisig address (%s)", msg.MultisigAddress)
+	}
+
+	// validate proposal
+	proposal, err := ms.k.OrmDB.ProposalTable().Get(ctx, msg.ProposalId)
+	if err != nil || !bytes.Equal(proposal.MultisigAddress, multisig_address) {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "Invalid proposal: %d", msg.ProposalId)
+	}
+
+	// cancelled by the depositor
+	if bytes.Equal(proposal.Depositor, rejecter) {
+		if err := ms.k.OrmDB.ProposalTable().Delete(ctx, proposal); err != nil {
+			return nil, err
+		}
+
+		if err := ms.k.refundDeposit(ctx, proposal); err != nil {
+			return nil, err
+		}
+		return &types.MsgCancelMultisigProposalResponse{}, nil
+	}
+
+	// rejected by a signer
+	multisig_account_details, err := ms.k.GetMultisigAccount(ctx, multisig_address)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrConflict, "Invalid multisig: Account not found")
+	}
+
+	if !contains(multisig_account_details.Signers, rejecter) {
+		return nil, errors.Wrap(sdkerrors.ErrConflict, "Invalid rejecter: Permission Denied")
+	}
+
+	if contains(proposal.Rejections, rejecter) {
+		return nil, errors.Wrap(sdkerrors.ErrConflict, "Invalid rejecter: Proposal already rejected by the signer")
+	}
+
+	proposal.Rejections = append(proposal.Rejections, rejecter)
+	proposal.Approvals = remove(proposal.Approvals, rejecter)
+
+	// the deposit is forfeited once the proposal is rejected by the threshold of signers
+	threshold, err := multisig_account_details.EffectiveThreshold()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(proposal.Rejections) < int(threshold) {
+		if err := ms.k.OrmDB.ProposalTable().Update(ctx, proposal); err != nil {
+			return nil, err
+		}
+		return &types.MsgCancelMultisigProposalResponse{}, nil
+	}
+
+	if err := ms.k.OrmDB.ProposalTable().Delete(ctx, proposal); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.forfeitDeposit(ctx, proposal, false); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelMultisigProposalResponse{Rejected: true}, nil
 }
 
 // CleanupMultisigProposal implements types.MsgServer.
@@ -341,10 +866,21 @@ func DeriveMultisigAccountID(seed uint32) sdk.AccAddress {
 }
 
 func contains(signers [][]byte, signer []byte) bool {
-	for _, signer := range signers {
-		if bytes.Equal(signer, signer) {
+	for _, s := range signers {
+		if bytes.Equal(s, signer) {
 			return true
 		}
 	}
 	return false
 }
+
+// remove returns signers without signer.
+func remove(signers [][]byte, signer []byte) [][]byte {
+	var res [][]byte
+	for _, s := range signers {
+		if !bytes.Equal(s, signer) {
+			res = append(res, s)
+		}
+	}
+	return res
+}
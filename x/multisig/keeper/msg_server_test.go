@@ -5,6 +5,13 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/DaevMithran/dmchain/x/multisig/keeper"
 	"github.com/DaevMithran/dmchain/x/multisig/types"
 )
 
@@ -25,6 +32,31 @@ func TestParams(t *testing.T) {
 			},
 			err: true,
 		},
+		{
+			name: "fail; zero max nesting depth",
+			request: &types.MsgUpdateParams{
+				Authority: f.govModAddr,
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.MaxNestingDepth = 0
+					return params
+				}(),
+			},
+			err: true,
+		},
+		{
+			name: "fail; fee budget without a period",
+			request: &types.MsgUpdateParams{
+				Authority: f.govModAddr,
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.FeeBudget = sdk.NewCoins(sdk.NewInt64Coin("uom", 100))
+					params.FeeBudgetPeriod = 0
+					return params
+				}(),
+			},
+			err: true,
+		},
 		{
 			name: "success",
 			request: &types.MsgUpdateParams{
@@ -54,3 +86,152 @@ func TestParams(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateMultisigAccount(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+
+	testCases := []struct {
+		name    string
+		request *types.MsgCreateMultisigAccountParams
+		err     bool
+	}{
+		{
+			name: "fail; duplicate signer",
+			request: &types.MsgCreateMultisigAccountParams{
+				Authority: bech32(t, f.addrs[0]),
+				Seed:      1,
+				Threshold: 1,
+				Signers:   [][]byte{f.addrs[1], f.addrs[0]},
+			},
+			err: true,
+		},
+		{
+			name: "fail; threshold exceeds signer count",
+			request: &types.MsgCreateMultisigAccountParams{
+				Authority: bech32(t, f.addrs[0]),
+				Seed:      1,
+				Threshold: 3,
+				Signers:   [][]byte{f.addrs[1]},
+			},
+			err: true,
+		},
+		{
+			name: "fail; threshold percentage above one",
+			request: &types.MsgCreateMultisigAccountParams{
+				Authority:           bech32(t, f.addrs[0]),
+				Seed:                1,
+				ThresholdPercentage: "1.01",
+				Signers:             [][]byte{f.addrs[1]},
+			},
+			err: true,
+		},
+		{
+			name: "fail; threshold and threshold percentage",
+			request: &types.MsgCreateMultisigAccountParams{
+				Authority:           bech32(t, f.addrs[0]),
+				Seed:                1,
+				Threshold:           1,
+				ThresholdPercentage: "0.5",
+				Signers:             [][]byte{f.addrs[1]},
+			},
+			err: true,
+		},
+		{
+			name: "success",
+			request: &types.MsgCreateMultisigAccountParams{
+				Authority: bech32(t, f.addrs[0]),
+				Seed:      1,
+				Threshold: 2,
+				Signers:   [][]byte{f.addrs[1]},
+			},
+			err: false,
+		},
+		{
+			name: "success; threshold percentage",
+			request: &types.MsgCreateMultisigAccountParams{
+				Authority:           bech32(t, f.addrs[0]),
+				Seed:                2,
+				ThresholdPercentage: "0.5",
+				Signers:             [][]byte{f.addrs[1]},
+			},
+			err: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := f.msgServer.CreateMultisigAccount(f.ctx, tc.request)
+			if tc.err {
+				require.Error(err)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+}
+
+func TestThresholdPercentage(t *testing.T) {
+	f := SetupTest(t)
+
+	deposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	require.NoError(t, f.bankkeeper.MintCoins(f.ctx, minttypes.ModuleName, deposit))
+	require.NoError(t, f.bankkeeper.SendCoinsFromModuleToAccount(f.ctx, minttypes.ModuleName, f.addrs[0], deposit))
+
+	res, err := f.msgServer.CreateMultisigAccount(f.ctx, &types.MsgCreateMultisigAccountParams{
+		Authority:           bech32(t, f.addrs[0]),
+		Seed:                1,
+		ThresholdPercentage: "0.5",
+		Signers:             [][]byte{f.addrs[1]},
+	})
+	require.NoError(t, err)
+	multisig := res.MultisigAddress
+
+	// one of two signers
+	details, err := f.k.GetMultisigAccount(f.ctx, keeper.DeriveMultisigAccountID(1))
+	require.NoError(t, err)
+	threshold, err := details.EffectiveThreshold()
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), threshold)
+
+	// the required approvals follow the signer set: two of three signers
+	_, err = f.msgServer.AddMultisigSigner(f.ctx, &types.MsgAddMultisigSignerParams{
+		MultisigAddress: multisig,
+		Signer:          bech32(t, f.addrs[2]),
+	})
+	require.NoError(t, err)
+
+	call, err := codectypes.NewAnyWithValue(&types.MsgAddMultisigSignerParams{
+		MultisigAddress: multisig,
+		Signer:          bech32(t, []byte("new_signer__________")),
+	})
+	require.NoError(t, err)
+
+	proposal, err := f.msgServer.InitializeMultisigProposal(f.ctx, &types.MsgInitializeMultisigProposalParams{
+		MultisigAddress: multisig,
+		Proposer:        bech32(t, f.addrs[0]),
+		Message:         call,
+	})
+	require.NoError(t, err)
+
+	dispatch := &types.MsgApproveAndDispatchMultisigProposalParams{
+		MultisigAddress: multisig,
+		Approver:        bech32(t, f.addrs[0]),
+		ProposalId:      proposal.ProposalId,
+		Message:         call,
+	}
+	_, err = f.msgServer.ApproveAndDispatchMultisigProposal(f.ctx, dispatch)
+	require.Error(t, err)
+
+	dispatch.Approver = bech32(t, f.addrs[1])
+	_, err = f.msgServer.ApproveAndDispatchMultisigProposal(f.ctx, dispatch)
+	require.NoError(t, err)
+
+	details, err = f.k.GetMultisigAccount(f.ctx, keeper.DeriveMultisigAccountID(1))
+	require.NoError(t, err)
+	require.Len(t, details.Signers, 4)
+	threshold, err = details.EffectiveThreshold()
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), threshold)
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/orm/types/ormerrors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/DaevMithran/dmchain/x/multisig/types"
+)
+
+// dispatchDepthKey is the context key holding the number of multisig dispatches
+// currently being executed.
+type dispatchDepthKey struct{}
+
+// ValidateNestedSigners checks that the signers can be added to the multisig account
+// without creating a cycle, and that the account then nests at most MaxNestingDepth
+// multisig levels.
+//
+// Only the levels below the account are checked as there is no index of the accounts
+// it is a signer of; DispatchActions bounds the levels above it at execution time.
+func (k Keeper) ValidateNestedSigners(ctx context.Context, multisigAddress []byte, signers [][]byte) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, signer := range signers {
+		if _, err := k.signerDepth(ctx, multisigAddress, signer, params.MaxNestingDepth-1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// signerDepth returns the number of multisig levels nested in signer, counting signer
+// itself when it is a multisig account. It fails when root is reached, as root would then
+// be its own signer, or when more than limit levels are nested.
+func (k Keeper) signerDepth(ctx context.Context, root, signer []byte, limit uint32) (uint32, error) {
+	if bytes.Equal(root, signer) {
+		return 0, errors.Wrapf(types.ErrNestingCycle, "%s", sdk.AccAddress(signer))
+	}
+
+	details, err := k.GetMultisigAccount(ctx, signer)
+	if ormerrors.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if limit == 0 {
+		return 0, errors.Wrapf(types.ErrMaxNestingDepth, "%s nests too many multisig levels", sdk.AccAddress(signer))
+	}
+
+	var depth uint32
+	for _, s := range details.Signers {
+		d, err := k.signerDepth(ctx, root, s, limit-1)
+		if err != nil {
+			return 0, err
+		}
+
+		depth = max(depth, d)
+	}
+
+	return depth + 1, nil
+}
+
+// enterDispatch returns a context for executing a call of a multisig account, failing
+// when the nested dispatches, e.g. an inner multisig dispatching an approval of the
+// outer one, exceed MaxNestingDepth.
+func (k Keeper) enterDispatch(ctx sdk.Context) (sdk.Context, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	depth, _ := ctx.Value(dispatchDepthKey{}).(uint32)
+	if depth >= params.MaxNestingDepth {
+		return ctx, errors.Wrapf(types.ErrMaxNestingDepth, "%d nested dispatches", depth+1)
+	}
+
+	return ctx.WithValue(dispatchDepthKey{}, depth+1), nil
+}
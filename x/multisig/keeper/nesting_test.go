@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/DaevMithran/dmchain/x/multisig/keeper"
+	"github.com/DaevMithran/dmchain/x/multisig/types"
+)
+
+// setupNestedMultisig creates an inner 2 of 2 multisig account of addrs 1 and 2, and an
+// outer 2 of 2 multisig account of addrs 0 and the inner account.
+func setupNestedMultisig(t *testing.T, f *testFixture) (outer, inner string) {
+	t.Helper()
+
+	deposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	for _, addr := range f.addrs[:2] {
+		require.NoError(t, f.bankkeeper.MintCoins(f.ctx, minttypes.ModuleName, deposit))
+		require.NoError(t, f.bankkeeper.SendCoinsFromModuleToAccount(f.ctx, minttypes.ModuleName, addr, deposit))
+	}
+
+	res, err := f.msgServer.CreateMultisigAccount(f.ctx, &types.MsgCreateMultisigAccountParams{
+		Authority: bech32(t, f.addrs[1]),
+		Seed:      2,
+		Threshold: 2,
+		Signers:   [][]byte{f.addrs[2]},
+	})
+	require.NoError(t, err)
+	inner = res.MultisigAddress
+
+	res, err = f.msgServer.CreateMultisigAccount(f.ctx, &types.MsgCreateMultisigAccountParams{
+		Authority: bech32(t, f.addrs[0]),
+		Seed:      1,
+		Threshold: 2,
+		Signers:   [][]byte{keeper.DeriveMultisigAccountID(2)},
+	})
+	require.NoError(t, err)
+
+	return res.MultisigAddress, inner
+}
+
+func TestNestedMultisigApproval(t *testing.T) {
+	f := SetupTest(t)
+	outer, inner := setupNestedMultisig(t, f)
+
+	// propose a new signer of the outer multisig
+	newSigner := []byte("new_signer__________")
+	call, err := codectypes.NewAnyWithValue(&types.MsgAddMultisigSignerParams{
+		MultisigAddress: outer,
+		Signer:          bech32(t, newSigner),
+	})
+	require.NoError(t, err)
+
+	proposal, err := f.msgServer.InitializeMultisigProposal(f.ctx, &types.MsgInitializeMultisigProposalParams{
+		MultisigAddress: outer,
+		Proposer:        bech32(t, f.addrs[0]),
+		Message:         call,
+	})
+	require.NoError(t, err)
+
+	// the inner multisig approves and dispatches the outer proposal through its own proposal
+	innerCall, err := codectypes.NewAnyWithValue(&types.MsgApproveAndDispatchMultisigProposalParams{
+		MultisigAddress: outer,
+		ProposalId:      proposal.ProposalId,
+		Approver:        inner,
+		Message:         call,
+	})
+	require.NoError(t, err)
+
+	innerProposal, err := f.msgServer.InitializeMultisigProposal(f.ctx, &types.MsgInitializeMultisigProposalParams{
+		MultisigAddress: inner,
+		Proposer:        bech32(t, f.addrs[1]),
+		Message:         innerCall,
+	})
+	require.NoError(t, err)
+
+	dispatch := &types.MsgApproveAndDispatchMultisigProposalParams{
+		MultisigAddress: inner,
+		ProposalId:      innerProposal.ProposalId,
+		Approver:        bech32(t, f.addrs[2]),
+		Message:         innerCall,
+	}
+
+	// a single level of dispatches is not enough for the nested approval
+	params := types.DefaultParams()
+	params.MaxNestingDepth = 1
+	require.NoError(t, f.k.Params.Set(f.ctx, params))
+
+	_, err = f.msgServer.ApproveAndDispatchMultisigProposal(f.ctx, dispatch)
+	require.ErrorIs(t, err, types.ErrMaxNestingDepth)
+
+	require.NoError(t, f.k.Params.Set(f.ctx, types.DefaultParams()))
+
+	_, err = f.msgServer.ApproveAndDispatchMultisigProposal(f.ctx, dispatch)
+	require.NoError(t, err)
+
+	details, err := f.k.GetMultisigAccount(f.ctx, keeper.DeriveMultisigAccountID(1))
+	require.NoError(t, err)
+	require.Contains(t, details.Signers, newSigner)
+}
+
+func TestNestedMultisigSigners(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(f *testFixture, outer, inner string) error
+		err      error
+	}{
+		{
+			name: "fail; multisig signs for itself",
+			malleate: func(f *testFixture, outer, _ string) error {
+				_, err := f.msgServer.AddMultisigSigner(f.ctx, &types.MsgAddMultisigSignerParams{
+					MultisigAddress: outer,
+					Signer:          outer,
+				})
+				return err
+			},
+			err: types.ErrNestingCycle,
+		},
+		{
+			name: "fail; outer multisig signs for the inner one",
+			malleate: func(f *testFixture, outer, inner string) error {
+				_, err := f.msgServer.AddMultisigSigner(f.ctx, &types.MsgAddMultisigSignerParams{
+					MultisigAddress: inner,
+					Signer:          outer,
+				})
+				return err
+			},
+			err: types.ErrNestingCycle,
+		},
+		{
+			name: "fail; depth limit exceeded",
+			malleate: func(f *testFixture, _, _ string) error {
+				params := types.DefaultParams()
+				params.MaxNestingDepth = 2
+				require.NoError(t, f.k.Params.Set(f.ctx, params))
+
+				_, err := f.msgServer.CreateMultisigAccount(f.ctx, &types.MsgCreateMultisigAccountParams{
+					Authority: bech32(t, f.addrs[0]),
+					Seed:      3,
+					Threshold: 1,
+					Signers:   [][]byte{keeper.DeriveMultisigAccountID(1)},
+				})
+				return err
+			},
+			err: types.ErrMaxNestingDepth,
+		},
+		{
+			name: "success; within depth limit",
+			malleate: func(f *testFixture, _, _ string) error {
+				_, err := f.msgServer.CreateMultisigAccount(f.ctx, &types.MsgCreateMultisigAccountParams{
+					Authority: bech32(t, f.addrs[0]),
+					Seed:      3,
+					Threshold: 1,
+					Signers:   [][]byte{keeper.DeriveMultisigAccountID(1)},
+				})
+				return err
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := SetupTest(t)
+			outer, inner := setupNestedMultisig(t, f)
+
+			err := tc.malleate(f, outer, inner)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
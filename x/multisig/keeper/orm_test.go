@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	apiv1 "github.com/DaevMithran/dmchain/api/multisig/v1"
 	"github.com/stretchr/testify/require"
 )
@@ -12,12 +13,12 @@ func TestORM(t *testing.T) {
 
 	dt := f.k.OrmDB.ProposalTable()
 	acc := []byte("test_acc")
-	amt := uint64(7)
+	amt := "7"
 
 	err := dt.Insert(f.ctx, &apiv1.Proposal{
 		Id: 1,
 		Depositor: acc,
-		Deposit: 100,
+		Deposit: &basev1beta1.Coin{Denom: "stake", Amount: "100"},
 		Approvals: [][]byte{},
 		MultisigAddress: []byte("test_multisig_acc"),
 		CallHash: []byte("test call"),
@@ -31,5 +32,5 @@ func TestORM(t *testing.T) {
 	res, err := dt.Get(f.ctx, 1)
 	require.NoError(t, err)
 	require.NotNil(t, res)
-	require.EqualValues(t, amt, res.Deposit)
+	require.EqualValues(t, amt, res.Deposit.Amount)
 }
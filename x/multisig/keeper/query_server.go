@@ -3,8 +3,13 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 
+	apiv1 "github.com/DaevMithran/dmchain/api/multisig/v1"
 	"github.com/DaevMithran/dmchain/x/multisig/types"
 )
 
@@ -28,3 +33,105 @@ func (k Querier) Params(c context.Context, req *types.QueryParamsRequest) (*type
 
 	return &types.QueryParamsResponse{Params: &p}, nil
 }
+
+func (k Querier) MultisigAccount(c context.Context, req *types.QueryMultisigAccountRequest) (*types.QueryMultisigAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.Keeper.ac.StringToBytes(req.Address)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid multisig address (%s)", req.Address)
+	}
+
+	account, err := k.Keeper.GetMultisigAccount(ctx, address)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "Invalid multisig: Account not found")
+	}
+
+	interchainAccounts, err := k.Keeper.GetInterchainAccounts(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMultisigAccountResponse{
+		Account:            &account,
+		InterchainAccounts: interchainAccounts,
+	}, nil
+}
+
+func (k Querier) InterchainAccount(c context.Context, req *types.QueryInterchainAccountRequest) (*types.QueryInterchainAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if k.Keeper.ICAControllerKeeper == nil {
+		return nil, errors.Wrap(sdkerrors.ErrNotSupported, "interchain accounts controller is not enabled")
+	}
+
+	portID, err := icatypes.NewControllerPortID(req.MultisigAddress)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid multisig address (%s)", req.MultisigAddress)
+	}
+
+	address, found := k.Keeper.ICAControllerKeeper.GetInterchainAccountAddress(ctx, req.ConnectionId, portID)
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "no interchain account found for port %s on connection %s", portID, req.ConnectionId)
+	}
+
+	return &types.QueryInterchainAccountResponse{
+		InterchainAccountAddress: address,
+	}, nil
+}
+
+func (k Querier) MultisigAccountsByCreator(c context.Context, req *types.QueryMultisigAccountsByCreatorRequest) (*types.QueryMultisigAccountsByCreatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	creator, err := k.Keeper.ac.StringToBytes(req.Creator)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid creator address (%s)", req.Creator)
+	}
+
+	addresses, pageRes, err := k.Keeper.paginateAccounts(ctx, apiv1.MultisigAccountCreatorIndexKey{}.WithCreator(creator), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMultisigAccountsByCreatorResponse{
+		Addresses:  addresses,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Querier) FrozenMultisigAccounts(c context.Context, req *types.QueryFrozenMultisigAccountsRequest) (*types.QueryFrozenMultisigAccountsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	addresses, pageRes, err := k.Keeper.paginateAccounts(ctx, apiv1.MultisigAccountFrozenIndexKey{}.WithFrozen(true), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFrozenMultisigAccountsResponse{
+		Addresses:  addresses,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Querier) Schedule(c context.Context, req *types.QueryScheduleRequest) (*types.QueryScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	schedule, err := k.Keeper.Schedules.Get(ctx, req.Id)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "Invalid schedule: %d not found", req.Id)
+	}
+
+	var failures []types.ScheduleFailure
+	err = k.Keeper.ScheduleFailures.Walk(ctx, collections.NewPrefixedPairRange[uint64, uint64](req.Id), func(_ collections.Pair[uint64, uint64], failure types.ScheduleFailure) (bool, error) {
+		failures = append(failures, failure)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryScheduleResponse{
+		Schedule: &schedule,
+		Failures: failures,
+	}, nil
+}
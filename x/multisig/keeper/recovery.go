@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	apiv1 "github.com/DaevMithran/dmchain/api/multisig/v1"
+)
+
+// pruneApprovals removes the approvals of accounts which are no longer signers from the
+// open proposals of the multisig account, so that approvals given with compromised keys
+// do not count towards the threshold after a recovery.
+func (k Keeper) pruneApprovals(ctx context.Context, multisigAddress []byte, signers [][]byte) error {
+	it, err := k.OrmDB.ProposalTable().List(ctx, apiv1.ProposalMultisigAddressCallHashIndexKey{}.WithMultisigAddress(multisigAddress))
+	if err != nil {
+		return err
+	}
+
+	var proposals []*apiv1.Proposal
+	for it.Next() {
+		proposal, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		proposals = append(proposals, proposal)
+	}
+	it.Close()
+
+	for _, proposal := range proposals {
+		approvals := make([][]byte, 0, len(proposal.Approvals))
+		for _, approver := range proposal.Approvals {
+			if contains(signers, approver) {
+				approvals = append(approvals, approver)
+			}
+		}
+
+		if len(approvals) == len(proposal.Approvals) {
+			continue
+		}
+
+		proposal.Approvals = approvals
+		if err := k.OrmDB.ProposalTable().Update(ctx, proposal); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/DaevMithran/dmchain/x/multisig/types"
+)
+
+func TestFreezeMultisigAccount(t *testing.T) {
+	f := SetupTest(t)
+	multisig := bech32(t, setupProposal(t, f))
+
+	_, err := f.msgServer.FreezeMultisigAccount(f.ctx, &types.MsgFreezeMultisigAccount{
+		Authority:       bech32(t, f.addrs[0]),
+		MultisigAddress: multisig,
+	})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = f.msgServer.FreezeMultisigAccount(f.ctx, &types.MsgFreezeMultisigAccount{
+		Authority:       f.govModAddr,
+		MultisigAddress: multisig,
+		Reason:          "compromised keys",
+	})
+	require.NoError(t, err)
+
+	events := f.ctx.EventManager().Events()
+	require.Equal(t, sdk.MsgTypeURL(&types.EventMultisigAccountFrozen{})[1:], events[len(events)-1].Type)
+
+	res, err := f.queryServer.FrozenMultisigAccounts(f.ctx, &types.QueryFrozenMultisigAccountsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{multisig}, res.Addresses)
+
+	account, err := f.queryServer.MultisigAccount(f.ctx, &types.QueryMultisigAccountRequest{Address: multisig})
+	require.NoError(t, err)
+	require.True(t, account.Account.Frozen)
+
+	// frozen accounts can neither open nor approve proposals
+	_, err = f.msgServer.ApproveMultisigProposal(f.ctx, &types.MsgApproveMultisigProposalParams{
+		MultisigAddress: multisig,
+		Approver:        bech32(t, f.addrs[1]),
+		ProposalId:      1,
+	})
+	require.ErrorIs(t, err, types.ErrAccountFrozen)
+
+	call, err := codectypes.NewAnyWithValue(&types.MsgAddMultisigSignerParams{
+		MultisigAddress: multisig,
+		Signer:          bech32(t, []byte("other_signer________")),
+	})
+	require.NoError(t, err)
+
+	_, err = f.msgServer.InitializeMultisigProposal(f.ctx, &types.MsgInitializeMultisigProposalParams{
+		MultisigAddress: multisig,
+		Proposer:        bech32(t, f.addrs[1]),
+		Message:         call,
+	})
+	require.ErrorIs(t, err, types.ErrAccountFrozen)
+
+	_, err = f.msgServer.FreezeMultisigAccount(f.ctx, &types.MsgFreezeMultisigAccount{
+		Authority:       f.govModAddr,
+		MultisigAddress: multisig,
+	})
+	require.ErrorIs(t, err, types.ErrAccountFrozen)
+}
+
+func TestRecoverMultisigAccount(t *testing.T) {
+	newSigner := []byte("new_signer__________")
+
+	testCases := []struct {
+		name      string
+		signers   []string
+		threshold uint32
+		err       error
+	}{
+		{
+			name:      "fail; threshold exceeds signer count",
+			signers:   []string{"signer_1", "new"},
+			threshold: 3,
+			err:       types.ErrInvalidThreshold,
+		},
+		{
+			name:      "fail; duplicate signer",
+			signers:   []string{"signer_1", "signer_1"},
+			threshold: 1,
+			err:       sdkerrors.ErrConflict,
+		},
+		{
+			name:      "success",
+			signers:   []string{"signer_1", "new"},
+			threshold: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := SetupTest(t)
+			multisigAddress := setupProposal(t, f)
+			multisig := bech32(t, multisigAddress)
+
+			_, err := f.msgServer.FreezeMultisigAccount(f.ctx, &types.MsgFreezeMultisigAccount{
+				Authority:       f.govModAddr,
+				MultisigAddress: multisig,
+			})
+			require.NoError(t, err)
+
+			addresses := map[string][]byte{"signer_1": f.addrs[1], "new": newSigner}
+			var signers []string
+			for _, signer := range tc.signers {
+				signers = append(signers, bech32(t, addresses[signer]))
+			}
+
+			_, err = f.msgServer.RecoverMultisigAccount(f.ctx, &types.MsgRecoverMultisigAccount{
+				Authority:       f.govModAddr,
+				MultisigAddress: multisig,
+				Signers:         signers,
+				Threshold:       tc.threshold,
+			})
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			details, err := f.k.GetMultisigAccount(f.ctx, multisigAddress)
+			require.NoError(t, err)
+			require.False(t, details.Frozen)
+			require.Equal(t, [][]byte{f.addrs[1], newSigner}, details.Signers)
+			require.Equal(t, tc.threshold, details.Threshold)
+
+			// the approval of the replaced signer no longer counts
+			proposal, err := f.k.OrmDB.ProposalTable().Get(f.ctx, 1)
+			require.NoError(t, err)
+			require.Empty(t, proposal.Approvals)
+
+			_, err = f.msgServer.ApproveMultisigProposal(f.ctx, &types.MsgApproveMultisigProposalParams{
+				MultisigAddress: multisig,
+				Approver:        bech32(t, f.addrs[1]),
+				ProposalId:      1,
+			})
+			require.NoError(t, err)
+
+			res, err := f.queryServer.FrozenMultisigAccounts(f.ctx, &types.QueryFrozenMultisigAccountsRequest{})
+			require.NoError(t, err)
+			require.Empty(t, res.Addresses)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/DaevMithran/dmchain/x/multisig/types"
+)
+
+// ExecuteSchedules executes the due scheduled calls, in the order of their next height and
+// id and up to MaxScheduledExecutionsPerBlock of them. The remaining due calls are executed
+// in the next blocks. A failed execution is recorded and counts as a run.
+func (k Keeper) ExecuteSchedules(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	var due []types.Schedule
+	rng := collections.NewPrefixUntilPairRange[int64, uint64](sdkCtx.BlockHeight())
+	err = k.ScheduleQueue.Walk(ctx, rng, func(key collections.Pair[int64, uint64]) (bool, error) {
+		schedule, err := k.Schedules.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		if schedule.StartTime != nil && sdkCtx.BlockTime().Before(*schedule.StartTime) {
+			return false, nil
+		}
+
+		due = append(due, schedule)
+		return len(due) >= int(params.MaxScheduledExecutionsPerBlock), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, schedule := range due {
+		if err := k.executeSchedule(sdkCtx, schedule); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setSchedule stores schedule and queues it at its next height.
+func (k Keeper) setSchedule(ctx context.Context, schedule types.Schedule) error {
+	if err := k.Schedules.Set(ctx, schedule.Id, schedule); err != nil {
+		return err
+	}
+
+	return k.ScheduleQueue.Set(ctx, collections.Join(schedule.NextHeight, schedule.Id))
+}
+
+// removeSchedule removes schedule along with its queue entry.
+func (k Keeper) removeSchedule(ctx context.Context, schedule types.Schedule) error {
+	if err := k.ScheduleQueue.Remove(ctx, collections.Join(schedule.NextHeight, schedule.Id)); err != nil {
+		return err
+	}
+
+	return k.Schedules.Remove(ctx, schedule.Id)
+}
+
+// executeSchedule runs the call of schedule once, then removes the schedule after its last
+// run or postpones it by its interval.
+func (k Keeper) executeSchedule(ctx sdk.Context, schedule types.Schedule) error {
+	schedule.Runs++
+
+	var reason string
+	if err := k.dispatchSchedule(ctx, schedule); err != nil {
+		reason = err.Error()
+
+		if err := k.ScheduleFailures.Set(ctx, collections.Join(schedule.Id, schedule.Runs), types.ScheduleFailure{
+			ScheduleId: schedule.Id,
+			Run:        schedule.Runs,
+			Height:     ctx.BlockHeight(),
+			Error:      reason,
+		}); err != nil {
+			return err
+		}
+	}
+
+	multisig_address, err := k.ac.BytesToString(schedule.MultisigAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventScheduleExecuted{
+		ScheduleId:      schedule.Id,
+		MultisigAddress: multisig_address,
+		Run:             schedule.Runs,
+		Error:           reason,
+	}); err != nil {
+		return err
+	}
+
+	if err := k.removeSchedule(ctx, schedule); err != nil {
+		return err
+	}
+
+	if schedule.Runs >= schedule.MaxRuns {
+		return nil
+	}
+
+	schedule.NextHeight = ctx.BlockHeight() + int64(schedule.IntervalBlocks)
+	return k.setSchedule(ctx, schedule)
+}
+
+// dispatchSchedule dispatches the call of schedule in a cached context, which is only
+// written when the call succeeds. Panics of the call are turned into errors as they would
+// otherwise halt the chain.
+func (k Keeper) dispatchSchedule(ctx sdk.Context, schedule types.Schedule) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	var call sdk.Msg
+	if err := k.cdc.UnpackAny(schedule.Message, &call); err != nil {
+		return err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if _, err := k.DispatchActions(cacheCtx, schedule.MultisigAddress, call); err != nil {
+		return err
+	}
+	write()
+
+	return nil
+}
+
+// importSchedules restores the pending schedules, their recorded failures and the last
+// assigned schedule id.
+func (k *Keeper) importSchedules(ctx context.Context, schedules []types.Schedule, failures []types.ScheduleFailure, sequence uint64) error {
+	for _, schedule := range schedules {
+		if err := k.setSchedule(ctx, schedule); err != nil {
+			return err
+		}
+	}
+
+	for _, failure := range failures {
+		if err := k.ScheduleFailures.Set(ctx, collections.Join(failure.ScheduleId, failure.Run), failure); err != nil {
+			return err
+		}
+	}
+
+	return k.ScheduleSequence.Set(ctx, sequence)
+}
+
+// exportSchedules returns the pending schedules, their recorded failures and the last
+// assigned schedule id.
+func (k *Keeper) exportSchedules(ctx context.Context) ([]types.Schedule, []types.ScheduleFailure, uint64, error) {
+	var schedules []types.Schedule
+	err := k.Schedules.Walk(ctx, nil, func(_ uint64, schedule types.Schedule) (bool, error) {
+		schedules = append(schedules, schedule)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	var failures []types.ScheduleFailure
+	err = k.ScheduleFailures.Walk(ctx, nil, func(_ collections.Pair[uint64, uint64], failure types.ScheduleFailure) (bool, error) {
+		failures = append(failures, failure)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	sequence, err := k.ScheduleSequence.Peek(ctx)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	return schedules, failures, sequence, nil
+}
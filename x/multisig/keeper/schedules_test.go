@@ -0,0 +1,287 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/DaevMithran/dmchain/x/multisig/keeper"
+	"github.com/DaevMithran/dmchain/x/multisig/types"
+)
+
+// setupSchedule creates a 2 of 3 multisig account and schedules a call adding signer to it.
+func setupSchedule(t *testing.T, f *testFixture, signer []byte, msg *types.MsgScheduleMultisigCallParams) (uint64, error) {
+	t.Helper()
+
+	multisig := bech32(t, keeper.DeriveMultisigAccountID(1))
+	if has, _ := f.k.HasMultisigAccount(f.ctx, keeper.DeriveMultisigAccountID(1)); !has {
+		_, err := f.msgServer.CreateMultisigAccount(f.ctx, &types.MsgCreateMultisigAccountParams{
+			Authority: bech32(t, f.addrs[0]),
+			Seed:      1,
+			Threshold: 2,
+			Signers:   [][]byte{f.addrs[1], f.addrs[2]},
+		})
+		require.NoError(t, err)
+	}
+
+	call, err := codectypes.NewAnyWithValue(&types.MsgAddMultisigSignerParams{
+		MultisigAddress: multisig,
+		Signer:          bech32(t, signer),
+	})
+	require.NoError(t, err)
+
+	msg.MultisigAddress = multisig
+	msg.Message = call
+
+	res, err := f.msgServer.ScheduleMultisigCall(f.ctx, msg)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.ScheduleId, nil
+}
+
+func TestScheduleMultisigCall(t *testing.T) {
+	testCases := []struct {
+		name string
+		msg  *types.MsgScheduleMultisigCallParams
+		err  error
+	}{
+		{
+			name: "fail; multiple runs without interval",
+			msg:  &types.MsgScheduleMultisigCallParams{MaxRuns: 2},
+			err:  types.ErrInvalidSchedule,
+		},
+		{
+			name: "fail; recurring without max runs",
+			msg:  &types.MsgScheduleMultisigCallParams{IntervalBlocks: 5},
+			err:  types.ErrInvalidSchedule,
+		},
+		{
+			name: "fail; start height in the past",
+			msg:  &types.MsgScheduleMultisigCallParams{StartHeight: 9},
+			err:  types.ErrInvalidSchedule,
+		},
+		{
+			name: "success; single execution",
+			msg:  &types.MsgScheduleMultisigCallParams{StartHeight: 20},
+		},
+		{
+			name: "success; recurring",
+			msg:  &types.MsgScheduleMultisigCallParams{IntervalBlocks: 5, MaxRuns: 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := SetupTest(t)
+			f.ctx = f.ctx.WithBlockHeight(10)
+
+			id, err := setupSchedule(t, f, []byte("new_signer__________"), tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), id)
+
+			res, err := f.queryServer.Schedule(f.ctx, &types.QueryScheduleRequest{Id: id})
+			require.NoError(t, err)
+			require.Equal(t, max(tc.msg.MaxRuns, 1), res.Schedule.MaxRuns)
+			require.Equal(t, max(tc.msg.StartHeight, 10), res.Schedule.NextHeight)
+		})
+	}
+}
+
+func TestScheduleMultisigCallSigner(t *testing.T) {
+	f := SetupTest(t)
+
+	_, err := f.msgServer.CreateMultisigAccount(f.ctx, &types.MsgCreateMultisigAccountParams{
+		Authority: bech32(t, f.addrs[0]),
+		Seed:      1,
+		Threshold: 1,
+		Signers:   [][]byte{f.addrs[1]},
+	})
+	require.NoError(t, err)
+
+	// calls of other accounts can not be scheduled
+	call, err := codectypes.NewAnyWithValue(&types.MsgAddMultisigSignerParams{
+		MultisigAddress: bech32(t, keeper.DeriveMultisigAccountID(2)),
+		Signer:          bech32(t, f.addrs[2]),
+	})
+	require.NoError(t, err)
+
+	_, err = f.msgServer.ScheduleMultisigCall(f.ctx, &types.MsgScheduleMultisigCallParams{
+		MultisigAddress: bech32(t, keeper.DeriveMultisigAccountID(1)),
+		Message:         call,
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestExecuteSchedules(t *testing.T) {
+	f := SetupTest(t)
+	f.ctx = f.ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	newSigner := []byte("new_signer__________")
+
+	// the second run fails as the signer was already added
+	recurring, err := setupSchedule(t, f, newSigner, &types.MsgScheduleMultisigCallParams{IntervalBlocks: 2, MaxRuns: 2})
+	require.NoError(t, err)
+
+	startTime := time.Unix(2000, 0)
+	timed, err := setupSchedule(t, f, []byte("timed_signer________"), &types.MsgScheduleMultisigCallParams{StartTime: &startTime})
+	require.NoError(t, err)
+
+	require.NoError(t, f.k.ExecuteSchedules(f.ctx))
+
+	details, err := f.k.GetMultisigAccount(f.ctx, keeper.DeriveMultisigAccountID(1))
+	require.NoError(t, err)
+	require.Contains(t, details.Signers, newSigner)
+	require.Len(t, details.Signers, 4)
+
+	schedule, err := f.k.Schedules.Get(f.ctx, recurring)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), schedule.Runs)
+	require.Equal(t, int64(12), schedule.NextHeight)
+
+	// not due yet
+	f.ctx = f.ctx.WithBlockHeight(11)
+	require.NoError(t, f.k.ExecuteSchedules(f.ctx))
+	schedule, err = f.k.Schedules.Get(f.ctx, recurring)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), schedule.Runs)
+
+	f.ctx = f.ctx.WithBlockHeight(12)
+	require.NoError(t, f.k.ExecuteSchedules(f.ctx))
+
+	has, err := f.k.Schedules.Has(f.ctx, recurring)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	failure, err := f.k.ScheduleFailures.Get(f.ctx, collections.Join(recurring, uint64(2)))
+	require.NoError(t, err)
+	require.Equal(t, int64(12), failure.Height)
+	require.Contains(t, failure.Error, "Duplicate signer")
+
+	// the timed schedule waits for its start time
+	has, err = f.k.Schedules.Has(f.ctx, timed)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	f.ctx = f.ctx.WithBlockTime(startTime)
+	require.NoError(t, f.k.ExecuteSchedules(f.ctx))
+
+	has, err = f.k.Schedules.Has(f.ctx, timed)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestExecuteSchedulesPerBlockCap(t *testing.T) {
+	f := SetupTest(t)
+
+	params := types.DefaultParams()
+	params.MaxScheduledExecutionsPerBlock = 1
+	require.NoError(t, f.k.Params.Set(f.ctx, params))
+
+	first, err := setupSchedule(t, f, []byte("first_signer________"), &types.MsgScheduleMultisigCallParams{})
+	require.NoError(t, err)
+	second, err := setupSchedule(t, f, []byte("second_signer_______"), &types.MsgScheduleMultisigCallParams{})
+	require.NoError(t, err)
+
+	require.NoError(t, f.k.ExecuteSchedules(f.ctx))
+
+	has, err := f.k.Schedules.Has(f.ctx, first)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the second due call is deferred to the next block
+	has, err = f.k.Schedules.Has(f.ctx, second)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	f.ctx = f.ctx.WithBlockHeight(f.ctx.BlockHeight() + 1)
+	require.NoError(t, f.k.ExecuteSchedules(f.ctx))
+
+	has, err = f.k.Schedules.Has(f.ctx, second)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestExecuteSchedulesQueue(t *testing.T) {
+	f := SetupTest(t)
+
+	params := types.DefaultParams()
+	params.MaxScheduledExecutionsPerBlock = 2
+	require.NoError(t, f.k.Params.Set(f.ctx, params))
+
+	// five calls due now, queued after a call due later
+	later, err := setupSchedule(t, f, []byte("later_signer________"), &types.MsgScheduleMultisigCallParams{StartHeight: 20})
+	require.NoError(t, err)
+
+	var due []uint64
+	for _, signer := range []string{"signer_a", "signer_b", "signer_c", "signer_d", "signer_e"} {
+		id, err := setupSchedule(t, f, []byte(signer+"____________"), &types.MsgScheduleMultisigCallParams{})
+		require.NoError(t, err)
+		due = append(due, id)
+	}
+
+	// the due calls are executed by the cap per block, in the order of their ids
+	for _, executed := range []int{2, 4, 5} {
+		require.NoError(t, f.k.ExecuteSchedules(f.ctx))
+
+		for i, id := range due {
+			has, err := f.k.Schedules.Has(f.ctx, id)
+			require.NoError(t, err)
+			require.Equal(t, i >= executed, has)
+		}
+
+		f.ctx = f.ctx.WithBlockHeight(f.ctx.BlockHeight() + 1)
+	}
+
+	// only the call due later is left in the queue
+	var queue []collections.Pair[int64, uint64]
+	require.NoError(t, f.k.ScheduleQueue.Walk(f.ctx, nil, func(key collections.Pair[int64, uint64]) (bool, error) {
+		queue = append(queue, key)
+		return false, nil
+	}))
+	require.Equal(t, []collections.Pair[int64, uint64]{collections.Join(int64(20), later)}, queue)
+}
+
+func TestCancelMultisigSchedule(t *testing.T) {
+	f := SetupTest(t)
+
+	id, err := setupSchedule(t, f, []byte("new_signer__________"), &types.MsgScheduleMultisigCallParams{IntervalBlocks: 10, MaxRuns: 5})
+	require.NoError(t, err)
+	require.NoError(t, f.k.ExecuteSchedules(f.ctx))
+
+	_, err = f.msgServer.CancelMultisigSchedule(f.ctx, &types.MsgCancelMultisigScheduleParams{
+		MultisigAddress: bech32(t, f.addrs[0]),
+		ScheduleId:      id,
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	res, err := f.msgServer.CancelMultisigSchedule(f.ctx, &types.MsgCancelMultisigScheduleParams{
+		MultisigAddress: bech32(t, keeper.DeriveMultisigAccountID(1)),
+		ScheduleId:      id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), res.RemainingRuns)
+
+	_, err = f.queryServer.Schedule(f.ctx, &types.QueryScheduleRequest{Id: id})
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	// the queue entry is removed along with the schedule
+	queued, err := f.k.ScheduleQueue.Has(f.ctx, collections.Join(f.ctx.BlockHeight()+10, id))
+	require.NoError(t, err)
+	require.False(t, queued)
+
+	events := f.ctx.EventManager().Events()
+	require.Equal(t, sdk.MsgTypeURL(&types.EventScheduleCancelled{})[1:], events[len(events)-1].Type)
+}
@@ -3,6 +3,7 @@ package module
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -17,14 +18,16 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 
 	"github.com/DaevMithran/dmchain/x/multisig/keeper"
+	simulation "github.com/DaevMithran/dmchain/x/multisig/simulations"
 	"github.com/DaevMithran/dmchain/x/multisig/types"
 )
 
 const (
 	// ConsensusVersion defines the current x/multisig module consensus version.
-	ConsensusVersion = 1
+	ConsensusVersion = 2
 )
 
 var (
@@ -32,6 +35,8 @@ var (
 	_ module.AppModuleGenesis = AppModule{}
 	_ module.AppModule        = AppModule{}
 
+	_ module.AppModuleSimulation = AppModule{}
+
 	_ autocli.HasAutoCLIConfig = AppModule{}
 )
 
@@ -43,17 +48,20 @@ type AppModuleBasic struct {
 type AppModule struct {
 	AppModuleBasic
 
-	keeper keeper.Keeper
+	keeper        keeper.Keeper
+	accountKeeper types.AccountKeeper
 }
 
 // NewAppModule constructor
 func NewAppModule(
 	cdc codec.Codec,
 	keeper keeper.Keeper,
+	accountKeeper types.AccountKeeper,
 ) *AppModule {
 	return &AppModule{
 		AppModuleBasic: AppModuleBasic{cdc: cdc},
 		keeper:         keeper,
+		accountKeeper:  accountKeeper,
 	}
 }
 
@@ -73,8 +81,8 @@ func (a AppModuleBasic) ValidateGenesis(marshaler codec.JSONCodec, _ client.TxEn
 	if err != nil {
 		return err
 	}
-	if err := data.Params.Validate(); err != nil {
-		return errorsmod.Wrap(err, "params")
+	if err := data.Validate(); err != nil {
+		return errorsmod.Wrap(err, "genesis")
 	}
 	return nil
 }
@@ -129,7 +137,8 @@ func (a AppModule) ExportGenesis(ctx sdk.Context, marshaler codec.JSONCodec) jso
 	return marshaler.MustMarshalJSON(genState)
 }
 
-func (a AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
+func (a AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, a.keeper)
 }
 
 func (a AppModule) QuerierRoute() string {
@@ -139,6 +148,11 @@ func (a AppModule) QuerierRoute() string {
 func (a AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(a.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(a.keeper))
+
+	m := keeper.NewMigrator(a.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // ConsensusVersion is a sequence number for state-breaking change of the
@@ -148,3 +162,34 @@ func (a AppModule) RegisterServices(cfg module.Configurator) {
 func (a AppModule) ConsensusVersion() uint64 {
 	return ConsensusVersion
 }
+
+// EndBlock executes all ABCI EndBlock logic respective to the x/multisig module.
+// It returns no validator updates.
+func (a AppModule) EndBlock(ctx context.Context) ([]abci.ValidatorUpdate, error) {
+	if err := a.keeper.ExecuteSchedules(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := a.keeper.ExpireProposals(ctx); err != nil {
+		return nil, err
+	}
+
+	return []abci.ValidatorUpdate{}, nil
+}
+
+// GenerateGenesisState creates a randomized GenState of the multisig module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// WeightedOperations returns the all the multisig module operations with their respective weights.
+func (a AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(
+		simState.AppParams, simState.TxConfig, a.accountKeeper, a.keeper,
+	)
+}
+
+// RegisterStoreDecoder registers a decoder for multisig module's types
+func (a AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simulation.NewDecodeStore(a.cdc)
+}
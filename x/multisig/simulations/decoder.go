@@ -0,0 +1,75 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/orm/model/ormdb"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/DaevMithran/dmchain/x/multisig/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding multisig type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	// the module db is only used to decode the ORM table entries
+	db, err := ormdb.NewModuleDB(&types.ORMModuleSchema, ormdb.ModuleDBOptions{})
+	if err != nil {
+		panic(err)
+	}
+
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key, types.ParamsKey.Bytes()):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		case bytes.HasPrefix(kvA.Key, types.AccountsKey.Bytes()):
+			var accountA, accountB types.MultisigAccountDetails
+			cdc.MustUnmarshal(kvA.Value, &accountA)
+			cdc.MustUnmarshal(kvB.Value, &accountB)
+			return fmt.Sprintf("%v\n%v", accountA, accountB)
+
+		case bytes.Equal(kvA.Key, types.ScheduleSequenceKey.Bytes()):
+			return fmt.Sprintf("%v\n%v", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, types.SchedulesKey.Bytes()):
+			var scheduleA, scheduleB types.Schedule
+			cdc.MustUnmarshal(kvA.Value, &scheduleA)
+			cdc.MustUnmarshal(kvB.Value, &scheduleB)
+			return fmt.Sprintf("%v\n%v", scheduleA, scheduleB)
+
+		case bytes.HasPrefix(kvA.Key, types.ScheduleFailuresKey.Bytes()):
+			var failureA, failureB types.ScheduleFailure
+			cdc.MustUnmarshal(kvA.Value, &failureA)
+			cdc.MustUnmarshal(kvB.Value, &failureB)
+			return fmt.Sprintf("%v\n%v", failureA, failureB)
+
+		case bytes.HasPrefix(kvA.Key, types.FeeBudgetSpendsKey.Bytes()):
+			var spendA, spendB types.FeeBudgetSpend
+			cdc.MustUnmarshal(kvA.Value, &spendA)
+			cdc.MustUnmarshal(kvB.Value, &spendB)
+			return fmt.Sprintf("%v\n%v", spendA, spendB)
+
+		case bytes.HasPrefix(kvA.Key, types.ORMModuleSchema.Prefix):
+			entryA, err := db.DecodeEntry(kvA.Key, kvA.Value)
+			if err != nil {
+				panic(err)
+			}
+			entryB, err := db.DecodeEntry(kvB.Key, kvB.Value)
+			if err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", entryA, entryB)
+
+		default:
+			panic(fmt.Sprintf("invalid multisig key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/DaevMithran/dmchain/x/multisig/keeper"
+	"github.com/DaevMithran/dmchain/x/multisig/types"
+)
+
+// Simulation parameter constants
+const (
+	someValueKey        = "some_value"
+	maxNestingDepthKey  = "max_nesting_depth"
+	maxExecutionsKey    = "max_scheduled_executions_per_block"
+	feeBudgetKey        = "fee_budget"
+	feeBudgetPeriodKey  = "fee_budget_period"
+	forfeitDestKey      = "deposit_forfeit_destination"
+	expiryBlocksKey     = "proposal_expiry_blocks"
+	maxOpenProposalsKey = "max_open_proposals"
+	depositKey          = "deposit"
+	multisigAccountsKey = "multisig_accounts"
+)
+
+// GenSomeValue produces a randomized SomeValue
+func GenSomeValue(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// GenMaxNestingDepth produces a randomized MaxNestingDepth in the range of [1, 4]
+func GenMaxNestingDepth(r *rand.Rand) uint32 {
+	return uint32(1 + r.Intn(4))
+}
+
+// GenMaxScheduledExecutionsPerBlock produces a randomized MaxScheduledExecutionsPerBlock in the range of [1, 20]
+func GenMaxScheduledExecutionsPerBlock(r *rand.Rand) uint32 {
+	return uint32(1 + r.Intn(20))
+}
+
+// GenFeeBudget produces a randomized FeeBudget, empty half of the time
+func GenFeeBudget(r *rand.Rand) sdk.Coins {
+	if r.Intn(2) == 0 {
+		return sdk.NewCoins()
+	}
+
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(1+r.Intn(1_000_000))))
+}
+
+// GenFeeBudgetPeriod produces a randomized FeeBudgetPeriod in the range of [1, 100]
+func GenFeeBudgetPeriod(r *rand.Rand) uint64 {
+	return uint64(1 + r.Intn(100))
+}
+
+// GenDepositForfeitDestination produces a randomized DepositForfeitDestination
+func GenDepositForfeitDestination(r *rand.Rand) types.DepositForfeitDestination {
+	if r.Intn(2) == 0 {
+		return types.DepositForfeitDestination_DEPOSIT_FORFEIT_DESTINATION_BURN
+	}
+
+	return types.DepositForfeitDestination_DEPOSIT_FORFEIT_DESTINATION_COMMUNITY_POOL
+}
+
+// GenProposalExpiryBlocks produces a randomized ProposalExpiryBlocks in the range of [0, 100]
+func GenProposalExpiryBlocks(r *rand.Rand) uint64 {
+	return uint64(r.Intn(101))
+}
+
+// GenMaxOpenProposals produces a randomized MaxOpenProposals in the range of [1, 50]
+func GenMaxOpenProposals(r *rand.Rand) uint32 {
+	return uint32(1 + r.Intn(50))
+}
+
+// GenDeposit produces a randomized Deposit in the range of [0, 100] of the bond denom
+func GenDeposit(r *rand.Rand) sdk.Coin {
+	return sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(r.Intn(101)))
+}
+
+// GenMultisigAccounts produces up to 5 multisig accounts, each controlled by
+// 2 to 4 distinct simulation accounts with a threshold in the range of [1, signers]
+func GenMultisigAccounts(r *rand.Rand, accs []simtypes.Account) []types.GenesisMultisigAccount {
+	if len(accs) < 2 {
+		return nil
+	}
+
+	var (
+		accounts []types.GenesisMultisigAccount
+		seeds    = make(map[uint32]bool)
+	)
+	n := r.Intn(6)
+	for i := 0; i < n; i++ {
+		seed := r.Uint32()
+		if seeds[seed] {
+			continue
+		}
+		seeds[seed] = true
+
+		signers := randomSigners(r, accs, 2+r.Intn(3))
+		details := types.MultisigAccountDetails{Signers: signers}
+		if r.Intn(2) == 0 {
+			details.ThresholdPercentage = randomThresholdPercentage(r)
+		} else {
+			details.Threshold = uint32(1 + r.Intn(len(signers)))
+		}
+
+		accounts = append(accounts, types.GenesisMultisigAccount{
+			Address: keeper.DeriveMultisigAccountID(seed).String(),
+			Details: details,
+		})
+	}
+
+	return accounts
+}
+
+// RandomizedGenState generates a random GenesisState for multisig
+func RandomizedGenState(simState *module.SimulationState) {
+	multisigGenesis := types.DefaultGenesis()
+
+	var someValue bool
+	simState.AppParams.GetOrGenerate(
+		someValueKey, &someValue, simState.Rand,
+		func(r *rand.Rand) { someValue = GenSomeValue(r) },
+	)
+
+	var maxNestingDepth uint32
+	simState.AppParams.GetOrGenerate(
+		maxNestingDepthKey, &maxNestingDepth, simState.Rand,
+		func(r *rand.Rand) { maxNestingDepth = GenMaxNestingDepth(r) },
+	)
+
+	var maxExecutions uint32
+	simState.AppParams.GetOrGenerate(
+		maxExecutionsKey, &maxExecutions, simState.Rand,
+		func(r *rand.Rand) { maxExecutions = GenMaxScheduledExecutionsPerBlock(r) },
+	)
+
+	var feeBudget sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		feeBudgetKey, &feeBudget, simState.Rand,
+		func(r *rand.Rand) { feeBudget = GenFeeBudget(r) },
+	)
+
+	var feeBudgetPeriod uint64
+	simState.AppParams.GetOrGenerate(
+		feeBudgetPeriodKey, &feeBudgetPeriod, simState.Rand,
+		func(r *rand.Rand) { feeBudgetPeriod = GenFeeBudgetPeriod(r) },
+	)
+
+	var forfeitDest types.DepositForfeitDestination
+	simState.AppParams.GetOrGenerate(
+		forfeitDestKey, &forfeitDest, simState.Rand,
+		func(r *rand.Rand) { forfeitDest = GenDepositForfeitDestination(r) },
+	)
+
+	var expiryBlocks uint64
+	simState.AppParams.GetOrGenerate(
+		expiryBlocksKey, &expiryBlocks, simState.Rand,
+		func(r *rand.Rand) { expiryBlocks = GenProposalExpiryBlocks(r) },
+	)
+
+	var maxOpenProposals uint32
+	simState.AppParams.GetOrGenerate(
+		maxOpenProposalsKey, &maxOpenProposals, simState.Rand,
+		func(r *rand.Rand) { maxOpenProposals = GenMaxOpenProposals(r) },
+	)
+
+	var deposit sdk.Coin
+	simState.AppParams.GetOrGenerate(
+		depositKey, &deposit, simState.Rand,
+		func(r *rand.Rand) { deposit = GenDeposit(r) },
+	)
+
+	var accounts []types.GenesisMultisigAccount
+	simState.AppParams.GetOrGenerate(
+		multisigAccountsKey, &accounts, simState.Rand,
+		func(r *rand.Rand) { accounts = GenMultisigAccounts(r, simState.Accounts) },
+	)
+
+	multisigGenesis.Params = types.Params{
+		SomeValue:       someValue,
+		MaxNestingDepth: maxNestingDepth,
+
+		MaxScheduledExecutionsPerBlock: maxExecutions,
+
+		FeeBudget:       feeBudget,
+		FeeBudgetPeriod: feeBudgetPeriod,
+
+		DepositForfeitDestination: forfeitDest,
+		ProposalExpiryBlocks:      expiryBlocks,
+		MaxOpenProposals:          maxOpenProposals,
+		Deposit:                   deposit,
+	}
+	multisigGenesis.Accounts = accounts
+
+	bz, err := json.MarshalIndent(&multisigGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated multisig parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(multisigGenesis)
+}
+
+// randomSigners returns up to n distinct simulation account addresses.
+func randomSigners(r *rand.Rand, accs []simtypes.Account, n int) [][]byte {
+	if n > len(accs) {
+		n = len(accs)
+	}
+
+	signers := make([][]byte, 0, n)
+	for _, i := range r.Perm(len(accs))[:n] {
+		signers = append(signers, accs[i].Address)
+	}
+
+	return signers
+}
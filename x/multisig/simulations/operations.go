@@ -0,0 +1,387 @@
+package simulation
+
+import (
+	"bytes"
+	"math/rand"
+
+	"golang.org/x/crypto/blake2b"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	apiv1 "github.com/DaevMithran/dmchain/api/multisig/v1"
+	"github.com/DaevMithran/dmchain/x/multisig/keeper"
+	"github.com/DaevMithran/dmchain/x/multisig/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateMultisigAccount              = "op_weight_msg_create_multisig_account"                //nolint: gosec
+	OpWeightMsgInitializeMultisigProposal         = "op_weight_msg_initialize_multisig_proposal"           //nolint: gosec
+	OpWeightMsgApproveMultisigProposal            = "op_weight_msg_approve_multisig_proposal"              //nolint: gosec
+	OpWeightMsgApproveAndDispatchMultisigProposal = "op_weight_msg_approve_and_dispatch_multisig_proposal" //nolint: gosec
+
+	DefaultWeightMsgCreateMultisigAccount              = 50
+	DefaultWeightMsgInitializeMultisigProposal         = 100
+	DefaultWeightMsgApproveMultisigProposal            = 100
+	DefaultWeightMsgApproveAndDispatchMultisigProposal = 100
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgCreateMultisigAccount              int
+		weightMsgInitializeMultisigProposal         int
+		weightMsgApproveMultisigProposal            int
+		weightMsgApproveAndDispatchMultisigProposal int
+		calls                                       = make(map[string]sdk.Msg)
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgCreateMultisigAccount, &weightMsgCreateMultisigAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateMultisigAccount = DefaultWeightMsgCreateMultisigAccount
+		},
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgInitializeMultisigProposal, &weightMsgInitializeMultisigProposal, nil,
+		func(_ *rand.Rand) {
+			weightMsgInitializeMultisigProposal = DefaultWeightMsgInitializeMultisigProposal
+		},
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgApproveMultisigProposal, &weightMsgApproveMultisigProposal, nil,
+		func(_ *rand.Rand) {
+			weightMsgApproveMultisigProposal = DefaultWeightMsgApproveMultisigProposal
+		},
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgApproveAndDispatchMultisigProposal, &weightMsgApproveAndDispatchMultisigProposal, nil,
+		func(_ *rand.Rand) {
+			weightMsgApproveAndDispatchMultisigProposal = DefaultWeightMsgApproveAndDispatchMultisigProposal
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateMultisigAccount,
+			SimulateMsgCreateMultisigAccount(txGen, ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgInitializeMultisigProposal,
+			SimulateMsgInitializeMultisigProposal(txGen, ak, k, calls),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgApproveMultisigProposal,
+			SimulateMsgApproveMultisigProposal(txGen, ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgApproveAndDispatchMultisigProposal,
+			SimulateMsgApproveAndDispatchMultisigProposal(txGen, ak, k, calls),
+		),
+	}
+}
+
+// SimulateMsgCreateMultisigAccount generates a MsgCreateMultisigAccountParams with random values.
+func SimulateMsgCreateMultisigAccount(txGen client.TxConfig, ak types.AccountKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		noop := func(comment string) simtypes.OperationMsg {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(new(types.MsgCreateMultisigAccountParams)), comment)
+		}
+
+		// ensure the seed is not taken
+		seed := r.Uint32()
+		if _, err := k.GetMultisigAccount(ctx, keeper.DeriveMultisigAccountID(seed)); err == nil {
+			return noop("multisig account already exists for this seed"), nil, nil
+		}
+
+		// the creator is appended to the signers by the msg server
+		var signers [][]byte
+		for _, signer := range randomSigners(r, accs, 1+r.Intn(3)) {
+			if !bytes.Equal(signer, simAccount.Address) {
+				signers = append(signers, signer)
+			}
+		}
+
+		msg := &types.MsgCreateMultisigAccountParams{
+			Authority: simAccount.Address.String(),
+			Seed:      seed,
+			Signers:   signers,
+		}
+		if r.Intn(2) == 0 {
+			msg.ThresholdPercentage = randomThresholdPercentage(r)
+		} else {
+			msg.Threshold = uint32(1 + r.Intn(len(signers)+1))
+		}
+
+		return deliver(r, app, ctx, txGen, ak, k, simAccount, msg, nil)
+	}
+}
+
+// SimulateMsgInitializeMultisigProposal generates a MsgInitializeMultisigProposalParams proposing
+// to add a random signer to a random multisig account.
+func SimulateMsgInitializeMultisigProposal(
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	k keeper.Keeper,
+	calls map[string]sdk.Msg,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		noop := func(comment string) simtypes.OperationMsg {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(new(types.MsgInitializeMultisigProposalParams)), comment)
+		}
+
+		multisigAddress, details, err := randomMultisigAccount(r, ctx, k)
+		if err != nil {
+			return noop("unable to find multisig account"), nil, nil
+		}
+
+		proposer, found := randomSigner(r, accs, details.Signers)
+		if !found {
+			return noop("unable to find proposer"), nil, nil
+		}
+
+		newSigner, _ := simtypes.RandomAcc(r, accs)
+		if containsSigner(details.Signers, newSigner.Address) {
+			return noop("signer already exists for this multisig account"), nil, nil
+		}
+
+		call := &types.MsgAddMultisigSignerParams{
+			MultisigAddress: multisigAddress.String(),
+			Signer:          newSigner.Address.String(),
+		}
+		message, err := codectypes.NewAnyWithValue(call)
+		if err != nil {
+			return noop("unable to pack call"), nil, err
+		}
+		calls[callKey(multisigAddress, message)] = call
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return noop("unable to get params"), nil, err
+		}
+
+		// the proposer pays the deposit out of its genesis funds
+		proposalDeposit := sdk.NewCoins(params.Deposit)
+		spendable := k.BankKeeper.SpendableCoins(ctx, proposer.Address)
+		if !spendable.IsAllGTE(proposalDeposit) {
+			return noop("unable to pay the proposal deposit"), nil, nil
+		}
+
+		msg := &types.MsgInitializeMultisigProposalParams{
+			MultisigAddress: multisigAddress.String(),
+			Proposer:        proposer.Address.String(),
+			Title:           simtypes.RandStringOfLength(r, 10),
+			Description:     simtypes.RandStringOfLength(r, 50),
+			Message:         message,
+		}
+
+		return deliver(r, app, ctx, txGen, ak, k, proposer, msg, proposalDeposit)
+	}
+}
+
+// SimulateMsgApproveMultisigProposal generates a MsgApproveMultisigProposalParams for a random open proposal.
+func SimulateMsgApproveMultisigProposal(txGen client.TxConfig, ak types.AccountKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		noop := func(comment string) simtypes.OperationMsg {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(new(types.MsgApproveMultisigProposalParams)), comment)
+		}
+
+		proposal, details, err := randomProposal(r, ctx, k)
+		if err != nil {
+			return noop("unable to find proposal"), nil, nil
+		}
+
+		approver, found := randomSigner(r, accs, details.Signers)
+		if !found {
+			return noop("unable to find approver"), nil, nil
+		}
+
+		if containsSigner(proposal.Approvals, approver.Address) {
+			return noop("proposal already approved by this signer"), nil, nil
+		}
+
+		msg := &types.MsgApproveMultisigProposalParams{
+			MultisigAddress: sdk.AccAddress(proposal.MultisigAddress).String(),
+			ProposalId:      proposal.Id,
+			Approver:        approver.Address.String(),
+		}
+
+		return deliver(r, app, ctx, txGen, ak, k, approver, msg, nil)
+	}
+}
+
+// SimulateMsgApproveAndDispatchMultisigProposal generates a MsgApproveAndDispatchMultisigProposalParams
+// for a random open proposal which reaches its threshold with the approval.
+func SimulateMsgApproveAndDispatchMultisigProposal(
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	k keeper.Keeper,
+	calls map[string]sdk.Msg,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		noop := func(comment string) simtypes.OperationMsg {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(new(types.MsgApproveAndDispatchMultisigProposalParams)), comment)
+		}
+
+		proposal, details, err := randomProposal(r, ctx, k)
+		if err != nil {
+			return noop("unable to find proposal"), nil, nil
+		}
+
+		approver, found := randomSigner(r, accs, details.Signers)
+		if !found {
+			return noop("unable to find approver"), nil, nil
+		}
+
+		approvals := len(proposal.Approvals)
+		if !containsSigner(proposal.Approvals, approver.Address) {
+			approvals++
+		}
+		threshold, err := details.EffectiveThreshold()
+		if err != nil || approvals < int(threshold) {
+			return noop("threshold not met"), nil, nil
+		}
+
+		call, ok := calls[string(proposal.MultisigAddress)+string(proposal.CallHash)]
+		if !ok {
+			return noop("unknown proposal call"), nil, nil
+		}
+
+		// the call must still be executable
+		if add, ok := call.(*types.MsgAddMultisigSignerParams); ok {
+			signer, err := sdk.AccAddressFromBech32(add.Signer)
+			if err != nil || containsSigner(details.Signers, signer) {
+				return noop("signer already exists for this multisig account"), nil, nil
+			}
+		}
+
+		message, err := codectypes.NewAnyWithValue(call)
+		if err != nil {
+			return noop("unable to pack call"), nil, err
+		}
+
+		msg := &types.MsgApproveAndDispatchMultisigProposalParams{
+			MultisigAddress: sdk.AccAddress(proposal.MultisigAddress).String(),
+			ProposalId:      proposal.Id,
+			Approver:        approver.Address.String(),
+			Message:         message,
+		}
+
+		return deliver(r, app, ctx, txGen, ak, k, approver, msg, nil)
+	}
+}
+
+// randomMultisigAccount returns a random multisig account.
+func randomMultisigAccount(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (sdk.AccAddress, types.MultisigAccountDetails, error) {
+	var addresses [][]byte
+	err := k.WalkMultisigAccounts(ctx, func(address []byte, _ types.MultisigAccountDetails) (bool, error) {
+		addresses = append(addresses, address)
+		return false, nil
+	})
+	if err != nil {
+		return nil, types.MultisigAccountDetails{}, err
+	}
+
+	if len(addresses) == 0 {
+		return nil, types.MultisigAccountDetails{}, collections.ErrNotFound
+	}
+
+	address := addresses[r.Intn(len(addresses))]
+	details, err := k.GetMultisigAccount(ctx, address)
+	return address, details, err
+}
+
+// randomProposal returns a random open proposal along with the details of its multisig account.
+func randomProposal(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (*apiv1.Proposal, types.MultisigAccountDetails, error) {
+	it, err := k.OrmDB.ProposalTable().List(ctx, apiv1.ProposalPrimaryKey{})
+	if err != nil {
+		return nil, types.MultisigAccountDetails{}, err
+	}
+	defer it.Close()
+
+	var proposals []*apiv1.Proposal
+	for it.Next() {
+		proposal, err := it.Value()
+		if err != nil {
+			return nil, types.MultisigAccountDetails{}, err
+		}
+		proposals = append(proposals, proposal)
+	}
+
+	if len(proposals) == 0 {
+		return nil, types.MultisigAccountDetails{}, collections.ErrNotFound
+	}
+
+	proposal := proposals[r.Intn(len(proposals))]
+	details, err := k.GetMultisigAccount(ctx, proposal.MultisigAddress)
+	return proposal, details, err
+}
+
+// randomThresholdPercentage returns a random threshold percentage in (0, 1].
+func randomThresholdPercentage(r *rand.Rand) string {
+	return math.LegacyNewDecWithPrec(int64(1+r.Intn(100)), 2).String()
+}
+
+// randomSigner returns a random simulation account among the signers.
+func randomSigner(r *rand.Rand, accs []simtypes.Account, signers [][]byte) (simtypes.Account, bool) {
+	if len(signers) == 0 {
+		return simtypes.Account{}, false
+	}
+
+	return simtypes.FindAccount(accs, sdk.AccAddress(signers[r.Intn(len(signers))]))
+}
+
+func containsSigner(signers [][]byte, signer []byte) bool {
+	for _, s := range signers {
+		if bytes.Equal(s, signer) {
+			return true
+		}
+	}
+	return false
+}
+
+// callKey identifies a proposal call the same way as the proposal table index.
+func callKey(multisigAddress sdk.AccAddress, message *codectypes.Any) string {
+	callHash := blake2b.Sum256(message.Value)
+	return string(multisigAddress) + string(callHash[:])
+}
+
+func deliver(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, txGen client.TxConfig, ak types.AccountKeeper,
+	k keeper.Keeper, from simtypes.Account, msg sdk.Msg, coins sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	o := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           txGen,
+		Msg:             msg,
+		Context:         ctx,
+		SimAccount:      from,
+		AccountKeeper:   ak,
+		Bankkeeper:      k.BankKeeper,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: coins,
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(o)
+}
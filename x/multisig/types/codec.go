@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,9 +20,26 @@ func init() {
 	sdk.RegisterLegacyAminoCodec(amino)
 }
 
-// RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
+// RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec. The names
+// must match the amino.name options of the msgs, which are used for SIGN_MODE_LEGACY_AMINO_JSON.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
-	cdc.RegisterConcrete(&MsgUpdateParams{}, ModuleName+"/MsgUpdateParams", nil)
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, ModuleName+"/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateMultisigAccountParams{}, ModuleName+"/MsgCreateAccount")
+	legacy.RegisterAminoMsg(cdc, &MsgAddMultisigSignerParams{}, ModuleName+"/MsgAddSigner")
+	legacy.RegisterAminoMsg(cdc, &MsgCleanupMultisigAccountParams{}, ModuleName+"/MsgCleanupAccount")
+	legacy.RegisterAminoMsg(cdc, &MsgSetMultisigThresholdParams{}, ModuleName+"/MsgSetThreshold")
+	legacy.RegisterAminoMsg(cdc, &MsgInitializeMultisigProposalParams{}, ModuleName+"/MsgInitializeProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgApproveMultisigProposalParams{}, ModuleName+"/MsgApproveProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgApproveAndDispatchMultisigProposalParams{}, ModuleName+"/MsgApproveAndDispatch")
+	legacy.RegisterAminoMsg(cdc, &MsgBatchApproveMultisigProposalParams{}, ModuleName+"/MsgBatchApproveProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleMultisigCallParams{}, ModuleName+"/MsgScheduleCall")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelMultisigScheduleParams{}, ModuleName+"/MsgCancelSchedule")
+	legacy.RegisterAminoMsg(cdc, &MsgFreezeMultisigAccount{}, ModuleName+"/MsgFreezeAccount")
+	legacy.RegisterAminoMsg(cdc, &MsgRecoverMultisigAccount{}, ModuleName+"/MsgRecoverAccount")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelMultisigProposalParams{}, ModuleName+"/MsgCancelProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgCleanupMultisigProposalParams{}, ModuleName+"/MsgCleanupProposal")
+
+	cdc.RegisterConcrete(&Params{}, ModuleName+"/params", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -29,6 +47,20 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgCreateMultisigAccountParams{},
+		&MsgAddMultisigSignerParams{},
+		&MsgCleanupMultisigAccountParams{},
+		&MsgSetMultisigThresholdParams{},
+		&MsgInitializeMultisigProposalParams{},
+		&MsgApproveMultisigProposalParams{},
+		&MsgApproveAndDispatchMultisigProposalParams{},
+		&MsgBatchApproveMultisigProposalParams{},
+		&MsgScheduleMultisigCallParams{},
+		&MsgCancelMultisigScheduleParams{},
+		&MsgFreezeMultisigAccount{},
+		&MsgRecoverMultisigAccount{},
+		&MsgCancelMultisigProposalParams{},
+		&MsgCleanupMultisigProposalParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
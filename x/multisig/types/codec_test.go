@@ -0,0 +1,117 @@
+package types_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"cosmossdk.io/x/tx/signing/aminojson"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	_ "github.com/DaevMithran/dmchain/api/multisig/v1"
+	"github.com/DaevMithran/dmchain/x/multisig/types"
+)
+
+func TestLegacyAminoJSON(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	sdk.RegisterLegacyAminoCodec(cdc)
+	types.RegisterLegacyAminoCodec(cdc)
+
+	// SIGN_MODE_LEGACY_AMINO_JSON sign bytes are produced from the amino.name options
+	encoder := aminojson.NewEncoder(aminojson.EncoderOptions{})
+
+	multisig := sdk.AccAddress([]byte("multisig_account____")).String()
+	signer := sdk.AccAddress([]byte("signer______________")).String()
+	startTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	call, err := codectypes.NewAnyWithValue(&types.MsgAddMultisigSignerParams{
+		MultisigAddress: multisig,
+		Signer:          signer,
+		NewThreshold:    2,
+	})
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.FeeBudget = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	testCases := []struct {
+		name string
+		msg  sdk.Msg
+	}{
+		{"update params", &types.MsgUpdateParams{Authority: signer, Params: params}},
+		{"update params without fee budget", &types.MsgUpdateParams{Authority: signer, Params: types.DefaultParams()}},
+		{"create account", &types.MsgCreateMultisigAccountParams{
+			Authority:           signer,
+			Seed:                1,
+			Signers:             [][]byte{[]byte("signer______________")},
+			Permission:          types.MultisigProposalType_MULTISIG_PROPOSAL_TYPE_TRANSFER_ONLY,
+			ThresholdPercentage: "0.5",
+		}},
+		{"add signer", &types.MsgAddMultisigSignerParams{MultisigAddress: multisig, Signer: signer, NewThreshold: 2}},
+		{"cleanup account", &types.MsgCleanupMultisigAccountParams{MultisigAddress: multisig}},
+		{"set threshold", &types.MsgSetMultisigThresholdParams{MultisigAddress: multisig, Threshold: 2}},
+		{"initialize proposal", &types.MsgInitializeMultisigProposalParams{MultisigAddress: multisig, Proposer: signer, Message: call}},
+		{"approve proposal", &types.MsgApproveMultisigProposalParams{MultisigAddress: multisig, ProposalId: 1, Approver: signer}},
+		{"approve and dispatch", &types.MsgApproveAndDispatchMultisigProposalParams{MultisigAddress: multisig, ProposalId: 1, Approver: signer, Message: call}},
+		{"batch approve proposal", &types.MsgBatchApproveMultisigProposalParams{
+			Submitter:       signer,
+			MultisigAddress: multisig,
+			ProposalId:      1,
+			Signatures:      []types.ApprovalSignature{{Signer: signer, Signature: []byte("signature")}},
+			Message:         call,
+		}},
+		{"schedule call", &types.MsgScheduleMultisigCallParams{
+			MultisigAddress: multisig,
+			Message:         call,
+			StartHeight:     10,
+			StartTime:       &startTime,
+			IntervalBlocks:  5,
+			MaxRuns:         3,
+		}},
+		{"cancel schedule", &types.MsgCancelMultisigScheduleParams{MultisigAddress: multisig, ScheduleId: 1}},
+		{"freeze account", &types.MsgFreezeMultisigAccount{Authority: signer, MultisigAddress: multisig, Reason: "compromised keys"}},
+		{"recover account", &types.MsgRecoverMultisigAccount{
+			Authority:           signer,
+			MultisigAddress:     multisig,
+			Signers:             []string{signer},
+			ThresholdPercentage: "0.66",
+		}},
+		{"cancel proposal", &types.MsgCancelMultisigProposalParams{MultisigAddress: multisig, ProposalId: 1, Rejecter: signer}},
+		{"cleanup proposal", &types.MsgCleanupMultisigProposalParams{MultisigAddress: multisig, ProposalId: 1, Remover: signer}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			legacyBz := sdk.MustSortJSON(cdc.MustMarshalJSON(tc.msg))
+
+			msgType, err := protoregistry.GlobalTypes.FindMessageByURL(sdk.MsgTypeURL(tc.msg))
+			require.NoError(t, err)
+
+			bz, err := gogoproto.Marshal(tc.msg)
+			require.NoError(t, err)
+			pulsarMsg := msgType.New().Interface()
+			require.NoError(t, proto.Unmarshal(bz, pulsarMsg))
+
+			signBz, err := encoder.Marshal(pulsarMsg)
+			require.NoError(t, err)
+			require.Equal(t, string(legacyBz), string(signBz))
+
+			decoded := reflect.New(reflect.TypeOf(tc.msg).Elem()).Interface().(sdk.Msg)
+			require.NoError(t, cdc.UnmarshalJSON(signBz, decoded))
+
+			// compare encodings, the decoded calls differ from the originals in cached state only
+			decodedBz, err := gogoproto.Marshal(decoded)
+			require.NoError(t, err)
+			require.Equal(t, bz, decodedBz)
+		})
+	}
+}
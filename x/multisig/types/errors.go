@@ -0,0 +1,17 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// Multisig sentinel errors
+var (
+	ErrNestingCycle     = errors.Register(ModuleName, 2, "multisig signer would create a nesting cycle")
+	ErrMaxNestingDepth  = errors.Register(ModuleName, 3, "multisig nesting depth exceeded")
+	ErrInvalidApproval  = errors.Register(ModuleName, 4, "invalid approval signature")
+	ErrInvalidSchedule  = errors.Register(ModuleName, 5, "invalid schedule")
+	ErrFeeBudget        = errors.Register(ModuleName, 6, "multisig fee budget exceeded")
+	ErrAccountFrozen    = errors.Register(ModuleName, 7, "multisig account is frozen")
+	ErrInvalidThreshold = errors.Register(ModuleName, 8, "invalid multisig threshold")
+	ErrTooManyProposals = errors.Register(ModuleName, 9, "too many open multisig proposals")
+)
@@ -0,0 +1,1623 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: multisig/v1/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventScheduleExecuted is emitted on every execution of a schedule by the EndBlocker
+type EventScheduleExecuted struct {
+	ScheduleId      uint64 `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	MultisigAddress string `protobuf:"bytes,2,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	Run             uint64 `protobuf:"varint,3,opt,name=run,proto3" json:"run,omitempty"`
+	// error is the reason of a failed execution, empty on success
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventScheduleExecuted) Reset()         { *m = EventScheduleExecuted{} }
+func (m *EventScheduleExecuted) String() string { return proto.CompactTextString(m) }
+func (*EventScheduleExecuted) ProtoMessage()    {}
+func (*EventScheduleExecuted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ebc92f951474872, []int{0}
+}
+func (m *EventScheduleExecuted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScheduleExecuted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScheduleExecuted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventScheduleExecuted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScheduleExecuted.Merge(m, src)
+}
+func (m *EventScheduleExecuted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScheduleExecuted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScheduleExecuted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScheduleExecuted proto.InternalMessageInfo
+
+func (m *EventScheduleExecuted) GetScheduleId() uint64 {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return 0
+}
+
+func (m *EventScheduleExecuted) GetMultisigAddress() string {
+	if m != nil {
+		return m.MultisigAddress
+	}
+	return ""
+}
+
+func (m *EventScheduleExecuted) GetRun() uint64 {
+	if m != nil {
+		return m.Run
+	}
+	return 0
+}
+
+func (m *EventScheduleExecuted) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// EventScheduleCancelled is emitted on Msg/CancelMultisigSchedule
+type EventScheduleCancelled struct {
+	ScheduleId      uint64 `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	MultisigAddress string `protobuf:"bytes,2,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	// remaining_runs is the number of executions cancelled
+	RemainingRuns uint64 `protobuf:"varint,3,opt,name=remaining_runs,json=remainingRuns,proto3" json:"remaining_runs,omitempty"`
+}
+
+func (m *EventScheduleCancelled) Reset()         { *m = EventScheduleCancelled{} }
+func (m *EventScheduleCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventScheduleCancelled) ProtoMessage()    {}
+func (*EventScheduleCancelled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ebc92f951474872, []int{1}
+}
+func (m *EventScheduleCancelled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScheduleCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScheduleCancelled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventScheduleCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScheduleCancelled.Merge(m, src)
+}
+func (m *EventScheduleCancelled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScheduleCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScheduleCancelled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScheduleCancelled proto.InternalMessageInfo
+
+func (m *EventScheduleCancelled) GetScheduleId() uint64 {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return 0
+}
+
+func (m *EventScheduleCancelled) GetMultisigAddress() string {
+	if m != nil {
+		return m.MultisigAddress
+	}
+	return ""
+}
+
+func (m *EventScheduleCancelled) GetRemainingRuns() uint64 {
+	if m != nil {
+		return m.RemainingRuns
+	}
+	return 0
+}
+
+// EventMultisigAccountFrozen is emitted on Msg/FreezeMultisigAccount
+type EventMultisigAccountFrozen struct {
+	MultisigAddress string `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	Reason          string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventMultisigAccountFrozen) Reset()         { *m = EventMultisigAccountFrozen{} }
+func (m *EventMultisigAccountFrozen) String() string { return proto.CompactTextString(m) }
+func (*EventMultisigAccountFrozen) ProtoMessage()    {}
+func (*EventMultisigAccountFrozen) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ebc92f951474872, []int{2}
+}
+func (m *EventMultisigAccountFrozen) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMultisigAccountFrozen) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMultisigAccountFrozen.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMultisigAccountFrozen) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMultisigAccountFrozen.Merge(m, src)
+}
+func (m *EventMultisigAccountFrozen) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMultisigAccountFrozen) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMultisigAccountFrozen.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMultisigAccountFrozen proto.InternalMessageInfo
+
+func (m *EventMultisigAccountFrozen) GetMultisigAddress() string {
+	if m != nil {
+		return m.MultisigAddress
+	}
+	return ""
+}
+
+func (m *EventMultisigAccountFrozen) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EventMultisigAccountRecovered is emitted on Msg/RecoverMultisigAccount
+type EventMultisigAccountRecovered struct {
+	MultisigAddress     string   `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	Signers             []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	Threshold           uint32   `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	ThresholdPercentage string   `protobuf:"bytes,4,opt,name=threshold_percentage,json=thresholdPercentage,proto3" json:"threshold_percentage,omitempty"`
+}
+
+func (m *EventMultisigAccountRecovered) Reset()         { *m = EventMultisigAccountRecovered{} }
+func (m *EventMultisigAccountRecovered) String() string { return proto.CompactTextString(m) }
+func (*EventMultisigAccountRecovered) ProtoMessage()    {}
+func (*EventMultisigAccountRecovered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ebc92f951474872, []int{3}
+}
+func (m *EventMultisigAccountRecovered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMultisigAccountRecovered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMultisigAccountRecovered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMultisigAccountRecovered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMultisigAccountRecovered.Merge(m, src)
+}
+func (m *EventMultisigAccountRecovered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMultisigAccountRecovered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMultisigAccountRecovered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMultisigAccountRecovered proto.InternalMessageInfo
+
+func (m *EventMultisigAccountRecovered) GetMultisigAddress() string {
+	if m != nil {
+		return m.MultisigAddress
+	}
+	return ""
+}
+
+func (m *EventMultisigAccountRecovered) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *EventMultisigAccountRecovered) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *EventMultisigAccountRecovered) GetThresholdPercentage() string {
+	if m != nil {
+		return m.ThresholdPercentage
+	}
+	return ""
+}
+
+// EventDepositForfeited is emitted when the deposit of a rejected or expired proposal is forfeited
+type EventDepositForfeited struct {
+	ProposalId      uint64                    `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	MultisigAddress string                    `protobuf:"bytes,2,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	Depositor       string                    `protobuf:"bytes,3,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Amount          string                    `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Destination     DepositForfeitDestination `protobuf:"varint,5,opt,name=destination,proto3,enum=multisig.v1.DepositForfeitDestination" json:"destination,omitempty"`
+	// expired is set for expired proposals, unset for rejected ones
+	Expired bool `protobuf:"varint,6,opt,name=expired,proto3" json:"expired,omitempty"`
+}
+
+func (m *EventDepositForfeited) Reset()         { *m = EventDepositForfeited{} }
+func (m *EventDepositForfeited) String() string { return proto.CompactTextString(m) }
+func (*EventDepositForfeited) ProtoMessage()    {}
+func (*EventDepositForfeited) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ebc92f951474872, []int{4}
+}
+func (m *EventDepositForfeited) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDepositForfeited) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDepositForfeited.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDepositForfeited) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDepositForfeited.Merge(m, src)
+}
+func (m *EventDepositForfeited) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDepositForfeited) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDepositForfeited.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDepositForfeited proto.InternalMessageInfo
+
+func (m *EventDepositForfeited) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventDepositForfeited) GetMultisigAddress() string {
+	if m != nil {
+		return m.MultisigAddress
+	}
+	return ""
+}
+
+func (m *EventDepositForfeited) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *EventDepositForfeited) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventDepositForfeited) GetDestination() DepositForfeitDestination {
+	if m != nil {
+		return m.Destination
+	}
+	return DepositForfeitDestination_DEPOSIT_FORFEIT_DESTINATION_UNSPECIFIED
+}
+
+func (m *EventDepositForfeited) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*EventScheduleExecuted)(nil), "multisig.v1.EventScheduleExecuted")
+	proto.RegisterType((*EventScheduleCancelled)(nil), "multisig.v1.EventScheduleCancelled")
+	proto.RegisterType((*EventMultisigAccountFrozen)(nil), "multisig.v1.EventMultisigAccountFrozen")
+	proto.RegisterType((*EventMultisigAccountRecovered)(nil), "multisig.v1.EventMultisigAccountRecovered")
+	proto.RegisterType((*EventDepositForfeited)(nil), "multisig.v1.EventDepositForfeited")
+}
+
+func init() { proto.RegisterFile("multisig/v1/events.proto", fileDescriptor_1ebc92f951474872) }
+
+var fileDescriptor_1ebc92f951474872 = []byte{
+	// 529 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xc1, 0x6a, 0xdb, 0x30,
+	0x18, 0x8e, 0x9a, 0x36, 0x5d, 0x14, 0x9a, 0x15, 0x2f, 0xeb, 0xbc, 0xb0, 0x79, 0x21, 0xb0, 0x91,
+	0x4b, 0x63, 0xda, 0xc1, 0xee, 0x4d, 0xd3, 0xb2, 0x1e, 0x0a, 0xc3, 0xbd, 0xed, 0x12, 0x54, 0xe9,
+	0x9f, 0x2d, 0xb0, 0x25, 0x23, 0xc9, 0x21, 0xdd, 0x53, 0xec, 0x25, 0xf6, 0x00, 0x83, 0x3e, 0xc4,
+	0x8e, 0xa5, 0xa7, 0x1d, 0x47, 0xf2, 0x14, 0xbb, 0x0d, 0xc7, 0xb2, 0x93, 0x42, 0xd9, 0x18, 0x94,
+	0xdd, 0xf2, 0x7f, 0xff, 0xf7, 0x7f, 0xff, 0x67, 0xe9, 0x8b, 0xb0, 0x9b, 0x64, 0xb1, 0xe1, 0x9a,
+	0x87, 0xfe, 0xf4, 0xc0, 0x87, 0x29, 0x08, 0xa3, 0x87, 0xa9, 0x92, 0x46, 0x3a, 0xad, 0xb2, 0x33,
+	0x9c, 0x1e, 0x74, 0x9f, 0x53, 0xa9, 0x13, 0xa9, 0x27, 0xcb, 0x96, 0x5f, 0x14, 0x05, 0xaf, 0xfb,
+	0x6c, 0x5d, 0x41, 0x1b, 0x62, 0xa0, 0x68, 0xf4, 0xbf, 0x22, 0xfc, 0xf4, 0x24, 0x57, 0xbc, 0xa0,
+	0x11, 0xb0, 0x2c, 0x86, 0x93, 0x19, 0xd0, 0xcc, 0x00, 0x73, 0x5e, 0xe1, 0x96, 0xb6, 0xd8, 0x84,
+	0x33, 0x17, 0xf5, 0xd0, 0x60, 0x33, 0xc0, 0x25, 0x74, 0xc6, 0x9c, 0x63, 0xbc, 0x5b, 0xaa, 0x4e,
+	0x08, 0x63, 0x0a, 0xb4, 0x76, 0x37, 0x7a, 0x68, 0xd0, 0x1c, 0xb9, 0xb7, 0xd7, 0xfb, 0x1d, 0xbb,
+	0xff, 0xa8, 0xe8, 0x5c, 0x18, 0xc5, 0x45, 0x18, 0x3c, 0x2e, 0x27, 0x2c, 0xec, 0xec, 0xe2, 0xba,
+	0xca, 0x84, 0x5b, 0x5f, 0xaa, 0xe7, 0x3f, 0x9d, 0x0e, 0xde, 0x02, 0xa5, 0xa4, 0x72, 0x37, 0x73,
+	0xad, 0xa0, 0x28, 0x72, 0x9f, 0x7b, 0x77, 0x7c, 0x1e, 0x13, 0x41, 0x21, 0x8e, 0xff, 0x9b, 0xd1,
+	0xd7, 0xb8, 0xad, 0x20, 0x21, 0x5c, 0x70, 0x11, 0x4e, 0x54, 0x26, 0xb4, 0xf5, 0xbc, 0x53, 0xa1,
+	0x41, 0x26, 0x74, 0xff, 0x0a, 0x77, 0x97, 0x36, 0xcf, 0xcb, 0x71, 0x4a, 0x65, 0x26, 0xcc, 0xa9,
+	0x92, 0x9f, 0x41, 0xdc, 0xeb, 0x04, 0xfd, 0xab, 0x93, 0x3d, 0xdc, 0x50, 0x40, 0xb4, 0x14, 0xc5,
+	0x47, 0x04, 0xb6, 0xea, 0xff, 0x42, 0xf8, 0xe5, 0x7d, 0xbb, 0x03, 0xa0, 0x72, 0x0a, 0x0a, 0xd8,
+	0xc3, 0xac, 0x3f, 0xc4, 0xdb, 0x9a, 0x87, 0x02, 0x54, 0x7e, 0x88, 0xf5, 0x3f, 0xce, 0x96, 0x44,
+	0xe7, 0x05, 0x6e, 0x9a, 0x48, 0x81, 0x8e, 0x64, 0xcc, 0x96, 0xe7, 0xb6, 0x13, 0xac, 0x00, 0xe7,
+	0x08, 0x77, 0xaa, 0x62, 0x92, 0x82, 0xa2, 0x20, 0x0c, 0x09, 0xa1, 0x08, 0xc0, 0xa8, 0x7d, 0x7b,
+	0xbd, 0x8f, 0xad, 0xfc, 0x18, 0x68, 0xf0, 0xa4, 0xe2, 0x7e, 0xa8, 0xa8, 0xfd, 0x6f, 0x1b, 0x36,
+	0xc6, 0x63, 0x48, 0xa5, 0xe6, 0xe6, 0x54, 0xaa, 0x4f, 0xc0, 0x6d, 0x8c, 0x53, 0x25, 0x53, 0xa9,
+	0x49, 0xbc, 0x96, 0x8e, 0x12, 0x7a, 0xa8, 0x74, 0xbc, 0xc3, 0x4d, 0x56, 0x6c, 0x96, 0xca, 0xad,
+	0xff, 0x65, 0x7a, 0x45, 0xcd, 0xef, 0x92, 0x24, 0xf9, 0x25, 0xd9, 0xb4, 0xdb, 0xca, 0x79, 0x8f,
+	0x5b, 0x0c, 0xb4, 0xe1, 0x82, 0x18, 0x2e, 0x85, 0xbb, 0xd5, 0x43, 0x83, 0xf6, 0xe1, 0x9b, 0xe1,
+	0xda, 0xbf, 0x7d, 0x78, 0xf7, 0x4b, 0xc7, 0x2b, 0x76, 0xb0, 0x3e, 0xea, 0xb8, 0x78, 0x1b, 0x66,
+	0x29, 0x57, 0xc0, 0xdc, 0x46, 0x0f, 0x0d, 0x1e, 0x05, 0x65, 0x39, 0x3a, 0xfb, 0x3e, 0xf7, 0xd0,
+	0xcd, 0xdc, 0x43, 0x3f, 0xe7, 0x1e, 0xfa, 0xb2, 0xf0, 0x6a, 0x37, 0x0b, 0xaf, 0xf6, 0x63, 0xe1,
+	0xd5, 0x3e, 0xfa, 0x21, 0x37, 0x51, 0x76, 0x39, 0xa4, 0x32, 0xf1, 0xc7, 0x04, 0xa6, 0xe7, 0xdc,
+	0x44, 0x8a, 0x08, 0x9f, 0x25, 0x34, 0x22, 0x5c, 0xf8, 0x33, 0xbf, 0x7a, 0x4e, 0xcc, 0x55, 0x0a,
+	0xfa, 0xb2, 0xb1, 0x7c, 0x4c, 0xde, 0xfe, 0x1e, 0x00, 0xe7, 0xf2, 0xd9, 0x5b, 0xa9, 0x04, 0x00,
+	0x00,
+}
+
+func (m *EventScheduleExecuted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScheduleExecuted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScheduleExecuted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Run != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Run))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MultisigAddress) > 0 {
+		i -= len(m.MultisigAddress)
+		copy(dAtA[i:], m.MultisigAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MultisigAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ScheduleId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScheduleCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScheduleCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScheduleCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingRuns != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RemainingRuns))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MultisigAddress) > 0 {
+		i -= len(m.MultisigAddress)
+		copy(dAtA[i:], m.MultisigAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MultisigAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ScheduleId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMultisigAccountFrozen) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMultisigAccountFrozen) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMultisigAccountFrozen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MultisigAddress) > 0 {
+		i -= len(m.MultisigAddress)
+		copy(dAtA[i:], m.MultisigAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MultisigAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMultisigAccountRecovered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMultisigAccountRecovered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMultisigAccountRecovered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ThresholdPercentage) > 0 {
+		i -= len(m.ThresholdPercentage)
+		copy(dAtA[i:], m.ThresholdPercentage)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ThresholdPercentage)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MultisigAddress) > 0 {
+		i -= len(m.MultisigAddress)
+		copy(dAtA[i:], m.MultisigAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MultisigAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDepositForfeited) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDepositForfeited) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDepositForfeited) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Destination != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MultisigAddress) > 0 {
+		i -= len(m.MultisigAddress)
+		copy(dAtA[i:], m.MultisigAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MultisigAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventScheduleExecuted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduleId != 0 {
+		n += 1 + sovEvents(uint64(m.ScheduleId))
+	}
+	l = len(m.MultisigAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Run != 0 {
+		n += 1 + sovEvents(uint64(m.Run))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScheduleCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduleId != 0 {
+		n += 1 + sovEvents(uint64(m.ScheduleId))
+	}
+	l = len(m.MultisigAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.RemainingRuns != 0 {
+		n += 1 + sovEvents(uint64(m.RemainingRuns))
+	}
+	return n
+}
+
+func (m *EventMultisigAccountFrozen) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MultisigAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMultisigAccountRecovered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MultisigAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovEvents(uint64(m.Threshold))
+	}
+	l = len(m.ThresholdPercentage)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDepositForfeited) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	l = len(m.MultisigAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Destination != 0 {
+		n += 1 + sovEvents(uint64(m.Destination))
+	}
+	if m.Expired {
+		n += 2
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventScheduleExecuted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScheduleExecuted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScheduleExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultisigAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Run", wireType)
+			}
+			m.Run = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Run |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScheduleCancelled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScheduleCancelled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScheduleCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultisigAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingRuns", wireType)
+			}
+			m.RemainingRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingRuns |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMultisigAccountFrozen) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMultisigAccountFrozen: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMultisigAccountFrozen: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultisigAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMultisigAccountRecovered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMultisigAccountRecovered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMultisigAccountRecovered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultisigAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThresholdPercentage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDepositForfeited) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDepositForfeited: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDepositForfeited: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultisigAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= DepositForfeitDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	genesistypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/genesis/types"
+)
+
+// ICAControllerKeeper defines the expected interface contract defined by the
+// interchain accounts controller submodule.
+type ICAControllerKeeper interface {
+	GetInterchainAccountAddress(ctx sdk.Context, connectionID, portID string) (string, bool)
+	GetAllInterchainAccounts(ctx sdk.Context) []genesistypes.RegisteredInterchainAccount
+}
+
+// DistributionKeeper defines the expected interface contract defined by the x/distribution
+// module, receiving forfeited deposits in the community pool.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// AccountKeeper defines the expected interface contract defined by the x/auth
+// module.
+type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+}
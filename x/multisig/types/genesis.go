@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // DefaultIndex is the default global index
 const DefaultIndex uint64 = 1
 
@@ -14,6 +20,95 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	accounts := make(map[string]bool, len(gs.Accounts))
+	for _, account := range gs.Accounts {
+		address, err := sdk.AccAddressFromBech32(account.Address)
+		if err != nil {
+			return fmt.Errorf("invalid multisig account address %s: %w", account.Address, err)
+		}
+
+		if accounts[string(address)] {
+			return fmt.Errorf("duplicate multisig account %s", account.Address)
+		}
+		accounts[string(address)] = true
+
+		if account.Details.ThresholdPercentage != "" {
+			if account.Details.Threshold != 0 {
+				return fmt.Errorf("multisig account %s has both a threshold and a threshold percentage", account.Address)
+			}
+			if err := ValidateThresholdPercentage(account.Details.ThresholdPercentage); err != nil {
+				return fmt.Errorf("invalid threshold for multisig account %s: %w", account.Address, err)
+			}
+		} else if account.Details.Threshold < 1 {
+			return fmt.Errorf("invalid threshold for multisig account %s", account.Address)
+		}
+	}
+
+	proposals := make(map[uint64]bool, len(gs.Proposals))
+	for _, proposal := range gs.Proposals {
+		if proposal.Id == 0 || proposal.Id > gs.ProposalSequence {
+			return fmt.Errorf("invalid proposal id %d, expected a value in [1, %d]", proposal.Id, gs.ProposalSequence)
+		}
+
+		if proposals[proposal.Id] {
+			return fmt.Errorf("duplicate proposal id %d", proposal.Id)
+		}
+		proposals[proposal.Id] = true
+
+		if !accounts[string(proposal.MultisigAddress)] {
+			return fmt.Errorf("proposal %d references unknown multisig account %s", proposal.Id, sdk.AccAddress(proposal.MultisigAddress))
+		}
+
+		if err := proposal.Deposit.Validate(); err != nil {
+			return fmt.Errorf("invalid deposit of proposal %d: %w", proposal.Id, err)
+		}
+	}
+
+	schedules := make(map[uint64]bool, len(gs.Schedules))
+	for _, schedule := range gs.Schedules {
+		if schedule.Id == 0 || schedule.Id > gs.ScheduleSequence {
+			return fmt.Errorf("invalid schedule id %d, expected a value in [1, %d]", schedule.Id, gs.ScheduleSequence)
+		}
+
+		if schedules[schedule.Id] {
+			return fmt.Errorf("duplicate schedule id %d", schedule.Id)
+		}
+		schedules[schedule.Id] = true
+
+		if !accounts[string(schedule.MultisigAddress)] {
+			return fmt.Errorf("schedule %d references unknown multisig account %s", schedule.Id, sdk.AccAddress(schedule.MultisigAddress))
+		}
+
+		if schedule.Message == nil {
+			return fmt.Errorf("schedule %d has no call", schedule.Id)
+		}
+
+		if schedule.MaxRuns == 0 || schedule.Runs >= schedule.MaxRuns {
+			return fmt.Errorf("schedule %d has no remaining runs", schedule.Id)
+		}
+	}
+
+	for _, failure := range gs.ScheduleFailures {
+		if failure.ScheduleId == 0 || failure.ScheduleId > gs.ScheduleSequence {
+			return fmt.Errorf("invalid schedule id %d of failure, expected a value in [1, %d]", failure.ScheduleId, gs.ScheduleSequence)
+		}
+	}
+
+	spends := make(map[string]bool, len(gs.FeeBudgetSpends))
+	for _, spend := range gs.FeeBudgetSpends {
+		if _, err := sdk.AccAddressFromBech32(spend.Address); err != nil {
+			return fmt.Errorf("invalid fee budget spend address %s: %w", spend.Address, err)
+		}
+
+		if spends[spend.Address] {
+			return fmt.Errorf("duplicate fee budget spend of %s", spend.Address)
+		}
+		spends[spend.Address] = true
+
+		if err := spend.Spend.Spent.Validate(); err != nil {
+			return fmt.Errorf("invalid fee budget spend of %s: %w", spend.Address, err)
+		}
+	}
 
 	return gs.Params.Validate()
 }
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -28,6 +31,20 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// Params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// accounts defines the multisig accounts.
+	Accounts []GenesisMultisigAccount `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts"`
+	// proposals defines the open multisig proposals.
+	Proposals []Proposal `protobuf:"bytes,3,rep,name=proposals,proto3" json:"proposals"`
+	// proposal_sequence is the last proposal id assigned by the proposal table.
+	ProposalSequence uint64 `protobuf:"varint,4,opt,name=proposal_sequence,json=proposalSequence,proto3" json:"proposal_sequence,omitempty"`
+	// schedules defines the pending scheduled calls.
+	Schedules []Schedule `protobuf:"bytes,5,rep,name=schedules,proto3" json:"schedules"`
+	// schedule_sequence is the last schedule id assigned.
+	ScheduleSequence uint64 `protobuf:"varint,6,opt,name=schedule_sequence,json=scheduleSequence,proto3" json:"schedule_sequence,omitempty"`
+	// schedule_failures defines the recorded failed executions of schedules.
+	ScheduleFailures []ScheduleFailure `protobuf:"bytes,7,rep,name=schedule_failures,json=scheduleFailures,proto3" json:"schedule_failures"`
+	// fee_budget_spends defines the fees paid by multisig accounts in their current budget period.
+	FeeBudgetSpends []GenesisFeeBudgetSpend `protobuf:"bytes,8,rep,name=fee_budget_spends,json=feeBudgetSpends,proto3" json:"fee_budget_spends"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,15 +87,191 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetAccounts() []GenesisMultisigAccount {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProposals() []Proposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProposalSequence() uint64 {
+	if m != nil {
+		return m.ProposalSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSchedules() []Schedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduleSequence() uint64 {
+	if m != nil {
+		return m.ScheduleSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetScheduleFailures() []ScheduleFailure {
+	if m != nil {
+		return m.ScheduleFailures
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeBudgetSpends() []GenesisFeeBudgetSpend {
+	if m != nil {
+		return m.FeeBudgetSpends
+	}
+	return nil
+}
+
+// GenesisMultisigAccount defines a multisig account and its details.
+type GenesisMultisigAccount struct {
+	Address string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Details MultisigAccountDetails `protobuf:"bytes,2,opt,name=details,proto3" json:"details"`
+}
+
+func (m *GenesisMultisigAccount) Reset()         { *m = GenesisMultisigAccount{} }
+func (m *GenesisMultisigAccount) String() string { return proto.CompactTextString(m) }
+func (*GenesisMultisigAccount) ProtoMessage()    {}
+func (*GenesisMultisigAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e8f892d9f3b1e70, []int{1}
+}
+func (m *GenesisMultisigAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisMultisigAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisMultisigAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisMultisigAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisMultisigAccount.Merge(m, src)
+}
+func (m *GenesisMultisigAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisMultisigAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisMultisigAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisMultisigAccount proto.InternalMessageInfo
+
+func (m *GenesisMultisigAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GenesisMultisigAccount) GetDetails() MultisigAccountDetails {
+	if m != nil {
+		return m.Details
+	}
+	return MultisigAccountDetails{}
+}
+
+// GenesisFeeBudgetSpend defines the fees paid by a multisig account in a budget period.
+type GenesisFeeBudgetSpend struct {
+	Address string         `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Spend   FeeBudgetSpend `protobuf:"bytes,2,opt,name=spend,proto3" json:"spend"`
+}
+
+func (m *GenesisFeeBudgetSpend) Reset()         { *m = GenesisFeeBudgetSpend{} }
+func (m *GenesisFeeBudgetSpend) String() string { return proto.CompactTextString(m) }
+func (*GenesisFeeBudgetSpend) ProtoMessage()    {}
+func (*GenesisFeeBudgetSpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e8f892d9f3b1e70, []int{2}
+}
+func (m *GenesisFeeBudgetSpend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisFeeBudgetSpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisFeeBudgetSpend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisFeeBudgetSpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisFeeBudgetSpend.Merge(m, src)
+}
+func (m *GenesisFeeBudgetSpend) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisFeeBudgetSpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisFeeBudgetSpend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisFeeBudgetSpend proto.InternalMessageInfo
+
+func (m *GenesisFeeBudgetSpend) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GenesisFeeBudgetSpend) GetSpend() FeeBudgetSpend {
+	if m != nil {
+		return m.Spend
+	}
+	return FeeBudgetSpend{}
+}
+
 // Params defines the set of module parameters.
 type Params struct {
 	SomeValue bool `protobuf:"varint,2,opt,name=some_value,json=someValue,proto3" json:"some_value,omitempty"`
+	// max_nesting_depth is the maximum number of nested multisig levels, i.e. multisig
+	// accounts acting as signers of other multisig accounts, including the outermost one.
+	MaxNestingDepth uint32 `protobuf:"varint,3,opt,name=max_nesting_depth,json=maxNestingDepth,proto3" json:"max_nesting_depth,omitempty"`
+	// max_scheduled_executions_per_block is the maximum number of scheduled calls executed
+	// by the EndBlocker in a block. Due calls above the cap are deferred to the next blocks.
+	MaxScheduledExecutionsPerBlock uint32 `protobuf:"varint,4,opt,name=max_scheduled_executions_per_block,json=maxScheduledExecutionsPerBlock,proto3" json:"max_scheduled_executions_per_block,omitempty"`
+	// fee_budget is the maximum amount of fees a multisig account pays per fee_budget_period for
+	// txs of its signers that only approve or execute its proposals. An empty budget disables it.
+	FeeBudget github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=fee_budget,json=feeBudget,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_budget"`
+	// fee_budget_period is the number of blocks after which the fee budgets are renewed.
+	FeeBudgetPeriod uint64 `protobuf:"varint,6,opt,name=fee_budget_period,json=feeBudgetPeriod,proto3" json:"fee_budget_period,omitempty"`
+	// deposit_forfeit_destination is where the deposits of rejected and expired proposals go.
+	// Deposits are refunded when proposals are executed or cancelled by their depositor.
+	DepositForfeitDestination DepositForfeitDestination `protobuf:"varint,7,opt,name=deposit_forfeit_destination,json=depositForfeitDestination,proto3,enum=multisig.v1.DepositForfeitDestination" json:"deposit_forfeit_destination,omitempty"`
+	// proposal_expiry_blocks is the number of blocks after which an open proposal expires,
+	// zero for proposals that do not expire.
+	ProposalExpiryBlocks uint64 `protobuf:"varint,8,opt,name=proposal_expiry_blocks,json=proposalExpiryBlocks,proto3" json:"proposal_expiry_blocks,omitempty"`
+	// max_open_proposals is the maximum number of proposals open at once per multisig account.
+	MaxOpenProposals uint32 `protobuf:"varint,9,opt,name=max_open_proposals,json=maxOpenProposals,proto3" json:"max_open_proposals,omitempty"`
+	// deposit is the amount held in reserve of the proposer of a multisig proposal.
+	Deposit types.Coin `protobuf:"bytes,10,opt,name=deposit,proto3" json:"deposit"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e8f892d9f3b1e70, []int{1}
+	return fileDescriptor_8e8f892d9f3b1e70, []int{3}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -114,31 +307,126 @@ func (m *Params) GetSomeValue() bool {
 	return false
 }
 
+func (m *Params) GetMaxNestingDepth() uint32 {
+	if m != nil {
+		return m.MaxNestingDepth
+	}
+	return 0
+}
+
+func (m *Params) GetMaxScheduledExecutionsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxScheduledExecutionsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetFeeBudget() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeBudget
+	}
+	return nil
+}
+
+func (m *Params) GetFeeBudgetPeriod() uint64 {
+	if m != nil {
+		return m.FeeBudgetPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetDepositForfeitDestination() DepositForfeitDestination {
+	if m != nil {
+		return m.DepositForfeitDestination
+	}
+	return DepositForfeitDestination_DEPOSIT_FORFEIT_DESTINATION_UNSPECIFIED
+}
+
+func (m *Params) GetProposalExpiryBlocks() uint64 {
+	if m != nil {
+		return m.ProposalExpiryBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxOpenProposals() uint32 {
+	if m != nil {
+		return m.MaxOpenProposals
+	}
+	return 0
+}
+
+func (m *Params) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "multisig.v1.GenesisState")
+	proto.RegisterType((*GenesisMultisigAccount)(nil), "multisig.v1.GenesisMultisigAccount")
+	proto.RegisterType((*GenesisFeeBudgetSpend)(nil), "multisig.v1.GenesisFeeBudgetSpend")
 	proto.RegisterType((*Params)(nil), "multisig.v1.Params")
 }
 
 func init() { proto.RegisterFile("multisig/v1/genesis.proto", fileDescriptor_8e8f892d9f3b1e70) }
 
 var fileDescriptor_8e8f892d9f3b1e70 = []byte{
-	// 256 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcc, 0x2d, 0xcd, 0x29,
-	0xc9, 0x2c, 0xce, 0x4c, 0xd7, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x86, 0x49, 0xe9, 0x95, 0x19, 0x4a, 0x89, 0xa4, 0xe7,
-	0xa7, 0xe7, 0x83, 0xc5, 0xf5, 0x41, 0x2c, 0x88, 0x12, 0x29, 0xc1, 0xc4, 0xdc, 0xcc, 0xbc, 0x7c,
-	0x7d, 0x30, 0x09, 0x11, 0x52, 0x72, 0xe4, 0xe2, 0x71, 0x87, 0x18, 0x13, 0x5c, 0x92, 0x58, 0x92,
-	0x2a, 0x64, 0xc8, 0xc5, 0x56, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1,
-	0x6d, 0x24, 0xac, 0x87, 0x64, 0xac, 0x5e, 0x00, 0x58, 0xca, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86,
-	0x20, 0xa8, 0x42, 0x25, 0x57, 0x2e, 0x36, 0x88, 0xb8, 0x90, 0x2c, 0x17, 0x57, 0x71, 0x7e, 0x6e,
-	0x6a, 0x7c, 0x59, 0x62, 0x4e, 0x69, 0xaa, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x47, 0x10, 0x27, 0x48,
-	0x24, 0x0c, 0x24, 0x60, 0x25, 0x33, 0x63, 0x81, 0x3c, 0xc3, 0x8b, 0x05, 0xf2, 0x8c, 0x5d, 0xcf,
-	0x37, 0x68, 0xf1, 0xc3, 0x7d, 0x02, 0x31, 0xc6, 0xc9, 0xf3, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
-	0x8f, 0xe5, 0x18, 0xa2, 0xf4, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
-	0x5d, 0x12, 0x53, 0xcb, 0x7c, 0x33, 0x4b, 0x32, 0x8a, 0x12, 0xf3, 0xf4, 0x53, 0x72, 0x93, 0x33,
-	0x12, 0x33, 0xf3, 0xf4, 0x2b, 0xf4, 0xe1, 0x66, 0x95, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81,
-	0xfd, 0x66, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x1a, 0xef, 0x20, 0xe4, 0x2e, 0x01, 0x00, 0x00,
+	// 844 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xbf, 0x6f, 0x23, 0x45,
+	0x14, 0xf6, 0x92, 0x5c, 0x1c, 0x4f, 0xee, 0x48, 0x32, 0xe4, 0x8e, 0x4d, 0xee, 0xd8, 0x58, 0x46,
+	0x42, 0x56, 0xe0, 0x76, 0xe5, 0x80, 0x84, 0xa0, 0x40, 0x3a, 0x9f, 0x13, 0x04, 0xd2, 0x71, 0xd1,
+	0x1a, 0x51, 0xd0, 0xac, 0xc6, 0xbb, 0xcf, 0xeb, 0xd1, 0xed, 0xee, 0x2c, 0x3b, 0xb3, 0x96, 0xd3,
+	0x51, 0x50, 0x51, 0x41, 0x87, 0xa8, 0xae, 0x44, 0x54, 0x29, 0xf8, 0x23, 0xae, 0x3c, 0x51, 0x51,
+	0x01, 0x4a, 0x8a, 0xf0, 0x27, 0xd0, 0x81, 0xe6, 0xc7, 0xae, 0x6d, 0x30, 0x14, 0xd7, 0x24, 0xde,
+	0xf7, 0x7d, 0xf3, 0xbe, 0x37, 0xef, 0x7d, 0xf3, 0xd0, 0x7e, 0x5a, 0x26, 0x82, 0x72, 0x1a, 0x7b,
+	0xd3, 0x9e, 0x17, 0x43, 0x06, 0x9c, 0x72, 0x37, 0x2f, 0x98, 0x60, 0x78, 0xab, 0x82, 0xdc, 0x69,
+	0xef, 0x60, 0x2f, 0x66, 0x31, 0x53, 0x71, 0x4f, 0xfe, 0xd2, 0x94, 0x83, 0x5d, 0x92, 0xd2, 0x8c,
+	0x79, 0xea, 0xaf, 0x09, 0xed, 0x87, 0x8c, 0xa7, 0x8c, 0x07, 0x9a, 0xab, 0x3f, 0x0c, 0xf4, 0xea,
+	0xa2, 0x16, 0x17, 0x44, 0x80, 0x01, 0x0e, 0x96, 0x80, 0x70, 0x02, 0x51, 0x99, 0x54, 0x98, 0xa3,
+	0x53, 0x78, 0x23, 0xc2, 0xc1, 0x9b, 0xf6, 0x46, 0x20, 0x48, 0xcf, 0x0b, 0x19, 0xcd, 0x34, 0xde,
+	0xf9, 0x6b, 0x0d, 0xdd, 0xfc, 0x50, 0xd7, 0x3d, 0x94, 0x29, 0x71, 0x0f, 0x6d, 0xe4, 0xa4, 0x20,
+	0x29, 0xb7, 0xad, 0xb6, 0xd5, 0xdd, 0x3a, 0x7e, 0xc5, 0x5d, 0xb8, 0x87, 0x7b, 0xa6, 0xa0, 0xfe,
+	0xfa, 0xb3, 0x5f, 0x0f, 0x1b, 0xbe, 0x21, 0xe2, 0x13, 0xb4, 0x49, 0xc2, 0x90, 0x95, 0x99, 0xe0,
+	0xf6, 0x4b, 0xed, 0xb5, 0xee, 0xd6, 0xf1, 0xeb, 0x4b, 0x87, 0x4c, 0xfe, 0x47, 0x26, 0xf4, 0x40,
+	0x73, 0x4d, 0x92, 0xfa, 0x28, 0x7e, 0x0f, 0xb5, 0xf2, 0x82, 0xe5, 0x8c, 0x93, 0x84, 0xdb, 0x6b,
+	0x2a, 0xcf, 0xed, 0x65, 0x71, 0x83, 0x9a, 0x93, 0x73, 0x36, 0x7e, 0x13, 0xed, 0x56, 0x1f, 0x01,
+	0x87, 0x2f, 0x4a, 0xc8, 0x42, 0xb0, 0xd7, 0xdb, 0x56, 0x77, 0xdd, 0xdf, 0xa9, 0x80, 0xa1, 0x89,
+	0x4b, 0x9d, 0xaa, 0x49, 0xdc, 0xbe, 0xb1, 0x42, 0x67, 0x68, 0xd0, 0x4a, 0xa7, 0x66, 0x4b, 0x9d,
+	0xea, 0x63, 0xae, 0xb3, 0xa1, 0x75, 0x2a, 0xa0, 0xd6, 0x79, 0xbc, 0x40, 0x1e, 0x13, 0x9a, 0x94,
+	0x05, 0x70, 0xbb, 0xa9, 0xf4, 0xee, 0xad, 0xd4, 0x3b, 0xd5, 0x24, 0x23, 0xbb, 0xc3, 0x97, 0xc3,
+	0x1c, 0x7f, 0x8a, 0x76, 0xc7, 0x00, 0xc1, 0xa8, 0x8c, 0x62, 0x10, 0x01, 0xcf, 0x21, 0x8b, 0xb8,
+	0xbd, 0xa9, 0x12, 0x76, 0x56, 0x35, 0xfc, 0x14, 0xa0, 0xaf, 0xb8, 0x43, 0x49, 0x35, 0x69, 0xb7,
+	0xc7, 0x4b, 0x51, 0xde, 0xf9, 0xd6, 0x42, 0x77, 0x56, 0x4f, 0x08, 0x1f, 0xa3, 0x26, 0x89, 0xa2,
+	0x02, 0xb8, 0x36, 0x43, 0xab, 0x6f, 0xff, 0xfc, 0xd3, 0xfd, 0x3d, 0x63, 0xca, 0x07, 0x1a, 0x19,
+	0x8a, 0x82, 0x66, 0xb1, 0x5f, 0x11, 0xf1, 0x43, 0xd4, 0x8c, 0x40, 0x10, 0x9a, 0x48, 0x2f, 0x58,
+	0xff, 0xf2, 0xc2, 0x3f, 0x24, 0x06, 0x9a, 0x6a, 0x6a, 0xab, 0x4e, 0x76, 0xbe, 0xb2, 0xd0, 0xed,
+	0x95, 0x97, 0x78, 0xa1, 0x92, 0xde, 0x45, 0x37, 0x54, 0xb3, 0x4c, 0x41, 0x77, 0x97, 0x0a, 0x5a,
+	0xd9, 0x24, 0xcd, 0xef, 0xfc, 0xb9, 0x8e, 0x36, 0xb4, 0xe3, 0xf1, 0x6b, 0x08, 0x71, 0x96, 0x42,
+	0x30, 0x25, 0x49, 0x09, 0x2a, 0xd1, 0xa6, 0xdf, 0x92, 0x91, 0xcf, 0x64, 0x00, 0x1f, 0xa1, 0xdd,
+	0x94, 0xcc, 0x82, 0x0c, 0xb8, 0xa0, 0x59, 0x1c, 0x44, 0x90, 0x8b, 0x89, 0xbd, 0xd6, 0xb6, 0xba,
+	0xb7, 0xfc, 0xed, 0x94, 0xcc, 0x3e, 0xd1, 0xf1, 0x81, 0x0c, 0xe3, 0x8f, 0x51, 0x47, 0x72, 0xab,
+	0xf1, 0x46, 0x01, 0xcc, 0x20, 0x2c, 0x05, 0x65, 0x19, 0x0f, 0x72, 0x28, 0x82, 0x51, 0xc2, 0xc2,
+	0x27, 0xca, 0xbd, 0xb7, 0x7c, 0x27, 0x25, 0xb3, 0xca, 0x1e, 0xd1, 0x49, 0xcd, 0x3b, 0x83, 0xa2,
+	0x2f, 0x59, 0xf8, 0x4b, 0x0b, 0xa1, 0xb9, 0x27, 0x8c, 0x9b, 0xf7, 0x5d, 0xd3, 0x0f, 0xf9, 0xe8,
+	0x5d, 0xf3, 0xe8, 0xdd, 0x87, 0x8c, 0x66, 0xfd, 0x53, 0x79, 0xbd, 0x1f, 0x7f, 0x3b, 0xec, 0xc6,
+	0x54, 0x4c, 0xca, 0x91, 0x1b, 0xb2, 0xd4, 0x2c, 0x19, 0xf3, 0xef, 0x3e, 0x8f, 0x9e, 0x78, 0xe2,
+	0x3c, 0x07, 0xae, 0x0e, 0xf0, 0xef, 0xaf, 0x2f, 0x8e, 0x6e, 0x26, 0x10, 0x93, 0xf0, 0x3c, 0x90,
+	0x6b, 0x83, 0xff, 0x70, 0x7d, 0x71, 0x64, 0xf9, 0xad, 0xda, 0x45, 0xf2, 0xea, 0x0b, 0xae, 0xcc,
+	0xa1, 0xa0, 0x2c, 0x32, 0x6f, 0x62, 0xee, 0xb5, 0x33, 0x15, 0xc6, 0x63, 0x74, 0x37, 0x82, 0x9c,
+	0x71, 0x2a, 0x82, 0x31, 0x2b, 0xc6, 0x40, 0x45, 0x10, 0xa9, 0xd6, 0x10, 0x79, 0x2d, 0xbb, 0xd9,
+	0xb6, 0xba, 0x2f, 0x1f, 0xbf, 0xb1, 0x34, 0x9f, 0x81, 0xe6, 0x9f, 0x6a, 0xfa, 0x60, 0xce, 0xf6,
+	0xf7, 0xa3, 0xff, 0x82, 0xf0, 0x3b, 0xe8, 0x4e, 0xbd, 0x0f, 0x60, 0x96, 0xd3, 0xe2, 0x5c, 0x77,
+	0x55, 0x3e, 0x17, 0x59, 0xd8, 0x5e, 0x85, 0x9e, 0x28, 0x50, 0xf5, 0x92, 0xe3, 0xb7, 0x10, 0x96,
+	0x83, 0x61, 0x39, 0x64, 0xc1, 0x7c, 0x13, 0xb5, 0xd4, 0x20, 0x76, 0x52, 0x32, 0x7b, 0x9c, 0x43,
+	0x56, 0xed, 0x20, 0x8e, 0x3f, 0x40, 0x4d, 0x53, 0x80, 0x8d, 0xda, 0xd6, 0xff, 0xb7, 0xbd, 0x25,
+	0xdb, 0xae, 0x3b, 0x57, 0x1d, 0x7a, 0xff, 0xde, 0x77, 0x4f, 0x0f, 0x1b, 0x7f, 0x3c, 0x3d, 0xb4,
+	0xbe, 0xbe, 0xbe, 0x38, 0xda, 0xae, 0x57, 0xb8, 0xde, 0xa9, 0xfd, 0x8f, 0x9e, 0x5d, 0x3a, 0xd6,
+	0xf3, 0x4b, 0xc7, 0xfa, 0xfd, 0xd2, 0xb1, 0xbe, 0xb9, 0x72, 0x1a, 0xcf, 0xaf, 0x9c, 0xc6, 0x2f,
+	0x57, 0x4e, 0xe3, 0x73, 0x6f, 0x61, 0x74, 0x03, 0x02, 0xd3, 0x47, 0x54, 0x4c, 0x0a, 0x92, 0x79,
+	0x51, 0x1a, 0x4e, 0x08, 0xcd, 0xbc, 0x99, 0x57, 0xe7, 0x52, 0x73, 0x1c, 0x6d, 0xa8, 0x4d, 0xff,
+	0xf6, 0xdf, 0x03, 0x00, 0xdb, 0xd9, 0xd5, 0x84, 0xac, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -163,6 +451,35 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SomeValue != that1.SomeValue {
 		return false
 	}
+	if this.MaxNestingDepth != that1.MaxNestingDepth {
+		return false
+	}
+	if this.MaxScheduledExecutionsPerBlock != that1.MaxScheduledExecutionsPerBlock {
+		return false
+	}
+	if len(this.FeeBudget) != len(that1.FeeBudget) {
+		return false
+	}
+	for i := range this.FeeBudget {
+		if !this.FeeBudget[i].Equal(&that1.FeeBudget[i]) {
+			return false
+		}
+	}
+	if this.FeeBudgetPeriod != that1.FeeBudgetPeriod {
+		return false
+	}
+	if this.DepositForfeitDestination != that1.DepositForfeitDestination {
+		return false
+	}
+	if this.ProposalExpiryBlocks != that1.ProposalExpiryBlocks {
+		return false
+	}
+	if this.MaxOpenProposals != that1.MaxOpenProposals {
+		return false
+	}
+	if !this.Deposit.Equal(&that1.Deposit) {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -185,6 +502,86 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeBudgetSpends) > 0 {
+		for iNdEx := len(m.FeeBudgetSpends) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeBudgetSpends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ScheduleFailures) > 0 {
+		for iNdEx := len(m.ScheduleFailures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduleFailures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ScheduleSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ScheduleSequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ProposalSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ProposalSequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -198,6 +595,86 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisMultisigAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisMultisigAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisMultisigAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisFeeBudgetSpend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisFeeBudgetSpend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisFeeBudgetSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Spend.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -218,6 +695,60 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.MaxOpenProposals != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxOpenProposals))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ProposalExpiryBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ProposalExpiryBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DepositForfeitDestination != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DepositForfeitDestination))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FeeBudgetPeriod != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FeeBudgetPeriod))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.FeeBudget) > 0 {
+		for iNdEx := len(m.FeeBudget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeBudget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MaxScheduledExecutionsPerBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxScheduledExecutionsPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxNestingDepth != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxNestingDepth))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.SomeValue {
 		i--
 		if m.SomeValue {
@@ -250,9 +781,75 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ProposalSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.ProposalSequence))
+	}
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ScheduleSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.ScheduleSequence))
+	}
+	if len(m.ScheduleFailures) > 0 {
+		for _, e := range m.ScheduleFailures {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeBudgetSpends) > 0 {
+		for _, e := range m.FeeBudgetSpends {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisMultisigAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Details.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisFeeBudgetSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Spend.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -262,6 +859,32 @@ func (m *Params) Size() (n int) {
 	if m.SomeValue {
 		n += 2
 	}
+	if m.MaxNestingDepth != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxNestingDepth))
+	}
+	if m.MaxScheduledExecutionsPerBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxScheduledExecutionsPerBlock))
+	}
+	if len(m.FeeBudget) > 0 {
+		for _, e := range m.FeeBudget {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.FeeBudgetPeriod != 0 {
+		n += 1 + sovGenesis(uint64(m.FeeBudgetPeriod))
+	}
+	if m.DepositForfeitDestination != 0 {
+		n += 1 + sovGenesis(uint64(m.DepositForfeitDestination))
+	}
+	if m.ProposalExpiryBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.ProposalExpiryBlocks))
+	}
+	if m.MaxOpenProposals != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxOpenProposals))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -333,6 +956,214 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, GenesisMultisigAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalSequence", wireType)
+			}
+			m.ProposalSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, Schedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleSequence", wireType)
+			}
+			m.ScheduleSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleFailures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleFailures = append(m.ScheduleFailures, ScheduleFailure{})
+			if err := m.ScheduleFailures[len(m.ScheduleFailures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBudgetSpends", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeBudgetSpends = append(m.FeeBudgetSpends, GenesisFeeBudgetSpend{})
+			if err := m.FeeBudgetSpends[len(m.FeeBudgetSpends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -354,7 +1185,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *GenesisMultisigAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -377,17 +1208,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenesisMultisigAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenesisMultisigAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SomeValue", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -397,12 +1228,423 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SomeValue = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisFeeBudgetSpend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisFeeBudgetSpend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisFeeBudgetSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SomeValue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SomeValue = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNestingDepth", wireType)
+			}
+			m.MaxNestingDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNestingDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScheduledExecutionsPerBlock", wireType)
+			}
+			m.MaxScheduledExecutionsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxScheduledExecutionsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBudget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeBudget = append(m.FeeBudget, types.Coin{})
+			if err := m.FeeBudget[len(m.FeeBudget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBudgetPeriod", wireType)
+			}
+			m.FeeBudgetPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeBudgetPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositForfeitDestination", wireType)
+			}
+			m.DepositForfeitDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositForfeitDestination |= DepositForfeitDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalExpiryBlocks", wireType)
+			}
+			m.ProposalExpiryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalExpiryBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenProposals", wireType)
+			}
+			m.MaxOpenProposals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOpenProposals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,12 +3,19 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/DaevMithran/dmchain/x/multisig/types"
 
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	multisig := sdk.AccAddress([]byte("multisig_account____"))
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -21,9 +28,207 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 		{
 			desc:     "valid genesis state",
-			genState: &types.GenesisState{},
+			genState: &types.GenesisState{Params: types.DefaultParams()},
 			valid:    true,
 		},
+		{
+			desc:     "zero max nesting depth",
+			genState: &types.GenesisState{Params: types.Params{MaxNestingDepth: 0}},
+			valid:    false,
+		},
+		{
+			desc: "unspecified deposit forfeit destination",
+			genState: func() *types.GenesisState {
+				params := types.DefaultParams()
+				params.DepositForfeitDestination = types.DepositForfeitDestination_DEPOSIT_FORFEIT_DESTINATION_UNSPECIFIED
+				return &types.GenesisState{Params: params}
+			}(),
+			valid: false,
+		},
+		{
+			desc: "zero max open proposals",
+			genState: func() *types.GenesisState {
+				params := types.DefaultParams()
+				params.MaxOpenProposals = 0
+				return &types.GenesisState{Params: params}
+			}(),
+			valid: false,
+		},
+		{
+			desc: "zero max scheduled executions per block",
+			genState: func() *types.GenesisState {
+				params := types.DefaultParams()
+				params.MaxScheduledExecutionsPerBlock = 0
+				return &types.GenesisState{Params: params}
+			}(),
+			valid: false,
+		},
+		{
+			desc: "zero fee budget period with a fee budget",
+			genState: func() *types.GenesisState {
+				params := types.DefaultParams()
+				params.FeeBudget = sdk.NewCoins(sdk.NewInt64Coin("uom", 100))
+				params.FeeBudgetPeriod = 0
+				return &types.GenesisState{Params: params}
+			}(),
+			valid: false,
+		},
+		{
+			desc: "zero fee budget period without a fee budget",
+			genState: func() *types.GenesisState {
+				params := types.DefaultParams()
+				params.FeeBudgetPeriod = 0
+				return &types.GenesisState{Params: params}
+			}(),
+			valid: true,
+		},
+		{
+			desc: "invalid deposit",
+			genState: func() *types.GenesisState {
+				params := types.DefaultParams()
+				params.Deposit = sdk.Coin{Denom: "?", Amount: math.NewInt(10)}
+				return &types.GenesisState{Params: params}
+			}(),
+			valid: false,
+		},
+		{
+			desc: "invalid account address",
+			genState: &types.GenesisState{
+				Accounts: []types.GenesisMultisigAccount{
+					{Address: "invalid", Details: types.MultisigAccountDetails{Threshold: 1}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate account",
+			genState: &types.GenesisState{
+				Accounts: []types.GenesisMultisigAccount{
+					{Address: multisig.String(), Details: types.MultisigAccountDetails{Threshold: 1}},
+					{Address: multisig.String(), Details: types.MultisigAccountDetails{Threshold: 1}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero threshold",
+			genState: &types.GenesisState{
+				Accounts: []types.GenesisMultisigAccount{
+					{Address: multisig.String()},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "threshold percentage above one",
+			genState: &types.GenesisState{
+				Accounts: []types.GenesisMultisigAccount{
+					{Address: multisig.String(), Details: types.MultisigAccountDetails{ThresholdPercentage: "1.5"}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "threshold and threshold percentage",
+			genState: &types.GenesisState{
+				Accounts: []types.GenesisMultisigAccount{
+					{Address: multisig.String(), Details: types.MultisigAccountDetails{Threshold: 1, ThresholdPercentage: "0.5"}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid threshold percentage",
+			genState: &types.GenesisState{
+				Accounts: []types.GenesisMultisigAccount{
+					{Address: multisig.String(), Details: types.MultisigAccountDetails{ThresholdPercentage: "0.5"}},
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: true,
+		},
+		{
+			desc: "proposal of unknown account",
+			genState: &types.GenesisState{
+				Proposals:        []types.Proposal{{Id: 1, MultisigAddress: multisig}},
+				ProposalSequence: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "proposal id above sequence",
+			genState: &types.GenesisState{
+				Accounts: []types.GenesisMultisigAccount{
+					{Address: multisig.String(), Details: types.MultisigAccountDetails{Threshold: 1}},
+				},
+				Proposals:        []types.Proposal{{Id: 2, MultisigAddress: multisig}},
+				ProposalSequence: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "schedule id above sequence",
+			genState: &types.GenesisState{
+				Accounts: []types.GenesisMultisigAccount{
+					{Address: multisig.String(), Details: types.MultisigAccountDetails{Threshold: 1}},
+				},
+				Schedules:        []types.Schedule{{Id: 2, MultisigAddress: multisig, Message: &codectypes.Any{}, MaxRuns: 1}},
+				ScheduleSequence: 1,
+				Params:           types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "schedule without remaining runs",
+			genState: &types.GenesisState{
+				Accounts: []types.GenesisMultisigAccount{
+					{Address: multisig.String(), Details: types.MultisigAccountDetails{Threshold: 1}},
+				},
+				Schedules:        []types.Schedule{{Id: 1, MultisigAddress: multisig, Message: &codectypes.Any{}, MaxRuns: 2, Runs: 2}},
+				ScheduleSequence: 1,
+				Params:           types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "valid schedules",
+			genState: &types.GenesisState{
+				Accounts: []types.GenesisMultisigAccount{
+					{Address: multisig.String(), Details: types.MultisigAccountDetails{Threshold: 1}},
+				},
+				Schedules:        []types.Schedule{{Id: 1, MultisigAddress: multisig, Message: &codectypes.Any{}, MaxRuns: 2, Runs: 1}},
+				ScheduleSequence: 1,
+				ScheduleFailures: []types.ScheduleFailure{{ScheduleId: 1, Run: 1}},
+				Params:           types.DefaultParams(),
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid proposal deposit",
+			genState: &types.GenesisState{
+				Accounts: []types.GenesisMultisigAccount{
+					{Address: multisig.String(), Details: types.MultisigAccountDetails{Threshold: 1}},
+				},
+				Proposals:        []types.Proposal{{Id: 1, MultisigAddress: multisig}},
+				ProposalSequence: 1,
+				Params:           types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "valid accounts and proposals",
+			genState: &types.GenesisState{
+				Accounts: []types.GenesisMultisigAccount{
+					{Address: multisig.String(), Details: types.MultisigAccountDetails{Threshold: 1}},
+				},
+				Proposals: []types.Proposal{
+					{Id: 1, MultisigAddress: multisig, Deposit: sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)},
+				},
+				ProposalSequence: 1,
+				Params:           types.DefaultParams(),
+			},
+			valid: true,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
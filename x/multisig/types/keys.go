@@ -9,7 +9,20 @@ import (
 var (
 	// ParamsKey saves the current module params.
 	ParamsKey = collections.NewPrefix(0)
+	// AccountsKey saved the multisig accounts before they were moved to the account table
+	// in consensus version 2.
 	AccountsKey = collections.NewPrefix(1)
+
+	// ScheduleSequenceKey saves the last assigned schedule id.
+	ScheduleSequenceKey = collections.NewPrefix(2)
+	// SchedulesKey saves the pending scheduled calls by id.
+	SchedulesKey = collections.NewPrefix(3)
+	// ScheduleFailuresKey saves the failed executions by schedule id and run.
+	ScheduleFailuresKey = collections.NewPrefix(4)
+	// FeeBudgetSpendsKey saves the fees paid by multisig accounts in their current budget period.
+	FeeBudgetSpendsKey = collections.NewPrefix(5)
+	// ScheduleQueueKey indexes the pending scheduled calls by next height and id.
+	ScheduleQueueKey = collections.NewPrefix(6)
 )
 
 const (
@@ -2,11 +2,17 @@ package types
 
 import (
 	"cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
 	_ sdk.Msg = &MsgUpdateParams{}
+
+	_ codectypes.UnpackInterfacesMessage = &MsgInitializeMultisigProposalParams{}
+	_ codectypes.UnpackInterfacesMessage = &MsgApproveAndDispatchMultisigProposalParams{}
+	_ codectypes.UnpackInterfacesMessage = &MsgBatchApproveMultisigProposalParams{}
+	_ codectypes.UnpackInterfacesMessage = &MsgScheduleMultisigCallParams{}
 )
 
 // NewMsgUpdateParams creates new instance of MsgUpdateParams
@@ -47,3 +53,34 @@ func (msg *MsgUpdateParams) Validate() error {
 
 	return msg.Params.Validate()
 }
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces, which the legacy amino
+// JSON encoding of the call requires.
+func (msg *MsgInitializeMultisigProposalParams) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackCall(unpacker, msg.Message)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces.
+func (msg *MsgApproveAndDispatchMultisigProposalParams) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackCall(unpacker, msg.Message)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces.
+func (msg *MsgBatchApproveMultisigProposalParams) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackCall(unpacker, msg.Message)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces.
+func (msg *MsgScheduleMultisigCallParams) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackCall(unpacker, msg.Message)
+}
+
+// unpackCall unpacks the optional call of a multisig msg.
+func unpackCall(unpacker codectypes.AnyUnpacker, call *codectypes.Any) error {
+	if call == nil {
+		return nil
+	}
+
+	var msg sdk.Msg
+	return unpacker.UnpackAny(call, &msg)
+}
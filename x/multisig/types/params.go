@@ -2,13 +2,45 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// DefaultMaxNestingDepth allows multisig accounts nested up to three levels deep.
+const DefaultMaxNestingDepth = 3
+
+// DefaultMaxScheduledExecutionsPerBlock bounds the EndBlocker work spent on scheduled calls.
+const DefaultMaxScheduledExecutionsPerBlock = 10
+
+// DefaultFeeBudgetPeriod renews the fee budgets about daily with 6 second blocks. The fee
+// budget itself is empty by default, i.e. signers pay their own fees until governance sets it.
+const DefaultFeeBudgetPeriod = 14400
+
+// DefaultProposalExpiryBlocks expires open proposals after about a week with 6 second blocks.
+const DefaultProposalExpiryBlocks = 100800
+
+// DefaultMaxOpenProposals bounds the proposals a multisig account can be spammed with.
+const DefaultMaxOpenProposals = 50
+
+// DefaultDepositAmount is the amount of the bond denom held in reserve of a proposer.
+const DefaultDepositAmount = 10
+
 // DefaultParams returns default module parameters.
 func DefaultParams() Params {
 	// TODO:
 	return Params{
-		SomeValue: true,
+		SomeValue:       true,
+		MaxNestingDepth: DefaultMaxNestingDepth,
+
+		MaxScheduledExecutionsPerBlock: DefaultMaxScheduledExecutionsPerBlock,
+
+		FeeBudgetPeriod: DefaultFeeBudgetPeriod,
+
+		DepositForfeitDestination: DepositForfeitDestination_DEPOSIT_FORFEIT_DESTINATION_BURN,
+		ProposalExpiryBlocks:      DefaultProposalExpiryBlocks,
+		MaxOpenProposals:          DefaultMaxOpenProposals,
+		Deposit:                   sdk.NewInt64Coin(sdk.DefaultBondDenom, DefaultDepositAmount),
 	}
 }
 
@@ -24,6 +56,33 @@ func (p Params) String() string {
 
 // Validate does the sanity check on the params.
 func (p Params) Validate() error {
-	// TODO:
+	if p.MaxNestingDepth < 1 {
+		return fmt.Errorf("max nesting depth must be at least 1: %d", p.MaxNestingDepth)
+	}
+
+	if p.MaxScheduledExecutionsPerBlock < 1 {
+		return fmt.Errorf("max scheduled executions per block must be at least 1: %d", p.MaxScheduledExecutionsPerBlock)
+	}
+
+	if err := p.FeeBudget.Validate(); err != nil {
+		return fmt.Errorf("invalid fee budget: %w", err)
+	}
+
+	if !p.FeeBudget.IsZero() && p.FeeBudgetPeriod < 1 {
+		return fmt.Errorf("fee budget period must be at least 1 with a fee budget: %d", p.FeeBudgetPeriod)
+	}
+
+	if _, ok := DepositForfeitDestination_name[int32(p.DepositForfeitDestination)]; !ok || p.DepositForfeitDestination == DepositForfeitDestination_DEPOSIT_FORFEIT_DESTINATION_UNSPECIFIED {
+		return fmt.Errorf("invalid deposit forfeit destination: %s", p.DepositForfeitDestination)
+	}
+
+	if p.MaxOpenProposals < 1 {
+		return fmt.Errorf("max open proposals must be at least 1: %d", p.MaxOpenProposals)
+	}
+
+	if err := p.Deposit.Validate(); err != nil {
+		return fmt.Errorf("invalid deposit: %w", err)
+	}
+
 	return nil
 }
@@ -0,0 +1,922 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: multisig/v1/schedule.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Schedule is an approved call of a multisig account, executed by the EndBlocker once or
+// every interval_blocks blocks.
+type Schedule struct {
+	// Unique identifier for the schedule
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The multisig account executing the call
+	MultisigAddress []byte `protobuf:"bytes,2,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	// The call to be executed
+	Message *types.Any `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// The height of the next execution
+	NextHeight int64 `protobuf:"varint,4,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+	// The earliest block time of the first execution, if any
+	StartTime *time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// The number of blocks between executions, zero for a single execution
+	IntervalBlocks uint64 `protobuf:"varint,6,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
+	// The maximum number of executions
+	MaxRuns uint64 `protobuf:"varint,7,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`
+	// The number of executions so far, including the failed ones
+	Runs uint64 `protobuf:"varint,8,opt,name=runs,proto3" json:"runs,omitempty"`
+}
+
+func (m *Schedule) Reset()         { *m = Schedule{} }
+func (m *Schedule) String() string { return proto.CompactTextString(m) }
+func (*Schedule) ProtoMessage()    {}
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cd9dfc098cc03be, []int{0}
+}
+func (m *Schedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Schedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schedule.Merge(m, src)
+}
+func (m *Schedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schedule proto.InternalMessageInfo
+
+func (m *Schedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Schedule) GetMultisigAddress() []byte {
+	if m != nil {
+		return m.MultisigAddress
+	}
+	return nil
+}
+
+func (m *Schedule) GetMessage() *types.Any {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *Schedule) GetNextHeight() int64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
+func (m *Schedule) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *Schedule) GetIntervalBlocks() uint64 {
+	if m != nil {
+		return m.IntervalBlocks
+	}
+	return 0
+}
+
+func (m *Schedule) GetMaxRuns() uint64 {
+	if m != nil {
+		return m.MaxRuns
+	}
+	return 0
+}
+
+func (m *Schedule) GetRuns() uint64 {
+	if m != nil {
+		return m.Runs
+	}
+	return 0
+}
+
+// ScheduleFailure records a failed execution of a schedule.
+type ScheduleFailure struct {
+	ScheduleId uint64 `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// The execution of the schedule, starting at 1
+	Run    uint64 `protobuf:"varint,2,opt,name=run,proto3" json:"run,omitempty"`
+	Height int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Error  string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ScheduleFailure) Reset()         { *m = ScheduleFailure{} }
+func (m *ScheduleFailure) String() string { return proto.CompactTextString(m) }
+func (*ScheduleFailure) ProtoMessage()    {}
+func (*ScheduleFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cd9dfc098cc03be, []int{1}
+}
+func (m *ScheduleFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleFailure.Merge(m, src)
+}
+func (m *ScheduleFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleFailure proto.InternalMessageInfo
+
+func (m *ScheduleFailure) GetScheduleId() uint64 {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return 0
+}
+
+func (m *ScheduleFailure) GetRun() uint64 {
+	if m != nil {
+		return m.Run
+	}
+	return 0
+}
+
+func (m *ScheduleFailure) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ScheduleFailure) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Schedule)(nil), "multisig.v1.Schedule")
+	proto.RegisterType((*ScheduleFailure)(nil), "multisig.v1.ScheduleFailure")
+}
+
+func init() { proto.RegisterFile("multisig/v1/schedule.proto", fileDescriptor_2cd9dfc098cc03be) }
+
+var fileDescriptor_2cd9dfc098cc03be = []byte{
+	// 454 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x41, 0x8e, 0xd3, 0x30,
+	0x14, 0x86, 0x9b, 0x36, 0xd3, 0x76, 0x5c, 0x34, 0x1d, 0x59, 0x15, 0x64, 0x8a, 0x94, 0x56, 0xb3,
+	0xa1, 0x2c, 0x88, 0x55, 0x38, 0x00, 0x9a, 0x0a, 0x21, 0x46, 0x68, 0x36, 0x81, 0x15, 0x9b, 0xc8,
+	0x49, 0x8c, 0x63, 0x11, 0xdb, 0x95, 0xed, 0x44, 0xed, 0x2d, 0xe6, 0x30, 0x1c, 0x02, 0x21, 0x16,
+	0xb3, 0x64, 0x07, 0x6a, 0x2f, 0x82, 0xe2, 0xc4, 0x5d, 0x30, 0xbb, 0xf7, 0xbe, 0x67, 0xfb, 0xfd,
+	0xff, 0x9f, 0x80, 0x39, 0xaf, 0x4a, 0xc3, 0x34, 0xa3, 0xa8, 0x5e, 0x23, 0x9d, 0x15, 0x24, 0xaf,
+	0x4a, 0x12, 0x6d, 0x95, 0x34, 0x12, 0x4e, 0xdc, 0x2c, 0xaa, 0xd7, 0xf3, 0x19, 0x95, 0x54, 0x5a,
+	0x8e, 0x9a, 0xaa, 0x3d, 0x32, 0xbf, 0xa2, 0x52, 0xd2, 0x92, 0x20, 0xdb, 0xa5, 0xd5, 0x57, 0x84,
+	0xc5, 0xbe, 0x1b, 0x2d, 0xfe, 0x1f, 0x19, 0xc6, 0x89, 0x36, 0x98, 0x6f, 0xdd, 0xdd, 0x4c, 0x6a,
+	0x2e, 0x75, 0xd2, 0x3e, 0xda, 0x36, 0xed, 0xe8, 0xfa, 0x57, 0x1f, 0x8c, 0x3f, 0x75, 0x62, 0xe0,
+	0x05, 0xe8, 0xb3, 0x3c, 0xf0, 0x96, 0xde, 0xca, 0x8f, 0xfb, 0x2c, 0x87, 0x2f, 0xc1, 0xa5, 0x13,
+	0x96, 0xe0, 0x3c, 0x57, 0x44, 0xeb, 0xa0, 0xbf, 0xf4, 0x56, 0x4f, 0xe2, 0xa9, 0xe3, 0x37, 0x2d,
+	0x86, 0x1f, 0xc1, 0x88, 0x13, 0xad, 0x31, 0x25, 0xc1, 0x60, 0xe9, 0xad, 0x26, 0xaf, 0x67, 0x51,
+	0xab, 0x2a, 0x72, 0xaa, 0xa2, 0x1b, 0xb1, 0xdf, 0x3c, 0xff, 0xf9, 0xfd, 0xd5, 0xb3, 0x4e, 0x40,
+	0x8a, 0x35, 0x89, 0xea, 0x75, 0x4a, 0x0c, 0x5e, 0x47, 0x77, 0x9a, 0xc6, 0xee, 0x05, 0xb8, 0x00,
+	0x13, 0x41, 0x76, 0x26, 0x29, 0x08, 0xa3, 0x85, 0x09, 0xfc, 0xa5, 0xb7, 0x1a, 0xc4, 0xa0, 0x41,
+	0x1f, 0x2c, 0x81, 0x6f, 0x01, 0xd0, 0x06, 0x2b, 0x93, 0x34, 0x4e, 0x83, 0x33, 0xbb, 0x70, 0xfe,
+	0x68, 0xe1, 0x67, 0x17, 0xc3, 0xc6, 0xbf, 0xff, 0xb3, 0xf0, 0xe2, 0x73, 0x7b, 0xa7, 0xa1, 0xf0,
+	0x05, 0x98, 0x32, 0x61, 0x88, 0xaa, 0x71, 0x99, 0xa4, 0xa5, 0xcc, 0xbe, 0xe9, 0x60, 0x68, 0x6d,
+	0x5f, 0x38, 0xbc, 0xb1, 0x14, 0x5e, 0x81, 0x31, 0xc7, 0xbb, 0x44, 0x55, 0x42, 0x07, 0x23, 0x7b,
+	0x62, 0xc4, 0xf1, 0x2e, 0xae, 0x84, 0x86, 0x10, 0xf8, 0x16, 0x8f, 0x2d, 0xb6, 0xf5, 0xb5, 0x02,
+	0x53, 0x97, 0xe6, 0x7b, 0xcc, 0xca, 0x4a, 0x59, 0x33, 0xee, 0x6b, 0x27, 0xa7, 0x74, 0x81, 0x43,
+	0xb7, 0x39, 0xbc, 0x04, 0x03, 0x55, 0x09, 0x1b, 0xac, 0x1f, 0x37, 0x25, 0x7c, 0x0a, 0x86, 0x9d,
+	0xf5, 0x81, 0xb5, 0xde, 0x75, 0x70, 0x06, 0xce, 0x88, 0x52, 0x52, 0xd9, 0x44, 0xce, 0xe3, 0xb6,
+	0xd9, 0xdc, 0xfe, 0x38, 0x84, 0xde, 0xc3, 0x21, 0xf4, 0xfe, 0x1e, 0x42, 0xef, 0xfe, 0x18, 0xf6,
+	0x1e, 0x8e, 0x61, 0xef, 0xf7, 0x31, 0xec, 0x7d, 0x41, 0x94, 0x99, 0xa2, 0x4a, 0xa3, 0x4c, 0x72,
+	0xf4, 0x0e, 0x93, 0xfa, 0x8e, 0x99, 0x42, 0x61, 0x81, 0x72, 0x9e, 0x15, 0x98, 0x09, 0xb4, 0x43,
+	0xa7, 0x7f, 0xd2, 0xec, 0xb7, 0x44, 0xa7, 0x43, 0x9b, 0xdd, 0x9b, 0x7f, 0x03, 0x00, 0x92, 0x39,
+	0x63, 0xc3, 0xac, 0x02, 0x00, 0x00,
+}
+
+func (m *Schedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Runs != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Runs))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxRuns != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.MaxRuns))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.IntervalBlocks != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.IntervalBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintSchedule(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MultisigAddress) > 0 {
+		i -= len(m.MultisigAddress)
+		copy(dAtA[i:], m.MultisigAddress)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.MultisigAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Run != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Run))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ScheduleId != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSchedule(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSchedule(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Schedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSchedule(uint64(m.Id))
+	}
+	l = len(m.MultisigAddress)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovSchedule(uint64(m.NextHeight))
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.IntervalBlocks != 0 {
+		n += 1 + sovSchedule(uint64(m.IntervalBlocks))
+	}
+	if m.MaxRuns != 0 {
+		n += 1 + sovSchedule(uint64(m.MaxRuns))
+	}
+	if m.Runs != 0 {
+		n += 1 + sovSchedule(uint64(m.Runs))
+	}
+	return n
+}
+
+func (m *ScheduleFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduleId != 0 {
+		n += 1 + sovSchedule(uint64(m.ScheduleId))
+	}
+	if m.Run != 0 {
+		n += 1 + sovSchedule(uint64(m.Run))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSchedule(uint64(m.Height))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	return n
+}
+
+func sovSchedule(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSchedule(x uint64) (n int) {
+	return sovSchedule(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Schedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Schedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Schedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultisigAddress = append(m.MultisigAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.MultisigAddress == nil {
+				m.MultisigAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &types.Any{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalBlocks", wireType)
+			}
+			m.IntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRuns", wireType)
+			}
+			m.MaxRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRuns |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
+			}
+			m.Runs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Runs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Run", wireType)
+			}
+			m.Run = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Run |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSchedule(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSchedule
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSchedule
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSchedule
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSchedule        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSchedule          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSchedule = fmt.Errorf("proto: unexpected end of group")
+)
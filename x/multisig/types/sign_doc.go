@@ -0,0 +1,19 @@
+package types
+
+// ApprovalSignBytes returns the canonical bytes signed off-chain by a signer to approve
+// the proposal of a multisig account, see MsgBatchApproveMultisigProposalParams.
+func ApprovalSignBytes(chainID, multisigAddress string, proposalID uint64, callHash []byte) []byte {
+	doc := ApprovalSignDoc{
+		ChainId:         chainID,
+		MultisigAddress: multisigAddress,
+		ProposalId:      proposalID,
+		CallHash:        callHash,
+	}
+
+	bz, err := doc.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
@@ -6,6 +6,10 @@ package types
 import (
 	_ "cosmossdk.io/orm"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -55,7 +59,39 @@ func (MultisigProposalType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_a87be96daf13cd0b, []int{0}
 }
 
-// Details of a multisig account - using Collections approach
+// Where the deposits of rejected and expired proposals go
+type DepositForfeitDestination int32
+
+const (
+	// Invalid destination
+	DepositForfeitDestination_DEPOSIT_FORFEIT_DESTINATION_UNSPECIFIED DepositForfeitDestination = 0
+	// Burn forfeited deposits
+	DepositForfeitDestination_DEPOSIT_FORFEIT_DESTINATION_BURN DepositForfeitDestination = 1
+	// Send forfeited deposits to the community pool
+	DepositForfeitDestination_DEPOSIT_FORFEIT_DESTINATION_COMMUNITY_POOL DepositForfeitDestination = 2
+)
+
+var DepositForfeitDestination_name = map[int32]string{
+	0: "DEPOSIT_FORFEIT_DESTINATION_UNSPECIFIED",
+	1: "DEPOSIT_FORFEIT_DESTINATION_BURN",
+	2: "DEPOSIT_FORFEIT_DESTINATION_COMMUNITY_POOL",
+}
+
+var DepositForfeitDestination_value = map[string]int32{
+	"DEPOSIT_FORFEIT_DESTINATION_UNSPECIFIED":    0,
+	"DEPOSIT_FORFEIT_DESTINATION_BURN":           1,
+	"DEPOSIT_FORFEIT_DESTINATION_COMMUNITY_POOL": 2,
+}
+
+func (x DepositForfeitDestination) String() string {
+	return proto.EnumName(DepositForfeitDestination_name, int32(x))
+}
+
+func (DepositForfeitDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a87be96daf13cd0b, []int{1}
+}
+
+// Details of a multisig account
 type MultisigAccountDetails struct {
 	// List of signers for this multisig account
 	Signers [][]byte `protobuf:"bytes,1,rep,name=signers,proto3" json:"signers,omitempty"`
@@ -63,6 +99,14 @@ type MultisigAccountDetails struct {
 	Threshold uint32 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
 	// Permission type for this multisig account
 	Permission MultisigProposalType `protobuf:"varint,3,opt,name=permission,proto3,enum=multisig.v1.MultisigProposalType" json:"permission,omitempty"`
+	// Frozen accounts can not open, approve or dispatch proposals until recovered by governance.
+	Frozen bool `protobuf:"varint,4,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// The account which created the multisig account, empty for accounts created before the
+	// accounts were moved to the account table.
+	Creator []byte `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	// The fraction of the signers required to approve a call, e.g. "0.5", evaluated at approval
+	// time. When set it replaces the absolute threshold.
+	ThresholdPercentage string `protobuf:"bytes,6,opt,name=threshold_percentage,json=thresholdPercentage,proto3" json:"threshold_percentage,omitempty"`
 }
 
 func (m *MultisigAccountDetails) Reset()         { *m = MultisigAccountDetails{} }
@@ -119,6 +163,128 @@ func (m *MultisigAccountDetails) GetPermission() MultisigProposalType {
 	return MultisigProposalType_MULTISIG_PROPOSAL_TYPE_UNSPECIFIED
 }
 
+func (m *MultisigAccountDetails) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+func (m *MultisigAccountDetails) GetCreator() []byte {
+	if m != nil {
+		return m.Creator
+	}
+	return nil
+}
+
+func (m *MultisigAccountDetails) GetThresholdPercentage() string {
+	if m != nil {
+		return m.ThresholdPercentage
+	}
+	return ""
+}
+
+// A multisig account. The primary key is the address derived from the seed the account
+// was created with, so that a seed creates at most one account.
+type MultisigAccount struct {
+	// The address of the multisig account
+	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The account which created the multisig account
+	Creator []byte `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// List of signers for this multisig account
+	Signers [][]byte `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+	// The threshold of approvers required for the multisig account to be able to execute a call.
+	Threshold uint32 `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Permission type for this multisig account
+	Permission MultisigProposalType `protobuf:"varint,5,opt,name=permission,proto3,enum=multisig.v1.MultisigProposalType" json:"permission,omitempty"`
+	// Frozen accounts can not open, approve or dispatch proposals until recovered by governance.
+	Frozen bool `protobuf:"varint,6,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// The fraction of the signers required to approve a call, replacing threshold when set.
+	ThresholdPercentage string `protobuf:"bytes,7,opt,name=threshold_percentage,json=thresholdPercentage,proto3" json:"threshold_percentage,omitempty"`
+}
+
+func (m *MultisigAccount) Reset()         { *m = MultisigAccount{} }
+func (m *MultisigAccount) String() string { return proto.CompactTextString(m) }
+func (*MultisigAccount) ProtoMessage()    {}
+func (*MultisigAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a87be96daf13cd0b, []int{1}
+}
+func (m *MultisigAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultisigAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultisigAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultisigAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultisigAccount.Merge(m, src)
+}
+func (m *MultisigAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultisigAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultisigAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultisigAccount proto.InternalMessageInfo
+
+func (m *MultisigAccount) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *MultisigAccount) GetCreator() []byte {
+	if m != nil {
+		return m.Creator
+	}
+	return nil
+}
+
+func (m *MultisigAccount) GetSigners() [][]byte {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *MultisigAccount) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *MultisigAccount) GetPermission() MultisigProposalType {
+	if m != nil {
+		return m.Permission
+	}
+	return MultisigProposalType_MULTISIG_PROPOSAL_TYPE_UNSPECIFIED
+}
+
+func (m *MultisigAccount) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+func (m *MultisigAccount) GetThresholdPercentage() string {
+	if m != nil {
+		return m.ThresholdPercentage
+	}
+	return ""
+}
+
 // An open multisig operation.
 type Proposal struct {
 	// Unique identifier for the proposal
@@ -129,17 +295,26 @@ type Proposal struct {
 	CallHash []byte `protobuf:"bytes,3,opt,name=call_hash,json=callHash,proto3" json:"call_hash,omitempty"`
 	// The account who opened it (i.e. the first to approve it).
 	Depositor []byte `protobuf:"bytes,4,opt,name=depositor,proto3" json:"depositor,omitempty"`
-	// The amount held in reserve of the `depositor`, to be returned once the operation ends.
-	Deposit uint64 `protobuf:"varint,5,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	// The amount of uom held in reserve of the `depositor` by proposals opened before consensus
+	// version 2. It is moved to `deposit` by the v1 to v2 migration.
+	LegacyDeposit uint64 `protobuf:"varint,5,opt,name=legacy_deposit,json=legacyDeposit,proto3" json:"legacy_deposit,omitempty"` // Deprecated: Do not use.
 	// The approvals achieved so far, including the depositor.
 	Approvals [][]byte `protobuf:"bytes,6,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// The height at the end of which the proposal expires and its deposit is forfeited, zero
+	// when the proposal does not expire.
+	ExpiryHeight int64 `protobuf:"varint,7,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// The signers who voted against the proposal. The proposal is rejected and its deposit
+	// forfeited once the rejections reach the threshold of the multisig account.
+	Rejections [][]byte `protobuf:"bytes,8,rep,name=rejections,proto3" json:"rejections,omitempty"`
+	// The deposit held in reserve of the `depositor`, to be returned once the operation ends.
+	Deposit types.Coin `protobuf:"bytes,9,opt,name=deposit,proto3" json:"deposit"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a87be96daf13cd0b, []int{1}
+	return fileDescriptor_a87be96daf13cd0b, []int{2}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,9 +371,10 @@ func (m *Proposal) GetDepositor() []byte {
 	return nil
 }
 
-func (m *Proposal) GetDeposit() uint64 {
+// Deprecated: Do not use.
+func (m *Proposal) GetLegacyDeposit() uint64 {
 	if m != nil {
-		return m.Deposit
+		return m.LegacyDeposit
 	}
 	return 0
 }
@@ -210,46 +386,150 @@ func (m *Proposal) GetApprovals() [][]byte {
 	return nil
 }
 
+func (m *Proposal) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *Proposal) GetRejections() [][]byte {
+	if m != nil {
+		return m.Rejections
+	}
+	return nil
+}
+
+func (m *Proposal) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
+// The fees paid by a multisig account for the txs of its signers in a budget period.
+type FeeBudgetSpend struct {
+	// The budget period, i.e. the block height divided by the fee budget period
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// The fees paid in the period
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *FeeBudgetSpend) Reset()         { *m = FeeBudgetSpend{} }
+func (m *FeeBudgetSpend) String() string { return proto.CompactTextString(m) }
+func (*FeeBudgetSpend) ProtoMessage()    {}
+func (*FeeBudgetSpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a87be96daf13cd0b, []int{3}
+}
+func (m *FeeBudgetSpend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeBudgetSpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeBudgetSpend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeBudgetSpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeBudgetSpend.Merge(m, src)
+}
+func (m *FeeBudgetSpend) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeBudgetSpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeBudgetSpend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeBudgetSpend proto.InternalMessageInfo
+
+func (m *FeeBudgetSpend) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *FeeBudgetSpend) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("multisig.v1.MultisigProposalType", MultisigProposalType_name, MultisigProposalType_value)
+	proto.RegisterEnum("multisig.v1.DepositForfeitDestination", DepositForfeitDestination_name, DepositForfeitDestination_value)
 	proto.RegisterType((*MultisigAccountDetails)(nil), "multisig.v1.MultisigAccountDetails")
+	proto.RegisterType((*MultisigAccount)(nil), "multisig.v1.MultisigAccount")
 	proto.RegisterType((*Proposal)(nil), "multisig.v1.Proposal")
+	proto.RegisterType((*FeeBudgetSpend)(nil), "multisig.v1.FeeBudgetSpend")
 }
 
 func init() { proto.RegisterFile("multisig/v1/state.proto", fileDescriptor_a87be96daf13cd0b) }
 
 var fileDescriptor_a87be96daf13cd0b = []byte{
-	// 475 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x6b, 0xd4, 0x40,
-	0x18, 0xc6, 0x77, 0x76, 0xeb, 0xda, 0x8e, 0xdb, 0x1a, 0x06, 0xb1, 0x43, 0x95, 0xb0, 0x2e, 0x52,
-	0x62, 0x91, 0x84, 0xea, 0x4d, 0x4f, 0xb1, 0x9b, 0x6a, 0x60, 0xff, 0x84, 0x6c, 0x0a, 0xd6, 0x4b,
-	0x98, 0x26, 0xc3, 0x66, 0x20, 0xc9, 0x84, 0x99, 0xd9, 0xc5, 0x7e, 0x09, 0x11, 0xf4, 0xec, 0xe7,
-	0xf1, 0x58, 0xf0, 0xe2, 0x51, 0x76, 0xbf, 0x81, 0x57, 0x2f, 0x92, 0xb4, 0xd9, 0x5d, 0xc4, 0x1e,
-	0xdf, 0xe7, 0x7d, 0x9e, 0x99, 0xdf, 0xfb, 0xf2, 0xc2, 0xfd, 0x6c, 0x96, 0x2a, 0x26, 0xd9, 0xd4,
-	0x9a, 0x1f, 0x5b, 0x52, 0x11, 0x45, 0xcd, 0x42, 0x70, 0xc5, 0xd1, 0xbd, 0xba, 0x61, 0xce, 0x8f,
-	0x0f, 0xf6, 0x23, 0x2e, 0x33, 0x2e, 0x2d, 0x2e, 0xb2, 0xd2, 0xc7, 0x45, 0x76, 0xed, 0xea, 0x7d,
-	0x01, 0xf0, 0xe1, 0xf0, 0xc6, 0x68, 0x47, 0x11, 0x9f, 0xe5, 0xaa, 0x4f, 0x15, 0x61, 0xa9, 0x44,
-	0x18, 0xde, 0x95, 0x6c, 0x9a, 0x53, 0x21, 0x31, 0xe8, 0xb6, 0x8c, 0x8e, 0x5f, 0x97, 0xe8, 0x31,
-	0xdc, 0x51, 0x89, 0xa0, 0x32, 0xe1, 0x69, 0x8c, 0x9b, 0x5d, 0x60, 0xec, 0xfa, 0x6b, 0x01, 0xd9,
-	0x10, 0x16, 0x54, 0x64, 0x4c, 0x4a, 0xc6, 0x73, 0xdc, 0xea, 0x02, 0x63, 0xef, 0xc5, 0x13, 0x73,
-	0x83, 0xc6, 0xac, 0x3f, 0xf4, 0x04, 0x2f, 0xb8, 0x24, 0x69, 0x70, 0x59, 0x50, 0x7f, 0x23, 0xd4,
-	0xfb, 0x03, 0xe0, 0x76, 0xdd, 0x44, 0x7b, 0xb0, 0xc9, 0x62, 0x0c, 0xba, 0xc0, 0xd8, 0xf2, 0x9b,
-	0x2c, 0x46, 0xcf, 0xa0, 0x56, 0x3f, 0x16, 0x92, 0x38, 0x16, 0x54, 0xca, 0x0a, 0xa2, 0xe3, 0xdf,
-	0xaf, 0x75, 0xfb, 0x5a, 0x46, 0x8f, 0xe0, 0x4e, 0x44, 0xd2, 0x34, 0x4c, 0x88, 0x4c, 0x2a, 0x92,
-	0x8e, 0xbf, 0x5d, 0x0a, 0xef, 0x88, 0x4c, 0xca, 0x29, 0x62, 0x5a, 0x70, 0xc9, 0x14, 0x17, 0x78,
-	0xab, 0x6a, 0xae, 0x85, 0x72, 0xfa, 0x9b, 0x02, 0xdf, 0xa9, 0xbe, 0xae, 0xcb, 0x32, 0x47, 0x8a,
-	0x42, 0xf0, 0x39, 0x49, 0x25, 0x6e, 0x57, 0x9b, 0x59, 0x0b, 0xaf, 0xec, 0xdf, 0xdf, 0x7e, 0x7c,
-	0x6a, 0xbd, 0x86, 0xed, 0x92, 0x5a, 0x03, 0xa8, 0x0b, 0x0f, 0xfe, 0xa5, 0x7d, 0xbe, 0x62, 0xd2,
-	0x00, 0x06, 0x68, 0x77, 0x83, 0x43, 0x6b, 0x62, 0x70, 0xf4, 0x15, 0xc0, 0x07, 0xff, 0x5b, 0x11,
-	0x3a, 0x84, 0xbd, 0xe1, 0xd9, 0x20, 0x70, 0x27, 0xee, 0xdb, 0xd0, 0xf3, 0xc7, 0xde, 0x78, 0x62,
-	0x0f, 0xc2, 0xe0, 0xdc, 0x73, 0xc2, 0xb3, 0xd1, 0xc4, 0x73, 0x4e, 0xdc, 0x53, 0xd7, 0xe9, 0x6b,
-	0x0d, 0x64, 0xc0, 0xa7, 0xb7, 0xf8, 0x02, 0xdf, 0x1e, 0x4d, 0x4e, 0x1d, 0x3f, 0x1c, 0x8f, 0x06,
-	0xe7, 0x1a, 0x40, 0x47, 0xf0, 0xf0, 0x16, 0xa7, 0xf3, 0xfe, 0xc4, 0xf1, 0x82, 0x55, 0x40, 0x6b,
-	0xbe, 0x71, 0xbf, 0x2f, 0x74, 0x70, 0xb5, 0xd0, 0xc1, 0xaf, 0x85, 0x0e, 0x3e, 0x2f, 0xf5, 0xc6,
-	0xd5, 0x52, 0x6f, 0xfc, 0x5c, 0xea, 0x8d, 0x0f, 0xd6, 0x94, 0xa9, 0x64, 0x76, 0x61, 0x46, 0x3c,
-	0xb3, 0xfa, 0x84, 0xce, 0x87, 0x4c, 0x25, 0x82, 0xe4, 0x56, 0x9c, 0x45, 0x09, 0x61, 0xb9, 0xf5,
-	0xd1, 0x5a, 0x1d, 0xa9, 0xba, 0x2c, 0xa8, 0xbc, 0x68, 0x57, 0xc7, 0xf7, 0xf2, 0x6f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x6d, 0x67, 0x68, 0x57, 0xbd, 0x02, 0x00, 0x00,
+	// 878 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x4f, 0x6f, 0xe3, 0x44,
+	0x18, 0xc6, 0x33, 0x4e, 0x9a, 0x36, 0xd3, 0x36, 0x6b, 0x86, 0x6a, 0xd7, 0x2d, 0x28, 0x6b, 0xc2,
+	0xaa, 0x64, 0xcb, 0x6e, 0x4c, 0x97, 0x13, 0x7b, 0x4b, 0x9a, 0x84, 0xb5, 0xd4, 0x24, 0x96, 0xe3,
+	0x4a, 0x94, 0x8b, 0x35, 0xb5, 0x67, 0xed, 0x81, 0xc4, 0x63, 0xcd, 0x4c, 0xa3, 0x2d, 0x9f, 0x00,
+	0x09, 0x09, 0x71, 0xe0, 0xbc, 0xdc, 0x39, 0xf3, 0x21, 0x96, 0xdb, 0x0a, 0x2e, 0x9c, 0x00, 0xb5,
+	0xdf, 0x80, 0x4f, 0x80, 0xfc, 0x2f, 0xcd, 0x56, 0x34, 0x42, 0xda, 0x53, 0xfc, 0x3e, 0xef, 0x3b,
+	0xf3, 0x3e, 0xf3, 0x7b, 0xc7, 0x31, 0xbc, 0x37, 0x3b, 0x9f, 0x4a, 0x2a, 0x68, 0x60, 0xcc, 0x0f,
+	0x0d, 0x21, 0xb1, 0x24, 0xed, 0x98, 0x33, 0xc9, 0xd0, 0x66, 0x91, 0x68, 0xcf, 0x0f, 0xf7, 0xee,
+	0x79, 0x4c, 0xcc, 0x98, 0x30, 0x18, 0x9f, 0x25, 0x75, 0x8c, 0xcf, 0xb2, 0xaa, 0xbd, 0x46, 0x9e,
+	0x38, 0xc3, 0x82, 0x18, 0xf3, 0xc3, 0x33, 0x22, 0xf1, 0xa1, 0xe1, 0x31, 0x1a, 0xe5, 0xf9, 0x9d,
+	0x80, 0x05, 0x2c, 0x7d, 0x34, 0x92, 0xa7, 0x5c, 0xdd, 0xcd, 0x56, 0xb9, 0x59, 0x22, 0x0b, 0xb2,
+	0x54, 0xf3, 0x5b, 0x05, 0xde, 0x1d, 0xe6, 0x9d, 0x3b, 0x9e, 0xc7, 0xce, 0x23, 0xd9, 0x23, 0x12,
+	0xd3, 0xa9, 0x40, 0x1a, 0x5c, 0x17, 0x34, 0x88, 0x08, 0x17, 0x1a, 0xd0, 0xcb, 0xad, 0x2d, 0xbb,
+	0x08, 0xd1, 0xfb, 0xb0, 0x26, 0x43, 0x4e, 0x44, 0xc8, 0xa6, 0xbe, 0xa6, 0xe8, 0xa0, 0xb5, 0x6d,
+	0x5f, 0x0b, 0xa8, 0x03, 0x61, 0x4c, 0xf8, 0x8c, 0x0a, 0x41, 0x59, 0xa4, 0x95, 0x75, 0xd0, 0xaa,
+	0x3f, 0xf9, 0xa0, 0xbd, 0x74, 0xbc, 0x76, 0xd1, 0xd0, 0xe2, 0x2c, 0x66, 0x02, 0x4f, 0x9d, 0x8b,
+	0x98, 0xd8, 0x4b, 0x8b, 0xd0, 0x5d, 0x58, 0x7d, 0xce, 0xd9, 0x37, 0x24, 0xd2, 0x2a, 0x3a, 0x68,
+	0x6d, 0xd8, 0x79, 0x94, 0x58, 0xf2, 0x38, 0xc1, 0x92, 0x71, 0x6d, 0x4d, 0x07, 0x89, 0xa5, 0x3c,
+	0x44, 0x1d, 0xb8, 0xb3, 0x70, 0xe0, 0xc6, 0x84, 0x7b, 0x24, 0x92, 0x38, 0x20, 0x5a, 0x55, 0x07,
+	0xad, 0x5a, 0xb7, 0xfe, 0xdb, 0x2f, 0x8f, 0x61, 0x7e, 0xee, 0x1e, 0xf1, 0xec, 0x77, 0x17, 0xb5,
+	0xd6, 0xa2, 0xb4, 0xf9, 0xab, 0x02, 0xef, 0xdc, 0x40, 0x91, 0x34, 0xc4, 0xbe, 0xcf, 0x89, 0x48,
+	0x18, 0xa4, 0x0d, 0xf3, 0x70, 0xd9, 0x8a, 0xf2, 0xa6, 0x95, 0x25, 0x6e, 0xe5, 0x15, 0xdc, 0x2a,
+	0xab, 0xb9, 0xad, 0xbd, 0x1d, 0xb7, 0xea, 0x1b, 0xdc, 0x6e, 0xa3, 0xb3, 0xfe, 0xbf, 0xe9, 0x3c,
+	0x7d, 0xf4, 0xcf, 0xcb, 0xdf, 0xbf, 0x2f, 0xef, 0xc3, 0xda, 0x82, 0x08, 0xda, 0x5c, 0x20, 0x50,
+	0x01, 0x82, 0x45, 0x6b, 0x55, 0xd1, 0x94, 0xe6, 0x4f, 0x65, 0xb8, 0x51, 0xb8, 0x44, 0x75, 0xa8,
+	0x50, 0x3f, 0xe5, 0x57, 0xb1, 0x15, 0xea, 0xa3, 0x87, 0x50, 0x2d, 0x4e, 0xe5, 0x16, 0x74, 0x33,
+	0x86, 0x77, 0x0a, 0xbd, 0x93, 0xb7, 0x78, 0x0f, 0xd6, 0x3c, 0x3c, 0x9d, 0xba, 0x21, 0x16, 0x61,
+	0x7a, 0x95, 0xb6, 0xec, 0x8d, 0x44, 0x78, 0x86, 0x45, 0x98, 0xe0, 0xf4, 0x49, 0xcc, 0x04, 0x4d,
+	0x86, 0x50, 0x49, 0x93, 0xd7, 0x02, 0x7a, 0x08, 0xeb, 0x53, 0x12, 0x60, 0xef, 0xc2, 0xcd, 0xb5,
+	0x14, 0x69, 0xa5, 0xab, 0x68, 0xc0, 0xde, 0xce, 0x32, 0xbd, 0x2c, 0x91, 0x6c, 0x84, 0xe3, 0x98,
+	0xb3, 0x39, 0x9e, 0x0a, 0xad, 0x9a, 0xce, 0xec, 0x5a, 0x40, 0x1f, 0xc2, 0x6d, 0xf2, 0x22, 0xa6,
+	0xfc, 0xc2, 0x0d, 0x09, 0x0d, 0x42, 0x99, 0x52, 0x2b, 0xdb, 0x5b, 0x99, 0xf8, 0x2c, 0xd5, 0x50,
+	0x03, 0x42, 0x4e, 0xbe, 0x22, 0x9e, 0xa4, 0x2c, 0x12, 0xda, 0x46, 0xba, 0xc7, 0x92, 0x82, 0x3e,
+	0x83, 0xeb, 0x85, 0x8d, 0x9a, 0x0e, 0x5a, 0x9b, 0x4f, 0x76, 0xdb, 0x39, 0xf1, 0xe4, 0x55, 0x6e,
+	0xe7, 0xaf, 0x72, 0xfb, 0x88, 0xd1, 0xa8, 0x5b, 0x79, 0xf5, 0xe7, 0xfd, 0x92, 0x5d, 0xd4, 0x3f,
+	0x75, 0x52, 0xf2, 0x23, 0x58, 0x4d, 0x30, 0xaa, 0x00, 0xe9, 0x70, 0xef, 0x26, 0xbe, 0x47, 0x0b,
+	0x48, 0x2a, 0xd0, 0x00, 0xda, 0x5e, 0x02, 0xa3, 0x2a, 0xe8, 0x9d, 0x1b, 0x07, 0x50, 0xcb, 0x1a,
+	0x68, 0x7e, 0x07, 0x60, 0x7d, 0x40, 0x48, 0xf7, 0xdc, 0x0f, 0x88, 0x9c, 0xc4, 0x24, 0xf2, 0x93,
+	0xdb, 0x13, 0x13, 0x4e, 0x59, 0x31, 0xab, 0x3c, 0x42, 0x18, 0xae, 0x89, 0x98, 0x44, 0x52, 0x53,
+	0xf4, 0xf2, 0x6a, 0xe7, 0x9f, 0x24, 0xce, 0x7f, 0xfe, 0xeb, 0x7e, 0x2b, 0xa0, 0x32, 0x3c, 0x3f,
+	0x6b, 0x7b, 0x6c, 0x96, 0xff, 0xdd, 0xe4, 0x3f, 0x8f, 0x85, 0xff, 0xb5, 0x21, 0x2f, 0x62, 0x22,
+	0xd2, 0x05, 0xc2, 0xce, 0x76, 0x3e, 0xf8, 0x11, 0xc0, 0x9d, 0xff, 0xba, 0xdd, 0x68, 0x1f, 0x36,
+	0x87, 0x27, 0xc7, 0x8e, 0x39, 0x31, 0x3f, 0x77, 0x2d, 0x7b, 0x6c, 0x8d, 0x27, 0x9d, 0x63, 0xd7,
+	0x39, 0xb5, 0xfa, 0xee, 0xc9, 0x68, 0x62, 0xf5, 0x8f, 0xcc, 0x81, 0xd9, 0xef, 0xa9, 0x25, 0xd4,
+	0x82, 0x0f, 0x6e, 0xa9, 0x73, 0xec, 0xce, 0x68, 0x32, 0xe8, 0xdb, 0xee, 0x78, 0x74, 0x7c, 0xaa,
+	0x02, 0x74, 0x00, 0xf7, 0x6f, 0xa9, 0xec, 0x7f, 0x71, 0xd4, 0xb7, 0x9c, 0xc5, 0x02, 0x55, 0x39,
+	0x78, 0x09, 0xe0, 0x6e, 0x7e, 0x49, 0x06, 0x8c, 0x3f, 0x27, 0x54, 0xf6, 0x88, 0x90, 0x34, 0xc2,
+	0xc9, 0x50, 0xd1, 0xc7, 0xf0, 0xa3, 0x5e, 0xdf, 0x1a, 0x4f, 0x4c, 0xc7, 0x1d, 0x8c, 0xed, 0x41,
+	0xdf, 0x74, 0xdc, 0x5e, 0x7f, 0xe2, 0x98, 0xa3, 0x8e, 0x63, 0x8e, 0x47, 0x37, 0x0c, 0x3e, 0x80,
+	0xfa, 0xaa, 0xe2, 0xee, 0x89, 0x3d, 0x52, 0x01, 0x6a, 0xc3, 0x83, 0x55, 0x55, 0x47, 0xe3, 0xe1,
+	0xf0, 0x64, 0x64, 0x3a, 0xa7, 0xae, 0x35, 0x1e, 0x1f, 0xab, 0x4a, 0xd7, 0x7c, 0x75, 0xd9, 0x00,
+	0xaf, 0x2f, 0x1b, 0xe0, 0xef, 0xcb, 0x06, 0xf8, 0xe1, 0xaa, 0x51, 0x7a, 0x7d, 0xd5, 0x28, 0xfd,
+	0x71, 0xd5, 0x28, 0x7d, 0x69, 0x2c, 0x8d, 0xa0, 0x87, 0xc9, 0x7c, 0x48, 0x65, 0xc8, 0x71, 0x64,
+	0xf8, 0x33, 0x2f, 0xc4, 0x34, 0x32, 0x5e, 0x18, 0x8b, 0x2f, 0x51, 0x3a, 0x8f, 0xb3, 0x6a, 0xfa,
+	0x41, 0xf8, 0xf4, 0xdf, 0x01, 0x00, 0x6e, 0x0c, 0x71, 0xd6, 0xa2, 0x06, 0x00, 0x00,
 }
 
 func (m *MultisigAccountDetails) Marshal() (dAtA []byte, err error) {
@@ -272,6 +552,30 @@ func (m *MultisigAccountDetails) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ThresholdPercentage) > 0 {
+		i -= len(m.ThresholdPercentage)
+		copy(dAtA[i:], m.ThresholdPercentage)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ThresholdPercentage)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Permission != 0 {
 		i = encodeVarintState(dAtA, i, uint64(m.Permission))
 		i--
@@ -294,6 +598,79 @@ func (m *MultisigAccountDetails) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MultisigAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultisigAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultisigAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ThresholdPercentage) > 0 {
+		i -= len(m.ThresholdPercentage)
+		copy(dAtA[i:], m.ThresholdPercentage)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ThresholdPercentage)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Permission != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Proposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -314,6 +691,30 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.Rejections) > 0 {
+		for iNdEx := len(m.Rejections) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Rejections[iNdEx])
+			copy(dAtA[i:], m.Rejections[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.Rejections[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.Approvals) > 0 {
 		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Approvals[iNdEx])
@@ -323,8 +724,8 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x32
 		}
 	}
-	if m.Deposit != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.Deposit))
+	if m.LegacyDeposit != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.LegacyDeposit))
 		i--
 		dAtA[i] = 0x28
 	}
@@ -357,6 +758,48 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeBudgetSpend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeBudgetSpend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeBudgetSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Period != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintState(dAtA []byte, offset int, v uint64) int {
 	offset -= sovState(v)
 	base := offset
@@ -386,46 +829,122 @@ func (m *MultisigAccountDetails) Size() (n int) {
 	if m.Permission != 0 {
 		n += 1 + sovState(uint64(m.Permission))
 	}
+	if m.Frozen {
+		n += 2
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.ThresholdPercentage)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
 	return n
 }
 
-func (m *Proposal) Size() (n int) {
+func (m *MultisigAccount) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovState(uint64(m.Id))
-	}
-	l = len(m.MultisigAddress)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
-	}
-	l = len(m.CallHash)
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovState(uint64(l))
 	}
-	l = len(m.Depositor)
+	l = len(m.Creator)
 	if l > 0 {
 		n += 1 + l + sovState(uint64(l))
 	}
-	if m.Deposit != 0 {
-		n += 1 + sovState(uint64(m.Deposit))
-	}
-	if len(m.Approvals) > 0 {
-		for _, b := range m.Approvals {
+	if len(m.Signers) > 0 {
+		for _, b := range m.Signers {
 			l = len(b)
 			n += 1 + l + sovState(uint64(l))
 		}
 	}
-	return n
-}
-
-func sovState(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozState(x uint64) (n int) {
+	if m.Threshold != 0 {
+		n += 1 + sovState(uint64(m.Threshold))
+	}
+	if m.Permission != 0 {
+		n += 1 + sovState(uint64(m.Permission))
+	}
+	if m.Frozen {
+		n += 2
+	}
+	l = len(m.ThresholdPercentage)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.MultisigAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.CallHash)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.LegacyDeposit != 0 {
+		n += 1 + sovState(uint64(m.LegacyDeposit))
+	}
+	if len(m.Approvals) > 0 {
+		for _, b := range m.Approvals {
+			l = len(b)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovState(uint64(m.ExpiryHeight))
+	}
+	if len(m.Rejections) > 0 {
+		for _, b := range m.Rejections {
+			l = len(b)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *FeeBudgetSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovState(uint64(m.Period))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovState(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozState(x uint64) (n int) {
 	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *MultisigAccountDetails) Unmarshal(dAtA []byte) error {
@@ -438,26 +957,300 @@ func (m *MultisigAccountDetails) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowState
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultisigAccountDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultisigAccountDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, make([]byte, postIndex-iNdEx))
+			copy(m.Signers[len(m.Signers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= MultisigProposalType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = append(m.Creator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Creator == nil {
+				m.Creator = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThresholdPercentage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultisigAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultisigAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultisigAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MultisigAccountDetails: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultisigAccountDetails: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = append(m.Creator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Creator == nil {
+				m.Creator = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
@@ -489,7 +1282,7 @@ func (m *MultisigAccountDetails) Unmarshal(dAtA []byte) error {
 			m.Signers = append(m.Signers, make([]byte, postIndex-iNdEx))
 			copy(m.Signers[len(m.Signers)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
@@ -508,7 +1301,7 @@ func (m *MultisigAccountDetails) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
 			}
@@ -527,6 +1320,58 @@ func (m *MultisigAccountDetails) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThresholdPercentage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -700,9 +1545,9 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyDeposit", wireType)
 			}
-			m.Deposit = 0
+			m.LegacyDeposit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -712,7 +1557,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Deposit |= uint64(b&0x7F) << shift
+				m.LegacyDeposit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -749,6 +1594,193 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			m.Approvals = append(m.Approvals, make([]byte, postIndex-iNdEx))
 			copy(m.Approvals[len(m.Approvals)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejections", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rejections = append(m.Rejections, make([]byte, postIndex-iNdEx))
+			copy(m.Rejections[len(m.Rejections)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeBudgetSpend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeBudgetSpend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeBudgetSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// ValidateThresholdPercentage checks that percentage is a decimal fraction in (0, 1].
+func ValidateThresholdPercentage(percentage string) error {
+	dec, err := math.LegacyNewDecFromStr(percentage)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidThreshold, "invalid threshold percentage %q: %s", percentage, err)
+	}
+
+	if !dec.IsPositive() || dec.GT(math.LegacyOneDec()) {
+		return errors.Wrapf(ErrInvalidThreshold, "threshold percentage must be in (0, 1], got %s", dec)
+	}
+
+	return nil
+}
+
+// ValidateThreshold checks that the threshold, absolute or a percentage of the signers,
+// requires between one and signers approvals. Exactly one of threshold and percentage
+// must be set.
+func ValidateThreshold(threshold uint32, percentage string, signers int) error {
+	if percentage != "" && threshold != 0 {
+		return errors.Wrap(ErrInvalidThreshold, "threshold and threshold percentage are mutually exclusive")
+	}
+
+	if percentage != "" {
+		if err := ValidateThresholdPercentage(percentage); err != nil {
+			return err
+		}
+	}
+
+	details := MultisigAccountDetails{Threshold: threshold, ThresholdPercentage: percentage, Signers: make([][]byte, signers)}
+	effective, err := details.EffectiveThreshold()
+	if err != nil {
+		return err
+	}
+
+	if int(effective) > signers {
+		return errors.Wrapf(ErrInvalidThreshold, "threshold %d exceeds signer count %d", effective, signers)
+	}
+
+	return nil
+}
+
+// EffectiveThreshold returns the number of approvals required to execute a call of the
+// account. With a threshold percentage it is the percentage of the current signers rounded
+// up, so that it follows the changes of the signer set.
+func (d MultisigAccountDetails) EffectiveThreshold() (uint32, error) {
+	threshold := d.Threshold
+	if d.ThresholdPercentage != "" {
+		percentage, err := math.LegacyNewDecFromStr(d.ThresholdPercentage)
+		if err != nil {
+			return 0, errors.Wrapf(ErrInvalidThreshold, "invalid threshold percentage %q: %s", d.ThresholdPercentage, err)
+		}
+
+		threshold = uint32(percentage.MulInt64(int64(len(d.Signers))).Ceil().TruncateInt64())
+	}
+
+	if threshold < 1 {
+		return 0, errors.Wrap(ErrInvalidThreshold, "effective threshold must be at least one")
+	}
+
+	return threshold, nil
+}
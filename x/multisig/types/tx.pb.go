@@ -9,21 +9,26 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -137,6 +142,10 @@ type MsgCreateMultisigAccountParams struct {
 	Threshold  uint32               `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
 	Signers    [][]byte             `protobuf:"bytes,4,rep,name=signers,proto3" json:"signers,omitempty"`
 	Permission MultisigProposalType `protobuf:"varint,5,opt,name=permission,proto3,enum=multisig.v1.MultisigProposalType" json:"permission,omitempty"`
+	// threshold_percentage is the fraction of the signers required to approve a call, in
+	// place of an absolute threshold. It is a decimal string such as "0.5", not annotated as
+	// cosmos.Dec since amino JSON would encode it as a scaled integer.
+	ThresholdPercentage string `protobuf:"bytes,6,opt,name=threshold_percentage,json=thresholdPercentage,proto3" json:"threshold_percentage,omitempty"`
 }
 
 func (m *MsgCreateMultisigAccountParams) Reset()         { *m = MsgCreateMultisigAccountParams{} }
@@ -207,6 +216,13 @@ func (m *MsgCreateMultisigAccountParams) GetPermission() MultisigProposalType {
 	return MultisigProposalType_MULTISIG_PROPOSAL_TYPE_UNSPECIFIED
 }
 
+func (m *MsgCreateMultisigAccountParams) GetThresholdPercentage() string {
+	if m != nil {
+		return m.ThresholdPercentage
+	}
+	return ""
+}
+
 // MsgCreateMultisigAccountResponse defines the response structure of a created multisig account operation
 type MsgCreateMultisigAccountResponse struct {
 	MultisigAddress string `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
@@ -864,25 +880,31 @@ func (m *MsgApproveAndDispatchMultisigProposalResponse) GetTransactionHash() str
 	return ""
 }
 
-// MsgCancelMultisigProposalParams defines the request type to reject a multisig proposal
-type MsgCancelMultisigProposalParams struct {
-	MultisigAddress string `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
-	ProposalId      uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	Rejecter        string `protobuf:"bytes,3,opt,name=rejecter,proto3" json:"rejecter,omitempty"`
+// MsgBatchApproveMultisigProposalParams defines the request type to approve a multisig proposal with
+// signatures of its signers collected off-chain
+type MsgBatchApproveMultisigProposalParams struct {
+	// submitter is the account submitting the approvals, it does not need to be a signer
+	Submitter       string              `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	MultisigAddress string              `protobuf:"bytes,2,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	ProposalId      uint64              `protobuf:"varint,3,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Signatures      []ApprovalSignature `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures"`
+	// message is the call of the proposal, dispatched once the approvals meet the threshold
+	// when set
+	Message *types.Any `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (m *MsgCancelMultisigProposalParams) Reset()         { *m = MsgCancelMultisigProposalParams{} }
-func (m *MsgCancelMultisigProposalParams) String() string { return proto.CompactTextString(m) }
-func (*MsgCancelMultisigProposalParams) ProtoMessage()    {}
-func (*MsgCancelMultisigProposalParams) Descriptor() ([]byte, []int) {
+func (m *MsgBatchApproveMultisigProposalParams) Reset()         { *m = MsgBatchApproveMultisigProposalParams{} }
+func (m *MsgBatchApproveMultisigProposalParams) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchApproveMultisigProposalParams) ProtoMessage()    {}
+func (*MsgBatchApproveMultisigProposalParams) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f023d0392a638bd4, []int{16}
 }
-func (m *MsgCancelMultisigProposalParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchApproveMultisigProposalParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCancelMultisigProposalParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchApproveMultisigProposalParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCancelMultisigProposalParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchApproveMultisigProposalParams.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -892,55 +914,71 @@ func (m *MsgCancelMultisigProposalParams) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgCancelMultisigProposalParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCancelMultisigProposalParams.Merge(m, src)
+func (m *MsgBatchApproveMultisigProposalParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchApproveMultisigProposalParams.Merge(m, src)
 }
-func (m *MsgCancelMultisigProposalParams) XXX_Size() int {
+func (m *MsgBatchApproveMultisigProposalParams) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCancelMultisigProposalParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCancelMultisigProposalParams.DiscardUnknown(m)
+func (m *MsgBatchApproveMultisigProposalParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchApproveMultisigProposalParams.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCancelMultisigProposalParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchApproveMultisigProposalParams proto.InternalMessageInfo
 
-func (m *MsgCancelMultisigProposalParams) GetMultisigAddress() string {
+func (m *MsgBatchApproveMultisigProposalParams) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *MsgBatchApproveMultisigProposalParams) GetMultisigAddress() string {
 	if m != nil {
 		return m.MultisigAddress
 	}
 	return ""
 }
 
-func (m *MsgCancelMultisigProposalParams) GetProposalId() uint64 {
+func (m *MsgBatchApproveMultisigProposalParams) GetProposalId() uint64 {
 	if m != nil {
 		return m.ProposalId
 	}
 	return 0
 }
 
-func (m *MsgCancelMultisigProposalParams) GetRejecter() string {
+func (m *MsgBatchApproveMultisigProposalParams) GetSignatures() []ApprovalSignature {
 	if m != nil {
-		return m.Rejecter
+		return m.Signatures
 	}
-	return ""
+	return nil
 }
 
-// MsgCancelMultisigProposalResponse defines the response structure of rejecting a multisig proposal
-type MsgCancelMultisigProposalResponse struct {
+func (m *MsgBatchApproveMultisigProposalParams) GetMessage() *types.Any {
+	if m != nil {
+		return m.Message
+	}
+	return nil
 }
 
-func (m *MsgCancelMultisigProposalResponse) Reset()         { *m = MsgCancelMultisigProposalResponse{} }
-func (m *MsgCancelMultisigProposalResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCancelMultisigProposalResponse) ProtoMessage()    {}
-func (*MsgCancelMultisigProposalResponse) Descriptor() ([]byte, []int) {
+// ApprovalSignature defines the signature of a signer over the ApprovalSignDoc of a proposal
+type ApprovalSignature struct {
+	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *ApprovalSignature) Reset()         { *m = ApprovalSignature{} }
+func (m *ApprovalSignature) String() string { return proto.CompactTextString(m) }
+func (*ApprovalSignature) ProtoMessage()    {}
+func (*ApprovalSignature) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f023d0392a638bd4, []int{17}
 }
-func (m *MsgCancelMultisigProposalResponse) XXX_Unmarshal(b []byte) error {
+func (m *ApprovalSignature) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCancelMultisigProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApprovalSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCancelMultisigProposalResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApprovalSignature.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -950,37 +988,52 @@ func (m *MsgCancelMultisigProposalResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgCancelMultisigProposalResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCancelMultisigProposalResponse.Merge(m, src)
+func (m *ApprovalSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApprovalSignature.Merge(m, src)
 }
-func (m *MsgCancelMultisigProposalResponse) XXX_Size() int {
+func (m *ApprovalSignature) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCancelMultisigProposalResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCancelMultisigProposalResponse.DiscardUnknown(m)
+func (m *ApprovalSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApprovalSignature.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCancelMultisigProposalResponse proto.InternalMessageInfo
+var xxx_messageInfo_ApprovalSignature proto.InternalMessageInfo
 
-// MsgCleanupMultisigProposalParams defines the request type to clear all multisig proposals after account deletion
-type MsgCleanupMultisigProposalParams struct {
-	MultisigAddress string `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
-	ProposalId      uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	Remover         string `protobuf:"bytes,3,opt,name=remover,proto3" json:"remover,omitempty"`
+func (m *ApprovalSignature) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func (m *MsgCleanupMultisigProposalParams) Reset()         { *m = MsgCleanupMultisigProposalParams{} }
-func (m *MsgCleanupMultisigProposalParams) String() string { return proto.CompactTextString(m) }
-func (*MsgCleanupMultisigProposalParams) ProtoMessage()    {}
-func (*MsgCleanupMultisigProposalParams) Descriptor() ([]byte, []int) {
+func (m *ApprovalSignature) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// ApprovalSignDoc defines the document signed off-chain to approve a multisig proposal
+type ApprovalSignDoc struct {
+	ChainId         string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	MultisigAddress string `protobuf:"bytes,2,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	ProposalId      uint64 `protobuf:"varint,3,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	CallHash        []byte `protobuf:"bytes,4,opt,name=call_hash,json=callHash,proto3" json:"call_hash,omitempty"`
+}
+
+func (m *ApprovalSignDoc) Reset()         { *m = ApprovalSignDoc{} }
+func (m *ApprovalSignDoc) String() string { return proto.CompactTextString(m) }
+func (*ApprovalSignDoc) ProtoMessage()    {}
+func (*ApprovalSignDoc) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f023d0392a638bd4, []int{18}
 }
-func (m *MsgCleanupMultisigProposalParams) XXX_Unmarshal(b []byte) error {
+func (m *ApprovalSignDoc) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCleanupMultisigProposalParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApprovalSignDoc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCleanupMultisigProposalParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApprovalSignDoc.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -990,55 +1043,67 @@ func (m *MsgCleanupMultisigProposalParams) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *MsgCleanupMultisigProposalParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCleanupMultisigProposalParams.Merge(m, src)
+func (m *ApprovalSignDoc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApprovalSignDoc.Merge(m, src)
 }
-func (m *MsgCleanupMultisigProposalParams) XXX_Size() int {
+func (m *ApprovalSignDoc) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCleanupMultisigProposalParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCleanupMultisigProposalParams.DiscardUnknown(m)
+func (m *ApprovalSignDoc) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApprovalSignDoc.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCleanupMultisigProposalParams proto.InternalMessageInfo
+var xxx_messageInfo_ApprovalSignDoc proto.InternalMessageInfo
 
-func (m *MsgCleanupMultisigProposalParams) GetMultisigAddress() string {
+func (m *ApprovalSignDoc) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ApprovalSignDoc) GetMultisigAddress() string {
 	if m != nil {
 		return m.MultisigAddress
 	}
 	return ""
 }
 
-func (m *MsgCleanupMultisigProposalParams) GetProposalId() uint64 {
+func (m *ApprovalSignDoc) GetProposalId() uint64 {
 	if m != nil {
 		return m.ProposalId
 	}
 	return 0
 }
 
-func (m *MsgCleanupMultisigProposalParams) GetRemover() string {
+func (m *ApprovalSignDoc) GetCallHash() []byte {
 	if m != nil {
-		return m.Remover
+		return m.CallHash
 	}
-	return ""
+	return nil
 }
 
-// MsgCleanupMultisigProposalResponse defines the response structure of removed proposal of deleted multisig account
-type MsgCleanupMultisigProposalResponse struct {
+// MsgBatchApproveMultisigProposalResponse defines the response structure of approving a multisig proposal
+// with off-chain signatures
+type MsgBatchApproveMultisigProposalResponse struct {
+	Dispatched      bool   `protobuf:"varint,1,opt,name=dispatched,proto3" json:"dispatched,omitempty"`
+	TransactionHash string `protobuf:"bytes,2,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
 }
 
-func (m *MsgCleanupMultisigProposalResponse) Reset()         { *m = MsgCleanupMultisigProposalResponse{} }
-func (m *MsgCleanupMultisigProposalResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCleanupMultisigProposalResponse) ProtoMessage()    {}
-func (*MsgCleanupMultisigProposalResponse) Descriptor() ([]byte, []int) {
+func (m *MsgBatchApproveMultisigProposalResponse) Reset() {
+	*m = MsgBatchApproveMultisigProposalResponse{}
+}
+func (m *MsgBatchApproveMultisigProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchApproveMultisigProposalResponse) ProtoMessage()    {}
+func (*MsgBatchApproveMultisigProposalResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f023d0392a638bd4, []int{19}
 }
-func (m *MsgCleanupMultisigProposalResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchApproveMultisigProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCleanupMultisigProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchApproveMultisigProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCleanupMultisigProposalResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchApproveMultisigProposalResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1048,1541 +1113,4606 @@ func (m *MsgCleanupMultisigProposalResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgCleanupMultisigProposalResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCleanupMultisigProposalResponse.Merge(m, src)
+func (m *MsgBatchApproveMultisigProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchApproveMultisigProposalResponse.Merge(m, src)
 }
-func (m *MsgCleanupMultisigProposalResponse) XXX_Size() int {
+func (m *MsgBatchApproveMultisigProposalResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCleanupMultisigProposalResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCleanupMultisigProposalResponse.DiscardUnknown(m)
+func (m *MsgBatchApproveMultisigProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchApproveMultisigProposalResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCleanupMultisigProposalResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchApproveMultisigProposalResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "multisig.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "multisig.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateMultisigAccountParams)(nil), "multisig.v1.MsgCreateMultisigAccountParams")
-	proto.RegisterType((*MsgCreateMultisigAccountResponse)(nil), "multisig.v1.MsgCreateMultisigAccountResponse")
-	proto.RegisterType((*MsgAddMultisigSignerParams)(nil), "multisig.v1.MsgAddMultisigSignerParams")
-	proto.RegisterType((*MsgAddMultisigSignerResponse)(nil), "multisig.v1.MsgAddMultisigSignerResponse")
-	proto.RegisterType((*MsgCleanupMultisigAccountParams)(nil), "multisig.v1.MsgCleanupMultisigAccountParams")
-	proto.RegisterType((*MsgCleanupMultisigAccountResponse)(nil), "multisig.v1.MsgCleanupMultisigAccountResponse")
-	proto.RegisterType((*MsgSetMultisigThresholdParams)(nil), "multisig.v1.MsgSetMultisigThresholdParams")
-	proto.RegisterType((*MsgSetMultisigThresholdResponse)(nil), "multisig.v1.MsgSetMultisigThresholdResponse")
-	proto.RegisterType((*MsgInitializeMultisigProposalParams)(nil), "multisig.v1.MsgInitializeMultisigProposalParams")
-	proto.RegisterType((*MsgInitializeMultisigResponse)(nil), "multisig.v1.MsgInitializeMultisigResponse")
-	proto.RegisterType((*MsgApproveMultisigProposalParams)(nil), "multisig.v1.MsgApproveMultisigProposalParams")
-	proto.RegisterType((*MsgApproveMultisigProposalResponse)(nil), "multisig.v1.MsgApproveMultisigProposalResponse")
-	proto.RegisterType((*MsgApproveAndDispatchMultisigProposalParams)(nil), "multisig.v1.MsgApproveAndDispatchMultisigProposalParams")
-	proto.RegisterType((*MsgApproveAndDispatchMultisigProposalResponse)(nil), "multisig.v1.MsgApproveAndDispatchMultisigProposalResponse")
-	proto.RegisterType((*MsgCancelMultisigProposalParams)(nil), "multisig.v1.MsgCancelMultisigProposalParams")
-	proto.RegisterType((*MsgCancelMultisigProposalResponse)(nil), "multisig.v1.MsgCancelMultisigProposalResponse")
-	proto.RegisterType((*MsgCleanupMultisigProposalParams)(nil), "multisig.v1.MsgCleanupMultisigProposalParams")
-	proto.RegisterType((*MsgCleanupMultisigProposalResponse)(nil), "multisig.v1.MsgCleanupMultisigProposalResponse")
+func (m *MsgBatchApproveMultisigProposalResponse) GetDispatched() bool {
+	if m != nil {
+		return m.Dispatched
+	}
+	return false
 }
 
-func init() { proto.RegisterFile("multisig/v1/tx.proto", fileDescriptor_f023d0392a638bd4) }
-
-var fileDescriptor_f023d0392a638bd4 = []byte{
-	// 1038 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xbf, 0x6f, 0xdb, 0x46,
-	0x14, 0x36, 0x65, 0xd9, 0xae, 0x9e, 0x9c, 0x38, 0xbd, 0x2a, 0xb5, 0xc2, 0xba, 0xb2, 0x4c, 0x07,
-	0xa8, 0xe3, 0xc4, 0x64, 0xa4, 0x16, 0x45, 0x91, 0xa9, 0x72, 0x32, 0xd4, 0x28, 0x04, 0x04, 0x74,
-	0xba, 0x64, 0x11, 0x4e, 0xe4, 0x85, 0x64, 0x21, 0xfe, 0x00, 0xef, 0xa4, 0x44, 0x99, 0xda, 0xcc,
-	0x1d, 0x3a, 0x75, 0xe8, 0xde, 0xce, 0x19, 0x32, 0x74, 0x68, 0x3b, 0x07, 0x9d, 0x82, 0x4e, 0x9d,
-	0x8a, 0xc2, 0x1e, 0xf2, 0x6f, 0x14, 0x22, 0x79, 0x67, 0x89, 0x12, 0x29, 0x15, 0x55, 0x11, 0x74,
-	0x13, 0xef, 0x7d, 0xf7, 0xde, 0x77, 0xdf, 0x7d, 0xf7, 0xee, 0x04, 0x15, 0xb7, 0xdf, 0x63, 0x0e,
-	0x75, 0x2c, 0x6d, 0xd0, 0xd0, 0xd8, 0x13, 0x35, 0x08, 0x7d, 0xe6, 0xa3, 0x32, 0x1f, 0x55, 0x07,
-	0x0d, 0x79, 0xdb, 0xf0, 0xa9, 0xeb, 0x53, 0xcd, 0xa5, 0x11, 0xc8, 0xa5, 0x56, 0x8c, 0x92, 0xaf,
-	0x59, 0xbe, 0x6f, 0xf5, 0x88, 0x16, 0x7d, 0x75, 0xfb, 0x8f, 0x34, 0xec, 0x0d, 0x79, 0x68, 0x3c,
-	0xad, 0x45, 0x3c, 0x42, 0x1d, 0x9a, 0x84, 0xb6, 0xc7, 0x43, 0x94, 0x61, 0x46, 0x92, 0x40, 0xc5,
-	0xf2, 0x2d, 0x3f, 0xfa, 0xa9, 0x8d, 0x7e, 0xf1, 0x4c, 0x71, 0xf5, 0x4e, 0x1c, 0x88, 0x3f, 0xe2,
-	0x90, 0xf2, 0x8d, 0x04, 0x5b, 0x6d, 0x6a, 0x7d, 0x11, 0x98, 0x98, 0x91, 0xfb, 0x38, 0xc4, 0x2e,
-	0x45, 0x1f, 0x43, 0x09, 0xf7, 0x99, 0xed, 0x87, 0x0e, 0x1b, 0x56, 0xa5, 0xba, 0x74, 0x50, 0x3a,
-	0xae, 0xfe, 0xfe, 0xe2, 0xa8, 0x92, 0x4c, 0x6c, 0x99, 0x66, 0x48, 0x28, 0x3d, 0x65, 0xa1, 0xe3,
-	0x59, 0xfa, 0x05, 0x14, 0x35, 0x60, 0x3d, 0x88, 0x32, 0x54, 0x0b, 0x75, 0xe9, 0xa0, 0xdc, 0x7c,
-	0x47, 0x1d, 0x93, 0x40, 0x8d, 0x93, 0x1f, 0x17, 0x5f, 0xfe, 0xb9, 0xbb, 0xa2, 0x27, 0xc0, 0x3b,
-	0x97, 0x9f, 0xbd, 0x7e, 0x7e, 0x78, 0x91, 0x42, 0xb9, 0x06, 0xdb, 0x29, 0x36, 0x3a, 0xa1, 0x81,
-	0xef, 0x51, 0xa2, 0x7c, 0x5d, 0x80, 0x5a, 0x9b, 0x5a, 0x77, 0x43, 0x82, 0x19, 0x69, 0x27, 0x89,
-	0x5b, 0x86, 0xe1, 0xf7, 0x3d, 0xf6, 0x2f, 0x89, 0x23, 0x28, 0x52, 0x42, 0xcc, 0x88, 0xf6, 0x25,
-	0x3d, 0xfa, 0x8d, 0x76, 0xa0, 0xc4, 0xec, 0x90, 0x50, 0xdb, 0xef, 0x99, 0xd5, 0xd5, 0x28, 0x70,
-	0x31, 0x80, 0x9a, 0xb0, 0x41, 0x1d, 0xcb, 0x23, 0x21, 0xad, 0x16, 0xeb, 0xab, 0x07, 0x9b, 0x39,
-	0x75, 0x38, 0x10, 0xb5, 0x00, 0x02, 0x12, 0xba, 0x0e, 0xa5, 0x8e, 0xef, 0x55, 0xd7, 0xea, 0xd2,
-	0xc1, 0xe5, 0xe6, 0xde, 0x84, 0x44, 0x7c, 0x55, 0xf7, 0x43, 0x3f, 0xf0, 0x29, 0xee, 0x3d, 0x18,
-	0x06, 0x44, 0x1f, 0x9b, 0xa4, 0x58, 0x50, 0xcf, 0x92, 0x80, 0xeb, 0x84, 0xee, 0xc2, 0x15, 0x9e,
-	0xb3, 0x83, 0x63, 0x26, 0x73, 0xb5, 0xd8, 0xe2, 0x33, 0x92, 0x61, 0xe5, 0x27, 0x09, 0xe4, 0x36,
-	0x1d, 0x7d, 0xf2, 0x32, 0xa7, 0xd1, 0x2a, 0x12, 0xa1, 0x97, 0x51, 0x03, 0xdd, 0x86, 0xf5, 0x58,
-	0x9a, 0x48, 0xf7, 0xbc, 0xa9, 0x09, 0x0e, 0xed, 0xc3, 0x25, 0x8f, 0x3c, 0xee, 0xa4, 0xf7, 0x65,
-	0xd3, 0x23, 0x8f, 0x1f, 0xf0, 0x31, 0xa5, 0x06, 0x3b, 0xb3, 0x98, 0x0b, 0x1f, 0x3d, 0x82, 0xdd,
-	0x91, 0x86, 0x3d, 0x82, 0xbd, 0x7e, 0x30, 0xdb, 0x47, 0x4b, 0x91, 0x70, 0x1f, 0xf6, 0x32, 0xeb,
-	0x08, 0x32, 0xcf, 0x24, 0x78, 0xbf, 0x4d, 0xad, 0x53, 0xc2, 0x38, 0x42, 0x2c, 0x64, 0x99, 0x52,
-	0x4f, 0x98, 0xb9, 0x90, 0x32, 0xb3, 0xb2, 0x17, 0x29, 0x32, 0x8b, 0x83, 0xe0, 0xf9, 0x43, 0x01,
-	0xf6, 0xdb, 0xd4, 0x3a, 0xf1, 0x1c, 0xe6, 0xe0, 0x9e, 0xf3, 0x94, 0xa4, 0xad, 0xba, 0x4c, 0xb6,
-	0x1f, 0xc1, 0x5b, 0x41, 0x94, 0x76, 0x01, 0x6b, 0x08, 0x24, 0xaa, 0xc0, 0x1a, 0x73, 0x58, 0x8f,
-	0x44, 0xa6, 0x28, 0xe9, 0xf1, 0x07, 0xaa, 0x43, 0xd9, 0x24, 0xd4, 0x08, 0x9d, 0x80, 0x8d, 0x4e,
-	0x5d, 0x31, 0x8a, 0x8d, 0x0f, 0xa1, 0xcf, 0x61, 0xc3, 0x25, 0x94, 0x62, 0x8b, 0x44, 0x67, 0xb2,
-	0xdc, 0xac, 0xa8, 0x71, 0x4f, 0x56, 0x79, 0x4f, 0x56, 0x5b, 0xde, 0xf0, 0xf8, 0xbd, 0xdf, 0x5e,
-	0x1c, 0x25, 0x5d, 0x5c, 0xed, 0x62, 0x4a, 0xd4, 0x41, 0xa3, 0x4b, 0x18, 0x6e, 0xa8, 0x6d, 0x6a,
-	0xe9, 0x3c, 0x83, 0xf2, 0x69, 0xb4, 0x9d, 0xd3, 0x32, 0x89, 0xd3, 0xb9, 0x0b, 0xe5, 0x20, 0x91,
-	0xac, 0xe3, 0x98, 0x91, 0x36, 0x45, 0x1d, 0xf8, 0xd0, 0x89, 0xa9, 0xfc, 0x2a, 0x45, 0x67, 0xbc,
-	0x15, 0x04, 0xa1, 0x3f, 0xf8, 0x4f, 0x65, 0x4e, 0x51, 0x29, 0xa4, 0xa9, 0x8c, 0xf6, 0x01, 0xc7,
-	0x34, 0xc2, 0x58, 0xd4, 0xbc, 0x7d, 0xe0, 0x48, 0xe5, 0x3a, 0x28, 0xd9, 0xfc, 0x85, 0xa1, 0xbe,
-	0x2b, 0xc0, 0xcd, 0x0b, 0x58, 0xcb, 0x33, 0xef, 0x39, 0x34, 0xc0, 0xcc, 0xb0, 0xff, 0x7f, 0x2b,
-	0x5e, 0xae, 0x83, 0x1e, 0xc2, 0xd1, 0x42, 0xba, 0x08, 0x47, 0xdd, 0x80, 0x2b, 0x2c, 0xc4, 0x1e,
-	0xc5, 0xc6, 0xc8, 0xce, 0x1d, 0x1b, 0x53, 0x3b, 0x56, 0x46, 0xdf, 0x1a, 0x1b, 0xff, 0x0c, 0x53,
-	0x5b, 0xf9, 0x45, 0x8a, 0x7b, 0x1f, 0xf6, 0x0c, 0xd2, 0x7b, 0xd3, 0x42, 0x87, 0xe4, 0x4b, 0x62,
-	0xb0, 0x45, 0x84, 0xe6, 0x48, 0xde, 0x52, 0x67, 0xd2, 0x17, 0xce, 0xfa, 0x39, 0x3e, 0x40, 0xa9,
-	0xc6, 0xfb, 0x46, 0x56, 0xd9, 0x84, 0x8d, 0x90, 0xb8, 0x0b, 0xb9, 0x89, 0x03, 0x93, 0xe3, 0x93,
-	0xc1, 0x9e, 0x2f, 0xb2, 0xf9, 0x63, 0x09, 0x56, 0xdb, 0xd4, 0x42, 0x3a, 0x6c, 0x4e, 0x3c, 0xdd,
-	0x76, 0x26, 0xdf, 0x13, 0x93, 0x4f, 0x29, 0xf9, 0x7a, 0x5e, 0x54, 0x18, 0x8a, 0xc2, 0xd5, 0x99,
-	0x2f, 0x0c, 0x74, 0x33, 0x3d, 0x3d, 0xe7, 0x2d, 0x26, 0x1f, 0x2d, 0x04, 0x16, 0x45, 0x2d, 0x78,
-	0x7b, 0xea, 0xca, 0x46, 0x1f, 0xa4, 0x73, 0x64, 0xbc, 0x47, 0xe4, 0x1b, 0x73, 0x81, 0xa2, 0x50,
-	0x1f, 0xae, 0xa6, 0xc4, 0x4d, 0x8a, 0xdd, 0x9a, 0x22, 0x9c, 0xf3, 0x44, 0x90, 0xd5, 0xc5, 0xd0,
-	0xa2, 0xac, 0x0d, 0x9b, 0xa7, 0x84, 0x89, 0x8b, 0x15, 0x1d, 0xa6, 0xe7, 0x67, 0x3f, 0x01, 0xe4,
-	0x5b, 0x8b, 0x60, 0x45, 0xa5, 0xa7, 0x20, 0x67, 0x5f, 0xd3, 0xe8, 0x76, 0x3a, 0xd7, 0xbc, 0x2b,
-	0x5d, 0x3e, 0x9c, 0x3f, 0x43, 0xd4, 0x1e, 0xc2, 0x76, 0x46, 0xe3, 0x47, 0x53, 0x7e, 0xc8, 0xbd,
-	0xe1, 0x64, 0x6d, 0x41, 0xb8, 0x28, 0xfd, 0xbd, 0x04, 0xca, 0xfc, 0xae, 0x89, 0x3e, 0xc9, 0xc8,
-	0x3b, 0xf7, 0x06, 0x92, 0xef, 0xfc, 0xf3, 0x99, 0x82, 0xdc, 0x00, 0xde, 0x9d, 0xdd, 0xb5, 0x66,
-	0xb8, 0x2e, 0xa7, 0x39, 0xcf, 0x70, 0x5d, 0x6e, 0x2f, 0x1c, 0xed, 0x47, 0x46, 0x27, 0x99, 0xde,
-	0x8f, 0xdc, 0x86, 0x39, 0xbd, 0x1f, 0x73, 0x3a, 0x94, 0xbc, 0xf6, 0xd5, 0xeb, 0xe7, 0x87, 0xd2,
-	0xf1, 0xc9, 0xcb, 0xb3, 0x9a, 0xf4, 0xea, 0xac, 0x26, 0xfd, 0x75, 0x56, 0x93, 0xbe, 0x3d, 0xaf,
-	0xad, 0xbc, 0x3a, 0xaf, 0xad, 0xfc, 0x71, 0x5e, 0x5b, 0x79, 0xa8, 0x59, 0x0e, 0xb3, 0xfb, 0x5d,
-	0xd5, 0xf0, 0x5d, 0xed, 0x1e, 0x26, 0x83, 0xb6, 0xc3, 0xec, 0x10, 0x7b, 0x9a, 0xe9, 0x1a, 0x36,
-	0x76, 0x3c, 0xed, 0x89, 0x26, 0xfe, 0xe4, 0xb2, 0x61, 0x40, 0x68, 0x77, 0x3d, 0xba, 0x4d, 0x3f,
-	0xfc, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x2c, 0xc2, 0x17, 0x15, 0x6f, 0x0f, 0x00, 0x00,
+func (m *MsgBatchApproveMultisigProposalResponse) GetTransactionHash() string {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a governance operation for updating the parameters.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// Multisig account
-	CreateMultisigAccount(ctx context.Context, in *MsgCreateMultisigAccountParams, opts ...grpc.CallOption) (*MsgCreateMultisigAccountResponse, error)
-	AddMultisigSigner(ctx context.Context, in *MsgAddMultisigSignerParams, opts ...grpc.CallOption) (*MsgAddMultisigSignerResponse, error)
-	CleanupMultisigSigner(ctx context.Context, in *MsgCleanupMultisigAccountParams, opts ...grpc.CallOption) (*MsgCleanupMultisigAccountResponse, error)
-	SetThreshold(ctx context.Context, in *MsgSetMultisigThresholdParams, opts ...grpc.CallOption) (*MsgSetMultisigThresholdResponse, error)
-	InitializeMultisigProposal(ctx context.Context, in *MsgInitializeMultisigProposalParams, opts ...grpc.CallOption) (*MsgInitializeMultisigResponse, error)
-	ApproveMultisigProposal(ctx context.Context, in *MsgApproveMultisigProposalParams, opts ...grpc.CallOption) (*MsgApproveMultisigProposalResponse, error)
-	ApproveAndDispatchMultisigProposal(ctx context.Context, in *MsgApproveAndDispatchMultisigProposalParams, opts ...grpc.CallOption) (*MsgApproveAndDispatchMultisigProposalResponse, error)
-	CancelMultisigProposal(ctx context.Context, in *MsgCancelMultisigProposalParams, opts ...grpc.CallOption) (*MsgCancelMultisigProposalResponse, error)
-	CleanupMultisigProposal(ctx context.Context, in *MsgCleanupMultisigProposalParams, opts ...grpc.CallOption) (*MsgCleanupMultisigProposalResponse, error)
+// MsgScheduleMultisigCallParams defines the request type to execute a call of a multisig account on a schedule
+type MsgScheduleMultisigCallParams struct {
+	MultisigAddress string     `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	Message         *types.Any `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// start_height is the height of the first execution, the current block when zero
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// start_time is the earliest block time of the first execution, when set
+	StartTime *time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// interval_blocks is the number of blocks between executions, zero for a single execution
+	IntervalBlocks uint64 `protobuf:"varint,5,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
+	// max_runs is the number of executions of a recurring call
+	MaxRuns uint64 `protobuf:"varint,6,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`
+}
+
+func (m *MsgScheduleMultisigCallParams) Reset()         { *m = MsgScheduleMultisigCallParams{} }
+func (m *MsgScheduleMultisigCallParams) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleMultisigCallParams) ProtoMessage()    {}
+func (*MsgScheduleMultisigCallParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f023d0392a638bd4, []int{20}
+}
+func (m *MsgScheduleMultisigCallParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgScheduleMultisigCallParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleMultisigCallParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgScheduleMultisigCallParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleMultisigCallParams.Merge(m, src)
 }
-
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgScheduleMultisigCallParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleMultisigCallParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleMultisigCallParams.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateMultisigAccount(ctx context.Context, in *MsgCreateMultisigAccountParams, opts ...grpc.CallOption) (*MsgCreateMultisigAccountResponse, error) {
-	out := new(MsgCreateMultisigAccountResponse)
-	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/CreateMultisigAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgScheduleMultisigCallParams proto.InternalMessageInfo
+
+func (m *MsgScheduleMultisigCallParams) GetMultisigAddress() string {
+	if m != nil {
+		return m.MultisigAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddMultisigSigner(ctx context.Context, in *MsgAddMultisigSignerParams, opts ...grpc.CallOption) (*MsgAddMultisigSignerResponse, error) {
-	out := new(MsgAddMultisigSignerResponse)
-	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/AddMultisigSigner", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleMultisigCallParams) GetMessage() *types.Any {
+	if m != nil {
+		return m.Message
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) CleanupMultisigSigner(ctx context.Context, in *MsgCleanupMultisigAccountParams, opts ...grpc.CallOption) (*MsgCleanupMultisigAccountResponse, error) {
-	out := new(MsgCleanupMultisigAccountResponse)
-	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/CleanupMultisigSigner", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleMultisigCallParams) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) SetThreshold(ctx context.Context, in *MsgSetMultisigThresholdParams, opts ...grpc.CallOption) (*MsgSetMultisigThresholdResponse, error) {
-	out := new(MsgSetMultisigThresholdResponse)
-	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/SetThreshold", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleMultisigCallParams) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) InitializeMultisigProposal(ctx context.Context, in *MsgInitializeMultisigProposalParams, opts ...grpc.CallOption) (*MsgInitializeMultisigResponse, error) {
-	out := new(MsgInitializeMultisigResponse)
-	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/InitializeMultisigProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleMultisigCallParams) GetIntervalBlocks() uint64 {
+	if m != nil {
+		return m.IntervalBlocks
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) ApproveMultisigProposal(ctx context.Context, in *MsgApproveMultisigProposalParams, opts ...grpc.CallOption) (*MsgApproveMultisigProposalResponse, error) {
-	out := new(MsgApproveMultisigProposalResponse)
-	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/ApproveMultisigProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleMultisigCallParams) GetMaxRuns() uint64 {
+	if m != nil {
+		return m.MaxRuns
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) ApproveAndDispatchMultisigProposal(ctx context.Context, in *MsgApproveAndDispatchMultisigProposalParams, opts ...grpc.CallOption) (*MsgApproveAndDispatchMultisigProposalResponse, error) {
-	out := new(MsgApproveAndDispatchMultisigProposalResponse)
-	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/ApproveAndDispatchMultisigProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgScheduleMultisigCallResponse defines the response structure of scheduling a multisig call
+type MsgScheduleMultisigCallResponse struct {
+	ScheduleId uint64 `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
 }
 
-func (c *msgClient) CancelMultisigProposal(ctx context.Context, in *MsgCancelMultisigProposalParams, opts ...grpc.CallOption) (*MsgCancelMultisigProposalResponse, error) {
-	out := new(MsgCancelMultisigProposalResponse)
-	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/CancelMultisigProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleMultisigCallResponse) Reset()         { *m = MsgScheduleMultisigCallResponse{} }
+func (m *MsgScheduleMultisigCallResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleMultisigCallResponse) ProtoMessage()    {}
+func (*MsgScheduleMultisigCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f023d0392a638bd4, []int{21}
+}
+func (m *MsgScheduleMultisigCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleMultisigCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleMultisigCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgScheduleMultisigCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleMultisigCallResponse.Merge(m, src)
+}
+func (m *MsgScheduleMultisigCallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleMultisigCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleMultisigCallResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CleanupMultisigProposal(ctx context.Context, in *MsgCleanupMultisigProposalParams, opts ...grpc.CallOption) (*MsgCleanupMultisigProposalResponse, error) {
-	out := new(MsgCleanupMultisigProposalResponse)
-	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/CleanupMultisigProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgScheduleMultisigCallResponse proto.InternalMessageInfo
+
+func (m *MsgScheduleMultisigCallResponse) GetScheduleId() uint64 {
+	if m != nil {
+		return m.ScheduleId
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a governance operation for updating the parameters.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// Multisig account
-	CreateMultisigAccount(context.Context, *MsgCreateMultisigAccountParams) (*MsgCreateMultisigAccountResponse, error)
-	AddMultisigSigner(context.Context, *MsgAddMultisigSignerParams) (*MsgAddMultisigSignerResponse, error)
-	CleanupMultisigSigner(context.Context, *MsgCleanupMultisigAccountParams) (*MsgCleanupMultisigAccountResponse, error)
-	SetThreshold(context.Context, *MsgSetMultisigThresholdParams) (*MsgSetMultisigThresholdResponse, error)
-	InitializeMultisigProposal(context.Context, *MsgInitializeMultisigProposalParams) (*MsgInitializeMultisigResponse, error)
-	ApproveMultisigProposal(context.Context, *MsgApproveMultisigProposalParams) (*MsgApproveMultisigProposalResponse, error)
-	ApproveAndDispatchMultisigProposal(context.Context, *MsgApproveAndDispatchMultisigProposalParams) (*MsgApproveAndDispatchMultisigProposalResponse, error)
-	CancelMultisigProposal(context.Context, *MsgCancelMultisigProposalParams) (*MsgCancelMultisigProposalResponse, error)
-	CleanupMultisigProposal(context.Context, *MsgCleanupMultisigProposalParams) (*MsgCleanupMultisigProposalResponse, error)
+// MsgCancelMultisigScheduleParams defines the request type to cancel the remaining executions of a schedule
+type MsgCancelMultisigScheduleParams struct {
+	MultisigAddress string `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	ScheduleId      uint64 `protobuf:"varint,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgCancelMultisigScheduleParams) Reset()         { *m = MsgCancelMultisigScheduleParams{} }
+func (m *MsgCancelMultisigScheduleParams) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelMultisigScheduleParams) ProtoMessage()    {}
+func (*MsgCancelMultisigScheduleParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f023d0392a638bd4, []int{22}
+}
+func (m *MsgCancelMultisigScheduleParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelMultisigScheduleParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelMultisigScheduleParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelMultisigScheduleParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelMultisigScheduleParams.Merge(m, src)
+}
+func (m *MsgCancelMultisigScheduleParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelMultisigScheduleParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelMultisigScheduleParams.DiscardUnknown(m)
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+var xxx_messageInfo_MsgCancelMultisigScheduleParams proto.InternalMessageInfo
+
+func (m *MsgCancelMultisigScheduleParams) GetMultisigAddress() string {
+	if m != nil {
+		return m.MultisigAddress
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) CreateMultisigAccount(ctx context.Context, req *MsgCreateMultisigAccountParams) (*MsgCreateMultisigAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateMultisigAccount not implemented")
+
+func (m *MsgCancelMultisigScheduleParams) GetScheduleId() uint64 {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) AddMultisigSigner(ctx context.Context, req *MsgAddMultisigSignerParams) (*MsgAddMultisigSignerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMultisigSigner not implemented")
+
+// MsgCancelMultisigScheduleResponse defines the response structure of cancelling a schedule
+type MsgCancelMultisigScheduleResponse struct {
+	RemainingRuns uint64 `protobuf:"varint,1,opt,name=remaining_runs,json=remainingRuns,proto3" json:"remaining_runs,omitempty"`
 }
-func (*UnimplementedMsgServer) CleanupMultisigSigner(ctx context.Context, req *MsgCleanupMultisigAccountParams) (*MsgCleanupMultisigAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CleanupMultisigSigner not implemented")
+
+func (m *MsgCancelMultisigScheduleResponse) Reset()         { *m = MsgCancelMultisigScheduleResponse{} }
+func (m *MsgCancelMultisigScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelMultisigScheduleResponse) ProtoMessage()    {}
+func (*MsgCancelMultisigScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f023d0392a638bd4, []int{23}
 }
-func (*UnimplementedMsgServer) SetThreshold(ctx context.Context, req *MsgSetMultisigThresholdParams) (*MsgSetMultisigThresholdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetThreshold not implemented")
+func (m *MsgCancelMultisigScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) InitializeMultisigProposal(ctx context.Context, req *MsgInitializeMultisigProposalParams) (*MsgInitializeMultisigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InitializeMultisigProposal not implemented")
+func (m *MsgCancelMultisigScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelMultisigScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ApproveMultisigProposal(ctx context.Context, req *MsgApproveMultisigProposalParams) (*MsgApproveMultisigProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ApproveMultisigProposal not implemented")
+func (m *MsgCancelMultisigScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelMultisigScheduleResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ApproveAndDispatchMultisigProposal(ctx context.Context, req *MsgApproveAndDispatchMultisigProposalParams) (*MsgApproveAndDispatchMultisigProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ApproveAndDispatchMultisigProposal not implemented")
+func (m *MsgCancelMultisigScheduleResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) CancelMultisigProposal(ctx context.Context, req *MsgCancelMultisigProposalParams) (*MsgCancelMultisigProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelMultisigProposal not implemented")
+func (m *MsgCancelMultisigScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelMultisigScheduleResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) CleanupMultisigProposal(ctx context.Context, req *MsgCleanupMultisigProposalParams) (*MsgCleanupMultisigProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CleanupMultisigProposal not implemented")
+
+var xxx_messageInfo_MsgCancelMultisigScheduleResponse proto.InternalMessageInfo
+
+func (m *MsgCancelMultisigScheduleResponse) GetRemainingRuns() uint64 {
+	if m != nil {
+		return m.RemainingRuns
+	}
+	return 0
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+// MsgFreezeMultisigAccount is the Msg/FreezeMultisigAccount request type.
+type MsgFreezeMultisigAccount struct {
+	// authority is the address of the governance account.
+	Authority       string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	MultisigAddress string `protobuf:"bytes,2,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	Reason          string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/multisig.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgFreezeMultisigAccount) Reset()         { *m = MsgFreezeMultisigAccount{} }
+func (m *MsgFreezeMultisigAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeMultisigAccount) ProtoMessage()    {}
+func (*MsgFreezeMultisigAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f023d0392a638bd4, []int{24}
+}
+func (m *MsgFreezeMultisigAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeMultisigAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeMultisigAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgFreezeMultisigAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeMultisigAccount.Merge(m, src)
+}
+func (m *MsgFreezeMultisigAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeMultisigAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeMultisigAccount.DiscardUnknown(m)
 }
 
-func _Msg_CreateMultisigAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateMultisigAccountParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateMultisigAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/multisig.v1.Msg/CreateMultisigAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateMultisigAccount(ctx, req.(*MsgCreateMultisigAccountParams))
+var xxx_messageInfo_MsgFreezeMultisigAccount proto.InternalMessageInfo
+
+func (m *MsgFreezeMultisigAccount) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_AddMultisigSigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddMultisigSignerParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddMultisigSigner(ctx, in)
+func (m *MsgFreezeMultisigAccount) GetMultisigAddress() string {
+	if m != nil {
+		return m.MultisigAddress
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/multisig.v1.Msg/AddMultisigSigner",
+	return ""
+}
+
+func (m *MsgFreezeMultisigAccount) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddMultisigSigner(ctx, req.(*MsgAddMultisigSignerParams))
+	return ""
+}
+
+// MsgFreezeMultisigAccountResponse defines the response structure of freezing a multisig account
+type MsgFreezeMultisigAccountResponse struct {
+}
+
+func (m *MsgFreezeMultisigAccountResponse) Reset()         { *m = MsgFreezeMultisigAccountResponse{} }
+func (m *MsgFreezeMultisigAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeMultisigAccountResponse) ProtoMessage()    {}
+func (*MsgFreezeMultisigAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f023d0392a638bd4, []int{25}
+}
+func (m *MsgFreezeMultisigAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeMultisigAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeMultisigAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgFreezeMultisigAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeMultisigAccountResponse.Merge(m, src)
+}
+func (m *MsgFreezeMultisigAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeMultisigAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeMultisigAccountResponse.DiscardUnknown(m)
 }
 
-func _Msg_CleanupMultisigSigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCleanupMultisigAccountParams)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgFreezeMultisigAccountResponse proto.InternalMessageInfo
+
+// MsgRecoverMultisigAccount is the Msg/RecoverMultisigAccount request type.
+type MsgRecoverMultisigAccount struct {
+	// authority is the address of the governance account.
+	Authority       string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	MultisigAddress string `protobuf:"bytes,2,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	// signers replace the signer set of the account
+	Signers   []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+	Threshold uint32   `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// threshold_percentage is the fraction of the signers required to approve a call, in
+	// place of an absolute threshold. It is a decimal string such as "0.5", not annotated as
+	// cosmos.Dec since amino JSON would encode it as a scaled integer.
+	ThresholdPercentage string `protobuf:"bytes,5,opt,name=threshold_percentage,json=thresholdPercentage,proto3" json:"threshold_percentage,omitempty"`
+}
+
+func (m *MsgRecoverMultisigAccount) Reset()         { *m = MsgRecoverMultisigAccount{} }
+func (m *MsgRecoverMultisigAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverMultisigAccount) ProtoMessage()    {}
+func (*MsgRecoverMultisigAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f023d0392a638bd4, []int{26}
+}
+func (m *MsgRecoverMultisigAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverMultisigAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverMultisigAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CleanupMultisigSigner(ctx, in)
+}
+func (m *MsgRecoverMultisigAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverMultisigAccount.Merge(m, src)
+}
+func (m *MsgRecoverMultisigAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverMultisigAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverMultisigAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverMultisigAccount proto.InternalMessageInfo
+
+func (m *MsgRecoverMultisigAccount) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/multisig.v1.Msg/CleanupMultisigSigner",
+	return ""
+}
+
+func (m *MsgRecoverMultisigAccount) GetMultisigAddress() string {
+	if m != nil {
+		return m.MultisigAddress
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CleanupMultisigSigner(ctx, req.(*MsgCleanupMultisigAccountParams))
+	return ""
+}
+
+func (m *MsgRecoverMultisigAccount) GetSigners() []string {
+	if m != nil {
+		return m.Signers
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_SetThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetMultisigThresholdParams)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgRecoverMultisigAccount) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetThreshold(ctx, in)
+	return 0
+}
+
+func (m *MsgRecoverMultisigAccount) GetThresholdPercentage() string {
+	if m != nil {
+		return m.ThresholdPercentage
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/multisig.v1.Msg/SetThreshold",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetThreshold(ctx, req.(*MsgSetMultisigThresholdParams))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_InitializeMultisigProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInitializeMultisigProposalParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).InitializeMultisigProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/multisig.v1.Msg/InitializeMultisigProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InitializeMultisigProposal(ctx, req.(*MsgInitializeMultisigProposalParams))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgRecoverMultisigAccountResponse defines the response structure of recovering a multisig account
+type MsgRecoverMultisigAccountResponse struct {
 }
 
-func _Msg_ApproveMultisigProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgApproveMultisigProposalParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ApproveMultisigProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/multisig.v1.Msg/ApproveMultisigProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ApproveMultisigProposal(ctx, req.(*MsgApproveMultisigProposalParams))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgRecoverMultisigAccountResponse) Reset()         { *m = MsgRecoverMultisigAccountResponse{} }
+func (m *MsgRecoverMultisigAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverMultisigAccountResponse) ProtoMessage()    {}
+func (*MsgRecoverMultisigAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f023d0392a638bd4, []int{27}
 }
-
-func _Msg_ApproveAndDispatchMultisigProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgApproveAndDispatchMultisigProposalParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ApproveAndDispatchMultisigProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/multisig.v1.Msg/ApproveAndDispatchMultisigProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ApproveAndDispatchMultisigProposal(ctx, req.(*MsgApproveAndDispatchMultisigProposalParams))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgRecoverMultisigAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Msg_CancelMultisigProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelMultisigProposalParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelMultisigProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/multisig.v1.Msg/CancelMultisigProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelMultisigProposal(ctx, req.(*MsgCancelMultisigProposalParams))
+func (m *MsgRecoverMultisigAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverMultisigAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Msg_CleanupMultisigProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCleanupMultisigProposalParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CleanupMultisigProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/multisig.v1.Msg/CleanupMultisigProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CleanupMultisigProposal(ctx, req.(*MsgCleanupMultisigProposalParams))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgRecoverMultisigAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverMultisigAccountResponse.Merge(m, src)
 }
-
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "multisig.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateMultisigAccount",
-			Handler:    _Msg_CreateMultisigAccount_Handler,
-		},
-		{
-			MethodName: "AddMultisigSigner",
-			Handler:    _Msg_AddMultisigSigner_Handler,
-		},
-		{
-			MethodName: "CleanupMultisigSigner",
-			Handler:    _Msg_CleanupMultisigSigner_Handler,
-		},
-		{
-			MethodName: "SetThreshold",
-			Handler:    _Msg_SetThreshold_Handler,
-		},
-		{
-			MethodName: "InitializeMultisigProposal",
-			Handler:    _Msg_InitializeMultisigProposal_Handler,
-		},
-		{
-			MethodName: "ApproveMultisigProposal",
-			Handler:    _Msg_ApproveMultisigProposal_Handler,
-		},
-		{
-			MethodName: "ApproveAndDispatchMultisigProposal",
-			Handler:    _Msg_ApproveAndDispatchMultisigProposal_Handler,
-		},
-		{
-			MethodName: "CancelMultisigProposal",
-			Handler:    _Msg_CancelMultisigProposal_Handler,
-		},
-		{
-			MethodName: "CleanupMultisigProposal",
-			Handler:    _Msg_CleanupMultisigProposal_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "multisig/v1/tx.proto",
+func (m *MsgRecoverMultisigAccountResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRecoverMultisigAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverMultisigAccountResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRecoverMultisigAccountResponse proto.InternalMessageInfo
+
+// MsgCancelMultisigProposalParams defines the request type to reject a multisig proposal
+type MsgCancelMultisigProposalParams struct {
+	MultisigAddress string `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	ProposalId      uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Rejecter        string `protobuf:"bytes,3,opt,name=rejecter,proto3" json:"rejecter,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgCancelMultisigProposalParams) Reset()         { *m = MsgCancelMultisigProposalParams{} }
+func (m *MsgCancelMultisigProposalParams) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelMultisigProposalParams) ProtoMessage()    {}
+func (*MsgCancelMultisigProposalParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f023d0392a638bd4, []int{28}
+}
+func (m *MsgCancelMultisigProposalParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelMultisigProposalParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelMultisigProposalParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCancelMultisigProposalParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelMultisigProposalParams.Merge(m, src)
+}
+func (m *MsgCancelMultisigProposalParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelMultisigProposalParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelMultisigProposalParams.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelMultisigProposalParams proto.InternalMessageInfo
+
+func (m *MsgCancelMultisigProposalParams) GetMultisigAddress() string {
+	if m != nil {
+		return m.MultisigAddress
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCancelMultisigProposalParams) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
 }
 
-func (m *MsgCreateMultisigAccountParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelMultisigProposalParams) GetRejecter() string {
+	if m != nil {
+		return m.Rejecter
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateMultisigAccountParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCancelMultisigProposalResponse defines the response structure of rejecting a multisig proposal
+type MsgCancelMultisigProposalResponse struct {
+	// rejected is true when the rejections reached the threshold and the proposal was removed.
+	Rejected bool `protobuf:"varint,1,opt,name=rejected,proto3" json:"rejected,omitempty"`
 }
 
-func (m *MsgCreateMultisigAccountParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Permission != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Permission))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+func (m *MsgCancelMultisigProposalResponse) Reset()         { *m = MsgCancelMultisigProposalResponse{} }
+func (m *MsgCancelMultisigProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelMultisigProposalResponse) ProtoMessage()    {}
+func (*MsgCancelMultisigProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f023d0392a638bd4, []int{29}
+}
+func (m *MsgCancelMultisigProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelMultisigProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelMultisigProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Threshold != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Seed != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Seed))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateMultisigAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCancelMultisigProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelMultisigProposalResponse.Merge(m, src)
 }
-
-func (m *MsgCreateMultisigAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelMultisigProposalResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgCreateMultisigAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MultisigAddress) > 0 {
-		i -= len(m.MultisigAddress)
-		copy(dAtA[i:], m.MultisigAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MultisigAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgCancelMultisigProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelMultisigProposalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddMultisigSignerParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelMultisigProposalResponse proto.InternalMessageInfo
+
+func (m *MsgCancelMultisigProposalResponse) GetRejected() bool {
+	if m != nil {
+		return m.Rejected
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgAddMultisigSignerParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCleanupMultisigProposalParams defines the request type to clear all multisig proposals after account deletion
+type MsgCleanupMultisigProposalParams struct {
+	MultisigAddress string `protobuf:"bytes,1,opt,name=multisig_address,json=multisigAddress,proto3" json:"multisig_address,omitempty"`
+	ProposalId      uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Remover         string `protobuf:"bytes,3,opt,name=remover,proto3" json:"remover,omitempty"`
 }
 
-func (m *MsgAddMultisigSignerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NewThreshold != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.NewThreshold))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.MultisigAddress) > 0 {
-		i -= len(m.MultisigAddress)
-		copy(dAtA[i:], m.MultisigAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MultisigAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgCleanupMultisigProposalParams) Reset()         { *m = MsgCleanupMultisigProposalParams{} }
+func (m *MsgCleanupMultisigProposalParams) String() string { return proto.CompactTextString(m) }
+func (*MsgCleanupMultisigProposalParams) ProtoMessage()    {}
+func (*MsgCleanupMultisigProposalParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f023d0392a638bd4, []int{30}
 }
-
-func (m *MsgAddMultisigSignerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCleanupMultisigProposalParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCleanupMultisigProposalParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCleanupMultisigProposalParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgAddMultisigSignerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCleanupMultisigProposalParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCleanupMultisigProposalParams.Merge(m, src)
 }
-
-func (m *MsgAddMultisigSignerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCleanupMultisigProposalParams) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgCleanupMultisigAccountParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCleanupMultisigProposalParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCleanupMultisigProposalParams.DiscardUnknown(m)
 }
 
-func (m *MsgCleanupMultisigAccountParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_MsgCleanupMultisigProposalParams proto.InternalMessageInfo
 
-func (m *MsgCleanupMultisigAccountParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MultisigAddress) > 0 {
-		i -= len(m.MultisigAddress)
-		copy(dAtA[i:], m.MultisigAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MultisigAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgCleanupMultisigProposalParams) GetMultisigAddress() string {
+	if m != nil {
+		return m.MultisigAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCleanupMultisigAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCleanupMultisigProposalParams) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCleanupMultisigAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCleanupMultisigProposalParams) GetRemover() string {
+	if m != nil {
+		return m.Remover
+	}
+	return ""
 }
 
-func (m *MsgCleanupMultisigAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgCleanupMultisigProposalResponse defines the response structure of removed proposal of deleted multisig account
+type MsgCleanupMultisigProposalResponse struct {
 }
 
-func (m *MsgSetMultisigThresholdParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCleanupMultisigProposalResponse) Reset()         { *m = MsgCleanupMultisigProposalResponse{} }
+func (m *MsgCleanupMultisigProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCleanupMultisigProposalResponse) ProtoMessage()    {}
+func (*MsgCleanupMultisigProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f023d0392a638bd4, []int{31}
 }
-
-func (m *MsgSetMultisigThresholdParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCleanupMultisigProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgSetMultisigThresholdParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Threshold != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.MultisigAddress) > 0 {
-		i -= len(m.MultisigAddress)
-		copy(dAtA[i:], m.MultisigAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MultisigAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgCleanupMultisigProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCleanupMultisigProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCleanupMultisigProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCleanupMultisigProposalResponse.Merge(m, src)
+}
+func (m *MsgCleanupMultisigProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCleanupMultisigProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCleanupMultisigProposalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetMultisigThresholdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCleanupMultisigProposalResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "multisig.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "multisig.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateMultisigAccountParams)(nil), "multisig.v1.MsgCreateMultisigAccountParams")
+	proto.RegisterType((*MsgCreateMultisigAccountResponse)(nil), "multisig.v1.MsgCreateMultisigAccountResponse")
+	proto.RegisterType((*MsgAddMultisigSignerParams)(nil), "multisig.v1.MsgAddMultisigSignerParams")
+	proto.RegisterType((*MsgAddMultisigSignerResponse)(nil), "multisig.v1.MsgAddMultisigSignerResponse")
+	proto.RegisterType((*MsgCleanupMultisigAccountParams)(nil), "multisig.v1.MsgCleanupMultisigAccountParams")
+	proto.RegisterType((*MsgCleanupMultisigAccountResponse)(nil), "multisig.v1.MsgCleanupMultisigAccountResponse")
+	proto.RegisterType((*MsgSetMultisigThresholdParams)(nil), "multisig.v1.MsgSetMultisigThresholdParams")
+	proto.RegisterType((*MsgSetMultisigThresholdResponse)(nil), "multisig.v1.MsgSetMultisigThresholdResponse")
+	proto.RegisterType((*MsgInitializeMultisigProposalParams)(nil), "multisig.v1.MsgInitializeMultisigProposalParams")
+	proto.RegisterType((*MsgInitializeMultisigResponse)(nil), "multisig.v1.MsgInitializeMultisigResponse")
+	proto.RegisterType((*MsgApproveMultisigProposalParams)(nil), "multisig.v1.MsgApproveMultisigProposalParams")
+	proto.RegisterType((*MsgApproveMultisigProposalResponse)(nil), "multisig.v1.MsgApproveMultisigProposalResponse")
+	proto.RegisterType((*MsgApproveAndDispatchMultisigProposalParams)(nil), "multisig.v1.MsgApproveAndDispatchMultisigProposalParams")
+	proto.RegisterType((*MsgApproveAndDispatchMultisigProposalResponse)(nil), "multisig.v1.MsgApproveAndDispatchMultisigProposalResponse")
+	proto.RegisterType((*MsgBatchApproveMultisigProposalParams)(nil), "multisig.v1.MsgBatchApproveMultisigProposalParams")
+	proto.RegisterType((*ApprovalSignature)(nil), "multisig.v1.ApprovalSignature")
+	proto.RegisterType((*ApprovalSignDoc)(nil), "multisig.v1.ApprovalSignDoc")
+	proto.RegisterType((*MsgBatchApproveMultisigProposalResponse)(nil), "multisig.v1.MsgBatchApproveMultisigProposalResponse")
+	proto.RegisterType((*MsgScheduleMultisigCallParams)(nil), "multisig.v1.MsgScheduleMultisigCallParams")
+	proto.RegisterType((*MsgScheduleMultisigCallResponse)(nil), "multisig.v1.MsgScheduleMultisigCallResponse")
+	proto.RegisterType((*MsgCancelMultisigScheduleParams)(nil), "multisig.v1.MsgCancelMultisigScheduleParams")
+	proto.RegisterType((*MsgCancelMultisigScheduleResponse)(nil), "multisig.v1.MsgCancelMultisigScheduleResponse")
+	proto.RegisterType((*MsgFreezeMultisigAccount)(nil), "multisig.v1.MsgFreezeMultisigAccount")
+	proto.RegisterType((*MsgFreezeMultisigAccountResponse)(nil), "multisig.v1.MsgFreezeMultisigAccountResponse")
+	proto.RegisterType((*MsgRecoverMultisigAccount)(nil), "multisig.v1.MsgRecoverMultisigAccount")
+	proto.RegisterType((*MsgRecoverMultisigAccountResponse)(nil), "multisig.v1.MsgRecoverMultisigAccountResponse")
+	proto.RegisterType((*MsgCancelMultisigProposalParams)(nil), "multisig.v1.MsgCancelMultisigProposalParams")
+	proto.RegisterType((*MsgCancelMultisigProposalResponse)(nil), "multisig.v1.MsgCancelMultisigProposalResponse")
+	proto.RegisterType((*MsgCleanupMultisigProposalParams)(nil), "multisig.v1.MsgCleanupMultisigProposalParams")
+	proto.RegisterType((*MsgCleanupMultisigProposalResponse)(nil), "multisig.v1.MsgCleanupMultisigProposalResponse")
+}
+
+func init() { proto.RegisterFile("multisig/v1/tx.proto", fileDescriptor_f023d0392a638bd4) }
+
+var fileDescriptor_f023d0392a638bd4 = []byte{
+	// 1756 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcb, 0x6f, 0xdb, 0xcc,
+	0x11, 0x37, 0x25, 0xf9, 0xa1, 0xb1, 0x1d, 0x27, 0x8c, 0x9d, 0xc8, 0x8c, 0x23, 0xcb, 0x4c, 0xd2,
+	0x38, 0x8e, 0x2d, 0xc5, 0x4a, 0x10, 0x14, 0xba, 0xa4, 0x56, 0x8c, 0x22, 0x6e, 0x21, 0x20, 0xa0,
+	0xd3, 0x4b, 0x2e, 0xc2, 0x9a, 0xda, 0x52, 0x6c, 0xc5, 0x07, 0xb8, 0x2b, 0xc7, 0xce, 0xa9, 0xe8,
+	0xa9, 0x28, 0x50, 0x20, 0xe7, 0xa2, 0x7f, 0x40, 0x4f, 0x6d, 0x0e, 0x41, 0x6f, 0xbd, 0x07, 0x45,
+	0x0f, 0x41, 0x2f, 0xed, 0xa5, 0x0f, 0x38, 0x87, 0xa0, 0x2d, 0xfa, 0x1f, 0xf4, 0x50, 0x70, 0xc9,
+	0x5d, 0x53, 0x14, 0x29, 0x31, 0x8d, 0xf2, 0xe5, 0xfb, 0x2e, 0x86, 0x38, 0x33, 0xbb, 0x33, 0xf3,
+	0x9b, 0x99, 0xdd, 0x99, 0x35, 0x2c, 0x5b, 0xfd, 0x1e, 0x35, 0x89, 0x69, 0xd4, 0x8e, 0x77, 0x6b,
+	0xf4, 0xa4, 0xea, 0x7a, 0x0e, 0x75, 0xe4, 0x79, 0x4e, 0xad, 0x1e, 0xef, 0x2a, 0x97, 0x90, 0x65,
+	0xda, 0x4e, 0x8d, 0xfd, 0x0d, 0xf8, 0xca, 0x55, 0xdd, 0x21, 0x96, 0x43, 0x6a, 0x16, 0x61, 0xeb,
+	0x2c, 0x62, 0x84, 0x8c, 0x55, 0xc3, 0x71, 0x8c, 0x1e, 0xae, 0xb1, 0xaf, 0xa3, 0xfe, 0x0f, 0x6b,
+	0xc8, 0x3e, 0x0d, 0x59, 0xeb, 0x71, 0x16, 0x35, 0x2d, 0x4c, 0x28, 0xb2, 0x5c, 0xbe, 0x36, 0x6a,
+	0x8a, 0x81, 0x6d, 0x4c, 0x4c, 0xc2, 0xf5, 0x45, 0x59, 0x84, 0x22, 0x8a, 0x43, 0xc6, 0xb2, 0xe1,
+	0x18, 0x0e, 0xfb, 0x59, 0xf3, 0x7f, 0xf1, 0x9d, 0x02, 0xf3, 0xda, 0x01, 0x23, 0xf8, 0x08, 0x58,
+	0xea, 0x6f, 0x24, 0x58, 0x6a, 0x11, 0xe3, 0x07, 0x6e, 0x07, 0x51, 0xfc, 0x14, 0x79, 0xc8, 0x22,
+	0xf2, 0x43, 0x28, 0xa2, 0x3e, 0xed, 0x3a, 0x9e, 0x49, 0x4f, 0x4b, 0x52, 0x45, 0xda, 0x2c, 0x36,
+	0x4b, 0x7f, 0x7a, 0xb3, 0xb3, 0x1c, 0x2e, 0xdc, 0xeb, 0x74, 0x3c, 0x4c, 0xc8, 0x21, 0xf5, 0x4c,
+	0xdb, 0xd0, 0xce, 0x45, 0xe5, 0x87, 0x30, 0xe3, 0xb2, 0x1d, 0x4a, 0xb9, 0x8a, 0xb4, 0x39, 0x5f,
+	0xbf, 0x5c, 0x8d, 0xc0, 0x56, 0x0d, 0x36, 0x6f, 0x16, 0xdf, 0xfe, 0x6d, 0x7d, 0xea, 0xd7, 0x1f,
+	0x5e, 0x6f, 0x49, 0x5a, 0x28, 0xdd, 0xb8, 0xfb, 0xd3, 0x0f, 0xaf, 0xb7, 0xce, 0xf7, 0xf9, 0xf9,
+	0x87, 0xd7, 0x5b, 0x25, 0xe1, 0x60, 0xcc, 0x38, 0x75, 0x15, 0xae, 0xc6, 0x48, 0x1a, 0x26, 0xae,
+	0x63, 0x13, 0xac, 0x9e, 0xe5, 0xa0, 0xdc, 0x22, 0xc6, 0x63, 0x0f, 0x23, 0x8a, 0x5b, 0xe1, 0x06,
+	0x7b, 0xba, 0xee, 0xf4, 0x6d, 0xfa, 0x89, 0xae, 0xc9, 0x50, 0x20, 0x18, 0x77, 0x98, 0x63, 0x8b,
+	0x1a, 0xfb, 0x2d, 0xaf, 0x41, 0x91, 0x76, 0x3d, 0x4c, 0xba, 0x4e, 0xaf, 0x53, 0xca, 0x33, 0xc6,
+	0x39, 0x41, 0xae, 0xc3, 0x2c, 0x31, 0x0d, 0x1b, 0x7b, 0xa4, 0x54, 0xa8, 0xe4, 0x37, 0x17, 0x46,
+	0xe8, 0xe1, 0x82, 0xf2, 0x1e, 0x80, 0x8b, 0x3d, 0xcb, 0x24, 0xc4, 0x74, 0xec, 0xd2, 0x74, 0x45,
+	0xda, 0xbc, 0x50, 0xdf, 0x18, 0x00, 0x91, 0x7b, 0xf5, 0xd4, 0x73, 0x5c, 0x87, 0xa0, 0xde, 0xb3,
+	0x53, 0x17, 0x6b, 0x91, 0x45, 0xf2, 0x2e, 0x2c, 0x0b, 0x1b, 0xda, 0x2e, 0xf6, 0x74, 0x6c, 0x53,
+	0x64, 0xe0, 0xd2, 0x8c, 0xef, 0xab, 0x76, 0x59, 0xf0, 0x9e, 0x0a, 0x56, 0x63, 0x7b, 0x18, 0xfe,
+	0xd5, 0x28, 0xfc, 0x01, 0x9e, 0x21, 0x8e, 0xaa, 0x01, 0x95, 0x34, 0x8c, 0x79, 0x20, 0xe4, 0xc7,
+	0x70, 0x91, 0x6f, 0xd0, 0x46, 0x81, 0xab, 0x63, 0xc1, 0x5e, 0xe2, 0x2b, 0x42, 0xb2, 0xfa, 0x4f,
+	0x09, 0x94, 0x16, 0xf1, 0x3f, 0xb9, 0x9a, 0x43, 0x06, 0x53, 0x18, 0xc9, 0x49, 0xe8, 0x90, 0xef,
+	0xc1, 0x4c, 0x80, 0x7d, 0x29, 0x37, 0x66, 0x69, 0x28, 0x27, 0xdf, 0x80, 0x45, 0x1b, 0xbf, 0x68,
+	0xc7, 0x03, 0xbf, 0x60, 0xe3, 0x17, 0xcf, 0x38, 0xad, 0x51, 0xf3, 0x11, 0x1d, 0x32, 0xcf, 0x07,
+	0x76, 0x25, 0x0a, 0xec, 0x5e, 0xa7, 0x13, 0xb8, 0xa4, 0x96, 0x61, 0x2d, 0xc9, 0x55, 0x91, 0xd9,
+	0xbf, 0x92, 0x60, 0xdd, 0x47, 0xbd, 0x87, 0x91, 0xdd, 0x77, 0x93, 0x53, 0x7b, 0x12, 0x80, 0x34,
+	0x1e, 0xa4, 0x5a, 0xae, 0x0c, 0xa4, 0x44, 0x60, 0x08, 0xcf, 0x89, 0x1b, 0xb0, 0x91, 0x6a, 0x9d,
+	0xf0, 0xe1, 0x77, 0x12, 0x5c, 0x6f, 0x11, 0xe3, 0x10, 0x53, 0x2e, 0x21, 0x00, 0x9b, 0x64, 0x48,
+	0x07, 0xaa, 0x32, 0x17, 0xab, 0xca, 0x46, 0x3d, 0xd5, 0xbf, 0x81, 0x13, 0xe7, 0x10, 0x53, 0x61,
+	0x9c, 0xba, 0x01, 0xeb, 0x29, 0x76, 0x0b, 0xdf, 0xfe, 0x9c, 0x83, 0x1b, 0x2d, 0x62, 0x1c, 0xd8,
+	0x26, 0x35, 0x51, 0xcf, 0x7c, 0x89, 0xe3, 0x75, 0x3a, 0x49, 0x0f, 0x1f, 0xc0, 0x9c, 0xcb, 0xb6,
+	0xcd, 0x90, 0xb6, 0x42, 0x52, 0x5e, 0x86, 0x69, 0x6a, 0xd2, 0x1e, 0x66, 0x09, 0x5b, 0xd4, 0x82,
+	0x0f, 0xb9, 0x02, 0xf3, 0x1d, 0x4c, 0x74, 0xcf, 0x74, 0xa9, 0x7f, 0xe4, 0x14, 0x18, 0x2f, 0x4a,
+	0x92, 0xbf, 0x0f, 0xb3, 0x16, 0x26, 0xc4, 0x3f, 0x43, 0xa6, 0xd9, 0xa9, 0xbe, 0x5c, 0x0d, 0x2e,
+	0xae, 0x2a, 0xbf, 0xb8, 0xaa, 0x7b, 0xf6, 0x69, 0xf3, 0xda, 0x1f, 0xde, 0xec, 0x84, 0xb7, 0x60,
+	0xf5, 0x08, 0x11, 0x5c, 0x3d, 0xde, 0x3d, 0xc2, 0x14, 0xed, 0x56, 0x5b, 0xc4, 0xd0, 0xf8, 0x0e,
+	0x8d, 0x7b, 0x3e, 0xfc, 0xc2, 0x26, 0x1f, 0xf6, 0x72, 0x14, 0xf6, 0x73, 0xfc, 0x38, 0x6e, 0xea,
+	0x77, 0xe0, 0xfa, 0x00, 0x83, 0x03, 0x2b, 0xce, 0x9a, 0x75, 0x98, 0x77, 0x43, 0xe1, 0xb6, 0xd9,
+	0x61, 0x68, 0x16, 0x34, 0xe0, 0xa4, 0x83, 0x8e, 0xfa, 0x1f, 0x89, 0x9d, 0x58, 0x7b, 0xae, 0xeb,
+	0x39, 0xc7, 0x9f, 0x35, 0x30, 0x31, 0x53, 0x72, 0x71, 0x53, 0xfc, 0xc8, 0xa1, 0xc0, 0x0c, 0xaf,
+	0x94, 0x1f, 0xb3, 0xbb, 0x90, 0x6c, 0xec, 0x30, 0xd0, 0xf8, 0xa7, 0x0f, 0xda, 0xb5, 0x81, 0x53,
+	0x24, 0x60, 0x08, 0xc4, 0x6e, 0x82, 0x9a, 0xee, 0xae, 0xc8, 0xd8, 0xb7, 0x39, 0xb8, 0x7b, 0x2e,
+	0xb6, 0x67, 0x77, 0xf6, 0x4d, 0xe2, 0x22, 0xaa, 0x77, 0xbf, 0x79, 0x00, 0x7d, 0x8e, 0x14, 0x8d,
+	0xa2, 0x5d, 0x4e, 0x40, 0x3b, 0x02, 0x98, 0xfa, 0x1c, 0x76, 0x32, 0x21, 0x29, 0x52, 0xf6, 0x0e,
+	0x5c, 0xa4, 0x1e, 0xb2, 0x09, 0xd2, 0xfd, 0x0a, 0x6b, 0x77, 0x11, 0xe9, 0x06, 0x58, 0x6a, 0x4b,
+	0x11, 0xfa, 0x13, 0x44, 0xba, 0xea, 0x2f, 0xf2, 0x70, 0xab, 0x45, 0x8c, 0xa6, 0xbf, 0xdf, 0xe8,
+	0x0c, 0x7e, 0x08, 0x45, 0xd2, 0x3f, 0xb2, 0x4c, 0x4a, 0xb1, 0x37, 0xbe, 0xb3, 0x11, 0xa2, 0x89,
+	0x81, 0xcd, 0x7d, 0x62, 0x60, 0xf3, 0x43, 0x81, 0xdd, 0x07, 0xf0, 0x2f, 0x50, 0x44, 0xfb, 0x1e,
+	0x0e, 0x1a, 0xa2, 0xf9, 0x7a, 0x79, 0xa0, 0xb3, 0x09, 0xbc, 0x43, 0xbd, 0x43, 0x2e, 0xd6, 0x2c,
+	0xf8, 0x9d, 0xa2, 0x16, 0x59, 0x37, 0xd9, 0x40, 0xdf, 0x67, 0x6d, 0x8f, 0x00, 0xc2, 0x8f, 0x74,
+	0x25, 0x1a, 0xe9, 0x28, 0xe6, 0xa2, 0xb8, 0x74, 0xb8, 0x34, 0x64, 0x68, 0xa4, 0x8b, 0x90, 0x32,
+	0x76, 0x11, 0x6b, 0x50, 0x14, 0x6e, 0x31, 0xb4, 0x17, 0xb4, 0x73, 0x82, 0xfa, 0x5b, 0x09, 0x96,
+	0xa2, 0x5a, 0xf6, 0x1d, 0x5d, 0x5e, 0x85, 0x39, 0xbd, 0x8b, 0x4c, 0x9b, 0x9f, 0x71, 0x45, 0x6d,
+	0x96, 0x7d, 0x1f, 0x74, 0xbe, 0xa2, 0x08, 0x5e, 0x83, 0xa2, 0x8e, 0x7a, 0xbd, 0x20, 0x5b, 0x0b,
+	0xcc, 0xe4, 0x39, 0x9f, 0xc0, 0xd2, 0x94, 0xc2, 0xed, 0x31, 0x59, 0x2a, 0x92, 0xbf, 0x0c, 0xd0,
+	0x09, 0x0b, 0x04, 0x07, 0xae, 0xcc, 0x69, 0x11, 0x4a, 0x62, 0x71, 0xe4, 0x52, 0x8b, 0x83, 0x75,
+	0x14, 0xfe, 0xba, 0x7e, 0x4f, 0xa8, 0x7c, 0x8c, 0x7a, 0x13, 0x3d, 0xb5, 0x22, 0x59, 0x97, 0xfb,
+	0xd4, 0xac, 0x93, 0x37, 0x60, 0x81, 0x50, 0xe4, 0xd1, 0x76, 0x17, 0x9b, 0x46, 0x97, 0x32, 0xa0,
+	0xf3, 0xda, 0x3c, 0xa3, 0x3d, 0x61, 0x24, 0xf9, 0x11, 0x40, 0x20, 0x42, 0x4d, 0x0b, 0x33, 0xa8,
+	0xe7, 0xeb, 0xca, 0x90, 0xca, 0x67, 0x7c, 0x5a, 0x6c, 0x16, 0x5e, 0xfd, 0x7d, 0x5d, 0xd2, 0x8a,
+	0x6c, 0x8d, 0x4f, 0x95, 0x6f, 0xc3, 0x92, 0x69, 0x53, 0xec, 0x1d, 0xa3, 0x5e, 0xfb, 0xa8, 0xe7,
+	0xe8, 0x3f, 0x26, 0xac, 0x5c, 0x0a, 0xda, 0x05, 0x4e, 0x6e, 0x32, 0xaa, 0x9f, 0x54, 0x16, 0x3a,
+	0x69, 0x7b, 0x7d, 0x9b, 0xb0, 0x01, 0xa1, 0xa0, 0xcd, 0x5a, 0xe8, 0x44, 0xeb, 0xdb, 0x24, 0x73,
+	0xa3, 0x14, 0x62, 0xef, 0x63, 0xae, 0x36, 0x61, 0x3d, 0x42, 0x8a, 0x86, 0x23, 0x7a, 0x5b, 0x93,
+	0x90, 0x1f, 0xb9, 0xad, 0x39, 0xe9, 0xa0, 0xa3, 0xfe, 0x3e, 0xec, 0x74, 0x91, 0xad, 0xe3, 0x9e,
+	0xe8, 0x86, 0x43, 0xfe, 0x84, 0xef, 0xa2, 0xa8, 0x25, 0xb9, 0xb8, 0x25, 0x99, 0x5b, 0x61, 0x66,
+	0x29, 0xb7, 0x50, 0xfd, 0x1e, 0x6c, 0x08, 0x62, 0xdc, 0x7c, 0x81, 0xc2, 0x2d, 0xb8, 0xe0, 0x61,
+	0x0b, 0x99, 0xb6, 0x69, 0x1b, 0x01, 0xfa, 0x01, 0x10, 0x8b, 0x82, 0xea, 0xc7, 0x40, 0xfd, 0xab,
+	0x04, 0xa5, 0x16, 0x31, 0xbe, 0xeb, 0x61, 0xfc, 0x32, 0x3e, 0x6b, 0xfd, 0xdf, 0x93, 0xec, 0x44,
+	0x4e, 0x8b, 0x2b, 0x30, 0xe3, 0x61, 0x44, 0x1c, 0x3b, 0xec, 0x26, 0xc3, 0xaf, 0xb1, 0xa3, 0x64,
+	0xe0, 0x0a, 0x1f, 0x1b, 0x54, 0xa8, 0x08, 0x5a, 0xda, 0xd4, 0xf0, 0xc7, 0x1c, 0xac, 0xfa, 0x05,
+	0x84, 0x75, 0xff, 0x3a, 0xfe, 0x5a, 0x81, 0x10, 0x99, 0xf0, 0xf3, 0x95, 0xfc, 0xc8, 0xb5, 0x5c,
+	0x70, 0x70, 0x3a, 0x29, 0xc4, 0xdf, 0x0c, 0xd2, 0x86, 0xf7, 0xe9, 0xf4, 0xe1, 0x7d, 0x67, 0x18,
+	0xf1, 0x81, 0xf4, 0x0c, 0x81, 0x1b, 0x9c, 0xd4, 0x92, 0xd1, 0x14, 0x98, 0xff, 0x3b, 0xa9, 0x06,
+	0xbf, 0x54, 0x3f, 0xe8, 0xe1, 0x1f, 0x61, 0x9d, 0x66, 0xe9, 0x07, 0xb9, 0x64, 0x90, 0x85, 0xe2,
+	0x33, 0xa5, 0x62, 0xc5, 0x95, 0xfe, 0x28, 0xa1, 0x62, 0x87, 0x6e, 0x2d, 0x45, 0x18, 0xc2, 0xef,
+	0x2c, 0xf1, 0xad, 0xfe, 0x2b, 0x18, 0x30, 0x62, 0xe3, 0xef, 0x17, 0xc1, 0xab, 0x0e, 0xb3, 0x1e,
+	0xb6, 0x32, 0xb5, 0xcf, 0x5c, 0x30, 0x40, 0x8b, 0x7f, 0x0d, 0x4d, 0x17, 0xa1, 0x57, 0xb1, 0xe9,
+	0x22, 0xc5, 0x57, 0x0e, 0x57, 0xfd, 0xbf, 0x8b, 0x90, 0x6f, 0x11, 0x43, 0xd6, 0x60, 0x61, 0xe0,
+	0x65, 0x71, 0x6d, 0xf0, 0x31, 0x6b, 0xf0, 0x1d, 0x4f, 0xb9, 0x39, 0x8a, 0x2b, 0x42, 0x41, 0x60,
+	0x25, 0xf1, 0xf5, 0x49, 0xbe, 0x1b, 0x5f, 0x3e, 0xe2, 0x21, 0x50, 0xd9, 0xc9, 0x24, 0x2c, 0x94,
+	0x1a, 0x70, 0x69, 0xe8, 0x75, 0x46, 0xbe, 0x1d, 0xdf, 0x23, 0xe5, 0xad, 0x4a, 0xb9, 0x33, 0x56,
+	0x50, 0x28, 0xea, 0xc3, 0x4a, 0x0c, 0xdc, 0x50, 0xd9, 0xf6, 0x90, 0xc1, 0x23, 0x1e, 0x83, 0x94,
+	0x6a, 0x36, 0x69, 0xa1, 0xb6, 0x0b, 0x0b, 0xd1, 0x37, 0x0f, 0x79, 0x2b, 0xbe, 0x3e, 0xfd, 0xd9,
+	0x46, 0xd9, 0xce, 0x22, 0x2b, 0x34, 0xbd, 0x04, 0x25, 0xfd, 0x99, 0x44, 0xbe, 0x17, 0xdf, 0x6b,
+	0xdc, 0x93, 0x8a, 0xb2, 0x35, 0x7e, 0x85, 0xd0, 0x7d, 0x0a, 0x57, 0x53, 0xda, 0x53, 0x79, 0x28,
+	0x1f, 0x46, 0x4e, 0x5b, 0x4a, 0x2d, 0xa3, 0xb8, 0x50, 0xfd, 0x4b, 0x09, 0xd4, 0xf1, 0x23, 0xa2,
+	0xfc, 0xed, 0x94, 0x7d, 0xc7, 0x0e, 0xe8, 0x4a, 0xe3, 0xe3, 0x57, 0x0a, 0xe3, 0x7e, 0x26, 0xc1,
+	0xda, 0xa8, 0xe6, 0x5d, 0xae, 0xc7, 0x37, 0x1f, 0x3f, 0x90, 0x2a, 0x0f, 0x3e, 0x66, 0x8d, 0x30,
+	0xc5, 0x85, 0xe5, 0xa4, 0x06, 0x32, 0x21, 0x21, 0x53, 0xbb, 0x7e, 0x65, 0x3b, 0x8b, 0xac, 0xd0,
+	0x78, 0x0c, 0x57, 0x92, 0xdb, 0xb5, 0x84, 0x92, 0x1b, 0xd1, 0x95, 0x2a, 0xd5, 0x6c, 0xd2, 0x42,
+	0xaf, 0x05, 0x2b, 0xc9, 0x9d, 0xdd, 0xad, 0xf8, 0x46, 0x89, 0x62, 0xca, 0x4e, 0x26, 0xb1, 0x08,
+	0xb0, 0x57, 0x52, 0x9a, 0xa8, 0x6f, 0xc5, 0x37, 0x4a, 0x96, 0x53, 0xaa, 0xd9, 0xe4, 0xd2, 0x81,
+	0x15, 0xe9, 0x34, 0x06, 0xd8, 0x58, 0x22, 0x55, 0xb3, 0x49, 0x47, 0xab, 0x3c, 0xe5, 0x7e, 0x1a,
+	0xae, 0xf2, 0x91, 0x97, 0xb6, 0x52, 0xcb, 0x28, 0xce, 0x55, 0x2b, 0xd3, 0x3f, 0xf1, 0xff, 0xb1,
+	0xd5, 0x3c, 0x78, 0x7b, 0x56, 0x96, 0xde, 0x9d, 0x95, 0xa5, 0x7f, 0x9c, 0x95, 0xa5, 0x57, 0xef,
+	0xcb, 0x53, 0xef, 0xde, 0x97, 0xa7, 0xfe, 0xf2, 0xbe, 0x3c, 0xf5, 0xbc, 0x66, 0x98, 0xb4, 0xdb,
+	0x3f, 0xaa, 0xea, 0x8e, 0x55, 0xdb, 0x47, 0xf8, 0xb8, 0x65, 0xd2, 0xae, 0x87, 0xec, 0x5a, 0xc7,
+	0x62, 0xd3, 0x7c, 0xed, 0xa4, 0x26, 0x2e, 0x5f, 0x7a, 0xea, 0x62, 0x72, 0x34, 0xc3, 0x06, 0xbe,
+	0xfb, 0xff, 0x1b, 0x00, 0x4a, 0xf9, 0x20, 0x96, 0x98, 0x1c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a governance operation for updating the parameters.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// Multisig account
+	CreateMultisigAccount(ctx context.Context, in *MsgCreateMultisigAccountParams, opts ...grpc.CallOption) (*MsgCreateMultisigAccountResponse, error)
+	AddMultisigSigner(ctx context.Context, in *MsgAddMultisigSignerParams, opts ...grpc.CallOption) (*MsgAddMultisigSignerResponse, error)
+	CleanupMultisigSigner(ctx context.Context, in *MsgCleanupMultisigAccountParams, opts ...grpc.CallOption) (*MsgCleanupMultisigAccountResponse, error)
+	SetThreshold(ctx context.Context, in *MsgSetMultisigThresholdParams, opts ...grpc.CallOption) (*MsgSetMultisigThresholdResponse, error)
+	InitializeMultisigProposal(ctx context.Context, in *MsgInitializeMultisigProposalParams, opts ...grpc.CallOption) (*MsgInitializeMultisigResponse, error)
+	ApproveMultisigProposal(ctx context.Context, in *MsgApproveMultisigProposalParams, opts ...grpc.CallOption) (*MsgApproveMultisigProposalResponse, error)
+	ApproveAndDispatchMultisigProposal(ctx context.Context, in *MsgApproveAndDispatchMultisigProposalParams, opts ...grpc.CallOption) (*MsgApproveAndDispatchMultisigProposalResponse, error)
+	// BatchApproveMultisigProposal approves a proposal with signatures collected off-chain,
+	// and dispatches it once the threshold is met.
+	BatchApproveMultisigProposal(ctx context.Context, in *MsgBatchApproveMultisigProposalParams, opts ...grpc.CallOption) (*MsgBatchApproveMultisigProposalResponse, error)
+	// ScheduleMultisigCall schedules a call of a multisig account, executed by the EndBlocker.
+	// It is signed by the multisig account, i.e. dispatched by an approved proposal.
+	ScheduleMultisigCall(ctx context.Context, in *MsgScheduleMultisigCallParams, opts ...grpc.CallOption) (*MsgScheduleMultisigCallResponse, error)
+	// CancelMultisigSchedule cancels the remaining executions of a schedule. It is signed by
+	// the multisig account, i.e. dispatched by an approved follow-up proposal.
+	CancelMultisigSchedule(ctx context.Context, in *MsgCancelMultisigScheduleParams, opts ...grpc.CallOption) (*MsgCancelMultisigScheduleResponse, error)
+	// FreezeMultisigAccount blocks the proposals and dispatched calls of a compromised multisig
+	// account. It is a governance operation.
+	FreezeMultisigAccount(ctx context.Context, in *MsgFreezeMultisigAccount, opts ...grpc.CallOption) (*MsgFreezeMultisigAccountResponse, error)
+	// RecoverMultisigAccount replaces the signers and threshold of a multisig account and
+	// unfreezes it. It is a governance operation.
+	RecoverMultisigAccount(ctx context.Context, in *MsgRecoverMultisigAccount, opts ...grpc.CallOption) (*MsgRecoverMultisigAccountResponse, error)
+	// CancelMultisigProposal removes an open proposal and refunds its deposit when signed by the
+	// depositor. Another signer votes against the proposal, which is removed and its deposit
+	// forfeited once the rejections reach the threshold of the multisig account.
+	CancelMultisigProposal(ctx context.Context, in *MsgCancelMultisigProposalParams, opts ...grpc.CallOption) (*MsgCancelMultisigProposalResponse, error)
+	CleanupMultisigProposal(ctx context.Context, in *MsgCleanupMultisigProposalParams, opts ...grpc.CallOption) (*MsgCleanupMultisigProposalResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSetMultisigThresholdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateMultisigAccount(ctx context.Context, in *MsgCreateMultisigAccountParams, opts ...grpc.CallOption) (*MsgCreateMultisigAccountResponse, error) {
+	out := new(MsgCreateMultisigAccountResponse)
+	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/CreateMultisigAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSetMultisigThresholdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) AddMultisigSigner(ctx context.Context, in *MsgAddMultisigSignerParams, opts ...grpc.CallOption) (*MsgAddMultisigSignerResponse, error) {
+	out := new(MsgAddMultisigSignerResponse)
+	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/AddMultisigSigner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgInitializeMultisigProposalParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CleanupMultisigSigner(ctx context.Context, in *MsgCleanupMultisigAccountParams, opts ...grpc.CallOption) (*MsgCleanupMultisigAccountResponse, error) {
+	out := new(MsgCleanupMultisigAccountResponse)
+	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/CleanupMultisigSigner", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgInitializeMultisigProposalParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetThreshold(ctx context.Context, in *MsgSetMultisigThresholdParams, opts ...grpc.CallOption) (*MsgSetMultisigThresholdResponse, error) {
+	out := new(MsgSetMultisigThresholdResponse)
+	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/SetThreshold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgInitializeMultisigProposalParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Message != nil {
-		{
-			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
+func (c *msgClient) InitializeMultisigProposal(ctx context.Context, in *MsgInitializeMultisigProposalParams, opts ...grpc.CallOption) (*MsgInitializeMultisigResponse, error) {
+	out := new(MsgInitializeMultisigResponse)
+	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/InitializeMultisigProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x1a
+	return out, nil
+}
+
+func (c *msgClient) ApproveMultisigProposal(ctx context.Context, in *MsgApproveMultisigProposalParams, opts ...grpc.CallOption) (*MsgApproveMultisigProposalResponse, error) {
+	out := new(MsgApproveMultisigProposalResponse)
+	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/ApproveMultisigProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposer)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *msgClient) ApproveAndDispatchMultisigProposal(ctx context.Context, in *MsgApproveAndDispatchMultisigProposalParams, opts ...grpc.CallOption) (*MsgApproveAndDispatchMultisigProposalResponse, error) {
+	out := new(MsgApproveAndDispatchMultisigProposalResponse)
+	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/ApproveAndDispatchMultisigProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.MultisigAddress) > 0 {
-		i -= len(m.MultisigAddress)
-		copy(dAtA[i:], m.MultisigAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MultisigAddress)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) BatchApproveMultisigProposal(ctx context.Context, in *MsgBatchApproveMultisigProposalParams, opts ...grpc.CallOption) (*MsgBatchApproveMultisigProposalResponse, error) {
+	out := new(MsgBatchApproveMultisigProposalResponse)
+	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/BatchApproveMultisigProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgInitializeMultisigResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ScheduleMultisigCall(ctx context.Context, in *MsgScheduleMultisigCallParams, opts ...grpc.CallOption) (*MsgScheduleMultisigCallResponse, error) {
+	out := new(MsgScheduleMultisigCallResponse)
+	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/ScheduleMultisigCall", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgInitializeMultisigResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelMultisigSchedule(ctx context.Context, in *MsgCancelMultisigScheduleParams, opts ...grpc.CallOption) (*MsgCancelMultisigScheduleResponse, error) {
+	out := new(MsgCancelMultisigScheduleResponse)
+	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/CancelMultisigSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgInitializeMultisigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) FreezeMultisigAccount(ctx context.Context, in *MsgFreezeMultisigAccount, opts ...grpc.CallOption) (*MsgFreezeMultisigAccountResponse, error) {
+	out := new(MsgFreezeMultisigAccountResponse)
+	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/FreezeMultisigAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgApproveMultisigProposalParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RecoverMultisigAccount(ctx context.Context, in *MsgRecoverMultisigAccount, opts ...grpc.CallOption) (*MsgRecoverMultisigAccountResponse, error) {
+	out := new(MsgRecoverMultisigAccountResponse)
+	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/RecoverMultisigAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgApproveMultisigProposalParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelMultisigProposal(ctx context.Context, in *MsgCancelMultisigProposalParams, opts ...grpc.CallOption) (*MsgCancelMultisigProposalResponse, error) {
+	out := new(MsgCancelMultisigProposalResponse)
+	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/CancelMultisigProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgApproveMultisigProposalParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Approver) > 0 {
-		i -= len(m.Approver)
-		copy(dAtA[i:], m.Approver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Approver)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ProposalId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.MultisigAddress) > 0 {
-		i -= len(m.MultisigAddress)
-		copy(dAtA[i:], m.MultisigAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MultisigAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgApproveMultisigProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CleanupMultisigProposal(ctx context.Context, in *MsgCleanupMultisigProposalParams, opts ...grpc.CallOption) (*MsgCleanupMultisigProposalResponse, error) {
+	out := new(MsgCleanupMultisigProposalResponse)
+	err := c.cc.Invoke(ctx, "/multisig.v1.Msg/CleanupMultisigProposal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgApproveMultisigProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a governance operation for updating the parameters.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// Multisig account
+	CreateMultisigAccount(context.Context, *MsgCreateMultisigAccountParams) (*MsgCreateMultisigAccountResponse, error)
+	AddMultisigSigner(context.Context, *MsgAddMultisigSignerParams) (*MsgAddMultisigSignerResponse, error)
+	CleanupMultisigSigner(context.Context, *MsgCleanupMultisigAccountParams) (*MsgCleanupMultisigAccountResponse, error)
+	SetThreshold(context.Context, *MsgSetMultisigThresholdParams) (*MsgSetMultisigThresholdResponse, error)
+	InitializeMultisigProposal(context.Context, *MsgInitializeMultisigProposalParams) (*MsgInitializeMultisigResponse, error)
+	ApproveMultisigProposal(context.Context, *MsgApproveMultisigProposalParams) (*MsgApproveMultisigProposalResponse, error)
+	ApproveAndDispatchMultisigProposal(context.Context, *MsgApproveAndDispatchMultisigProposalParams) (*MsgApproveAndDispatchMultisigProposalResponse, error)
+	// BatchApproveMultisigProposal approves a proposal with signatures collected off-chain,
+	// and dispatches it once the threshold is met.
+	BatchApproveMultisigProposal(context.Context, *MsgBatchApproveMultisigProposalParams) (*MsgBatchApproveMultisigProposalResponse, error)
+	// ScheduleMultisigCall schedules a call of a multisig account, executed by the EndBlocker.
+	// It is signed by the multisig account, i.e. dispatched by an approved proposal.
+	ScheduleMultisigCall(context.Context, *MsgScheduleMultisigCallParams) (*MsgScheduleMultisigCallResponse, error)
+	// CancelMultisigSchedule cancels the remaining executions of a schedule. It is signed by
+	// the multisig account, i.e. dispatched by an approved follow-up proposal.
+	CancelMultisigSchedule(context.Context, *MsgCancelMultisigScheduleParams) (*MsgCancelMultisigScheduleResponse, error)
+	// FreezeMultisigAccount blocks the proposals and dispatched calls of a compromised multisig
+	// account. It is a governance operation.
+	FreezeMultisigAccount(context.Context, *MsgFreezeMultisigAccount) (*MsgFreezeMultisigAccountResponse, error)
+	// RecoverMultisigAccount replaces the signers and threshold of a multisig account and
+	// unfreezes it. It is a governance operation.
+	RecoverMultisigAccount(context.Context, *MsgRecoverMultisigAccount) (*MsgRecoverMultisigAccountResponse, error)
+	// CancelMultisigProposal removes an open proposal and refunds its deposit when signed by the
+	// depositor. Another signer votes against the proposal, which is removed and its deposit
+	// forfeited once the rejections reach the threshold of the multisig account.
+	CancelMultisigProposal(context.Context, *MsgCancelMultisigProposalParams) (*MsgCancelMultisigProposalResponse, error)
+	CleanupMultisigProposal(context.Context, *MsgCleanupMultisigProposalParams) (*MsgCleanupMultisigProposalResponse, error)
 }
 
-func (m *MsgApproveMultisigProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgApproveAndDispatchMultisigProposalParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreateMultisigAccount(ctx context.Context, req *MsgCreateMultisigAccountParams) (*MsgCreateMultisigAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMultisigAccount not implemented")
+}
+func (*UnimplementedMsgServer) AddMultisigSigner(ctx context.Context, req *MsgAddMultisigSignerParams) (*MsgAddMultisigSignerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMultisigSigner not implemented")
+}
+func (*UnimplementedMsgServer) CleanupMultisigSigner(ctx context.Context, req *MsgCleanupMultisigAccountParams) (*MsgCleanupMultisigAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CleanupMultisigSigner not implemented")
+}
+func (*UnimplementedMsgServer) SetThreshold(ctx context.Context, req *MsgSetMultisigThresholdParams) (*MsgSetMultisigThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetThreshold not implemented")
+}
+func (*UnimplementedMsgServer) InitializeMultisigProposal(ctx context.Context, req *MsgInitializeMultisigProposalParams) (*MsgInitializeMultisigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitializeMultisigProposal not implemented")
+}
+func (*UnimplementedMsgServer) ApproveMultisigProposal(ctx context.Context, req *MsgApproveMultisigProposalParams) (*MsgApproveMultisigProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveMultisigProposal not implemented")
+}
+func (*UnimplementedMsgServer) ApproveAndDispatchMultisigProposal(ctx context.Context, req *MsgApproveAndDispatchMultisigProposalParams) (*MsgApproveAndDispatchMultisigProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAndDispatchMultisigProposal not implemented")
+}
+func (*UnimplementedMsgServer) BatchApproveMultisigProposal(ctx context.Context, req *MsgBatchApproveMultisigProposalParams) (*MsgBatchApproveMultisigProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchApproveMultisigProposal not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleMultisigCall(ctx context.Context, req *MsgScheduleMultisigCallParams) (*MsgScheduleMultisigCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMultisigCall not implemented")
+}
+func (*UnimplementedMsgServer) CancelMultisigSchedule(ctx context.Context, req *MsgCancelMultisigScheduleParams) (*MsgCancelMultisigScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMultisigSchedule not implemented")
+}
+func (*UnimplementedMsgServer) FreezeMultisigAccount(ctx context.Context, req *MsgFreezeMultisigAccount) (*MsgFreezeMultisigAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeMultisigAccount not implemented")
+}
+func (*UnimplementedMsgServer) RecoverMultisigAccount(ctx context.Context, req *MsgRecoverMultisigAccount) (*MsgRecoverMultisigAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverMultisigAccount not implemented")
+}
+func (*UnimplementedMsgServer) CancelMultisigProposal(ctx context.Context, req *MsgCancelMultisigProposalParams) (*MsgCancelMultisigProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMultisigProposal not implemented")
+}
+func (*UnimplementedMsgServer) CleanupMultisigProposal(ctx context.Context, req *MsgCleanupMultisigProposalParams) (*MsgCleanupMultisigProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CleanupMultisigProposal not implemented")
 }
 
-func (m *MsgApproveAndDispatchMultisigProposalParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgApproveAndDispatchMultisigProposalParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Message != nil {
-		{
-			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Approver) > 0 {
-		i -= len(m.Approver)
-		copy(dAtA[i:], m.Approver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Approver)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	if m.ProposalId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multisig.v1.Msg/UpdateParams",
 	}
-	if len(m.MultisigAddress) > 0 {
-		i -= len(m.MultisigAddress)
-		copy(dAtA[i:], m.MultisigAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MultisigAddress)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgApproveAndDispatchMultisigProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreateMultisigAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateMultisigAccountParams)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgApproveAndDispatchMultisigProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgApproveAndDispatchMultisigProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TransactionHash) > 0 {
-		i -= len(m.TransactionHash)
-		copy(dAtA[i:], m.TransactionHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TransactionHash)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).CreateMultisigAccount(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multisig.v1.Msg/CreateMultisigAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateMultisigAccount(ctx, req.(*MsgCreateMultisigAccountParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelMultisigProposalParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddMultisigSigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddMultisigSignerParams)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCancelMultisigProposalParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCancelMultisigProposalParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rejecter) > 0 {
-		i -= len(m.Rejecter)
-		copy(dAtA[i:], m.Rejecter)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Rejecter)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).AddMultisigSigner(ctx, in)
 	}
-	if m.ProposalId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multisig.v1.Msg/AddMultisigSigner",
 	}
-	if len(m.MultisigAddress) > 0 {
-		i -= len(m.MultisigAddress)
-		copy(dAtA[i:], m.MultisigAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MultisigAddress)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddMultisigSigner(ctx, req.(*MsgAddMultisigSignerParams))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelMultisigProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CleanupMultisigSigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCleanupMultisigAccountParams)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCancelMultisigProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCancelMultisigProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).CleanupMultisigSigner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multisig.v1.Msg/CleanupMultisigSigner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CleanupMultisigSigner(ctx, req.(*MsgCleanupMultisigAccountParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCleanupMultisigProposalParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SetThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMultisigThresholdParams)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCleanupMultisigProposalParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCleanupMultisigProposalParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Remover) > 0 {
-		i -= len(m.Remover)
-		copy(dAtA[i:], m.Remover)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Remover)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).SetThreshold(ctx, in)
 	}
-	if m.ProposalId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multisig.v1.Msg/SetThreshold",
 	}
-	if len(m.MultisigAddress) > 0 {
-		i -= len(m.MultisigAddress)
-		copy(dAtA[i:], m.MultisigAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MultisigAddress)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetThreshold(ctx, req.(*MsgSetMultisigThresholdParams))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCleanupMultisigProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_InitializeMultisigProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInitializeMultisigProposalParams)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCleanupMultisigProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCleanupMultisigProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).InitializeMultisigProposal(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multisig.v1.Msg/InitializeMultisigProposal",
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InitializeMultisigProposal(ctx, req.(*MsgInitializeMultisigProposalParams))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateMultisigAccountParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Seed != 0 {
-		n += 1 + sovTx(uint64(m.Seed))
+func _Msg_ApproveMultisigProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveMultisigProposalParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Threshold != 0 {
-		n += 1 + sovTx(uint64(m.Threshold))
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveMultisigProposal(ctx, in)
 	}
-	if len(m.Signers) > 0 {
-		for _, b := range m.Signers {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multisig.v1.Msg/ApproveMultisigProposal",
 	}
-	if m.Permission != 0 {
-		n += 1 + sovTx(uint64(m.Permission))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveMultisigProposal(ctx, req.(*MsgApproveMultisigProposalParams))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateMultisigAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ApproveAndDispatchMultisigProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveAndDispatchMultisigProposalParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.MultisigAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveAndDispatchMultisigProposal(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multisig.v1.Msg/ApproveAndDispatchMultisigProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveAndDispatchMultisigProposal(ctx, req.(*MsgApproveAndDispatchMultisigProposalParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddMultisigSignerParams) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_BatchApproveMultisigProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchApproveMultisigProposalParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.MultisigAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).BatchApproveMultisigProposal(ctx, in)
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multisig.v1.Msg/BatchApproveMultisigProposal",
 	}
-	if m.NewThreshold != 0 {
-		n += 1 + sovTx(uint64(m.NewThreshold))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchApproveMultisigProposal(ctx, req.(*MsgBatchApproveMultisigProposalParams))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddMultisigSignerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ScheduleMultisigCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleMultisigCallParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgCleanupMultisigAccountParams) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleMultisigCall(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.MultisigAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multisig.v1.Msg/ScheduleMultisigCall",
 	}
-	return n
-}
-
-func (m *MsgCleanupMultisigAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleMultisigCall(ctx, req.(*MsgScheduleMultisigCallParams))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetMultisigThresholdParams) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CancelMultisigSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelMultisigScheduleParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.MultisigAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CancelMultisigSchedule(ctx, in)
 	}
-	if m.Threshold != 0 {
-		n += 1 + sovTx(uint64(m.Threshold))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multisig.v1.Msg/CancelMultisigSchedule",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelMultisigSchedule(ctx, req.(*MsgCancelMultisigScheduleParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetMultisigThresholdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_FreezeMultisigAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeMultisigAccount)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgInitializeMultisigProposalParams) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeMultisigAccount(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.MultisigAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multisig.v1.Msg/FreezeMultisigAccount",
 	}
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeMultisigAccount(ctx, req.(*MsgFreezeMultisigAccount))
 	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RecoverMultisigAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverMultisigAccount)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverMultisigAccount(ctx, in)
 	}
-	if m.Message != nil {
-		l = m.Message.Size()
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multisig.v1.Msg/RecoverMultisigAccount",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverMultisigAccount(ctx, req.(*MsgRecoverMultisigAccount))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInitializeMultisigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CancelMultisigProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelMultisigProposalParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovTx(uint64(m.ProposalId))
+	if interceptor == nil {
+		return srv.(MsgServer).CancelMultisigProposal(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multisig.v1.Msg/CancelMultisigProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelMultisigProposal(ctx, req.(*MsgCancelMultisigProposalParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgApproveMultisigProposalParams) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CleanupMultisigProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCleanupMultisigProposalParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.MultisigAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CleanupMultisigProposal(ctx, in)
 	}
-	if m.ProposalId != 0 {
-		n += 1 + sovTx(uint64(m.ProposalId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multisig.v1.Msg/CleanupMultisigProposal",
 	}
-	l = len(m.Approver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CleanupMultisigProposal(ctx, req.(*MsgCleanupMultisigProposalParams))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgApproveMultisigProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "multisig.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateMultisigAccount",
+			Handler:    _Msg_CreateMultisigAccount_Handler,
+		},
+		{
+			MethodName: "AddMultisigSigner",
+			Handler:    _Msg_AddMultisigSigner_Handler,
+		},
+		{
+			MethodName: "CleanupMultisigSigner",
+			Handler:    _Msg_CleanupMultisigSigner_Handler,
+		},
+		{
+			MethodName: "SetThreshold",
+			Handler:    _Msg_SetThreshold_Handler,
+		},
+		{
+			MethodName: "InitializeMultisigProposal",
+			Handler:    _Msg_InitializeMultisigProposal_Handler,
+		},
+		{
+			MethodName: "ApproveMultisigProposal",
+			Handler:    _Msg_ApproveMultisigProposal_Handler,
+		},
+		{
+			MethodName: "ApproveAndDispatchMultisigProposal",
+			Handler:    _Msg_ApproveAndDispatchMultisigProposal_Handler,
+		},
+		{
+			MethodName: "BatchApproveMultisigProposal",
+			Handler:    _Msg_BatchApproveMultisigProposal_Handler,
+		},
+		{
+			MethodName: "ScheduleMultisigCall",
+			Handler:    _Msg_ScheduleMultisigCall_Handler,
+		},
+		{
+			MethodName: "CancelMultisigSchedule",
+			Handler:    _Msg_CancelMultisigSchedule_Handler,
+		},
+		{
+			MethodName: "FreezeMultisigAccount",
+			Handler:    _Msg_FreezeMultisigAccount_Handler,
+		},
+		{
+			MethodName: "RecoverMultisigAccount",
+			Handler:    _Msg_RecoverMultisigAccount_Handler,
+		},
+		{
+			MethodName: "CancelMultisigProposal",
+			Handler:    _Msg_CancelMultisigProposal_Handler,
+		},
+		{
+			MethodName: "CleanupMultisigProposal",
+			Handler:    _Msg_CleanupMultisigProposal_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "multisig/v1/tx.proto",
 }
 
-func (m *MsgApproveAndDispatchMultisigProposalParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MultisigAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ProposalId != 0 {
-		n += 1 + sovTx(uint64(m.ProposalId))
-	}
-	l = len(m.Approver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.Message != nil {
-		l = m.Message.Size()
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgApproveAndDispatchMultisigProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TransactionHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelMultisigProposalParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateMultisigAccountParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMultisigAccountParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMultisigAccountParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MultisigAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ProposalId != 0 {
-		n += 1 + sovTx(uint64(m.ProposalId))
+	if len(m.ThresholdPercentage) > 0 {
+		i -= len(m.ThresholdPercentage)
+		copy(dAtA[i:], m.ThresholdPercentage)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ThresholdPercentage)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Permission != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Seed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Seed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMultisigAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMultisigAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMultisigAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MultisigAddress) > 0 {
+		i -= len(m.MultisigAddress)
+		copy(dAtA[i:], m.MultisigAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MultisigAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMultisigSignerParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMultisigSignerParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMultisigSignerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewThreshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewThreshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MultisigAddress) > 0 {
+		i -= len(m.MultisigAddress)
+		copy(dAtA[i:], m.MultisigAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MultisigAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMultisigSignerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMultisigSignerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMultisigSignerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCleanupMultisigAccountParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCleanupMultisigAccountParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCleanupMultisigAccountParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MultisigAddress) > 0 {
+		i -= len(m.MultisigAddress)
+		copy(dAtA[i:], m.MultisigAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MultisigAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCleanupMultisigAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCleanupMultisigAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCleanupMultisigAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMultisigThresholdParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMultisigThresholdParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMultisigThresholdParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MultisigAddress) > 0 {
+		i -= len(m.MultisigAddress)
+		copy(dAtA[i:], m.MultisigAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MultisigAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMultisigThresholdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMultisigThresholdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMultisigThresholdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitializeMultisigProposalParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitializeMultisigProposalParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitializeMultisigProposalParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MultisigAddress) > 0 {
+		i -= len(m.MultisigAddress)
+		copy(dAtA[i:], m.MultisigAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MultisigAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitializeMultisigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitializeMultisigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitializeMultisigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveMultisigProposalParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveMultisigProposalParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveMultisigProposalParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Approver) > 0 {
+		i -= len(m.Approver)
+		copy(dAtA[i:], m.Approver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Approver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MultisigAddress) > 0 {
+		i -= len(m.MultisigAddress)
+		copy(dAtA[i:], m.MultisigAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MultisigAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveMultisigProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveMultisigProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveMultisigProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveAndDispatchMultisigProposalParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveAndDispatchMultisigProposalParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveAndDispatchMultisigProposalParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Approver) > 0 {
+		i -= len(m.Approver)
+		copy(dAtA[i:], m.Approver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Approver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MultisigAddress) > 0 {
+		i -= len(m.MultisigAddress)
+		copy(dAtA[i:], m.MultisigAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MultisigAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveAndDispatchMultisigProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveAndDispatchMultisigProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveAndDispatchMultisigProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TransactionHash) > 0 {
+		i -= len(m.TransactionHash)
+		copy(dAtA[i:], m.TransactionHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransactionHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchApproveMultisigProposalParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchApproveMultisigProposalParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchApproveMultisigProposalParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MultisigAddress) > 0 {
+		i -= len(m.MultisigAddress)
+		copy(dAtA[i:], m.MultisigAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MultisigAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApprovalSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApprovalSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApprovalSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApprovalSignDoc) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApprovalSignDoc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApprovalSignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CallHash) > 0 {
+		i -= len(m.CallHash)
+		copy(dAtA[i:], m.CallHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CallHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MultisigAddress) > 0 {
+		i -= len(m.MultisigAddress)
+		copy(dAtA[i:], m.MultisigAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MultisigAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchApproveMultisigProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchApproveMultisigProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchApproveMultisigProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TransactionHash) > 0 {
+		i -= len(m.TransactionHash)
+		copy(dAtA[i:], m.TransactionHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransactionHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Dispatched {
+		i--
+		if m.Dispatched {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleMultisigCallParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleMultisigCallParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleMultisigCallParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxRuns != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxRuns))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IntervalBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IntervalBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartTime != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTx(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MultisigAddress) > 0 {
+		i -= len(m.MultisigAddress)
+		copy(dAtA[i:], m.MultisigAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MultisigAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleMultisigCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleMultisigCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleMultisigCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduleId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelMultisigScheduleParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelMultisigScheduleParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelMultisigScheduleParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduleId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MultisigAddress) > 0 {
+		i -= len(m.MultisigAddress)
+		copy(dAtA[i:], m.MultisigAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MultisigAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelMultisigScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelMultisigScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelMultisigScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingRuns != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RemainingRuns))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeMultisigAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeMultisigAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeMultisigAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MultisigAddress) > 0 {
+		i -= len(m.MultisigAddress)
+		copy(dAtA[i:], m.MultisigAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MultisigAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeMultisigAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeMultisigAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeMultisigAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverMultisigAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverMultisigAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverMultisigAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ThresholdPercentage) > 0 {
+		i -= len(m.ThresholdPercentage)
+		copy(dAtA[i:], m.ThresholdPercentage)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ThresholdPercentage)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MultisigAddress) > 0 {
+		i -= len(m.MultisigAddress)
+		copy(dAtA[i:], m.MultisigAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MultisigAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverMultisigAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverMultisigAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverMultisigAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelMultisigProposalParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelMultisigProposalParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelMultisigProposalParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rejecter) > 0 {
+		i -= len(m.Rejecter)
+		copy(dAtA[i:], m.Rejecter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Rejecter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MultisigAddress) > 0 {
+		i -= len(m.MultisigAddress)
+		copy(dAtA[i:], m.MultisigAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MultisigAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelMultisigProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelMultisigProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelMultisigProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rejected {
+		i--
+		if m.Rejected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCleanupMultisigProposalParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCleanupMultisigProposalParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCleanupMultisigProposalParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remover) > 0 {
+		i -= len(m.Remover)
+		copy(dAtA[i:], m.Remover)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Remover)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MultisigAddress) > 0 {
+		i -= len(m.MultisigAddress)
+		copy(dAtA[i:], m.MultisigAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MultisigAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCleanupMultisigProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCleanupMultisigProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCleanupMultisigProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateMultisigAccountParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Seed != 0 {
+		n += 1 + sovTx(uint64(m.Seed))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	if len(m.Signers) > 0 {
+		for _, b := range m.Signers {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Permission != 0 {
+		n += 1 + sovTx(uint64(m.Permission))
+	}
+	l = len(m.ThresholdPercentage)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateMultisigAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MultisigAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddMultisigSignerParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MultisigAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewThreshold != 0 {
+		n += 1 + sovTx(uint64(m.NewThreshold))
+	}
+	return n
+}
+
+func (m *MsgAddMultisigSignerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCleanupMultisigAccountParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MultisigAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCleanupMultisigAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMultisigThresholdParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MultisigAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *MsgSetMultisigThresholdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInitializeMultisigProposalParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MultisigAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInitializeMultisigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgApproveMultisigProposalParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MultisigAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Approver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveMultisigProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgApproveAndDispatchMultisigProposalParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MultisigAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Approver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveAndDispatchMultisigProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TransactionHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchApproveMultisigProposalParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MultisigAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *ApprovalSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *ApprovalSignDoc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MultisigAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.CallHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchApproveMultisigProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Dispatched {
+		n += 2
+	}
+	l = len(m.TransactionHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgScheduleMultisigCallParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MultisigAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IntervalBlocks != 0 {
+		n += 1 + sovTx(uint64(m.IntervalBlocks))
+	}
+	if m.MaxRuns != 0 {
+		n += 1 + sovTx(uint64(m.MaxRuns))
+	}
+	return n
+}
+
+func (m *MsgScheduleMultisigCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduleId != 0 {
+		n += 1 + sovTx(uint64(m.ScheduleId))
+	}
+	return n
+}
+
+func (m *MsgCancelMultisigScheduleParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MultisigAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ScheduleId != 0 {
+		n += 1 + sovTx(uint64(m.ScheduleId))
+	}
+	return n
+}
+
+func (m *MsgCancelMultisigScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RemainingRuns != 0 {
+		n += 1 + sovTx(uint64(m.RemainingRuns))
+	}
+	return n
+}
+
+func (m *MsgFreezeMultisigAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MultisigAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFreezeMultisigAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRecoverMultisigAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MultisigAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	l = len(m.ThresholdPercentage)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecoverMultisigAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelMultisigProposalParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MultisigAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
 	}
 	l = len(m.Rejecter)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgCancelMultisigProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rejected {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCleanupMultisigProposalParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MultisigAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Remover)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCleanupMultisigProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMultisigAccountParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMultisigAccountParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMultisigAccountParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			m.Seed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seed |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, make([]byte, postIndex-iNdEx))
+			copy(m.Signers[len(m.Signers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= MultisigProposalType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThresholdPercentage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMultisigAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMultisigAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMultisigAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultisigAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMultisigSignerParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMultisigSignerParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMultisigSignerParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultisigAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewThreshold", wireType)
+			}
+			m.NewThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMultisigSignerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMultisigSignerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMultisigSignerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCleanupMultisigAccountParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCleanupMultisigAccountParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCleanupMultisigAccountParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultisigAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCleanupMultisigAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCleanupMultisigAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCleanupMultisigAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMultisigThresholdParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMultisigThresholdParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMultisigThresholdParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultisigAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMultisigThresholdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMultisigThresholdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMultisigThresholdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitializeMultisigProposalParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitializeMultisigProposalParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitializeMultisigProposalParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultisigAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &types.Any{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitializeMultisigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitializeMultisigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitializeMultisigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveMultisigProposalParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveMultisigProposalParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveMultisigProposalParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultisigAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgCancelMultisigProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgCleanupMultisigProposalParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MultisigAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ProposalId != 0 {
-		n += 1 + sovTx(uint64(m.ProposalId))
-	}
-	l = len(m.Remover)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgApproveMultisigProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveMultisigProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveMultisigProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgCleanupMultisigProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveAndDispatchMultisigProposalParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2605,15 +5735,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveAndDispatchMultisigProposalParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveAndDispatchMultisigProposalParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2641,11 +5771,62 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.MultisigAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2672,7 +5853,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Message == nil {
+				m.Message = &types.Any{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2697,7 +5881,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveAndDispatchMultisigProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2707,25 +5891,57 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveAndDispatchMultisigProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveAndDispatchMultisigProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.TransactionHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2747,7 +5963,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateMultisigAccountParams) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchApproveMultisigProposalParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2770,15 +5986,15 @@ func (m *MsgCreateMultisigAccountParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateMultisigAccountParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchApproveMultisigProposalParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateMultisigAccountParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchApproveMultisigProposalParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2806,13 +6022,13 @@ func (m *MsgCreateMultisigAccountParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Submitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
 			}
-			m.Seed = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2822,16 +6038,29 @@ func (m *MsgCreateMultisigAccountParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Seed |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultisigAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			m.Threshold = 0
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2841,16 +6070,16 @@ func (m *MsgCreateMultisigAccountParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Threshold |= uint32(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2860,29 +6089,31 @@ func (m *MsgCreateMultisigAccountParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, make([]byte, postIndex-iNdEx))
-			copy(m.Signers[len(m.Signers)-1], dAtA[iNdEx:postIndex])
+			m.Signatures = append(m.Signatures, ApprovalSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			m.Permission = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2892,11 +6123,28 @@ func (m *MsgCreateMultisigAccountParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Permission |= MultisigProposalType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &types.Any{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2918,7 +6166,7 @@ func (m *MsgCreateMultisigAccountParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateMultisigAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *ApprovalSignature) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2941,15 +6189,15 @@ func (m *MsgCreateMultisigAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateMultisigAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApprovalSignature: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateMultisigAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApprovalSignature: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2977,7 +6225,41 @@ func (m *MsgCreateMultisigAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MultisigAddress = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3000,7 +6282,7 @@ func (m *MsgCreateMultisigAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddMultisigSignerParams) Unmarshal(dAtA []byte) error {
+func (m *ApprovalSignDoc) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3023,15 +6305,15 @@ func (m *MsgAddMultisigSignerParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMultisigSignerParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApprovalSignDoc: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMultisigSignerParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApprovalSignDoc: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3059,11 +6341,11 @@ func (m *MsgAddMultisigSignerParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MultisigAddress = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3091,13 +6373,13 @@ func (m *MsgAddMultisigSignerParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.MultisigAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			m.NewThreshold = 0
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3107,116 +6389,16 @@ func (m *MsgAddMultisigSignerParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewThreshold |= uint32(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAddMultisigSignerResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMultisigSignerResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMultisigSignerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCleanupMultisigAccountParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCleanupMultisigAccountParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCleanupMultisigAccountParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CallHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3226,23 +6408,25 @@ func (m *MsgCleanupMultisigAccountParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MultisigAddress = string(dAtA[iNdEx:postIndex])
+			m.CallHash = append(m.CallHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CallHash == nil {
+				m.CallHash = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3265,7 +6449,7 @@ func (m *MsgCleanupMultisigAccountParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCleanupMultisigAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchApproveMultisigProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3288,12 +6472,64 @@ func (m *MsgCleanupMultisigAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCleanupMultisigAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchApproveMultisigProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCleanupMultisigAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchApproveMultisigProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispatched", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Dispatched = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransactionHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3315,7 +6551,7 @@ func (m *MsgCleanupMultisigAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetMultisigThresholdParams) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleMultisigCallParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3338,10 +6574,10 @@ func (m *MsgSetMultisigThresholdParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetMultisigThresholdParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleMultisigCallParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetMultisigThresholdParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleMultisigCallParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3377,10 +6613,10 @@ func (m *MsgSetMultisigThresholdParams) Unmarshal(dAtA []byte) error {
 			m.MultisigAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			m.Threshold = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3390,116 +6626,52 @@ func (m *MsgSetMultisigThresholdParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Threshold |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSetMultisigThresholdResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Message == nil {
+				m.Message = &types.Any{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetMultisigThresholdResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetMultisigThresholdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgInitializeMultisigProposalParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInitializeMultisigProposalParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInitializeMultisigProposalParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3509,29 +6681,33 @@ func (m *MsgInitializeMultisigProposalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MultisigAddress = string(dAtA[iNdEx:postIndex])
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalBlocks", wireType)
 			}
-			var stringLen uint64
+			m.IntervalBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3541,29 +6717,85 @@ func (m *MsgInitializeMultisigProposalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.IntervalBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRuns", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			m.MaxRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRuns |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleMultisigCallResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleMultisigCallResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleMultisigCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
 			}
-			var stringLen uint64
+			m.ScheduleId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3573,27 +6805,64 @@ func (m *MsgInitializeMultisigProposalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ScheduleId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelMultisigScheduleParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelMultisigScheduleParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelMultisigScheduleParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3621,13 +6890,13 @@ func (m *MsgInitializeMultisigProposalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.MultisigAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
 			}
-			var msglen int
+			m.ScheduleId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3637,28 +6906,11 @@ func (m *MsgInitializeMultisigProposalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ScheduleId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Message == nil {
-				m.Message = &types.Any{}
-			}
-			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3680,7 +6932,7 @@ func (m *MsgInitializeMultisigProposalParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInitializeMultisigResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelMultisigScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3703,17 +6955,17 @@ func (m *MsgInitializeMultisigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInitializeMultisigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelMultisigScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInitializeMultisigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelMultisigScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingRuns", wireType)
 			}
-			m.ProposalId = 0
+			m.RemainingRuns = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3723,7 +6975,7 @@ func (m *MsgInitializeMultisigResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				m.RemainingRuns |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3749,7 +7001,7 @@ func (m *MsgInitializeMultisigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgApproveMultisigProposalParams) Unmarshal(dAtA []byte) error {
+func (m *MsgFreezeMultisigAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3772,15 +7024,15 @@ func (m *MsgApproveMultisigProposalParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgApproveMultisigProposalParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFreezeMultisigAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgApproveMultisigProposalParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFreezeMultisigAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3808,13 +7060,13 @@ func (m *MsgApproveMultisigProposalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MultisigAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3824,14 +7076,27 @@ func (m *MsgApproveMultisigProposalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultisigAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3859,7 +7124,7 @@ func (m *MsgApproveMultisigProposalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Approver = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3882,7 +7147,7 @@ func (m *MsgApproveMultisigProposalParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgApproveMultisigProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFreezeMultisigAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3905,10 +7170,10 @@ func (m *MsgApproveMultisigProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgApproveMultisigProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFreezeMultisigAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgApproveMultisigProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFreezeMultisigAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3932,7 +7197,7 @@ func (m *MsgApproveMultisigProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgApproveAndDispatchMultisigProposalParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRecoverMultisigAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3955,15 +7220,15 @@ func (m *MsgApproveAndDispatchMultisigProposalParams) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgApproveAndDispatchMultisigProposalParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecoverMultisigAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgApproveAndDispatchMultisigProposalParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecoverMultisigAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3991,13 +7256,13 @@ func (m *MsgApproveAndDispatchMultisigProposalParams) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MultisigAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigAddress", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4007,14 +7272,27 @@ func (m *MsgApproveAndDispatchMultisigProposalParams) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultisigAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4042,13 +7320,32 @@ func (m *MsgApproveAndDispatchMultisigProposalParams) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Approver = string(dAtA[iNdEx:postIndex])
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdPercentage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4058,27 +7355,23 @@ func (m *MsgApproveAndDispatchMultisigProposalParams) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Message == nil {
-				m.Message = &types.Any{}
-			}
-			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ThresholdPercentage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4101,7 +7394,7 @@ func (m *MsgApproveAndDispatchMultisigProposalParams) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *MsgApproveAndDispatchMultisigProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRecoverMultisigAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4124,44 +7417,12 @@ func (m *MsgApproveAndDispatchMultisigProposalResponse) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgApproveAndDispatchMultisigProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecoverMultisigAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgApproveAndDispatchMultisigProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecoverMultisigAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransactionHash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TransactionHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4345,6 +7606,26 @@ func (m *MsgCancelMultisigProposalResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgCancelMultisigProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejected", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rejected = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
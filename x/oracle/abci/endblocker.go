@@ -105,9 +105,18 @@ func CalcPrices(ctx sdk.Context, params types.Params, k keeper.Keeper) error {
 
 	k.ClearExchangeRates(ctx)
 
+	// Keep the previous exchange rates of denoms which are not updated in this vote period
+	for _, override := range params.DenomOverrides {
+		if !override.IsUpdatePeriod(ctx.BlockHeight(), params.VotePeriod) {
+			if err := k.KeepLastExchangeRate(ctx, override.SymbolDenom); err != nil {
+				return err
+			}
+		}
+	}
+
 	// NOTE: it filters out inactive or jailed validators
 	ballotDenomSlice := k.OrganizeBallotByDenom(ctx, validatorClaimMap)
-	threshold := k.VoteThreshold(ctx).MulInt64(types.MaxVoteThresholdMultiplier).TruncateInt64()
+	voteThreshold := k.VoteThreshold(ctx)
 
 	// Iterate through ballots and update exchange rates; drop if not enough votes have been achieved.
 	for _, ballotDenom := range ballotDenomSlice {
@@ -120,6 +129,10 @@ func CalcPrices(ctx sdk.Context, params types.Params, k keeper.Keeper) error {
 			continue
 		}
 
+		// Denoms with an override replace the global voting rules with their own
+		override, _ := params.DenomOverrides.GetOverrideFromDenom(ballotDenom.Denom)
+		threshold := override.VoteThresholdOr(voteThreshold).MulInt64(types.MaxVoteThresholdMultiplier).TruncateInt64()
+
 		// Calculate the portion of votes received as an integer, scaled up using the
 		// same multiplier as the `threshold` computed above
 		support := ballotDenom.Ballot.Power() * types.MaxVoteThresholdMultiplier / totalBondedPower
@@ -128,6 +141,19 @@ func CalcPrices(ctx sdk.Context, params types.Params, k keeper.Keeper) error {
 			continue
 		}
 
+		if util.SafeIntToUint64(ballotDenom.Ballot.NumVoters()) < override.MinVoters {
+			ctx.Logger().Info("Ballot voters are under minimum voters, dropping ballot", "denom", ballotDenom)
+			continue
+		}
+
+		// Remove outlying votes before the tally, their voters miss the ballot
+		ballot := ballotDenom.Ballot
+		if filter, found := params.OutlierFilters.GetFilterFromDenom(ballotDenom.Denom); found {
+			if ballot, err = filterBallot(ctx, ballotDenom, filter); err != nil {
+				return err
+			}
+		}
+
 		// Get the current denom's reward band
 		rewardBand, err := params.RewardBands.GetBandFromDenom(ballotDenom.Denom)
 		if err != nil {
@@ -135,10 +161,26 @@ func CalcPrices(ctx sdk.Context, params types.Params, k keeper.Keeper) error {
 		}
 
 		// Get weighted median of exchange rates
-		exchangeRate, err := Tally(ballotDenom.Ballot, rewardBand, validatorClaimMap, incrementWin)
+		exchangeRate, err := Tally(ballot, rewardBand, validatorClaimMap, incrementWin)
+		if err != nil {
+			return err
+		}
+
+		// Validators win the ballot, but the exchange rate is only updated every
+		// VotePeriodMultiplier vote periods
+		if !override.IsUpdatePeriod(ctx.BlockHeight(), params.VotePeriod) {
+			continue
+		}
+
+		// Keep the previous exchange rate if the circuit breaker of the denom trips
+		halted, err := k.ApplyCircuitBreaker(ctx, ballotDenom.Denom, exchangeRate)
 		if err != nil {
 			return err
 		}
+		if halted {
+			ctx.Logger().Info("Circuit breaker is tripped, keeping the previous exchange rate", "denom", ballotDenom)
+			continue
+		}
 
 		// Set the exchange rate, emit ABCI event
 		if err = k.SetExchangeRateWithEvent(ctx, ballotDenom.Denom, exchangeRate); err != nil {
@@ -147,6 +189,9 @@ func CalcPrices(ctx sdk.Context, params types.Params, k keeper.Keeper) error {
 
 		if k.IsPeriodLastBlock(ctx, params.HistoricStampPeriod) {
 			k.AddHistoricPrice(ctx, ballotDenom.Denom, exchangeRate)
+			if err = k.UpdateCandles(ctx, ballotDenom.Denom, exchangeRate); err != nil {
+				return err
+			}
 		}
 
 		// Calculate and stamp median/median deviation if median stamp period has passed
@@ -187,6 +232,34 @@ func CalcPrices(ctx sdk.Context, params types.Params, k keeper.Keeper) error {
 	return nil
 }
 
+// filterBallot removes the outlying votes of a ballot with a given outlier
+// filter, and emits the amount of removed votes.
+func filterBallot(
+	ctx sdk.Context,
+	ballotDenom types.BallotDenom,
+	filter types.OutlierFilter,
+) (types.ExchangeRateBallot, error) {
+	kept, filtered, err := ballotDenom.Ballot.FilterOutliers(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	filteredVoters := make([]string, len(filtered))
+	for i, vote := range filtered {
+		filteredVoters[i] = vote.Voter.String()
+	}
+	if len(filtered) > 0 {
+		ctx.Logger().Info("Outlying votes are removed from ballot", "denom", ballotDenom.Denom, "filtered", len(filtered))
+	}
+
+	return kept, ctx.EventManager().EmitTypedEvent(&types.EventBallotFiltered{
+		Denom:          ballotDenom.Denom,
+		FilteredVotes:  util.SafeIntToUint64(len(filtered)),
+		TotalVotes:     util.SafeIntToUint64(len(ballotDenom.Ballot)),
+		FilteredVoters: filteredVoters,
+	})
+}
+
 // Tally calculates and returns the median. It sets the set of voters to be
 // rewarded, i.e. voted within a reasonable spread from the weighted median to
 // the store. Note, the ballot is sorted by ExchangeRate.
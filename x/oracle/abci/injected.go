@@ -0,0 +1,81 @@
+package abci
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+	cometabci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	oracletypes "github.com/DaevMithran/dmchain/x/oracle/types"
+)
+
+// InjectedVoteExtensionTxPrefix marks the InjectedVoteExtensionTx placed at the top of a block.
+// A protobuf encoded tx never starts with 0xff, since it would be a field tag with the invalid
+// wire type 7, so normal txs are never mistaken for the injected one.
+var InjectedVoteExtensionTxPrefix = []byte{0xff, 'o', 'r', 'a', 'c', 'l', 'e', 'v', 'e'}
+
+// EncodeInjectedVoteExtensionTx returns the marked encoding of the injected vote extension tx.
+func EncodeInjectedVoteExtensionTx(tx oracletypes.InjectedVoteExtensionTx) ([]byte, error) {
+	bz, err := tx.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append([]byte{}, InjectedVoteExtensionTxPrefix...), bz...), nil
+}
+
+// IsInjectedVoteExtensionTx reports whether bz carries the injected vote extension tx marker.
+func IsInjectedVoteExtensionTx(bz []byte) bool {
+	return bytes.HasPrefix(bz, InjectedVoteExtensionTxPrefix)
+}
+
+// DecodeInjectedVoteExtensionTx decodes a tx encoded by EncodeInjectedVoteExtensionTx.
+func DecodeInjectedVoteExtensionTx(bz []byte) (oracletypes.InjectedVoteExtensionTx, error) {
+	var tx oracletypes.InjectedVoteExtensionTx
+	if !IsInjectedVoteExtensionTx(bz) {
+		return tx, errorsmod.Wrap(oracletypes.ErrInvalidInjVoteExt, "missing injected tx marker")
+	}
+
+	if err := tx.Unmarshal(bz[len(InjectedVoteExtensionTxPrefix):]); err != nil {
+		return tx, errorsmod.Wrap(oracletypes.ErrInvalidInjVoteExt, err.Error())
+	}
+
+	return tx, nil
+}
+
+// NewTxDecoder wraps the app tx decoder so that the injected vote extension tx is reported as
+// such instead of as a malformed tx. The injected tx is never executed: its votes are stored by
+// the PreBlocker and the app drops it before finalizing the block, while in CheckTx the error
+// keeps marked txs out of the mempool.
+func NewTxDecoder(decoder sdk.TxDecoder) sdk.TxDecoder {
+	return func(txBytes []byte) (sdk.Tx, error) {
+		if IsInjectedVoteExtensionTx(txBytes) {
+			return nil, errorsmod.Wrap(oracletypes.ErrInvalidInjVoteExt, "injected vote extension tx cannot be executed")
+		}
+
+		return decoder(txBytes)
+	}
+}
+
+// StripInjectedVoteExtensionTx returns txs without the injected vote extension tx, which is
+// always the first tx of a block, so that it is dropped before the block txs are executed.
+func StripInjectedVoteExtensionTx(txs [][]byte) [][]byte {
+	if len(txs) == 0 || !IsInjectedVoteExtensionTx(txs[0]) {
+		return txs
+	}
+
+	return txs[1:]
+}
+
+// AddInjectedVoteExtensionTxResult prepends an empty successful result for the injected vote
+// extension tx dropped by StripInjectedVoteExtensionTx, as CometBFT expects a result for every
+// tx of the block.
+func AddInjectedVoteExtensionTxResult(txs [][]byte, res *cometabci.ResponseFinalizeBlock) {
+	if len(txs) == 0 || !IsInjectedVoteExtensionTx(txs[0]) {
+		return
+	}
+
+	res.TxResults = append([]*cometabci.ExecTxResult{{}}, res.TxResults...)
+}
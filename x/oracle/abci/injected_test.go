@@ -0,0 +1,112 @@
+package abci_test
+
+import (
+	"testing"
+
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	dmchainapp "github.com/DaevMithran/dmchain/app"
+	oracleabci "github.com/DaevMithran/dmchain/x/oracle/abci"
+	"github.com/DaevMithran/dmchain/x/oracle/types"
+)
+
+func TestInjectedVoteExtensionTxDecoding(t *testing.T) {
+	app := dmchainapp.Setup(t)
+	txConfig := app.TxConfig()
+
+	builder := txConfig.NewTxBuilder()
+	from := sdk.AccAddress([]byte("from________________"))
+	to := sdk.AccAddress([]byte("to__________________"))
+	require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))))
+	txBz, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	injected := types.InjectedVoteExtensionTx{ExtendedCommitInfo: []byte("commit")}
+	injectedBz, err := oracleabci.EncodeInjectedVoteExtensionTx(injected)
+	require.NoError(t, err)
+	rawInjectedBz, err := injected.Marshal()
+	require.NoError(t, err)
+
+	// normal txs, and injected txs without the marker, are not detected as injected txs
+	require.False(t, oracleabci.IsInjectedVoteExtensionTx(txBz))
+	require.False(t, oracleabci.IsInjectedVoteExtensionTx(rawInjectedBz))
+	require.True(t, oracleabci.IsInjectedVoteExtensionTx(injectedBz))
+
+	_, err = oracleabci.DecodeInjectedVoteExtensionTx(txBz)
+	require.ErrorIs(t, err, types.ErrInvalidInjVoteExt)
+	decoded, err := oracleabci.DecodeInjectedVoteExtensionTx(injectedBz)
+	require.NoError(t, err)
+	require.Equal(t, injected.ExtendedCommitInfo, decoded.ExtendedCommitInfo)
+
+	// the app decoder delegates normal txs and refuses the injected tx
+	decoder := oracleabci.NewTxDecoder(txConfig.TxDecoder())
+	_, err = decoder(txBz)
+	require.NoError(t, err)
+	_, err = decoder(injectedBz)
+	require.ErrorIs(t, err, types.ErrInvalidInjVoteExt)
+
+	// the marked bytes are never a valid tx for the underlying decoder
+	_, err = txConfig.TxDecoder()(injectedBz)
+	require.Error(t, err)
+}
+
+func TestProcessProposalRejectsMisplacedInjectedTx(t *testing.T) {
+	app := dmchainapp.Setup(t)
+	handler := oracleabci.NewProposalHandler(log.NewNopLogger(), app.OracleKeeper, app.StakingKeeper).ProcessProposalHandler()
+
+	injectedBz, err := oracleabci.EncodeInjectedVoteExtensionTx(types.InjectedVoteExtensionTx{})
+	require.NoError(t, err)
+
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 3})
+	veCtx := ctx.WithConsensusParams(cmtproto.ConsensusParams{Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 1}})
+
+	testCases := []struct {
+		name string
+		ctx  sdk.Context
+		txs  [][]byte
+	}{
+		{"injected tx with vote extensions disabled", ctx, [][]byte{injectedBz}},
+		{"injected tx after the first tx", veCtx, [][]byte{[]byte("tx"), injectedBz}},
+		{"multiple injected txs", veCtx, [][]byte{injectedBz, injectedBz}},
+		{"missing injected tx", veCtx, [][]byte{[]byte("tx")}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := handler(tc.ctx, &cometabci.RequestProcessProposal{Height: 3, Txs: tc.txs})
+			require.ErrorIs(t, err, types.ErrInvalidInjVoteExt)
+			require.Equal(t, cometabci.ResponseProcessProposal_REJECT, res.Status)
+		})
+	}
+}
+
+func TestFinalizeBlockDropsInjectedTx(t *testing.T) {
+	app := dmchainapp.Setup(t)
+	_, err := app.Commit()
+	require.NoError(t, err)
+
+	injectedBz, err := oracleabci.EncodeInjectedVoteExtensionTx(types.InjectedVoteExtensionTx{})
+	require.NoError(t, err)
+	txs := [][]byte{injectedBz, []byte("tx")}
+
+	require.Equal(t, txs[1:], oracleabci.StripInjectedVoteExtensionTx(txs))
+	require.Equal(t, txs[1:], oracleabci.StripInjectedVoteExtensionTx(txs[1:]))
+
+	// the injected tx is not executed, and the results of the other txs keep their position
+	res, err := app.FinalizeBlock(&cometabci.RequestFinalizeBlock{
+		Height: app.LastBlockHeight() + 1,
+		Txs:    txs,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, 2)
+	require.Equal(t, &cometabci.ExecTxResult{}, res.TxResults[0])
+	require.NotEqual(t, uint32(0), res.TxResults[1].Code)
+}
@@ -0,0 +1,61 @@
+package abci
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/DaevMithran/dmchain/x/oracle/keeper"
+)
+
+// blockTxsKey is the context key of the txs of the block being finalized.
+type blockTxsKey struct{}
+
+// WithBlockTxs returns a copy of ctx carrying the txs of the block being finalized. Module
+// PreBlockers do not receive the RequestFinalizeBlock, so the app PreBlocker passes the txs
+// to the oracle PreBlocker through the context.
+func WithBlockTxs(ctx sdk.Context, txs [][]byte) sdk.Context {
+	return ctx.WithValue(blockTxsKey{}, txs)
+}
+
+// blockTxs returns the txs of the block being finalized set by WithBlockTxs.
+func blockTxs(ctx sdk.Context) [][]byte {
+	txs, _ := ctx.Value(blockTxsKey{}).([][]byte)
+	return txs
+}
+
+// PreBlocker stores the exchange rate votes of the InjectedVoteExtensionTx placed at the top
+// of the block by the PrepareProposalHandler and verified by the ProcessProposalHandler. A
+// vote extension vote replaces the commit-reveal vote and prevote of the validator for the
+// vote period, so that both are never tallied together.
+func PreBlocker(ctx sdk.Context, k keeper.Keeper) error {
+	if !VoteExtensionVotesEnabled(ctx, k) {
+		return nil
+	}
+
+	txs := blockTxs(ctx)
+	if len(txs) == 0 || !IsInjectedVoteExtensionTx(txs[0]) {
+		return nil
+	}
+
+	injectedTx, err := DecodeInjectedVoteExtensionTx(txs[0])
+	if err != nil {
+		return err
+	}
+
+	for _, vote := range injectedTx.ExchangeRateVotes {
+		voter, err := sdk.ValAddressFromBech32(vote.Voter)
+		if err != nil {
+			return err
+		}
+
+		k.DeleteAggregateExchangeRatePrevote(ctx, voter)
+		k.SetAggregateExchangeRateVote(ctx, voter, vote)
+	}
+
+	ctx.Logger().Debug(
+		"stored vote extension exchange rate votes",
+		"height", ctx.BlockHeight(),
+		"votes", len(injectedTx.ExchangeRateVotes),
+	)
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package abci_test
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dmchainapp "github.com/DaevMithran/dmchain/app"
+	oracleabci "github.com/DaevMithran/dmchain/x/oracle/abci"
+	"github.com/DaevMithran/dmchain/x/oracle/types"
+)
+
+func TestPreBlockerStoresVoteExtensionVotes(t *testing.T) {
+	app := dmchainapp.Setup(t)
+	_, err := app.Commit()
+	require.NoError(t, err)
+
+	blockTime := time.Now().UTC()
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: app.LastBlockHeight(), Time: blockTime})
+
+	// enable vote extensions at the last block, so they are injected from the next one
+	consensusParams, err := app.ConsensusParamsKeeper.ParamsStore.Get(ctx)
+	require.NoError(t, err)
+	consensusParams.Abci = &cmtproto.ABCIParams{VoteExtensionsEnableHeight: app.LastBlockHeight()}
+	require.NoError(t, app.ConsensusParamsKeeper.ParamsStore.Set(ctx, consensusParams))
+
+	params := app.OracleKeeper.GetParams(ctx)
+	validators, err := app.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	require.NoError(t, err)
+	require.Len(t, validators, 1)
+	voter, err := sdk.ValAddressFromBech32(validators[0].GetOperator())
+	require.NoError(t, err)
+
+	injectedTx := func(rate math.LegacyDec) []byte {
+		// symbol denoms such as DM are too short for sdk.NewDecCoinFromDec
+		var rates sdk.DecCoins
+		for _, denom := range params.AcceptList {
+			rates = append(rates, sdk.DecCoin{Denom: denom.SymbolDenom, Amount: rate})
+		}
+		sort.Sort(rates)
+
+		bz, err := oracleabci.EncodeInjectedVoteExtensionTx(types.InjectedVoteExtensionTx{
+			ExchangeRateVotes: []types.AggregateExchangeRateVote{types.NewAggregateExchangeRateVote(rates, voter)},
+		})
+		require.NoError(t, err)
+		return bz
+	}
+
+	// drive blocks through several vote periods, voting a new rate in each period
+	for period := int64(1); period <= 3; period++ {
+		rate := math.LegacyNewDec(period)
+
+		for {
+			height := app.LastBlockHeight() + 1
+			blockTime = blockTime.Add(5 * time.Second)
+
+			res, err := app.FinalizeBlock(&cometabci.RequestFinalizeBlock{
+				Height: height,
+				Time:   blockTime,
+				Txs:    [][]byte{injectedTx(rate)},
+			})
+			require.NoError(t, err)
+			// the injected tx is skipped rather than failing to decode
+			require.Len(t, res.TxResults, 1)
+			require.Equal(t, uint32(0), res.TxResults[0].Code)
+			_, err = app.Commit()
+			require.NoError(t, err)
+
+			ctx = app.NewUncachedContext(false, cmtproto.Header{Height: height, Time: blockTime})
+			if app.OracleKeeper.IsPeriodLastBlock(ctx, params.VotePeriod) {
+				break
+			}
+
+			// the vote is stored until it is tallied at the end of the vote period
+			vote, err := app.OracleKeeper.GetAggregateExchangeRateVote(ctx, voter)
+			require.NoError(t, err)
+			require.Len(t, vote.ExchangeRates, len(params.AcceptList))
+			require.Equal(t, rate, vote.ExchangeRates[0].Amount)
+		}
+
+		for _, denom := range params.AcceptList {
+			exchangeRate, err := app.OracleKeeper.GetExchangeRate(ctx, denom.SymbolDenom)
+			require.NoError(t, err)
+			require.Equal(t, rate, exchangeRate)
+		}
+
+		_, err = app.OracleKeeper.GetAggregateExchangeRateVote(ctx, voter)
+		require.Error(t, err)
+	}
+}
+
+func TestPreBlockerIgnoresVoteExtensionsInCommitRevealMode(t *testing.T) {
+	app := dmchainapp.Setup(t)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 3}).
+		WithConsensusParams(cmtproto.ConsensusParams{Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 1}})
+	app.OracleKeeper.SetPriceSubmissionMode(ctx, types.PriceSubmissionMode_PRICE_SUBMISSION_MODE_COMMIT_REVEAL)
+
+	voter := sdk.ValAddress([]byte("voter_______________"))
+	bz, err := oracleabci.EncodeInjectedVoteExtensionTx(types.InjectedVoteExtensionTx{
+		ExchangeRateVotes: []types.AggregateExchangeRateVote{types.NewAggregateExchangeRateVote(nil, voter)},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, oracleabci.PreBlocker(oracleabci.WithBlockTxs(ctx, [][]byte{bz}), app.OracleKeeper))
+	_, err = app.OracleKeeper.GetAggregateExchangeRateVote(ctx, voter)
+	require.Error(t, err)
+
+	app.OracleKeeper.SetPriceSubmissionMode(ctx, types.PriceSubmissionMode_PRICE_SUBMISSION_MODE_VOTE_EXTENSIONS)
+	require.NoError(t, oracleabci.PreBlocker(oracleabci.WithBlockTxs(ctx, [][]byte{bz}), app.OracleKeeper))
+	_, err = app.OracleKeeper.GetAggregateExchangeRateVote(ctx, voter)
+	require.NoError(t, err)
+}
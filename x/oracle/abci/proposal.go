@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sort"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	cometabci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -57,9 +58,15 @@ func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 			)
 			return &cometabci.ResponsePrepareProposal{Txs: make([][]byte, 0)}, err
 		}
-		proposalTxs := req.Txs
+		// the injected tx is only ever placed by the proposer, drop any marked tx from the mempool
+		proposalTxs := make([][]byte, 0, len(req.Txs)+1)
+		for _, tx := range req.Txs {
+			if !IsInjectedVoteExtensionTx(tx) {
+				proposalTxs = append(proposalTxs, tx)
+			}
+		}
 
-		voteExtensionsEnabled := VoteExtensionsEnabled(ctx)
+		voteExtensionsEnabled := VoteExtensionVotesEnabled(ctx, h.oracleKeeper)
 		if voteExtensionsEnabled {
 			exchangeRateVotes, err := h.generateExchangeRateVotes(ctx, req.LocalLastCommit)
 			if err != nil {
@@ -75,7 +82,7 @@ func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 				ExtendedCommitInfo: extendedCommitInfoBz,
 			}
 
-			bz, err := injectedVoteExtTx.Marshal()
+			bz, err := EncodeInjectedVoteExtensionTx(injectedVoteExtTx)
 			if err != nil {
 				h.logger.Error("failed to encode injected vote extension tx", "err", err)
 				return &cometabci.ResponsePrepareProposal{Txs: make([][]byte, 0)}, oracletypes.ErrEncodeInjVoteExt
@@ -115,7 +122,17 @@ func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 			return rejectProposal(), err
 		}
 
-		if VoteExtensionsEnabled(ctx) {
+		// only the first tx of the block may be the injected tx, and only with vote extensions enabled
+		voteExtensionsEnabled := VoteExtensionVotesEnabled(ctx, h.oracleKeeper)
+		for i, tx := range req.Txs {
+			if IsInjectedVoteExtensionTx(tx) && (i > 0 || !voteExtensionsEnabled) {
+				err := errorsmod.Wrapf(oracletypes.ErrInvalidInjVoteExt, "unexpected injected tx at index %d", i)
+				h.logger.Error("invalid proposal", "height", req.Height, "err", err)
+				return rejectProposal(), err
+			}
+		}
+
+		if voteExtensionsEnabled {
 			result, err := h.handleVoteExtensions(ctx, req)
 			if err != nil {
 				h.logger.Error("vote extension validation failed", "err", err)
@@ -127,7 +144,7 @@ func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 
 		h.logger.Info("processed proposal",
 			"txs", len(req.Txs),
-			"vote_extensions_enabled", VoteExtensionsEnabled(ctx),
+			"vote_extensions_enabled", voteExtensionsEnabled,
 		)
 
 		return &cometabci.ResponseProcessProposal{Status: cometabci.ResponseProcessProposal_ACCEPT}, nil
@@ -143,7 +160,7 @@ func (h *ProposalHandler) handleVoteExtensions(
 		return rejectProposal(), oracletypes.ErrNoCommitInfo
 	}
 
-	injectedTx, err := h.extractInjectedVoteExtensionTx(req.Txs)
+	injectedTx, err := DecodeInjectedVoteExtensionTx(req.Txs[0])
 	if err != nil {
 		h.logger.Error("failed to extract injected vote extension tx", "err", err)
 		return rejectProposal(), err
@@ -171,16 +188,6 @@ func (h *ProposalHandler) handleVoteExtensions(
 	return &cometabci.ResponseProcessProposal{Status: cometabci.ResponseProcessProposal_ACCEPT}, nil
 }
 
-func (h *ProposalHandler) extractInjectedVoteExtensionTx(txs [][]byte) (oracletypes.InjectedVoteExtensionTx, error) {
-	for _, tx := range txs {
-		var injected oracletypes.InjectedVoteExtensionTx
-		if err := injected.Unmarshal(tx); err == nil {
-			return injected, nil
-		}
-	}
-	return oracletypes.InjectedVoteExtensionTx{}, fmt.Errorf("failed to decode injected vote extension tx")
-}
-
 func rejectProposal() *cometabci.ResponseProcessProposal {
 	return &cometabci.ResponseProcessProposal{Status: cometabci.ResponseProcessProposal_REJECT}
 }
@@ -189,18 +196,27 @@ func (h *ProposalHandler) generateExchangeRateVotes(
 	ctx sdk.Context,
 	ci cometabci.ExtendedCommitInfo,
 ) (votes []oracletypes.AggregateExchangeRateVote, err error) {
+	params := h.oracleKeeper.GetParams(ctx)
 	for _, vote := range ci.Votes {
 		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
 			continue
 		}
 
+		// a malformed vote extension only drops the vote of its validator
 		var voteExt oracletypes.OracleVoteExtension
 		if err := voteExt.Unmarshal(vote.VoteExtension); err != nil {
 			h.logger.Error(
-				"failed to decode vote extension",
+				"skipping undecodable vote extension",
 				"err", err,
 			)
-			return nil, err
+			continue
+		}
+		if err := voteExt.ValidateExchangeRates(params.AcceptList, params.MaxVoteExtensionRates); err != nil {
+			h.logger.Error(
+				"skipping invalid vote extension",
+				"err", err,
+			)
+			continue
 		}
 
 		var valConsAddr sdk.ConsAddress
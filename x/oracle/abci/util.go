@@ -2,6 +2,8 @@ package abci
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/DaevMithran/dmchain/x/oracle/keeper"
 )
 
 // VoteExtensionsEnabled determines if vote extensions are enabled for the current block.
@@ -21,3 +23,9 @@ func VoteExtensionsEnabled(ctx sdk.Context) bool {
 
 	return cp.Abci.VoteExtensionsEnableHeight < ctx.BlockHeight()
 }
+
+// VoteExtensionVotesEnabled determines if the exchange rates of vote extensions are injected and
+// stored for the current block, which requires the PriceSubmissionMode to accept them.
+func VoteExtensionVotesEnabled(ctx sdk.Context, k keeper.Keeper) bool {
+	return VoteExtensionsEnabled(ctx) && k.PriceSubmissionMode(ctx).AcceptsVoteExtensions()
+}
@@ -31,6 +31,8 @@ func NewVoteExtensionHandler(
 
 // ExtendVoteHandler creates an OracleVoteExtension using the prices fetched from the price feeder
 // service. It will filter out exchange rates that are not part of the oracle module's accept list.
+// Vote extensions are created in every PriceSubmissionMode, so that the first block after a switch
+// to a mode accepting them already injects their exchange rates.
 func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
 	return func(ctx sdk.Context, req *cometabci.RequestExtendVote) (resp *cometabci.ResponseExtendVote, err error) {
 		defer func() {
@@ -87,6 +89,17 @@ func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
 			ExchangeRates: filteredDecCoins,
 		}
 
+		// Check the rates as the VerifyVoteExtensionHandler of the other validators will.
+		params := h.oracleKeeper.GetParams(ctx)
+		if err := voteExt.ValidateExchangeRates(params.AcceptList, params.MaxVoteExtensionRates); err != nil {
+			err := fmt.Errorf("extend vote handler created invalid exchange rates: %w", err)
+			h.logger.Error(
+				"height", req.Height,
+				err.Error(),
+			)
+			return &cometabci.ResponseExtendVote{VoteExtension: []byte{}}, err
+		}
+
 		bz, err := voteExt.Marshal()
 		if err != nil {
 			err := fmt.Errorf("failed to marshal vote extension: %w", err)
@@ -106,7 +119,9 @@ func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
 }
 
 // VerifyVoteExtensionHandler validates the OracleVoteExtension created by the ExtendVoteHandler. It
-// verifies that the vote extension can unmarshal correctly and is for the correct height.
+// verifies that the vote extension can unmarshal correctly, is for the correct height and only
+// contains positive exchange rates of distinct AcceptList denoms, within the MaxVoteExtensionRates
+// param.
 func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
 	return func(ctx sdk.Context, req *cometabci.RequestVerifyVoteExtension) (
 		*cometabci.ResponseVerifyVoteExtension,
@@ -149,6 +164,16 @@ func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtens
 			return &cometabci.ResponseVerifyVoteExtension{Status: cometabci.ResponseVerifyVoteExtension_REJECT}, err
 		}
 
+		params := h.oracleKeeper.GetParams(ctx)
+		if err := voteExt.ValidateExchangeRates(params.AcceptList, params.MaxVoteExtensionRates); err != nil {
+			err := fmt.Errorf("verify vote extension handler received invalid exchange rates: %w", err)
+			h.logger.Error(
+				"height", req.Height,
+				err.Error(),
+			)
+			return &cometabci.ResponseVerifyVoteExtension{Status: cometabci.ResponseVerifyVoteExtension_REJECT}, err
+		}
+
 		h.logger.Info(
 			"verfied vote extension",
 			"height", req.Height,
@@ -0,0 +1,73 @@
+package abci_test
+
+import (
+	"testing"
+
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dmchainapp "github.com/DaevMithran/dmchain/app"
+	oracleabci "github.com/DaevMithran/dmchain/x/oracle/abci"
+	"github.com/DaevMithran/dmchain/x/oracle/types"
+)
+
+func TestVerifyVoteExtensionHandler(t *testing.T) {
+	app := dmchainapp.Setup(t)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 3})
+	handler := oracleabci.NewVoteExtensionHandler(log.NewNopLogger(), app.OracleKeeper).VerifyVoteExtensionHandler()
+
+	params := app.OracleKeeper.GetParams(ctx)
+	params.MaxVoteExtensionRates = uint64(len(params.AcceptList))
+	app.OracleKeeper.SetParams(ctx, params)
+
+	// symbol denoms such as DM are too short for sdk.NewDecCoinFromDec
+	rate := func(denom string, amount int64) sdk.DecCoin {
+		return sdk.DecCoin{Denom: denom, Amount: math.LegacyNewDec(amount)}
+	}
+	var acceptedRates sdk.DecCoins
+	for _, denom := range params.AcceptList {
+		acceptedRates = append(acceptedRates, rate(denom.SymbolDenom, 1))
+	}
+
+	testCases := []struct {
+		name   string
+		height int64
+		rates  sdk.DecCoins
+		reject bool
+		err    error
+	}{
+		{"valid", 3, acceptedRates, false, nil},
+		{"wrong height", 2, acceptedRates, true, nil},
+		{"unknown denom", 3, sdk.DecCoins{rate("FOO", 1)}, true, types.ErrUnknownDenom},
+		{"duplicated denom", 3, sdk.DecCoins{rate(types.DmSymbol, 1), rate(types.DmSymbol, 2)}, true, types.ErrInvalidVoteExt},
+		{"non-positive rate", 3, sdk.DecCoins{rate(types.DmSymbol, 0)}, true, types.ErrNegativeOrZeroRate},
+		{"too many rates", 3, append(acceptedRates, rate(types.DmSymbol, 1)), true, types.ErrInvalidVoteExt},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := (&types.OracleVoteExtension{Height: tc.height, ExchangeRates: tc.rates}).Marshal()
+			require.NoError(t, err)
+
+			res, err := handler(ctx, &cometabci.RequestVerifyVoteExtension{Height: 3, VoteExtension: bz})
+			if !tc.reject {
+				require.NoError(t, err)
+				require.Equal(t, cometabci.ResponseVerifyVoteExtension_ACCEPT, res.Status)
+				return
+			}
+
+			require.Error(t, err)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			}
+			require.Equal(t, cometabci.ResponseVerifyVoteExtension_REJECT, res.Status)
+		})
+	}
+}
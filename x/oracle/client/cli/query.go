@@ -1,13 +1,18 @@
 package cli
 
 import (
+	"context"
+	"encoding/csv"
 	"fmt"
+	"io"
+	"os"
 	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/spf13/cobra"
 
 	"github.com/DaevMithran/dmchain/util/cli"
@@ -36,6 +41,13 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryEMA(),
 		CmdQueryWMA(),
 		CmdQuerySMA(),
+		CmdQueryTWAP(),
+		CmdQueryHistoricPrices(),
+		CmdQueryCandles(),
+		CmdQueryPriceStatistics(),
+		CmdQueryCrossRate(),
+		CmdQueryLastExchangeRates(),
+		CmdQueryPriceHalts(),
 	)
 
 	return cmd
@@ -369,3 +381,397 @@ func CmdQuerySMA() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// CmdQueryTWAP implements the query twap command.
+func CmdQueryTWAP() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [denom] [window-seconds] [end-height]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Query the time-weighted average price of the given denom",
+		Long: strings.TrimSpace(`
+Query the block-time-weighted average of the historic prices of a denom over a
+window in seconds, ending at the current height or at the given end height.
+
+$ simd query oracle twap ATOM 3600
+$ simd query oracle twap ATOM 3600 1000
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			window, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var endHeight uint64
+			if len(args) == 3 {
+				endHeight, err = strconv.ParseUint(args[2], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TWAP(cmd.Context(), &types.QueryTWAPRequest{
+				Denom:         strings.ToUpper(args[0]),
+				WindowSeconds: window,
+				EndHeight:     endHeight,
+			})
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+const (
+	flagStartBlock = "start-block"
+	flagEndBlock   = "end-block"
+	flagCSV        = "csv"
+	flagNumStamps  = "num-stamps"
+	flagPercentile = "percentiles"
+	flagSource     = "source"
+	flagWindow     = "window-seconds"
+	flagBaseDenoms = "base-denoms"
+)
+
+// CmdQueryHistoricPrices implements the query historic prices command.
+func CmdQueryHistoricPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "historic-prices [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the historic prices of the given denom",
+		Long: strings.TrimSpace(`
+Query a page of the historic prices of a denom, optionally within a range of
+blocks. With --csv, all the pages from the given one on are streamed to a CSV
+file, or to the standard output if the file is "-".
+
+$ simd query oracle historic-prices ATOM --start-block 1000 --end-block 2000
+$ simd query oracle historic-prices ATOM --csv atom.csv
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startBlock, err := cmd.Flags().GetUint64(flagStartBlock)
+			if err != nil {
+				return err
+			}
+			endBlock, err := cmd.Flags().GetUint64(flagEndBlock)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryHistoricPricesRequest{
+				Denom:      strings.ToUpper(args[0]),
+				StartBlock: startBlock,
+				EndBlock:   endBlock,
+				Pagination: pageReq,
+			}
+
+			csvPath, err := cmd.Flags().GetString(flagCSV)
+			if err != nil {
+				return err
+			}
+			if csvPath == "" {
+				res, err := queryClient.HistoricPrices(cmd.Context(), req)
+				return cli.PrintOrErr(res, err, clientCtx)
+			}
+
+			if csvPath == "-" {
+				return writeHistoricPricesCSV(cmd.Context(), queryClient, req, cmd.OutOrStdout())
+			}
+
+			file, err := os.Create(csvPath)
+			if err != nil {
+				return err
+			}
+			if err := writeHistoricPricesCSV(cmd.Context(), queryClient, req, file); err != nil {
+				file.Close()
+				return err
+			}
+			return file.Close()
+		},
+	}
+
+	cmd.Flags().Uint64(flagStartBlock, 0, "First block to query prices from")
+	cmd.Flags().Uint64(flagEndBlock, 0, "Last block to query prices from")
+	cmd.Flags().String(flagCSV, "", "Stream all the pages to a CSV file, or to the standard output if \"-\"")
+	flags.AddPaginationFlagsToCmd(cmd, "historic prices")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// writeHistoricPricesCSV writes the historic prices of req and of all the
+// pages following it to w as CSV, flushing each page as it is received.
+func writeHistoricPricesCSV(
+	ctx context.Context,
+	queryClient types.QueryClient,
+	req *types.QueryHistoricPricesRequest,
+	w io.Writer,
+) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"denom", "block_num", "exchange_rate"}); err != nil {
+		return err
+	}
+
+	for {
+		res, err := queryClient.HistoricPrices(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		for _, price := range res.HistoricPrices {
+			record := []string{
+				price.ExchangeRate.Denom,
+				strconv.FormatUint(price.BlockNum, 10),
+				price.ExchangeRate.Amount.String(),
+			}
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return err
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return nil
+		}
+
+		// continue from the next key, the offset only applies to the first page
+		pageReq := query.PageRequest{}
+		if req.Pagination != nil {
+			pageReq = *req.Pagination
+		}
+		pageReq.Key = res.Pagination.NextKey
+		pageReq.Offset = 0
+		pageReq.CountTotal = false
+		req.Pagination = &pageReq
+	}
+}
+
+// CmdQueryCandles implements the query candles command.
+func CmdQueryCandles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "candles [denom] [interval]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the OHLC candles of the given denom for a candle interval in blocks",
+		Long: strings.TrimSpace(`
+Query a page of the OHLC candles of a denom for one of the CandleIntervals
+param, in blocks.
+
+$ simd query oracle candles ATOM 600
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			interval, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Candles(cmd.Context(), &types.QueryCandlesRequest{
+				Denom:      strings.ToUpper(args[0]),
+				Interval:   interval,
+				Pagination: pageReq,
+			})
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "candles")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryPriceStatistics implements the query price statistics command.
+func CmdQueryPriceStatistics() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-statistics [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the statistics of the latest historic prices of the given denom",
+		Long: strings.TrimSpace(`
+Query the min, max, mean, median, standard deviation, realized volatility and
+percentiles of the latest historic prices of a denom.
+
+$ simd query oracle price-statistics ATOM --num-stamps 20 --percentiles 10,50,90
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			numStamps, err := cmd.Flags().GetUint64(flagNumStamps)
+			if err != nil {
+				return err
+			}
+			percentiles, err := cmd.Flags().GetUintSlice(flagPercentile)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPriceStatisticsRequest{
+				Denom:     strings.ToUpper(args[0]),
+				NumStamps: numStamps,
+			}
+			for _, percentile := range percentiles {
+				if percentile > 100 {
+					return fmt.Errorf("percentile %d is over 100", percentile)
+				}
+				req.Percentiles = append(req.Percentiles, uint32(percentile))
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PriceStatistics(cmd.Context(), req)
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	cmd.Flags().Uint64(flagNumStamps, 0, "Amount of latest historic prices, the MaximumPriceStamps param if zero")
+	cmd.Flags().UintSlice(flagPercentile, nil, "Percentiles in whole percent, 5,25,75,95 if empty")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryCrossRate implements the query cross rate command.
+func CmdQueryCrossRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cross-rate [base] [quote]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the rate of the given base denom in the given quote denom",
+		Long: strings.TrimSpace(`
+Query the rate of a base denom in a quote denom derived from their USD rates,
+which are the spot exchange rates or their sma, ema or twap.
+
+$ simd query oracle cross-rate ATOM OSMO
+$ simd query oracle cross-rate ATOM OSMO --source twap --window-seconds 3600
+$ simd query oracle cross-rate uatom uosmo --base-denoms
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sourceName, err := cmd.Flags().GetString(flagSource)
+			if err != nil {
+				return err
+			}
+			source, ok := types.PriceSource_value["PRICE_SOURCE_"+strings.ToUpper(sourceName)]
+			if !ok {
+				return fmt.Errorf("unknown price source %s", sourceName)
+			}
+			window, err := cmd.Flags().GetUint64(flagWindow)
+			if err != nil {
+				return err
+			}
+			baseDenoms, err := cmd.Flags().GetBool(flagBaseDenoms)
+			if err != nil {
+				return err
+			}
+
+			base, quote := args[0], args[1]
+			if !baseDenoms {
+				base, quote = strings.ToUpper(base), strings.ToUpper(quote)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CrossRate(cmd.Context(), &types.QueryCrossRateRequest{
+				Base:          base,
+				Quote:         quote,
+				Source:        types.PriceSource(source),
+				WindowSeconds: window,
+				BaseDenoms:    baseDenoms,
+			})
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	cmd.Flags().String(flagSource, "spot", "USD rate source, one of spot, sma, ema or twap")
+	cmd.Flags().Uint64(flagWindow, 0, "TWAP window in seconds, 30 minutes if zero")
+	cmd.Flags().Bool(flagBaseDenoms, false, "Treat base and quote as base denoms of the accept list")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryLastExchangeRates implements the query last exchange rates command.
+func CmdQueryLastExchangeRates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "last-exchange-rates [denom]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the last exchange rates with their age and staleness",
+		Long: strings.TrimSpace(`
+Query the last exchange rates of all denoms, or of the given denom, with the
+blocks and time since they were updated. Exchange rates which were not updated
+for more than MaximumRateStaleness blocks are flagged stale.
+
+$ simd query oracle last-exchange-rates
+$ simd query oracle last-exchange-rates ATOM
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			if len(args) == 0 {
+				res, err := queryClient.LastExchangeRates(cmd.Context(), &types.QueryLastExchangeRatesRequest{})
+				return cli.PrintOrErr(res, err, clientCtx)
+			}
+
+			res, err := queryClient.LastExchangeRate(cmd.Context(), &types.QueryLastExchangeRateRequest{
+				Denom: strings.ToUpper(args[0]),
+			})
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryPriceHalts implements the query price halts command.
+func CmdQueryPriceHalts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-halts",
+		Args:  cobra.NoArgs,
+		Short: "Query the tripped price circuit breakers",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PriceHalts(cmd.Context(), &types.QueryPriceHaltsRequest{})
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -75,6 +75,34 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, genState types.GenesisSt
 		)
 	}
 
+	for _, stampTime := range genState.HistoricStampTimes {
+		keeper.SetHistoricStampTime(ctx, stampTime.BlockNum, stampTime.BlockTime)
+	}
+
+	for _, candle := range genState.Candles {
+		if err := keeper.SetCandle(ctx, candle); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, update := range genState.ExchangeRateUpdates {
+		if err := keeper.ExchangeRateUpdates.Set(ctx, update.Denom, update); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, halt := range genState.PriceHalts {
+		if err := keeper.PriceHalts.Set(ctx, halt.Denom, halt); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, denom := range genState.CircuitBreakerResets {
+		if err := keeper.CircuitBreakerResets.Set(ctx, denom); err != nil {
+			panic(err)
+		}
+	}
+
 	keeper.SetParams(ctx, genState.Params)
 
 	// check if the module account exists
@@ -163,6 +191,51 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		},
 	)
 
+	historicStampTimes := []types.HistoricStampTime{}
+	keeper.IterateAllHistoricStampTimes(
+		ctx,
+		func(stampTime types.HistoricStampTime) bool {
+			historicStampTimes = append(historicStampTimes, stampTime)
+			return false
+		},
+	)
+
+	candles := []types.Candle{}
+	keeper.IterateAllCandles(
+		ctx,
+		func(candle types.Candle) bool {
+			candles = append(candles, candle)
+			return false
+		},
+	)
+
+	exchangeRateUpdates := []types.ExchangeRateUpdate{}
+	keeper.IterateExchangeRateUpdates(
+		ctx,
+		func(update types.ExchangeRateUpdate) bool {
+			exchangeRateUpdates = append(exchangeRateUpdates, update)
+			return false
+		},
+	)
+
+	priceHalts := []types.PriceHalt{}
+	keeper.IteratePriceHalts(
+		ctx,
+		func(halt types.PriceHalt) bool {
+			priceHalts = append(priceHalts, halt)
+			return false
+		},
+	)
+
+	circuitBreakerResets := []string{}
+	keeper.IterateCircuitBreakerResets(
+		ctx,
+		func(denom string) bool {
+			circuitBreakerResets = append(circuitBreakerResets, denom)
+			return false
+		},
+	)
+
 	return types.NewGenesisState(
 		params,
 		exchangeRates,
@@ -173,5 +246,10 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		historicPrices,
 		medianPrices,
 		medianDeviationPrices,
+		historicStampTimes,
+		candles,
+		exchangeRateUpdates,
+		priceHalts,
+		circuitBreakerResets,
 	)
 }
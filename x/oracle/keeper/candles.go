@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/DaevMithran/dmchain/util"
+	"github.com/DaevMithran/dmchain/x/oracle/types"
+)
+
+// UpdateCandles updates the OHLC candles of a given denom for each of the
+// CandleIntervals with its historic price at the current block. A candle of an
+// interval starts at the first block of the interval, and opens with the first
+// price stamped in it. Opening a candle prunes the oldest candles of the
+// interval beyond MaximumCandles.
+func (k Keeper) UpdateCandles(
+	ctx sdk.Context,
+	denom string,
+	price math.LegacyDec,
+) error {
+	block := util.SafeInt64ToUint64(ctx.BlockHeight())
+	maximumCandles := k.MaximumCandles(ctx)
+
+	for _, interval := range k.CandleIntervals(ctx) {
+		key := collections.Join3(denom, interval, block-block%interval)
+		candle, err := k.Candles.Get(ctx, key)
+		switch {
+		case errors.Is(err, collections.ErrNotFound):
+			candle = types.NewCandle(denom, interval, key.K3(), price)
+			if err := k.Candles.Set(ctx, key, candle); err != nil {
+				return err
+			}
+			if err := k.pruneCandles(ctx, denom, interval, maximumCandles); err != nil {
+				return err
+			}
+			continue
+
+		case err != nil:
+			return err
+		}
+
+		candle.Update(price)
+		if err := k.Candles.Set(ctx, key, candle); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetCandle sets a candle to the store.
+func (k Keeper) SetCandle(ctx sdk.Context, candle types.Candle) error {
+	return k.Candles.Set(ctx, collections.Join3(candle.Denom, candle.Interval, candle.StartBlock), candle)
+}
+
+// pruneCandles deletes the candles of a given denom and interval older than
+// its newest maximumCandles candles.
+func (k Keeper) pruneCandles(
+	ctx sdk.Context,
+	denom string,
+	interval uint64,
+	maximumCandles uint64,
+) error {
+	rng := new(collections.Range[collections.Triple[string, uint64, uint64]]).
+		Prefix(collections.TripleSuperPrefix[string, uint64, uint64](denom, interval)).
+		Descending()
+	it, err := k.Candles.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+
+	var (
+		kept  uint64
+		stale []collections.Triple[string, uint64, uint64]
+	)
+	for ; it.Valid(); it.Next() {
+		if kept < maximumCandles {
+			kept++
+			continue
+		}
+
+		key, err := it.Key()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		stale = append(stale, key)
+	}
+	it.Close()
+
+	for _, key := range stale {
+		if err := k.Candles.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	oracle "github.com/DaevMithran/dmchain/x/oracle"
+	"github.com/DaevMithran/dmchain/x/oracle/keeper"
+	"github.com/DaevMithran/dmchain/x/oracle/types"
+)
+
+func TestUpdateCandles(t *testing.T) {
+	app := setupApp(t)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{})
+	app.OracleKeeper.SetCandleIntervals(ctx, []uint64{10, 30})
+	app.OracleKeeper.SetMaximumCandles(ctx, 2)
+
+	// prices are stamped every 5 blocks from block 4
+	prices := []int64{5, 7, 3, 4, 9, 8, 6, 2}
+	for i, price := range prices {
+		stampCtx := ctx.WithBlockHeight(int64(4 + 5*i))
+		require.NoError(t, app.OracleKeeper.UpdateCandles(stampCtx, types.USDCSymbol, math.LegacyNewDec(price)))
+	}
+
+	candle := func(interval, startBlock uint64, open, high, low, close int64) types.Candle {
+		return types.Candle{
+			Denom:      types.USDCSymbol,
+			Interval:   interval,
+			StartBlock: startBlock,
+			Open:       math.LegacyNewDec(open),
+			High:       math.LegacyNewDec(high),
+			Low:        math.LegacyNewDec(low),
+			Close:      math.LegacyNewDec(close),
+		}
+	}
+
+	querier := keeper.NewQuerier(app.OracleKeeper)
+	testCases := []struct {
+		name     string
+		interval uint64
+		page     *query.PageRequest
+		candles  []types.Candle
+		next     bool
+	}{
+		{
+			// blocks 0-9 and 10-19 are pruned
+			name:     "short interval",
+			interval: 10,
+			candles:  []types.Candle{candle(10, 20, 9, 9, 8, 8), candle(10, 30, 6, 6, 2, 2)},
+		},
+		{
+			name:     "long interval",
+			interval: 30,
+			candles:  []types.Candle{candle(30, 0, 5, 9, 3, 8), candle(30, 30, 6, 6, 2, 2)},
+		},
+		{
+			name:     "paginated in reverse",
+			interval: 30,
+			page:     &query.PageRequest{Limit: 1, Reverse: true},
+			candles:  []types.Candle{candle(30, 30, 6, 6, 2, 2)},
+			next:     true,
+		},
+		{
+			name:     "not an interval",
+			interval: 20,
+			candles:  []types.Candle{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := querier.Candles(ctx, &types.QueryCandlesRequest{
+				Denom:      "usdc",
+				Interval:   tc.interval,
+				Pagination: tc.page,
+			})
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.candles, res.Candles)
+			require.Equal(t, tc.next, len(res.Pagination.NextKey) != 0)
+		})
+	}
+
+	// candles are not recorded without intervals
+	app.OracleKeeper.SetCandleIntervals(ctx, []uint64{})
+	require.NoError(t, app.OracleKeeper.UpdateCandles(ctx.WithBlockHeight(100), types.USDTSymbol, math.LegacyOneDec()))
+
+	genesis := oracle.ExportGenesis(ctx, app.OracleKeeper)
+	require.Len(t, genesis.Candles, 4)
+	for _, candle := range genesis.Candles {
+		require.Equal(t, types.USDCSymbol, candle.Denom)
+	}
+}
+
+func TestLegacyGovUpdateCandleIntervals(t *testing.T) {
+	app := setupApp(t)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.OracleKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	intervals := app.OracleKeeper.CandleIntervals(ctx)
+
+	testCases := []struct {
+		name      string
+		intervals []uint64
+		errMsg    string
+	}{
+		{"zero interval", []uint64{0}, "oracle parameter CandleIntervals must be > 0"},
+		{"duplicate interval", []uint64{10, 30, 10}, "oracle parameter CandleIntervals has a duplicate interval"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.LegacyGovUpdateParams(ctx, &types.MsgLegacyGovUpdateParams{
+				Authority: authority,
+				Keys:      []string{string(types.KeyCandleIntervals)},
+				Changes:   types.Params{CandleIntervals: tc.intervals},
+			})
+			require.ErrorContains(t, err, tc.errMsg)
+			require.Equal(t, intervals, app.OracleKeeper.CandleIntervals(ctx))
+		})
+	}
+
+	_, err := msgServer.LegacyGovUpdateParams(ctx, &types.MsgLegacyGovUpdateParams{
+		Authority: authority,
+		Keys:      []string{string(types.KeyCandleIntervals)},
+		Changes:   types.Params{CandleIntervals: []uint64{10, 30}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10, 30}, app.OracleKeeper.CandleIntervals(ctx))
+}
@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/DaevMithran/dmchain/util"
+	"github.com/DaevMithran/dmchain/x/oracle/types"
+)
+
+const (
+	// HaltReasonPreviousRate is the reason of a halt on a move from the
+	// previous exchange rate.
+	HaltReasonPreviousRate = "previous_rate"
+	// HaltReasonHistoricMedian is the reason of a halt on a move from the
+	// latest historic median beyond its deviation.
+	HaltReasonHistoricMedian = "historic_median"
+)
+
+// ApplyCircuitBreaker checks a new exchange rate of a given denom against its
+// circuit breaker before it is published, and returns whether it is halted.
+//
+// The circuit breaker of a denom in the MaxPriceMoves param trips when the new
+// exchange rate moved more than its max move from the previous exchange rate,
+// or from the latest historic median while the latest historic price is
+// outside its median deviation, as WithinHistoricMedianDeviation checks. A halted denom keeps its previous
+// exchange rate, which then ages towards staleness, until governance resets
+// its circuit breaker. A denom halted before it was ever priced stays without
+// an exchange rate. The first exchange rate after a reset is published
+// without checks.
+func (k Keeper) ApplyCircuitBreaker(ctx sdk.Context, denom string, exchangeRate math.LegacyDec) (bool, error) {
+	denom = strings.ToUpper(denom)
+
+	if halt, found := k.GetPriceHalt(ctx, denom); found {
+		if halt.HaltedRate.IsPositive() {
+			k.SetExchangeRate(ctx, denom, halt.HaltedRate)
+		}
+		return true, nil
+	}
+
+	reset, err := k.CircuitBreakerResets.Has(ctx, denom)
+	if err != nil {
+		return false, err
+	}
+	if reset {
+		return false, k.CircuitBreakerResets.Remove(ctx, denom)
+	}
+
+	maxMove, found := k.MaxPriceMoves(ctx).GetMaxMoveFromDenom(denom)
+	if !found {
+		return false, nil
+	}
+
+	reason, err := k.priceMoveBreach(ctx, denom, exchangeRate, maxMove)
+	if err != nil || len(reason) == 0 {
+		return false, err
+	}
+
+	// keep the previous exchange rate, if the denom was ever priced
+	previous, err := k.ExchangeRateUpdates.Get(ctx, denom)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return false, err
+	}
+	haltedRate := math.LegacyZeroDec()
+	if err == nil && previous.ExchangeRate.IsPositive() {
+		haltedRate = previous.ExchangeRate
+		k.SetExchangeRate(ctx, denom, haltedRate)
+	}
+
+	halt := types.PriceHalt{
+		Denom:        denom,
+		HaltedRate:   haltedRate,
+		RejectedRate: exchangeRate,
+		BlockNum:     util.SafeInt64ToUint64(ctx.BlockHeight()),
+		Reason:       reason,
+	}
+	if err := k.PriceHalts.Set(ctx, denom, halt); err != nil {
+		return false, err
+	}
+
+	ctx.Logger().Info("circuit breaker tripped, halting exchange rate", "denom", denom, "reason", reason)
+	return true, ctx.EventManager().EmitTypedEvent(&types.EventPriceHalted{
+		Denom:        denom,
+		HaltedRate:   haltedRate,
+		RejectedRate: exchangeRate,
+		Reason:       reason,
+	})
+}
+
+// priceMoveBreach returns the reason a new exchange rate of a given denom
+// breaches a given max move, or an empty string if it does not.
+func (k Keeper) priceMoveBreach(
+	ctx sdk.Context,
+	denom string,
+	exchangeRate math.LegacyDec,
+	maxMove math.LegacyDec,
+) (string, error) {
+	previous, err := k.ExchangeRateUpdates.Get(ctx, denom)
+	switch {
+	case err == nil:
+		if movedMoreThan(exchangeRate, previous.ExchangeRate, maxMove) {
+			return HaltReasonPreviousRate, nil
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return "", err
+	}
+
+	// denoms are not checked against their median until it is stamped
+	medians := k.HistoricMedians(ctx, denom, 1)
+	if len(medians) == 0 {
+		return "", nil
+	}
+	within, err := k.WithinHistoricMedianDeviation(ctx, denom)
+	switch {
+	case errors.Is(err, types.ErrNoMedianDeviation):
+		// the denom was not stamped in the last median stamp period
+		return "", nil
+	case err != nil:
+		return "", err
+	}
+
+	if !within && movedMoreThan(exchangeRate, medians[0].ExchangeRate.Amount, maxMove) {
+		return HaltReasonHistoricMedian, nil
+	}
+
+	return "", nil
+}
+
+// movedMoreThan returns whether a given price moved more than a given
+// relative move from a given reference price.
+func movedMoreThan(price, reference, maxMove math.LegacyDec) bool {
+	if !reference.IsPositive() {
+		return false
+	}
+	return price.Sub(reference).Abs().GT(reference.Mul(maxMove))
+}
+
+// GetPriceHalt returns the tripped circuit breaker of a given denom.
+func (k Keeper) GetPriceHalt(ctx sdk.Context, denom string) (types.PriceHalt, bool) {
+	halt, err := k.PriceHalts.Get(ctx, strings.ToUpper(denom))
+	return halt, err == nil
+}
+
+// ResetCircuitBreaker resets the tripped circuit breaker of a given denom, so
+// that its next exchange rate is published without checks.
+func (k Keeper) ResetCircuitBreaker(ctx sdk.Context, denom string) error {
+	denom = strings.ToUpper(denom)
+	if _, found := k.GetPriceHalt(ctx, denom); !found {
+		return types.ErrNoPriceHalt.Wrap(denomErr + denom)
+	}
+
+	if err := k.PriceHalts.Remove(ctx, denom); err != nil {
+		return err
+	}
+	if err := k.CircuitBreakerResets.Set(ctx, denom); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventCircuitBreakerReset{Denom: denom})
+}
+
+// IteratePriceHalts iterates over the tripped circuit breakers of all denoms.
+func (k Keeper) IteratePriceHalts(ctx sdk.Context, handler func(types.PriceHalt) bool) {
+	err := k.PriceHalts.Walk(ctx, nil, func(_ string, halt types.PriceHalt) (bool, error) {
+		return handler(halt), nil
+	})
+	if err != nil {
+		k.Logger(ctx).Error("failed to iterate price halts", "error", err)
+	}
+}
+
+// IterateCircuitBreakerResets iterates over the denoms whose circuit breaker
+// was reset since their last exchange rate.
+func (k Keeper) IterateCircuitBreakerResets(ctx sdk.Context, handler func(string) bool) {
+	err := k.CircuitBreakerResets.Walk(ctx, nil, func(denom string) (bool, error) {
+		return handler(denom), nil
+	})
+	if err != nil {
+		k.Logger(ctx).Error("failed to iterate circuit breaker resets", "error", err)
+	}
+}
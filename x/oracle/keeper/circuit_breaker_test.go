@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	oracle "github.com/DaevMithran/dmchain/x/oracle"
+	"github.com/DaevMithran/dmchain/x/oracle/keeper"
+	"github.com/DaevMithran/dmchain/x/oracle/types"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	app := setupApp(t)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{}).WithBlockHeight(1809)
+	msgServer := keeper.NewMsgServerImpl(app.OracleKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// the legacy param update validates the max price moves against the accept list
+	_, err := msgServer.LegacyGovUpdateParams(ctx, &types.MsgLegacyGovUpdateParams{
+		Authority: authority,
+		Keys:      []string{string(types.KeyMaxPriceMoves)},
+		Changes: types.Params{
+			MaxPriceMoves: types.MaxPriceMoveList{{SymbolDenom: "FOO", MaxMove: math.LegacyNewDecWithPrec(1, 1)}},
+		},
+	})
+	require.ErrorContains(t, err, "denom FOO in MaxPriceMoves not present in AcceptList")
+
+	_, err = msgServer.LegacyGovUpdateParams(ctx, &types.MsgLegacyGovUpdateParams{
+		Authority: authority,
+		Keys:      []string{string(types.KeyMaxPriceMoves)},
+		Changes: types.Params{
+			MaxPriceMoves: types.MaxPriceMoveList{
+				{SymbolDenom: types.DmSymbol, MaxMove: math.LegacyNewDecWithPrec(1, 1)},
+				{SymbolDenom: types.USDCSymbol, MaxMove: math.LegacyNewDecWithPrec(1, 1)},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	apply := func(denom string, rate math.LegacyDec) bool {
+		halted, err := app.OracleKeeper.ApplyCircuitBreaker(ctx, denom, rate)
+		require.NoError(t, err)
+		if !halted {
+			require.NoError(t, app.OracleKeeper.SetExchangeRateWithEvent(ctx, denom, rate))
+		}
+		return halted
+	}
+
+	// denoms are not checked until they have a previous rate or a median
+	require.False(t, apply(types.DmSymbol, math.LegacyNewDec(10)))
+	require.False(t, apply(types.DmSymbol, math.LegacyNewDecWithPrec(105, 1)))
+	// denoms without a max price move have no circuit breaker
+	require.False(t, apply(types.USDTSymbol, math.LegacyNewDec(1)))
+	require.False(t, apply(types.USDTSymbol, math.LegacyNewDec(100)))
+
+	// a move of over 10% from the previous rate halts the denom at it
+	ctx = ctx.WithEventManager(ctx.EventManager())
+	require.True(t, apply(types.DmSymbol, math.LegacyNewDec(12)))
+	rate, err := app.OracleKeeper.GetExchangeRate(ctx, types.DmSymbol)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(105, 1), rate)
+
+	halt, found := app.OracleKeeper.GetPriceHalt(ctx, types.DmSymbol)
+	require.True(t, found)
+	require.Equal(t, keeper.HaltReasonPreviousRate, halt.Reason)
+	require.Equal(t, math.LegacyNewDec(12), halt.RejectedRate)
+	require.Equal(t, "oracle.v1.EventPriceHalted", ctx.EventManager().Events()[len(ctx.EventManager().Events())-1].Type)
+
+	// the denom stays halted until it is reset
+	require.True(t, apply(types.DmSymbol, math.LegacyNewDecWithPrec(106, 1)))
+	status, err := app.OracleKeeper.GetExchangeRateStatus(ctx, types.DmSymbol)
+	require.NoError(t, err)
+	require.True(t, status.Halted)
+
+	// a move of over 10% from the median while the latest historic price is
+	// beyond its deviation halts the denom
+	medianBlock := uint64(1799)
+	app.OracleKeeper.SetHistoricMedian(ctx, types.USDCSymbol, medianBlock, math.LegacyOneDec())
+	app.OracleKeeper.AddHistoricPrice(ctx.WithBlockHeight(1804), types.USDCSymbol, math.LegacyNewDecWithPrec(11, 1))
+	// the median is not checked without a deviation stamped in the last period
+	halted, err := app.OracleKeeper.ApplyCircuitBreaker(ctx, types.USDCSymbol, math.LegacyNewDecWithPrec(12, 1))
+	require.NoError(t, err)
+	require.False(t, halted)
+
+	app.OracleKeeper.SetHistoricMedianDeviation(ctx, types.USDCSymbol, medianBlock, math.LegacyNewDecWithPrec(5, 2))
+	halted, err = app.OracleKeeper.ApplyCircuitBreaker(ctx, types.USDCSymbol, math.LegacyNewDecWithPrec(108, 2))
+	require.NoError(t, err)
+	require.False(t, halted)
+	require.True(t, apply(types.USDCSymbol, math.LegacyNewDecWithPrec(12, 1)))
+	halt, found = app.OracleKeeper.GetPriceHalt(ctx, types.USDCSymbol)
+	require.True(t, found)
+	require.Equal(t, keeper.HaltReasonHistoricMedian, halt.Reason)
+	require.True(t, halt.HaltedRate.IsZero())
+
+	// a denom halted before it was ever priced stays without a rate
+	require.True(t, apply(types.USDCSymbol, math.LegacyNewDecWithPrec(12, 1)))
+	_, err = app.OracleKeeper.GetExchangeRate(ctx, types.USDCSymbol)
+	require.Error(t, err)
+
+	res, err := keeper.NewQuerier(app.OracleKeeper).PriceHalts(ctx, &types.QueryPriceHaltsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.PriceHalts, 2)
+
+	// governance resets the circuit breaker, the next rate is published unchecked
+	_, err = msgServer.GovResetCircuitBreakers(ctx, &types.MsgGovResetCircuitBreakers{
+		Authority: authtypes.NewModuleAddress("not_gov").String(),
+		Denoms:    []string{types.DmSymbol},
+	})
+	require.ErrorIs(t, err, types.ErrNoGovAuthority)
+
+	_, err = msgServer.GovResetCircuitBreakers(ctx, &types.MsgGovResetCircuitBreakers{
+		Authority: authority,
+		Denoms:    []string{types.USDTSymbol},
+	})
+	require.ErrorIs(t, err, types.ErrNoPriceHalt)
+
+	_, err = msgServer.GovResetCircuitBreakers(ctx, &types.MsgGovResetCircuitBreakers{
+		Authority: authority,
+		Denoms:    []string{"dm"},
+	})
+	require.NoError(t, err)
+	_, found = app.OracleKeeper.GetPriceHalt(ctx, types.DmSymbol)
+	require.False(t, found)
+
+	genesis := oracle.ExportGenesis(ctx, app.OracleKeeper)
+	require.Len(t, genesis.PriceHalts, 1)
+	require.Equal(t, []string{types.DmSymbol}, genesis.CircuitBreakerResets)
+
+	require.False(t, apply(types.DmSymbol, math.LegacyNewDec(12)))
+	require.True(t, apply(types.DmSymbol, math.LegacyNewDec(20)))
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/DaevMithran/dmchain/x/oracle/types"
+)
+
+// CrossRate returns the rate of a given base denom in a given quote denom,
+// along with the USD rates of both it is derived from. The USD rates are the
+// spot exchange rates or, depending on the source, their SMA, EMA or TWAP
+// over the given window, TwapRateWindow if zero.
+//
+// If baseDenoms is set, base and quote are base denoms of the accept list and
+// their USD rates are of one base unit, as returned by GetExchangeRateBase.
+func (k Keeper) CrossRate(
+	ctx sdk.Context,
+	base, quote string,
+	source types.PriceSource,
+	window time.Duration,
+	baseDenoms bool,
+) (rate, baseRate, quoteRate math.LegacyDec, err error) {
+	zero := math.LegacyZeroDec()
+
+	baseRate, err = k.sourceRate(ctx, base, source, window, baseDenoms)
+	if err != nil {
+		return zero, zero, zero, err
+	}
+	quoteRate, err = k.sourceRate(ctx, quote, source, window, baseDenoms)
+	if err != nil {
+		return zero, zero, zero, err
+	}
+	if !quoteRate.IsPositive() {
+		return zero, zero, zero, types.ErrInvalidOraclePrice.Wrap(denomErr + quote)
+	}
+
+	return baseRate.Quo(quoteRate), baseRate, quoteRate, nil
+}
+
+// sourceRate returns the USD rate of a given denom from a given source.
+func (k Keeper) sourceRate(
+	ctx sdk.Context,
+	denom string,
+	source types.PriceSource,
+	window time.Duration,
+	baseDenom bool,
+) (math.LegacyDec, error) {
+	if baseDenom && isSpotSource(source) {
+		return k.GetExchangeRateBase(ctx, denom)
+	}
+
+	symbol, exponent := denom, uint64(0)
+	if baseDenom {
+		var err error
+		symbol, exponent, err = k.symbolOfBaseDenom(ctx, denom)
+		if err != nil {
+			return math.LegacyZeroDec(), err
+		}
+	}
+	symbol = strings.ToUpper(symbol)
+
+	var rate math.LegacyDec
+	switch source {
+	case types.PriceSource_PRICE_SOURCE_UNSPECIFIED, types.PriceSource_PRICE_SOURCE_SPOT:
+		var err error
+		if rate, err = k.GetExchangeRate(ctx, symbol); err != nil {
+			return math.LegacyZeroDec(), err
+		}
+	case types.PriceSource_PRICE_SOURCE_SMA, types.PriceSource_PRICE_SOURCE_EMA:
+		var found bool
+		if source == types.PriceSource_PRICE_SOURCE_SMA {
+			rate, found = k.GetSMA(ctx, symbol)
+		} else {
+			rate, found = k.GetEMA(ctx, symbol)
+		}
+		if !found {
+			return math.LegacyZeroDec(), types.ErrInvalidOraclePrice.Wrapf("no %s for %s", source, symbol)
+		}
+	case types.PriceSource_PRICE_SOURCE_TWAP:
+		if window == 0 {
+			window = TwapRateWindow
+		}
+		var err error
+		if rate, err = k.TWAP(ctx, symbol, window, 0); err != nil {
+			return math.LegacyZeroDec(), err
+		}
+	default:
+		return math.LegacyZeroDec(), types.ErrInvalidRequest.Wrapf("unknown price source %s", source)
+	}
+
+	return rate.Quo(ten.Power(exponent)), nil
+}
+
+// isSpotSource returns whether a given price source is the spot exchange rate.
+func isSpotSource(source types.PriceSource) bool {
+	return source == types.PriceSource_PRICE_SOURCE_UNSPECIFIED || source == types.PriceSource_PRICE_SOURCE_SPOT
+}
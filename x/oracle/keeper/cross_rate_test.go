@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/DaevMithran/dmchain/x/oracle/keeper"
+	"github.com/DaevMithran/dmchain/x/oracle/types"
+)
+
+func TestCrossRate(t *testing.T) {
+	app := setupApp(t)
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{}).WithBlockHeight(10).WithBlockTime(start)
+
+	app.OracleKeeper.SetExchangeRate(ctx, types.DmSymbol, math.LegacyNewDec(2))
+	app.OracleKeeper.SetExchangeRate(ctx, types.USDCSymbol, math.LegacyNewDecWithPrec(5, 1))
+	app.OracleKeeper.SetSMA(ctx, types.DmSymbol, math.LegacyNewDec(3))
+	app.OracleKeeper.SetSMA(ctx, types.USDCSymbol, math.LegacyNewDecWithPrec(15, 1))
+	app.OracleKeeper.SetEMA(ctx, types.DmSymbol, math.LegacyNewDec(3))
+	app.OracleKeeper.SetEMA(ctx, types.USDCSymbol, math.LegacyZeroDec())
+	app.OracleKeeper.AddHistoricPrice(ctx, types.DmSymbol, math.LegacyNewDec(4))
+	app.OracleKeeper.AddHistoricPrice(ctx, types.USDCSymbol, math.LegacyOneDec())
+	ctx = ctx.WithBlockHeight(20).WithBlockTime(start.Add(time.Minute))
+
+	testCases := []struct {
+		name       string
+		base       string
+		quote      string
+		source     types.PriceSource
+		baseDenoms bool
+		rate       math.LegacyDec
+		err        error
+	}{
+		{"spot", types.DmSymbol, "usdc", types.PriceSource_PRICE_SOURCE_UNSPECIFIED, false, math.LegacyNewDec(4), nil},
+		{"inverse spot", types.USDCSymbol, types.DmSymbol, types.PriceSource_PRICE_SOURCE_SPOT, false, math.LegacyNewDecWithPrec(25, 2), nil},
+		{"sma", types.DmSymbol, types.USDCSymbol, types.PriceSource_PRICE_SOURCE_SMA, false, math.LegacyNewDec(2), nil},
+		{"twap", types.DmSymbol, types.USDCSymbol, types.PriceSource_PRICE_SOURCE_TWAP, false, math.LegacyNewDec(4), nil},
+		{"spot base denoms", types.DmDenom, types.USDCDenom, types.PriceSource_PRICE_SOURCE_SPOT, true, math.LegacyNewDecWithPrec(4, 3), nil},
+		{"sma base denoms", types.DmDenom, types.USDCDenom, types.PriceSource_PRICE_SOURCE_SMA, true, math.LegacyNewDecWithPrec(2, 3), nil},
+		{"zero quote", types.DmSymbol, types.USDCSymbol, types.PriceSource_PRICE_SOURCE_EMA, false, math.LegacyZeroDec(), types.ErrInvalidOraclePrice},
+		{"no average", types.DmSymbol, types.USDTSymbol, types.PriceSource_PRICE_SOURCE_SMA, false, math.LegacyZeroDec(), types.ErrInvalidOraclePrice},
+		{"no exchange rate", types.DmSymbol, types.USDTSymbol, types.PriceSource_PRICE_SOURCE_SPOT, false, math.LegacyZeroDec(), types.ErrUnknownDenom},
+		{"unknown base denom", types.DmSymbol, types.USDCDenom, types.PriceSource_PRICE_SOURCE_TWAP, true, math.LegacyZeroDec(), types.ErrUnknownDenom},
+		{"unknown source", types.DmSymbol, types.USDCSymbol, types.PriceSource(10), false, math.LegacyZeroDec(), types.ErrInvalidRequest},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			rate, _, _, err := app.OracleKeeper.CrossRate(ctx, tc.base, tc.quote, tc.source, 0, tc.baseDenoms)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.rate, rate)
+		})
+	}
+
+	res, err := keeper.NewQuerier(app.OracleKeeper).CrossRate(ctx, &types.QueryCrossRateRequest{
+		Base:       types.DmDenom,
+		Quote:      types.USDCDenom,
+		BaseDenoms: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(4, 3), res.Rate)
+	require.Equal(t, math.LegacyNewDec(2).QuoInt64(1e9), res.BaseRate)
+}
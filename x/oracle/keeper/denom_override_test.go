@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/DaevMithran/dmchain/x/oracle/abci"
+	"github.com/DaevMithran/dmchain/x/oracle/keeper"
+	"github.com/DaevMithran/dmchain/x/oracle/types"
+)
+
+func TestDenomOverrides(t *testing.T) {
+	app := setupApp(t)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{}).WithBlockHeight(9)
+	msgServer := keeper.NewMsgServerImpl(app.OracleKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	vals, err := app.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	require.NoError(t, err)
+	require.Len(t, vals, 1)
+	valAddr, err := sdk.ValAddressFromBech32(vals[0].GetOperator())
+	require.NoError(t, err)
+
+	calcPrices := func(height int64, rate math.LegacyDec) (math.LegacyDec, error) {
+		ctx := ctx.WithBlockHeight(height)
+		app.OracleKeeper.SetAggregateExchangeRateVote(ctx, valAddr, types.AggregateExchangeRateVote{
+			ExchangeRates: sdk.DecCoins{{Denom: types.DmSymbol, Amount: rate}},
+			Voter:         valAddr.String(),
+		})
+		require.NoError(t, abci.CalcPrices(ctx, app.OracleKeeper.GetParams(ctx), app.OracleKeeper))
+		return app.OracleKeeper.GetExchangeRate(ctx, types.DmSymbol)
+	}
+
+	executePlan := func() {
+		plans := app.OracleKeeper.GetParamUpdatePlans(ctx)
+		require.Len(t, plans, 1)
+		require.NoError(t, app.OracleKeeper.ExecuteParamUpdatePlan(ctx, plans[0]))
+	}
+
+	// overrides are scheduled as a param update plan by governance
+	_, err = msgServer.GovUpdateDenomOverrides(ctx, &types.MsgGovUpdateDenomOverrides{
+		Authority: authtypes.NewModuleAddress("not_gov").String(),
+		Height:    10,
+		Overrides: []types.DenomOverride{{SymbolDenom: types.DmSymbol, MinVoters: 2}},
+	})
+	require.ErrorIs(t, err, types.ErrNoGovAuthority)
+
+	_, err = msgServer.GovUpdateDenomOverrides(ctx, &types.MsgGovUpdateDenomOverrides{
+		Authority: authority,
+		Height:    10,
+		Overrides: []types.DenomOverride{{SymbolDenom: "foo", MinVoters: 2}},
+	})
+	require.ErrorContains(t, err, "denom FOO in DenomOverrides not present in AcceptList")
+
+	// the legacy param update validates the overrides against the accept list too
+	_, err = msgServer.LegacyGovUpdateParams(ctx, &types.MsgLegacyGovUpdateParams{
+		Authority: authority,
+		Keys:      []string{string(types.KeyDenomOverrides)},
+		Changes: types.Params{
+			DenomOverrides: types.DenomOverrideList{{SymbolDenom: "FOO", MinVoters: 2}},
+		},
+	})
+	require.ErrorContains(t, err, "denom FOO in DenomOverrides not present in AcceptList")
+	require.Empty(t, app.OracleKeeper.DenomOverrides(ctx))
+
+	_, err = msgServer.GovUpdateDenomOverrides(ctx, &types.MsgGovUpdateDenomOverrides{
+		Authority: authority,
+		Height:    10,
+		Overrides: []types.DenomOverride{{SymbolDenom: "dm", VoteThreshold: math.LegacyZeroDec(), MinVoters: 2}},
+	})
+	require.NoError(t, err)
+	executePlan()
+	require.Equal(t, types.DenomOverrideList{
+		{SymbolDenom: types.DmSymbol, VoteThreshold: math.LegacyZeroDec(), MinVoters: 2},
+	}, app.OracleKeeper.DenomOverrides(ctx))
+
+	// a ballot under the minimum voters of the denom is dropped
+	_, err = calcPrices(19, math.LegacyNewDec(2))
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+
+	// the vote threshold of the denom replaces the global one
+	voteThreshold := math.LegacyNewDecWithPrec(5, 2)
+	app.OracleKeeper.SetDenomOverrides(ctx, types.DenomOverrideList{
+		{SymbolDenom: types.DmSymbol, VoteThreshold: voteThreshold, MinVoters: 1, VotePeriodMultiplier: 2},
+	})
+	override, _ := app.OracleKeeper.DenomOverrides(ctx).GetOverrideFromDenom(types.DmSymbol)
+	require.Equal(t, voteThreshold, override.VoteThresholdOr(app.OracleKeeper.VoteThreshold(ctx)))
+
+	// the denom is only updated every second vote period, and keeps its
+	// previous exchange rate in between
+	require.True(t, override.IsUpdatePeriod(19, types.DefaultVotePeriod))
+	rate, err := calcPrices(19, math.LegacyNewDec(2))
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(2), rate)
+
+	require.False(t, override.IsUpdatePeriod(29, types.DefaultVotePeriod))
+	rate, err = calcPrices(29, math.LegacyNewDec(3))
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(2), rate)
+
+	rate, err = calcPrices(39, math.LegacyNewDec(3))
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(3), rate)
+
+	// removed overrides fall back to the global voting rules
+	_, err = msgServer.GovUpdateDenomOverrides(ctx, &types.MsgGovUpdateDenomOverrides{
+		Authority:    authority,
+		Height:       10,
+		RemoveDenoms: []string{"dm"},
+	})
+	require.NoError(t, err)
+	executePlan()
+	require.Empty(t, app.OracleKeeper.DenomOverrides(ctx))
+}
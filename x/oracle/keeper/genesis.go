@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -92,3 +94,30 @@ func (k Keeper) AllMedianDeviationPrices(ctx sdk.Context) types.PriceStamps {
 	})
 	return prices
 }
+
+// IterateAllHistoricStampTimes iterates over the block times of all historic
+// price stamps.
+func (k Keeper) IterateAllHistoricStampTimes(
+	ctx sdk.Context,
+	handler func(types.HistoricStampTime) bool,
+) {
+	err := k.HistoricStampTimes.Walk(ctx, nil, func(blockNum uint64, blockTime time.Time) (stop bool, err error) {
+		return handler(types.HistoricStampTime{BlockNum: blockNum, BlockTime: blockTime}), nil
+	})
+	if err != nil {
+		k.Logger(ctx).Error("failed to iterate historic stamp times", "error", err)
+	}
+}
+
+// IterateAllCandles iterates over the candles of all denoms and intervals.
+func (k Keeper) IterateAllCandles(
+	ctx sdk.Context,
+	handler func(types.Candle) bool,
+) {
+	err := k.Candles.Walk(ctx, nil, func(_ collections.Triple[string, uint64, uint64], candle types.Candle) (bool, error) {
+		return handler(candle), nil
+	})
+	if err != nil {
+		k.Logger(ctx).Error("failed to iterate candles", "error", err)
+	}
+}
@@ -3,9 +3,13 @@ package keeper
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -395,3 +399,198 @@ func (q Querier) SMA(ctx context.Context, req *types.QuerySMARequest) (*types.Qu
 		Price: price,
 	}, nil
 }
+
+func (q Querier) TWAP(ctx context.Context, req *types.QueryTWAPRequest) (*types.QueryTWAPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.WindowSeconds == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty window")
+	}
+	if req.WindowSeconds > maxTwapWindowSeconds {
+		return nil, status.Error(codes.InvalidArgument, "window too long")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	window := time.Duration(req.WindowSeconds) * time.Second
+	price, err := q.Keeper.TWAP(sdkCtx, req.Denom, window, req.EndHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTWAPResponse{
+		Price: price,
+	}, nil
+}
+
+func (q Querier) HistoricPrices(
+	ctx context.Context,
+	req *types.QueryHistoricPricesRequest,
+) (*types.QueryHistoricPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+	if req.EndBlock != 0 && req.EndBlock < req.StartBlock {
+		return nil, status.Error(codes.InvalidArgument, "end block is before start block")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	prices, pageRes, err := q.PaginateHistoricPrices(
+		sdkCtx,
+		strings.ToUpper(req.Denom),
+		req.StartBlock,
+		req.EndBlock,
+		req.Pagination,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryHistoricPricesResponse{
+		HistoricPrices: prices,
+		Pagination:     pageRes,
+	}, nil
+}
+
+func (q Querier) Candles(
+	ctx context.Context,
+	req *types.QueryCandlesRequest,
+) (*types.QueryCandlesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+	if req.Interval == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty interval")
+	}
+
+	denom := strings.ToUpper(req.Denom)
+	candles, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.Keeper.Candles,
+		req.Pagination,
+		func(_ collections.Triple[string, uint64, uint64], candle types.Candle) (types.Candle, error) {
+			return candle, nil
+		},
+		func(o *query.CollectionsPaginateOptions[collections.Triple[string, uint64, uint64]]) {
+			prefix := collections.TripleSuperPrefix[string, uint64, uint64](denom, req.Interval)
+			o.Prefix = &prefix
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryCandlesResponse{
+		Candles:    candles,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q Querier) PriceStatistics(
+	ctx context.Context,
+	req *types.QueryPriceStatisticsRequest,
+) (*types.QueryPriceStatisticsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	stats, err := q.Keeper.PriceStatistics(sdkCtx, req.Denom, req.NumStamps, req.Percentiles)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPriceStatisticsResponse{
+		Statistics: stats,
+	}, nil
+}
+
+func (q Querier) CrossRate(ctx context.Context, req *types.QueryCrossRateRequest) (*types.QueryCrossRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Base) == 0 || len(req.Quote) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+	if req.WindowSeconds > maxTwapWindowSeconds {
+		return nil, status.Error(codes.InvalidArgument, "window too long")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	window := time.Duration(req.WindowSeconds) * time.Second
+	rate, baseRate, quoteRate, err := q.Keeper.CrossRate(sdkCtx, req.Base, req.Quote, req.Source, window, req.BaseDenoms)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCrossRateResponse{
+		Rate:      rate,
+		BaseRate:  baseRate,
+		QuoteRate: quoteRate,
+	}, nil
+}
+
+func (q Querier) LastExchangeRate(
+	ctx context.Context,
+	req *types.QueryLastExchangeRateRequest,
+) (*types.QueryLastExchangeRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	rateStatus, err := q.Keeper.GetExchangeRateStatus(sdkCtx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLastExchangeRateResponse{
+		Status: rateStatus,
+	}, nil
+}
+
+func (q Querier) LastExchangeRates(
+	ctx context.Context,
+	req *types.QueryLastExchangeRatesRequest,
+) (*types.QueryLastExchangeRatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryLastExchangeRatesResponse{
+		Statuses: q.Keeper.GetExchangeRateStatuses(sdkCtx),
+	}, nil
+}
+
+func (q Querier) PriceHalts(
+	ctx context.Context,
+	req *types.QueryPriceHaltsRequest,
+) (*types.QueryPriceHaltsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	halts := []types.PriceHalt{}
+	q.IteratePriceHalts(sdkCtx, func(halt types.PriceHalt) bool {
+		halts = append(halts, halt)
+		return false
+	})
+
+	return &types.QueryPriceHaltsResponse{
+		PriceHalts: halts,
+	}, nil
+}
@@ -1,10 +1,14 @@
 package keeper
 
 import (
+	"encoding/binary"
+	"time"
+
 	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/DaevMithran/dmchain/util"
 	"github.com/DaevMithran/dmchain/util/decmath"
@@ -277,7 +281,7 @@ func (k Keeper) IterateHistoricMedians(
 	handler func(types.PriceStamp) bool,
 ) {
 	prefix := collections.NewPrefixedPairRange[string, uint64](denom).Descending()
-	err := k.HistoricPrices.Walk(ctx, prefix, func(key collections.Pair[string, uint64], value math.LegacyDec) (stop bool, err error) {
+	err := k.MedianPrices.Walk(ctx, prefix, func(key collections.Pair[string, uint64], value math.LegacyDec) (stop bool, err error) {
 		if numStamps == 0 {
 			return true, nil
 		}
@@ -320,7 +324,7 @@ func (k Keeper) IterateHistoricDeviations(
 }
 
 // AddHistoricPrice adds the historic price of a denom at the current
-// block height.
+// block height and records the block time of the stamp.
 func (k Keeper) AddHistoricPrice(
 	ctx sdk.Context,
 	denom string,
@@ -328,6 +332,7 @@ func (k Keeper) AddHistoricPrice(
 ) {
 	block := util.SafeInt64ToUint64(ctx.BlockHeight())
 	k.SetHistoricPrice(ctx, denom, block, exchangeRate)
+	k.SetHistoricStampTime(ctx, block, ctx.BlockTime())
 }
 
 func (k Keeper) SetHistoricPrice(
@@ -340,6 +345,26 @@ func (k Keeper) SetHistoricPrice(
 	_ = k.LastHistoricPriceBlock.Set(ctx, denom, blockNum)
 }
 
+// SetHistoricStampTime sets the block time of the block historic prices
+// were stamped at.
+func (k Keeper) SetHistoricStampTime(
+	ctx sdk.Context,
+	blockNum uint64,
+	blockTime time.Time,
+) {
+	_ = k.HistoricStampTimes.Set(ctx, blockNum, blockTime)
+}
+
+// GetHistoricStampTime returns the block time of the block historic prices
+// were stamped at.
+func (k Keeper) GetHistoricStampTime(
+	ctx sdk.Context,
+	blockNum uint64,
+) (time.Time, bool) {
+	blockTime, err := k.HistoricStampTimes.Get(ctx, blockNum)
+	return blockTime, err == nil
+}
+
 // DeleteHistoricPrice deletes the historic price of a denom at a
 // given block.
 func (k Keeper) DeleteHistoricPrice(
@@ -376,6 +401,11 @@ func (k Keeper) PruneHistoricPricesBeforeBlock(ctx sdk.Context, blockNum uint64)
 		}
 		return false
 	})
+
+	rng := new(collections.Range[uint64]).EndInclusive(blockNum)
+	if err := k.HistoricStampTimes.Clear(ctx, rng); err != nil {
+		k.Logger(ctx).Error("failed to prune historic stamp times", "block", blockNum, "error", err)
+	}
 }
 
 func (k Keeper) PruneMediansBeforeBlock(ctx sdk.Context, blockNum uint64) {
@@ -395,3 +425,94 @@ func (k Keeper) PruneMedianDeviationsBeforeBlock(ctx sdk.Context, blockNum uint6
 		return false
 	})
 }
+
+// PaginateHistoricPrices returns a page of the historic prices of a given
+// denom stamped from startBlock to endBlock inclusive, where a zero bound
+// is unbounded. The next key of the page is the big endian block number
+// the next page starts at.
+func (k Keeper) PaginateHistoricPrices(
+	ctx sdk.Context,
+	denom string,
+	startBlock, endBlock uint64,
+	pageReq *query.PageRequest,
+) (types.PriceStamps, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) != 0 && pageReq.Offset > 0 {
+		return nil, nil, types.ErrInvalidRequest.Wrap("either offset or key is expected, got both")
+	}
+
+	countTotal := pageReq.CountTotal && len(pageReq.Key) == 0
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = len(pageReq.Key) == 0
+	}
+
+	// the page key moves the bound the iteration starts at
+	if len(pageReq.Key) != 0 {
+		if len(pageReq.Key) != 8 {
+			return nil, nil, types.ErrInvalidRequest.Wrap("invalid page key")
+		}
+		block := binary.BigEndian.Uint64(pageReq.Key)
+		if pageReq.Reverse {
+			endBlock = block
+		} else {
+			startBlock = block
+		}
+	}
+
+	rng := collections.NewPrefixedPairRange[string, uint64](denom)
+	if startBlock > 0 {
+		rng = rng.StartInclusive(startBlock)
+	}
+	if endBlock > 0 {
+		if endBlock < startBlock {
+			return types.PriceStamps{}, &query.PageResponse{}, nil
+		}
+		rng = rng.EndInclusive(endBlock)
+	}
+	if pageReq.Reverse {
+		rng = rng.Descending()
+	}
+
+	it, err := k.HistoricPrices.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+
+	prices := types.PriceStamps{}
+	pageRes := &query.PageResponse{}
+	var count uint64
+	for ; it.Valid(); it.Next() {
+		count++
+		if count <= pageReq.Offset {
+			continue
+		}
+
+		kv, err := it.KeyValue()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if count > pageReq.Offset+limit {
+			if pageRes.NextKey == nil {
+				pageRes.NextKey = binary.BigEndian.AppendUint64(nil, kv.Key.K2())
+			}
+			if !countTotal {
+				break
+			}
+			continue
+		}
+
+		prices = append(prices, *types.NewPriceStamp(kv.Value, kv.Key.K1(), kv.Key.K2()))
+	}
+
+	if countTotal {
+		pageRes.Total = count
+	}
+
+	return prices, pageRes, nil
+}
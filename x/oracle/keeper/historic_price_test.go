@@ -1,7 +1,14 @@
 package keeper_test
 
 import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
 	"cosmossdk.io/math"
+	"github.com/DaevMithran/dmchain/x/oracle/keeper"
 	"github.com/DaevMithran/dmchain/x/oracle/types"
 )
 
@@ -98,3 +105,140 @@ func (s *IntegrationTestSuite) TestSetHistoraclePricing() {
 	s.Require().Equal(medians[0], *types.NewPriceStamp(math.LegacyMustNewDecFromStr("1.2"), displayDenom, 17))
 	s.Require().Equal(medians[1], *types.NewPriceStamp(math.LegacyMustNewDecFromStr("1.125"), displayDenom, 14))
 }
+
+func TestQueryHistoricPrices(t *testing.T) {
+	app := setupApp(t)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{})
+	querier := keeper.NewQuerier(app.OracleKeeper)
+
+	// a denom sharing the prefix of the queried one must not leak into its pages
+	for block := uint64(1); block <= 10; block++ {
+		app.OracleKeeper.SetHistoricPrice(ctx, types.USDCSymbol, block*10, math.LegacyNewDec(int64(block)))
+		app.OracleKeeper.SetHistoricPrice(ctx, types.USDCSymbol+"X", block*10, math.LegacyNewDec(int64(block)))
+	}
+
+	blocks := func(prices types.PriceStamps) (res []uint64) {
+		for _, price := range prices {
+			require.Equal(t, types.USDCSymbol, price.ExchangeRate.Denom)
+			require.Equal(t, math.LegacyNewDec(int64(price.BlockNum/10)), price.ExchangeRate.Amount)
+			res = append(res, price.BlockNum)
+		}
+		return res
+	}
+
+	testCases := []struct {
+		name   string
+		req    *types.QueryHistoricPricesRequest
+		blocks []uint64
+		total  uint64
+		next   bool
+	}{
+		{
+			name:   "all prices",
+			req:    &types.QueryHistoricPricesRequest{Denom: "usdc"},
+			blocks: []uint64{10, 20, 30, 40, 50, 60, 70, 80, 90, 100},
+			total:  10,
+		},
+		{
+			name:   "block range",
+			req:    &types.QueryHistoricPricesRequest{Denom: types.USDCSymbol, StartBlock: 25, EndBlock: 70},
+			blocks: []uint64{30, 40, 50, 60, 70},
+			total:  5,
+		},
+		{
+			name: "limited page",
+			req: &types.QueryHistoricPricesRequest{
+				Denom:      types.USDCSymbol,
+				StartBlock: 30,
+				Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+			},
+			blocks: []uint64{30, 40, 50},
+			total:  8,
+			next:   true,
+		},
+		{
+			name: "offset in reverse",
+			req: &types.QueryHistoricPricesRequest{
+				Denom:      types.USDCSymbol,
+				EndBlock:   80,
+				Pagination: &query.PageRequest{Limit: 2, Offset: 1, Reverse: true},
+			},
+			blocks: []uint64{70, 60},
+			next:   true,
+		},
+		{
+			name:   "empty range",
+			req:    &types.QueryHistoricPricesRequest{Denom: types.USDCSymbol, StartBlock: 101},
+			blocks: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := querier.HistoricPrices(ctx, tc.req)
+			require.NoError(t, err)
+			require.Equal(t, tc.blocks, blocks(res.HistoricPrices))
+			require.Equal(t, tc.total, res.Pagination.Total)
+			require.Equal(t, tc.next, len(res.Pagination.NextKey) != 0)
+		})
+	}
+
+	// following the next keys walks the range page by page in both directions
+	for _, reverse := range []bool{false, true} {
+		req := &types.QueryHistoricPricesRequest{
+			Denom:      types.USDCSymbol,
+			StartBlock: 20,
+			EndBlock:   90,
+			Pagination: &query.PageRequest{Limit: 3, Reverse: reverse},
+		}
+
+		var pages [][]uint64
+		for {
+			res, err := querier.HistoricPrices(ctx, req)
+			require.NoError(t, err)
+			pages = append(pages, blocks(res.HistoricPrices))
+			if len(res.Pagination.NextKey) == 0 {
+				break
+			}
+			req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 3, Reverse: reverse}
+		}
+
+		if reverse {
+			require.Equal(t, [][]uint64{{90, 80, 70}, {60, 50, 40}, {30, 20}}, pages)
+		} else {
+			require.Equal(t, [][]uint64{{20, 30, 40}, {50, 60, 70}, {80, 90}}, pages)
+		}
+	}
+
+	_, err := querier.HistoricPrices(ctx, &types.QueryHistoricPricesRequest{Denom: types.USDCSymbol, StartBlock: 20, EndBlock: 10})
+	require.Error(t, err)
+
+	_, err = querier.HistoricPrices(ctx, &types.QueryHistoricPricesRequest{
+		Denom:      types.USDCSymbol,
+		Pagination: &query.PageRequest{Key: []byte{1}},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+}
+
+func TestIterateHistoricMedians(t *testing.T) {
+	app := setupApp(t)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{})
+
+	// medians differ from the historic prices stamped at the same blocks
+	for block := uint64(1); block <= 3; block++ {
+		app.OracleKeeper.SetHistoricPrice(ctx, types.USDCSymbol, block*10, math.LegacyNewDec(int64(block)))
+		app.OracleKeeper.SetHistoricMedian(ctx, types.USDCSymbol, block*10, math.LegacyNewDec(int64(block*100)))
+	}
+
+	var medians []types.PriceStamp
+	app.OracleKeeper.IterateHistoricMedians(ctx, types.USDCSymbol, 2, func(median types.PriceStamp) bool {
+		medians = append(medians, median)
+		return false
+	})
+
+	require.Equal(t, []types.PriceStamp{
+		*types.NewPriceStamp(math.LegacyNewDec(300), types.USDCSymbol, 30),
+		*types.NewPriceStamp(math.LegacyNewDec(200), types.USDCSymbol, 20),
+	}, medians)
+}
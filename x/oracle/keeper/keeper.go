@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/DaevMithran/dmchain/pricefeeder"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -14,6 +15,7 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	storetypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
@@ -56,6 +58,21 @@ type Keeper struct {
 	// AvgType example: "sma", "ema", "wma:BALANCED"
 	Averages collections.Map[collections.Pair[string, string], math.LegacyDec]
 
+	// Map: Block Height (uint64) -> Block Time of the historic price stamp (time.Time)
+	HistoricStampTimes collections.Map[uint64, time.Time]
+
+	// Map: Triple(Denom, Interval, Start Block) -> Candle
+	Candles collections.Map[collections.Triple[string, uint64, uint64], types.Candle]
+
+	// Map: Denom -> Last Exchange Rate Update, retained when the exchange rate is cleared
+	ExchangeRateUpdates collections.Map[string, types.ExchangeRateUpdate]
+
+	// Map: Denom -> Tripped Circuit Breaker
+	PriceHalts collections.Map[string, types.PriceHalt]
+
+	// KeySet: Denoms whose next exchange rate skips the circuit breaker after a reset
+	CircuitBreakerResets collections.KeySet[string]
+
 	// Map: Validator Address -> Miss Counter (uint64)
 	MissCounters collections.Map[sdk.ValAddress, uint64]
 
@@ -110,7 +127,15 @@ func NewKeeper(
 	}
 
 	k := Keeper{
-		cdc: cdc,
+		cdc:              cdc,
+		paramSpace:       paramspace,
+		accountKeeper:    accountKeeper,
+		bankKeeper:       bankKeeper,
+		distrKeeper:      distrKeeper,
+		StakingKeeper:    stakingKeeper,
+		PriceFeeder:      &pricefeeder.PriceFeeder{},
+		distrName:        distrName,
+		telemetryEnabled: telemetryEnabled,
 
 		authority: authority,
 		ExchangeRates: collections.NewMap(
@@ -176,6 +201,40 @@ func NewKeeper(
 			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
 			sdk.LegacyDecValue,
 		),
+		HistoricStampTimes: collections.NewMap(
+			sb,
+			types.KeyPrefixHistoricStampTime,
+			"historic_stamp_times",
+			collections.Uint64Key,
+			collcodec.KeyToValueCodec(sdk.TimeKey),
+		),
+		Candles: collections.NewMap(
+			sb,
+			types.KeyPrefixCandle,
+			"candles",
+			collections.TripleKeyCodec(collections.StringKey, collections.Uint64Key, collections.Uint64Key),
+			codec.CollValue[types.Candle](cdc),
+		),
+		ExchangeRateUpdates: collections.NewMap(
+			sb,
+			types.KeyPrefixExchangeRateUpdate,
+			"exchange_rate_updates",
+			collections.StringKey,
+			codec.CollValue[types.ExchangeRateUpdate](cdc),
+		),
+		PriceHalts: collections.NewMap(
+			sb,
+			types.KeyPrefixPriceHalt,
+			"price_halts",
+			collections.StringKey,
+			codec.CollValue[types.PriceHalt](cdc),
+		),
+		CircuitBreakerResets: collections.NewKeySet(
+			sb,
+			types.KeyPrefixCircuitBreakerReset,
+			"circuit_breaker_resets",
+			collections.StringKey,
+		),
 		MissCounters: collections.NewMap(
 			sb,
 			types.KeyPrefixMissCounter,
@@ -231,19 +290,10 @@ func (k Keeper) GetExchangeRate(ctx sdk.Context, symbol string) (math.LegacyDec,
 // GetExchangeRateBase gets the consensus exchange rate of an asset
 // in the base denom (e.g. ATOM -> uatom)
 func (k Keeper) GetExchangeRateBase(ctx sdk.Context, denom string) (math.LegacyDec, error) {
-	var symbol string
-	var exponent uint64
 	// Translate the base denom -> symbol
-	params := k.GetParams(ctx)
-	for _, listDenom := range params.AcceptList {
-		if listDenom.BaseDenom == denom {
-			symbol = listDenom.SymbolDenom
-			exponent = uint64(listDenom.Exponent)
-			break
-		}
-	}
-	if len(symbol) == 0 {
-		return math.LegacyZeroDec(), types.ErrUnknownDenom.Wrap(denom)
+	symbol, exponent, err := k.symbolOfBaseDenom(ctx, denom)
+	if err != nil {
+		return math.LegacyZeroDec(), err
 	}
 
 	exchangeRate, err := k.GetExchangeRate(ctx, symbol)
@@ -255,6 +305,17 @@ func (k Keeper) GetExchangeRateBase(ctx sdk.Context, denom string) (math.LegacyD
 	return exchangeRate.Quo(powerReduction), nil
 }
 
+// symbolOfBaseDenom returns the symbol denom and the exponent of a given base
+// denom of the accept list.
+func (k Keeper) symbolOfBaseDenom(ctx sdk.Context, denom string) (string, uint64, error) {
+	for _, listDenom := range k.GetParams(ctx).AcceptList {
+		if listDenom.BaseDenom == denom {
+			return listDenom.SymbolDenom, uint64(listDenom.Exponent), nil
+		}
+	}
+	return "", 0, types.ErrUnknownDenom.Wrap(denom)
+}
+
 // SetExchangeRate sets the consensus exchange rate of USD denominated in the
 // denom asset to the store.
 func (k Keeper) SetExchangeRate(ctx sdk.Context, denom string, exchangeRate math.LegacyDec) {
@@ -263,9 +324,11 @@ func (k Keeper) SetExchangeRate(ctx sdk.Context, denom string, exchangeRate math
 }
 
 // SetExchangeRateWithEvent sets an consensus
-// exchange rate to the store with ABCI event
+// exchange rate to the store with ABCI event and records it as the last
+// update of the denom
 func (k Keeper) SetExchangeRateWithEvent(ctx sdk.Context, denom string, exchangeRate math.LegacyDec) error {
 	k.SetExchangeRate(ctx, denom, exchangeRate)
+	k.SetExchangeRateUpdate(ctx, denom, exchangeRate)
 	return ctx.EventManager().EmitTypedEvent(&types.EventSetFxRate{
 		Denom: denom, Rate: exchangeRate,
 	})
@@ -53,12 +53,27 @@ const (
 	initialPower = int64(10000000000)
 )
 
-func (s *IntegrationTestSuite) SetupTest() {
-	// set prefixes for valid addresses, without sealing
+// setAddressPrefixes sets the prefixes for valid addresses, without sealing.
+func setAddressPrefixes() {
 	config := sdk.GetConfig()
 	config.SetBech32PrefixForAccount(appparams.AccountAddressPrefix, appparams.AccountPubKeyPrefix)
 	config.SetBech32PrefixForValidator(appparams.ValidatorAddressPrefix, appparams.ValidatorPubKeyPrefix)
 	config.SetBech32PrefixForConsensusNode(appparams.ConsNodeAddressPrefix, appparams.ConsNodePubKeyPrefix)
+}
+
+// setupApp returns an app for the tests outside of the suite. These tests run
+// standalone because SetupTest mints and stakes appparams.BondDenom while the
+// app bonds the staking default denom, so the suite fails before any of its
+// tests run; they use the validator of the app genesis instead. The address
+// prefixes are set as in the suite, as addresses stringified before they are
+// set are cached with the wrong prefix.
+func setupApp(t *testing.T) *dmchainapp.ChainApp {
+	setAddressPrefixes()
+	return dmchainapp.Setup(t)
+}
+
+func (s *IntegrationTestSuite) SetupTest() {
+	setAddressPrefixes()
 
 	require := s.Require()
 	isCheckTx := false
@@ -24,6 +24,21 @@ func (m Migrator) MigrateValidatorSet(ctx sdk.Context) error {
 	return nil
 }
 
+// Migrate1to2 sets the params added since consensus version 1 to their defaults, as the
+// legacy params subspace panics on reading a key which was never set.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	m.keeper.SetMaxVoteExtensionRates(ctx, defaults.MaxVoteExtensionRates)
+	m.keeper.SetPriceSubmissionMode(ctx, defaults.PriceSubmissionMode)
+	m.keeper.SetCandleIntervals(ctx, defaults.CandleIntervals)
+	m.keeper.SetMaximumCandles(ctx, defaults.MaximumCandles)
+	m.keeper.SetMaximumRateStaleness(ctx, defaults.MaximumRateStaleness)
+	m.keeper.SetMaxPriceMoves(ctx, defaults.MaxPriceMoves)
+	m.keeper.SetDenomOverrides(ctx, defaults.DenomOverrides)
+	m.keeper.SetOutlierFilters(ctx, defaults.OutlierFilters)
+	return nil
+}
+
 // MigrateCurrencyPairProviders adds the price feeder
 // currency pair provider list.
 func (m Migrator) MigrateCurrencyPairProviders(ctx sdk.Context) {
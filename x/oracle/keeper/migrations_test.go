@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/prefix"
+
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/DaevMithran/dmchain/x/oracle/keeper"
+	"github.com/DaevMithran/dmchain/x/oracle/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	app := setupApp(t)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{})
+
+	// drop the params added since consensus version 1
+	keys := [][]byte{
+		types.KeyMaxVoteExtensionRates,
+		types.KeyPriceSubmissionMode,
+		types.KeyCandleIntervals,
+		types.KeyMaximumCandles,
+		types.KeyMaximumRateStaleness,
+		types.KeyMaxPriceMoves,
+		types.KeyDenomOverrides,
+		types.KeyOutlierFilters,
+	}
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	require.Panics(t, func() { app.OracleKeeper.MaxVoteExtensionRates(ctx) })
+
+	m := keeper.NewMigrator(&app.OracleKeeper)
+	require.NoError(t, m.Migrate1to2(ctx))
+
+	defaults := types.DefaultParams()
+	require.Equal(t, defaults.MaxVoteExtensionRates, app.OracleKeeper.MaxVoteExtensionRates(ctx))
+	require.Equal(t, defaults.PriceSubmissionMode, app.OracleKeeper.PriceSubmissionMode(ctx))
+	require.Equal(t, defaults.CandleIntervals, app.OracleKeeper.CandleIntervals(ctx))
+	require.Equal(t, defaults.MaximumCandles, app.OracleKeeper.MaximumCandles(ctx))
+	require.Equal(t, defaults.MaximumRateStaleness, app.OracleKeeper.MaximumRateStaleness(ctx))
+	require.ElementsMatch(t, defaults.MaxPriceMoves, app.OracleKeeper.MaxPriceMoves(ctx))
+	require.ElementsMatch(t, defaults.DenomOverrides, app.OracleKeeper.DenomOverrides(ctx))
+	require.ElementsMatch(t, defaults.OutlierFilters, app.OracleKeeper.OutlierFilters(ctx))
+	require.NoError(t, app.OracleKeeper.GetParams(ctx).Validate())
+}
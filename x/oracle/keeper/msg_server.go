@@ -41,6 +41,10 @@ func (ms msgServer) AggregateExchangeRatePrevote(
 		return nil, err
 	}
 
+	if !ms.PriceSubmissionMode(ctx).AcceptsCommitReveal() && !ms.commitRevealScheduled(ctx) {
+		return nil, types.ErrSubmissionMode.Wrap("prevotes are not accepted")
+	}
+
 	// Ensure prevote wasn't already submitted
 	if ms.HasAggregateExchangeRatePrevote(ctx, valAddr) {
 		return nil, types.ErrExistingPrevote
@@ -80,6 +84,10 @@ func (ms msgServer) AggregateExchangeRateVote(
 	}
 
 	params := ms.GetParams(ctx)
+	if !params.PriceSubmissionMode.AcceptsCommitReveal() {
+		return nil, types.ErrSubmissionMode.Wrap("votes are not accepted")
+	}
+
 	aggregatePrevote, err := ms.GetAggregateExchangeRatePrevote(ctx, valAddr)
 	if err != nil {
 		return nil, types.ErrNoAggregatePrevote.Wrap(msg.Validator)
@@ -189,6 +197,11 @@ func (ms msgServer) LegacyGovUpdateParams(
 			if !accept.ContainDenoms(mandatory) {
 				return nil, fmt.Errorf("denom in MandatoryList not present in AcceptList")
 			}
+			params := ms.GetParams(ctx)
+			params.AcceptList = accept
+			if err := params.Validate(); err != nil {
+				return nil, err
+			}
 			ms.SetAcceptList(ctx, accept)
 
 		case string(types.KeyMandatoryList):
@@ -256,6 +269,62 @@ func (ms msgServer) LegacyGovUpdateParams(
 		case string(types.KeyAveragingWindow):
 			ms.SetAveragingWindow(ctx, msg.Changes.AveragingWindow)
 
+		case string(types.KeyMaxVoteExtensionRates):
+			params := ms.GetParams(ctx)
+			params.MaxVoteExtensionRates = msg.Changes.MaxVoteExtensionRates
+			if err := params.Validate(); err != nil {
+				return nil, err
+			}
+			ms.SetMaxVoteExtensionRates(ctx, msg.Changes.MaxVoteExtensionRates)
+
+		case string(types.KeyCandleIntervals):
+			params := ms.GetParams(ctx)
+			params.CandleIntervals = msg.Changes.CandleIntervals
+			if err := params.Validate(); err != nil {
+				return nil, err
+			}
+			ms.SetCandleIntervals(ctx, msg.Changes.CandleIntervals)
+
+		case string(types.KeyMaximumCandles):
+			if msg.Changes.MaximumCandles < 1 {
+				return nil, fmt.Errorf("oracle parameters MaximumCandles must be greater than 0")
+			}
+			ms.SetMaximumCandles(ctx, msg.Changes.MaximumCandles)
+
+		case string(types.KeyMaximumRateStaleness):
+			if msg.Changes.MaximumRateStaleness < ms.Keeper.VotePeriod(ctx) {
+				return nil, fmt.Errorf("oracle parameters MaximumRateStaleness must be greater than or equal with VotePeriod")
+			}
+			ms.SetMaximumRateStaleness(ctx, msg.Changes.MaximumRateStaleness)
+
+		case string(types.KeyMaxPriceMoves):
+			params := ms.GetParams(ctx)
+			params.MaxPriceMoves = msg.Changes.MaxPriceMoves
+			if err := params.Validate(); err != nil {
+				return nil, err
+			}
+			ms.SetMaxPriceMoves(ctx, msg.Changes.MaxPriceMoves)
+
+		case string(types.KeyDenomOverrides):
+			params := ms.GetParams(ctx)
+			params.DenomOverrides = msg.Changes.DenomOverrides
+			if err := params.Validate(); err != nil {
+				return nil, err
+			}
+			ms.SetDenomOverrides(ctx, msg.Changes.DenomOverrides)
+
+		case string(types.KeyOutlierFilters):
+			params := ms.GetParams(ctx)
+			params.OutlierFilters = msg.Changes.OutlierFilters
+			if err := params.Validate(); err != nil {
+				return nil, err
+			}
+			ms.SetOutlierFilters(ctx, msg.Changes.OutlierFilters)
+
+		case string(types.KeyPriceSubmissionMode):
+			// switching immediately could drop the votes of the current vote period
+			return nil, fmt.Errorf("oracle parameter PriceSubmissionMode can only be changed by a ParamUpdatePlan")
+
 		default:
 			return nil, fmt.Errorf("%s is not an existing oracle param key", key)
 		}
@@ -494,3 +563,71 @@ func (ms msgServer) GovCancelUpdateParamPlan(
 
 	return &types.MsgGovCancelUpdateParamPlanResponse{}, nil
 }
+
+func (ms msgServer) GovResetCircuitBreakers(
+	goCtx context.Context,
+	msg *types.MsgGovResetCircuitBreakers,
+) (*types.MsgGovResetCircuitBreakersResponse, error) {
+	if msg.Authority != ms.authority {
+		err := errors.Wrapf(
+			types.ErrNoGovAuthority,
+			"invalid authority; expected %s, got %s",
+			ms.authority,
+			msg.Authority,
+		)
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, denom := range msg.Denoms {
+		if err := ms.ResetCircuitBreaker(ctx, denom); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgGovResetCircuitBreakersResponse{}, nil
+}
+
+func (ms msgServer) GovUpdateDenomOverrides(
+	goCtx context.Context,
+	msg *types.MsgGovUpdateDenomOverrides,
+) (*types.MsgGovUpdateDenomOverridesResponse, error) {
+	if msg.Authority != ms.authority {
+		err := errors.Wrapf(
+			types.ErrNoGovAuthority,
+			"invalid authority; expected %s, got %s",
+			ms.authority,
+			msg.Authority,
+		)
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := ms.GetParams(ctx)
+
+	plan := types.ParamUpdatePlan{
+		Keys:    []string{string(types.KeyDenomOverrides)},
+		Height:  msg.Height,
+		Changes: params,
+	}
+
+	for _, denom := range msg.RemoveDenoms {
+		plan.Changes.DenomOverrides = plan.Changes.DenomOverrides.Remove(denom)
+	}
+	for _, override := range msg.Overrides {
+		override.SymbolDenom = strings.ToUpper(override.SymbolDenom)
+		plan.Changes.DenomOverrides = plan.Changes.DenomOverrides.Set(override)
+	}
+
+	// validate plan construction before scheduling
+	err := plan.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.ScheduleParamUpdatePlan(ctx, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgGovUpdateDenomOverridesResponse{}, nil
+}
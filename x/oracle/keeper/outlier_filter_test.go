@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"testing"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+
+	"github.com/DaevMithran/dmchain/x/oracle/abci"
+	"github.com/DaevMithran/dmchain/x/oracle/keeper"
+	"github.com/DaevMithran/dmchain/x/oracle/types"
+)
+
+func TestOutlierFilter(t *testing.T) {
+	app := setupApp(t)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{}).WithBlockHeight(9)
+
+	bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	sh := stakingtestutil.NewHelper(t, ctx, app.StakingKeeper)
+	sh.Denom = bondDenom
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, amt))
+	for _, acc := range []sdk.AccAddress{addr, addr2} {
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+		require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, acc, coins))
+	}
+	sh.CreateValidator(valAddr, valPubKey, amt, true)
+	sh.CreateValidator(valAddr2, valPubKey2, amt, true)
+	_, err = app.StakingKeeper.EndBlocker(ctx)
+	require.NoError(t, err)
+
+	vals, err := app.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	require.NoError(t, err)
+	require.Len(t, vals, 3)
+
+	// the voter of the outlying rate is not one of the validators created above
+	rates := map[string]math.LegacyDec{
+		valAddr.String():  math.LegacyOneDec(),
+		valAddr2.String(): math.LegacyNewDecWithPrec(101, 2),
+	}
+	var outlier sdk.ValAddress
+	for _, val := range vals {
+		voter, err := sdk.ValAddressFromBech32(val.GetOperator())
+		require.NoError(t, err)
+		rate, found := rates[voter.String()]
+		if !found {
+			outlier = voter
+			rate = math.LegacyNewDec(5)
+		}
+		app.OracleKeeper.SetAggregateExchangeRateVote(ctx, voter, types.AggregateExchangeRateVote{
+			ExchangeRates: sdk.DecCoins{{Denom: types.DmSymbol, Amount: rate}},
+			Voter:         voter.String(),
+		})
+	}
+
+	app.OracleKeeper.SetOutlierFilters(ctx, types.OutlierFilterList{
+		{SymbolDenom: types.DmSymbol, MadMultiplier: math.LegacyNewDec(3)},
+	})
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, abci.CalcPrices(ctx, app.OracleKeeper.GetParams(ctx), app.OracleKeeper))
+
+	// the outlying rate is not tallied
+	rate, err := app.OracleKeeper.GetExchangeRate(ctx, types.DmSymbol)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyOneDec(), rate)
+
+	var event *types.EventBallotFiltered
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type != "oracle.v1.EventBallotFiltered" {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abcitypes.Event(e))
+		require.NoError(t, err)
+		event = msg.(*types.EventBallotFiltered)
+	}
+	require.NotNil(t, event)
+	require.Equal(t, types.DmSymbol, event.Denom)
+	require.Equal(t, uint64(1), event.FilteredVotes)
+	require.Equal(t, uint64(3), event.TotalVotes)
+	require.Equal(t, []string{outlier.String()}, event.FilteredVoters)
+
+	// all voters miss the other mandatory denoms, the filtered voter also
+	// misses the ballot of DM
+	misses := uint64(len(app.OracleKeeper.MandatoryList(ctx)) - 1)
+	require.Equal(t, misses, app.OracleKeeper.GetMissCounter(ctx, valAddr))
+	require.Equal(t, misses, app.OracleKeeper.GetMissCounter(ctx, valAddr2))
+	require.Equal(t, misses+1, app.OracleKeeper.GetMissCounter(ctx, outlier))
+}
+
+func TestLegacyGovUpdateOutlierFilters(t *testing.T) {
+	app := setupApp(t)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.OracleKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		filters types.OutlierFilterList
+		errMsg  string
+	}{
+		{
+			"inverted percentiles",
+			types.OutlierFilterList{{
+				SymbolDenom:     types.DmSymbol,
+				LowerPercentile: math.LegacyNewDecWithPrec(9, 1),
+				UpperPercentile: math.LegacyNewDecWithPrec(1, 1),
+			}},
+			"oracle parameter OutlierFilter percentiles must be 0 <= LowerPercentile < UpperPercentile <= 1",
+		},
+		{
+			"denom not in the accept list",
+			types.OutlierFilterList{{SymbolDenom: "FOO", MadMultiplier: math.LegacyNewDec(3)}},
+			"denom FOO in OutlierFilters not present in AcceptList",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.LegacyGovUpdateParams(ctx, &types.MsgLegacyGovUpdateParams{
+				Authority: authority,
+				Keys:      []string{string(types.KeyOutlierFilters)},
+				Changes:   types.Params{OutlierFilters: tc.filters},
+			})
+			require.ErrorContains(t, err, tc.errMsg)
+			require.Empty(t, app.OracleKeeper.OutlierFilters(ctx))
+		})
+	}
+
+	filters := types.OutlierFilterList{{SymbolDenom: types.DmSymbol, MadMultiplier: math.LegacyNewDec(3)}}
+	_, err := msgServer.LegacyGovUpdateParams(ctx, &types.MsgLegacyGovUpdateParams{
+		Authority: authority,
+		Keys:      []string{string(types.KeyOutlierFilters)},
+		Changes:   types.Params{OutlierFilters: filters},
+	})
+	require.NoError(t, err)
+	stored := app.OracleKeeper.OutlierFilters(ctx)
+	require.Len(t, stored, 1)
+	require.Equal(t, types.DmSymbol, stored[0].SymbolDenom)
+	require.Equal(t, math.LegacyNewDec(3), stored[0].MadMultiplier)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"slices"
+
 	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -16,12 +18,59 @@ func (k Keeper) ScheduleParamUpdatePlan(ctx sdk.Context, plan types.ParamUpdateP
 	if err := k.ValidateParamChanges(ctx, plan.Keys, plan.Changes); err != nil {
 		return err
 	}
+	if err := k.validatePriceSubmissionModeSwitch(ctx, plan); err != nil {
+		return err
+	}
 
 	height := util.SafeInt64ToUint64(plan.Height)
 
 	return k.ParamUpdatePlans.Set(ctx, height, plan)
 }
 
+// validatePriceSubmissionModeSwitch checks that a plan switching the PriceSubmissionMode
+// executes at the last block of a vote period, so that the votes of the ending period are
+// tallied in the mode they were submitted in, and that vote extensions are enabled before
+// switching to a mode accepting them.
+func (k Keeper) validatePriceSubmissionModeSwitch(ctx sdk.Context, plan types.ParamUpdatePlan) error {
+	if !slices.Contains(plan.Keys, string(types.KeyPriceSubmissionMode)) {
+		return nil
+	}
+
+	votePeriod := k.VotePeriod(ctx)
+	if (util.SafeInt64ToUint64(plan.Height)+1)%votePeriod != 0 {
+		return types.ErrInvalidRequest.Wrapf(
+			"PriceSubmissionMode can only be switched at the last block of a vote period of %d blocks",
+			votePeriod,
+		)
+	}
+
+	if plan.Changes.PriceSubmissionMode.AcceptsVoteExtensions() {
+		cp := ctx.ConsensusParams()
+		if cp.Abci == nil || cp.Abci.VoteExtensionsEnableHeight == 0 || cp.Abci.VoteExtensionsEnableHeight > plan.Height {
+			return types.ErrInvalidRequest.Wrap("vote extensions must be enabled by the plan height")
+		}
+	}
+
+	return nil
+}
+
+// commitRevealScheduled returns true if a plan switching to a PriceSubmissionMode accepting
+// commit-reveal votes executes at the end of the current vote period. Prevotes are accepted
+// in that period so that validators can reveal in the first period of the new mode.
+func (k Keeper) commitRevealScheduled(ctx sdk.Context) bool {
+	votePeriod := k.VotePeriod(ctx)
+	height := util.SafeInt64ToUint64(ctx.BlockHeight())
+	periodLastBlock := height + votePeriod - 1 - height%votePeriod
+
+	plan, err := k.ParamUpdatePlans.Get(ctx, periodLastBlock)
+	if err != nil {
+		return false
+	}
+
+	return slices.Contains(plan.Keys, string(types.KeyPriceSubmissionMode)) &&
+		plan.Changes.PriceSubmissionMode.AcceptsCommitReveal()
+}
+
 // ClearParamUpdatePlan will clear an upcoming param update plan if one exists and return
 // an error if one isn't found.
 func (k Keeper) ClearParamUpdatePlan(ctx sdk.Context, planHeight uint64) error {
@@ -138,6 +187,29 @@ func (k Keeper) ValidateParamChanges(ctx sdk.Context, keys []string, changes typ
 		case string(types.KeyAveragingWindow):
 			params.AveragingWindow = changes.AveragingWindow
 
+		case string(types.KeyMaxVoteExtensionRates):
+			params.MaxVoteExtensionRates = changes.MaxVoteExtensionRates
+
+		case string(types.KeyPriceSubmissionMode):
+			params.PriceSubmissionMode = changes.PriceSubmissionMode
+
+		case string(types.KeyCandleIntervals):
+			params.CandleIntervals = changes.CandleIntervals
+
+		case string(types.KeyMaximumCandles):
+			params.MaximumCandles = changes.MaximumCandles
+
+		case string(types.KeyMaximumRateStaleness):
+			params.MaximumRateStaleness = changes.MaximumRateStaleness
+
+		case string(types.KeyMaxPriceMoves):
+			params.MaxPriceMoves = changes.MaxPriceMoves
+
+		case string(types.KeyDenomOverrides):
+			params.DenomOverrides = changes.DenomOverrides
+
+		case string(types.KeyOutlierFilters):
+			params.OutlierFilters = changes.OutlierFilters
 		}
 	}
 
@@ -202,6 +274,30 @@ func (k Keeper) ExecuteParamUpdatePlan(ctx sdk.Context, plan types.ParamUpdatePl
 
 		case string(types.KeyAveragingWindow):
 			k.SetAveragingWindow(ctx, plan.Changes.AveragingWindow)
+
+		case string(types.KeyMaxVoteExtensionRates):
+			k.SetMaxVoteExtensionRates(ctx, plan.Changes.MaxVoteExtensionRates)
+
+		case string(types.KeyPriceSubmissionMode):
+			k.SetPriceSubmissionMode(ctx, plan.Changes.PriceSubmissionMode)
+
+		case string(types.KeyCandleIntervals):
+			k.SetCandleIntervals(ctx, plan.Changes.CandleIntervals)
+
+		case string(types.KeyMaximumCandles):
+			k.SetMaximumCandles(ctx, plan.Changes.MaximumCandles)
+
+		case string(types.KeyMaximumRateStaleness):
+			k.SetMaximumRateStaleness(ctx, plan.Changes.MaximumRateStaleness)
+
+		case string(types.KeyMaxPriceMoves):
+			k.SetMaxPriceMoves(ctx, plan.Changes.MaxPriceMoves)
+
+		case string(types.KeyDenomOverrides):
+			k.SetDenomOverrides(ctx, plan.Changes.DenomOverrides)
+
+		case string(types.KeyOutlierFilters):
+			k.SetOutlierFilters(ctx, plan.Changes.OutlierFilters)
 		}
 	}
 
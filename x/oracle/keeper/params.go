@@ -254,3 +254,104 @@ func (k Keeper) AveragingWindow(ctx sdk.Context) (res bool) {
 	k.paramSpace.Get(ctx, types.KeyAveragingWindow, &res)
 	return
 }
+
+// MaxVoteExtensionRates returns the maximum amount of exchange rates a vote
+// extension may contain.
+func (k Keeper) MaxVoteExtensionRates(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxVoteExtensionRates, &res)
+	return
+}
+
+// SetMaxVoteExtensionRates updates the maximum amount of exchange rates a vote
+// extension may contain.
+func (k Keeper) SetMaxVoteExtensionRates(ctx sdk.Context, value uint64) {
+	k.paramSpace.Set(ctx, types.KeyMaxVoteExtensionRates, value)
+}
+
+// PriceSubmissionMode returns how validators submit exchange rate votes.
+func (k Keeper) PriceSubmissionMode(ctx sdk.Context) (res types.PriceSubmissionMode) {
+	k.paramSpace.Get(ctx, types.KeyPriceSubmissionMode, &res)
+	return
+}
+
+// SetPriceSubmissionMode updates how validators submit exchange rate votes.
+func (k Keeper) SetPriceSubmissionMode(ctx sdk.Context, value types.PriceSubmissionMode) {
+	k.paramSpace.Set(ctx, types.KeyPriceSubmissionMode, value)
+}
+
+// CandleIntervals returns the lengths in blocks of the OHLC candles updated at
+// each historic price stamp.
+func (k Keeper) CandleIntervals(ctx sdk.Context) (res []uint64) {
+	k.paramSpace.Get(ctx, types.KeyCandleIntervals, &res)
+	return
+}
+
+// SetCandleIntervals updates the lengths in blocks of the OHLC candles updated
+// at each historic price stamp.
+func (k Keeper) SetCandleIntervals(ctx sdk.Context, value []uint64) {
+	k.paramSpace.Set(ctx, types.KeyCandleIntervals, value)
+}
+
+// MaximumCandles returns the maximum amount of candles the oracle module will
+// keep for each denom and candle interval.
+func (k Keeper) MaximumCandles(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaximumCandles, &res)
+	return
+}
+
+// SetMaximumCandles updates the maximum amount of candles the oracle module
+// will keep for each denom and candle interval.
+func (k Keeper) SetMaximumCandles(ctx sdk.Context, value uint64) {
+	k.paramSpace.Set(ctx, types.KeyMaximumCandles, value)
+}
+
+// MaximumRateStaleness returns the maximum amount of blocks since the last
+// update of an exchange rate before it is stale.
+func (k Keeper) MaximumRateStaleness(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaximumRateStaleness, &res)
+	return
+}
+
+// SetMaximumRateStaleness updates the maximum amount of blocks since the
+// last update of an exchange rate before it is stale.
+func (k Keeper) SetMaximumRateStaleness(ctx sdk.Context, value uint64) {
+	k.paramSpace.Set(ctx, types.KeyMaximumRateStaleness, value)
+}
+
+// MaxPriceMoves returns the largest relative moves of the exchange rates of
+// denoms before their circuit breaker halts them.
+func (k Keeper) MaxPriceMoves(ctx sdk.Context) (res types.MaxPriceMoveList) {
+	k.paramSpace.Get(ctx, types.KeyMaxPriceMoves, &res)
+	return
+}
+
+// SetMaxPriceMoves updates the largest relative moves of the exchange rates
+// of denoms before their circuit breaker halts them.
+func (k Keeper) SetMaxPriceMoves(ctx sdk.Context, maxPriceMoves types.MaxPriceMoveList) {
+	k.paramSpace.Set(ctx, types.KeyMaxPriceMoves, maxPriceMoves)
+}
+
+// DenomOverrides returns the voting rules of denoms replacing the global ones.
+func (k Keeper) DenomOverrides(ctx sdk.Context) (res types.DenomOverrideList) {
+	k.paramSpace.Get(ctx, types.KeyDenomOverrides, &res)
+	return
+}
+
+// SetDenomOverrides updates the voting rules of denoms replacing the global
+// ones.
+func (k Keeper) SetDenomOverrides(ctx sdk.Context, denomOverrides types.DenomOverrideList) {
+	k.paramSpace.Set(ctx, types.KeyDenomOverrides, denomOverrides)
+}
+
+// OutlierFilters returns the filters removing outlying votes from the ballots
+// of denoms.
+func (k Keeper) OutlierFilters(ctx sdk.Context) (res types.OutlierFilterList) {
+	k.paramSpace.Get(ctx, types.KeyOutlierFilters, &res)
+	return
+}
+
+// SetOutlierFilters updates the filters removing outlying votes from the
+// ballots of denoms.
+func (k Keeper) SetOutlierFilters(ctx sdk.Context, outlierFilters types.OutlierFilterList) {
+	k.paramSpace.Set(ctx, types.KeyOutlierFilters, outlierFilters)
+}
@@ -1,7 +1,17 @@
 package keeper_test
 
 import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
 	"cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/DaevMithran/dmchain/x/oracle/keeper"
 	"github.com/DaevMithran/dmchain/x/oracle/types"
 )
 
@@ -19,3 +29,40 @@ func (s *IntegrationTestSuite) TestVoteThreshold() {
 	voteThresholdDec := app.OracleKeeper.VoteThreshold(ctx)
 	s.Require().Equal(newVoteTreshold, voteThresholdDec)
 }
+
+func TestLegacyGovUpdateMaxVoteExtensionRates(t *testing.T) {
+	app := setupApp(t)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.OracleKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	acceptList := app.OracleKeeper.AcceptList(ctx)
+	maxRates := app.OracleKeeper.MaxVoteExtensionRates(ctx)
+
+	// the maximum must leave room for a rate of every accept list denom
+	_, err := msgServer.LegacyGovUpdateParams(ctx, &types.MsgLegacyGovUpdateParams{
+		Authority: authority,
+		Keys:      []string{string(types.KeyMaxVoteExtensionRates)},
+		Changes:   types.Params{MaxVoteExtensionRates: uint64(len(acceptList)) - 1},
+	})
+	require.ErrorContains(t, err, "MaxVoteExtensionRates must be greater than or equal with the AcceptList length")
+	require.Equal(t, maxRates, app.OracleKeeper.MaxVoteExtensionRates(ctx))
+
+	_, err = msgServer.LegacyGovUpdateParams(ctx, &types.MsgLegacyGovUpdateParams{
+		Authority: authority,
+		Keys:      []string{string(types.KeyMaxVoteExtensionRates)},
+		Changes:   types.Params{MaxVoteExtensionRates: uint64(len(acceptList))},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(acceptList)), app.OracleKeeper.MaxVoteExtensionRates(ctx))
+
+	// growing the accept list past the maximum is rejected too
+	grown := append(types.DenomList{{BaseDenom: "ufoo", SymbolDenom: "FOO", Exponent: 6}}, acceptList...)
+	_, err = msgServer.LegacyGovUpdateParams(ctx, &types.MsgLegacyGovUpdateParams{
+		Authority: authority,
+		Keys:      []string{string(types.KeyAcceptList)},
+		Changes:   types.Params{AcceptList: grown},
+	})
+	require.ErrorContains(t, err, "MaxVoteExtensionRates must be greater than or equal with the AcceptList length")
+	require.Equal(t, acceptList, app.OracleKeeper.AcceptList(ctx))
+}
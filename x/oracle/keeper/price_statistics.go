@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"strings"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/DaevMithran/dmchain/util/decmath"
+	"github.com/DaevMithran/dmchain/x/oracle/types"
+)
+
+// DefaultStatisticsPercentiles are the percentiles, in whole percent, returned
+// by PriceStatistics when none are requested.
+var DefaultStatisticsPercentiles = []uint32{5, 25, 75, 95}
+
+// PriceStatistics returns the statistics of the latest numStamps historic
+// prices of a given denom, or of its latest MaximumPriceStamps prices if
+// numStamps is zero, with the given percentiles in whole percent.
+func (k Keeper) PriceStatistics(
+	ctx sdk.Context,
+	denom string,
+	numStamps uint64,
+	percentiles []uint32,
+) (types.PriceStatistics, error) {
+	denom = strings.ToUpper(denom)
+	if numStamps == 0 {
+		numStamps = k.MaximumPriceStamps(ctx)
+	}
+	if len(percentiles) == 0 {
+		percentiles = DefaultStatisticsPercentiles
+	}
+
+	// historic prices are returned from the newest
+	prices := k.historicPrices(ctx, denom, numStamps)
+	if len(prices) == 0 {
+		return types.PriceStatistics{}, types.ErrNoHistoricPrice.Wrap(denomErr + denom)
+	}
+
+	stats := types.PriceStatistics{
+		Denom:     denom,
+		NumStamps: uint64(len(prices)),
+	}
+
+	var err error
+	if stats.Min, err = decmath.Min(prices); err != nil {
+		return types.PriceStatistics{}, errors.Wrap(err, denomErr+denom)
+	}
+	if stats.Max, err = decmath.Max(prices); err != nil {
+		return types.PriceStatistics{}, errors.Wrap(err, denomErr+denom)
+	}
+	if stats.Mean, err = decmath.Average(prices); err != nil {
+		return types.PriceStatistics{}, errors.Wrap(err, denomErr+denom)
+	}
+
+	variance, err := decmath.Variance(prices)
+	if err != nil {
+		return types.PriceStatistics{}, errors.Wrap(err, denomErr+denom)
+	}
+	if stats.StandardDeviation, err = variance.ApproxSqrt(); err != nil {
+		return types.PriceStatistics{}, errors.Wrap(err, denomErr+denom)
+	}
+
+	if stats.RealizedVolatility, err = realizedVolatility(prices); err != nil {
+		return types.PriceStatistics{}, errors.Wrap(err, denomErr+denom)
+	}
+
+	for _, percentile := range percentiles {
+		if percentile > 100 {
+			return types.PriceStatistics{}, types.ErrInvalidRequest.Wrapf("percentile %d is over 100", percentile)
+		}
+
+		value, err := decmath.Percentile(prices, math.LegacyNewDecWithPrec(int64(percentile), 2))
+		if err != nil {
+			return types.PriceStatistics{}, errors.Wrap(err, denomErr+denom)
+		}
+		stats.Percentiles = append(stats.Percentiles, types.PricePercentile{Percentile: percentile, Value: value})
+	}
+
+	// Median sorts the prices in place, so it is computed last
+	if stats.Median, err = decmath.Median(prices); err != nil {
+		return types.PriceStatistics{}, errors.Wrap(err, denomErr+denom)
+	}
+
+	return stats, nil
+}
+
+// realizedVolatility returns the square root of the sum of the squared log
+// returns between consecutive prices.
+func realizedVolatility(prices []math.LegacyDec) (math.LegacyDec, error) {
+	sumSquares := math.LegacyZeroDec()
+	for i := 1; i < len(prices); i++ {
+		if !prices[i].IsPositive() {
+			return math.LegacyZeroDec(), decmath.ErrNonPositiveLog
+		}
+		logReturn, err := decmath.Ln(prices[i-1].Quo(prices[i]))
+		if err != nil {
+			return math.LegacyZeroDec(), err
+		}
+		sumSquares = sumSquares.Add(logReturn.Mul(logReturn))
+	}
+
+	return sumSquares.ApproxSqrt()
+}
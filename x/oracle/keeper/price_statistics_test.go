@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/DaevMithran/dmchain/x/oracle/keeper"
+	"github.com/DaevMithran/dmchain/x/oracle/types"
+)
+
+func TestPriceStatistics(t *testing.T) {
+	app := setupApp(t)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{})
+	querier := keeper.NewQuerier(app.OracleKeeper)
+
+	for i, price := range []int64{2, 4, 1, 2} {
+		app.OracleKeeper.SetHistoricPrice(ctx, types.USDCSymbol, uint64(i+1), math.LegacyNewDec(price))
+	}
+
+	res, err := querier.PriceStatistics(ctx, &types.QueryPriceStatisticsRequest{
+		Denom:       "usdc",
+		Percentiles: []uint32{0, 25, 50, 100},
+	})
+	require.NoError(t, err)
+
+	stats := res.Statistics
+	require.Equal(t, types.USDCSymbol, stats.Denom)
+	require.Equal(t, uint64(4), stats.NumStamps)
+	require.Equal(t, math.LegacyNewDec(1), stats.Min)
+	require.Equal(t, math.LegacyNewDec(4), stats.Max)
+	require.Equal(t, math.LegacyMustNewDecFromStr("2.25"), stats.Mean)
+	require.Equal(t, math.LegacyNewDec(2), stats.Median)
+
+	// sqrt(1.1875) and sqrt(6) * ln(2)
+	tolerance := math.LegacyNewDecWithPrec(1, 12)
+	require.True(t, stats.StandardDeviation.Sub(math.LegacyMustNewDecFromStr("1.089724735885168")).Abs().LTE(tolerance))
+	require.True(t, stats.RealizedVolatility.Sub(math.LegacyMustNewDecFromStr("1.697856909020665")).Abs().LTE(tolerance))
+
+	require.Equal(t, []types.PricePercentile{
+		{Percentile: 0, Value: math.LegacyNewDec(1)},
+		{Percentile: 25, Value: math.LegacyMustNewDecFromStr("1.75")},
+		{Percentile: 50, Value: math.LegacyNewDec(2)},
+		{Percentile: 100, Value: math.LegacyNewDec(4)},
+	}, stats.Percentiles)
+
+	// a window of the latest stamps, with the default percentiles
+	res, err = querier.PriceStatistics(ctx, &types.QueryPriceStatisticsRequest{Denom: types.USDCSymbol, NumStamps: 2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Statistics.NumStamps)
+	require.Equal(t, math.LegacyMustNewDecFromStr("1.5"), res.Statistics.Median)
+	require.Len(t, res.Statistics.Percentiles, len(keeper.DefaultStatisticsPercentiles))
+
+	_, err = querier.PriceStatistics(ctx, &types.QueryPriceStatisticsRequest{Denom: types.USDCSymbol, Percentiles: []uint32{101}})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	_, err = querier.PriceStatistics(ctx, &types.QueryPriceStatisticsRequest{Denom: types.USDTSymbol})
+	require.ErrorIs(t, err, types.ErrNoHistoricPrice)
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/DaevMithran/dmchain/util"
+	"github.com/DaevMithran/dmchain/x/oracle/types"
+)
+
+// SetExchangeRateUpdate records an exchange rate of a given denom as its last
+// update at the current block.
+func (k Keeper) SetExchangeRateUpdate(ctx sdk.Context, denom string, exchangeRate math.LegacyDec) {
+	denom = strings.ToUpper(denom)
+	_ = k.ExchangeRateUpdates.Set(ctx, denom, types.ExchangeRateUpdate{
+		Denom:        denom,
+		ExchangeRate: exchangeRate,
+		BlockNum:     util.SafeInt64ToUint64(ctx.BlockHeight()),
+		BlockTime:    ctx.BlockTime(),
+	})
+}
+
+// KeepLastExchangeRate sets the exchange rate of a given denom to its last
+// update, if it has one, without recording a new update.
+func (k Keeper) KeepLastExchangeRate(ctx sdk.Context, denom string) error {
+	update, err := k.ExchangeRateUpdates.Get(ctx, strings.ToUpper(denom))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	k.SetExchangeRate(ctx, update.Denom, update.ExchangeRate)
+	return nil
+}
+
+// GetExchangeRateStatus returns the last exchange rate of a given denom with
+// its age at the current block. The last exchange rate is retained when the
+// ballot of the denom is dropped, it is then stale after MaximumRateStaleness
+// blocks.
+func (k Keeper) GetExchangeRateStatus(ctx sdk.Context, denom string) (types.ExchangeRateStatus, error) {
+	update, err := k.ExchangeRateUpdates.Get(ctx, strings.ToUpper(denom))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ExchangeRateStatus{}, types.ErrUnknownDenom.Wrap(denom)
+		}
+		return types.ExchangeRateStatus{}, err
+	}
+
+	return k.exchangeRateStatus(ctx, update, k.MaximumRateStaleness(ctx)), nil
+}
+
+// GetFreshExchangeRate returns the last exchange rate of a given denom, or
+// ErrStaleExchangeRate if it is stale. Modules which must not act on an old
+// price should use it over GetExchangeRate.
+func (k Keeper) GetFreshExchangeRate(ctx sdk.Context, denom string) (math.LegacyDec, error) {
+	status, err := k.GetExchangeRateStatus(ctx, denom)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+	if status.Stale {
+		return math.LegacyZeroDec(), types.ErrStaleExchangeRate.Wrapf(
+			"%s last updated %d blocks ago", status.LastUpdate.Denom, status.AgeBlocks,
+		)
+	}
+
+	return status.LastUpdate.ExchangeRate, nil
+}
+
+// GetExchangeRateStatuses returns the last exchange rates of all denoms with
+// their ages at the current block.
+func (k Keeper) GetExchangeRateStatuses(ctx sdk.Context) []types.ExchangeRateStatus {
+	maxStaleness := k.MaximumRateStaleness(ctx)
+
+	statuses := []types.ExchangeRateStatus{}
+	k.IterateExchangeRateUpdates(ctx, func(update types.ExchangeRateUpdate) bool {
+		statuses = append(statuses, k.exchangeRateStatus(ctx, update, maxStaleness))
+		return false
+	})
+
+	return statuses
+}
+
+// IterateExchangeRateUpdates iterates over the last exchange rate updates of
+// all denoms.
+func (k Keeper) IterateExchangeRateUpdates(ctx sdk.Context, handler func(types.ExchangeRateUpdate) bool) {
+	err := k.ExchangeRateUpdates.Walk(ctx, nil, func(_ string, update types.ExchangeRateUpdate) (bool, error) {
+		return handler(update), nil
+	})
+	if err != nil {
+		k.Logger(ctx).Error("failed to iterate exchange rate updates", "error", err)
+	}
+}
+
+func (k Keeper) exchangeRateStatus(
+	ctx sdk.Context,
+	update types.ExchangeRateUpdate,
+	maxStaleness uint64,
+) types.ExchangeRateStatus {
+	var ageBlocks uint64
+	if height := util.SafeInt64ToUint64(ctx.BlockHeight()); height > update.BlockNum {
+		ageBlocks = height - update.BlockNum
+	}
+
+	age := ctx.BlockTime().Sub(update.BlockTime)
+	if age < 0 {
+		age = 0
+	}
+
+	_, halted := k.GetPriceHalt(ctx, update.Denom)
+
+	return types.ExchangeRateStatus{
+		LastUpdate: update,
+		AgeBlocks:  ageBlocks,
+		Age:        age,
+		Stale:      ageBlocks > maxStaleness,
+		Halted:     halted,
+	}
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	oracle "github.com/DaevMithran/dmchain/x/oracle"
+	"github.com/DaevMithran/dmchain/x/oracle/keeper"
+	"github.com/DaevMithran/dmchain/x/oracle/types"
+)
+
+func TestExchangeRateStaleness(t *testing.T) {
+	app := setupApp(t)
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{}).WithBlockHeight(10).WithBlockTime(start)
+
+	_, err := app.OracleKeeper.GetExchangeRateStatus(ctx, types.DmSymbol)
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+
+	require.NoError(t, app.OracleKeeper.SetExchangeRateWithEvent(ctx, "dm", math.LegacyNewDec(2)))
+	// the ballot of the denom is dropped in the following vote periods
+	app.OracleKeeper.ClearExchangeRates(ctx)
+	_, err = app.OracleKeeper.GetExchangeRate(ctx, types.DmSymbol)
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+
+	maxStaleness := app.OracleKeeper.MaximumRateStaleness(ctx)
+	testCases := []struct {
+		name   string
+		height uint64
+		stale  bool
+	}{
+		{"updated in the current block", 10, false},
+		{"at the maximum staleness", 10 + maxStaleness, false},
+		{"over the maximum staleness", 11 + maxStaleness, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			age := time.Duration(tc.height-10) * 5 * time.Second
+			ctx := ctx.WithBlockHeight(int64(tc.height)).WithBlockTime(start.Add(age))
+
+			status, err := app.OracleKeeper.GetExchangeRateStatus(ctx, types.DmSymbol)
+			require.NoError(t, err)
+			require.Equal(t, math.LegacyNewDec(2), status.LastUpdate.ExchangeRate)
+			require.Equal(t, uint64(10), status.LastUpdate.BlockNum)
+			require.Equal(t, start, status.LastUpdate.BlockTime)
+			require.Equal(t, tc.height-10, status.AgeBlocks)
+			require.Equal(t, age, status.Age)
+			require.Equal(t, tc.stale, status.Stale)
+
+			rate, err := app.OracleKeeper.GetFreshExchangeRate(ctx, types.DmSymbol)
+			if tc.stale {
+				require.ErrorIs(t, err, types.ErrStaleExchangeRate)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, math.LegacyNewDec(2), rate)
+		})
+	}
+
+	ctx = ctx.WithBlockHeight(20)
+	require.NoError(t, app.OracleKeeper.SetExchangeRateWithEvent(ctx, types.USDTSymbol, math.LegacyOneDec()))
+
+	querier := keeper.NewQuerier(app.OracleKeeper)
+	res, err := querier.LastExchangeRate(ctx, &types.QueryLastExchangeRateRequest{Denom: types.USDTSymbol})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), res.Status.AgeBlocks)
+
+	allRes, err := querier.LastExchangeRates(ctx, &types.QueryLastExchangeRatesRequest{})
+	require.NoError(t, err)
+	require.Len(t, allRes.Statuses, 2)
+	require.Equal(t, types.DmSymbol, allRes.Statuses[0].LastUpdate.Denom)
+	require.Equal(t, uint64(10), allRes.Statuses[0].AgeBlocks)
+
+	genesis := oracle.ExportGenesis(ctx, app.OracleKeeper)
+	require.Len(t, genesis.ExchangeRateUpdates, 2)
+}
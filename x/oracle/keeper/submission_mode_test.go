@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/DaevMithran/dmchain/x/oracle/keeper"
+	"github.com/DaevMithran/dmchain/x/oracle/types"
+)
+
+func TestPriceSubmissionModeSwitch(t *testing.T) {
+	app := setupApp(t)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 5})
+	msgServer := keeper.NewMsgServerImpl(app.OracleKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	votePeriod := app.OracleKeeper.VotePeriod(ctx)
+	periodLastBlock := int64(votePeriod - 1)
+
+	validators, err := app.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	require.NoError(t, err)
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].GetOperator())
+	require.NoError(t, err)
+
+	salt := "1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef"
+	prevote := &types.MsgAggregateExchangeRatePrevote{
+		Hash:      types.GetAggregateVoteHash(salt, "USDT:1.0", valAddr).String(),
+		Feeder:    sdk.AccAddress(valAddr).String(),
+		Validator: valAddr.String(),
+	}
+	vote := &types.MsgAggregateExchangeRateVote{
+		Salt:          salt,
+		ExchangeRates: "USDT:1.0",
+		Feeder:        sdk.AccAddress(valAddr).String(),
+		Validator:     valAddr.String(),
+	}
+
+	plan := func(height int64, mode types.PriceSubmissionMode) types.ParamUpdatePlan {
+		changes := app.OracleKeeper.GetParams(ctx)
+		changes.PriceSubmissionMode = mode
+		return types.ParamUpdatePlan{
+			Keys:    []string{string(types.KeyPriceSubmissionMode)},
+			Height:  height,
+			Changes: changes,
+		}
+	}
+
+	// the mode cannot be switched immediately, nor in the middle of a vote period
+	_, err = msgServer.LegacyGovUpdateParams(ctx, &types.MsgLegacyGovUpdateParams{
+		Authority: authority,
+		Keys:      []string{string(types.KeyPriceSubmissionMode)},
+		Changes:   plan(0, types.PriceSubmissionMode_PRICE_SUBMISSION_MODE_COMMIT_REVEAL).Changes,
+	})
+	require.Error(t, err)
+	err = app.OracleKeeper.ScheduleParamUpdatePlan(ctx, plan(periodLastBlock-1, types.PriceSubmissionMode_PRICE_SUBMISSION_MODE_COMMIT_REVEAL))
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	// vote extension modes require vote extensions to be enabled by the plan height
+	err = app.OracleKeeper.ScheduleParamUpdatePlan(ctx, plan(periodLastBlock, types.PriceSubmissionMode_PRICE_SUBMISSION_MODE_VOTE_EXTENSIONS))
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	veCtx := ctx.WithConsensusParams(cmtproto.ConsensusParams{Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 2}})
+	require.NoError(t, app.OracleKeeper.ScheduleParamUpdatePlan(veCtx, plan(periodLastBlock, types.PriceSubmissionMode_PRICE_SUBMISSION_MODE_VOTE_EXTENSIONS)))
+	require.NoError(t, app.OracleKeeper.ClearParamUpdatePlan(ctx, uint64(periodLastBlock)))
+
+	// commit-reveal msgs are rejected in vote extension mode
+	app.OracleKeeper.SetPriceSubmissionMode(ctx, types.PriceSubmissionMode_PRICE_SUBMISSION_MODE_VOTE_EXTENSIONS)
+	_, err = msgServer.AggregateExchangeRatePrevote(ctx, prevote)
+	require.ErrorIs(t, err, types.ErrSubmissionMode)
+	_, err = msgServer.AggregateExchangeRateVote(ctx, vote)
+	require.ErrorIs(t, err, types.ErrSubmissionMode)
+
+	// prevotes are accepted in the period ending with a switch to commit-reveal, so that
+	// they are revealed in the first period of the new mode
+	require.NoError(t, app.OracleKeeper.ScheduleParamUpdatePlan(ctx, plan(periodLastBlock, types.PriceSubmissionMode_PRICE_SUBMISSION_MODE_COMMIT_REVEAL)))
+	_, err = msgServer.AggregateExchangeRatePrevote(ctx, prevote)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(periodLastBlock)
+	require.NoError(t, app.OracleKeeper.ExecuteParamUpdatePlan(ctx, plan(periodLastBlock, types.PriceSubmissionMode_PRICE_SUBMISSION_MODE_COMMIT_REVEAL)))
+	require.Equal(t, types.PriceSubmissionMode_PRICE_SUBMISSION_MODE_COMMIT_REVEAL, app.OracleKeeper.PriceSubmissionMode(ctx))
+
+	ctx = ctx.WithBlockHeight(periodLastBlock + 1)
+	_, err = msgServer.AggregateExchangeRateVote(ctx, vote)
+	require.NoError(t, err)
+}
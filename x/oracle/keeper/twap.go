@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"strings"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/DaevMithran/dmchain/util"
+	"github.com/DaevMithran/dmchain/x/oracle/types"
+)
+
+// TwapRateWindow is the window of the TWAP returned by GetTwapRate.
+const TwapRateWindow = 30 * time.Minute
+
+// maxTwapWindowSeconds is the longest TWAP window in seconds that fits a time.Duration.
+const maxTwapWindowSeconds = uint64(1<<63-1) / uint64(time.Second)
+
+var _ types.FeeAbskeeper = Keeper{}
+
+// TWAP returns the block-time-weighted average of the historic prices of a
+// given denom over the window ending at endHeight, or at the current block
+// if endHeight is zero or not in the past.
+//
+// Each historic price is weighted by the block time it held for, from its
+// stamp until the next stamp, or until the end of the window for the latest
+// one. A window ending in the past ends at the latest stamp at or before
+// endHeight. If no price held for any time in the window, the latest price
+// is returned.
+func (k Keeper) TWAP(
+	ctx sdk.Context,
+	denom string,
+	window time.Duration,
+	endHeight uint64,
+) (math.LegacyDec, error) {
+	if window <= 0 {
+		return math.LegacyZeroDec(), types.ErrInvalidRequest.Wrap("twap window must be positive")
+	}
+
+	denom = strings.ToUpper(denom)
+	endTime := ctx.BlockTime()
+	endsAtCurrentBlock := true
+	if height := util.SafeInt64ToUint64(ctx.BlockHeight()); endHeight == 0 || endHeight >= height {
+		endHeight = height
+	} else {
+		endsAtCurrentBlock = false
+	}
+
+	var (
+		found       bool
+		latest      math.LegacyDec
+		startTime   time.Time
+		next        time.Time
+		weightedSum = math.LegacyZeroDec()
+		totalWeight = math.LegacyZeroDec()
+	)
+
+	rng := collections.NewPrefixedPairRange[string, uint64](denom).EndInclusive(endHeight).Descending()
+	err := k.HistoricPrices.Walk(ctx, rng, func(key collections.Pair[string, uint64], price math.LegacyDec) (bool, error) {
+		stampTime, ok := k.GetHistoricStampTime(ctx, key.K2())
+		if !ok {
+			// prices stamped before stamp times were recorded cannot be weighted
+			return false, nil
+		}
+
+		if !found {
+			found = true
+			latest = price
+			if !endsAtCurrentBlock {
+				endTime = stampTime
+			}
+			startTime = endTime.Add(-window)
+			next = endTime
+		}
+
+		from := stampTime
+		if from.Before(startTime) {
+			from = startTime
+		}
+		if next.After(from) {
+			weight := math.LegacyNewDec(next.Sub(from).Nanoseconds())
+			weightedSum = weightedSum.Add(price.Mul(weight))
+			totalWeight = totalWeight.Add(weight)
+		}
+		next = stampTime
+
+		return !stampTime.After(startTime), nil
+	})
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	if !found {
+		return math.LegacyZeroDec(), types.ErrNoHistoricPrice.Wrap(denomErr + denom)
+	}
+	if totalWeight.IsZero() {
+		return latest, nil
+	}
+
+	return weightedSum.Quo(totalWeight), nil
+}
+
+// GetTwapRate returns the TWAP over TwapRateWindow of the USD rate of a given
+// ibc denom in its base denom, translating the denom through the accept list
+// as GetExchangeRateBase does. The UsdcIbcDenom param is translated to USDC.
+func (k Keeper) GetTwapRate(ctx sdk.Context, ibcDenom string) (math.LegacyDec, error) {
+	params := k.GetParams(ctx)
+
+	var symbol string
+	var exponent uint64
+	for _, listDenom := range params.AcceptList {
+		if listDenom.BaseDenom == ibcDenom ||
+			(ibcDenom == params.UsdcIbcDenom && strings.EqualFold(listDenom.SymbolDenom, types.USDCSymbol)) {
+			symbol = listDenom.SymbolDenom
+			exponent = uint64(listDenom.Exponent)
+			break
+		}
+	}
+	if len(symbol) == 0 {
+		return math.LegacyZeroDec(), types.ErrUnknownDenom.Wrap(ibcDenom)
+	}
+
+	twap, err := k.TWAP(ctx, symbol, TwapRateWindow, 0)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	return twap.Quo(ten.Power(exponent)), nil
+}
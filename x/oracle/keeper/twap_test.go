@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	oracle "github.com/DaevMithran/dmchain/x/oracle"
+	"github.com/DaevMithran/dmchain/x/oracle/keeper"
+	"github.com/DaevMithran/dmchain/x/oracle/types"
+)
+
+func TestTWAP(t *testing.T) {
+	app := setupApp(t)
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{})
+
+	// USDC is stamped at 1 for 10s, at 2 for 30s and at 4 since
+	stamps := []struct {
+		height int64
+		offset time.Duration
+		price  int64
+	}{
+		{10, 0, 1},
+		{20, 10 * time.Second, 2},
+		{30, 40 * time.Second, 4},
+	}
+	for _, stamp := range stamps {
+		stampCtx := ctx.WithBlockHeight(stamp.height).WithBlockTime(start.Add(stamp.offset))
+		app.OracleKeeper.AddHistoricPrice(stampCtx, types.USDCSymbol, math.LegacyNewDec(stamp.price))
+	}
+	ctx = ctx.WithBlockHeight(40).WithBlockTime(start.Add(60 * time.Second))
+
+	testCases := []struct {
+		name      string
+		denom     string
+		window    time.Duration
+		endHeight uint64
+		twap      math.LegacyDec
+		err       error
+	}{
+		{"whole history", types.USDCSymbol, time.Minute, 0, math.LegacyNewDecWithPrec(25, 1), nil},
+		{"window clips the oldest prices", "usdc", 40 * time.Second, 0, math.LegacyNewDec(3), nil},
+		{"window exceeds the history", types.USDCSymbol, time.Hour, 40, math.LegacyNewDecWithPrec(25, 1), nil},
+		{"window ends at a past stamp", types.USDCSymbol, 40 * time.Second, 30, math.LegacyNewDecWithPrec(175, 2), nil},
+		{"window ends between stamps", types.USDCSymbol, 40 * time.Second, 35, math.LegacyNewDecWithPrec(175, 2), nil},
+		{"single stamp in window", types.USDCSymbol, 40 * time.Second, 10, math.LegacyOneDec(), nil},
+		{"no historic prices", types.USDTSymbol, time.Minute, 0, math.LegacyZeroDec(), types.ErrNoHistoricPrice},
+		{"no historic prices before end height", types.USDCSymbol, time.Minute, 5, math.LegacyZeroDec(), types.ErrNoHistoricPrice},
+		{"empty window", types.USDCSymbol, 0, 0, math.LegacyZeroDec(), types.ErrInvalidRequest},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			twap, err := app.OracleKeeper.TWAP(ctx, tc.denom, tc.window, tc.endHeight)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.twap, twap)
+		})
+	}
+
+	// the fee abstraction rate is the TWAP of one base unit of the usdc ibc denom
+	rate, err := app.OracleKeeper.GetTwapRate(ctx, app.OracleKeeper.UsdcIbcDenom(ctx))
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(25, 1).QuoInt64(1e6), rate)
+
+	_, err = app.OracleKeeper.GetTwapRate(ctx, "ibc/unknown")
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+
+	res, err := keeper.NewQuerier(app.OracleKeeper).TWAP(ctx, &types.QueryTWAPRequest{
+		Denom:         types.USDCSymbol,
+		WindowSeconds: 40,
+		EndHeight:     30,
+	})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(175, 2), res.Price)
+
+	// stamp times are exported with the historic prices and pruned with them
+	genesis := oracle.ExportGenesis(ctx, app.OracleKeeper)
+	require.Len(t, genesis.HistoricStampTimes, len(stamps))
+	require.Equal(t, start.Add(10*time.Second), genesis.HistoricStampTimes[1].BlockTime)
+
+	app.OracleKeeper.PruneHistoricPricesBeforeBlock(ctx, 10)
+	_, found := app.OracleKeeper.GetHistoricStampTime(ctx, 10)
+	require.False(t, found)
+	_, found = app.OracleKeeper.GetHistoricStampTime(ctx, 20)
+	require.True(t, found)
+}
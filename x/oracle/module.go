@@ -3,6 +3,7 @@ package module
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -11,6 +12,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	"cosmossdk.io/client/v2/autocli"
+	"cosmossdk.io/core/appmodule"
 	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -30,7 +32,7 @@ import (
 
 const (
 	// ConsensusVersion defines the current x/oracle module consensus version.
-	ConsensusVersion = 1
+	ConsensusVersion = 2
 )
 
 var (
@@ -39,6 +41,7 @@ var (
 	_ module.AppModule        = AppModule{}
 
 	_ autocli.HasAutoCLIConfig = AppModule{}
+	_ appmodule.HasPreBlocker  = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the wasm module.
@@ -144,6 +147,11 @@ func (a AppModule) QuerierRoute() string {
 func (a AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(a.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(a.keeper))
+
+	m := keeper.NewMigrator(&a.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // ConsensusVersion is a sequence number for state-breaking change of the
@@ -154,6 +162,16 @@ func (a AppModule) ConsensusVersion() uint64 {
 	return ConsensusVersion
 }
 
+// PreBlock stores the exchange rate votes of the vote extension tx injected in the block,
+// before the txs of the block are delivered.
+func (a AppModule) PreBlock(ctx context.Context) (appmodule.ResponsePreBlock, error) {
+	if err := oracleabci.PreBlocker(sdk.UnwrapSDKContext(ctx), a.keeper); err != nil {
+		return nil, err
+	}
+
+	return &sdk.ResponsePreBlock{}, nil
+}
+
 // BeginBlock executes all ABCI BeginBlock logic respective to the x/oracle module.
 func (a AppModule) BeginBlock(_ context.Context) {}
 
@@ -167,22 +167,20 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { maximumMedianStamps = GenMaximumMedianStamps(r) },
 	)
 
-	oracleGenesis.Params = types.Params{
-		VotePeriod:               votePeriod,
-		VoteThreshold:            voteThreshold,
-		RewardBands:              rewardBands,
-		RewardDistributionWindow: rewardDistributionWindow,
-		AcceptList: types.DenomList{
-			{SymbolDenom: types.DmSymbol, BaseDenom: types.DmDenom},
-		},
-		SlashFraction:       slashFraction,
-		SlashWindow:         slashWindow,
-		MinValidPerWindow:   minValidPerWindow,
-		HistoricStampPeriod: historicStampPeriod,
-		MedianStampPeriod:   medianStampPeriod,
-		MaximumPriceStamps:  historicStampPeriod,
-		MaximumMedianStamps: historicStampPeriod,
+	oracleGenesis.Params.VotePeriod = votePeriod
+	oracleGenesis.Params.VoteThreshold = voteThreshold
+	oracleGenesis.Params.RewardBands = rewardBands
+	oracleGenesis.Params.RewardDistributionWindow = rewardDistributionWindow
+	oracleGenesis.Params.AcceptList = types.DenomList{
+		{SymbolDenom: types.DmSymbol, BaseDenom: types.DmDenom},
 	}
+	oracleGenesis.Params.SlashFraction = slashFraction
+	oracleGenesis.Params.SlashWindow = slashWindow
+	oracleGenesis.Params.MinValidPerWindow = minValidPerWindow
+	oracleGenesis.Params.HistoricStampPeriod = historicStampPeriod
+	oracleGenesis.Params.MedianStampPeriod = medianStampPeriod
+	oracleGenesis.Params.MaximumPriceStamps = historicStampPeriod
+	oracleGenesis.Params.MaximumMedianStamps = historicStampPeriod
 
 	bz, err := json.MarshalIndent(&oracleGenesis.Params, "", " ")
 	if err != nil {
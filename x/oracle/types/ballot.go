@@ -6,6 +6,8 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/DaevMithran/dmchain/util/decmath"
 )
 
 // VoteForTally is a convenience wrapper to reduce redundant lookup cost.
@@ -51,6 +53,87 @@ func (pb ExchangeRateBallot) Power() int64 {
 	return totalPower
 }
 
+// NumVoters returns the amount of distinct voters of the ballot.
+func (pb ExchangeRateBallot) NumVoters() int {
+	voters := make(map[string]struct{}, len(pb))
+	for _, vote := range pb {
+		voters[vote.Voter.String()] = struct{}{}
+	}
+
+	return len(voters)
+}
+
+// FilterOutliers returns the votes of the ballot kept by a given outlier
+// filter, and the votes it removes. Positive exchange rates are compared with
+// the bounds of the filter over their unweighted distribution, abstaining
+// votes are always kept. The ballot is left unfiltered if its median absolute
+// deviation is zero, or if the filter would remove all positive exchange rates.
+// CONTRACT: The ballot must be sorted, the kept votes remain sorted.
+func (pb ExchangeRateBallot) FilterOutliers(filter OutlierFilter) (ExchangeRateBallot, ExchangeRateBallot, error) {
+	rates := make([]math.LegacyDec, 0, len(pb))
+	for _, vote := range pb {
+		if vote.ExchangeRate.IsPositive() {
+			rates = append(rates, vote.ExchangeRate)
+		}
+	}
+	if len(rates) == 0 {
+		return pb, nil, nil
+	}
+
+	var lower, upper math.LegacyDec
+	switch {
+	case filter.IsMAD():
+		median, err := decmath.Median(rates)
+		if err != nil {
+			return nil, nil, err
+		}
+		deviations := make([]math.LegacyDec, len(rates))
+		for i, rate := range rates {
+			deviations[i] = rate.Sub(median).Abs()
+		}
+		mad, err := decmath.Median(deviations)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !mad.IsPositive() {
+			return pb, nil, nil
+		}
+		spread := mad.Mul(filter.MadMultiplier)
+		lower, upper = median.Sub(spread), median.Add(spread)
+
+	case filter.IsPercentileBand():
+		lowerPercentile := filter.LowerPercentile
+		if lowerPercentile.IsNil() {
+			lowerPercentile = math.LegacyZeroDec()
+		}
+		var err error
+		if lower, err = decmath.Percentile(rates, lowerPercentile); err != nil {
+			return nil, nil, err
+		}
+		if upper, err = decmath.Percentile(rates, filter.UpperPercentile); err != nil {
+			return nil, nil, err
+		}
+
+	default:
+		return pb, nil, nil
+	}
+
+	kept := make(ExchangeRateBallot, 0, len(pb))
+	var filtered ExchangeRateBallot
+	for _, vote := range pb {
+		if vote.ExchangeRate.IsPositive() && (vote.ExchangeRate.LT(lower) || vote.ExchangeRate.GT(upper)) {
+			filtered = append(filtered, vote)
+			continue
+		}
+		kept = append(kept, vote)
+	}
+	if len(filtered) == len(rates) {
+		return pb, nil, nil
+	}
+
+	return kept, filtered, nil
+}
+
 // WeightedMedian returns the median weighted by the power of the ExchangeRateVote.
 // CONTRACT: The ballot must be sorted.
 func (pb ExchangeRateBallot) WeightedMedian() (math.LegacyDec, error) {
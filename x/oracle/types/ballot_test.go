@@ -296,6 +296,82 @@ func TestPBStandardDeviation_Overflow(t *testing.T) {
 	require.Equal(t, expectedDevation, deviation)
 }
 
+func TestPBFilterOutliers(t *testing.T) {
+	ballot := ExchangeRateBallot{}
+	for _, rate := range []string{"0", "0.9", "1.0", "1.01", "1.02", "1.1", "5"} {
+		ballot = append(ballot, NewVoteForTally(
+			sdkmath.LegacyMustNewDecFromStr(rate),
+			DmSymbol,
+			sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()),
+			100,
+		))
+	}
+	sort.Sort(ballot)
+
+	rates := func(ballot ExchangeRateBallot) (out []string) {
+		for _, vote := range ballot {
+			out = append(out, vote.ExchangeRate.String())
+		}
+		return out
+	}
+
+	testCases := []struct {
+		name     string
+		filter   OutlierFilter
+		filtered []string
+	}{
+		{
+			"median absolute deviation",
+			OutlierFilter{MadMultiplier: sdkmath.LegacyNewDec(3)},
+			[]string{"5.000000000000000000"},
+		},
+		{
+			"tight median absolute deviation",
+			OutlierFilter{MadMultiplier: sdkmath.LegacyOneDec()},
+			[]string{"0.900000000000000000", "1.100000000000000000", "5.000000000000000000"},
+		},
+		{
+			"percentile band",
+			OutlierFilter{
+				LowerPercentile: sdkmath.LegacyNewDecWithPrec(1, 1),
+				UpperPercentile: sdkmath.LegacyNewDecWithPrec(9, 1),
+			},
+			[]string{"0.900000000000000000", "5.000000000000000000"},
+		},
+		{
+			"no filter",
+			OutlierFilter{},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			kept, filtered, err := ballot.FilterOutliers(tc.filter)
+			require.NoError(t, err)
+			require.Equal(t, tc.filtered, rates(filtered))
+			require.Len(t, kept, len(ballot)-len(tc.filtered))
+			require.True(t, sort.IsSorted(kept))
+			// abstaining votes are kept
+			require.True(t, kept[0].ExchangeRate.IsZero())
+		})
+	}
+
+	// ballots are left unfiltered if the median absolute deviation is zero, or
+	// if all votes would be removed
+	same := ExchangeRateBallot{ballot[2], ballot[2], ballot[2], ballot[6]}
+	kept, filtered, err := same.FilterOutliers(OutlierFilter{MadMultiplier: sdkmath.LegacyNewDec(3)})
+	require.NoError(t, err)
+	require.Empty(t, filtered)
+	require.Equal(t, same, kept)
+
+	pair := ExchangeRateBallot{ballot[2], ballot[4]}
+	kept, filtered, err = pair.FilterOutliers(OutlierFilter{MadMultiplier: sdkmath.LegacyNewDecWithPrec(5, 1)})
+	require.NoError(t, err)
+	require.Empty(t, filtered)
+	require.Equal(t, pair, kept)
+}
+
 func TestBallotMapToSlice(t *testing.T) {
 	valAddress := GenerateRandomValAddr(1)
 
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// NewCandle creates a Candle of a denom for a candle interval starting at
+// startBlock, opening with price.
+func NewCandle(denom string, interval, startBlock uint64, price math.LegacyDec) Candle {
+	return Candle{
+		Denom:      denom,
+		Interval:   interval,
+		StartBlock: startBlock,
+		Open:       price,
+		High:       price,
+		Low:        price,
+		Close:      price,
+	}
+}
+
+// Update closes the candle with price, updating its high and low.
+func (c *Candle) Update(price math.LegacyDec) {
+	if price.GT(c.High) {
+		c.High = price
+	}
+	if price.LT(c.Low) {
+		c.Low = price
+	}
+	c.Close = price
+}
@@ -39,6 +39,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 		"dmchain/oracle/MsgGovRemoveCurrencyDeviationThresholds",
 		nil,
 	)
+	cdc.RegisterConcrete(&MsgGovResetCircuitBreakers{}, "dmchain/oracle/MsgGovResetCircuitBreakers", nil)
+	cdc.RegisterConcrete(&MsgGovUpdateDenomOverrides{}, "dmchain/oracle/MsgGovUpdateDenomOverrides", nil)
 }
 
 // RegisterInterfaces registers the x/oracle interfaces types with the interface registry
@@ -52,6 +54,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgGovAddDenoms{},
 		&MsgGovRemoveCurrencyPairProviders{},
 		&MsgGovRemoveCurrencyDeviationThresholds{},
+		&MsgGovResetCircuitBreakers{},
+		&MsgGovUpdateDenomOverrides{},
 	)
 
 	registry.RegisterImplementations(
@@ -61,6 +65,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgGovAddDenoms{},
 		&MsgGovRemoveCurrencyPairProviders{},
 		&MsgGovRemoveCurrencyDeviationThresholds{},
+		&MsgGovResetCircuitBreakers{},
+		&MsgGovUpdateDenomOverrides{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
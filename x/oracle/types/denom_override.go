@@ -0,0 +1,89 @@
+package types
+
+import (
+	"strings"
+
+	"cosmossdk.io/math"
+	"gopkg.in/yaml.v3"
+)
+
+// String implements fmt.Stringer interface
+func (o DenomOverride) String() string {
+	out, _ := yaml.Marshal(o)
+	return string(out)
+}
+
+func (o DenomOverride) Equal(o2 *DenomOverride) bool {
+	if !strings.EqualFold(o.SymbolDenom, o2.SymbolDenom) {
+		return false
+	}
+	if !decEqual(o.VoteThreshold, o2.VoteThreshold) {
+		return false
+	}
+	return o.MinVoters == o2.MinVoters && o.VotePeriodMultiplier == o2.VotePeriodMultiplier
+}
+
+// VoteThresholdOr returns the vote threshold of the override, or a given
+// global vote threshold if it is not set.
+func (o DenomOverride) VoteThresholdOr(voteThreshold math.LegacyDec) math.LegacyDec {
+	if o.VoteThreshold.IsNil() || !o.VoteThreshold.IsPositive() {
+		return voteThreshold
+	}
+	return o.VoteThreshold
+}
+
+// IsUpdatePeriod returns whether the exchange rate of the denom is updated in
+// the vote period ending at a given height.
+func (o DenomOverride) IsUpdatePeriod(height int64, votePeriod uint64) bool {
+	if o.VotePeriodMultiplier <= 1 || votePeriod == 0 || height < 0 {
+		return true
+	}
+	period := (uint64(height) + 1) / votePeriod
+	return period%o.VotePeriodMultiplier == 0
+}
+
+// DenomOverrideList is array of DenomOverride
+type DenomOverrideList []DenomOverride
+
+func (ol DenomOverrideList) String() (out string) {
+	for _, o := range ol {
+		out += o.String() + "\n"
+	}
+
+	return strings.TrimSpace(out)
+}
+
+// GetOverrideFromDenom returns the override of a given denom, and false if the
+// denom follows the global voting rules.
+func (ol DenomOverrideList) GetOverrideFromDenom(denom string) (DenomOverride, bool) {
+	for _, o := range ol {
+		if strings.EqualFold(denom, o.SymbolDenom) {
+			return o, true
+		}
+	}
+	return DenomOverride{}, false
+}
+
+// Set returns the list with a given override replacing the override of its
+// denom, or appended if there is none.
+func (ol DenomOverrideList) Set(override DenomOverride) DenomOverrideList {
+	for i, o := range ol {
+		if strings.EqualFold(override.SymbolDenom, o.SymbolDenom) {
+			updated := append(DenomOverrideList{}, ol...)
+			updated[i] = override
+			return updated
+		}
+	}
+	return append(append(DenomOverrideList{}, ol...), override)
+}
+
+// Remove returns the list without the override of a given denom.
+func (ol DenomOverrideList) Remove(denom string) DenomOverrideList {
+	updated := DenomOverrideList{}
+	for _, o := range ol {
+		if !strings.EqualFold(denom, o.SymbolDenom) {
+			updated = append(updated, o)
+		}
+	}
+	return updated
+}
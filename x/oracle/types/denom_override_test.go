@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDenomOverrideIsUpdatePeriod(t *testing.T) {
+	o := DenomOverride{SymbolDenom: DmSymbol}
+	require.True(t, o.IsUpdatePeriod(9, 10))
+	require.True(t, o.IsUpdatePeriod(19, 10))
+
+	o.VotePeriodMultiplier = 3
+	require.False(t, o.IsUpdatePeriod(9, 10))
+	require.False(t, o.IsUpdatePeriod(19, 10))
+	require.True(t, o.IsUpdatePeriod(29, 10))
+	require.True(t, o.IsUpdatePeriod(59, 10))
+}
+
+func TestDenomOverrideVoteThresholdOr(t *testing.T) {
+	global := math.LegacyNewDecWithPrec(5, 1)
+	require.Equal(t, global, DenomOverride{}.VoteThresholdOr(global))
+	require.Equal(t, global, DenomOverride{VoteThreshold: math.LegacyZeroDec()}.VoteThresholdOr(global))
+
+	threshold := math.LegacyNewDecWithPrec(2, 1)
+	require.Equal(t, threshold, DenomOverride{VoteThreshold: threshold}.VoteThresholdOr(global))
+}
+
+func TestDenomOverrideListSetRemove(t *testing.T) {
+	ol := DenomOverrideList{{SymbolDenom: DmSymbol, MinVoters: 2}}
+
+	updated := ol.Set(DenomOverride{SymbolDenom: "dm", MinVoters: 3})
+	require.Equal(t, DenomOverrideList{{SymbolDenom: "dm", MinVoters: 3}}, updated)
+	require.Equal(t, uint64(2), ol[0].MinVoters)
+
+	updated = updated.Set(DenomOverride{SymbolDenom: USDCSymbol, MinVoters: 4})
+	require.Len(t, updated, 2)
+	o, found := updated.GetOverrideFromDenom(USDCSymbol)
+	require.True(t, found)
+	require.Equal(t, uint64(4), o.MinVoters)
+
+	updated = updated.Remove(DmSymbol)
+	require.Equal(t, DenomOverrideList{{SymbolDenom: USDCSymbol, MinVoters: 4}}, updated)
+	_, found = updated.GetOverrideFromDenom(DmSymbol)
+	require.False(t, found)
+}
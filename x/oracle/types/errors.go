@@ -39,4 +39,9 @@ var (
 	ErrNonEqualInjVotesRates = errors.Register(ModuleName, 29, "injected exchange rate votes and generated exchange votes are not equal")                //nolint: lll
 	ErrNoCommitInfo          = errors.Register(ModuleName, 30, "no commit info in process proposal request")
 	ErrInvalidWmaStrategy    = errors.Register(ModuleName, 31, "invalid WMA strategy")
+	ErrInvalidInjVoteExt     = errors.Register(ModuleName, 32, "invalid injected vote extension tx")
+	ErrInvalidVoteExt        = errors.Register(ModuleName, 33, "invalid vote extension")
+	ErrSubmissionMode        = errors.Register(ModuleName, 34, "not accepted in the current price submission mode")
+	ErrStaleExchangeRate     = errors.Register(ModuleName, 35, "exchange rate is stale")
+	ErrNoPriceHalt           = errors.Register(ModuleName, 36, "circuit breaker of this denom is not tripped")
 )
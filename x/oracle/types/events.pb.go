@@ -107,35 +107,175 @@ func (m *EventSetFxRate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventSetFxRate proto.InternalMessageInfo
 
+// EventPriceHalted is emitted when the circuit breaker of a denom is tripped
+type EventPriceHalted struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Exchange rate kept while halted
+	HaltedRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=halted_rate,json=haltedRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"halted_rate"`
+	// Exchange rate which tripped the circuit breaker
+	RejectedRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=rejected_rate,json=rejectedRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rejected_rate"`
+	// Reference price the move breached
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventPriceHalted) Reset()         { *m = EventPriceHalted{} }
+func (m *EventPriceHalted) String() string { return proto.CompactTextString(m) }
+func (*EventPriceHalted) ProtoMessage()    {}
+func (*EventPriceHalted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d3b914ef0d57537, []int{2}
+}
+func (m *EventPriceHalted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPriceHalted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPriceHalted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPriceHalted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPriceHalted.Merge(m, src)
+}
+func (m *EventPriceHalted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPriceHalted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPriceHalted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPriceHalted proto.InternalMessageInfo
+
+// EventCircuitBreakerReset is emitted on Msg/GovResetCircuitBreakers
+type EventCircuitBreakerReset struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *EventCircuitBreakerReset) Reset()         { *m = EventCircuitBreakerReset{} }
+func (m *EventCircuitBreakerReset) String() string { return proto.CompactTextString(m) }
+func (*EventCircuitBreakerReset) ProtoMessage()    {}
+func (*EventCircuitBreakerReset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d3b914ef0d57537, []int{3}
+}
+func (m *EventCircuitBreakerReset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCircuitBreakerReset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCircuitBreakerReset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCircuitBreakerReset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCircuitBreakerReset.Merge(m, src)
+}
+func (m *EventCircuitBreakerReset) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCircuitBreakerReset) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCircuitBreakerReset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCircuitBreakerReset proto.InternalMessageInfo
+
+// EventBallotFiltered is emitted when the outlier filter of a denom is applied
+// to its ballot
+type EventBallotFiltered struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Amount of votes removed from the ballot
+	FilteredVotes uint64 `protobuf:"varint,2,opt,name=filtered_votes,json=filteredVotes,proto3" json:"filtered_votes,omitempty"`
+	// Amount of votes in the ballot before filtering
+	TotalVotes uint64 `protobuf:"varint,3,opt,name=total_votes,json=totalVotes,proto3" json:"total_votes,omitempty"`
+	// Voters whose votes were removed, counted as misses
+	FilteredVoters []string `protobuf:"bytes,4,rep,name=filtered_voters,json=filteredVoters,proto3" json:"filtered_voters,omitempty"`
+}
+
+func (m *EventBallotFiltered) Reset()         { *m = EventBallotFiltered{} }
+func (m *EventBallotFiltered) String() string { return proto.CompactTextString(m) }
+func (*EventBallotFiltered) ProtoMessage()    {}
+func (*EventBallotFiltered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d3b914ef0d57537, []int{4}
+}
+func (m *EventBallotFiltered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBallotFiltered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBallotFiltered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBallotFiltered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBallotFiltered.Merge(m, src)
+}
+func (m *EventBallotFiltered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBallotFiltered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBallotFiltered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBallotFiltered proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*EventDelegateFeedConsent)(nil), "oracle.v1.EventDelegateFeedConsent")
 	proto.RegisterType((*EventSetFxRate)(nil), "oracle.v1.EventSetFxRate")
+	proto.RegisterType((*EventPriceHalted)(nil), "oracle.v1.EventPriceHalted")
+	proto.RegisterType((*EventCircuitBreakerReset)(nil), "oracle.v1.EventCircuitBreakerReset")
+	proto.RegisterType((*EventBallotFiltered)(nil), "oracle.v1.EventBallotFiltered")
 }
 
 func init() { proto.RegisterFile("oracle/v1/events.proto", fileDescriptor_4d3b914ef0d57537) }
 
 var fileDescriptor_4d3b914ef0d57537 = []byte{
-	// 315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
-	0x14, 0x85, 0x93, 0x5f, 0x3f, 0x88, 0x7a, 0x60, 0x88, 0x2a, 0x14, 0x8a, 0xe4, 0xa2, 0x4e, 0x2c,
-	0x8d, 0x55, 0xc1, 0x0b, 0x50, 0xd2, 0x2e, 0xc0, 0x92, 0x6e, 0x2c, 0xc8, 0xb5, 0xaf, 0x92, 0x88,
-	0xc6, 0xb7, 0xb2, 0x4d, 0xd4, 0xbe, 0x00, 0x33, 0x0f, 0xd3, 0x87, 0xe8, 0x58, 0x75, 0x42, 0x0c,
-	0x15, 0xb4, 0x2f, 0x82, 0x1a, 0x07, 0x18, 0xd9, 0x7c, 0x8f, 0xcf, 0xf9, 0x8e, 0xe5, 0x4b, 0x4e,
-	0x50, 0x73, 0x31, 0x01, 0x56, 0xf6, 0x18, 0x94, 0xa0, 0xac, 0x89, 0xa6, 0x1a, 0x2d, 0x06, 0x0d,
-	0xa7, 0x47, 0x65, 0xaf, 0x75, 0x2a, 0xd0, 0x14, 0x68, 0x1e, 0xab, 0x0b, 0xe6, 0x06, 0xe7, 0x6a,
-	0x35, 0x53, 0x4c, 0xd1, 0xe9, 0xfb, 0x93, 0x53, 0x3b, 0x2f, 0x3e, 0x09, 0x07, 0x7b, 0x58, 0x0c,
-	0x13, 0x48, 0xb9, 0x85, 0x21, 0x80, 0xbc, 0x41, 0x65, 0x40, 0xd9, 0xe0, 0x8a, 0x1c, 0xe1, 0x14,
-	0x34, 0xb7, 0xa8, 0x43, 0xff, 0xdc, 0xbf, 0x68, 0xf4, 0xc3, 0xf5, 0xa2, 0xdb, 0xac, 0xb1, 0xd7,
-	0x52, 0x6a, 0x30, 0x66, 0x64, 0x75, 0xae, 0xd2, 0xe4, 0xc7, 0xb9, 0x4f, 0xc9, 0x1a, 0x16, 0xfe,
-	0xfb, 0x2b, 0xf5, 0xed, 0xec, 0x14, 0xe4, 0xb8, 0x7a, 0xc7, 0x08, 0xec, 0x70, 0x96, 0x70, 0x0b,
-	0x41, 0x93, 0x1c, 0x48, 0x50, 0x58, 0xb8, 0xea, 0xc4, 0x0d, 0xc1, 0x80, 0xfc, 0xd7, 0xbf, 0xe4,
-	0xde, 0x72, 0xd3, 0xf6, 0xde, 0x37, 0xed, 0x33, 0x47, 0x37, 0xf2, 0x29, 0xca, 0x91, 0x15, 0xdc,
-	0x66, 0xd1, 0x1d, 0xa4, 0x5c, 0xcc, 0x63, 0x10, 0xeb, 0x45, 0x97, 0xd4, 0xe5, 0x31, 0x88, 0xa4,
-	0x8a, 0xf7, 0x6f, 0x97, 0x9f, 0xd4, 0x5b, 0x6e, 0xa9, 0xbf, 0xda, 0x52, 0xff, 0x63, 0x4b, 0xfd,
-	0xd7, 0x1d, 0xf5, 0x56, 0x3b, 0xea, 0xbd, 0xed, 0xa8, 0xf7, 0xd0, 0x4d, 0x73, 0x9b, 0x3d, 0x8f,
-	0x23, 0x81, 0x05, 0x8b, 0x39, 0x94, 0xf7, 0xb9, 0xcd, 0x34, 0x57, 0x4c, 0x16, 0x22, 0xe3, 0xb9,
-	0x62, 0x33, 0x56, 0xaf, 0xc2, 0xce, 0xa7, 0x60, 0xc6, 0x87, 0xd5, 0x5f, 0x5e, 0x7e, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0x2c, 0x5f, 0x5b, 0xd8, 0xa1, 0x01, 0x00, 0x00,
+	// 471 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xc1, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc6, 0xed, 0x7f, 0xf2, 0xaf, 0xe8, 0x96, 0x06, 0x64, 0xa2, 0xca, 0x14, 0xc9, 0xa9, 0x2c,
+	0x21, 0x71, 0x89, 0x4d, 0x04, 0x2f, 0xd0, 0x34, 0x8d, 0x90, 0x00, 0x09, 0xb9, 0x52, 0x0f, 0x5c,
+	0xa2, 0xed, 0x7a, 0xb0, 0x97, 0xda, 0xde, 0x68, 0x76, 0x6a, 0xb5, 0x2f, 0xc0, 0x99, 0x87, 0xa9,
+	0x78, 0x86, 0x1c, 0xab, 0x9e, 0x10, 0x87, 0x0a, 0x92, 0x2b, 0x0f, 0x81, 0xb2, 0xeb, 0x14, 0x90,
+	0x28, 0x48, 0x70, 0xf3, 0x7c, 0xfb, 0xcd, 0xef, 0xf3, 0xac, 0x3d, 0x6c, 0x4b, 0x21, 0x17, 0x05,
+	0xc4, 0xf5, 0x20, 0x86, 0x1a, 0x2a, 0xd2, 0xd1, 0x14, 0x15, 0x29, 0x6f, 0xdd, 0xea, 0x51, 0x3d,
+	0xd8, 0xbe, 0x2f, 0x94, 0x2e, 0x95, 0x9e, 0x98, 0x83, 0xd8, 0x16, 0xd6, 0xb5, 0xdd, 0xcd, 0x54,
+	0xa6, 0xac, 0xbe, 0x7c, 0xb2, 0x6a, 0xf8, 0xce, 0x65, 0xfe, 0xfe, 0x12, 0x36, 0x82, 0x02, 0x32,
+	0x4e, 0x30, 0x06, 0x48, 0xf7, 0x54, 0xa5, 0xa1, 0x22, 0xef, 0x29, 0xbb, 0xa5, 0xa6, 0x80, 0x9c,
+	0x14, 0xfa, 0xee, 0x8e, 0xfb, 0x68, 0x7d, 0xe8, 0x5f, 0x9e, 0xf7, 0xbb, 0x0d, 0x76, 0x37, 0x4d,
+	0x11, 0xb4, 0x3e, 0x20, 0x94, 0x55, 0x96, 0x5c, 0x3b, 0x97, 0x5d, 0x69, 0x03, 0xf3, 0xff, 0xfb,
+	0x53, 0xd7, 0xca, 0x19, 0x96, 0xac, 0x63, 0xde, 0xe3, 0x00, 0x68, 0x7c, 0x9a, 0x70, 0x02, 0xaf,
+	0xcb, 0xfe, 0x4f, 0xa1, 0x52, 0xa5, 0x8d, 0x4e, 0x6c, 0xe1, 0xed, 0xb3, 0x36, 0x7e, 0x27, 0x0f,
+	0x66, 0x57, 0x3d, 0xe7, 0xd3, 0x55, 0xef, 0x81, 0xa5, 0xeb, 0xf4, 0x38, 0x92, 0x2a, 0x2e, 0x39,
+	0xe5, 0xd1, 0x0b, 0xc8, 0xb8, 0x38, 0x1b, 0x81, 0xb8, 0x3c, 0xef, 0xb3, 0x26, 0x7c, 0x04, 0x22,
+	0x31, 0xed, 0xe1, 0x57, 0x97, 0xdd, 0x35, 0x79, 0xaf, 0x50, 0x0a, 0x78, 0xc6, 0x0b, 0x82, 0xf4,
+	0x86, 0xc4, 0x84, 0x6d, 0xe4, 0xe6, 0x7c, 0xf2, 0x6f, 0xc1, 0xcc, 0x52, 0xcc, 0x6c, 0x87, 0x6c,
+	0x13, 0xe1, 0x2d, 0x88, 0x6b, 0x6a, 0xeb, 0x6f, 0xa9, 0xb7, 0x57, 0x1c, 0xc3, 0xdd, 0x62, 0x6b,
+	0x08, 0x5c, 0xab, 0xca, 0x6f, 0x9b, 0x11, 0x9a, 0x2a, 0x7c, 0xdc, 0x7c, 0xe5, 0x3d, 0x89, 0xe2,
+	0x44, 0xd2, 0x10, 0x81, 0x1f, 0x03, 0x26, 0xa0, 0x81, 0x7e, 0x3d, 0x75, 0xf8, 0xc1, 0x65, 0xf7,
+	0x4c, 0xcb, 0x90, 0x17, 0x85, 0xa2, 0xb1, 0x2c, 0x08, 0xf0, 0xc6, 0x3b, 0x7a, 0xc8, 0x3a, 0x6f,
+	0x1a, 0xc7, 0xa4, 0x56, 0x04, 0xda, 0x5c, 0x53, 0x3b, 0xd9, 0x5c, 0xa9, 0x87, 0x4b, 0xd1, 0xeb,
+	0xb1, 0x0d, 0x52, 0xc4, 0x8b, 0xc6, 0xd3, 0x32, 0x1e, 0x66, 0x24, 0x6b, 0xd8, 0x65, 0x77, 0x7e,
+	0xe2, 0xa0, 0xf6, 0xdb, 0x3b, 0xad, 0xdf, 0xfe, 0x42, 0x9d, 0x1f, 0x23, 0x50, 0x0f, 0x9f, 0xcf,
+	0xbe, 0x04, 0xce, 0x6c, 0x1e, 0xb8, 0x17, 0xf3, 0xc0, 0xfd, 0x3c, 0x0f, 0xdc, 0xf7, 0x8b, 0xc0,
+	0xb9, 0x58, 0x04, 0xce, 0xc7, 0x45, 0xe0, 0xbc, 0xee, 0x67, 0x92, 0xf2, 0x93, 0xa3, 0x48, 0xa8,
+	0x32, 0x1e, 0x71, 0xa8, 0x5f, 0x4a, 0xca, 0x91, 0x57, 0x71, 0x5a, 0x8a, 0x9c, 0xcb, 0x2a, 0x3e,
+	0x8d, 0x9b, 0x25, 0xa3, 0xb3, 0x29, 0xe8, 0xa3, 0x35, 0xb3, 0x25, 0x4f, 0xbe, 0x0d, 0x00, 0x8b,
+	0xcb, 0x3b, 0x4b, 0x7b, 0x03, 0x00, 0x00,
 }
 
 func (m *EventDelegateFeedConsent) Marshal() (dAtA []byte, err error) {
@@ -215,6 +355,142 @@ func (m *EventSetFxRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventPriceHalted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPriceHalted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPriceHalted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.RejectedRate.Size()
+		i -= size
+		if _, err := m.RejectedRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.HaltedRate.Size()
+		i -= size
+		if _, err := m.HaltedRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCircuitBreakerReset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCircuitBreakerReset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCircuitBreakerReset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBallotFiltered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBallotFiltered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBallotFiltered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FilteredVoters) > 0 {
+		for iNdEx := len(m.FilteredVoters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FilteredVoters[iNdEx])
+			copy(dAtA[i:], m.FilteredVoters[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.FilteredVoters[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TotalVotes != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TotalVotes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FilteredVotes != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FilteredVotes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -258,6 +534,65 @@ func (m *EventSetFxRate) Size() (n int) {
 	return n
 }
 
+func (m *EventPriceHalted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.HaltedRate.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.RejectedRate.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCircuitBreakerReset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBallotFiltered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.FilteredVotes != 0 {
+		n += 1 + sovEvents(uint64(m.FilteredVotes))
+	}
+	if m.TotalVotes != 0 {
+		n += 1 + sovEvents(uint64(m.TotalVotes))
+	}
+	if len(m.FilteredVoters) > 0 {
+		for _, s := range m.FilteredVoters {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -494,6 +829,422 @@ func (m *EventSetFxRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventPriceHalted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPriceHalted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPriceHalted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HaltedRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RejectedRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCircuitBreakerReset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCircuitBreakerReset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCircuitBreakerReset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBallotFiltered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBallotFiltered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBallotFiltered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilteredVotes", wireType)
+			}
+			m.FilteredVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FilteredVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotes", wireType)
+			}
+			m.TotalVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilteredVoters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilteredVoters = append(m.FilteredVoters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18,6 +18,11 @@ func NewGenesisState(
 	historicPrices []PriceStamp,
 	medianPrices []PriceStamp,
 	medianDeviationPrices []PriceStamp,
+	historicStampTimes []HistoricStampTime,
+	candles []Candle,
+	exchangeRateUpdates []ExchangeRateUpdate,
+	priceHalts []PriceHalt,
+	circuitBreakerResets []string,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                        params,
@@ -29,6 +34,11 @@ func NewGenesisState(
 		HistoricPrices:                historicPrices,
 		Medians:                       medianPrices,
 		MedianDeviations:              medianDeviationPrices,
+		HistoricStampTimes:            historicStampTimes,
+		Candles:                       candles,
+		ExchangeRateUpdates:           exchangeRateUpdates,
+		PriceHalts:                    priceHalts,
+		CircuitBreakerResets:          circuitBreakerResets,
 	}
 }
 
@@ -45,6 +55,11 @@ func DefaultGenesisState() *GenesisState {
 		HistoricPrices:                []PriceStamp{},
 		Medians:                       []PriceStamp{},
 		MedianDeviations:              []PriceStamp{},
+		HistoricStampTimes:            []HistoricStampTime{},
+		Candles:                       []Candle{},
+		ExchangeRateUpdates:           []ExchangeRateUpdate{},
+		PriceHalts:                    []PriceHalt{},
+		CircuitBreakerResets:          []string{},
 	}
 }
 
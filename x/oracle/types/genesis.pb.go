@@ -9,15 +9,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -37,6 +41,13 @@ type GenesisState struct {
 	Medians                       []PriceStamp                                `protobuf:"bytes,7,rep,name=medians,proto3" json:"medians"`
 	HistoricPrices                []PriceStamp                                `protobuf:"bytes,8,rep,name=historic_prices,json=historicPrices,proto3" json:"historic_prices"`
 	MedianDeviations              []PriceStamp                                `protobuf:"bytes,9,rep,name=median_deviations,json=medianDeviations,proto3" json:"median_deviations"`
+	HistoricStampTimes            []HistoricStampTime                         `protobuf:"bytes,10,rep,name=historic_stamp_times,json=historicStampTimes,proto3" json:"historic_stamp_times"`
+	Candles                       []Candle                                    `protobuf:"bytes,11,rep,name=candles,proto3" json:"candles"`
+	ExchangeRateUpdates           []ExchangeRateUpdate                        `protobuf:"bytes,12,rep,name=exchange_rate_updates,json=exchangeRateUpdates,proto3" json:"exchange_rate_updates"`
+	PriceHalts                    []PriceHalt                                 `protobuf:"bytes,13,rep,name=price_halts,json=priceHalts,proto3" json:"price_halts"`
+	// circuit_breaker_resets are the denoms whose next exchange rate is
+	// published without circuit breaker checks.
+	CircuitBreakerResets []string `protobuf:"bytes,14,rep,name=circuit_breaker_resets,json=circuitBreakerResets,proto3" json:"circuit_breaker_resets,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -135,6 +146,95 @@ func (m *GenesisState) GetMedianDeviations() []PriceStamp {
 	return nil
 }
 
+func (m *GenesisState) GetHistoricStampTimes() []HistoricStampTime {
+	if m != nil {
+		return m.HistoricStampTimes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCandles() []Candle {
+	if m != nil {
+		return m.Candles
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExchangeRateUpdates() []ExchangeRateUpdate {
+	if m != nil {
+		return m.ExchangeRateUpdates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceHalts() []PriceHalt {
+	if m != nil {
+		return m.PriceHalts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCircuitBreakerResets() []string {
+	if m != nil {
+		return m.CircuitBreakerResets
+	}
+	return nil
+}
+
+// HistoricStampTime is the block time of a block historic prices were stamped
+// at, used in oracle module's genesis state
+type HistoricStampTime struct {
+	BlockNum  uint64    `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	BlockTime time.Time `protobuf:"bytes,2,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+}
+
+func (m *HistoricStampTime) Reset()         { *m = HistoricStampTime{} }
+func (m *HistoricStampTime) String() string { return proto.CompactTextString(m) }
+func (*HistoricStampTime) ProtoMessage()    {}
+func (*HistoricStampTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14b982a0a6345d1d, []int{1}
+}
+func (m *HistoricStampTime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoricStampTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoricStampTime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoricStampTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoricStampTime.Merge(m, src)
+}
+func (m *HistoricStampTime) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoricStampTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoricStampTime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoricStampTime proto.InternalMessageInfo
+
+func (m *HistoricStampTime) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *HistoricStampTime) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
 // FeederDelegation is the address for where oracle feeder authority are
 // delegated to. By default this struct is only used at genesis to feed in
 // default feeder addresses.
@@ -147,7 +247,7 @@ func (m *FeederDelegation) Reset()         { *m = FeederDelegation{} }
 func (m *FeederDelegation) String() string { return proto.CompactTextString(m) }
 func (*FeederDelegation) ProtoMessage()    {}
 func (*FeederDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14b982a0a6345d1d, []int{1}
+	return fileDescriptor_14b982a0a6345d1d, []int{2}
 }
 func (m *FeederDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -201,7 +301,7 @@ func (m *MissCounter) Reset()         { *m = MissCounter{} }
 func (m *MissCounter) String() string { return proto.CompactTextString(m) }
 func (*MissCounter) ProtoMessage()    {}
 func (*MissCounter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14b982a0a6345d1d, []int{2}
+	return fileDescriptor_14b982a0a6345d1d, []int{3}
 }
 func (m *MissCounter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -246,6 +346,7 @@ func (m *MissCounter) GetMissCounter() uint64 {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "oracle.v1.GenesisState")
+	proto.RegisterType((*HistoricStampTime)(nil), "oracle.v1.HistoricStampTime")
 	proto.RegisterType((*FeederDelegation)(nil), "oracle.v1.FeederDelegation")
 	proto.RegisterType((*MissCounter)(nil), "oracle.v1.MissCounter")
 }
@@ -253,43 +354,56 @@ func init() {
 func init() { proto.RegisterFile("oracle/v1/genesis.proto", fileDescriptor_14b982a0a6345d1d) }
 
 var fileDescriptor_14b982a0a6345d1d = []byte{
-	// 563 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xdd, 0x6e, 0xd3, 0x30,
-	0x14, 0x6e, 0xf6, 0xcb, 0xdc, 0xad, 0xb4, 0x16, 0x8c, 0xa8, 0xb0, 0xac, 0x54, 0x20, 0x26, 0x4d,
-	0x4b, 0xd4, 0x4d, 0x3c, 0x40, 0xbb, 0xc2, 0xb8, 0x99, 0x54, 0x75, 0x12, 0x17, 0x48, 0x28, 0x72,
-	0x13, 0x37, 0xb5, 0x68, 0xe2, 0xc8, 0xc7, 0x8b, 0xca, 0x5b, 0xf0, 0x1c, 0x3c, 0xc9, 0x2e, 0x77,
-	0xc9, 0x15, 0xa0, 0xf6, 0x35, 0xb8, 0x40, 0xb1, 0xdd, 0x36, 0x4c, 0x1b, 0xbd, 0x8a, 0x75, 0xce,
-	0xf7, 0x93, 0x4f, 0xfa, 0x6c, 0xf4, 0x8c, 0x0b, 0x12, 0x8c, 0xa9, 0x97, 0xb5, 0xbc, 0x88, 0x26,
-	0x14, 0x18, 0xb8, 0xa9, 0xe0, 0x92, 0xe3, 0x1d, 0xbd, 0x70, 0xb3, 0x56, 0x7d, 0x7f, 0x89, 0x31,
-	0x43, 0x05, 0xa9, 0x3f, 0x89, 0x78, 0xc4, 0xd5, 0xd1, 0xcb, 0x4f, 0x66, 0xea, 0x04, 0x1c, 0x62,
-	0x0e, 0xde, 0x80, 0x40, 0x4e, 0x19, 0x50, 0x49, 0x5a, 0x5e, 0xc0, 0x59, 0xa2, 0xf7, 0xcd, 0x3f,
-	0x9b, 0x68, 0xf7, 0x42, 0x5b, 0x5d, 0x49, 0x22, 0x29, 0xf6, 0xd0, 0x56, 0x4a, 0x04, 0x89, 0xc1,
-	0xb6, 0x1a, 0xd6, 0x51, 0xf9, 0xb4, 0xe6, 0x2e, 0xac, 0xdd, 0x9e, 0x5a, 0x74, 0x36, 0x6e, 0x7e,
-	0x1e, 0x96, 0xfa, 0x06, 0x86, 0x7b, 0x08, 0x0f, 0x29, 0x0d, 0xa9, 0xf0, 0x43, 0x3a, 0xa6, 0x11,
-	0x91, 0x8c, 0x27, 0x60, 0xaf, 0x35, 0xd6, 0x8f, 0xca, 0xa7, 0xcf, 0x0b, 0xe4, 0xf7, 0x0a, 0xd4,
-	0x5d, 0x60, 0x8c, 0x4c, 0x6d, 0x78, 0x67, 0x0e, 0x78, 0x82, 0x2a, 0x74, 0x12, 0x8c, 0x48, 0x12,
-	0x51, 0x5f, 0x10, 0x49, 0xc1, 0x5e, 0x57, 0x6a, 0x2f, 0x5c, 0x1d, 0xc6, 0xcd, 0xc3, 0xb8, 0x26,
-	0x8c, 0xdb, 0xa5, 0xc1, 0x39, 0x67, 0x49, 0xe7, 0x2c, 0x97, 0xfb, 0xfe, 0xeb, 0xf0, 0x38, 0x62,
-	0x72, 0x74, 0x3d, 0x70, 0x03, 0x1e, 0x7b, 0x26, 0xbc, 0xfe, 0x9c, 0x40, 0xf8, 0xc5, 0x93, 0x5f,
-	0x53, 0x0a, 0x73, 0x0e, 0xf4, 0xf7, 0xe6, 0x46, 0xfd, 0xdc, 0x07, 0xb7, 0xd1, 0x5e, 0xcc, 0x00,
-	0xfc, 0x80, 0x5f, 0x27, 0x92, 0x0a, 0xb0, 0x37, 0x94, 0xf1, 0x7e, 0x21, 0xc6, 0x25, 0x03, 0x38,
-	0xd7, 0x6b, 0x93, 0x60, 0x37, 0x5e, 0x8e, 0x00, 0x67, 0xa8, 0x41, 0xa2, 0x48, 0xe4, 0x61, 0xa8,
-	0xff, 0x4f, 0x0c, 0x3f, 0x15, 0x34, 0xe3, 0x79, 0x9c, 0x4d, 0xa5, 0xfa, 0xa6, 0xa0, 0xda, 0x9e,
-	0x53, 0xde, 0x15, 0xfe, 0xa7, 0xa7, 0xf1, 0xc6, 0xe6, 0x80, 0xfc, 0x07, 0x03, 0x38, 0x46, 0x07,
-	0x0f, 0xf9, 0x6a, 0xd3, 0x2d, 0x65, 0xfa, 0x6a, 0x95, 0xe9, 0xc7, 0xa5, 0x63, 0x9d, 0x3c, 0x04,
-	0x00, 0xfc, 0x16, 0x6d, 0xc7, 0x34, 0x64, 0x24, 0x01, 0x7b, 0x5b, 0x09, 0x3f, 0x2d, 0xf6, 0x44,
-	0xb0, 0x80, 0x5e, 0x49, 0x12, 0xa7, 0x46, 0x69, 0x8e, 0xc5, 0x5d, 0xf4, 0x78, 0xc4, 0x40, 0x72,
-	0xc1, 0x02, 0x3f, 0xcd, 0x51, 0x60, 0x3f, 0x5a, 0x4d, 0xaf, 0xcc, 0x39, 0x6a, 0x03, 0xf8, 0x03,
-	0xaa, 0x69, 0x41, 0x3f, 0xa4, 0x19, 0x33, 0x8d, 0xdb, 0x59, 0xad, 0x53, 0xd5, 0xac, 0xee, 0x82,
-	0xd4, 0x1c, 0xa2, 0xea, 0xdd, 0x5e, 0xe2, 0xd7, 0xa8, 0x62, 0x0a, 0x4d, 0xc2, 0x50, 0x50, 0xd0,
-	0x37, 0x61, 0xa7, 0xbf, 0xa7, 0xa7, 0x6d, 0x3d, 0xc4, 0xc7, 0xa8, 0x96, 0x91, 0x31, 0x0b, 0x89,
-	0xe4, 0x4b, 0xe4, 0x9a, 0x42, 0x56, 0x17, 0x0b, 0x03, 0x6e, 0x7e, 0x46, 0xe5, 0x42, 0x71, 0xee,
-	0xe7, 0x5a, 0xf7, 0x73, 0xf1, 0x4b, 0xb4, 0x5b, 0x2c, 0xa5, 0xf2, 0xd8, 0xe8, 0x97, 0x0b, 0xad,
-	0xeb, 0x5c, 0xdc, 0x4c, 0x1d, 0xeb, 0x76, 0xea, 0x58, 0xbf, 0xa7, 0x8e, 0xf5, 0x6d, 0xe6, 0x94,
-	0x6e, 0x67, 0x4e, 0xe9, 0xc7, 0xcc, 0x29, 0x7d, 0x3a, 0x29, 0xdc, 0x86, 0x2e, 0xa1, 0xd9, 0x25,
-	0x93, 0x23, 0x41, 0x12, 0x2f, 0x8c, 0x83, 0x11, 0x61, 0x89, 0x37, 0x31, 0x8f, 0x88, 0xbe, 0x18,
-	0x83, 0x2d, 0xf5, 0x2a, 0x9c, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x33, 0x49, 0x7a, 0xdf, 0x89,
-	0x04, 0x00, 0x00,
+	// 781 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x96, 0x2c, 0xd5, 0x96, 0x56, 0x3f, 0xb5, 0xb6, 0x8a, 0x4b, 0x28, 0xb5, 0xa4, 0x0a, 0x2d,
+	0x6a, 0x20, 0x08, 0x09, 0x25, 0xed, 0xa9, 0x27, 0x4b, 0x6a, 0xe3, 0x4b, 0x0a, 0x83, 0x71, 0x5b,
+	0xa0, 0x40, 0x41, 0xac, 0xc8, 0x15, 0xb5, 0x30, 0xc9, 0x25, 0x76, 0x96, 0x82, 0xfb, 0x16, 0x79,
+	0x8e, 0x3e, 0x49, 0x8e, 0xe9, 0xad, 0xa7, 0xa6, 0xb0, 0x5f, 0xa4, 0xe0, 0xee, 0x52, 0x62, 0x94,
+	0xb8, 0x3e, 0x89, 0x9a, 0xef, 0x9b, 0x6f, 0x76, 0x86, 0xb3, 0x1f, 0xd1, 0xe7, 0x5c, 0x10, 0x3f,
+	0xa2, 0xce, 0x66, 0xea, 0x84, 0x34, 0xa1, 0xc0, 0xc0, 0x4e, 0x05, 0x97, 0x1c, 0x37, 0x35, 0x60,
+	0x6f, 0xa6, 0x83, 0x93, 0x1d, 0xc7, 0x04, 0x15, 0x65, 0xd0, 0x0f, 0x79, 0xc8, 0xd5, 0xa3, 0x93,
+	0x3f, 0x99, 0xe8, 0xd0, 0xe7, 0x10, 0x73, 0x70, 0x96, 0x04, 0xf2, 0x94, 0x25, 0x95, 0x64, 0xea,
+	0xf8, 0x9c, 0x25, 0x06, 0x1f, 0x85, 0x9c, 0x87, 0x11, 0x75, 0xd4, 0xbf, 0x65, 0xb6, 0x72, 0x24,
+	0x8b, 0x29, 0x48, 0x12, 0xa7, 0x9a, 0x30, 0xf9, 0xab, 0x81, 0xda, 0x2f, 0xf4, 0x59, 0x5e, 0x49,
+	0x22, 0x29, 0x76, 0xd0, 0x61, 0x4a, 0x04, 0x89, 0xc1, 0xaa, 0x8e, 0xab, 0x67, 0xad, 0x67, 0x3d,
+	0x7b, 0x7b, 0x36, 0xfb, 0x52, 0x01, 0xb3, 0xfa, 0x9b, 0x7f, 0x46, 0x15, 0xd7, 0xd0, 0xf0, 0x25,
+	0xc2, 0x2b, 0x4a, 0x03, 0x2a, 0xbc, 0x80, 0x46, 0x34, 0x24, 0x92, 0xf1, 0x04, 0xac, 0x83, 0x71,
+	0xed, 0xac, 0xf5, 0xec, 0x71, 0x29, 0xf9, 0x47, 0x45, 0x5a, 0x6c, 0x39, 0x46, 0xa6, 0xb7, 0xda,
+	0x8b, 0x03, 0xbe, 0x41, 0x5d, 0x7a, 0xe3, 0xaf, 0x49, 0x12, 0x52, 0x4f, 0x10, 0x49, 0xc1, 0xaa,
+	0x29, 0xb5, 0x2f, 0x6c, 0xdd, 0xad, 0x9d, 0x77, 0x6b, 0x9b, 0x6e, 0xed, 0x05, 0xf5, 0xe7, 0x9c,
+	0x25, 0xb3, 0xe7, 0xb9, 0xdc, 0x9f, 0xef, 0x46, 0x4f, 0x42, 0x26, 0xd7, 0xd9, 0xd2, 0xf6, 0x79,
+	0xec, 0x98, 0xe9, 0xe8, 0x9f, 0xa7, 0x10, 0x5c, 0x3b, 0xf2, 0x8f, 0x94, 0x42, 0x91, 0x03, 0x6e,
+	0xa7, 0x28, 0xe4, 0xe6, 0x75, 0xf0, 0x39, 0xea, 0xc4, 0x0c, 0xc0, 0xf3, 0x79, 0x96, 0x48, 0x2a,
+	0xc0, 0xaa, 0xab, 0xc2, 0x27, 0xa5, 0x36, 0x5e, 0x32, 0x80, 0xb9, 0x86, 0x4d, 0x07, 0xed, 0x78,
+	0x17, 0x02, 0xbc, 0x41, 0x63, 0x12, 0x86, 0x22, 0x6f, 0x86, 0x7a, 0xef, 0xb5, 0xe1, 0xa5, 0x82,
+	0x6e, 0x78, 0xde, 0xce, 0x27, 0x4a, 0xf5, 0x9b, 0x92, 0xea, 0x79, 0x91, 0xf2, 0x43, 0xe9, 0x3c,
+	0x97, 0x9a, 0x6f, 0xca, 0x9c, 0x92, 0xff, 0xe1, 0x00, 0x8e, 0xd1, 0xe9, 0x7d, 0x75, 0x75, 0xd1,
+	0x43, 0x55, 0xf4, 0xab, 0x87, 0x8a, 0xfe, 0xb2, 0xab, 0x38, 0x20, 0xf7, 0x11, 0x00, 0x7f, 0x87,
+	0x8e, 0x62, 0x1a, 0x30, 0x92, 0x80, 0x75, 0xa4, 0x84, 0x1f, 0x95, 0xf7, 0x44, 0x30, 0x9f, 0xbe,
+	0xca, 0xb7, 0xcc, 0x28, 0x15, 0x5c, 0xbc, 0x40, 0x9f, 0xae, 0x19, 0x48, 0x2e, 0x98, 0xef, 0xa5,
+	0x39, 0x0b, 0xac, 0xc6, 0xc3, 0xe9, 0xdd, 0x22, 0x47, 0x21, 0x80, 0x2f, 0x50, 0x4f, 0x0b, 0x7a,
+	0x01, 0xdd, 0x30, 0xb3, 0x71, 0xcd, 0x87, 0x75, 0x8e, 0x75, 0xd6, 0x62, 0x9b, 0x84, 0xaf, 0x50,
+	0x7f, 0x7b, 0x1e, 0x75, 0x2d, 0x3c, 0x75, 0x41, 0x2c, 0x64, 0x16, 0x6e, 0x27, 0x76, 0x61, 0x68,
+	0x4a, 0xef, 0x8a, 0xc5, 0xc5, 0x90, 0xf0, 0x7a, 0x1f, 0x00, 0x3c, 0x45, 0x47, 0x3e, 0x49, 0x82,
+	0x88, 0x82, 0xd5, 0x1a, 0xd7, 0xf6, 0x2e, 0xd1, 0x5c, 0x21, 0xc5, 0x60, 0x0c, 0x0f, 0xff, 0x8a,
+	0x1e, 0xbd, 0xff, 0xd2, 0xb2, 0x34, 0x50, 0xab, 0xdf, 0x56, 0x02, 0xa7, 0x25, 0x81, 0xf2, 0xcb,
+	0xf8, 0x59, 0xb1, 0x8c, 0xd8, 0x67, 0xf4, 0x03, 0x04, 0xf0, 0xf7, 0xa8, 0xa5, 0x06, 0xed, 0xad,
+	0x49, 0x24, 0xc1, 0xea, 0x28, 0xb9, 0xfe, 0xfe, 0x94, 0x2e, 0x48, 0x24, 0x8d, 0x0a, 0x4a, 0x8b,
+	0x00, 0xe0, 0x6f, 0xd1, 0x89, 0xcf, 0x84, 0x9f, 0x31, 0xe9, 0x2d, 0x05, 0x25, 0xd7, 0x54, 0x78,
+	0x82, 0x02, 0x95, 0x60, 0x75, 0xc7, 0xb5, 0xb3, 0xa6, 0xdb, 0x37, 0xe8, 0x4c, 0x83, 0xae, 0xc2,
+	0x26, 0x19, 0xea, 0x7d, 0x30, 0x2d, 0xfc, 0x18, 0x35, 0x97, 0x11, 0xf7, 0xaf, 0xbd, 0x24, 0x8b,
+	0x95, 0xb5, 0xd4, 0xdd, 0x86, 0x0a, 0xfc, 0x94, 0xc5, 0x78, 0x8e, 0x90, 0x06, 0xf3, 0xe9, 0x5b,
+	0x07, 0xca, 0x78, 0x06, 0xb6, 0xf6, 0x2e, 0xbb, 0xf0, 0x2e, 0xfb, 0xaa, 0xf0, 0xae, 0x59, 0x23,
+	0x3f, 0xe9, 0xeb, 0x77, 0xa3, 0xaa, 0xab, 0x45, 0x73, 0x64, 0xb2, 0x42, 0xc7, 0xfb, 0x1e, 0x83,
+	0xbf, 0x46, 0x5d, 0x63, 0x4e, 0x24, 0x08, 0x04, 0x05, 0xed, 0x6a, 0x4d, 0xb7, 0xa3, 0xa3, 0xe7,
+	0x3a, 0x88, 0x9f, 0xa0, 0xde, 0x86, 0x44, 0x2c, 0x20, 0x92, 0xef, 0x98, 0x07, 0x8a, 0x79, 0xbc,
+	0x05, 0x0c, 0x79, 0xf2, 0x3b, 0x6a, 0x95, 0x4c, 0xe0, 0xe3, 0xb9, 0xd5, 0x8f, 0xe7, 0xe2, 0x2f,
+	0x51, 0xbb, 0x6c, 0x30, 0xaa, 0x46, 0xdd, 0x6d, 0x95, 0x1c, 0x64, 0xf6, 0xe2, 0xcd, 0xed, 0xb0,
+	0xfa, 0xf6, 0x76, 0x58, 0xfd, 0xf7, 0x76, 0x58, 0x7d, 0x7d, 0x37, 0xac, 0xbc, 0xbd, 0x1b, 0x56,
+	0xfe, 0xbe, 0x1b, 0x56, 0x7e, 0x7b, 0x5a, 0x72, 0xb6, 0x05, 0xa1, 0x9b, 0x97, 0x4c, 0xae, 0x05,
+	0x49, 0x9c, 0x20, 0xf6, 0xd7, 0x84, 0x25, 0xce, 0x8d, 0xf9, 0x62, 0x68, 0x93, 0x5b, 0x1e, 0xaa,
+	0xc1, 0x3d, 0xff, 0x6f, 0x00, 0x88, 0xbf, 0x22, 0x02, 0x76, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -312,6 +426,71 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CircuitBreakerResets) > 0 {
+		for iNdEx := len(m.CircuitBreakerResets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CircuitBreakerResets[iNdEx])
+			copy(dAtA[i:], m.CircuitBreakerResets[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.CircuitBreakerResets[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.PriceHalts) > 0 {
+		for iNdEx := len(m.PriceHalts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceHalts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ExchangeRateUpdates) > 0 {
+		for iNdEx := len(m.ExchangeRateUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRateUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Candles) > 0 {
+		for iNdEx := len(m.Candles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.HistoricStampTimes) > 0 {
+		for iNdEx := len(m.HistoricStampTimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoricStampTimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.MedianDeviations) > 0 {
 		for iNdEx := len(m.MedianDeviations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -437,6 +616,42 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HistoricStampTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricStampTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricStampTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.BlockNum != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *FeederDelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -576,6 +791,50 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.HistoricStampTimes) > 0 {
+		for _, e := range m.HistoricStampTimes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Candles) > 0 {
+		for _, e := range m.Candles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExchangeRateUpdates) > 0 {
+		for _, e := range m.ExchangeRateUpdates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceHalts) > 0 {
+		for _, e := range m.PriceHalts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CircuitBreakerResets) > 0 {
+		for _, s := range m.CircuitBreakerResets {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HistoricStampTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockNum != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockNum))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -952,6 +1211,276 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricStampTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoricStampTimes = append(m.HistoricStampTimes, HistoricStampTime{})
+			if err := m.HistoricStampTimes[len(m.HistoricStampTimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candles = append(m.Candles, Candle{})
+			if err := m.Candles[len(m.Candles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateUpdates = append(m.ExchangeRateUpdates, ExchangeRateUpdate{})
+			if err := m.ExchangeRateUpdates[len(m.ExchangeRateUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHalts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceHalts = append(m.PriceHalts, PriceHalt{})
+			if err := m.PriceHalts[len(m.PriceHalts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerResets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakerResets = append(m.CircuitBreakerResets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoricStampTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricStampTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricStampTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNum", wireType)
+			}
+			m.BlockNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
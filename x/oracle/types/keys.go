@@ -37,6 +37,11 @@ var (
 	KeyPrefixParamUpdatePlan              = []byte{0x10} // prefix for each key to a param update plan
 	KeyPrefixLastHistoricPriceBlock       = []byte{0x11} // prefix for last block number per denom
 	KeyPrefixAverages                     = []byte{0x12} // prefix for each key to a average
+	KeyPrefixHistoricStampTime            = []byte{0x13} // prefix for each key to a historic price stamp block time
+	KeyPrefixCandle                       = []byte{0x14} // prefix for each key to a candle
+	KeyPrefixExchangeRateUpdate           = []byte{0x15} // prefix for each key to a last exchange rate update
+	KeyPrefixPriceHalt                    = []byte{0x16} // prefix for each key to a tripped circuit breaker
+	KeyPrefixCircuitBreakerReset          = []byte{0x17} // prefix for each key to a reset circuit breaker
 
 )
 
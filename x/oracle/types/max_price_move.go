@@ -0,0 +1,46 @@
+package types
+
+import (
+	"strings"
+
+	"cosmossdk.io/math"
+	"gopkg.in/yaml.v3"
+)
+
+// String implements fmt.Stringer interface
+func (m MaxPriceMove) String() string {
+	out, _ := yaml.Marshal(m)
+	return string(out)
+}
+
+func (m MaxPriceMove) Equal(m2 *MaxPriceMove) bool {
+	if !strings.EqualFold(m.SymbolDenom, m2.SymbolDenom) {
+		return false
+	}
+	if !m.MaxMove.Equal(m2.MaxMove) {
+		return false
+	}
+	return true
+}
+
+// MaxPriceMoveList is array of MaxPriceMove
+type MaxPriceMoveList []MaxPriceMove
+
+func (ml MaxPriceMoveList) String() (out string) {
+	for _, m := range ml {
+		out += m.String() + "\n"
+	}
+
+	return strings.TrimSpace(out)
+}
+
+// GetMaxMoveFromDenom returns the max price move of a given denom, and false
+// if the denom has no circuit breaker.
+func (ml MaxPriceMoveList) GetMaxMoveFromDenom(denom string) (math.LegacyDec, bool) {
+	for _, m := range ml {
+		if strings.EqualFold(denom, m.SymbolDenom) {
+			return m.MaxMove, true
+		}
+	}
+	return math.LegacyZeroDec(), false
+}
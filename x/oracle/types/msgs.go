@@ -19,6 +19,8 @@ var (
 	_ sdk.Msg = &MsgLegacyGovUpdateParams{}
 	_ sdk.Msg = &MsgGovUpdateParams{}
 	_ sdk.Msg = &MsgGovCancelUpdateParamPlan{}
+	_ sdk.Msg = &MsgGovResetCircuitBreakers{}
+	_ sdk.Msg = &MsgGovUpdateDenomOverrides{}
 )
 
 func NewMsgAggregateExchangeRatePrevote(
@@ -272,6 +274,44 @@ func (msg MsgLegacyGovUpdateParams) ValidateBasic() error {
 				return err
 			}
 
+		case string(KeyMaxVoteExtensionRates):
+			if err := validateMaxVoteExtensionRates(msg.Changes.MaxVoteExtensionRates); err != nil {
+				return err
+			}
+
+		case string(KeyCandleIntervals):
+			if err := validateCandleIntervals(msg.Changes.CandleIntervals); err != nil {
+				return err
+			}
+
+		case string(KeyMaximumCandles):
+			if err := validateMaximumCandles(msg.Changes.MaximumCandles); err != nil {
+				return err
+			}
+
+		case string(KeyMaximumRateStaleness):
+			if err := validateMaximumRateStaleness(msg.Changes.MaximumRateStaleness); err != nil {
+				return err
+			}
+
+		case string(KeyMaxPriceMoves):
+			if err := validateMaxPriceMoves(msg.Changes.MaxPriceMoves); err != nil {
+				return err
+			}
+
+		case string(KeyDenomOverrides):
+			if err := validateDenomOverrides(msg.Changes.DenomOverrides); err != nil {
+				return err
+			}
+
+		case string(KeyOutlierFilters):
+			if err := validateOutlierFilters(msg.Changes.OutlierFilters); err != nil {
+				return err
+			}
+
+		case string(KeyPriceSubmissionMode):
+			return ErrInvalidParamValue.Wrap("oracle parameter PriceSubmissionMode can only be changed by a ParamUpdatePlan")
+
 		default:
 			return fmt.Errorf("%s is not an existing oracle param key", key)
 		}
@@ -449,3 +489,80 @@ func (msg MsgGovRemoveCurrencyDeviationThresholds) GetSigners() []sdk.AccAddress
 func (msg MsgGovRemoveCurrencyDeviationThresholds) ValidateBasic() error {
 	return checkers.ValidateProposal(msg.Title, msg.Description, msg.Authority)
 }
+
+// NewMsgGovResetCircuitBreakers will creates a new MsgGovResetCircuitBreakers instance
+func NewMsgGovResetCircuitBreakers(
+	authority, title, description string,
+	denoms []string,
+) *MsgGovResetCircuitBreakers {
+	return &MsgGovResetCircuitBreakers{
+		Authority:   authority,
+		Title:       title,
+		Description: description,
+		Denoms:      denoms,
+	}
+}
+
+// Type implements Msg interface
+func (msg MsgGovResetCircuitBreakers) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// String implements the Stringer interface.
+func (msg MsgGovResetCircuitBreakers) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSigners implements Msg
+func (msg MsgGovResetCircuitBreakers) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.Authority)
+}
+
+// ValidateBasic implements Msg
+func (msg MsgGovResetCircuitBreakers) ValidateBasic() error {
+	if len(msg.Denoms) == 0 {
+		return ErrInvalidRequest.Wrap("no denoms to reset")
+	}
+
+	return checkers.ValidateProposal(msg.Title, msg.Description, msg.Authority)
+}
+
+// NewMsgGovUpdateDenomOverrides will creates a new MsgGovUpdateDenomOverrides instance
+func NewMsgGovUpdateDenomOverrides(
+	authority, title, description string,
+	height int64, overrides DenomOverrideList, removeDenoms []string,
+) *MsgGovUpdateDenomOverrides {
+	return &MsgGovUpdateDenomOverrides{
+		Authority:    authority,
+		Title:        title,
+		Description:  description,
+		Height:       height,
+		Overrides:    overrides,
+		RemoveDenoms: removeDenoms,
+	}
+}
+
+// Type implements Msg interface
+func (msg MsgGovUpdateDenomOverrides) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// String implements the Stringer interface.
+func (msg MsgGovUpdateDenomOverrides) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSigners implements Msg
+func (msg MsgGovUpdateDenomOverrides) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.Authority)
+}
+
+// ValidateBasic implements Msg
+func (msg MsgGovUpdateDenomOverrides) ValidateBasic() error {
+	if len(msg.Overrides) == 0 && len(msg.RemoveDenoms) == 0 {
+		return ErrInvalidRequest.Wrap("no denom overrides to update or remove")
+	}
+	if err := validateDenomOverrides(DenomOverrideList(msg.Overrides)); err != nil {
+		return err
+	}
+
+	return checkers.ValidateProposal(msg.Title, msg.Description, msg.Authority)
+}
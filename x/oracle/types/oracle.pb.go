@@ -10,15 +10,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,6 +31,86 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PriceSubmissionMode defines how validators submit exchange rate votes.
+type PriceSubmissionMode int32
+
+const (
+	// PRICE_SUBMISSION_MODE_UNSPECIFIED is an invalid mode.
+	PriceSubmissionMode_PRICE_SUBMISSION_MODE_UNSPECIFIED PriceSubmissionMode = 0
+	// PRICE_SUBMISSION_MODE_COMMIT_REVEAL only accepts MsgAggregateExchangeRatePrevote
+	// and MsgAggregateExchangeRateVote.
+	PriceSubmissionMode_PRICE_SUBMISSION_MODE_COMMIT_REVEAL PriceSubmissionMode = 1
+	// PRICE_SUBMISSION_MODE_VOTE_EXTENSIONS only accepts the exchange rates of
+	// the vote extensions injected in the block.
+	PriceSubmissionMode_PRICE_SUBMISSION_MODE_VOTE_EXTENSIONS PriceSubmissionMode = 2
+	// PRICE_SUBMISSION_MODE_BOTH accepts both, a vote extension vote replacing
+	// the commit-reveal vote of the validator.
+	PriceSubmissionMode_PRICE_SUBMISSION_MODE_BOTH PriceSubmissionMode = 3
+)
+
+var PriceSubmissionMode_name = map[int32]string{
+	0: "PRICE_SUBMISSION_MODE_UNSPECIFIED",
+	1: "PRICE_SUBMISSION_MODE_COMMIT_REVEAL",
+	2: "PRICE_SUBMISSION_MODE_VOTE_EXTENSIONS",
+	3: "PRICE_SUBMISSION_MODE_BOTH",
+}
+
+var PriceSubmissionMode_value = map[string]int32{
+	"PRICE_SUBMISSION_MODE_UNSPECIFIED":     0,
+	"PRICE_SUBMISSION_MODE_COMMIT_REVEAL":   1,
+	"PRICE_SUBMISSION_MODE_VOTE_EXTENSIONS": 2,
+	"PRICE_SUBMISSION_MODE_BOTH":            3,
+}
+
+func (x PriceSubmissionMode) String() string {
+	return proto.EnumName(PriceSubmissionMode_name, int32(x))
+}
+
+func (PriceSubmissionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_652b57db11528d07, []int{0}
+}
+
+// PriceSource defines the USD rate a cross rate is derived from.
+type PriceSource int32
+
+const (
+	// PRICE_SOURCE_UNSPECIFIED defaults to the spot exchange rate.
+	PriceSource_PRICE_SOURCE_UNSPECIFIED PriceSource = 0
+	// PRICE_SOURCE_SPOT is the latest exchange rate.
+	PriceSource_PRICE_SOURCE_SPOT PriceSource = 1
+	// PRICE_SOURCE_SMA is the simple moving average of the historic prices.
+	PriceSource_PRICE_SOURCE_SMA PriceSource = 2
+	// PRICE_SOURCE_EMA is the exponential moving average of the historic
+	// prices.
+	PriceSource_PRICE_SOURCE_EMA PriceSource = 3
+	// PRICE_SOURCE_TWAP is the time-weighted average of the historic prices.
+	PriceSource_PRICE_SOURCE_TWAP PriceSource = 4
+)
+
+var PriceSource_name = map[int32]string{
+	0: "PRICE_SOURCE_UNSPECIFIED",
+	1: "PRICE_SOURCE_SPOT",
+	2: "PRICE_SOURCE_SMA",
+	3: "PRICE_SOURCE_EMA",
+	4: "PRICE_SOURCE_TWAP",
+}
+
+var PriceSource_value = map[string]int32{
+	"PRICE_SOURCE_UNSPECIFIED": 0,
+	"PRICE_SOURCE_SPOT":        1,
+	"PRICE_SOURCE_SMA":         2,
+	"PRICE_SOURCE_EMA":         3,
+	"PRICE_SOURCE_TWAP":        4,
+}
+
+func (x PriceSource) String() string {
+	return proto.EnumName(PriceSource_name, int32(x))
+}
+
+func (PriceSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_652b57db11528d07, []int{1}
+}
+
 // Params defines the parameters for the oracle module.
 type Params struct {
 	VotePeriod               uint64                      `protobuf:"varint,1,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty" yaml:"vote_period"`
@@ -60,6 +145,32 @@ type Params struct {
 	UsdcIbcDenom    string `protobuf:"bytes,16,opt,name=usdc_ibc_denom,json=usdcIbcDenom,proto3" json:"usdc_ibc_denom,omitempty"`
 	SlashingEnabled bool   `protobuf:"varint,17,opt,name=slashing_enabled,json=slashingEnabled,proto3" json:"slashing_enabled,omitempty"`
 	AveragingWindow uint64 `protobuf:"varint,18,opt,name=averaging_window,json=averagingWindow,proto3" json:"averaging_window,omitempty"`
+	// Max Vote Extension Rates represents the maximum amount of exchange rates
+	// a validator vote extension may contain.
+	MaxVoteExtensionRates uint64 `protobuf:"varint,19,opt,name=max_vote_extension_rates,json=maxVoteExtensionRates,proto3" json:"max_vote_extension_rates,omitempty"`
+	// Price Submission Mode selects how validators submit exchange rates. It
+	// can only be changed by a ParamUpdatePlan scheduled at the last block of
+	// a vote period.
+	PriceSubmissionMode PriceSubmissionMode `protobuf:"varint,20,opt,name=price_submission_mode,json=priceSubmissionMode,proto3,enum=oracle.v1.PriceSubmissionMode" json:"price_submission_mode,omitempty"`
+	// Candle Intervals are the lengths in blocks of the OHLC candles updated
+	// at each historic price stamp. Candles are not recorded if empty.
+	CandleIntervals []uint64 `protobuf:"varint,21,rep,packed,name=candle_intervals,json=candleIntervals,proto3" json:"candle_intervals,omitempty" yaml:"candle_intervals"`
+	// Maximum Candles represents the maximum amount of candles the oracle
+	// module will keep for each denom and candle interval.
+	MaximumCandles uint64 `protobuf:"varint,22,opt,name=maximum_candles,json=maximumCandles,proto3" json:"maximum_candles,omitempty" yaml:"maximum_candles"`
+	// Maximum Rate Staleness represents the maximum amount of blocks since
+	// the last update of a denom's exchange rate before it is stale.
+	MaximumRateStaleness uint64 `protobuf:"varint,23,opt,name=maximum_rate_staleness,json=maximumRateStaleness,proto3" json:"maximum_rate_staleness,omitempty" yaml:"maximum_rate_staleness"`
+	// Max Price Moves are the largest relative moves of the exchange rates of
+	// denoms from their previous rate, or from their latest historic median
+	// beyond its deviation, before their circuit breaker halts them.
+	MaxPriceMoves MaxPriceMoveList `protobuf:"bytes,24,rep,name=max_price_moves,json=maxPriceMoves,proto3,castrepeated=MaxPriceMoveList" json:"max_price_moves" yaml:"max_price_moves"`
+	// Denom Overrides replace the voting rules of denoms whose ballots can not
+	// meet the global ones, such as long-tail assets.
+	DenomOverrides DenomOverrideList `protobuf:"bytes,25,rep,name=denom_overrides,json=denomOverrides,proto3,castrepeated=DenomOverrideList" json:"denom_overrides" yaml:"denom_overrides"`
+	// Outlier Filters remove outlying votes from the ballots of denoms before
+	// they are tallied.
+	OutlierFilters OutlierFilterList `protobuf:"bytes,26,rep,name=outlier_filters,json=outlierFilters,proto3,castrepeated=OutlierFilterList" json:"outlier_filters" yaml:"outlier_filters"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -171,6 +282,135 @@ func (m *RewardBand) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RewardBand proto.InternalMessageInfo
 
+// MaxPriceMove - the largest relative move of the exchange rate of a denom
+// in a vote period before its circuit breaker halts it.
+type MaxPriceMove struct {
+	SymbolDenom string                      `protobuf:"bytes,1,opt,name=symbol_denom,json=symbolDenom,proto3" json:"symbol_denom,omitempty" yaml:"symbol_denom"`
+	MaxMove     cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_move,json=maxMove,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_move" yaml:"max_move"`
+}
+
+func (m *MaxPriceMove) Reset()      { *m = MaxPriceMove{} }
+func (*MaxPriceMove) ProtoMessage() {}
+func (*MaxPriceMove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_652b57db11528d07, []int{3}
+}
+func (m *MaxPriceMove) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaxPriceMove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaxPriceMove.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaxPriceMove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaxPriceMove.Merge(m, src)
+}
+func (m *MaxPriceMove) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaxPriceMove) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaxPriceMove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaxPriceMove proto.InternalMessageInfo
+
+// DenomOverride - the voting rules of a denom replacing the global ones.
+type DenomOverride struct {
+	SymbolDenom string `protobuf:"bytes,1,opt,name=symbol_denom,json=symbolDenom,proto3" json:"symbol_denom,omitempty" yaml:"symbol_denom"`
+	// vote_threshold replaces the VoteThreshold param if positive.
+	VoteThreshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"vote_threshold" yaml:"vote_threshold"`
+	// min_voters is the minimum amount of distinct validators voting in a
+	// ballot of the denom.
+	MinVoters uint64 `protobuf:"varint,3,opt,name=min_voters,json=minVoters,proto3" json:"min_voters,omitempty" yaml:"min_voters"`
+	// vote_period_multiplier is the amount of vote periods between updates of
+	// the exchange rate of the denom, every vote period if zero.
+	VotePeriodMultiplier uint64 `protobuf:"varint,4,opt,name=vote_period_multiplier,json=votePeriodMultiplier,proto3" json:"vote_period_multiplier,omitempty" yaml:"vote_period_multiplier"`
+}
+
+func (m *DenomOverride) Reset()      { *m = DenomOverride{} }
+func (*DenomOverride) ProtoMessage() {}
+func (*DenomOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_652b57db11528d07, []int{4}
+}
+func (m *DenomOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomOverride.Merge(m, src)
+}
+func (m *DenomOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomOverride proto.InternalMessageInfo
+
+// OutlierFilter - the filter removing outlying votes from the ballots of a
+// denom, either further than mad_multiplier median absolute deviations from
+// the unweighted median, or outside the percentile band.
+type OutlierFilter struct {
+	SymbolDenom string `protobuf:"bytes,1,opt,name=symbol_denom,json=symbolDenom,proto3" json:"symbol_denom,omitempty" yaml:"symbol_denom"`
+	// mad_multiplier is the k of the k x median absolute deviation filter.
+	MadMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=mad_multiplier,json=madMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"mad_multiplier" yaml:"mad_multiplier"`
+	// lower_percentile is the lower bound of the percentile band filter.
+	LowerPercentile cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=lower_percentile,json=lowerPercentile,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"lower_percentile" yaml:"lower_percentile"`
+	// upper_percentile is the upper bound of the percentile band filter.
+	UpperPercentile cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=upper_percentile,json=upperPercentile,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"upper_percentile" yaml:"upper_percentile"`
+}
+
+func (m *OutlierFilter) Reset()      { *m = OutlierFilter{} }
+func (*OutlierFilter) ProtoMessage() {}
+func (*OutlierFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_652b57db11528d07, []int{5}
+}
+func (m *OutlierFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutlierFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutlierFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutlierFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutlierFilter.Merge(m, src)
+}
+func (m *OutlierFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutlierFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutlierFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutlierFilter proto.InternalMessageInfo
+
 // AggregateExchangeRatePrevote -
 // struct for aggregate prevoting on the ExchangeRateVote.
 // The purpose of aggregate prevote is to hide vote exchange rates with hash
@@ -185,7 +425,7 @@ type AggregateExchangeRatePrevote struct {
 func (m *AggregateExchangeRatePrevote) Reset()      { *m = AggregateExchangeRatePrevote{} }
 func (*AggregateExchangeRatePrevote) ProtoMessage() {}
 func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_652b57db11528d07, []int{3}
+	return fileDescriptor_652b57db11528d07, []int{6}
 }
 func (m *AggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,7 +464,7 @@ type AggregateExchangeRateVote struct {
 func (m *AggregateExchangeRateVote) Reset()      { *m = AggregateExchangeRateVote{} }
 func (*AggregateExchangeRateVote) ProtoMessage() {}
 func (*AggregateExchangeRateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_652b57db11528d07, []int{4}
+	return fileDescriptor_652b57db11528d07, []int{7}
 }
 func (m *AggregateExchangeRateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -264,7 +504,7 @@ func (m *PriceStamp) Reset()         { *m = PriceStamp{} }
 func (m *PriceStamp) String() string { return proto.CompactTextString(m) }
 func (*PriceStamp) ProtoMessage()    {}
 func (*PriceStamp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_652b57db11528d07, []int{5}
+	return fileDescriptor_652b57db11528d07, []int{8}
 }
 func (m *PriceStamp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -293,6 +533,277 @@ func (m *PriceStamp) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PriceStamp proto.InternalMessageInfo
 
+// ExchangeRateUpdate is the last exchange rate of a denom with the block it
+// was set in. It is retained when the ballot of the denom is dropped.
+type ExchangeRateUpdate struct {
+	Denom        string                      `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	ExchangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"exchange_rate"`
+	BlockNum     uint64                      `protobuf:"varint,3,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	BlockTime    time.Time                   `protobuf:"bytes,4,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+}
+
+func (m *ExchangeRateUpdate) Reset()         { *m = ExchangeRateUpdate{} }
+func (m *ExchangeRateUpdate) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRateUpdate) ProtoMessage()    {}
+func (*ExchangeRateUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_652b57db11528d07, []int{9}
+}
+func (m *ExchangeRateUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRateUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRateUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeRateUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRateUpdate.Merge(m, src)
+}
+func (m *ExchangeRateUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRateUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRateUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRateUpdate proto.InternalMessageInfo
+
+// ExchangeRateStatus is the last exchange rate of a denom with its age at
+// the current block.
+type ExchangeRateStatus struct {
+	LastUpdate ExchangeRateUpdate `protobuf:"bytes,1,opt,name=last_update,json=lastUpdate,proto3" json:"last_update"`
+	// age_blocks is the amount of blocks since the last update.
+	AgeBlocks uint64 `protobuf:"varint,2,opt,name=age_blocks,json=ageBlocks,proto3" json:"age_blocks,omitempty"`
+	// age is the block time since the last update.
+	Age time.Duration `protobuf:"bytes,3,opt,name=age,proto3,stdduration" json:"age"`
+	// stale is set if age_blocks is over the MaximumRateStaleness param.
+	Stale bool `protobuf:"varint,4,opt,name=stale,proto3" json:"stale,omitempty"`
+	// halted is set if the circuit breaker of the denom is tripped.
+	Halted bool `protobuf:"varint,5,opt,name=halted,proto3" json:"halted,omitempty"`
+}
+
+func (m *ExchangeRateStatus) Reset()         { *m = ExchangeRateStatus{} }
+func (m *ExchangeRateStatus) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRateStatus) ProtoMessage()    {}
+func (*ExchangeRateStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_652b57db11528d07, []int{10}
+}
+func (m *ExchangeRateStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRateStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRateStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeRateStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRateStatus.Merge(m, src)
+}
+func (m *ExchangeRateStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRateStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRateStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRateStatus proto.InternalMessageInfo
+
+// PriceHalt is a tripped circuit breaker of a denom, which keeps its
+// exchange rate at halted_rate until it is reset by governance.
+type PriceHalt struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// halted_rate is the last exchange rate before the breach.
+	HaltedRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=halted_rate,json=haltedRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"halted_rate"`
+	// rejected_rate is the exchange rate which tripped the circuit breaker.
+	RejectedRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=rejected_rate,json=rejectedRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rejected_rate"`
+	BlockNum     uint64                      `protobuf:"varint,4,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	// reason is the reference price the move breached.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *PriceHalt) Reset()         { *m = PriceHalt{} }
+func (m *PriceHalt) String() string { return proto.CompactTextString(m) }
+func (*PriceHalt) ProtoMessage()    {}
+func (*PriceHalt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_652b57db11528d07, []int{11}
+}
+func (m *PriceHalt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceHalt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceHalt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceHalt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceHalt.Merge(m, src)
+}
+func (m *PriceHalt) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceHalt) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceHalt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceHalt proto.InternalMessageInfo
+
+// Candle is the open, high, low and close historic prices of a denom over
+// a candle interval starting at a block.
+type Candle struct {
+	Denom      string                      `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Interval   uint64                      `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	StartBlock uint64                      `protobuf:"varint,3,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	Open       cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=open,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"open"`
+	High       cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=high,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"high"`
+	Low        cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=low,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"low"`
+	Close      cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=close,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"close"`
+}
+
+func (m *Candle) Reset()         { *m = Candle{} }
+func (m *Candle) String() string { return proto.CompactTextString(m) }
+func (*Candle) ProtoMessage()    {}
+func (*Candle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_652b57db11528d07, []int{12}
+}
+func (m *Candle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Candle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candle.Merge(m, src)
+}
+func (m *Candle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Candle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candle proto.InternalMessageInfo
+
+// PriceStatistics are the statistics of the latest historic prices of a
+// denom.
+type PriceStatistics struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// num_stamps is the amount of historic prices the statistics are of.
+	NumStamps uint64                      `protobuf:"varint,2,opt,name=num_stamps,json=numStamps,proto3" json:"num_stamps,omitempty"`
+	Min       cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=min,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min"`
+	Max       cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max"`
+	Mean      cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=mean,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"mean"`
+	Median    cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=median,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"median"`
+	// standard_deviation is the population standard deviation of the prices.
+	StandardDeviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=standard_deviation,json=standardDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"standard_deviation"`
+	// realized_volatility is the square root of the sum of the squared log
+	// returns between consecutive prices.
+	RealizedVolatility cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=realized_volatility,json=realizedVolatility,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"realized_volatility"`
+	Percentiles        []PricePercentile           `protobuf:"bytes,9,rep,name=percentiles,proto3" json:"percentiles"`
+}
+
+func (m *PriceStatistics) Reset()         { *m = PriceStatistics{} }
+func (m *PriceStatistics) String() string { return proto.CompactTextString(m) }
+func (*PriceStatistics) ProtoMessage()    {}
+func (*PriceStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_652b57db11528d07, []int{13}
+}
+func (m *PriceStatistics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceStatistics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceStatistics.Merge(m, src)
+}
+func (m *PriceStatistics) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceStatistics proto.InternalMessageInfo
+
+// PricePercentile is a percentile of historic prices.
+type PricePercentile struct {
+	// percentile is in whole percent, from 0 to 100.
+	Percentile uint32                      `protobuf:"varint,1,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	Value      cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=value,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"value"`
+}
+
+func (m *PricePercentile) Reset()         { *m = PricePercentile{} }
+func (m *PricePercentile) String() string { return proto.CompactTextString(m) }
+func (*PricePercentile) ProtoMessage()    {}
+func (*PricePercentile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_652b57db11528d07, []int{14}
+}
+func (m *PricePercentile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PricePercentile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PricePercentile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PricePercentile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PricePercentile.Merge(m, src)
+}
+func (m *PricePercentile) XXX_Size() int {
+	return m.Size()
+}
+func (m *PricePercentile) XXX_DiscardUnknown() {
+	xxx_messageInfo_PricePercentile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PricePercentile proto.InternalMessageInfo
+
 // ValidatorRewardSet is the set of validators that is earning
 // rewards for voting on exchange rates based on their misscounter
 // in a given Slash Window. This set gets updated every Slash Window.
@@ -304,7 +815,7 @@ func (m *ValidatorRewardSet) Reset()         { *m = ValidatorRewardSet{} }
 func (m *ValidatorRewardSet) String() string { return proto.CompactTextString(m) }
 func (*ValidatorRewardSet) ProtoMessage()    {}
 func (*ValidatorRewardSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_652b57db11528d07, []int{6}
+	return fileDescriptor_652b57db11528d07, []int{15}
 }
 func (m *ValidatorRewardSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -345,7 +856,7 @@ type CurrencyPairProviders struct {
 func (m *CurrencyPairProviders) Reset()      { *m = CurrencyPairProviders{} }
 func (*CurrencyPairProviders) ProtoMessage() {}
 func (*CurrencyPairProviders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_652b57db11528d07, []int{7}
+	return fileDescriptor_652b57db11528d07, []int{16}
 }
 func (m *CurrencyPairProviders) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,7 +896,7 @@ func (m *PairAddressProvider) Reset()         { *m = PairAddressProvider{} }
 func (m *PairAddressProvider) String() string { return proto.CompactTextString(m) }
 func (*PairAddressProvider) ProtoMessage()    {}
 func (*PairAddressProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_652b57db11528d07, []int{8}
+	return fileDescriptor_652b57db11528d07, []int{17}
 }
 func (m *PairAddressProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -424,7 +935,7 @@ type CurrencyDeviationThreshold struct {
 func (m *CurrencyDeviationThreshold) Reset()      { *m = CurrencyDeviationThreshold{} }
 func (*CurrencyDeviationThreshold) ProtoMessage() {}
 func (*CurrencyDeviationThreshold) Descriptor() ([]byte, []int) {
-	return fileDescriptor_652b57db11528d07, []int{9}
+	return fileDescriptor_652b57db11528d07, []int{18}
 }
 func (m *CurrencyDeviationThreshold) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -467,7 +978,7 @@ type ParamUpdatePlan struct {
 func (m *ParamUpdatePlan) Reset()      { *m = ParamUpdatePlan{} }
 func (*ParamUpdatePlan) ProtoMessage() {}
 func (*ParamUpdatePlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_652b57db11528d07, []int{10}
+	return fileDescriptor_652b57db11528d07, []int{19}
 }
 func (m *ParamUpdatePlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -497,12 +1008,23 @@ func (m *ParamUpdatePlan) XXX_DiscardUnknown() {
 var xxx_messageInfo_ParamUpdatePlan proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("oracle.v1.PriceSubmissionMode", PriceSubmissionMode_name, PriceSubmissionMode_value)
+	proto.RegisterEnum("oracle.v1.PriceSource", PriceSource_name, PriceSource_value)
 	proto.RegisterType((*Params)(nil), "oracle.v1.Params")
 	proto.RegisterType((*Denom)(nil), "oracle.v1.Denom")
 	proto.RegisterType((*RewardBand)(nil), "oracle.v1.RewardBand")
+	proto.RegisterType((*MaxPriceMove)(nil), "oracle.v1.MaxPriceMove")
+	proto.RegisterType((*DenomOverride)(nil), "oracle.v1.DenomOverride")
+	proto.RegisterType((*OutlierFilter)(nil), "oracle.v1.OutlierFilter")
 	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "oracle.v1.AggregateExchangeRatePrevote")
 	proto.RegisterType((*AggregateExchangeRateVote)(nil), "oracle.v1.AggregateExchangeRateVote")
 	proto.RegisterType((*PriceStamp)(nil), "oracle.v1.PriceStamp")
+	proto.RegisterType((*ExchangeRateUpdate)(nil), "oracle.v1.ExchangeRateUpdate")
+	proto.RegisterType((*ExchangeRateStatus)(nil), "oracle.v1.ExchangeRateStatus")
+	proto.RegisterType((*PriceHalt)(nil), "oracle.v1.PriceHalt")
+	proto.RegisterType((*Candle)(nil), "oracle.v1.Candle")
+	proto.RegisterType((*PriceStatistics)(nil), "oracle.v1.PriceStatistics")
+	proto.RegisterType((*PricePercentile)(nil), "oracle.v1.PricePercentile")
 	proto.RegisterType((*ValidatorRewardSet)(nil), "oracle.v1.ValidatorRewardSet")
 	proto.RegisterType((*CurrencyPairProviders)(nil), "oracle.v1.CurrencyPairProviders")
 	proto.RegisterType((*PairAddressProvider)(nil), "oracle.v1.PairAddressProvider")
@@ -513,96 +1035,164 @@ func init() {
 func init() { proto.RegisterFile("oracle/v1/oracle.proto", fileDescriptor_652b57db11528d07) }
 
 var fileDescriptor_652b57db11528d07 = []byte{
-	// 1410 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x36, 0x69, 0x1a, 0x8f, 0xbf, 0x92, 0x49, 0xd2, 0x6e, 0x93, 0xe2, 0x0d, 0x4b, 0x0b,
-	0xe1, 0xa3, 0x36, 0x49, 0x91, 0x2a, 0x22, 0x38, 0x74, 0x9b, 0x56, 0x42, 0xb4, 0x95, 0x35, 0x81,
-	0x22, 0xf5, 0xc0, 0x6a, 0xbc, 0x3b, 0xd8, 0xa3, 0x78, 0x77, 0xcd, 0xcc, 0xda, 0x8d, 0x2f, 0x9c,
-	0x91, 0xb8, 0x70, 0xe0, 0xc0, 0x05, 0xa9, 0x47, 0xd4, 0x23, 0x12, 0x12, 0xfc, 0x07, 0x95, 0xb8,
-	0xf4, 0x88, 0x38, 0x6c, 0xa1, 0xbd, 0x70, 0xf6, 0x85, 0x2b, 0x9a, 0x8f, 0x5d, 0xaf, 0x5d, 0x47,
-	0x94, 0x9e, 0xbc, 0x6f, 0x7e, 0xef, 0xbd, 0xf9, 0xcd, 0x6f, 0xe6, 0xbd, 0x19, 0x83, 0xb3, 0x11,
-	0xc3, 0x5e, 0x8f, 0x34, 0x87, 0xbb, 0x4d, 0xf5, 0xd5, 0xe8, 0xb3, 0x28, 0x8e, 0x60, 0x51, 0x5b,
-	0xc3, 0xdd, 0xcd, 0xba, 0x17, 0xf1, 0x20, 0xe2, 0xcd, 0x36, 0xe6, 0xc2, 0xaf, 0x4d, 0x62, 0xbc,
-	0xdb, 0xf4, 0x22, 0x1a, 0x2a, 0xd7, 0xcd, 0xf5, 0x4e, 0xd4, 0x89, 0xe4, 0x67, 0x53, 0x7c, 0xa9,
-	0x51, 0xfb, 0x9f, 0x12, 0x58, 0x6a, 0x61, 0x86, 0x03, 0x0e, 0xaf, 0x82, 0xd2, 0x30, 0x8a, 0x89,
-	0xdb, 0x27, 0x8c, 0x46, 0xbe, 0x69, 0x6c, 0x1b, 0x3b, 0x8b, 0xce, 0xd9, 0x71, 0x62, 0xc1, 0x11,
-	0x0e, 0x7a, 0xfb, 0x76, 0x0e, 0xb4, 0x11, 0x10, 0x56, 0x4b, 0x1a, 0xd0, 0x03, 0x55, 0x89, 0xc5,
-	0x5d, 0x46, 0x78, 0x37, 0xea, 0xf9, 0xe6, 0xa9, 0x6d, 0x63, 0xa7, 0xe8, 0x7c, 0xf0, 0x28, 0xb1,
-	0x0a, 0x7f, 0x24, 0xd6, 0x96, 0x62, 0xc6, 0xfd, 0xa3, 0x06, 0x8d, 0x9a, 0x01, 0x8e, 0xbb, 0x8d,
-	0x5b, 0xa4, 0x83, 0xbd, 0xd1, 0x01, 0xf1, 0xc6, 0x89, 0xb5, 0x91, 0x4b, 0x9f, 0xa5, 0xb0, 0x51,
-	0x45, 0x0c, 0x7c, 0x92, 0xda, 0xb0, 0x03, 0xca, 0x8c, 0xdc, 0xc7, 0xcc, 0x77, 0xdb, 0x38, 0xf4,
-	0xb9, 0xb9, 0xb0, 0xbd, 0xb0, 0x53, 0xda, 0xdb, 0x68, 0x64, 0x02, 0x34, 0x90, 0x84, 0x1d, 0x1c,
-	0xfa, 0xce, 0x65, 0x31, 0xf3, 0x38, 0xb1, 0xd6, 0x54, 0xea, 0x7c, 0xa0, 0xfd, 0xf0, 0x89, 0x55,
-	0x9d, 0xb8, 0xde, 0xa2, 0x3c, 0x46, 0x25, 0x96, 0xd9, 0x1c, 0x7a, 0x60, 0x53, 0xfb, 0xfb, 0x94,
-	0xc7, 0x8c, 0xb6, 0x07, 0x31, 0x8d, 0x42, 0xf7, 0x3e, 0x0d, 0xfd, 0xe8, 0xbe, 0xb9, 0x28, 0x55,
-	0xb9, 0x34, 0x4e, 0xac, 0x57, 0xa7, 0x72, 0xcf, 0xf1, 0xb5, 0x91, 0xa9, 0xc0, 0x83, 0x1c, 0xf6,
-	0x99, 0x84, 0xe0, 0x3d, 0x50, 0xc2, 0x9e, 0x47, 0xfa, 0xb1, 0xdb, 0xa3, 0x3c, 0x36, 0x4f, 0xcb,
-	0xc5, 0xac, 0xe4, 0x16, 0x73, 0x40, 0xc2, 0x28, 0x70, 0xde, 0xd0, 0xeb, 0xd0, 0x3b, 0x90, 0x0b,
-	0x11, 0xcb, 0x28, 0x4a, 0x27, 0xb9, 0x02, 0xa0, 0x20, 0xf1, 0x2d, 0xb6, 0x83, 0xf7, 0x30, 0xef,
-	0xba, 0x5f, 0x30, 0xec, 0x89, 0x39, 0xcd, 0xa5, 0x97, 0xd8, 0x8e, 0xe9, 0x14, 0x36, 0xaa, 0xc8,
-	0x81, 0x9b, 0xda, 0x86, 0xfb, 0xa0, 0xac, 0x3c, 0xb4, 0x2e, 0x67, 0xa4, 0x2e, 0xe7, 0x26, 0x9a,
-	0xe7, 0x51, 0x1b, 0x95, 0xa4, 0xa9, 0x17, 0xcf, 0xc1, 0x7a, 0x40, 0x43, 0x77, 0x88, 0x7b, 0xd4,
-	0x17, 0x07, 0x2a, 0xcd, 0xb1, 0x2c, 0x69, 0x3a, 0x2f, 0x46, 0x73, 0x4b, 0x4d, 0x33, 0x2f, 0x91,
-	0x8d, 0x56, 0x03, 0x1a, 0xde, 0x15, 0xa3, 0x2d, 0xc2, 0xf4, 0xa4, 0x1e, 0xa8, 0x06, 0x38, 0xf4,
-	0x71, 0x1c, 0xb1, 0x91, 0x12, 0xbd, 0x78, 0x82, 0xe8, 0x6f, 0x69, 0xd1, 0xb5, 0x10, 0xd3, 0x51,
-	0x33, 0xba, 0x57, 0x32, 0x54, 0x4a, 0xbf, 0x07, 0x36, 0xba, 0x94, 0xc7, 0x11, 0xa3, 0x9e, 0xcb,
-	0x63, 0x1c, 0xf4, 0xd3, 0x62, 0x02, 0x42, 0x1e, 0xb4, 0x96, 0x82, 0x87, 0x02, 0xd3, 0xd5, 0xd3,
-	0x00, 0x6b, 0x01, 0xf1, 0x29, 0x0e, 0xa7, 0x23, 0x4a, 0x32, 0x62, 0x55, 0x41, 0x79, 0xff, 0x77,
-	0xc1, 0x7a, 0x80, 0x8f, 0x69, 0x30, 0x08, 0xdc, 0x3e, 0xa3, 0x1e, 0x51, 0x61, 0xdc, 0x2c, 0xcb,
-	0x00, 0xa8, 0xb1, 0x96, 0x80, 0x64, 0x18, 0x17, 0xac, 0xd2, 0x88, 0xfc, 0x4c, 0xdc, 0xac, 0x28,
-	0x56, 0x1a, 0xbc, 0x3d, 0x99, 0x8a, 0xc3, 0x1f, 0x0c, 0x70, 0xce, 0x1b, 0x30, 0x46, 0x42, 0x6f,
-	0xe4, 0xf6, 0x31, 0x65, 0x6e, 0x9f, 0x45, 0x43, 0xea, 0x13, 0xc6, 0xcd, 0xaa, 0x14, 0x6e, 0x3b,
-	0x27, 0xdc, 0x75, 0xed, 0xd9, 0xc2, 0x94, 0xb5, 0x52, 0x3f, 0xe7, 0xba, 0x16, 0xb2, 0xae, 0x84,
-	0x3c, 0x21, 0x9d, 0x50, 0xf4, 0xfc, 0xdc, 0x04, 0x52, 0xe1, 0x0d, 0x6f, 0x1e, 0x04, 0x7f, 0x35,
-	0xc0, 0x2b, 0x59, 0x42, 0x9f, 0x0c, 0x29, 0x96, 0x95, 0x97, 0xf5, 0x0f, 0x6e, 0xd6, 0x24, 0xcb,
-	0x4b, 0x73, 0x58, 0x1e, 0xa4, 0xee, 0x59, 0x77, 0x71, 0xee, 0x68, 0xaa, 0x17, 0x67, 0xa8, 0xce,
-	0xcb, 0x2c, 0x08, 0xd7, 0x4f, 0xce, 0x25, 0x59, 0x6f, 0x79, 0x27, 0xe2, 0x1c, 0x5e, 0x04, 0xd5,
-	0x01, 0xf7, 0x3d, 0x97, 0xb6, 0x3d, 0xd7, 0x17, 0x47, 0xc9, 0x5c, 0x11, 0x27, 0x1f, 0x95, 0xc5,
-	0xe8, 0x47, 0x6d, 0x4f, 0x1e, 0x2f, 0xf8, 0x26, 0x58, 0x91, 0x45, 0x43, 0xc3, 0x8e, 0x4b, 0x42,
-	0xdc, 0xee, 0x11, 0xdf, 0x5c, 0xdd, 0x36, 0x76, 0x96, 0x51, 0x2d, 0x1d, 0xbf, 0xa1, 0x86, 0x85,
-	0x2b, 0x1e, 0x12, 0x86, 0x3b, 0xc2, 0x57, 0x17, 0x13, 0x94, 0x7b, 0x5b, 0xcb, 0xc6, 0x55, 0x19,
-	0xec, 0x2f, 0x7f, 0xff, 0xc0, 0x2a, 0xfc, 0xfd, 0xc0, 0x32, 0xec, 0x5f, 0x0c, 0x70, 0x5a, 0xcd,
-	0xf4, 0x1e, 0x00, 0xe2, 0xd2, 0xd0, 0x5c, 0x0c, 0x59, 0x85, 0x1b, 0xe3, 0xc4, 0x5a, 0x55, 0x62,
-	0x4c, 0x30, 0x1b, 0x15, 0x85, 0xa1, 0xa2, 0x44, 0x07, 0x18, 0x05, 0xed, 0xa8, 0xa7, 0xe3, 0x54,
-	0xcf, 0xcf, 0x77, 0x80, 0x1c, 0x2a, 0x3a, 0x80, 0x34, 0x55, 0x6c, 0x13, 0x2c, 0x93, 0xe3, 0x7e,
-	0x14, 0x92, 0x30, 0x36, 0x17, 0xb6, 0x8d, 0x9d, 0x8a, 0xb3, 0x36, 0x4e, 0xac, 0x9a, 0x8a, 0x4b,
-	0x11, 0x1b, 0x65, 0x4e, 0xfb, 0xe5, 0xaf, 0x1f, 0x58, 0x05, 0x4d, 0xbd, 0x60, 0xff, 0x64, 0x00,
-	0x30, 0x69, 0xe1, 0xcf, 0x31, 0x31, 0xfe, 0x07, 0x93, 0x7b, 0xa0, 0x94, 0xbb, 0x1d, 0xf4, 0x22,
-	0xde, 0x7f, 0xb1, 0x16, 0x04, 0x9f, 0xbb, 0x5d, 0x6c, 0x04, 0x26, 0x57, 0xc9, 0x0c, 0xe9, 0x9f,
-	0x0d, 0x70, 0xe1, 0x5a, 0xa7, 0xc3, 0x48, 0x07, 0xc7, 0xe4, 0xc6, 0xb1, 0xd7, 0xc5, 0x61, 0x87,
-	0x20, 0x1c, 0x93, 0x16, 0x23, 0xe2, 0xaa, 0x83, 0xaf, 0x81, 0xc5, 0x2e, 0xe6, 0x5d, 0x4d, 0xbf,
-	0x36, 0x4e, 0xac, 0x92, 0x9a, 0x40, 0x8c, 0xda, 0x48, 0x82, 0xf0, 0x75, 0x70, 0x5a, 0x38, 0x33,
-	0xcd, 0x74, 0x65, 0x9c, 0x58, 0xe5, 0xc9, 0xfd, 0xc9, 0x6c, 0xa4, 0x60, 0xa9, 0xc9, 0xa0, 0x1d,
-	0xd0, 0xd8, 0x6d, 0xf7, 0x22, 0xef, 0x48, 0xaa, 0x3c, 0xdd, 0x9f, 0x73, 0xa8, 0xd0, 0x44, 0x9a,
-	0x8e, 0xb0, 0x66, 0x78, 0xff, 0x66, 0x80, 0xf3, 0x73, 0x79, 0xdf, 0x15, 0xa4, 0x8f, 0x41, 0x95,
-	0xe8, 0x31, 0x97, 0xe1, 0x98, 0x70, 0xd3, 0x90, 0x75, 0x77, 0xa1, 0xa1, 0xb4, 0x6b, 0x88, 0x03,
-	0xd3, 0xd0, 0xcf, 0x91, 0xc6, 0x01, 0xf1, 0xae, 0x47, 0x34, 0x74, 0xae, 0x08, 0x81, 0x1f, 0x3e,
-	0xb1, 0xde, 0xee, 0xd0, 0xb8, 0x3b, 0x68, 0x37, 0xbc, 0x28, 0x68, 0xea, 0xe7, 0x8b, 0xfa, 0xb9,
-	0xcc, 0xfd, 0xa3, 0x66, 0x3c, 0xea, 0x13, 0x9e, 0xc6, 0x70, 0x54, 0x21, 0xb9, 0xc9, 0xf9, 0x8b,
-	0x2a, 0x31, 0xb3, 0x9a, 0x1e, 0x00, 0x93, 0xd6, 0x08, 0xaf, 0x81, 0xca, 0x14, 0x7b, 0xa9, 0xfd,
-	0x7f, 0x90, 0x47, 0xe5, 0x3c, 0x0f, 0xb8, 0x05, 0x8a, 0x52, 0x43, 0x37, 0x1c, 0xa8, 0x1a, 0x58,
-	0x44, 0xcb, 0x72, 0xe0, 0xce, 0x20, 0xb0, 0x0f, 0x01, 0x94, 0xd7, 0x90, 0xb8, 0x21, 0xd4, 0x81,
-	0x3d, 0x24, 0x31, 0xfc, 0x10, 0x54, 0x86, 0xe9, 0xa8, 0xcb, 0x49, 0x2c, 0x25, 0x2b, 0x3a, 0xe6,
-	0x38, 0xb1, 0xd6, 0xf5, 0x0a, 0xf2, 0xb0, 0x8d, 0xca, 0x99, 0x7d, 0x48, 0x62, 0xfb, 0xc7, 0x53,
-	0x60, 0x63, 0x6e, 0xbf, 0x7c, 0xc9, 0x42, 0xbe, 0x0a, 0x4a, 0x5f, 0x0e, 0xc4, 0xe3, 0x2b, 0x5f,
-	0xc7, 0xb9, 0x77, 0x5f, 0x0e, 0xb4, 0x11, 0x90, 0x96, 0x0a, 0xfc, 0x1c, 0x94, 0x65, 0x2b, 0xc7,
-	0xbe, 0xcf, 0x08, 0x4f, 0x9f, 0x64, 0xf5, 0x5c, 0xc7, 0x15, 0xf4, 0xae, 0x29, 0x34, 0x65, 0xe9,
-	0x6c, 0x4d, 0xbf, 0xcd, 0xf2, 0x19, 0x6c, 0x54, 0xea, 0x4f, 0x22, 0xe0, 0x1e, 0x28, 0x4e, 0x2e,
-	0x9d, 0x45, 0xa9, 0xd1, 0xfa, 0x38, 0xb1, 0x56, 0x74, 0x60, 0x76, 0x81, 0xa0, 0x89, 0xdb, 0xcc,
-	0x6e, 0x7f, 0x63, 0x80, 0xb5, 0x39, 0x1c, 0xe0, 0x3b, 0xe0, 0x4c, 0x4a, 0x5a, 0xa9, 0x04, 0xc7,
-	0x89, 0x55, 0xd5, 0x8f, 0xac, 0x94, 0x4b, 0xea, 0x02, 0x6f, 0x82, 0x15, 0xfd, 0x99, 0xdd, 0x5a,
-	0x5a, 0xa5, 0xad, 0x71, 0x62, 0x9d, 0x9b, 0x0a, 0xcb, 0x3c, 0x6c, 0x54, 0xc3, 0xd3, 0xb3, 0xda,
-	0xdf, 0x19, 0x60, 0xf3, 0xe4, 0x7b, 0xe3, 0x25, 0x77, 0x6f, 0x0f, 0x14, 0x67, 0xdf, 0xdd, 0x39,
-	0x91, 0x72, 0xef, 0xe9, 0x89, 0xdb, 0x8c, 0x48, 0x5f, 0x81, 0x9a, 0xfc, 0x07, 0xf0, 0x69, 0xdf,
-	0x17, 0xdd, 0xa8, 0x87, 0x43, 0x08, 0xc1, 0xe2, 0x11, 0x19, 0xa9, 0x5a, 0x2e, 0x22, 0xf9, 0x0d,
-	0xcf, 0x82, 0xa5, 0x2e, 0xa1, 0x9d, 0x6e, 0x2c, 0x67, 0x59, 0x40, 0xda, 0x82, 0xbb, 0xe0, 0x8c,
-	0x2a, 0x07, 0x2e, 0x9b, 0x4c, 0x69, 0x6f, 0x75, 0xea, 0x00, 0x88, 0xbf, 0x16, 0xce, 0xa2, 0xd8,
-	0x73, 0x94, 0xfa, 0x4d, 0xcd, 0x6f, 0x38, 0x1f, 0x3f, 0xfa, 0xab, 0x5e, 0x78, 0xf4, 0xb4, 0x6e,
-	0x3c, 0x7e, 0x5a, 0x37, 0xfe, 0x7c, 0x5a, 0x37, 0xbe, 0x7d, 0x56, 0x2f, 0x3c, 0x7e, 0x56, 0x2f,
-	0xfc, 0xfe, 0xac, 0x5e, 0xb8, 0x77, 0x39, 0xd7, 0x22, 0x0e, 0x30, 0x19, 0xde, 0xa6, 0x71, 0x97,
-	0xe1, 0xb0, 0xe9, 0x07, 0x5e, 0x17, 0xd3, 0xb0, 0x79, 0xac, 0xff, 0x10, 0xa9, 0x6e, 0xd1, 0x5e,
-	0x92, 0x7f, 0x6b, 0xae, 0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x6b, 0xaa, 0x0b, 0x92, 0x31, 0x0d,
-	0x00, 0x00,
+	// 2500 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcb, 0x6f, 0x23, 0xc7,
+	0xd1, 0xd7, 0x2c, 0x29, 0xad, 0x58, 0x94, 0x44, 0xaa, 0xf5, 0xd8, 0x59, 0xad, 0x97, 0x23, 0x8f,
+	0xbd, 0x9f, 0x65, 0x7f, 0x31, 0x99, 0x95, 0xed, 0x2c, 0xbc, 0x79, 0x00, 0xa2, 0xa4, 0xc5, 0x0a,
+	0xb1, 0x56, 0x4c, 0x4b, 0xbb, 0x0e, 0x7c, 0xc8, 0xa0, 0x39, 0xd3, 0x26, 0x27, 0x3b, 0x0f, 0x66,
+	0x7a, 0xc8, 0x95, 0x72, 0xc8, 0x2d, 0x40, 0x80, 0x5c, 0x7c, 0xf0, 0xc1, 0x97, 0x00, 0x3e, 0x06,
+	0xce, 0x25, 0x08, 0x10, 0x20, 0x39, 0xe5, 0x6a, 0x20, 0x08, 0xe2, 0x53, 0x10, 0xe4, 0x40, 0x27,
+	0x36, 0x10, 0xe4, 0xcc, 0xbf, 0x20, 0xe8, 0xc7, 0x90, 0x33, 0x23, 0x2a, 0x91, 0xd6, 0x40, 0x4e,
+	0x62, 0x57, 0xd5, 0xaf, 0xaa, 0xfa, 0xd7, 0xd5, 0xdd, 0x35, 0x2d, 0x58, 0x0f, 0x23, 0x62, 0x7b,
+	0xb4, 0x31, 0xb8, 0xdb, 0x90, 0xbf, 0xea, 0xbd, 0x28, 0x8c, 0x43, 0x54, 0x52, 0xa3, 0xc1, 0xdd,
+	0x8d, 0x9a, 0x1d, 0x32, 0x3f, 0x64, 0x8d, 0x36, 0x61, 0xdc, 0xae, 0x4d, 0x63, 0x72, 0xb7, 0x61,
+	0x87, 0x6e, 0x20, 0x4d, 0x37, 0x56, 0x3b, 0x61, 0x27, 0x14, 0x3f, 0x1b, 0xfc, 0x97, 0x92, 0xd6,
+	0x3a, 0x61, 0xd8, 0xf1, 0x68, 0x43, 0x8c, 0xda, 0xfd, 0xf7, 0x1b, 0x4e, 0x3f, 0x22, 0xb1, 0x1b,
+	0x26, 0x28, 0x23, 0xaf, 0x8f, 0x5d, 0x9f, 0xb2, 0x98, 0xf8, 0x3d, 0x69, 0x60, 0xfe, 0x79, 0x19,
+	0xe6, 0x5a, 0x24, 0x22, 0x3e, 0x43, 0xf7, 0xa0, 0x3c, 0x08, 0x63, 0x6a, 0xf5, 0x68, 0xe4, 0x86,
+	0x8e, 0xae, 0x6d, 0x6a, 0x5b, 0xc5, 0xe6, 0xfa, 0x68, 0x68, 0xa0, 0x33, 0xe2, 0x7b, 0xf7, 0xcd,
+	0x94, 0xd2, 0xc4, 0xc0, 0x47, 0x2d, 0x31, 0x40, 0x36, 0x2c, 0x09, 0x5d, 0xdc, 0x8d, 0x28, 0xeb,
+	0x86, 0x9e, 0xa3, 0x5f, 0xdb, 0xd4, 0xb6, 0x4a, 0xcd, 0x6f, 0x7d, 0x3a, 0x34, 0x66, 0xfe, 0x36,
+	0x34, 0x6e, 0xc9, 0xa9, 0x31, 0xe7, 0x69, 0xdd, 0x0d, 0x1b, 0x3e, 0x89, 0xbb, 0xf5, 0x77, 0x68,
+	0x87, 0xd8, 0x67, 0x7b, 0xd4, 0x1e, 0x0d, 0x8d, 0xb5, 0x94, 0xfb, 0xb1, 0x0b, 0x13, 0x2f, 0x72,
+	0xc1, 0x49, 0x32, 0x46, 0x1d, 0x58, 0x88, 0xe8, 0x33, 0x12, 0x39, 0x56, 0x9b, 0x04, 0x0e, 0xd3,
+	0x0b, 0x9b, 0x85, 0xad, 0xf2, 0xf6, 0x5a, 0x7d, 0xcc, 0x60, 0x1d, 0x0b, 0x75, 0x93, 0x04, 0x4e,
+	0xf3, 0x75, 0x1e, 0x79, 0x34, 0x34, 0x56, 0xa4, 0xeb, 0x34, 0xd0, 0xfc, 0xe4, 0x73, 0x63, 0x69,
+	0x62, 0xfa, 0x8e, 0xcb, 0x62, 0x5c, 0x8e, 0xc6, 0x63, 0x86, 0x6c, 0xd8, 0x50, 0xf6, 0x8e, 0xcb,
+	0xe2, 0xc8, 0x6d, 0xf7, 0x39, 0x9f, 0xd6, 0x33, 0x37, 0x70, 0xc2, 0x67, 0x7a, 0x51, 0xb0, 0x72,
+	0x67, 0x34, 0x34, 0x5e, 0xcc, 0xf8, 0x9e, 0x62, 0x6b, 0x62, 0x5d, 0x2a, 0xf7, 0x52, 0xba, 0x77,
+	0x85, 0x0a, 0xbd, 0x07, 0x65, 0x62, 0xdb, 0xb4, 0x17, 0x5b, 0x9e, 0xcb, 0x62, 0x7d, 0x56, 0x4c,
+	0xa6, 0x9a, 0x9a, 0xcc, 0x1e, 0x0d, 0x42, 0xbf, 0xf9, 0x8a, 0x9a, 0x87, 0x5a, 0x81, 0x14, 0x84,
+	0x4f, 0xa3, 0x24, 0x8c, 0xc4, 0x0c, 0x40, 0xaa, 0xf8, 0x6f, 0xbe, 0x1c, 0xcc, 0x23, 0xac, 0x6b,
+	0xbd, 0x1f, 0x11, 0x9b, 0xc7, 0xd4, 0xe7, 0x9e, 0x63, 0x39, 0xb2, 0x2e, 0x4c, 0xbc, 0x28, 0x04,
+	0x0f, 0xd4, 0x18, 0xdd, 0x87, 0x05, 0x69, 0xa1, 0x78, 0xb9, 0x2e, 0x78, 0xb9, 0x31, 0xe1, 0x3c,
+	0xad, 0x35, 0x71, 0x59, 0x0c, 0xd5, 0xe4, 0x19, 0xac, 0xfa, 0x6e, 0x60, 0x0d, 0x88, 0xe7, 0x3a,
+	0xbc, 0xa0, 0x12, 0x1f, 0xf3, 0x22, 0xcd, 0xe6, 0xe5, 0xd2, 0xbc, 0x25, 0xc3, 0x4c, 0x73, 0x64,
+	0xe2, 0x65, 0xdf, 0x0d, 0x9e, 0x70, 0x69, 0x8b, 0x46, 0x2a, 0xa8, 0x0d, 0x4b, 0x3e, 0x09, 0x1c,
+	0x12, 0x87, 0xd1, 0x99, 0x24, 0xbd, 0x74, 0x01, 0xe9, 0xaf, 0x29, 0xd2, 0x15, 0x11, 0x59, 0x54,
+	0x8e, 0xf7, 0xc5, 0xb1, 0x56, 0x50, 0xbf, 0x0d, 0x6b, 0x5d, 0x97, 0xc5, 0x61, 0xe4, 0xda, 0x96,
+	0xd8, 0x65, 0xc9, 0x66, 0x02, 0x4e, 0x0f, 0x5e, 0x49, 0x94, 0xc7, 0x5c, 0xa7, 0x76, 0x4f, 0x1d,
+	0x56, 0x7c, 0xea, 0xb8, 0x24, 0xc8, 0x22, 0xca, 0x02, 0xb1, 0x2c, 0x55, 0x69, 0xfb, 0xaf, 0xc3,
+	0xaa, 0x4f, 0x4e, 0x5d, 0xbf, 0xef, 0x5b, 0xbd, 0xc8, 0xb5, 0xa9, 0x84, 0x31, 0x7d, 0x41, 0x00,
+	0x90, 0xd2, 0xb5, 0xb8, 0x4a, 0xc0, 0x18, 0xcf, 0x2a, 0x41, 0xa4, 0x23, 0x31, 0x7d, 0x51, 0x66,
+	0xa5, 0x94, 0x87, 0x93, 0x50, 0x0c, 0xfd, 0x42, 0x83, 0x1b, 0x76, 0x3f, 0x8a, 0x68, 0x60, 0x9f,
+	0x59, 0x3d, 0xe2, 0x46, 0x56, 0x2f, 0x0a, 0x07, 0xae, 0x43, 0x23, 0xa6, 0x2f, 0x09, 0xe2, 0x36,
+	0x53, 0xc4, 0xed, 0x2a, 0xcb, 0x16, 0x71, 0xa3, 0x56, 0x62, 0xd7, 0xdc, 0x55, 0x44, 0xd6, 0x24,
+	0x91, 0x17, 0xb8, 0xe3, 0x8c, 0xde, 0x9c, 0xea, 0x40, 0x30, 0xbc, 0x66, 0x4f, 0x53, 0xa1, 0xdf,
+	0x6b, 0x70, 0x7b, 0xec, 0xd0, 0xa1, 0x03, 0x57, 0x9c, 0x7a, 0x93, 0xf3, 0x83, 0xe9, 0x15, 0x91,
+	0xe5, 0x9d, 0x29, 0x59, 0xee, 0x25, 0xe6, 0xe3, 0xd3, 0xa5, 0xf9, 0x48, 0xa5, 0xfa, 0x72, 0x2e,
+	0xd5, 0x69, 0x9e, 0x79, 0xc2, 0xb5, 0x8b, 0x7d, 0x89, 0xac, 0x6f, 0xd9, 0x17, 0xea, 0x19, 0x7a,
+	0x19, 0x96, 0xfa, 0xcc, 0xb1, 0x2d, 0xb7, 0x6d, 0x5b, 0x0e, 0x2f, 0x25, 0xbd, 0xca, 0x2b, 0x1f,
+	0x2f, 0x70, 0xe9, 0x41, 0xdb, 0x16, 0xe5, 0x85, 0x5e, 0x85, 0xaa, 0xd8, 0x34, 0x6e, 0xd0, 0xb1,
+	0x68, 0x40, 0xda, 0x1e, 0x75, 0xf4, 0xe5, 0x4d, 0x6d, 0x6b, 0x1e, 0x57, 0x12, 0xf9, 0xbe, 0x14,
+	0x73, 0x53, 0x32, 0xa0, 0x11, 0xe9, 0x70, 0x5b, 0xb5, 0x99, 0x90, 0x58, 0xdb, 0xca, 0x58, 0xae,
+	0xb6, 0xc1, 0x3d, 0xd0, 0x7d, 0x72, 0x6a, 0x89, 0xc3, 0x96, 0x9e, 0xc6, 0x34, 0x60, 0x7c, 0x72,
+	0x11, 0x89, 0x29, 0xd3, 0x57, 0x04, 0x84, 0xd7, 0xca, 0x93, 0x30, 0xa6, 0xfb, 0x89, 0x16, 0x73,
+	0x25, 0xc2, 0xb0, 0xa6, 0xca, 0xad, 0xdf, 0xf6, 0x5d, 0x26, 0x60, 0x7e, 0xe8, 0x50, 0x7d, 0x75,
+	0x53, 0xdb, 0x5a, 0xda, 0xae, 0xa5, 0x78, 0x96, 0xb5, 0x37, 0x36, 0x3b, 0x0c, 0x1d, 0x8a, 0x57,
+	0x7a, 0xe7, 0x85, 0xe8, 0x01, 0x54, 0x6d, 0x12, 0x38, 0x1e, 0xb5, 0xdc, 0x20, 0xa6, 0xd1, 0x80,
+	0x78, 0x4c, 0x5f, 0xdb, 0x2c, 0x6c, 0x15, 0x9b, 0xb7, 0x46, 0x43, 0xe3, 0x86, 0x5a, 0x8b, 0x9c,
+	0x85, 0x89, 0x2b, 0x52, 0x74, 0x90, 0x48, 0xd0, 0x2e, 0x54, 0x92, 0x02, 0x97, 0x2a, 0xa6, 0xaf,
+	0x8b, 0xf3, 0x68, 0x63, 0x34, 0x34, 0xd6, 0x93, 0x6d, 0x9c, 0x31, 0x30, 0xf1, 0x92, 0x92, 0xec,
+	0x4a, 0x01, 0x7a, 0x17, 0xd6, 0x13, 0x1b, 0x4e, 0x07, 0xdf, 0x23, 0x1e, 0x0d, 0x28, 0x63, 0xfa,
+	0x0d, 0xe1, 0xeb, 0xc5, 0xd1, 0xd0, 0xb8, 0x9d, 0xf5, 0x95, 0xb5, 0x33, 0x71, 0xb2, 0x31, 0x39,
+	0x63, 0xc7, 0x89, 0x18, 0x31, 0x91, 0x9d, 0xda, 0xac, 0x7e, 0x38, 0xa0, 0x4c, 0xd7, 0x45, 0x6d,
+	0xde, 0x48, 0x71, 0x76, 0x48, 0x4e, 0x05, 0x6d, 0x87, 0xe1, 0x80, 0x36, 0xb7, 0x55, 0x35, 0x4e,
+	0x52, 0x4f, 0xa3, 0x79, 0xfd, 0x55, 0xd3, 0xf6, 0xc9, 0x49, 0x34, 0x91, 0x30, 0x34, 0x80, 0x8a,
+	0x28, 0x2d, 0x2b, 0x1c, 0xd0, 0x28, 0x72, 0x1d, 0xca, 0xf4, 0x9b, 0x22, 0xa8, 0x9e, 0x3f, 0xef,
+	0x8e, 0x94, 0x41, 0xf3, 0x8d, 0x6c, 0xd4, 0x1c, 0x9c, 0x47, 0x5d, 0xce, 0x00, 0x44, 0xd8, 0x25,
+	0x27, 0x2d, 0x12, 0x71, 0xc3, 0x7e, 0xec, 0xb9, 0x34, 0xb2, 0xde, 0x77, 0xbd, 0x98, 0x1f, 0x17,
+	0x1b, 0xe7, 0xe2, 0x1e, 0x49, 0x8b, 0x07, 0xc2, 0x20, 0x1f, 0x37, 0x07, 0x17, 0x71, 0x33, 0x00,
+	0x19, 0x37, 0x4c, 0x8b, 0xd8, 0xfd, 0xf9, 0x8f, 0x3e, 0x36, 0x66, 0xfe, 0xf5, 0xb1, 0xa1, 0x99,
+	0xbf, 0xd3, 0x60, 0x56, 0xee, 0xa0, 0x37, 0x01, 0x78, 0x37, 0xa5, 0xf6, 0x98, 0x26, 0x6e, 0x97,
+	0xb5, 0xd1, 0xd0, 0x58, 0x96, 0x81, 0x26, 0x3a, 0x13, 0x97, 0xf8, 0x40, 0xa2, 0xf8, 0xcd, 0x76,
+	0xe6, 0xb7, 0x43, 0x4f, 0xe1, 0x64, 0x2f, 0x93, 0xbe, 0xd9, 0x52, 0x5a, 0x7e, 0xb3, 0x89, 0xa1,
+	0xc4, 0x36, 0x60, 0x9e, 0x9e, 0xf6, 0xc2, 0x80, 0x06, 0xb1, 0x5e, 0xd8, 0xd4, 0xb6, 0x16, 0x9b,
+	0x2b, 0xa3, 0xa1, 0x51, 0x91, 0xb8, 0x44, 0x63, 0xe2, 0xb1, 0xd1, 0xfd, 0x85, 0x9f, 0x7d, 0x6c,
+	0xcc, 0xa8, 0xd4, 0x67, 0xcc, 0xdf, 0x68, 0x00, 0x93, 0xd6, 0xe4, 0x5c, 0x26, 0xda, 0x15, 0x32,
+	0x79, 0x0f, 0xca, 0xa9, 0xae, 0x47, 0x4d, 0xe2, 0xed, 0xcb, 0x5d, 0xad, 0xe8, 0x5c, 0xd7, 0x64,
+	0x62, 0x98, 0xb4, 0x48, 0xb9, 0xa4, 0x7f, 0xa5, 0xc1, 0x42, 0xba, 0x1a, 0xbf, 0x52, 0xda, 0xdf,
+	0x83, 0x79, 0x5e, 0xed, 0xbc, 0xce, 0x55, 0xce, 0xdf, 0xb8, 0x5c, 0xce, 0x95, 0xc9, 0x56, 0xe1,
+	0x60, 0x13, 0x5f, 0xf7, 0xc9, 0x29, 0x4f, 0x27, 0x97, 0xed, 0x9f, 0xae, 0xc1, 0x62, 0xa6, 0x8a,
+	0xbf, 0x52, 0xba, 0xff, 0x93, 0xce, 0xf7, 0x4d, 0x00, 0xd1, 0xe5, 0x84, 0x62, 0x37, 0x15, 0xc4,
+	0x61, 0x94, 0x2a, 0xe3, 0x89, 0xce, 0xc4, 0x25, 0xde, 0xf7, 0x88, 0xdf, 0xfc, 0x38, 0x4b, 0x35,
+	0xec, 0x96, 0xdf, 0xf7, 0x62, 0xb7, 0xc7, 0x77, 0x8c, 0x5e, 0xcc, 0x1f, 0x67, 0xd3, 0xed, 0x4c,
+	0xbc, 0x3a, 0xe9, 0xf1, 0x0f, 0xc7, 0xe2, 0x1c, 0x9f, 0x1f, 0x16, 0x60, 0x31, 0xb3, 0x3b, 0xbf,
+	0x2a, 0x9f, 0x3e, 0xc9, 0x24, 0xfb, 0x3c, 0x7c, 0x66, 0x5d, 0x98, 0xfc, 0x68, 0x4c, 0x4d, 0x00,
+	0xb9, 0x50, 0xf5, 0xc2, 0x67, 0x34, 0xe2, 0x53, 0xb6, 0x69, 0x10, 0xbb, 0x1e, 0x15, 0xac, 0x96,
+	0x9a, 0xdf, 0xb9, 0x5c, 0x18, 0x75, 0x31, 0xe5, 0x9d, 0x98, 0xb8, 0x22, 0x44, 0xad, 0xb1, 0x84,
+	0x87, 0xea, 0xf7, 0x7a, 0xd9, 0x50, 0xc5, 0xe7, 0x08, 0x95, 0x77, 0x62, 0xe2, 0x8a, 0x10, 0x4d,
+	0x42, 0xe5, 0x96, 0xe5, 0xb7, 0x1a, 0xbc, 0xb0, 0xd3, 0xe9, 0x44, 0xb4, 0x43, 0xf8, 0x4d, 0x6e,
+	0x77, 0x49, 0xd0, 0xa1, 0xfc, 0x5a, 0x6a, 0x45, 0x94, 0xaf, 0x2a, 0x7a, 0x09, 0x8a, 0x5d, 0xc2,
+	0xba, 0x6a, 0x75, 0x2a, 0xa3, 0xa1, 0x51, 0x96, 0xa1, 0xb8, 0xd4, 0xc4, 0x42, 0x89, 0xfe, 0x0f,
+	0x66, 0x45, 0x65, 0xa9, 0x55, 0xa8, 0x8e, 0x86, 0xc6, 0xc2, 0xa4, 0x64, 0x22, 0x13, 0x4b, 0xb5,
+	0x58, 0x72, 0x7e, 0xb3, 0xc7, 0x56, 0xdb, 0x0b, 0xed, 0xa7, 0xaa, 0x46, 0xd3, 0x4b, 0x9e, 0xd2,
+	0xf2, 0x25, 0x17, 0xc3, 0x26, 0x1f, 0xe5, 0xf2, 0xfe, 0xa3, 0x06, 0x37, 0xa7, 0xe6, 0xcd, 0xab,
+	0x1a, 0x9d, 0xc2, 0x12, 0x55, 0x32, 0xd5, 0xb2, 0x68, 0xe2, 0x6e, 0x79, 0xa1, 0x2e, 0x59, 0xac,
+	0xf3, 0x53, 0xbc, 0xae, 0x3e, 0x9e, 0xeb, 0x7b, 0xd4, 0xde, 0x0d, 0xdd, 0x40, 0xde, 0x2f, 0x9f,
+	0x7c, 0x6e, 0xfc, 0x7f, 0xc7, 0x8d, 0xbb, 0xfd, 0x76, 0xdd, 0x0e, 0xfd, 0x86, 0xfa, 0xd8, 0x96,
+	0x7f, 0x5e, 0x67, 0xce, 0xd3, 0x46, 0x7c, 0xd6, 0xa3, 0x2c, 0xc1, 0x30, 0xbc, 0x48, 0x53, 0xc1,
+	0xd9, 0x65, 0x99, 0xc8, 0xcd, 0xc6, 0x03, 0x98, 0xf4, 0xe1, 0x68, 0x07, 0x16, 0x33, 0xd9, 0x0b,
+	0xee, 0xff, 0x4b, 0xf2, 0x78, 0x21, 0x9d, 0x07, 0xba, 0x05, 0x25, 0xc1, 0xa1, 0x15, 0xf4, 0xe5,
+	0xc5, 0x54, 0xc4, 0xf3, 0x42, 0xf0, 0xa8, 0xef, 0x9b, 0x7f, 0xd1, 0x00, 0xa5, 0x29, 0x7b, 0xdc,
+	0x73, 0x38, 0x66, 0x15, 0x66, 0x53, 0x1b, 0x11, 0xcb, 0x01, 0x7a, 0x98, 0x4f, 0x46, 0x4e, 0xec,
+	0xa5, 0x4b, 0x94, 0xe5, 0x7f, 0xca, 0xa9, 0x90, 0xcd, 0x09, 0xed, 0x02, 0x48, 0x25, 0x7f, 0x77,
+	0x10, 0xa5, 0x5f, 0xde, 0xde, 0xa8, 0xcb, 0x47, 0x89, 0x7a, 0xf2, 0x28, 0x51, 0x3f, 0x49, 0x1e,
+	0x25, 0x9a, 0xf3, 0x3c, 0xfe, 0x07, 0x9f, 0x1b, 0x1a, 0x96, 0x4e, 0xb9, 0xc6, 0x1c, 0xe6, 0x26,
+	0x76, 0x1c, 0x93, 0xb8, 0xcf, 0xd0, 0x1e, 0x94, 0x3d, 0xc2, 0x62, 0xab, 0x2f, 0xe6, 0xa9, 0xd8,
+	0xbc, 0x9d, 0x6a, 0x33, 0xce, 0x93, 0xd1, 0x2c, 0x72, 0xff, 0x18, 0x38, 0x4e, 0xd1, 0x73, 0x1b,
+	0x80, 0x74, 0xa8, 0x2c, 0x4d, 0xa6, 0x38, 0x2d, 0x91, 0x0e, 0x15, 0xd5, 0xc9, 0xd0, 0x5b, 0x50,
+	0x20, 0x1d, 0x79, 0x3e, 0x94, 0xb7, 0x6f, 0x9e, 0xcb, 0x7c, 0x4f, 0x3d, 0xb7, 0xc8, 0xc4, 0x3f,
+	0xe2, 0x89, 0x73, 0x7b, 0x4e, 0xba, 0xe8, 0x0b, 0xc5, 0x94, 0xe7, 0xb1, 0x1c, 0xa0, 0x75, 0x98,
+	0xeb, 0x12, 0x2f, 0xa6, 0x8e, 0x3e, 0x2b, 0xc4, 0x6a, 0x64, 0xfe, 0x53, 0x83, 0x92, 0x28, 0x94,
+	0x87, 0xc4, 0x8b, 0x2f, 0x58, 0xb0, 0x3d, 0x28, 0x4b, 0xeb, 0x2b, 0x2f, 0x17, 0x48, 0x9c, 0x58,
+	0xac, 0x87, 0xb0, 0x18, 0xd1, 0x1f, 0x52, 0x7b, 0xec, 0xa7, 0x70, 0x85, 0x65, 0x4f, 0x90, 0xe7,
+	0x97, 0xbd, 0x98, 0x5b, 0xf6, 0x75, 0x98, 0x8b, 0x28, 0x61, 0x61, 0x20, 0x26, 0x5a, 0xc2, 0x6a,
+	0x64, 0xfe, 0xe1, 0x1a, 0xcc, 0xc9, 0x7e, 0xfb, 0x82, 0x59, 0x6e, 0xc0, 0x7c, 0xd2, 0xe8, 0x27,
+	0xf5, 0x9d, 0x8c, 0x91, 0x01, 0x65, 0x16, 0x93, 0x28, 0x73, 0xc8, 0x60, 0x10, 0x22, 0xb1, 0x58,
+	0xe8, 0x1e, 0x14, 0xc3, 0x1e, 0x0d, 0xf4, 0xe2, 0xe5, 0xe7, 0x24, 0x00, 0x1c, 0xd8, 0x75, 0x3b,
+	0x5d, 0x7d, 0xf6, 0x0a, 0x40, 0x0e, 0xe0, 0xd5, 0xe1, 0x85, 0xcf, 0xf4, 0xb9, 0xcb, 0xe3, 0xb8,
+	0x3d, 0x7a, 0x1b, 0x66, 0x6d, 0x2f, 0x64, 0x54, 0xbf, 0x7e, 0x79, 0xa0, 0x44, 0x98, 0x1f, 0x15,
+	0xa1, 0x92, 0x9c, 0x29, 0xb1, 0xcb, 0x62, 0xd7, 0x66, 0x17, 0x50, 0x79, 0x1b, 0x20, 0xe8, 0xfb,
+	0xc9, 0xa7, 0xbe, 0x2a, 0xec, 0xa0, 0xef, 0xab, 0x0f, 0xfc, 0xb7, 0xa0, 0xe0, 0xbb, 0xc1, 0x55,
+	0xd6, 0x9f, 0xdb, 0x0b, 0x18, 0x39, 0xbd, 0x0a, 0xc5, 0xdc, 0x9e, 0x33, 0xec, 0x53, 0x12, 0x5c,
+	0x89, 0x61, 0x0e, 0x40, 0xdf, 0x84, 0x39, 0xf9, 0x66, 0x71, 0x15, 0x92, 0x15, 0x04, 0x61, 0x40,
+	0x2c, 0x26, 0x81, 0x23, 0x1e, 0xe8, 0x92, 0x0f, 0xf1, 0xab, 0x90, 0xbe, 0x9c, 0xc0, 0xc7, 0x9f,
+	0xf1, 0xe8, 0x04, 0x56, 0x22, 0x4a, 0x3c, 0xf7, 0xc7, 0xd4, 0xb1, 0x06, 0xa1, 0x47, 0x62, 0xd7,
+	0x73, 0xe3, 0x33, 0x7d, 0xfe, 0xf2, 0x4e, 0x51, 0x82, 0x7f, 0x32, 0x86, 0xa3, 0x26, 0x94, 0x27,
+	0xb7, 0x3b, 0x53, 0x4f, 0x53, 0x1b, 0xf9, 0x6f, 0xea, 0xc9, 0x75, 0xaf, 0x0e, 0xb2, 0x34, 0xc8,
+	0xf4, 0xa0, 0x92, 0xb3, 0x42, 0x35, 0x80, 0x89, 0x85, 0x28, 0x8f, 0x45, 0x9c, 0x92, 0xf0, 0x42,
+	0x1c, 0x10, 0xaf, 0x7f, 0xa5, 0xe3, 0x44, 0x22, 0xcc, 0x63, 0x40, 0xe2, 0x85, 0x8d, 0xc4, 0x61,
+	0x24, 0xbf, 0x59, 0x8e, 0x69, 0x8c, 0xbe, 0x0d, 0x8b, 0x83, 0x44, 0x6a, 0x31, 0x1a, 0x8b, 0x0b,
+	0xba, 0xd4, 0xd4, 0x47, 0x43, 0x63, 0x55, 0xdd, 0x97, 0x69, 0xb5, 0x89, 0x17, 0xc6, 0xe3, 0x63,
+	0x1a, 0x9b, 0xbf, 0xbc, 0x06, 0x6b, 0x53, 0x9f, 0x82, 0x9e, 0xf3, 0x5b, 0xee, 0x1e, 0x94, 0x7f,
+	0xd4, 0x0f, 0x63, 0xa5, 0x52, 0xb3, 0x4c, 0x3d, 0x69, 0xa7, 0x94, 0x26, 0x06, 0x31, 0x92, 0xc0,
+	0x1f, 0xc0, 0x82, 0x78, 0xa5, 0x22, 0x8e, 0x13, 0x51, 0x96, 0xbc, 0x36, 0x67, 0x1e, 0x39, 0x88,
+	0x1b, 0xed, 0x48, 0x6d, 0x92, 0x65, 0xf3, 0x56, 0xf6, 0xd9, 0x39, 0xed, 0xc1, 0xc4, 0xe5, 0xde,
+	0x04, 0x81, 0xb6, 0xa1, 0x34, 0x79, 0x4f, 0x2b, 0x0a, 0x8e, 0x56, 0x47, 0x43, 0xa3, 0xaa, 0x80,
+	0x89, 0xca, 0xc4, 0x13, 0xb3, 0x5c, 0x6f, 0xf1, 0x73, 0x0d, 0x56, 0xa6, 0xe4, 0x80, 0xbe, 0x06,
+	0xd7, 0x93, 0xa4, 0x25, 0x4b, 0x68, 0x34, 0x34, 0x96, 0xa4, 0xdf, 0x71, 0x2e, 0x89, 0x09, 0x7f,
+	0x81, 0x51, 0x3f, 0xc7, 0x0f, 0x72, 0x8a, 0xa5, 0xd4, 0x0b, 0x4c, 0xde, 0xc2, 0xc4, 0x15, 0x92,
+	0x8d, 0x6a, 0x7e, 0xa8, 0xc1, 0xc6, 0xc5, 0x4f, 0x62, 0xcf, 0xb9, 0x7a, 0xdb, 0x50, 0xca, 0x7f,
+	0x58, 0xa5, 0x48, 0x4a, 0x7d, 0x30, 0x4d, 0xcc, 0x72, 0x24, 0xfd, 0x04, 0x2a, 0xe2, 0x9f, 0x1b,
+	0xf2, 0xae, 0x6f, 0x79, 0x24, 0x40, 0x08, 0x8a, 0x4f, 0xe9, 0x99, 0xec, 0x1c, 0x4b, 0x58, 0xfc,
+	0x16, 0xf7, 0x32, 0x75, 0x3b, 0xdd, 0x58, 0x44, 0x29, 0x60, 0x35, 0x42, 0x77, 0xe1, 0xba, 0xec,
+	0x20, 0x98, 0x6a, 0x00, 0x96, 0x33, 0x05, 0xc0, 0xff, 0x6b, 0xa2, 0x36, 0x62, 0x62, 0x97, 0x89,
+	0xaf, 0xbd, 0xf6, 0x6b, 0xbe, 0x48, 0x53, 0x1e, 0xbe, 0xee, 0xc0, 0x8b, 0x2d, 0x7c, 0xb0, 0xbb,
+	0x6f, 0x1d, 0x3f, 0x6e, 0x1e, 0x1e, 0x1c, 0x1f, 0x1f, 0x1c, 0x3d, 0xb2, 0x0e, 0x8f, 0xf6, 0xf6,
+	0xad, 0xc7, 0x8f, 0x8e, 0x5b, 0xfb, 0xbb, 0x07, 0x0f, 0x0e, 0xf6, 0xf7, 0xaa, 0x33, 0xe8, 0x15,
+	0x78, 0x69, 0xba, 0xd9, 0xee, 0xd1, 0xe1, 0xe1, 0xc1, 0x89, 0x85, 0xf7, 0x9f, 0xec, 0xef, 0xbc,
+	0x53, 0xd5, 0xd0, 0xab, 0x70, 0x67, 0xba, 0xe1, 0x93, 0xa3, 0x93, 0x7d, 0x6b, 0xff, 0xfb, 0x27,
+	0xfb, 0x8f, 0xb8, 0xec, 0xb8, 0x7a, 0x0d, 0xd5, 0x60, 0x63, 0xba, 0x69, 0xf3, 0xe8, 0xe4, 0x61,
+	0xb5, 0xf0, 0xda, 0x4f, 0x35, 0x28, 0xcb, 0x94, 0xc3, 0x7e, 0x64, 0x53, 0xf4, 0x02, 0xe8, 0xca,
+	0xfe, 0xe8, 0x31, 0xde, 0xcd, 0x67, 0xb8, 0x06, 0xcb, 0x19, 0xed, 0x71, 0xeb, 0xe8, 0xa4, 0xaa,
+	0xa1, 0x55, 0xa8, 0x66, 0xc5, 0x87, 0x3b, 0xd5, 0x6b, 0xe7, 0xa4, 0xfb, 0x87, 0x3b, 0xd5, 0xc2,
+	0x39, 0x17, 0x27, 0xef, 0xee, 0xb4, 0xaa, 0xc5, 0xe6, 0x77, 0x3f, 0xfd, 0x47, 0x6d, 0xe6, 0xd3,
+	0x2f, 0x6a, 0xda, 0x67, 0x5f, 0xd4, 0xb4, 0xbf, 0x7f, 0x51, 0xd3, 0x3e, 0xf8, 0xb2, 0x36, 0xf3,
+	0xd9, 0x97, 0xb5, 0x99, 0xbf, 0x7e, 0x59, 0x9b, 0x79, 0xef, 0xf5, 0x54, 0x2f, 0xbf, 0x47, 0xe8,
+	0xe0, 0xd0, 0x8d, 0xbb, 0x11, 0x09, 0x1a, 0x8e, 0x6f, 0x77, 0x89, 0x1b, 0x34, 0x4e, 0xd5, 0xff,
+	0xd9, 0x64, 0x5b, 0xdf, 0x9e, 0x13, 0x0d, 0xdb, 0x1b, 0xff, 0x1e, 0x00, 0x83, 0x46, 0x19, 0x9b,
+	0x88, 0x1b, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -703,36 +1293,80 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.AveragingWindow != that1.AveragingWindow {
 		return false
 	}
-	return true
-}
-func (this *ParamUpdatePlan) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if this.MaxVoteExtensionRates != that1.MaxVoteExtensionRates {
+		return false
 	}
-
-	that1, ok := that.(*ParamUpdatePlan)
-	if !ok {
-		that2, ok := that.(ParamUpdatePlan)
-		if ok {
-			that1 = &that2
-		} else {
+	if this.PriceSubmissionMode != that1.PriceSubmissionMode {
+		return false
+	}
+	if len(this.CandleIntervals) != len(that1.CandleIntervals) {
+		return false
+	}
+	for i := range this.CandleIntervals {
+		if this.CandleIntervals[i] != that1.CandleIntervals[i] {
 			return false
 		}
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if this.MaximumCandles != that1.MaximumCandles {
 		return false
 	}
-	if len(this.Keys) != len(that1.Keys) {
+	if this.MaximumRateStaleness != that1.MaximumRateStaleness {
 		return false
 	}
-	for i := range this.Keys {
-		if this.Keys[i] != that1.Keys[i] {
+	if len(this.MaxPriceMoves) != len(that1.MaxPriceMoves) {
+		return false
+	}
+	for i := range this.MaxPriceMoves {
+		if !this.MaxPriceMoves[i].Equal(&that1.MaxPriceMoves[i]) {
 			return false
 		}
 	}
-	if this.Height != that1.Height {
+	if len(this.DenomOverrides) != len(that1.DenomOverrides) {
+		return false
+	}
+	for i := range this.DenomOverrides {
+		if !this.DenomOverrides[i].Equal(&that1.DenomOverrides[i]) {
+			return false
+		}
+	}
+	if len(this.OutlierFilters) != len(that1.OutlierFilters) {
+		return false
+	}
+	for i := range this.OutlierFilters {
+		if !this.OutlierFilters[i].Equal(&that1.OutlierFilters[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ParamUpdatePlan) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ParamUpdatePlan)
+	if !ok {
+		that2, ok := that.(ParamUpdatePlan)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Keys) != len(that1.Keys) {
+		return false
+	}
+	for i := range this.Keys {
+		if this.Keys[i] != that1.Keys[i] {
+			return false
+		}
+	}
+	if this.Height != that1.Height {
 		return false
 	}
 	if !this.Changes.Equal(&that1.Changes) {
@@ -760,6 +1394,102 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OutlierFilters) > 0 {
+		for iNdEx := len(m.OutlierFilters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutlierFilters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.DenomOverrides) > 0 {
+		for iNdEx := len(m.DenomOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.MaxPriceMoves) > 0 {
+		for iNdEx := len(m.MaxPriceMoves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxPriceMoves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.MaximumRateStaleness != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaximumRateStaleness))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.MaximumCandles != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaximumCandles))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.CandleIntervals) > 0 {
+		dAtA2 := make([]byte, len(m.CandleIntervals)*10)
+		var j1 int
+		for _, num := range m.CandleIntervals {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintOracle(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.PriceSubmissionMode != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PriceSubmissionMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.MaxVoteExtensionRates != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxVoteExtensionRates))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
 	if m.AveragingWindow != 0 {
 		i = encodeVarintOracle(dAtA, i, uint64(m.AveragingWindow))
 		i--
@@ -1008,6 +1738,156 @@ func (m *RewardBand) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MaxPriceMove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaxPriceMove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaxPriceMove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxMove.Size()
+		i -= size
+		if _, err := m.MaxMove.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.SymbolDenom) > 0 {
+		i -= len(m.SymbolDenom)
+		copy(dAtA[i:], m.SymbolDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.SymbolDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotePeriodMultiplier != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriodMultiplier))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinVoters != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinVoters))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.VoteThreshold.Size()
+		i -= size
+		if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.SymbolDenom) > 0 {
+		i -= len(m.SymbolDenom)
+		copy(dAtA[i:], m.SymbolDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.SymbolDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutlierFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutlierFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutlierFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.UpperPercentile.Size()
+		i -= size
+		if _, err := m.UpperPercentile.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LowerPercentile.Size()
+		i -= size
+		if _, err := m.LowerPercentile.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MadMultiplier.Size()
+		i -= size
+		if _, err := m.MadMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.SymbolDenom) > 0 {
+		i -= len(m.SymbolDenom)
+		copy(dAtA[i:], m.SymbolDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.SymbolDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1134,7 +2014,7 @@ func (m *PriceStamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorRewardSet) Marshal() (dAtA []byte, err error) {
+func (m *ExchangeRateUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1144,29 +2024,50 @@ func (m *ValidatorRewardSet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorRewardSet) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExchangeRateUpdate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorRewardSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExchangeRateUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValidatorSet) > 0 {
-		for iNdEx := len(m.ValidatorSet) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ValidatorSet[iNdEx])
-			copy(dAtA[i:], m.ValidatorSet[iNdEx])
-			i = encodeVarintOracle(dAtA, i, uint64(len(m.ValidatorSet[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintOracle(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if m.BlockNum != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.BlockNum))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CurrencyPairProviders) Marshal() (dAtA []byte, err error) {
+func (m *ExchangeRateStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1176,57 +2077,63 @@ func (m *CurrencyPairProviders) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CurrencyPairProviders) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExchangeRateStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CurrencyPairProviders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExchangeRateStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Providers) > 0 {
-		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Providers[iNdEx])
-			copy(dAtA[i:], m.Providers[iNdEx])
-			i = encodeVarintOracle(dAtA, i, uint64(len(m.Providers[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.PairAddress) > 0 {
-		for iNdEx := len(m.PairAddress) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PairAddress[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-	}
-	if len(m.QuoteDenom) > 0 {
-		i -= len(m.QuoteDenom)
-		copy(dAtA[i:], m.QuoteDenom)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.QuoteDenom)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x20
 	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.BaseDenom)))
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Age, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Age):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintOracle(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if m.AgeBlocks != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AgeBlocks))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.LastUpdate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *PairAddressProvider) Marshal() (dAtA []byte, err error) {
+func (m *PriceHalt) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1236,34 +2143,59 @@ func (m *PairAddressProvider) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PairAddressProvider) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceHalt) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PairAddressProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceHalt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AddressProvider) > 0 {
-		i -= len(m.AddressProvider)
-		copy(dAtA[i:], m.AddressProvider)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.AddressProvider)))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Reason)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x2a
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Address)))
+	if m.BlockNum != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.BlockNum))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.RejectedRate.Size()
+		i -= size
+		if _, err := m.RejectedRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.HaltedRate.Size()
+		i -= size
+		if _, err := m.HaltedRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CurrencyDeviationThreshold) Marshal() (dAtA []byte, err error) {
+func (m *Candle) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1273,34 +2205,77 @@ func (m *CurrencyDeviationThreshold) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CurrencyDeviationThreshold) MarshalTo(dAtA []byte) (int, error) {
+func (m *Candle) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CurrencyDeviationThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Candle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Threshold) > 0 {
-		i -= len(m.Threshold)
-		copy(dAtA[i:], m.Threshold)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Threshold)))
+	{
+		size := m.Close.Size()
+		i -= size
+		if _, err := m.Close.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Low.Size()
+		i -= size
+		if _, err := m.Low.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.High.Size()
+		i -= size
+		if _, err := m.High.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Open.Size()
+		i -= size
+		if _, err := m.Open.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.StartBlock != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.StartBlock))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.BaseDenom)))
+	if m.Interval != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ParamUpdatePlan) Marshal() (dAtA []byte, err error) {
+func (m *PriceStatistics) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1310,88 +2285,401 @@ func (m *ParamUpdatePlan) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ParamUpdatePlan) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceStatistics) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ParamUpdatePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Percentiles) > 0 {
+		for iNdEx := len(m.Percentiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Percentiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	{
-		size, err := m.Changes.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.RealizedVolatility.Size()
+		i -= size
+		if _, err := m.RealizedVolatility.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.StandardDeviation.Size()
+		i -= size
+		if _, err := m.StandardDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Median.Size()
+		i -= size
+		if _, err := m.Median.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Mean.Size()
+		i -= size
+		if _, err := m.Mean.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Max.Size()
+		i -= size
+		if _, err := m.Max.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Min.Size()
 		i -= size
+		if _, err := m.Min.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
-	if m.Height != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Height))
+	if m.NumStamps != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.NumStamps))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Keys) > 0 {
-		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Keys[iNdEx])
-			copy(dAtA[i:], m.Keys[iNdEx])
-			i = encodeVarintOracle(dAtA, i, uint64(len(m.Keys[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PricePercentile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PricePercentile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PricePercentile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.VotePeriod != 0 {
-		n += 1 + sovOracle(uint64(m.VotePeriod))
-	}
-	l = m.VoteThreshold.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if len(m.RewardBands) > 0 {
-		for _, e := range m.RewardBands {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	if m.RewardDistributionWindow != 0 {
-		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
-	}
-	if len(m.AcceptList) > 0 {
-		for _, e := range m.AcceptList {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x12
+	if m.Percentile != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Percentile))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.SlashFraction.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.SlashWindow != 0 {
-		n += 1 + sovOracle(uint64(m.SlashWindow))
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRewardSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.MinValidPerWindow.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if len(m.MandatoryList) > 0 {
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewardSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewardSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorSet) > 0 {
+		for iNdEx := len(m.ValidatorSet) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorSet[iNdEx])
+			copy(dAtA[i:], m.ValidatorSet[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.ValidatorSet[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CurrencyPairProviders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrencyPairProviders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrencyPairProviders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Providers[iNdEx])
+			copy(dAtA[i:], m.Providers[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Providers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PairAddress) > 0 {
+		for iNdEx := len(m.PairAddress) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairAddress[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PairAddressProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairAddressProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairAddressProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AddressProvider) > 0 {
+		i -= len(m.AddressProvider)
+		copy(dAtA[i:], m.AddressProvider)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.AddressProvider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CurrencyDeviationThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrencyDeviationThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrencyDeviationThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamUpdatePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamUpdatePlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamUpdatePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Changes.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriod))
+	}
+	l = m.VoteThreshold.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if len(m.RewardBands) > 0 {
+		for _, e := range m.RewardBands {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.RewardDistributionWindow != 0 {
+		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
+	}
+	if len(m.AcceptList) > 0 {
+		for _, e := range m.AcceptList {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.SlashWindow != 0 {
+		n += 1 + sovOracle(uint64(m.SlashWindow))
+	}
+	l = m.MinValidPerWindow.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if len(m.MandatoryList) > 0 {
 		for _, e := range m.MandatoryList {
 			l = e.Size()
 			n += 1 + l + sovOracle(uint64(l))
@@ -1431,11 +2719,48 @@ func (m *Params) Size() (n int) {
 	if m.AveragingWindow != 0 {
 		n += 2 + sovOracle(uint64(m.AveragingWindow))
 	}
-	return n
-}
-
-func (m *Denom) Size() (n int) {
-	if m == nil {
+	if m.MaxVoteExtensionRates != 0 {
+		n += 2 + sovOracle(uint64(m.MaxVoteExtensionRates))
+	}
+	if m.PriceSubmissionMode != 0 {
+		n += 2 + sovOracle(uint64(m.PriceSubmissionMode))
+	}
+	if len(m.CandleIntervals) > 0 {
+		l = 0
+		for _, e := range m.CandleIntervals {
+			l += sovOracle(uint64(e))
+		}
+		n += 2 + sovOracle(uint64(l)) + l
+	}
+	if m.MaximumCandles != 0 {
+		n += 2 + sovOracle(uint64(m.MaximumCandles))
+	}
+	if m.MaximumRateStaleness != 0 {
+		n += 2 + sovOracle(uint64(m.MaximumRateStaleness))
+	}
+	if len(m.MaxPriceMoves) > 0 {
+		for _, e := range m.MaxPriceMoves {
+			l = e.Size()
+			n += 2 + l + sovOracle(uint64(l))
+		}
+	}
+	if len(m.DenomOverrides) > 0 {
+		for _, e := range m.DenomOverrides {
+			l = e.Size()
+			n += 2 + l + sovOracle(uint64(l))
+		}
+	}
+	if len(m.OutlierFilters) > 0 {
+		for _, e := range m.OutlierFilters {
+			l = e.Size()
+			n += 2 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Denom) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1469,6 +2794,61 @@ func (m *RewardBand) Size() (n int) {
 	return n
 }
 
+func (m *MaxPriceMove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SymbolDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.MaxMove.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *DenomOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SymbolDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.VoteThreshold.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.MinVoters != 0 {
+		n += 1 + sovOracle(uint64(m.MinVoters))
+	}
+	if m.VotePeriodMultiplier != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriodMultiplier))
+	}
+	return n
+}
+
+func (m *OutlierFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SymbolDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.MadMultiplier.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.LowerPercentile.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.UpperPercentile.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
 func (m *AggregateExchangeRatePrevote) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1524,6 +2904,147 @@ func (m *PriceStamp) Size() (n int) {
 	return n
 }
 
+func (m *ExchangeRateUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.BlockNum != 0 {
+		n += 1 + sovOracle(uint64(m.BlockNum))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *ExchangeRateStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LastUpdate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.AgeBlocks != 0 {
+		n += 1 + sovOracle(uint64(m.AgeBlocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Age)
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Stale {
+		n += 2
+	}
+	if m.Halted {
+		n += 2
+	}
+	return n
+}
+
+func (m *PriceHalt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.HaltedRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.RejectedRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.BlockNum != 0 {
+		n += 1 + sovOracle(uint64(m.BlockNum))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *Candle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovOracle(uint64(m.Interval))
+	}
+	if m.StartBlock != 0 {
+		n += 1 + sovOracle(uint64(m.StartBlock))
+	}
+	l = m.Open.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.High.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Low.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Close.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *PriceStatistics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.NumStamps != 0 {
+		n += 1 + sovOracle(uint64(m.NumStamps))
+	}
+	l = m.Min.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Max.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Mean.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Median.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.StandardDeviation.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.RealizedVolatility.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if len(m.Percentiles) > 0 {
+		for _, e := range m.Percentiles {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PricePercentile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Percentile != 0 {
+		n += 1 + sovOracle(uint64(m.Percentile))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
 func (m *ValidatorRewardSet) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1628,7 +3149,1623 @@ func sovOracle(x uint64) (n int) {
 func sozOracle(x uint64) (n int) {
 	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBands", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardBands = append(m.RewardBands, RewardBand{})
+			if err := m.RewardBands[len(m.RewardBands)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
+			}
+			m.RewardDistributionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptList = append(m.AcceptList, Denom{})
+			if err := m.AcceptList[len(m.AcceptList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+			}
+			m.SlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MandatoryList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MandatoryList = append(m.MandatoryList, Denom{})
+			if err := m.MandatoryList[len(m.MandatoryList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricStampPeriod", wireType)
+			}
+			m.HistoricStampPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricStampPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianStampPeriod", wireType)
+			}
+			m.MedianStampPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MedianStampPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPriceStamps", wireType)
+			}
+			m.MaximumPriceStamps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPriceStamps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumMedianStamps", wireType)
+			}
+			m.MaximumMedianStamps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumMedianStamps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairProviders = append(m.CurrencyPairProviders, CurrencyPairProviders{})
+			if err := m.CurrencyPairProviders[len(m.CurrencyPairProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyDeviationThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyDeviationThresholds = append(m.CurrencyDeviationThresholds, CurrencyDeviationThreshold{})
+			if err := m.CurrencyDeviationThresholds[len(m.CurrencyDeviationThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdcIbcDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsdcIbcDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SlashingEnabled = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AveragingWindow", wireType)
+			}
+			m.AveragingWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AveragingWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVoteExtensionRates", wireType)
+			}
+			m.MaxVoteExtensionRates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVoteExtensionRates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSubmissionMode", wireType)
+			}
+			m.PriceSubmissionMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceSubmissionMode |= PriceSubmissionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CandleIntervals = append(m.CandleIntervals, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthOracle
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthOracle
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CandleIntervals) == 0 {
+					m.CandleIntervals = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CandleIntervals = append(m.CandleIntervals, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandleIntervals", wireType)
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumCandles", wireType)
+			}
+			m.MaximumCandles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumCandles |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumRateStaleness", wireType)
+			}
+			m.MaximumRateStaleness = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumRateStaleness |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceMoves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxPriceMoves = append(m.MaxPriceMoves, MaxPriceMove{})
+			if err := m.MaxPriceMoves[len(m.MaxPriceMoves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomOverrides = append(m.DenomOverrides, DenomOverride{})
+			if err := m.DenomOverrides[len(m.DenomOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutlierFilters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutlierFilters = append(m.OutlierFilters, OutlierFilter{})
+			if err := m.OutlierFilters[len(m.OutlierFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Denom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardBand) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardBand: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardBand: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaxPriceMove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaxPriceMove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaxPriceMove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMove.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVoters", wireType)
+			}
+			m.MinVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriodMultiplier", wireType)
+			}
+			m.VotePeriodMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriodMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutlierFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutlierFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutlierFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MadMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MadMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerPercentile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LowerPercentile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperPercentile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpperPercentile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+			}
+			m.SubmitBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1651,17 +4788,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
 			}
-			m.VotePeriod = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1671,14 +4808,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotePeriod |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, types.DecCoin{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1706,13 +4858,61 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceStamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceStamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceStamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardBands", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1739,16 +4939,18 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardBands = append(m.RewardBands, RewardBand{})
-			if err := m.RewardBands[len(m.RewardBands)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ExchangeRate == nil {
+				m.ExchangeRate = &types.DecCoin{}
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNum", wireType)
 			}
-			m.RewardDistributionWindow = 0
+			m.BlockNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1758,16 +4960,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				m.BlockNum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeRateUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcceptList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1777,29 +5029,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AcceptList = append(m.AcceptList, Denom{})
-			if err := m.AcceptList[len(m.AcceptList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1827,15 +5077,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNum", wireType)
 			}
-			m.SlashWindow = 0
+			m.BlockNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1845,16 +5095,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashWindow |= uint64(b&0x7F) << shift
+				m.BlockNum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1864,29 +5114,78 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeRateStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MandatoryList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1913,16 +5212,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MandatoryList = append(m.MandatoryList, Denom{})
-			if err := m.MandatoryList[len(m.MandatoryList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricStampPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AgeBlocks", wireType)
 			}
-			m.HistoricStampPeriod = 0
+			m.AgeBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1932,16 +5230,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HistoricStampPeriod |= uint64(b&0x7F) << shift
+				m.AgeBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MedianStampPeriod", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Age", wireType)
 			}
-			m.MedianStampPeriod = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1951,16 +5249,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MedianStampPeriod |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Age, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPriceStamps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
 			}
-			m.MaximumPriceStamps = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1970,16 +5282,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaximumPriceStamps |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+			m.Stale = bool(v != 0)
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumMedianStamps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
 			}
-			m.MaximumMedianStamps = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1989,16 +5302,67 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaximumMedianStamps |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+			m.Halted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceHalt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceHalt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceHalt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2008,31 +5372,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrencyPairProviders = append(m.CurrencyPairProviders, CurrencyPairProviders{})
-			if err := m.CurrencyPairProviders[len(m.CurrencyPairProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyDeviationThresholds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2042,29 +5404,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrencyDeviationThresholds = append(m.CurrencyDeviationThresholds, CurrencyDeviationThreshold{})
-			if err := m.CurrencyDeviationThresholds[len(m.CurrencyDeviationThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HaltedRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsdcIbcDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2092,13 +5454,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UsdcIbcDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.RejectedRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 17:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNum", wireType)
 			}
-			var v int
+			m.BlockNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2108,17 +5472,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.BlockNum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SlashingEnabled = bool(v != 0)
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AveragingWindow", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.AveragingWindow = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2128,11 +5491,24 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AveragingWindow |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -2154,7 +5530,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Denom) Unmarshal(dAtA []byte) error {
+func (m *Candle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2177,15 +5553,15 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+			return fmt.Errorf("proto: Candle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Candle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2213,11 +5589,49 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+			}
+			m.StartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2245,13 +5659,83 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Open.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
 			}
-			m.Exponent = 0
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2261,11 +5745,26 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Exponent |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Close.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -2287,7 +5786,7 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardBand) Unmarshal(dAtA []byte) error {
+func (m *PriceStatistics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2310,15 +5809,15 @@ func (m *RewardBand) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardBand: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceStatistics: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardBand: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2346,11 +5845,30 @@ func (m *RewardBand) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumStamps", wireType)
+			}
+			m.NumStamps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumStamps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2378,63 +5896,47 @@ func (m *RewardBand) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Min.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Max.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2462,11 +5964,13 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			if err := m.Mean.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Median", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2494,13 +5998,15 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			if err := m.Median.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandardDeviation", wireType)
 			}
-			m.SubmitBlock = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2510,66 +6016,31 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubmitBlock |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.StandardDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedVolatility", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2579,31 +6050,31 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRates = append(m.ExchangeRates, types.DecCoin{})
-			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RealizedVolatility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentiles", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2613,23 +6084,25 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.Percentiles = append(m.Percentiles, PricePercentile{})
+			if err := m.Percentiles[len(m.Percentiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2652,7 +6125,7 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriceStamp) Unmarshal(dAtA []byte) error {
+func (m *PricePercentile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2675,17 +6148,17 @@ func (m *PriceStamp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceStamp: wiretype end group for non-group")
+			return fmt.Errorf("proto: PricePercentile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceStamp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PricePercentile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentile", wireType)
 			}
-			var msglen int
+			m.Percentile = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2695,33 +6168,16 @@ func (m *PriceStamp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Percentile |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ExchangeRate == nil {
-				m.ExchangeRate = &types.DecCoin{}
-			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNum", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.BlockNum = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2731,11 +6187,26 @@ func (m *PriceStamp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockNum |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
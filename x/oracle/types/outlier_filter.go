@@ -0,0 +1,65 @@
+package types
+
+import (
+	"strings"
+
+	"cosmossdk.io/math"
+	"gopkg.in/yaml.v3"
+)
+
+// String implements fmt.Stringer interface
+func (f OutlierFilter) String() string {
+	out, _ := yaml.Marshal(f)
+	return string(out)
+}
+
+func (f OutlierFilter) Equal(f2 *OutlierFilter) bool {
+	if !strings.EqualFold(f.SymbolDenom, f2.SymbolDenom) {
+		return false
+	}
+	return decEqual(f.MadMultiplier, f2.MadMultiplier) &&
+		decEqual(f.LowerPercentile, f2.LowerPercentile) &&
+		decEqual(f.UpperPercentile, f2.UpperPercentile)
+}
+
+// IsMAD returns whether the filter removes votes further than MadMultiplier
+// median absolute deviations from the median.
+func (f OutlierFilter) IsMAD() bool {
+	return !f.MadMultiplier.IsNil() && f.MadMultiplier.IsPositive()
+}
+
+// IsPercentileBand returns whether the filter removes votes outside the band
+// between LowerPercentile and UpperPercentile.
+func (f OutlierFilter) IsPercentileBand() bool {
+	return !f.UpperPercentile.IsNil() && f.UpperPercentile.IsPositive()
+}
+
+// OutlierFilterList is array of OutlierFilter
+type OutlierFilterList []OutlierFilter
+
+func (fl OutlierFilterList) String() (out string) {
+	for _, f := range fl {
+		out += f.String() + "\n"
+	}
+
+	return strings.TrimSpace(out)
+}
+
+// GetFilterFromDenom returns the outlier filter of a given denom, and false if
+// the ballots of the denom are not filtered.
+func (fl OutlierFilterList) GetFilterFromDenom(denom string) (OutlierFilter, bool) {
+	for _, f := range fl {
+		if strings.EqualFold(denom, f.SymbolDenom) {
+			return f, true
+		}
+	}
+	return OutlierFilter{}, false
+}
+
+// decEqual compares decimals which may be nil.
+func decEqual(d1, d2 math.LegacyDec) bool {
+	if d1.IsNil() || d2.IsNil() {
+		return d1.IsNil() == d2.IsNil()
+	}
+	return d1.Equal(d2)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	fmt "fmt"
+	"strings"
 
 	"cosmossdk.io/math"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -39,6 +40,14 @@ var (
 	KeyUsdcIbcDenom                = []byte("UsdcIbcDenom")
 	KeySlashingEnabled             = []byte("SlashingEnabled")
 	KeyAveragingWindow             = []byte("KeyAveragingWindow")
+	KeyMaxVoteExtensionRates       = []byte("MaxVoteExtensionRates")
+	KeyPriceSubmissionMode         = []byte("PriceSubmissionMode")
+	KeyCandleIntervals             = []byte("CandleIntervals")
+	KeyMaximumCandles              = []byte("MaximumCandles")
+	KeyMaximumRateStaleness        = []byte("MaximumRateStaleness")
+	KeyMaxPriceMoves               = []byte("MaxPriceMoves")
+	KeyDenomOverrides              = []byte("DenomOverrides")
+	KeyOutlierFilters              = []byte("OutlierFilters")
 )
 
 // Default parameter values
@@ -51,11 +60,19 @@ const (
 	DefaultMedianStampPeriod        = BlocksPerHour * 3   // window for 3 hours
 	DefaultMaximumMedianStamps      = 24                  // retain for 3 days
 	DefaultAveragingWindow          = 3
+	DefaultMaxVoteExtensionRates    = 100
+	DefaultMaximumCandles           = 168                 // retain a week of hourly candles
+	DefaultMaximumRateStaleness     = BlocksPerMinute * 5 // stale after 5 minutes
 )
 
 // Default parameter values
 var (
-	DefaultVoteThreshold = math.LegacyNewDecWithPrec(50, 2) // 50%
+	DefaultVoteThreshold       = math.LegacyNewDecWithPrec(50, 2) // 50%
+	DefaultPriceSubmissionMode = PriceSubmissionMode_PRICE_SUBMISSION_MODE_BOTH
+	DefaultCandleIntervals     = []uint64{BlocksPerHour, BlocksPerDay}
+	DefaultMaxPriceMoves       = MaxPriceMoveList{}
+	DefaultDenomOverrides      = DenomOverrideList{}
+	DefaultOutlierFilters      = OutlierFilterList{}
 
 	DefaultAcceptList = DenomList{
 		{
@@ -209,6 +226,14 @@ func DefaultParams() Params {
 		UsdcIbcDenom:                "ibc/F5FABF52B54E65064B57BF6DBD8E5FAD22CEE9F4B8A57ADBB20CCD0173AA72A4",
 		SlashingEnabled:             false,
 		AveragingWindow:             DefaultAveragingWindow,
+		MaxVoteExtensionRates:       DefaultMaxVoteExtensionRates,
+		PriceSubmissionMode:         DefaultPriceSubmissionMode,
+		CandleIntervals:             DefaultCandleIntervals,
+		MaximumCandles:              DefaultMaximumCandles,
+		MaximumRateStaleness:        DefaultMaximumRateStaleness,
+		MaxPriceMoves:               DefaultMaxPriceMoves,
+		DenomOverrides:              DefaultDenomOverrides,
+		OutlierFilters:              DefaultOutlierFilters,
 	}
 }
 
@@ -311,6 +336,46 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 			&p.AveragingWindow,
 			validateAveragingWindow,
 		),
+		paramstypes.NewParamSetPair(
+			KeyMaxVoteExtensionRates,
+			&p.MaxVoteExtensionRates,
+			validateMaxVoteExtensionRates,
+		),
+		paramstypes.NewParamSetPair(
+			KeyPriceSubmissionMode,
+			&p.PriceSubmissionMode,
+			validatePriceSubmissionMode,
+		),
+		paramstypes.NewParamSetPair(
+			KeyCandleIntervals,
+			&p.CandleIntervals,
+			validateCandleIntervals,
+		),
+		paramstypes.NewParamSetPair(
+			KeyMaximumCandles,
+			&p.MaximumCandles,
+			validateMaximumCandles,
+		),
+		paramstypes.NewParamSetPair(
+			KeyMaximumRateStaleness,
+			&p.MaximumRateStaleness,
+			validateMaximumRateStaleness,
+		),
+		paramstypes.NewParamSetPair(
+			KeyMaxPriceMoves,
+			&p.MaxPriceMoves,
+			validateMaxPriceMoves,
+		),
+		paramstypes.NewParamSetPair(
+			KeyDenomOverrides,
+			&p.DenomOverrides,
+			validateDenomOverrides,
+		),
+		paramstypes.NewParamSetPair(
+			KeyOutlierFilters,
+			&p.OutlierFilters,
+			validateOutlierFilters,
+		),
 	}
 }
 
@@ -384,6 +449,66 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validatePriceSubmissionMode(p.PriceSubmissionMode); err != nil {
+		return err
+	}
+
+	if err := validateCandleIntervals(p.CandleIntervals); err != nil {
+		return err
+	}
+
+	if err := validateMaxPriceMoves(p.MaxPriceMoves); err != nil {
+		return err
+	}
+
+	// all denoms with a circuit breaker must be in accept list
+	for _, m := range p.MaxPriceMoves {
+		if !p.AcceptList.Contains(m.SymbolDenom) {
+			return ErrInvalidParamValue.Wrapf("denom %s in MaxPriceMoves not present in AcceptList", m.SymbolDenom)
+		}
+	}
+
+	if p.MaximumRateStaleness < p.VotePeriod {
+		return ErrInvalidParamValue.Wrap(
+			"oracle parameter MaximumRateStaleness must be greater than or equal with VotePeriod",
+		)
+	}
+
+	if err := validateDenomOverrides(p.DenomOverrides); err != nil {
+		return err
+	}
+
+	// all denoms with an override must be in accept list, and must not be
+	// stale between their updates
+	for _, o := range p.DenomOverrides {
+		if !p.AcceptList.Contains(o.SymbolDenom) {
+			return ErrInvalidParamValue.Wrapf("denom %s in DenomOverrides not present in AcceptList", o.SymbolDenom)
+		}
+		if o.VotePeriodMultiplier > p.MaximumRateStaleness/p.VotePeriod {
+			return ErrInvalidParamValue.Wrapf(
+				"oracle parameter DenomOverride VotePeriodMultiplier of %s exceeds MaximumRateStaleness",
+				o.SymbolDenom,
+			)
+		}
+	}
+
+	if err := validateOutlierFilters(p.OutlierFilters); err != nil {
+		return err
+	}
+
+	// all denoms with an outlier filter must be in accept list
+	for _, f := range p.OutlierFilters {
+		if !p.AcceptList.Contains(f.SymbolDenom) {
+			return ErrInvalidParamValue.Wrapf("denom %s in OutlierFilters not present in AcceptList", f.SymbolDenom)
+		}
+	}
+
+	if p.MaxVoteExtensionRates < uint64(len(p.AcceptList)) {
+		return ErrInvalidParamValue.Wrap(
+			"oracle parameter MaxVoteExtensionRates must be greater than or equal with the AcceptList length",
+		)
+	}
+
 	return nil
 }
 
@@ -650,3 +775,173 @@ func validateAveragingWindow(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxVoteExtensionRates(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return ErrInvalidParamValue.Wrapf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return ErrInvalidParamValue.Wrap("oracle parameter MaxVoteExtensionRates must be > 0")
+	}
+
+	return nil
+}
+
+func validatePriceSubmissionMode(i interface{}) error {
+	v, ok := i.(PriceSubmissionMode)
+	if !ok {
+		return ErrInvalidParamValue.Wrapf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := PriceSubmissionMode_name[int32(v)]; !ok || v == PriceSubmissionMode_PRICE_SUBMISSION_MODE_UNSPECIFIED {
+		return ErrInvalidParamValue.Wrapf("oracle parameter PriceSubmissionMode is invalid: %s", v)
+	}
+
+	return nil
+}
+
+func validateCandleIntervals(i interface{}) error {
+	v, ok := i.([]uint64)
+	if !ok {
+		return ErrInvalidParamValue.Wrapf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[uint64]bool, len(v))
+	for _, interval := range v {
+		if interval == 0 {
+			return ErrInvalidParamValue.Wrap("oracle parameter CandleIntervals must be > 0")
+		}
+		if seen[interval] {
+			return ErrInvalidParamValue.Wrapf("oracle parameter CandleIntervals has a duplicate interval: %d", interval)
+		}
+		seen[interval] = true
+	}
+
+	return nil
+}
+
+func validateMaximumCandles(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return ErrInvalidParamValue.Wrapf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return ErrInvalidParamValue.Wrap("oracle parameter MaximumCandles must be > 0")
+	}
+
+	return nil
+}
+
+func validateMaximumRateStaleness(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return ErrInvalidParamValue.Wrapf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return ErrInvalidParamValue.Wrap("oracle parameter MaximumRateStaleness must be > 0")
+	}
+
+	return nil
+}
+
+func validateMaxPriceMoves(i interface{}) error {
+	v, ok := i.(MaxPriceMoveList)
+	if !ok {
+		return ErrInvalidParamValue.Wrapf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, m := range v {
+		if len(m.SymbolDenom) == 0 {
+			return ErrInvalidParamValue.Wrap("oracle parameter MaxPriceMove must have SymbolDenom")
+		}
+		if m.MaxMove.IsNil() || !m.MaxMove.IsPositive() {
+			return ErrInvalidParamValue.Wrap("oracle parameter MaxPriceMove MaxMove must be > 0")
+		}
+		denom := strings.ToUpper(m.SymbolDenom)
+		if seen[denom] {
+			return ErrInvalidParamValue.Wrapf("oracle parameter MaxPriceMoves has a duplicate denom: %s", m.SymbolDenom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+func validateDenomOverrides(i interface{}) error {
+	v, ok := i.(DenomOverrideList)
+	if !ok {
+		return ErrInvalidParamValue.Wrapf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, o := range v {
+		if len(o.SymbolDenom) == 0 {
+			return ErrInvalidParamValue.Wrap("oracle parameter DenomOverride must have SymbolDenom")
+		}
+		if !o.VoteThreshold.IsNil() && !o.VoteThreshold.IsZero() {
+			// overrides may lower the threshold below the global minimum
+			if !o.VoteThreshold.IsPositive() || o.VoteThreshold.GT(oneDec) {
+				return ErrInvalidParamValue.Wrap("oracle parameter DenomOverride VoteThreshold must be between [0, 1]")
+			}
+			val := o.VoteThreshold.MulInt64(MaxVoteThresholdMultiplier).TruncateInt64()
+			if !math.LegacyNewDecWithPrec(val, MaxVoteThresholdPrecision).Equal(o.VoteThreshold) {
+				return ErrInvalidParamValue.Wrap("oracle parameter DenomOverride VoteThreshold precision must be maximum 2 decimals")
+			}
+		}
+		denom := strings.ToUpper(o.SymbolDenom)
+		if seen[denom] {
+			return ErrInvalidParamValue.Wrapf("oracle parameter DenomOverrides has a duplicate denom: %s", o.SymbolDenom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+func validateOutlierFilters(i interface{}) error {
+	v, ok := i.(OutlierFilterList)
+	if !ok {
+		return ErrInvalidParamValue.Wrapf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, f := range v {
+		if len(f.SymbolDenom) == 0 {
+			return ErrInvalidParamValue.Wrap("oracle parameter OutlierFilter must have SymbolDenom")
+		}
+		if !f.MadMultiplier.IsNil() && f.MadMultiplier.IsNegative() {
+			return ErrInvalidParamValue.Wrap("oracle parameter OutlierFilter MadMultiplier must be >= 0")
+		}
+
+		lower, upper := f.LowerPercentile, f.UpperPercentile
+		if lower.IsNil() {
+			lower = math.LegacyZeroDec()
+		}
+		if upper.IsNil() {
+			upper = math.LegacyZeroDec()
+		}
+		if f.IsMAD() == f.IsPercentileBand() || (f.IsMAD() && lower.IsPositive()) {
+			return ErrInvalidParamValue.Wrap(
+				"oracle parameter OutlierFilter must set either MadMultiplier or a percentile band",
+			)
+		}
+		if f.IsPercentileBand() && (lower.IsNegative() || lower.GTE(upper) || upper.GT(oneDec)) {
+			return ErrInvalidParamValue.Wrap(
+				"oracle parameter OutlierFilter percentiles must be 0 <= LowerPercentile < UpperPercentile <= 1",
+			)
+		}
+
+		denom := strings.ToUpper(f.SymbolDenom)
+		if seen[denom] {
+			return ErrInvalidParamValue.Wrapf("oracle parameter OutlierFilters has a duplicate denom: %s", f.SymbolDenom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
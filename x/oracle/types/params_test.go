@@ -320,3 +320,187 @@ func TestValidateAveragingWindow(t *testing.T) {
 	err = validateSlashWindow(uint64(10))
 	require.Nil(t, err)
 }
+
+func TestValidateMaxVoteExtensionRates(t *testing.T) {
+	err := validateMaxVoteExtensionRates("invalidUint64")
+	require.ErrorContains(t, err, "invalid parameter type: string")
+
+	err = validateMaxVoteExtensionRates(uint64(0))
+	require.ErrorContains(t, err, "oracle parameter MaxVoteExtensionRates must be > 0")
+
+	err = validateMaxVoteExtensionRates(uint64(10))
+	require.Nil(t, err)
+
+	p := DefaultParams()
+	p.AcceptList = DenomList{{BaseDenom: DmDenom, SymbolDenom: DmSymbol}, {BaseDenom: USDTDenom, SymbolDenom: USDTSymbol}}
+	p.MandatoryList = p.AcceptList
+	p.MaxVoteExtensionRates = 1
+	require.ErrorContains(t, p.Validate(), "MaxVoteExtensionRates must be greater than or equal with the AcceptList length")
+}
+
+func TestValidatePriceSubmissionMode(t *testing.T) {
+	err := validatePriceSubmissionMode("invalidMode")
+	require.ErrorContains(t, err, "invalid parameter type: string")
+
+	err = validatePriceSubmissionMode(PriceSubmissionMode_PRICE_SUBMISSION_MODE_UNSPECIFIED)
+	require.ErrorContains(t, err, "oracle parameter PriceSubmissionMode is invalid")
+
+	err = validatePriceSubmissionMode(PriceSubmissionMode(10))
+	require.ErrorContains(t, err, "oracle parameter PriceSubmissionMode is invalid")
+
+	err = validatePriceSubmissionMode(PriceSubmissionMode_PRICE_SUBMISSION_MODE_VOTE_EXTENSIONS)
+	require.Nil(t, err)
+}
+
+func TestValidateCandleIntervals(t *testing.T) {
+	err := validateCandleIntervals("invalidUint64s")
+	require.ErrorContains(t, err, "invalid parameter type: string")
+
+	err = validateCandleIntervals([]uint64{BlocksPerHour, 0})
+	require.ErrorContains(t, err, "oracle parameter CandleIntervals must be > 0")
+
+	err = validateCandleIntervals([]uint64{BlocksPerHour, BlocksPerDay, BlocksPerHour})
+	require.ErrorContains(t, err, "oracle parameter CandleIntervals has a duplicate interval")
+
+	err = validateCandleIntervals([]uint64{})
+	require.Nil(t, err)
+
+	err = validateCandleIntervals([]uint64{BlocksPerHour, BlocksPerDay})
+	require.Nil(t, err)
+}
+
+func TestValidateMaximumCandles(t *testing.T) {
+	err := validateMaximumCandles("invalidUint64")
+	require.ErrorContains(t, err, "invalid parameter type: string")
+
+	err = validateMaximumCandles(uint64(0))
+	require.ErrorContains(t, err, "oracle parameter MaximumCandles must be > 0")
+
+	err = validateMaximumCandles(uint64(10))
+	require.Nil(t, err)
+}
+
+func TestValidateMaximumRateStaleness(t *testing.T) {
+	err := validateMaximumRateStaleness("invalidUint64")
+	require.ErrorContains(t, err, "invalid parameter type: string")
+
+	err = validateMaximumRateStaleness(uint64(0))
+	require.ErrorContains(t, err, "oracle parameter MaximumRateStaleness must be > 0")
+
+	err = validateMaximumRateStaleness(uint64(10))
+	require.Nil(t, err)
+}
+
+func TestValidateMaxPriceMoves(t *testing.T) {
+	err := validateMaxPriceMoves("invalidMaxPriceMoves")
+	require.ErrorContains(t, err, "invalid parameter type: string")
+
+	err = validateMaxPriceMoves(MaxPriceMoveList{{SymbolDenom: "", MaxMove: math.LegacyNewDecWithPrec(2, 1)}})
+	require.ErrorContains(t, err, "oracle parameter MaxPriceMove must have SymbolDenom")
+
+	err = validateMaxPriceMoves(MaxPriceMoveList{{SymbolDenom: DmSymbol, MaxMove: math.LegacyZeroDec()}})
+	require.ErrorContains(t, err, "oracle parameter MaxPriceMove MaxMove must be > 0")
+
+	err = validateMaxPriceMoves(MaxPriceMoveList{
+		{SymbolDenom: DmSymbol, MaxMove: math.LegacyNewDecWithPrec(2, 1)},
+		{SymbolDenom: "dm", MaxMove: math.LegacyNewDecWithPrec(3, 1)},
+	})
+	require.ErrorContains(t, err, "oracle parameter MaxPriceMoves has a duplicate denom")
+
+	err = validateMaxPriceMoves(MaxPriceMoveList{{SymbolDenom: DmSymbol, MaxMove: math.LegacyNewDecWithPrec(2, 1)}})
+	require.Nil(t, err)
+
+	params := DefaultParams()
+	params.AcceptList = DenomList{{BaseDenom: DmDenom, SymbolDenom: DmSymbol, Exponent: DmExponent}}
+	params.MandatoryList = params.AcceptList
+	params.MaxPriceMoves = MaxPriceMoveList{{SymbolDenom: "FOO", MaxMove: math.LegacyNewDecWithPrec(2, 1)}}
+	require.ErrorContains(t, params.Validate(), "denom FOO in MaxPriceMoves not present in AcceptList")
+}
+
+func TestValidateDenomOverrides(t *testing.T) {
+	err := validateDenomOverrides("invalidDenomOverrides")
+	require.ErrorContains(t, err, "invalid parameter type: string")
+
+	err = validateDenomOverrides(DenomOverrideList{{SymbolDenom: "", MinVoters: 2}})
+	require.ErrorContains(t, err, "oracle parameter DenomOverride must have SymbolDenom")
+
+	err = validateDenomOverrides(DenomOverrideList{{SymbolDenom: DmSymbol, VoteThreshold: math.LegacyNewDec(2)}})
+	require.ErrorContains(t, err, "oracle parameter DenomOverride VoteThreshold must be between [0, 1]")
+
+	err = validateDenomOverrides(DenomOverrideList{{SymbolDenom: DmSymbol, VoteThreshold: math.LegacyNewDecWithPrec(555, 3)}})
+	require.ErrorContains(t, err, "oracle parameter DenomOverride VoteThreshold precision must be maximum 2 decimals")
+
+	err = validateDenomOverrides(DenomOverrideList{
+		{SymbolDenom: DmSymbol, MinVoters: 2},
+		{SymbolDenom: "dm", VotePeriodMultiplier: 2},
+	})
+	require.ErrorContains(t, err, "oracle parameter DenomOverrides has a duplicate denom")
+
+	err = validateDenomOverrides(DenomOverrideList{
+		{SymbolDenom: DmSymbol, VoteThreshold: math.LegacyNewDecWithPrec(2, 1), MinVoters: 2, VotePeriodMultiplier: 3},
+		{SymbolDenom: USDCSymbol, VoteThreshold: math.LegacyZeroDec(), MinVoters: 3},
+	})
+	require.Nil(t, err)
+
+	params := DefaultParams()
+	params.AcceptList = DenomList{{BaseDenom: DmDenom, SymbolDenom: DmSymbol, Exponent: DmExponent}}
+	params.MandatoryList = params.AcceptList
+	params.DenomOverrides = DenomOverrideList{{SymbolDenom: "FOO", MinVoters: 2}}
+	require.ErrorContains(t, params.Validate(), "denom FOO in DenomOverrides not present in AcceptList")
+
+	params.DenomOverrides = DenomOverrideList{{
+		SymbolDenom:          DmSymbol,
+		VotePeriodMultiplier: params.MaximumRateStaleness/params.VotePeriod + 1,
+	}}
+	require.ErrorContains(t, params.Validate(), "VotePeriodMultiplier of DM exceeds MaximumRateStaleness")
+}
+
+func TestValidateOutlierFilters(t *testing.T) {
+	err := validateOutlierFilters("invalidOutlierFilters")
+	require.ErrorContains(t, err, "invalid parameter type: string")
+
+	err = validateOutlierFilters(OutlierFilterList{{SymbolDenom: "", MadMultiplier: math.LegacyNewDec(3)}})
+	require.ErrorContains(t, err, "oracle parameter OutlierFilter must have SymbolDenom")
+
+	err = validateOutlierFilters(OutlierFilterList{{SymbolDenom: DmSymbol, MadMultiplier: math.LegacyNewDec(-3)}})
+	require.ErrorContains(t, err, "oracle parameter OutlierFilter MadMultiplier must be >= 0")
+
+	err = validateOutlierFilters(OutlierFilterList{{SymbolDenom: DmSymbol}})
+	require.ErrorContains(t, err, "oracle parameter OutlierFilter must set either MadMultiplier or a percentile band")
+
+	err = validateOutlierFilters(OutlierFilterList{{
+		SymbolDenom:     DmSymbol,
+		MadMultiplier:   math.LegacyNewDec(3),
+		UpperPercentile: math.LegacyNewDecWithPrec(9, 1),
+	}})
+	require.ErrorContains(t, err, "oracle parameter OutlierFilter must set either MadMultiplier or a percentile band")
+
+	err = validateOutlierFilters(OutlierFilterList{{
+		SymbolDenom:     DmSymbol,
+		LowerPercentile: math.LegacyNewDecWithPrec(9, 1),
+		UpperPercentile: math.LegacyNewDecWithPrec(1, 1),
+	}})
+	require.ErrorContains(t, err, "oracle parameter OutlierFilter percentiles must be 0 <= LowerPercentile < UpperPercentile <= 1")
+
+	err = validateOutlierFilters(OutlierFilterList{
+		{SymbolDenom: DmSymbol, MadMultiplier: math.LegacyNewDec(3)},
+		{SymbolDenom: "dm", MadMultiplier: math.LegacyNewDec(2)},
+	})
+	require.ErrorContains(t, err, "oracle parameter OutlierFilters has a duplicate denom")
+
+	err = validateOutlierFilters(OutlierFilterList{
+		{SymbolDenom: DmSymbol, MadMultiplier: math.LegacyNewDec(3)},
+		{
+			SymbolDenom:     USDCSymbol,
+			LowerPercentile: math.LegacyNewDecWithPrec(5, 2),
+			UpperPercentile: math.LegacyNewDecWithPrec(95, 2),
+		},
+	})
+	require.Nil(t, err)
+
+	params := DefaultParams()
+	params.AcceptList = DenomList{{BaseDenom: DmDenom, SymbolDenom: DmSymbol, Exponent: DmExponent}}
+	params.MandatoryList = params.AcceptList
+	params.OutlierFilters = OutlierFilterList{{SymbolDenom: "FOO", MadMultiplier: math.LegacyNewDec(3)}}
+	require.ErrorContains(t, params.Validate(), "denom FOO in OutlierFilters not present in AcceptList")
+}
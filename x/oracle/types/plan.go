@@ -102,6 +102,46 @@ func (p ParamUpdatePlan) ValidateBasic() error {
 				return err
 			}
 
+		case string(KeyMaxVoteExtensionRates):
+			if err := validateMaxVoteExtensionRates(p.Changes.MaxVoteExtensionRates); err != nil {
+				return err
+			}
+
+		case string(KeyPriceSubmissionMode):
+			if err := validatePriceSubmissionMode(p.Changes.PriceSubmissionMode); err != nil {
+				return err
+			}
+
+		case string(KeyCandleIntervals):
+			if err := validateCandleIntervals(p.Changes.CandleIntervals); err != nil {
+				return err
+			}
+
+		case string(KeyMaximumCandles):
+			if err := validateMaximumCandles(p.Changes.MaximumCandles); err != nil {
+				return err
+			}
+
+		case string(KeyMaximumRateStaleness):
+			if err := validateMaximumRateStaleness(p.Changes.MaximumRateStaleness); err != nil {
+				return err
+			}
+
+		case string(KeyMaxPriceMoves):
+			if err := validateMaxPriceMoves(p.Changes.MaxPriceMoves); err != nil {
+				return err
+			}
+
+		case string(KeyDenomOverrides):
+			if err := validateDenomOverrides(p.Changes.DenomOverrides); err != nil {
+				return err
+			}
+
+		case string(KeyOutlierFilters):
+			if err := validateOutlierFilters(p.Changes.OutlierFilters); err != nil {
+				return err
+			}
+
 		default:
 			return fmt.Errorf("%s is not an existing oracle param key", key)
 		}
@@ -11,6 +11,8 @@ var (
 	proposalTypeMsgGovAddDenoms                         = MsgGovAddDenoms{}.String()
 	proposalTypeMsgGovRemoveCurrencyPairProviders       = MsgGovRemoveCurrencyPairProviders{}.String()
 	proposalTypeMsgGovRemoveCurrencyDeviationThresholds = MsgGovRemoveCurrencyDeviationThresholds{}.String()
+	proposalTypeMsgGovResetCircuitBreakers              = MsgGovResetCircuitBreakers{}.String()
+	proposalTypeMsgGovUpdateDenomOverrides              = MsgGovUpdateDenomOverrides{}.String()
 )
 
 func init() {
@@ -20,6 +22,8 @@ func init() {
 	gov.RegisterProposalType(proposalTypeMsgGovAddDenoms)
 	gov.RegisterProposalType(proposalTypeMsgGovRemoveCurrencyPairProviders)
 	gov.RegisterProposalType(proposalTypeMsgGovRemoveCurrencyDeviationThresholds)
+	gov.RegisterProposalType(proposalTypeMsgGovResetCircuitBreakers)
+	gov.RegisterProposalType(proposalTypeMsgGovUpdateDenomOverrides)
 }
 
 // Implements Proposal Interface
@@ -121,3 +125,37 @@ func (msg *MsgGovRemoveCurrencyDeviationThresholds) ProposalRoute() string { ret
 func (msg *MsgGovRemoveCurrencyDeviationThresholds) ProposalType() string {
 	return proposalTypeMsgGovRemoveCurrencyDeviationThresholds
 }
+
+// Implements Proposal Interface
+var _ gov.Content = &MsgGovResetCircuitBreakers{}
+
+// GetTitle returns the title of a community pool spend proposal.
+func (msg *MsgGovResetCircuitBreakers) GetTitle() string { return msg.Title }
+
+// GetDescription returns the description of a community pool spend proposal.
+func (msg *MsgGovResetCircuitBreakers) GetDescription() string { return msg.Description }
+
+// GetDescription returns the routing key of a community pool spend proposal.
+func (msg *MsgGovResetCircuitBreakers) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool spend proposal.
+func (msg *MsgGovResetCircuitBreakers) ProposalType() string {
+	return proposalTypeMsgGovResetCircuitBreakers
+}
+
+// Implements Proposal Interface
+var _ gov.Content = &MsgGovUpdateDenomOverrides{}
+
+// GetTitle returns the title of a community pool spend proposal.
+func (msg *MsgGovUpdateDenomOverrides) GetTitle() string { return msg.Title }
+
+// GetDescription returns the description of a community pool spend proposal.
+func (msg *MsgGovUpdateDenomOverrides) GetDescription() string { return msg.Description }
+
+// GetDescription returns the routing key of a community pool spend proposal.
+func (msg *MsgGovUpdateDenomOverrides) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool spend proposal.
+func (msg *MsgGovUpdateDenomOverrides) ProposalType() string {
+	return proposalTypeMsgGovUpdateDenomOverrides
+}
@@ -10,6 +10,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -1289,1295 +1290,1862 @@ func (m *QuerySMAResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuerySMAResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryExchangeRates)(nil), "oracle.v1.QueryExchangeRates")
-	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "oracle.v1.QueryExchangeRatesResponse")
-	proto.RegisterType((*QueryActiveExchangeRates)(nil), "oracle.v1.QueryActiveExchangeRates")
-	proto.RegisterType((*QueryActiveExchangeRatesResponse)(nil), "oracle.v1.QueryActiveExchangeRatesResponse")
-	proto.RegisterType((*QueryFeederDelegation)(nil), "oracle.v1.QueryFeederDelegation")
-	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "oracle.v1.QueryFeederDelegationResponse")
-	proto.RegisterType((*QueryMissCounter)(nil), "oracle.v1.QueryMissCounter")
-	proto.RegisterType((*QueryMissCounterResponse)(nil), "oracle.v1.QueryMissCounterResponse")
-	proto.RegisterType((*QuerySlashWindow)(nil), "oracle.v1.QuerySlashWindow")
-	proto.RegisterType((*QuerySlashWindowResponse)(nil), "oracle.v1.QuerySlashWindowResponse")
-	proto.RegisterType((*QueryAggregatePrevote)(nil), "oracle.v1.QueryAggregatePrevote")
-	proto.RegisterType((*QueryAggregatePrevoteResponse)(nil), "oracle.v1.QueryAggregatePrevoteResponse")
-	proto.RegisterType((*QueryAggregatePrevotes)(nil), "oracle.v1.QueryAggregatePrevotes")
-	proto.RegisterType((*QueryAggregatePrevotesResponse)(nil), "oracle.v1.QueryAggregatePrevotesResponse")
-	proto.RegisterType((*QueryAggregateVote)(nil), "oracle.v1.QueryAggregateVote")
-	proto.RegisterType((*QueryAggregateVoteResponse)(nil), "oracle.v1.QueryAggregateVoteResponse")
-	proto.RegisterType((*QueryAggregateVotes)(nil), "oracle.v1.QueryAggregateVotes")
-	proto.RegisterType((*QueryAggregateVotesResponse)(nil), "oracle.v1.QueryAggregateVotesResponse")
-	proto.RegisterType((*QueryParams)(nil), "oracle.v1.QueryParams")
-	proto.RegisterType((*QueryParamsResponse)(nil), "oracle.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryMedians)(nil), "oracle.v1.QueryMedians")
-	proto.RegisterType((*QueryMediansResponse)(nil), "oracle.v1.QueryMediansResponse")
-	proto.RegisterType((*QueryMedianDeviations)(nil), "oracle.v1.QueryMedianDeviations")
-	proto.RegisterType((*QueryMedianDeviationsResponse)(nil), "oracle.v1.QueryMedianDeviationsResponse")
-	proto.RegisterType((*QueryValidatorRewardSet)(nil), "oracle.v1.QueryValidatorRewardSet")
-	proto.RegisterType((*QueryValidatorRewardSetResponse)(nil), "oracle.v1.QueryValidatorRewardSetResponse")
-	proto.RegisterType((*QueryEMARequest)(nil), "oracle.v1.QueryEMARequest")
-	proto.RegisterType((*QueryEMAResponse)(nil), "oracle.v1.QueryEMAResponse")
-	proto.RegisterType((*QueryWMARequest)(nil), "oracle.v1.QueryWMARequest")
-	proto.RegisterType((*QueryWMAResponse)(nil), "oracle.v1.QueryWMAResponse")
-	proto.RegisterType((*QuerySMARequest)(nil), "oracle.v1.QuerySMARequest")
-	proto.RegisterType((*QuerySMAResponse)(nil), "oracle.v1.QuerySMAResponse")
+// QueryTWAPRequest is the request type for the Query/TWAP RPC method.
+type QueryTWAPRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// window_seconds is the length of the averaging window in seconds.
+	WindowSeconds uint64 `protobuf:"varint,2,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	// end_height is the height the window ends at, the current height if zero.
+	EndHeight uint64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
 }
 
-func init() { proto.RegisterFile("oracle/v1/query.proto", fileDescriptor_34238c8dfdfcd7ec) }
-
-var fileDescriptor_34238c8dfdfcd7ec = []byte{
-	// 1444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0x4f, 0x6f, 0x13, 0xc7,
-	0x1b, 0xc7, 0xbd, 0x84, 0x7f, 0x79, 0x8c, 0x83, 0x33, 0x90, 0xe0, 0x6c, 0x88, 0x6d, 0x96, 0x5f,
-	0x7e, 0x09, 0xd0, 0x78, 0x9b, 0x50, 0x44, 0x85, 0x84, 0x44, 0xfe, 0x51, 0xa4, 0x36, 0x12, 0xd8,
-	0x12, 0x91, 0xa0, 0xaa, 0x35, 0xd9, 0x1d, 0xec, 0x85, 0xec, 0xae, 0xd9, 0x59, 0x3b, 0x44, 0x11,
-	0x3d, 0x54, 0x95, 0xe8, 0xad, 0x95, 0x68, 0xab, 0x1e, 0x39, 0xf7, 0xdc, 0x43, 0x5f, 0x02, 0x47,
-	0xd4, 0x5e, 0xaa, 0x1e, 0x68, 0x0b, 0x3d, 0xf4, 0x65, 0x54, 0x3b, 0x33, 0x1e, 0x8f, 0xbd, 0x6b,
-	0x3b, 0xa9, 0xc4, 0x29, 0xd9, 0xe7, 0x99, 0xf9, 0x3e, 0x9f, 0x79, 0x76, 0x66, 0xe7, 0x2b, 0xc3,
-	0x84, 0x1f, 0x60, 0x6b, 0x9b, 0x98, 0xad, 0x45, 0xf3, 0x71, 0x93, 0x04, 0xbb, 0xa5, 0x46, 0xe0,
-	0x87, 0x3e, 0x1a, 0xe5, 0xe1, 0x52, 0x6b, 0x51, 0x3f, 0x5b, 0xf3, 0xfd, 0xda, 0x36, 0x31, 0x71,
-	0xc3, 0x31, 0xb1, 0xe7, 0xf9, 0x21, 0x0e, 0x1d, 0xdf, 0xa3, 0x7c, 0xa0, 0x3e, 0xd9, 0x99, 0x2f,
-	0xa6, 0xf0, 0x78, 0xde, 0xf2, 0xa9, 0xeb, 0x53, 0x73, 0x0b, 0xd3, 0x28, 0xb9, 0x45, 0x42, 0xbc,
-	0x68, 0x5a, 0xbe, 0xe3, 0x89, 0xfc, 0x14, 0xcf, 0x57, 0xd9, 0x93, 0xc9, 0x1f, 0x44, 0xea, 0x74,
-	0xcd, 0xaf, 0xf9, 0x3c, 0x1e, 0xfd, 0xc7, 0xa3, 0xc6, 0x07, 0x80, 0xee, 0x44, 0x80, 0xeb, 0x4f,
-	0xac, 0x3a, 0xf6, 0x6a, 0xa4, 0x8c, 0x43, 0x42, 0xd1, 0x69, 0x38, 0x62, 0x13, 0xcf, 0x77, 0x73,
-	0x5a, 0x51, 0x9b, 0x1f, 0x2d, 0xf3, 0x87, 0x6b, 0xc7, 0xbf, 0x7a, 0x51, 0x48, 0xfd, 0xf3, 0xa2,
-	0x90, 0x32, 0xbe, 0xd7, 0x40, 0x8f, 0x4f, 0x2b, 0x13, 0xda, 0xf0, 0x3d, 0x4a, 0xd0, 0x13, 0x18,
-	0x23, 0x22, 0x51, 0x0d, 0xa2, 0x4c, 0x4e, 0x2b, 0x8e, 0xcc, 0xa7, 0x97, 0xce, 0x96, 0x04, 0x51,
-	0x84, 0x5f, 0x12, 0xf8, 0xa5, 0x35, 0x62, 0xad, 0xfa, 0x8e, 0xb7, 0x72, 0xf9, 0xe5, 0xeb, 0x42,
-	0xea, 0xc7, 0x3f, 0x0a, 0x97, 0x6a, 0x4e, 0x58, 0x6f, 0x6e, 0x95, 0x2c, 0xdf, 0x15, 0x2b, 0x10,
-	0x7f, 0x16, 0xa8, 0xfd, 0xc8, 0x0c, 0x77, 0x1b, 0x84, 0xb6, 0xe7, 0xd0, 0x72, 0x86, 0xa8, 0x04,
-	0x86, 0x0e, 0x39, 0xc6, 0xb5, 0x6c, 0x85, 0x4e, 0x8b, 0x74, 0xd1, 0x19, 0xeb, 0x50, 0xec, 0x97,
-	0x93, 0xe4, 0xe7, 0xe0, 0x04, 0x66, 0x69, 0x85, 0x7b, 0xb4, 0x9c, 0xe6, 0x31, 0x2e, 0x73, 0x0b,
-	0x26, 0x98, 0xcc, 0x4d, 0x42, 0x6c, 0x12, 0xac, 0x91, 0x6d, 0x52, 0x63, 0xaf, 0x0e, 0xcd, 0xc2,
-	0x58, 0x0b, 0x6f, 0x3b, 0x36, 0x0e, 0xfd, 0xa0, 0x8a, 0x6d, 0x3b, 0x10, 0xdd, 0xcb, 0xc8, 0xe8,
-	0xb2, 0x6d, 0x07, 0x4a, 0x17, 0x6f, 0xc0, 0x4c, 0xa2, 0x92, 0xa4, 0x29, 0x40, 0xfa, 0x01, 0xcb,
-	0xa9, 0x72, 0xc0, 0x43, 0x91, 0x96, 0xb1, 0x0a, 0x59, 0xa6, 0xb0, 0xe1, 0x50, 0xba, 0xea, 0x37,
-	0xbd, 0x90, 0x04, 0x07, 0xc7, 0xb8, 0x2e, 0x7a, 0xa6, 0x88, 0xa8, 0xfd, 0x70, 0x1d, 0x4a, 0xab,
-	0x16, 0x8f, 0x33, 0xa9, 0xc3, 0xe5, 0xb4, 0xdb, 0x19, 0x6a, 0x20, 0xc1, 0x50, 0xd9, 0xc6, 0xb4,
-	0xbe, 0xe9, 0x78, 0xb6, 0xbf, 0x63, 0xac, 0x0a, 0x49, 0x25, 0x26, 0x25, 0xe7, 0xe0, 0xe4, 0x0e,
-	0x8b, 0x44, 0x9b, 0xb4, 0x16, 0x10, 0x4a, 0x85, 0xea, 0x18, 0x0f, 0xdf, 0x16, 0x51, 0xd9, 0xe8,
-	0xe5, 0x5a, 0x2d, 0x88, 0x3a, 0x43, 0x6e, 0x07, 0xa4, 0xe5, 0x87, 0xe4, 0xe0, 0x2b, 0xdc, 0x13,
-	0x8d, 0xee, 0x55, 0x92, 0x4c, 0xf7, 0x60, 0x1c, 0xb7, 0x73, 0xd5, 0x06, 0x4f, 0x32, 0xd1, 0xf4,
-	0xd2, 0x5c, 0x49, 0x9e, 0xd9, 0x92, 0x9c, 0xaf, 0x6e, 0x1e, 0xa1, 0xb5, 0x72, 0x38, 0xda, 0xbe,
-	0xe5, 0x2c, 0xee, 0xa9, 0x61, 0xe4, 0x60, 0x32, 0xb1, 0x38, 0x35, 0x3e, 0x87, 0x7c, 0x72, 0x46,
-	0x72, 0x7d, 0x0a, 0x28, 0xc6, 0xd5, 0x3e, 0x4c, 0x07, 0x04, 0x1b, 0xc7, 0xb1, 0xfa, 0xeb, 0xe2,
-	0xec, 0xcb, 0xd9, 0x77, 0xff, 0x53, 0x77, 0x7d, 0xf1, 0x2d, 0xe8, 0x92, 0x91, 0x4b, 0xb8, 0x03,
-	0x63, 0x9d, 0x25, 0x28, 0x7d, 0xfd, 0xdf, 0x30, 0xfc, 0xbb, 0x1d, 0xf6, 0x0c, 0x56, 0xa5, 0x8d,
-	0x09, 0x38, 0x15, 0x2f, 0x48, 0x8d, 0x00, 0xa6, 0x13, 0xc2, 0x12, 0xa4, 0x02, 0x27, 0xbb, 0x41,
-	0xda, 0x8d, 0x3c, 0x08, 0xc9, 0x18, 0xee, 0xae, 0x99, 0x81, 0x34, 0xab, 0x79, 0x1b, 0x07, 0xd8,
-	0xa5, 0xc6, 0x4d, 0x41, 0xc6, 0x1f, 0x65, 0x69, 0x13, 0x8e, 0x36, 0x58, 0x44, 0xac, 0x7d, 0x5c,
-	0xa9, 0xc8, 0x87, 0x0a, 0x79, 0x31, 0xcc, 0xd8, 0x80, 0x13, 0xfc, 0x48, 0x12, 0xdb, 0xc1, 0x5e,
-	0x9f, 0xef, 0x31, 0x9a, 0x01, 0xf0, 0x9a, 0x6e, 0x95, 0x86, 0xd8, 0x6d, 0xd0, 0xdc, 0xa1, 0xa2,
-	0x36, 0x9f, 0x29, 0x8f, 0x7a, 0x4d, 0xb7, 0xc2, 0x02, 0xca, 0x1b, 0xda, 0x80, 0xd3, 0xaa, 0x9c,
-	0xe4, 0xba, 0x02, 0xc7, 0x5c, 0x1e, 0x12, 0xad, 0x98, 0x50, 0xc1, 0x02, 0xc7, 0x22, 0x4c, 0x4a,
-	0xc0, 0xb5, 0xc7, 0x1a, 0x57, 0xc5, 0xc1, 0xe4, 0x72, 0x6b, 0xa4, 0xe5, 0xf0, 0xbb, 0x6b, 0xe8,
-	0xb5, 0xe1, 0x88, 0x73, 0xd8, 0x3b, 0x51, 0x02, 0xdd, 0x82, 0x71, 0x5e, 0xa4, 0x6a, 0xcb, 0xe4,
-	0x7e, 0xd0, 0xb2, 0x6e, 0x8f, 0xa2, 0x31, 0x05, 0x67, 0x58, 0xa9, 0xbb, 0xed, 0x4d, 0x5b, 0x26,
-	0x3b, 0x38, 0xb0, 0x2b, 0x24, 0x34, 0x1e, 0x40, 0xa1, 0x4f, 0x4a, 0x72, 0xac, 0x02, 0xc8, 0xdd,
-	0xde, 0x7e, 0x69, 0x33, 0x0a, 0x40, 0x7c, 0xaa, 0x00, 0x51, 0xa6, 0x19, 0x73, 0x70, 0x92, 0xdf,
-	0x91, 0x1b, 0xcb, 0x65, 0xf2, 0xb8, 0x49, 0x68, 0x98, 0xdc, 0x20, 0xe3, 0xbe, 0xf8, 0x82, 0xb2,
-	0x81, 0x82, 0xe0, 0x23, 0x38, 0xd2, 0x88, 0x56, 0xc9, 0x47, 0xae, 0x2c, 0x46, 0xea, 0xbf, 0xbf,
-	0x2e, 0x4c, 0xf3, 0x9b, 0x90, 0xda, 0x8f, 0x4a, 0x8e, 0x6f, 0xba, 0x38, 0xac, 0x97, 0x3e, 0x21,
-	0x35, 0x6c, 0xed, 0xae, 0x11, 0xeb, 0x97, 0x9f, 0x16, 0x40, 0xdc, 0xaf, 0x6b, 0xc4, 0x2a, 0xf3,
-	0xf9, 0xc6, 0x43, 0x41, 0xb1, 0x39, 0x84, 0x02, 0xe9, 0x70, 0x9c, 0x86, 0xd1, 0xad, 0x57, 0xdb,
-	0x65, 0x7b, 0x69, 0xb4, 0x2c, 0x9f, 0xa3, 0x6f, 0x82, 0xd5, 0xa4, 0xa1, 0xef, 0x56, 0x77, 0x88,
-	0x53, 0xab, 0x87, 0x34, 0x37, 0x52, 0x1c, 0x99, 0x1f, 0x29, 0x67, 0x78, 0x74, 0x93, 0x07, 0xe5,
-	0x42, 0x36, 0xdf, 0xc5, 0x42, 0xda, 0xed, 0xac, 0xec, 0xb7, 0x9d, 0x95, 0x77, 0x40, 0xb1, 0xf4,
-	0x73, 0x16, 0x8e, 0x30, 0x75, 0xf4, 0x4c, 0x83, 0x4c, 0xb7, 0x6b, 0x52, 0x77, 0x48, 0xdc, 0x1d,
-	0xe9, 0xb3, 0x03, 0xd3, 0x6d, 0x54, 0xe3, 0xfd, 0x2f, 0x7e, 0xfd, 0xfb, 0xf9, 0xa1, 0x8b, 0x68,
-	0xde, 0xec, 0x78, 0x40, 0xb6, 0x30, 0x6a, 0x76, 0x9b, 0x2a, 0x73, 0x8f, 0x85, 0x9f, 0xa2, 0x1f,
-	0x34, 0x38, 0x95, 0x60, 0x6a, 0xd0, 0xf9, 0xde, 0x82, 0x09, 0x83, 0xf4, 0x4b, 0xfb, 0x18, 0x24,
-	0xd9, 0x4c, 0xc6, 0x76, 0x01, 0xcd, 0xc5, 0xd9, 0x84, 0x6d, 0xea, 0x46, 0x44, 0xdf, 0x69, 0x90,
-	0x8d, 0x19, 0xa5, 0x62, 0x6f, 0xc9, 0xde, 0x11, 0xfa, 0xfc, 0xb0, 0x11, 0x92, 0xe8, 0x0a, 0x23,
-	0x32, 0xd1, 0x82, 0x42, 0xd4, 0x39, 0x83, 0xe6, 0x5e, 0xf7, 0x55, 0xf6, 0xd4, 0xe4, 0xe6, 0x09,
-	0x7d, 0xa9, 0x41, 0x5a, 0x35, 0x4d, 0xd3, 0xbd, 0x05, 0x95, 0xa4, 0x7e, 0x7e, 0x40, 0x52, 0x82,
-	0x5c, 0x66, 0x20, 0x0b, 0xe8, 0xd2, 0x3e, 0x41, 0x22, 0x0b, 0x85, 0x1a, 0x90, 0x56, 0x2c, 0x52,
-	0x9c, 0x42, 0x49, 0xc6, 0x29, 0x12, 0xcc, 0x95, 0x51, 0x60, 0x14, 0x53, 0xe8, 0x8c, 0x42, 0x41,
-	0xa3, 0x71, 0x55, 0x6e, 0xae, 0xd0, 0x0b, 0x0d, 0xb2, 0x31, 0x43, 0x15, 0x7b, 0x21, 0xbd, 0x23,
-	0xe2, 0x2f, 0xa4, 0x9f, 0x95, 0x32, 0x6e, 0x30, 0x82, 0x6b, 0xe8, 0xc3, 0x7d, 0xf6, 0x21, 0xe6,
-	0x6f, 0xd0, 0x73, 0x0d, 0xc6, 0x63, 0x96, 0x08, 0x9d, 0x1b, 0x46, 0x40, 0xf5, 0x0b, 0x43, 0x87,
-	0x0c, 0x3c, 0x64, 0x0a, 0x65, 0xdc, 0x74, 0xa1, 0x6f, 0x35, 0xc8, 0x74, 0x1b, 0xa5, 0x99, 0xbe,
-	0xe5, 0xa2, 0x74, 0xfc, 0xb8, 0x27, 0xfa, 0x23, 0xe3, 0x3a, 0x23, 0xb9, 0x8a, 0xae, 0x74, 0x93,
-	0xd8, 0xce, 0xd0, 0x7e, 0xb1, 0x66, 0x3d, 0xd3, 0x60, 0xac, 0xdb, 0xf0, 0xa0, 0xfc, 0xc0, 0xc2,
-	0x54, 0xff, 0xff, 0xe0, 0xbc, 0x24, 0x5b, 0x60, 0x64, 0x73, 0x68, 0x76, 0x58, 0x8f, 0x78, 0x83,
-	0xee, 0xc3, 0x51, 0xee, 0x65, 0xd0, 0x64, 0x6f, 0x01, 0x1e, 0xd7, 0xf3, 0xc9, 0x71, 0x59, 0x70,
-	0x8a, 0x15, 0x3c, 0x85, 0xc6, 0x95, 0x82, 0xdc, 0x10, 0xa1, 0x87, 0x70, 0xac, 0xed, 0x85, 0xce,
-	0xc4, 0x4e, 0x23, 0x4f, 0xe8, 0x85, 0x3e, 0x09, 0xa9, 0x3f, 0xcb, 0xf4, 0x0b, 0x68, 0xc6, 0xac,
-	0x3b, 0x34, 0x8c, 0x7d, 0xc1, 0x84, 0xbd, 0x41, 0x5f, 0x6b, 0x90, 0x8d, 0x59, 0x9b, 0x62, 0xb2,
-	0x78, 0x67, 0x44, 0xfc, 0x88, 0xf4, 0x73, 0x39, 0xca, 0x57, 0x74, 0x00, 0x87, 0xe2, 0x80, 0xa2,
-	0x0f, 0x3c, 0x8a, 0x5b, 0x0e, 0x64, 0xf4, 0x56, 0x8c, 0x8f, 0xd1, 0x2f, 0x0e, 0x1f, 0x23, 0xb9,
-	0x96, 0x18, 0xd7, 0x7b, 0xe8, 0x62, 0xf2, 0x56, 0xec, 0xec, 0xc4, 0x80, 0x4d, 0xad, 0x52, 0x12,
-	0xa2, 0xcf, 0x60, 0x64, 0x7d, 0x63, 0x19, 0xe9, 0xb1, 0xbb, 0x4d, 0xde, 0xd2, 0xfa, 0x74, 0x62,
-	0x4e, 0xd4, 0xcc, 0xb3, 0x9a, 0x39, 0x34, 0xa9, 0xd4, 0x24, 0x2e, 0x96, 0x77, 0x9b, 0x03, 0x23,
-	0x9b, 0x49, 0xfa, 0x9b, 0x03, 0xf4, 0x15, 0xfb, 0x61, 0x5c, 0x60, 0xfa, 0xe7, 0xd1, 0x39, 0x45,
-	0x7f, 0xa7, 0xa3, 0x6f, 0xee, 0xb5, 0x3d, 0xce, 0xd3, 0x68, 0x29, 0x95, 0xa4, 0x52, 0x95, 0x01,
-	0xa5, 0x2a, 0x43, 0x96, 0x42, 0x3b, 0xa5, 0x56, 0x3e, 0x7e, 0xf9, 0x57, 0x3e, 0xf5, 0xf2, 0x4d,
-	0x5e, 0x7b, 0xf5, 0x26, 0xaf, 0xfd, 0xf9, 0x26, 0xaf, 0x7d, 0xf3, 0x36, 0x9f, 0x7a, 0xf5, 0x36,
-	0x9f, 0xfa, 0xed, 0x6d, 0x3e, 0x75, 0x6f, 0x41, 0xf9, 0xd5, 0x63, 0x0d, 0x93, 0xd6, 0x86, 0x13,
-	0xd6, 0x03, 0xec, 0x99, 0xb6, 0x6b, 0xd5, 0xb1, 0xe3, 0x99, 0x4f, 0xda, 0xaa, 0xec, 0x07, 0x90,
-	0xad, 0xa3, 0xec, 0xe7, 0x9b, 0xcb, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x75, 0xcc, 0x8d, 0x3b,
-	0x69, 0x12, 0x00, 0x00,
+func (m *QueryTWAPRequest) Reset()         { *m = QueryTWAPRequest{} }
+func (m *QueryTWAPRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPRequest) ProtoMessage()    {}
+func (*QueryTWAPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{32}
+}
+func (m *QueryTWAPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTWAPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTWAPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTWAPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPRequest.Merge(m, src)
+}
+func (m *QueryTWAPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTWAPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryTWAPRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ExchangeRates returns exchange rates of all denoms,
-	// or, if specified, returns a single denom
-	ExchangeRates(ctx context.Context, in *QueryExchangeRates, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
-	// ActiveExchangeRates returns all active denoms
-	ActiveExchangeRates(ctx context.Context, in *QueryActiveExchangeRates, opts ...grpc.CallOption) (*QueryActiveExchangeRatesResponse, error)
-	// FeederDelegation returns feeder delegation of a validator
-	FeederDelegation(ctx context.Context, in *QueryFeederDelegation, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
-	// MissCounter returns oracle miss counter of a validator
-	MissCounter(ctx context.Context, in *QueryMissCounter, opts ...grpc.CallOption) (*QueryMissCounterResponse, error)
-	// SlashWindow returns slash window information
-	SlashWindow(ctx context.Context, in *QuerySlashWindow, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error)
-	// AggregatePrevote returns an aggregate prevote of a validator
-	AggregatePrevote(ctx context.Context, in *QueryAggregatePrevote, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error)
-	// AggregatePrevotes returns aggregate prevotes of all validators
-	AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotes, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error)
-	// AggregateVote returns an aggregate vote of a validator
-	AggregateVote(ctx context.Context, in *QueryAggregateVote, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error)
-	// AggregateVotes returns aggregate votes of all validators
-	AggregateVotes(ctx context.Context, in *QueryAggregateVotes, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error)
-	// Params queries all parameters.
-	Params(ctx context.Context, in *QueryParams, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Medians returns medians of all denoms,
-	// or, if specified, returns a single median
-	Medians(ctx context.Context, in *QueryMedians, opts ...grpc.CallOption) (*QueryMediansResponse, error)
-	// MedianDeviations returns median deviations of all denoms,
-	// or, if specified, returns a single median deviation
-	MedianDeviations(ctx context.Context, in *QueryMedianDeviations, opts ...grpc.CallOption) (*QueryMedianDeviationsResponse, error)
-	// ValidatorRewardSet returns the set of validators that is
-	// earning rewards for voting on exchange rates based on their
-	// misscounter in a given Slash Window
-	ValidatorRewardSet(ctx context.Context, in *QueryValidatorRewardSet, opts ...grpc.CallOption) (*QueryValidatorRewardSetResponse, error)
-	EMA(ctx context.Context, in *QueryEMARequest, opts ...grpc.CallOption) (*QueryEMAResponse, error)
-	WMA(ctx context.Context, in *QueryWMARequest, opts ...grpc.CallOption) (*QueryWMAResponse, error)
-	SMA(ctx context.Context, in *QuerySMARequest, opts ...grpc.CallOption) (*QuerySMAResponse, error)
+// QueryTWAPResponse is the response type for the Query/TWAP RPC method.
+type QueryTWAPResponse struct {
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryTWAPResponse) Reset()         { *m = QueryTWAPResponse{} }
+func (m *QueryTWAPResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPResponse) ProtoMessage()    {}
+func (*QueryTWAPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{33}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryTWAPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRates, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
-	out := new(QueryExchangeRatesResponse)
-	err := c.cc.Invoke(ctx, "/oracle.v1.Query/ExchangeRates", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTWAPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTWAPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTWAPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPResponse.Merge(m, src)
+}
+func (m *QueryTWAPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTWAPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ActiveExchangeRates(ctx context.Context, in *QueryActiveExchangeRates, opts ...grpc.CallOption) (*QueryActiveExchangeRatesResponse, error) {
-	out := new(QueryActiveExchangeRatesResponse)
-	err := c.cc.Invoke(ctx, "/oracle.v1.Query/ActiveExchangeRates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryTWAPResponse proto.InternalMessageInfo
+
+// QueryHistoricPricesRequest is the request type for the Query/HistoricPrices
+// RPC method.
+type QueryHistoricPricesRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// start_block is the first block to return prices from, unbounded if zero.
+	StartBlock uint64 `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	// end_block is the last block to return prices from, unbounded if zero.
+	EndBlock   uint64             `protobuf:"varint,3,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) FeederDelegation(ctx context.Context, in *QueryFeederDelegation, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error) {
-	out := new(QueryFeederDelegationResponse)
-	err := c.cc.Invoke(ctx, "/oracle.v1.Query/FeederDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHistoricPricesRequest) Reset()         { *m = QueryHistoricPricesRequest{} }
+func (m *QueryHistoricPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricPricesRequest) ProtoMessage()    {}
+func (*QueryHistoricPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{34}
+}
+func (m *QueryHistoricPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryHistoricPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricPricesRequest.Merge(m, src)
+}
+func (m *QueryHistoricPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricPricesRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) MissCounter(ctx context.Context, in *QueryMissCounter, opts ...grpc.CallOption) (*QueryMissCounterResponse, error) {
-	out := new(QueryMissCounterResponse)
-	err := c.cc.Invoke(ctx, "/oracle.v1.Query/MissCounter", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryHistoricPricesRequest proto.InternalMessageInfo
+
+// QueryHistoricPricesResponse is the response type for the
+// Query/HistoricPrices RPC method.
+type QueryHistoricPricesResponse struct {
+	// historic_prices are the historic prices ordered by block.
+	HistoricPrices []PriceStamp        `protobuf:"bytes,1,rep,name=historic_prices,json=historicPrices,proto3" json:"historic_prices"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) SlashWindow(ctx context.Context, in *QuerySlashWindow, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error) {
-	out := new(QuerySlashWindowResponse)
-	err := c.cc.Invoke(ctx, "/oracle.v1.Query/SlashWindow", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHistoricPricesResponse) Reset()         { *m = QueryHistoricPricesResponse{} }
+func (m *QueryHistoricPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricPricesResponse) ProtoMessage()    {}
+func (*QueryHistoricPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{35}
+}
+func (m *QueryHistoricPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryHistoricPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricPricesResponse.Merge(m, src)
+}
+func (m *QueryHistoricPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricPricesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) AggregatePrevote(ctx context.Context, in *QueryAggregatePrevote, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error) {
-	out := new(QueryAggregatePrevoteResponse)
-	err := c.cc.Invoke(ctx, "/oracle.v1.Query/AggregatePrevote", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryHistoricPricesResponse proto.InternalMessageInfo
+
+// QueryCandlesRequest is the request type for the Query/Candles RPC method.
+type QueryCandlesRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// interval is the candle interval in blocks, one of the CandleIntervals
+	// param.
+	Interval   uint64             `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotes, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error) {
-	out := new(QueryAggregatePrevotesResponse)
-	err := c.cc.Invoke(ctx, "/oracle.v1.Query/AggregatePrevotes", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCandlesRequest) Reset()         { *m = QueryCandlesRequest{} }
+func (m *QueryCandlesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCandlesRequest) ProtoMessage()    {}
+func (*QueryCandlesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{36}
+}
+func (m *QueryCandlesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCandlesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCandlesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCandlesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCandlesRequest.Merge(m, src)
+}
+func (m *QueryCandlesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCandlesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCandlesRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) AggregateVote(ctx context.Context, in *QueryAggregateVote, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error) {
-	out := new(QueryAggregateVoteResponse)
-	err := c.cc.Invoke(ctx, "/oracle.v1.Query/AggregateVote", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryCandlesRequest proto.InternalMessageInfo
+
+// QueryCandlesResponse is the response type for the Query/Candles RPC method.
+type QueryCandlesResponse struct {
+	// candles are the candles ordered by start block.
+	Candles    []Candle            `protobuf:"bytes,1,rep,name=candles,proto3" json:"candles"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) AggregateVotes(ctx context.Context, in *QueryAggregateVotes, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error) {
-	out := new(QueryAggregateVotesResponse)
-	err := c.cc.Invoke(ctx, "/oracle.v1.Query/AggregateVotes", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCandlesResponse) Reset()         { *m = QueryCandlesResponse{} }
+func (m *QueryCandlesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCandlesResponse) ProtoMessage()    {}
+func (*QueryCandlesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{37}
+}
+func (m *QueryCandlesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCandlesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCandlesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCandlesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCandlesResponse.Merge(m, src)
+}
+func (m *QueryCandlesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCandlesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCandlesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParams, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/oracle.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryCandlesResponse proto.InternalMessageInfo
+
+// QueryPriceStatisticsRequest is the request type for the
+// Query/PriceStatistics RPC method.
+type QueryPriceStatisticsRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// num_stamps is the amount of latest historic prices to compute the
+	// statistics of, the MaximumPriceStamps param if zero.
+	NumStamps uint64 `protobuf:"varint,2,opt,name=num_stamps,json=numStamps,proto3" json:"num_stamps,omitempty"`
+	// percentiles are the percentiles to return in whole percent, 5, 25, 75
+	// and 95 if empty.
+	Percentiles []uint32 `protobuf:"varint,3,rep,packed,name=percentiles,proto3" json:"percentiles,omitempty"`
 }
 
-func (c *queryClient) Medians(ctx context.Context, in *QueryMedians, opts ...grpc.CallOption) (*QueryMediansResponse, error) {
-	out := new(QueryMediansResponse)
-	err := c.cc.Invoke(ctx, "/oracle.v1.Query/Medians", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPriceStatisticsRequest) Reset()         { *m = QueryPriceStatisticsRequest{} }
+func (m *QueryPriceStatisticsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceStatisticsRequest) ProtoMessage()    {}
+func (*QueryPriceStatisticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{38}
 }
-
-func (c *queryClient) MedianDeviations(ctx context.Context, in *QueryMedianDeviations, opts ...grpc.CallOption) (*QueryMedianDeviationsResponse, error) {
-	out := new(QueryMedianDeviationsResponse)
-	err := c.cc.Invoke(ctx, "/oracle.v1.Query/MedianDeviations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPriceStatisticsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceStatisticsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPriceStatisticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceStatisticsRequest.Merge(m, src)
+}
+func (m *QueryPriceStatisticsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceStatisticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceStatisticsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ValidatorRewardSet(ctx context.Context, in *QueryValidatorRewardSet, opts ...grpc.CallOption) (*QueryValidatorRewardSetResponse, error) {
-	out := new(QueryValidatorRewardSetResponse)
-	err := c.cc.Invoke(ctx, "/oracle.v1.Query/ValidatorRewardSet", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryPriceStatisticsRequest proto.InternalMessageInfo
+
+// QueryPriceStatisticsResponse is the response type for the
+// Query/PriceStatistics RPC method.
+type QueryPriceStatisticsResponse struct {
+	Statistics PriceStatistics `protobuf:"bytes,1,opt,name=statistics,proto3" json:"statistics"`
 }
 
-func (c *queryClient) EMA(ctx context.Context, in *QueryEMARequest, opts ...grpc.CallOption) (*QueryEMAResponse, error) {
-	out := new(QueryEMAResponse)
-	err := c.cc.Invoke(ctx, "/oracle.v1.Query/EMA", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPriceStatisticsResponse) Reset()         { *m = QueryPriceStatisticsResponse{} }
+func (m *QueryPriceStatisticsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceStatisticsResponse) ProtoMessage()    {}
+func (*QueryPriceStatisticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{39}
+}
+func (m *QueryPriceStatisticsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceStatisticsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPriceStatisticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceStatisticsResponse.Merge(m, src)
+}
+func (m *QueryPriceStatisticsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceStatisticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceStatisticsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) WMA(ctx context.Context, in *QueryWMARequest, opts ...grpc.CallOption) (*QueryWMAResponse, error) {
-	out := new(QueryWMAResponse)
-	err := c.cc.Invoke(ctx, "/oracle.v1.Query/WMA", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPriceStatisticsResponse proto.InternalMessageInfo
+
+// QueryCrossRateRequest is the request type for the Query/CrossRate RPC
+// method.
+type QueryCrossRateRequest struct {
+	Base  string `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Quote string `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	// source is the USD rate the cross rate is derived from, the spot rate if
+	// unspecified.
+	Source PriceSource `protobuf:"varint,3,opt,name=source,proto3,enum=oracle.v1.PriceSource" json:"source,omitempty"`
+	// window_seconds is the TWAP window in seconds, 30 minutes if zero. It is
+	// only used by the TWAP source.
+	WindowSeconds uint64 `protobuf:"varint,4,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	// base_denoms treats base and quote as base denoms of the accept list, so
+	// that the rate is of base units.
+	BaseDenoms bool `protobuf:"varint,5,opt,name=base_denoms,json=baseDenoms,proto3" json:"base_denoms,omitempty"`
+}
+
+func (m *QueryCrossRateRequest) Reset()         { *m = QueryCrossRateRequest{} }
+func (m *QueryCrossRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCrossRateRequest) ProtoMessage()    {}
+func (*QueryCrossRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{40}
+}
+func (m *QueryCrossRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCrossRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCrossRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCrossRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCrossRateRequest.Merge(m, src)
+}
+func (m *QueryCrossRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCrossRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCrossRateRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) SMA(ctx context.Context, in *QuerySMARequest, opts ...grpc.CallOption) (*QuerySMAResponse, error) {
-	out := new(QuerySMAResponse)
-	err := c.cc.Invoke(ctx, "/oracle.v1.Query/SMA", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCrossRateRequest proto.InternalMessageInfo
+
+// QueryCrossRateResponse is the response type for the Query/CrossRate RPC
+// method.
+type QueryCrossRateResponse struct {
+	// rate is the amount of quote per base.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	// base_rate is the USD rate of the base.
+	BaseRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_rate,json=baseRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_rate"`
+	// quote_rate is the USD rate of the quote.
+	QuoteRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=quote_rate,json=quoteRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"quote_rate"`
+}
+
+func (m *QueryCrossRateResponse) Reset()         { *m = QueryCrossRateResponse{} }
+func (m *QueryCrossRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCrossRateResponse) ProtoMessage()    {}
+func (*QueryCrossRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{41}
+}
+func (m *QueryCrossRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCrossRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCrossRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ExchangeRates returns exchange rates of all denoms,
-	// or, if specified, returns a single denom
-	ExchangeRates(context.Context, *QueryExchangeRates) (*QueryExchangeRatesResponse, error)
-	// ActiveExchangeRates returns all active denoms
-	ActiveExchangeRates(context.Context, *QueryActiveExchangeRates) (*QueryActiveExchangeRatesResponse, error)
-	// FeederDelegation returns feeder delegation of a validator
-	FeederDelegation(context.Context, *QueryFeederDelegation) (*QueryFeederDelegationResponse, error)
-	// MissCounter returns oracle miss counter of a validator
-	MissCounter(context.Context, *QueryMissCounter) (*QueryMissCounterResponse, error)
-	// SlashWindow returns slash window information
-	SlashWindow(context.Context, *QuerySlashWindow) (*QuerySlashWindowResponse, error)
-	// AggregatePrevote returns an aggregate prevote of a validator
-	AggregatePrevote(context.Context, *QueryAggregatePrevote) (*QueryAggregatePrevoteResponse, error)
-	// AggregatePrevotes returns aggregate prevotes of all validators
-	AggregatePrevotes(context.Context, *QueryAggregatePrevotes) (*QueryAggregatePrevotesResponse, error)
-	// AggregateVote returns an aggregate vote of a validator
-	AggregateVote(context.Context, *QueryAggregateVote) (*QueryAggregateVoteResponse, error)
-	// AggregateVotes returns aggregate votes of all validators
-	AggregateVotes(context.Context, *QueryAggregateVotes) (*QueryAggregateVotesResponse, error)
-	// Params queries all parameters.
-	Params(context.Context, *QueryParams) (*QueryParamsResponse, error)
-	// Medians returns medians of all denoms,
-	// or, if specified, returns a single median
-	Medians(context.Context, *QueryMedians) (*QueryMediansResponse, error)
-	// MedianDeviations returns median deviations of all denoms,
-	// or, if specified, returns a single median deviation
-	MedianDeviations(context.Context, *QueryMedianDeviations) (*QueryMedianDeviationsResponse, error)
-	// ValidatorRewardSet returns the set of validators that is
-	// earning rewards for voting on exchange rates based on their
-	// misscounter in a given Slash Window
-	ValidatorRewardSet(context.Context, *QueryValidatorRewardSet) (*QueryValidatorRewardSetResponse, error)
-	EMA(context.Context, *QueryEMARequest) (*QueryEMAResponse, error)
-	WMA(context.Context, *QueryWMARequest) (*QueryWMAResponse, error)
-	SMA(context.Context, *QuerySMARequest) (*QuerySMAResponse, error)
+func (m *QueryCrossRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCrossRateResponse.Merge(m, src)
+}
+func (m *QueryCrossRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCrossRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCrossRateResponse.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryCrossRateResponse proto.InternalMessageInfo
+
+// QueryLastExchangeRateRequest is the request type for the
+// Query/LastExchangeRate RPC method.
+type QueryLastExchangeRateRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (*UnimplementedQueryServer) ExchangeRates(ctx context.Context, req *QueryExchangeRates) (*QueryExchangeRatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
+func (m *QueryLastExchangeRateRequest) Reset()         { *m = QueryLastExchangeRateRequest{} }
+func (m *QueryLastExchangeRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLastExchangeRateRequest) ProtoMessage()    {}
+func (*QueryLastExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{42}
 }
-func (*UnimplementedQueryServer) ActiveExchangeRates(ctx context.Context, req *QueryActiveExchangeRates) (*QueryActiveExchangeRatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActiveExchangeRates not implemented")
+func (m *QueryLastExchangeRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) FeederDelegation(ctx context.Context, req *QueryFeederDelegation) (*QueryFeederDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FeederDelegation not implemented")
+func (m *QueryLastExchangeRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastExchangeRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) MissCounter(ctx context.Context, req *QueryMissCounter) (*QueryMissCounterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MissCounter not implemented")
+func (m *QueryLastExchangeRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastExchangeRateRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) SlashWindow(ctx context.Context, req *QuerySlashWindow) (*QuerySlashWindowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SlashWindow not implemented")
+func (m *QueryLastExchangeRateRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) AggregatePrevote(ctx context.Context, req *QueryAggregatePrevote) (*QueryAggregatePrevoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevote not implemented")
+func (m *QueryLastExchangeRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastExchangeRateRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) AggregatePrevotes(ctx context.Context, req *QueryAggregatePrevotes) (*QueryAggregatePrevotesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevotes not implemented")
+
+var xxx_messageInfo_QueryLastExchangeRateRequest proto.InternalMessageInfo
+
+// QueryLastExchangeRateResponse is the response type for the
+// Query/LastExchangeRate RPC method.
+type QueryLastExchangeRateResponse struct {
+	Status ExchangeRateStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status"`
 }
-func (*UnimplementedQueryServer) AggregateVote(ctx context.Context, req *QueryAggregateVote) (*QueryAggregateVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregateVote not implemented")
+
+func (m *QueryLastExchangeRateResponse) Reset()         { *m = QueryLastExchangeRateResponse{} }
+func (m *QueryLastExchangeRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLastExchangeRateResponse) ProtoMessage()    {}
+func (*QueryLastExchangeRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{43}
 }
-func (*UnimplementedQueryServer) AggregateVotes(ctx context.Context, req *QueryAggregateVotes) (*QueryAggregateVotesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregateVotes not implemented")
+func (m *QueryLastExchangeRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParams) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryLastExchangeRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastExchangeRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Medians(ctx context.Context, req *QueryMedians) (*QueryMediansResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Medians not implemented")
+func (m *QueryLastExchangeRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastExchangeRateResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) MedianDeviations(ctx context.Context, req *QueryMedianDeviations) (*QueryMedianDeviationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MedianDeviations not implemented")
+func (m *QueryLastExchangeRateResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ValidatorRewardSet(ctx context.Context, req *QueryValidatorRewardSet) (*QueryValidatorRewardSetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorRewardSet not implemented")
+func (m *QueryLastExchangeRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastExchangeRateResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) EMA(ctx context.Context, req *QueryEMARequest) (*QueryEMAResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EMA not implemented")
+
+var xxx_messageInfo_QueryLastExchangeRateResponse proto.InternalMessageInfo
+
+// QueryLastExchangeRatesRequest is the request type for the
+// Query/LastExchangeRates RPC method.
+type QueryLastExchangeRatesRequest struct {
 }
-func (*UnimplementedQueryServer) WMA(ctx context.Context, req *QueryWMARequest) (*QueryWMAResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WMA not implemented")
+
+func (m *QueryLastExchangeRatesRequest) Reset()         { *m = QueryLastExchangeRatesRequest{} }
+func (m *QueryLastExchangeRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLastExchangeRatesRequest) ProtoMessage()    {}
+func (*QueryLastExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{44}
 }
-func (*UnimplementedQueryServer) SMA(ctx context.Context, req *QuerySMARequest) (*QuerySMAResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SMA not implemented")
+func (m *QueryLastExchangeRatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastExchangeRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastExchangeRatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLastExchangeRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastExchangeRatesRequest.Merge(m, src)
+}
+func (m *QueryLastExchangeRatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastExchangeRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastExchangeRatesRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryLastExchangeRatesRequest proto.InternalMessageInfo
+
+// QueryLastExchangeRatesResponse is the response type for the
+// Query/LastExchangeRates RPC method.
+type QueryLastExchangeRatesResponse struct {
+	Statuses []ExchangeRateStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses"`
 }
 
-func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryExchangeRates)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ExchangeRates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/oracle.v1.Query/ExchangeRates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ExchangeRates(ctx, req.(*QueryExchangeRates))
+func (m *QueryLastExchangeRatesResponse) Reset()         { *m = QueryLastExchangeRatesResponse{} }
+func (m *QueryLastExchangeRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLastExchangeRatesResponse) ProtoMessage()    {}
+func (*QueryLastExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{45}
+}
+func (m *QueryLastExchangeRatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastExchangeRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastExchangeRatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryLastExchangeRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastExchangeRatesResponse.Merge(m, src)
+}
+func (m *QueryLastExchangeRatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastExchangeRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastExchangeRatesResponse.DiscardUnknown(m)
 }
 
-func _Query_ActiveExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryActiveExchangeRates)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ActiveExchangeRates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/oracle.v1.Query/ActiveExchangeRates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ActiveExchangeRates(ctx, req.(*QueryActiveExchangeRates))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryLastExchangeRatesResponse proto.InternalMessageInfo
+
+// QueryPriceHaltsRequest is the request type for the Query/PriceHalts RPC
+// method.
+type QueryPriceHaltsRequest struct {
 }
 
-func _Query_FeederDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFeederDelegation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FeederDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/oracle.v1.Query/FeederDelegation",
+func (m *QueryPriceHaltsRequest) Reset()         { *m = QueryPriceHaltsRequest{} }
+func (m *QueryPriceHaltsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceHaltsRequest) ProtoMessage()    {}
+func (*QueryPriceHaltsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{46}
+}
+func (m *QueryPriceHaltsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceHaltsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceHaltsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FeederDelegation(ctx, req.(*QueryFeederDelegation))
+}
+func (m *QueryPriceHaltsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceHaltsRequest.Merge(m, src)
+}
+func (m *QueryPriceHaltsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceHaltsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceHaltsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceHaltsRequest proto.InternalMessageInfo
+
+// QueryPriceHaltsResponse is the response type for the Query/PriceHalts RPC
+// method.
+type QueryPriceHaltsResponse struct {
+	PriceHalts []PriceHalt `protobuf:"bytes,1,rep,name=price_halts,json=priceHalts,proto3" json:"price_halts"`
+}
+
+func (m *QueryPriceHaltsResponse) Reset()         { *m = QueryPriceHaltsResponse{} }
+func (m *QueryPriceHaltsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceHaltsResponse) ProtoMessage()    {}
+func (*QueryPriceHaltsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{47}
+}
+func (m *QueryPriceHaltsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceHaltsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceHaltsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPriceHaltsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceHaltsResponse.Merge(m, src)
+}
+func (m *QueryPriceHaltsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceHaltsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceHaltsResponse.DiscardUnknown(m)
 }
 
-func _Query_MissCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMissCounter)
-	if err := dec(in); err != nil {
+var xxx_messageInfo_QueryPriceHaltsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QueryExchangeRates)(nil), "oracle.v1.QueryExchangeRates")
+	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "oracle.v1.QueryExchangeRatesResponse")
+	proto.RegisterType((*QueryActiveExchangeRates)(nil), "oracle.v1.QueryActiveExchangeRates")
+	proto.RegisterType((*QueryActiveExchangeRatesResponse)(nil), "oracle.v1.QueryActiveExchangeRatesResponse")
+	proto.RegisterType((*QueryFeederDelegation)(nil), "oracle.v1.QueryFeederDelegation")
+	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "oracle.v1.QueryFeederDelegationResponse")
+	proto.RegisterType((*QueryMissCounter)(nil), "oracle.v1.QueryMissCounter")
+	proto.RegisterType((*QueryMissCounterResponse)(nil), "oracle.v1.QueryMissCounterResponse")
+	proto.RegisterType((*QuerySlashWindow)(nil), "oracle.v1.QuerySlashWindow")
+	proto.RegisterType((*QuerySlashWindowResponse)(nil), "oracle.v1.QuerySlashWindowResponse")
+	proto.RegisterType((*QueryAggregatePrevote)(nil), "oracle.v1.QueryAggregatePrevote")
+	proto.RegisterType((*QueryAggregatePrevoteResponse)(nil), "oracle.v1.QueryAggregatePrevoteResponse")
+	proto.RegisterType((*QueryAggregatePrevotes)(nil), "oracle.v1.QueryAggregatePrevotes")
+	proto.RegisterType((*QueryAggregatePrevotesResponse)(nil), "oracle.v1.QueryAggregatePrevotesResponse")
+	proto.RegisterType((*QueryAggregateVote)(nil), "oracle.v1.QueryAggregateVote")
+	proto.RegisterType((*QueryAggregateVoteResponse)(nil), "oracle.v1.QueryAggregateVoteResponse")
+	proto.RegisterType((*QueryAggregateVotes)(nil), "oracle.v1.QueryAggregateVotes")
+	proto.RegisterType((*QueryAggregateVotesResponse)(nil), "oracle.v1.QueryAggregateVotesResponse")
+	proto.RegisterType((*QueryParams)(nil), "oracle.v1.QueryParams")
+	proto.RegisterType((*QueryParamsResponse)(nil), "oracle.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryMedians)(nil), "oracle.v1.QueryMedians")
+	proto.RegisterType((*QueryMediansResponse)(nil), "oracle.v1.QueryMediansResponse")
+	proto.RegisterType((*QueryMedianDeviations)(nil), "oracle.v1.QueryMedianDeviations")
+	proto.RegisterType((*QueryMedianDeviationsResponse)(nil), "oracle.v1.QueryMedianDeviationsResponse")
+	proto.RegisterType((*QueryValidatorRewardSet)(nil), "oracle.v1.QueryValidatorRewardSet")
+	proto.RegisterType((*QueryValidatorRewardSetResponse)(nil), "oracle.v1.QueryValidatorRewardSetResponse")
+	proto.RegisterType((*QueryEMARequest)(nil), "oracle.v1.QueryEMARequest")
+	proto.RegisterType((*QueryEMAResponse)(nil), "oracle.v1.QueryEMAResponse")
+	proto.RegisterType((*QueryWMARequest)(nil), "oracle.v1.QueryWMARequest")
+	proto.RegisterType((*QueryWMAResponse)(nil), "oracle.v1.QueryWMAResponse")
+	proto.RegisterType((*QuerySMARequest)(nil), "oracle.v1.QuerySMARequest")
+	proto.RegisterType((*QuerySMAResponse)(nil), "oracle.v1.QuerySMAResponse")
+	proto.RegisterType((*QueryTWAPRequest)(nil), "oracle.v1.QueryTWAPRequest")
+	proto.RegisterType((*QueryTWAPResponse)(nil), "oracle.v1.QueryTWAPResponse")
+	proto.RegisterType((*QueryHistoricPricesRequest)(nil), "oracle.v1.QueryHistoricPricesRequest")
+	proto.RegisterType((*QueryHistoricPricesResponse)(nil), "oracle.v1.QueryHistoricPricesResponse")
+	proto.RegisterType((*QueryCandlesRequest)(nil), "oracle.v1.QueryCandlesRequest")
+	proto.RegisterType((*QueryCandlesResponse)(nil), "oracle.v1.QueryCandlesResponse")
+	proto.RegisterType((*QueryPriceStatisticsRequest)(nil), "oracle.v1.QueryPriceStatisticsRequest")
+	proto.RegisterType((*QueryPriceStatisticsResponse)(nil), "oracle.v1.QueryPriceStatisticsResponse")
+	proto.RegisterType((*QueryCrossRateRequest)(nil), "oracle.v1.QueryCrossRateRequest")
+	proto.RegisterType((*QueryCrossRateResponse)(nil), "oracle.v1.QueryCrossRateResponse")
+	proto.RegisterType((*QueryLastExchangeRateRequest)(nil), "oracle.v1.QueryLastExchangeRateRequest")
+	proto.RegisterType((*QueryLastExchangeRateResponse)(nil), "oracle.v1.QueryLastExchangeRateResponse")
+	proto.RegisterType((*QueryLastExchangeRatesRequest)(nil), "oracle.v1.QueryLastExchangeRatesRequest")
+	proto.RegisterType((*QueryLastExchangeRatesResponse)(nil), "oracle.v1.QueryLastExchangeRatesResponse")
+	proto.RegisterType((*QueryPriceHaltsRequest)(nil), "oracle.v1.QueryPriceHaltsRequest")
+	proto.RegisterType((*QueryPriceHaltsResponse)(nil), "oracle.v1.QueryPriceHaltsResponse")
+}
+
+func init() { proto.RegisterFile("oracle/v1/query.proto", fileDescriptor_34238c8dfdfcd7ec) }
+
+var fileDescriptor_34238c8dfdfcd7ec = []byte{
+	// 2182 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x5a, 0xb2, 0x2d, 0x3d, 0x86, 0xb2, 0x34, 0xb6, 0x65, 0x7a, 0x65, 0x91, 0xf4, 0xca,
+	0x92, 0x68, 0x39, 0xe2, 0x56, 0x72, 0x8c, 0x14, 0x29, 0x82, 0x5a, 0xff, 0x1c, 0x01, 0x8d, 0x0a,
+	0x85, 0x2c, 0x2c, 0x20, 0x31, 0xca, 0x8e, 0x76, 0x27, 0xe4, 0xc6, 0xe4, 0x2e, 0xbd, 0xb3, 0x94,
+	0x2c, 0x08, 0x0a, 0xd0, 0xa2, 0x40, 0x0a, 0xf4, 0x90, 0xa0, 0x69, 0x8b, 0x1e, 0x7d, 0x2e, 0x7a,
+	0xec, 0xa1, 0xfd, 0x06, 0x3e, 0x06, 0xed, 0xa1, 0x45, 0x0f, 0x69, 0x6b, 0xf7, 0xd0, 0x8f, 0x51,
+	0xec, 0xcc, 0xec, 0x70, 0xb8, 0xbb, 0x24, 0x65, 0x37, 0x39, 0xd9, 0x7c, 0xef, 0xcd, 0xef, 0xfd,
+	0xde, 0xdb, 0x99, 0x37, 0xf3, 0x9e, 0xe0, 0xaa, 0xe7, 0x63, 0xab, 0x49, 0xcc, 0xc3, 0x55, 0xf3,
+	0x49, 0x87, 0xf8, 0xc7, 0xe5, 0xb6, 0xef, 0x05, 0x1e, 0x9a, 0xe0, 0xe2, 0xf2, 0xe1, 0xaa, 0x7e,
+	0xa3, 0xee, 0x79, 0xf5, 0x26, 0x31, 0x71, 0xdb, 0x31, 0xb1, 0xeb, 0x7a, 0x01, 0x0e, 0x1c, 0xcf,
+	0xa5, 0xdc, 0x50, 0x9f, 0xe9, 0xae, 0x17, 0x4b, 0xb8, 0x3c, 0x6f, 0x79, 0xb4, 0xe5, 0x51, 0xf3,
+	0x00, 0xd3, 0x50, 0x79, 0x40, 0x02, 0xbc, 0x6a, 0x5a, 0x9e, 0xe3, 0x0a, 0xfd, 0x75, 0xae, 0xaf,
+	0xb1, 0x5f, 0x26, 0xff, 0x21, 0x54, 0x57, 0xea, 0x5e, 0xdd, 0xe3, 0xf2, 0xf0, 0x7f, 0x42, 0xba,
+	0xac, 0x02, 0x32, 0xaa, 0x12, 0xb6, 0x8d, 0xeb, 0x8e, 0xcb, 0x58, 0x71, 0x5b, 0xe3, 0x2d, 0x40,
+	0x1f, 0x84, 0x16, 0xdb, 0x4f, 0xad, 0x06, 0x76, 0xeb, 0xa4, 0x82, 0x03, 0x42, 0xd1, 0x15, 0x38,
+	0x6f, 0x13, 0xd7, 0x6b, 0xe5, 0xb4, 0xa2, 0x56, 0x9a, 0xa8, 0xf0, 0x1f, 0xef, 0x8c, 0xff, 0xe2,
+	0x59, 0x61, 0xe4, 0xbf, 0xcf, 0x0a, 0x23, 0xc6, 0x6f, 0x35, 0xd0, 0x93, 0xcb, 0x2a, 0x84, 0xb6,
+	0x3d, 0x97, 0x12, 0xf4, 0x14, 0x26, 0x89, 0x50, 0xd4, 0xfc, 0x50, 0x93, 0xd3, 0x8a, 0xa3, 0xa5,
+	0xcc, 0xda, 0x8d, 0xb2, 0x60, 0x1f, 0x32, 0x2b, 0x0b, 0x4e, 0xe5, 0x2d, 0x62, 0x6d, 0x7a, 0x8e,
+	0xbb, 0x71, 0xf7, 0xf9, 0xd7, 0x85, 0x91, 0xdf, 0xff, 0xb3, 0x70, 0xa7, 0xee, 0x04, 0x8d, 0xce,
+	0x41, 0xd9, 0xf2, 0x5a, 0x22, 0x5a, 0xf1, 0xcf, 0x0a, 0xb5, 0x1f, 0x9b, 0xc1, 0x71, 0x9b, 0xd0,
+	0x68, 0x0d, 0xad, 0x64, 0x89, 0xca, 0xc0, 0xd0, 0x21, 0xc7, 0x78, 0xad, 0x5b, 0x81, 0x73, 0x48,
+	0x7a, 0xd8, 0x19, 0xdb, 0x50, 0xec, 0xa7, 0x93, 0xcc, 0x6f, 0xc2, 0x1b, 0x98, 0xa9, 0x15, 0xde,
+	0x13, 0x95, 0x0c, 0x97, 0x71, 0x98, 0x1d, 0xb8, 0xca, 0x60, 0x1e, 0x10, 0x62, 0x13, 0x7f, 0x8b,
+	0x34, 0x49, 0x9d, 0x25, 0x14, 0x2d, 0xc0, 0xe4, 0x21, 0x6e, 0x3a, 0x36, 0x0e, 0x3c, 0xbf, 0x86,
+	0x6d, 0xdb, 0x17, 0xd9, 0xcb, 0x4a, 0xe9, 0xba, 0x6d, 0xfb, 0x4a, 0x16, 0xef, 0xc3, 0x5c, 0x2a,
+	0x92, 0x64, 0x53, 0x80, 0xcc, 0xc7, 0x4c, 0xa7, 0xc2, 0x01, 0x17, 0x85, 0x58, 0xc6, 0x26, 0x4c,
+	0x31, 0x84, 0x5d, 0x87, 0xd2, 0x4d, 0xaf, 0xe3, 0x06, 0xc4, 0x7f, 0x75, 0x1a, 0xef, 0x42, 0x2e,
+	0x0e, 0xa2, 0xe6, 0xa3, 0xe5, 0x50, 0x5a, 0xb3, 0xb8, 0x9c, 0x41, 0x8d, 0x55, 0x32, 0xad, 0xae,
+	0xa9, 0x81, 0x04, 0x87, 0x6a, 0x13, 0xd3, 0xc6, 0xbe, 0xe3, 0xda, 0xde, 0x91, 0xb1, 0x09, 0xb9,
+	0xb8, 0x4c, 0x42, 0x2e, 0xc1, 0xa5, 0x23, 0x26, 0x09, 0x37, 0x74, 0xdd, 0x27, 0x94, 0x0a, 0xd4,
+	0x49, 0x2e, 0xde, 0x13, 0x52, 0x99, 0xe8, 0xf5, 0x7a, 0xdd, 0x0f, 0x33, 0x43, 0xf6, 0x7c, 0x72,
+	0xe8, 0x05, 0xe4, 0xd5, 0x23, 0x3c, 0x81, 0xb9, 0x54, 0x24, 0xc9, 0xe9, 0x43, 0x98, 0xc6, 0x91,
+	0xae, 0xd6, 0xe6, 0x4a, 0x06, 0x9a, 0x59, 0x5b, 0x2a, 0xcb, 0xf3, 0x5d, 0x96, 0xeb, 0xd5, 0xcd,
+	0x23, 0xb0, 0x36, 0xc6, 0xc2, 0xed, 0x5b, 0x99, 0xc2, 0x31, 0x1f, 0x46, 0x0e, 0x66, 0x52, 0x9d,
+	0x53, 0xe3, 0x53, 0xc8, 0xa7, 0x6b, 0x24, 0xaf, 0x47, 0x80, 0x12, 0xbc, 0xa2, 0xc3, 0xf4, 0x8a,
+	0xc4, 0xa6, 0x71, 0xc2, 0xff, 0xb6, 0x38, 0xfb, 0x72, 0xf5, 0xc3, 0xd7, 0xca, 0xae, 0x07, 0x7a,
+	0x12, 0x46, 0x86, 0xf0, 0x01, 0x4c, 0x76, 0x43, 0x50, 0xf2, 0x7a, 0x6b, 0x18, 0xfd, 0x87, 0x5d,
+	0xee, 0x59, 0xac, 0x42, 0x1b, 0x57, 0xe1, 0x72, 0xd2, 0x21, 0x35, 0x7c, 0x98, 0x4d, 0x11, 0x4b,
+	0x22, 0x55, 0xb8, 0xd4, 0x4b, 0x24, 0x4a, 0xe4, 0xab, 0x30, 0x99, 0xc4, 0xbd, 0x3e, 0xb3, 0x90,
+	0x61, 0x3e, 0xf7, 0xb0, 0x8f, 0x5b, 0xd4, 0x78, 0x00, 0x97, 0x95, 0x9f, 0xd2, 0xb5, 0x09, 0x17,
+	0xda, 0x4c, 0x22, 0x62, 0x9f, 0x56, 0x3c, 0x72, 0x53, 0x01, 0x2f, 0xcc, 0x8c, 0x5d, 0x78, 0x83,
+	0x1f, 0x49, 0x62, 0x3b, 0xd8, 0xed, 0x53, 0x8f, 0xd1, 0x1c, 0x80, 0xdb, 0x69, 0xd5, 0x68, 0x80,
+	0x5b, 0x6d, 0x9a, 0x3b, 0x57, 0xd4, 0x4a, 0xd9, 0xca, 0x84, 0xdb, 0x69, 0x55, 0x99, 0x40, 0xf9,
+	0x42, 0xbb, 0x70, 0x45, 0x85, 0x93, 0xbc, 0xee, 0xc1, 0xc5, 0x16, 0x17, 0x89, 0x54, 0x5c, 0x55,
+	0x89, 0xf9, 0x8e, 0x45, 0x18, 0x94, 0x20, 0x17, 0xd9, 0x1a, 0x6f, 0xc3, 0x55, 0x05, 0x6e, 0x8b,
+	0x1c, 0x3a, 0xfc, 0x9e, 0x1b, 0x7a, 0x6d, 0x38, 0x30, 0x97, 0xba, 0x50, 0x12, 0xda, 0x81, 0x69,
+	0xee, 0xa4, 0x66, 0x4b, 0xe5, 0x59, 0xa8, 0x4d, 0xb5, 0x62, 0x88, 0xc6, 0x75, 0xb8, 0xc6, 0x5c,
+	0x3d, 0x8c, 0x36, 0x6d, 0x85, 0x1c, 0x61, 0xdf, 0xae, 0x92, 0xc0, 0xf8, 0x18, 0x0a, 0x7d, 0x54,
+	0x92, 0xc7, 0x26, 0x80, 0xdc, 0xed, 0xd1, 0x47, 0x9b, 0x53, 0x08, 0x24, 0x97, 0x0a, 0x22, 0xca,
+	0x32, 0x63, 0x09, 0x2e, 0xf1, 0x3b, 0x72, 0x77, 0xbd, 0x42, 0x9e, 0x74, 0x08, 0x0d, 0xd2, 0x13,
+	0x64, 0x7c, 0x04, 0x53, 0x5d, 0x43, 0xc1, 0xe0, 0x3d, 0x38, 0xdf, 0x0e, 0xa3, 0xe4, 0x96, 0x1b,
+	0xab, 0x21, 0xfa, 0x3f, 0xbe, 0x2e, 0xcc, 0xf2, 0x9b, 0x90, 0xda, 0x8f, 0xcb, 0x8e, 0x67, 0xb6,
+	0x70, 0xd0, 0x28, 0xbf, 0x4f, 0xea, 0xd8, 0x3a, 0xde, 0x22, 0xd6, 0x5f, 0xfe, 0xb8, 0x02, 0x5c,
+	0x1d, 0x5e, 0x8f, 0x15, 0xbe, 0xde, 0xf8, 0x44, 0xb0, 0xd8, 0x1f, 0xc2, 0x02, 0xe9, 0x30, 0x4e,
+	0x03, 0x1f, 0x07, 0xa4, 0x7e, 0xcc, 0xf6, 0xd2, 0x44, 0x45, 0xfe, 0x0e, 0x6b, 0x82, 0xd5, 0xa1,
+	0x81, 0xd7, 0xaa, 0x1d, 0x11, 0xa7, 0xde, 0x08, 0x68, 0x6e, 0xb4, 0x38, 0x5a, 0x1a, 0xad, 0x64,
+	0xb9, 0x74, 0x9f, 0x0b, 0x65, 0x20, 0xfb, 0xdf, 0x46, 0x20, 0x51, 0x3a, 0xab, 0x67, 0x4d, 0x67,
+	0xf5, 0xdb, 0x60, 0xe1, 0x0a, 0xf0, 0x1f, 0xed, 0xaf, 0xef, 0x0d, 0xce, 0xe7, 0x02, 0x88, 0x0b,
+	0xad, 0x46, 0x89, 0xe5, 0xb9, 0x36, 0x3f, 0xa1, 0x63, 0x95, 0x2c, 0x97, 0x56, 0xb9, 0x30, 0x3c,
+	0xc4, 0xc4, 0xb5, 0x6b, 0x0d, 0x96, 0xc2, 0xdc, 0x28, 0x33, 0x99, 0x20, 0xae, 0xbd, 0xc3, 0x04,
+	0xc6, 0x23, 0x98, 0x56, 0xfc, 0x7d, 0xd3, 0xd1, 0xfc, 0x29, 0x7a, 0xc7, 0xed, 0x38, 0x34, 0xf0,
+	0x7c, 0xc7, 0x62, 0x27, 0x8b, 0x0e, 0x0e, 0xac, 0x00, 0x19, 0x1a, 0x60, 0x3f, 0xa8, 0x1d, 0x34,
+	0x3d, 0xeb, 0xb1, 0x88, 0x0a, 0x98, 0x68, 0x23, 0x94, 0xa0, 0x59, 0x08, 0x03, 0x10, 0x6a, 0x1e,
+	0xd1, 0x38, 0x71, 0x6d, 0xae, 0x7c, 0x00, 0xd0, 0x7d, 0x84, 0xe6, 0xc6, 0xd8, 0xd1, 0x5a, 0xec,
+	0x79, 0x17, 0xf2, 0xc7, 0x75, 0xf4, 0x3a, 0xdc, 0xc3, 0x75, 0x22, 0xf8, 0x54, 0x94, 0x95, 0xc6,
+	0x1f, 0x34, 0x98, 0x4d, 0xa5, 0x2e, 0x72, 0xb4, 0x05, 0x97, 0x1a, 0x42, 0x53, 0x63, 0xc1, 0x9e,
+	0xa9, 0x90, 0x4c, 0x36, 0x7a, 0xd0, 0xd0, 0x7b, 0x3d, 0x6c, 0xcf, 0x89, 0x17, 0xc1, 0x30, 0xb6,
+	0x9c, 0x42, 0x0f, 0xdd, 0xcf, 0x35, 0x71, 0x35, 0x6c, 0x62, 0xd7, 0x6e, 0x0e, 0x4b, 0xb1, 0x0e,
+	0xe3, 0x8e, 0x1b, 0x10, 0xff, 0x10, 0x37, 0x45, 0x7e, 0xe5, 0xef, 0x58, 0x02, 0x47, 0x5f, 0x3b,
+	0x81, 0xbf, 0xd2, 0xe0, 0x4a, 0x2f, 0x23, 0x91, 0xb9, 0x55, 0xb8, 0x68, 0x71, 0x91, 0xc8, 0x98,
+	0x7a, 0x5d, 0x71, 0xe3, 0xe8, 0x46, 0x10, 0x76, 0xdf, 0x5c, 0x9a, 0x02, 0xf1, 0x51, 0xa3, 0x0f,
+	0x13, 0x38, 0x34, 0x70, 0xac, 0x21, 0xd9, 0x4a, 0xde, 0x83, 0x63, 0xca, 0x3d, 0x88, 0x8a, 0x90,
+	0x69, 0x13, 0xdf, 0x22, 0x6e, 0xe0, 0x34, 0x09, 0xaf, 0x5c, 0xd9, 0x8a, 0x2a, 0x32, 0x7e, 0x02,
+	0x37, 0xd2, 0xbd, 0x8a, 0x8c, 0xdc, 0x07, 0xa0, 0x52, 0x2a, 0xae, 0x03, 0x3d, 0x65, 0x1b, 0x09,
+	0x8b, 0xe8, 0x2e, 0xe8, 0xae, 0x31, 0xfe, 0xac, 0x89, 0x3b, 0x73, 0xd3, 0xf7, 0x28, 0x0d, 0x1f,
+	0x15, 0x51, 0x48, 0x08, 0xc6, 0xc2, 0x04, 0x89, 0x88, 0xd8, 0xff, 0xc3, 0x30, 0x9f, 0x74, 0xc2,
+	0xa7, 0x12, 0xaf, 0xc3, 0xfc, 0x07, 0x2a, 0xc3, 0x05, 0xea, 0x75, 0x7c, 0x8b, 0xb0, 0x8f, 0x3e,
+	0xb9, 0x36, 0x93, 0x60, 0xc0, 0xb4, 0x15, 0x61, 0x95, 0x52, 0x80, 0xc6, 0xd2, 0x0a, 0x50, 0x01,
+	0x32, 0xa1, 0xd3, 0x1a, 0xcb, 0x25, 0xcd, 0x9d, 0x2f, 0x6a, 0xa5, 0xf1, 0x0a, 0x84, 0xa2, 0x2d,
+	0x26, 0x31, 0x7e, 0x7a, 0x0e, 0x66, 0xe2, 0xdc, 0x45, 0x62, 0xb6, 0x61, 0xcc, 0xc7, 0xc1, 0xff,
+	0x51, 0x87, 0xd8, 0x72, 0xf4, 0x43, 0x98, 0x60, 0x14, 0x7c, 0x1c, 0xc5, 0xfc, 0x3a, 0x58, 0xe3,
+	0x21, 0x46, 0x48, 0x0f, 0xed, 0x01, 0xb0, 0x94, 0x71, 0xc0, 0xd1, 0xd7, 0x05, 0x9c, 0x60, 0x20,
+	0x21, 0xa2, 0xf1, 0x96, 0xd8, 0x21, 0xef, 0x63, 0x1a, 0xa8, 0x4f, 0xc3, 0xc1, 0x37, 0xd1, 0x23,
+	0x98, 0xeb, 0xb3, 0x4a, 0xe4, 0xef, 0x7b, 0x70, 0x21, 0xdc, 0x24, 0x9d, 0xb4, 0x37, 0x86, 0xba,
+	0xa0, 0xca, 0x8c, 0xa2, 0x47, 0x22, 0x5f, 0x62, 0x14, 0xfa, 0xa0, 0x47, 0xa7, 0xc5, 0xc0, 0x90,
+	0xef, 0x67, 0x20, 0xfc, 0x7f, 0x1f, 0xc6, 0x39, 0x98, 0x3c, 0xeb, 0x67, 0x62, 0x20, 0x17, 0xc9,
+	0xe6, 0x86, 0xed, 0xbf, 0x1d, 0xdc, 0x0c, 0xa4, 0xf3, 0x87, 0x70, 0x2d, 0xa1, 0x91, 0x51, 0x67,
+	0x58, 0x45, 0xae, 0x35, 0x42, 0xb1, 0x70, 0x7c, 0x25, 0xbe, 0x9b, 0xc3, 0x35, 0xd1, 0x49, 0x6a,
+	0x4b, 0x90, 0xb5, 0xbf, 0xe5, 0xe0, 0x3c, 0x03, 0x46, 0x9f, 0x69, 0x90, 0xed, 0x1d, 0x5b, 0xa8,
+	0xe4, 0x93, 0xe3, 0x09, 0x7d, 0x61, 0xa0, 0x3a, 0xa2, 0x67, 0x7c, 0xe7, 0x67, 0x7f, 0xfd, 0xcf,
+	0x97, 0xe7, 0x96, 0x51, 0xc9, 0xec, 0x0e, 0x6c, 0xf8, 0xe1, 0x30, 0x7b, 0xa7, 0x1a, 0xe6, 0x09,
+	0x13, 0x9f, 0xa2, 0xdf, 0x69, 0x70, 0x39, 0x65, 0xaa, 0x80, 0xe6, 0xe3, 0x0e, 0x53, 0x8c, 0xf4,
+	0x3b, 0x67, 0x30, 0x92, 0xdc, 0x4c, 0xc6, 0xed, 0x36, 0x5a, 0x4a, 0x72, 0x13, 0x73, 0x8b, 0x5e,
+	0x8a, 0xe8, 0x37, 0x1a, 0x4c, 0x25, 0x26, 0x15, 0xc5, 0xb8, 0xcb, 0xb8, 0x85, 0x5e, 0x1a, 0x66,
+	0x21, 0x19, 0xdd, 0x63, 0x8c, 0x4c, 0xb4, 0xa2, 0x30, 0xea, 0x3e, 0x82, 0xcd, 0x93, 0xde, 0x5e,
+	0xf2, 0xd4, 0xe4, 0xd3, 0x0b, 0xf4, 0x73, 0x0d, 0x32, 0xea, 0xd4, 0x62, 0x36, 0xee, 0x50, 0x51,
+	0xea, 0xf3, 0x03, 0x94, 0x92, 0xc8, 0x5d, 0x46, 0x64, 0x05, 0xdd, 0x39, 0x23, 0x91, 0x70, 0x86,
+	0x81, 0xda, 0x90, 0x51, 0x66, 0x14, 0x49, 0x16, 0x8a, 0x52, 0x9f, 0x1f, 0xa0, 0x94, 0x2c, 0x0a,
+	0x8c, 0xc5, 0x75, 0x74, 0x4d, 0x61, 0x41, 0x43, 0xbb, 0x1a, 0xaf, 0xba, 0xe8, 0x99, 0x06, 0x53,
+	0x89, 0x89, 0x46, 0xe2, 0x83, 0xc4, 0x2d, 0xf4, 0xd2, 0x30, 0x0b, 0xc9, 0xe0, 0x3e, 0x63, 0xf0,
+	0x0e, 0xfa, 0xee, 0x19, 0xf3, 0x90, 0x18, 0x30, 0xa0, 0x2f, 0x35, 0x98, 0x8e, 0xc3, 0x53, 0x74,
+	0x73, 0x18, 0x03, 0xaa, 0xdf, 0x1e, 0x6a, 0x32, 0xf0, 0x90, 0x29, 0x2c, 0x13, 0xa4, 0x28, 0xfa,
+	0xb5, 0x06, 0xd9, 0xde, 0x49, 0xc5, 0x5c, 0x5f, 0x77, 0xa1, 0x5a, 0x5f, 0x18, 0xa8, 0x96, 0x4c,
+	0xde, 0x65, 0x4c, 0xde, 0x46, 0xf7, 0x7a, 0x99, 0xd8, 0xce, 0xd0, 0x7c, 0xb1, 0x64, 0x7d, 0xa6,
+	0xc1, 0x64, 0x0f, 0x30, 0x45, 0xf9, 0x81, 0x8e, 0xa9, 0xbe, 0x38, 0x58, 0x2f, 0x99, 0xad, 0x30,
+	0x66, 0x4b, 0x68, 0x61, 0x58, 0x8e, 0x78, 0x82, 0x3e, 0x82, 0x0b, 0x7c, 0x98, 0x80, 0x66, 0xe2,
+	0x0e, 0xb8, 0x5c, 0xcf, 0xa7, 0xcb, 0xa5, 0xc3, 0xeb, 0xcc, 0xe1, 0x65, 0x34, 0xad, 0x38, 0xe4,
+	0x13, 0x09, 0xf4, 0x09, 0x5c, 0x8c, 0x86, 0x11, 0xd7, 0x12, 0xa7, 0x91, 0x2b, 0xf4, 0x42, 0x1f,
+	0x85, 0xc4, 0x5f, 0x60, 0xf8, 0x05, 0x34, 0x67, 0xf2, 0x67, 0x76, 0xac, 0x82, 0x89, 0xf9, 0x02,
+	0xfa, 0x5c, 0x83, 0xa9, 0xc4, 0x6c, 0xa1, 0x98, 0x0e, 0xde, 0xb5, 0xd0, 0x4b, 0xc3, 0x2c, 0x52,
+	0xaa, 0xe8, 0x00, 0x1e, 0xca, 0x08, 0x22, 0x2c, 0xf0, 0x28, 0xd9, 0xf3, 0x23, 0x23, 0xee, 0x31,
+	0x69, 0xa3, 0x2f, 0x0f, 0xb7, 0x91, 0xbc, 0xd6, 0x18, 0xaf, 0x37, 0xd1, 0x72, 0xfa, 0x56, 0xec,
+	0xee, 0x44, 0x9f, 0x2d, 0xad, 0x51, 0x12, 0xa0, 0x1f, 0xc3, 0xe8, 0xf6, 0xee, 0x3a, 0xd2, 0x13,
+	0x77, 0x9b, 0x6c, 0x93, 0xf5, 0xd9, 0x54, 0x9d, 0xf0, 0x99, 0x67, 0x3e, 0x73, 0x68, 0x46, 0xf1,
+	0x49, 0x5a, 0x58, 0xde, 0x6d, 0x0e, 0x8c, 0xee, 0xa7, 0xe1, 0xef, 0x0f, 0xc0, 0x57, 0xfa, 0x7f,
+	0xe3, 0x36, 0xc3, 0x9f, 0x47, 0x37, 0x15, 0xfc, 0xa3, 0x2e, 0xbe, 0x79, 0x12, 0x0d, 0x19, 0x4e,
+	0xc3, 0x50, 0xaa, 0x69, 0xae, 0xaa, 0x03, 0x5c, 0x55, 0x87, 0x84, 0x42, 0x95, 0x50, 0x0e, 0x60,
+	0x2c, 0x6c, 0xa3, 0x93, 0x55, 0x5e, 0x69, 0xe6, 0xf5, 0x1b, 0xe9, 0xca, 0x01, 0xe5, 0x3d, 0x38,
+	0xc2, 0x6d, 0xe9, 0xe3, 0x97, 0x1a, 0x4c, 0xf6, 0x76, 0xa4, 0x28, 0x51, 0x87, 0x52, 0x9b, 0x6d,
+	0x7d, 0x71, 0x98, 0x99, 0xa0, 0xb0, 0xcc, 0x28, 0xdc, 0x42, 0x86, 0x42, 0x21, 0xd6, 0xe9, 0x4a,
+	0x36, 0xc7, 0x70, 0x51, 0x74, 0x77, 0xc9, 0xa2, 0xd4, 0xdb, 0x88, 0xea, 0x85, 0xbe, 0xfa, 0x01,
+	0xd5, 0x48, 0xf4, 0x7f, 0xdd, 0x8f, 0x19, 0x75, 0xa9, 0xa7, 0xe1, 0x21, 0xbe, 0x14, 0xeb, 0x8b,
+	0x50, 0x22, 0xc4, 0xf4, 0x36, 0x4f, 0x5f, 0x1a, 0x6a, 0x27, 0x38, 0xdd, 0x61, 0x9c, 0x16, 0xd0,
+	0xbc, 0x5a, 0xb0, 0xd8, 0xd3, 0xb2, 0xdb, 0x7b, 0xc9, 0x64, 0x7c, 0x0a, 0x13, 0xb2, 0x83, 0x49,
+	0x96, 0x93, 0x78, 0x63, 0xa6, 0xdf, 0x1c, 0x60, 0x21, 0xdc, 0xbf, 0xc9, 0xdc, 0x2f, 0xa2, 0x5b,
+	0x6a, 0x4a, 0x42, 0x2b, 0xf6, 0xf8, 0x32, 0x4f, 0xc2, 0x86, 0xe4, 0xd4, 0x3c, 0x61, 0x6d, 0xc4,
+	0x29, 0x7b, 0x8a, 0xc5, 0x9f, 0xe2, 0x28, 0x11, 0x6a, 0x9f, 0x0e, 0x43, 0x2f, 0x0d, 0x37, 0x14,
+	0xac, 0xca, 0x8c, 0x55, 0x09, 0x2d, 0x2a, 0xac, 0x9a, 0x98, 0x06, 0xb5, 0x3e, 0xaf, 0xd7, 0x2f,
+	0x34, 0x98, 0x8e, 0x83, 0x51, 0x34, 0xd4, 0x9f, 0xfc, 0x5a, 0xb7, 0xcf, 0x60, 0x29, 0xa8, 0x2d,
+	0x32, 0x6a, 0x45, 0x94, 0x1f, 0x4c, 0x0d, 0x51, 0x80, 0x6e, 0xdf, 0x90, 0x7c, 0x79, 0x24, 0xba,
+	0x0d, 0xdd, 0x18, 0x64, 0x32, 0xa0, 0x3c, 0x28, 0x7d, 0xc8, 0xc6, 0x0f, 0x9e, 0xff, 0x3b, 0x3f,
+	0xf2, 0xfc, 0x45, 0x5e, 0xfb, 0xea, 0x45, 0x5e, 0xfb, 0xd7, 0x8b, 0xbc, 0xf6, 0xc5, 0xcb, 0xfc,
+	0xc8, 0x57, 0x2f, 0xf3, 0x23, 0x7f, 0x7f, 0x99, 0x1f, 0xf9, 0x70, 0x45, 0xf9, 0xab, 0xe4, 0x16,
+	0x26, 0x87, 0xbb, 0x4e, 0xd0, 0xf0, 0xb1, 0x6b, 0xda, 0x2d, 0xab, 0x81, 0x1d, 0xd7, 0x7c, 0x1a,
+	0xa1, 0xb2, 0x3f, 0x50, 0x1e, 0x5c, 0x60, 0x7f, 0x5e, 0xbd, 0xfb, 0xbf, 0x01, 0x00, 0x92, 0xb8,
+	0xba, 0xb6, 0x35, 0x1e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ExchangeRates returns exchange rates of all denoms,
+	// or, if specified, returns a single denom
+	ExchangeRates(ctx context.Context, in *QueryExchangeRates, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
+	// ActiveExchangeRates returns all active denoms
+	ActiveExchangeRates(ctx context.Context, in *QueryActiveExchangeRates, opts ...grpc.CallOption) (*QueryActiveExchangeRatesResponse, error)
+	// FeederDelegation returns feeder delegation of a validator
+	FeederDelegation(ctx context.Context, in *QueryFeederDelegation, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
+	// MissCounter returns oracle miss counter of a validator
+	MissCounter(ctx context.Context, in *QueryMissCounter, opts ...grpc.CallOption) (*QueryMissCounterResponse, error)
+	// SlashWindow returns slash window information
+	SlashWindow(ctx context.Context, in *QuerySlashWindow, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error)
+	// AggregatePrevote returns an aggregate prevote of a validator
+	AggregatePrevote(ctx context.Context, in *QueryAggregatePrevote, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error)
+	// AggregatePrevotes returns aggregate prevotes of all validators
+	AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotes, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error)
+	// AggregateVote returns an aggregate vote of a validator
+	AggregateVote(ctx context.Context, in *QueryAggregateVote, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error)
+	// AggregateVotes returns aggregate votes of all validators
+	AggregateVotes(ctx context.Context, in *QueryAggregateVotes, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error)
+	// Params queries all parameters.
+	Params(ctx context.Context, in *QueryParams, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Medians returns medians of all denoms,
+	// or, if specified, returns a single median
+	Medians(ctx context.Context, in *QueryMedians, opts ...grpc.CallOption) (*QueryMediansResponse, error)
+	// MedianDeviations returns median deviations of all denoms,
+	// or, if specified, returns a single median deviation
+	MedianDeviations(ctx context.Context, in *QueryMedianDeviations, opts ...grpc.CallOption) (*QueryMedianDeviationsResponse, error)
+	// ValidatorRewardSet returns the set of validators that is
+	// earning rewards for voting on exchange rates based on their
+	// misscounter in a given Slash Window
+	ValidatorRewardSet(ctx context.Context, in *QueryValidatorRewardSet, opts ...grpc.CallOption) (*QueryValidatorRewardSetResponse, error)
+	EMA(ctx context.Context, in *QueryEMARequest, opts ...grpc.CallOption) (*QueryEMAResponse, error)
+	WMA(ctx context.Context, in *QueryWMARequest, opts ...grpc.CallOption) (*QueryWMAResponse, error)
+	SMA(ctx context.Context, in *QuerySMARequest, opts ...grpc.CallOption) (*QuerySMAResponse, error)
+	// TWAP returns the block-time-weighted average of the historic prices of a
+	// denom over a window ending at a given height
+	TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error)
+	// HistoricPrices returns a page of the historic prices of a denom,
+	// optionally within a range of blocks
+	HistoricPrices(ctx context.Context, in *QueryHistoricPricesRequest, opts ...grpc.CallOption) (*QueryHistoricPricesResponse, error)
+	// Candles returns a page of the OHLC candles of a denom for a candle
+	// interval
+	Candles(ctx context.Context, in *QueryCandlesRequest, opts ...grpc.CallOption) (*QueryCandlesResponse, error)
+	// PriceStatistics returns the statistics of the latest historic prices of
+	// a denom
+	PriceStatistics(ctx context.Context, in *QueryPriceStatisticsRequest, opts ...grpc.CallOption) (*QueryPriceStatisticsResponse, error)
+	// CrossRate returns the rate of a base denom in a quote denom derived from
+	// their USD rates
+	CrossRate(ctx context.Context, in *QueryCrossRateRequest, opts ...grpc.CallOption) (*QueryCrossRateResponse, error)
+	// LastExchangeRate returns the last exchange rate of a denom with its age,
+	// including a stale one
+	LastExchangeRate(ctx context.Context, in *QueryLastExchangeRateRequest, opts ...grpc.CallOption) (*QueryLastExchangeRateResponse, error)
+	// LastExchangeRates returns the last exchange rates of all denoms with
+	// their ages, including stale ones
+	LastExchangeRates(ctx context.Context, in *QueryLastExchangeRatesRequest, opts ...grpc.CallOption) (*QueryLastExchangeRatesResponse, error)
+	// PriceHalts returns the tripped circuit breakers of all denoms
+	PriceHalts(ctx context.Context, in *QueryPriceHaltsRequest, opts ...grpc.CallOption) (*QueryPriceHaltsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRates, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
+	out := new(QueryExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/ExchangeRates", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).MissCounter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/oracle.v1.Query/MissCounter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MissCounter(ctx, req.(*QueryMissCounter))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_SlashWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySlashWindow)
-	if err := dec(in); err != nil {
+func (c *queryClient) ActiveExchangeRates(ctx context.Context, in *QueryActiveExchangeRates, opts ...grpc.CallOption) (*QueryActiveExchangeRatesResponse, error) {
+	out := new(QueryActiveExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/ActiveExchangeRates", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).SlashWindow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/oracle.v1.Query/SlashWindow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SlashWindow(ctx, req.(*QuerySlashWindow))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_AggregatePrevote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregatePrevote)
-	if err := dec(in); err != nil {
+func (c *queryClient) FeederDelegation(ctx context.Context, in *QueryFeederDelegation, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error) {
+	out := new(QueryFeederDelegationResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/FeederDelegation", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregatePrevote(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/oracle.v1.Query/AggregatePrevote",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregatePrevote(ctx, req.(*QueryAggregatePrevote))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_AggregatePrevotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregatePrevotes)
-	if err := dec(in); err != nil {
+func (c *queryClient) MissCounter(ctx context.Context, in *QueryMissCounter, opts ...grpc.CallOption) (*QueryMissCounterResponse, error) {
+	out := new(QueryMissCounterResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/MissCounter", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregatePrevotes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/oracle.v1.Query/AggregatePrevotes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregatePrevotes(ctx, req.(*QueryAggregatePrevotes))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_AggregateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregateVote)
-	if err := dec(in); err != nil {
+func (c *queryClient) SlashWindow(ctx context.Context, in *QuerySlashWindow, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error) {
+	out := new(QuerySlashWindowResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/SlashWindow", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregateVote(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/oracle.v1.Query/AggregateVote",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregateVote(ctx, req.(*QueryAggregateVote))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_AggregateVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregateVotes)
-	if err := dec(in); err != nil {
+func (c *queryClient) AggregatePrevote(ctx context.Context, in *QueryAggregatePrevote, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error) {
+	out := new(QueryAggregatePrevoteResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/AggregatePrevote", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregateVotes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/oracle.v1.Query/AggregateVotes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregateVotes(ctx, req.(*QueryAggregateVotes))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParams)
-	if err := dec(in); err != nil {
+func (c *queryClient) AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotes, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error) {
+	out := new(QueryAggregatePrevotesResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/AggregatePrevotes", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/oracle.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParams))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_Medians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMedians)
-	if err := dec(in); err != nil {
+func (c *queryClient) AggregateVote(ctx context.Context, in *QueryAggregateVote, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error) {
+	out := new(QueryAggregateVoteResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/AggregateVote", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Medians(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/oracle.v1.Query/Medians",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Medians(ctx, req.(*QueryMedians))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_MedianDeviations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMedianDeviations)
-	if err := dec(in); err != nil {
+func (c *queryClient) AggregateVotes(ctx context.Context, in *QueryAggregateVotes, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error) {
+	out := new(QueryAggregateVotesResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/AggregateVotes", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).MedianDeviations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/oracle.v1.Query/MedianDeviations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MedianDeviations(ctx, req.(*QueryMedianDeviations))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ValidatorRewardSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorRewardSet)
-	if err := dec(in); err != nil {
+func (c *queryClient) Params(ctx context.Context, in *QueryParams, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/Params", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorRewardSet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/oracle.v1.Query/ValidatorRewardSet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorRewardSet(ctx, req.(*QueryValidatorRewardSet))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_EMA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEMARequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Medians(ctx context.Context, in *QueryMedians, opts ...grpc.CallOption) (*QueryMediansResponse, error) {
+	out := new(QueryMediansResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/Medians", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).EMA(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/oracle.v1.Query/EMA",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EMA(ctx, req.(*QueryEMARequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_WMA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWMARequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) MedianDeviations(ctx context.Context, in *QueryMedianDeviations, opts ...grpc.CallOption) (*QueryMedianDeviationsResponse, error) {
+	out := new(QueryMedianDeviationsResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/MedianDeviations", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).WMA(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/oracle.v1.Query/WMA",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).WMA(ctx, req.(*QueryWMARequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_SMA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySMARequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ValidatorRewardSet(ctx context.Context, in *QueryValidatorRewardSet, opts ...grpc.CallOption) (*QueryValidatorRewardSetResponse, error) {
+	out := new(QueryValidatorRewardSetResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/ValidatorRewardSet", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).SMA(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) EMA(ctx context.Context, in *QueryEMARequest, opts ...grpc.CallOption) (*QueryEMAResponse, error) {
+	out := new(QueryEMAResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/EMA", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/oracle.v1.Query/SMA",
+	return out, nil
+}
+
+func (c *queryClient) WMA(ctx context.Context, in *QueryWMARequest, opts ...grpc.CallOption) (*QueryWMAResponse, error) {
+	out := new(QueryWMAResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/WMA", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SMA(ctx, req.(*QuerySMARequest))
+	return out, nil
+}
+
+func (c *queryClient) SMA(ctx context.Context, in *QuerySMARequest, opts ...grpc.CallOption) (*QuerySMAResponse, error) {
+	out := new(QuerySMAResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/SMA", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "oracle.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ExchangeRates",
-			Handler:    _Query_ExchangeRates_Handler,
-		},
-		{
-			MethodName: "ActiveExchangeRates",
-			Handler:    _Query_ActiveExchangeRates_Handler,
-		},
-		{
-			MethodName: "FeederDelegation",
-			Handler:    _Query_FeederDelegation_Handler,
-		},
-		{
-			MethodName: "MissCounter",
-			Handler:    _Query_MissCounter_Handler,
-		},
-		{
-			MethodName: "SlashWindow",
-			Handler:    _Query_SlashWindow_Handler,
-		},
-		{
-			MethodName: "AggregatePrevote",
-			Handler:    _Query_AggregatePrevote_Handler,
-		},
-		{
-			MethodName: "AggregatePrevotes",
-			Handler:    _Query_AggregatePrevotes_Handler,
-		},
-		{
-			MethodName: "AggregateVote",
-			Handler:    _Query_AggregateVote_Handler,
-		},
-		{
-			MethodName: "AggregateVotes",
-			Handler:    _Query_AggregateVotes_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Medians",
-			Handler:    _Query_Medians_Handler,
-		},
-		{
-			MethodName: "MedianDeviations",
-			Handler:    _Query_MedianDeviations_Handler,
-		},
-		{
-			MethodName: "ValidatorRewardSet",
-			Handler:    _Query_ValidatorRewardSet_Handler,
-		},
-		{
-			MethodName: "EMA",
-			Handler:    _Query_EMA_Handler,
-		},
-		{
-			MethodName: "WMA",
-			Handler:    _Query_WMA_Handler,
-		},
-		{
-			MethodName: "SMA",
-			Handler:    _Query_SMA_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "oracle/v1/query.proto",
+func (c *queryClient) TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error) {
+	out := new(QueryTWAPResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/TWAP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryExchangeRates) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) HistoricPrices(ctx context.Context, in *QueryHistoricPricesRequest, opts ...grpc.CallOption) (*QueryHistoricPricesResponse, error) {
+	out := new(QueryHistoricPricesResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/HistoricPrices", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryExchangeRates) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Candles(ctx context.Context, in *QueryCandlesRequest, opts ...grpc.CallOption) (*QueryCandlesResponse, error) {
+	out := new(QueryCandlesResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/Candles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryExchangeRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) PriceStatistics(ctx context.Context, in *QueryPriceStatisticsRequest, opts ...grpc.CallOption) (*QueryPriceStatisticsResponse, error) {
+	out := new(QueryPriceStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/PriceStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CrossRate(ctx context.Context, in *QueryCrossRateRequest, opts ...grpc.CallOption) (*QueryCrossRateResponse, error) {
+	out := new(QueryCrossRateResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/CrossRate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LastExchangeRate(ctx context.Context, in *QueryLastExchangeRateRequest, opts ...grpc.CallOption) (*QueryLastExchangeRateResponse, error) {
+	out := new(QueryLastExchangeRateResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/LastExchangeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExchangeRates) > 0 {
-		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) LastExchangeRates(ctx context.Context, in *QueryLastExchangeRatesRequest, opts ...grpc.CallOption) (*QueryLastExchangeRatesResponse, error) {
+	out := new(QueryLastExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/LastExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryActiveExchangeRates) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PriceHalts(ctx context.Context, in *QueryPriceHaltsRequest, opts ...grpc.CallOption) (*QueryPriceHaltsResponse, error) {
+	out := new(QueryPriceHaltsResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Query/PriceHalts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryActiveExchangeRates) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ExchangeRates returns exchange rates of all denoms,
+	// or, if specified, returns a single denom
+	ExchangeRates(context.Context, *QueryExchangeRates) (*QueryExchangeRatesResponse, error)
+	// ActiveExchangeRates returns all active denoms
+	ActiveExchangeRates(context.Context, *QueryActiveExchangeRates) (*QueryActiveExchangeRatesResponse, error)
+	// FeederDelegation returns feeder delegation of a validator
+	FeederDelegation(context.Context, *QueryFeederDelegation) (*QueryFeederDelegationResponse, error)
+	// MissCounter returns oracle miss counter of a validator
+	MissCounter(context.Context, *QueryMissCounter) (*QueryMissCounterResponse, error)
+	// SlashWindow returns slash window information
+	SlashWindow(context.Context, *QuerySlashWindow) (*QuerySlashWindowResponse, error)
+	// AggregatePrevote returns an aggregate prevote of a validator
+	AggregatePrevote(context.Context, *QueryAggregatePrevote) (*QueryAggregatePrevoteResponse, error)
+	// AggregatePrevotes returns aggregate prevotes of all validators
+	AggregatePrevotes(context.Context, *QueryAggregatePrevotes) (*QueryAggregatePrevotesResponse, error)
+	// AggregateVote returns an aggregate vote of a validator
+	AggregateVote(context.Context, *QueryAggregateVote) (*QueryAggregateVoteResponse, error)
+	// AggregateVotes returns aggregate votes of all validators
+	AggregateVotes(context.Context, *QueryAggregateVotes) (*QueryAggregateVotesResponse, error)
+	// Params queries all parameters.
+	Params(context.Context, *QueryParams) (*QueryParamsResponse, error)
+	// Medians returns medians of all denoms,
+	// or, if specified, returns a single median
+	Medians(context.Context, *QueryMedians) (*QueryMediansResponse, error)
+	// MedianDeviations returns median deviations of all denoms,
+	// or, if specified, returns a single median deviation
+	MedianDeviations(context.Context, *QueryMedianDeviations) (*QueryMedianDeviationsResponse, error)
+	// ValidatorRewardSet returns the set of validators that is
+	// earning rewards for voting on exchange rates based on their
+	// misscounter in a given Slash Window
+	ValidatorRewardSet(context.Context, *QueryValidatorRewardSet) (*QueryValidatorRewardSetResponse, error)
+	EMA(context.Context, *QueryEMARequest) (*QueryEMAResponse, error)
+	WMA(context.Context, *QueryWMARequest) (*QueryWMAResponse, error)
+	SMA(context.Context, *QuerySMARequest) (*QuerySMAResponse, error)
+	// TWAP returns the block-time-weighted average of the historic prices of a
+	// denom over a window ending at a given height
+	TWAP(context.Context, *QueryTWAPRequest) (*QueryTWAPResponse, error)
+	// HistoricPrices returns a page of the historic prices of a denom,
+	// optionally within a range of blocks
+	HistoricPrices(context.Context, *QueryHistoricPricesRequest) (*QueryHistoricPricesResponse, error)
+	// Candles returns a page of the OHLC candles of a denom for a candle
+	// interval
+	Candles(context.Context, *QueryCandlesRequest) (*QueryCandlesResponse, error)
+	// PriceStatistics returns the statistics of the latest historic prices of
+	// a denom
+	PriceStatistics(context.Context, *QueryPriceStatisticsRequest) (*QueryPriceStatisticsResponse, error)
+	// CrossRate returns the rate of a base denom in a quote denom derived from
+	// their USD rates
+	CrossRate(context.Context, *QueryCrossRateRequest) (*QueryCrossRateResponse, error)
+	// LastExchangeRate returns the last exchange rate of a denom with its age,
+	// including a stale one
+	LastExchangeRate(context.Context, *QueryLastExchangeRateRequest) (*QueryLastExchangeRateResponse, error)
+	// LastExchangeRates returns the last exchange rates of all denoms with
+	// their ages, including stale ones
+	LastExchangeRates(context.Context, *QueryLastExchangeRatesRequest) (*QueryLastExchangeRatesResponse, error)
+	// PriceHalts returns the tripped circuit breakers of all denoms
+	PriceHalts(context.Context, *QueryPriceHaltsRequest) (*QueryPriceHaltsResponse, error)
 }
 
-func (m *QueryActiveExchangeRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryActiveExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) ExchangeRates(ctx context.Context, req *QueryExchangeRates) (*QueryExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
 }
-
-func (m *QueryActiveExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) ActiveExchangeRates(ctx context.Context, req *QueryActiveExchangeRates) (*QueryActiveExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveExchangeRates not implemented")
+}
+func (*UnimplementedQueryServer) FeederDelegation(ctx context.Context, req *QueryFeederDelegation) (*QueryFeederDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeederDelegation not implemented")
+}
+func (*UnimplementedQueryServer) MissCounter(ctx context.Context, req *QueryMissCounter) (*QueryMissCounterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissCounter not implemented")
+}
+func (*UnimplementedQueryServer) SlashWindow(ctx context.Context, req *QuerySlashWindow) (*QuerySlashWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashWindow not implemented")
+}
+func (*UnimplementedQueryServer) AggregatePrevote(ctx context.Context, req *QueryAggregatePrevote) (*QueryAggregatePrevoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevote not implemented")
+}
+func (*UnimplementedQueryServer) AggregatePrevotes(ctx context.Context, req *QueryAggregatePrevotes) (*QueryAggregatePrevotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevotes not implemented")
+}
+func (*UnimplementedQueryServer) AggregateVote(ctx context.Context, req *QueryAggregateVote) (*QueryAggregateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateVote not implemented")
+}
+func (*UnimplementedQueryServer) AggregateVotes(ctx context.Context, req *QueryAggregateVotes) (*QueryAggregateVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateVotes not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParams) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Medians(ctx context.Context, req *QueryMedians) (*QueryMediansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Medians not implemented")
+}
+func (*UnimplementedQueryServer) MedianDeviations(ctx context.Context, req *QueryMedianDeviations) (*QueryMedianDeviationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MedianDeviations not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorRewardSet(ctx context.Context, req *QueryValidatorRewardSet) (*QueryValidatorRewardSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorRewardSet not implemented")
+}
+func (*UnimplementedQueryServer) EMA(ctx context.Context, req *QueryEMARequest) (*QueryEMAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EMA not implemented")
+}
+func (*UnimplementedQueryServer) WMA(ctx context.Context, req *QueryWMARequest) (*QueryWMAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WMA not implemented")
+}
+func (*UnimplementedQueryServer) SMA(ctx context.Context, req *QuerySMARequest) (*QuerySMAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SMA not implemented")
+}
+func (*UnimplementedQueryServer) TWAP(ctx context.Context, req *QueryTWAPRequest) (*QueryTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TWAP not implemented")
+}
+func (*UnimplementedQueryServer) HistoricPrices(ctx context.Context, req *QueryHistoricPricesRequest) (*QueryHistoricPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricPrices not implemented")
+}
+func (*UnimplementedQueryServer) Candles(ctx context.Context, req *QueryCandlesRequest) (*QueryCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Candles not implemented")
+}
+func (*UnimplementedQueryServer) PriceStatistics(ctx context.Context, req *QueryPriceStatisticsRequest) (*QueryPriceStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceStatistics not implemented")
+}
+func (*UnimplementedQueryServer) CrossRate(ctx context.Context, req *QueryCrossRateRequest) (*QueryCrossRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrossRate not implemented")
+}
+func (*UnimplementedQueryServer) LastExchangeRate(ctx context.Context, req *QueryLastExchangeRateRequest) (*QueryLastExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastExchangeRate not implemented")
+}
+func (*UnimplementedQueryServer) LastExchangeRates(ctx context.Context, req *QueryLastExchangeRatesRequest) (*QueryLastExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastExchangeRates not implemented")
+}
+func (*UnimplementedQueryServer) PriceHalts(ctx context.Context, req *QueryPriceHaltsRequest) (*QueryPriceHaltsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceHalts not implemented")
 }
 
-func (m *QueryActiveExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ActiveRates) > 0 {
-		for iNdEx := len(m.ActiveRates) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ActiveRates[iNdEx])
-			copy(dAtA[i:], m.ActiveRates[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.ActiveRates[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryFeederDelegation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRates)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryFeederDelegation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/ExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRates(ctx, req.(*QueryExchangeRates))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFeederDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
+func _Query_ActiveExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveExchangeRates)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/ActiveExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveExchangeRates(ctx, req.(*QueryActiveExchangeRates))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFeederDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_FeederDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeederDelegation)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).FeederDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/FeederDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeederDelegation(ctx, req.(*QueryFeederDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFeederDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryFeederDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FeederAddr) > 0 {
-		i -= len(m.FeederAddr)
-		copy(dAtA[i:], m.FeederAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeederAddr)))
-		i--
-		dAtA[i] = 0xa
+func _Query_MissCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissCounter)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).MissCounter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/MissCounter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MissCounter(ctx, req.(*QueryMissCounter))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryMissCounter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SlashWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashWindow)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryMissCounter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryMissCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).SlashWindow(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/SlashWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashWindow(ctx, req.(*QuerySlashWindow))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryMissCounterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AggregatePrevote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregatePrevote)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryMissCounterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryMissCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MissCounter != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MissCounter))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatePrevote(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/AggregatePrevote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatePrevote(ctx, req.(*QueryAggregatePrevote))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySlashWindow) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AggregatePrevotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregatePrevotes)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySlashWindow) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatePrevotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/AggregatePrevotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatePrevotes(ctx, req.(*QueryAggregatePrevotes))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySlashWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_AggregateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/AggregateVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregateVote(ctx, req.(*QueryAggregateVote))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySlashWindowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AggregateVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregateVotes)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).AggregateVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/AggregateVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregateVotes(ctx, req.(*QueryAggregateVotes))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySlashWindowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySlashWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WindowProgress != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.WindowProgress))
-		i--
-		dAtA[i] = 0x8
+func _Query_Medians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMedians)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Medians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/Medians",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Medians(ctx, req.(*QueryMedians))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregatePrevote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_MedianDeviations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMedianDeviations)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).MedianDeviations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/MedianDeviations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MedianDeviations(ctx, req.(*QueryMedianDeviations))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregatePrevote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ValidatorRewardSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRewardSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorRewardSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/ValidatorRewardSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorRewardSet(ctx, req.(*QueryValidatorRewardSet))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
+func _Query_EMA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEMARequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).EMA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/EMA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EMA(ctx, req.(*QueryEMARequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_WMA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWMARequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAggregatePrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAggregatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AggregatePrevote.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).WMA(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/WMA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WMA(ctx, req.(*QueryWMARequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregatePrevotes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SMA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySMARequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAggregatePrevotes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAggregatePrevotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).SMA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/SMA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SMA(ctx, req.(*QuerySMARequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregatePrevotesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTWAPRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAggregatePrevotesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAggregatePrevotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AggregatePrevotes) > 0 {
-		for iNdEx := len(m.AggregatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AggregatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).TWAP(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/TWAP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TWAP(ctx, req.(*QueryTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregateVote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_HistoricPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricPricesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAggregateVote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/HistoricPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricPrices(ctx, req.(*QueryHistoricPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
+func _Query_Candles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCandlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Candles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/Candles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Candles(ctx, req.(*QueryCandlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregateVoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PriceStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceStatisticsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).PriceStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/PriceStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceStatistics(ctx, req.(*QueryPriceStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_CrossRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCrossRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CrossRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/CrossRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CrossRate(ctx, req.(*QueryCrossRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AggregateVote.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_LastExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).LastExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/LastExchangeRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastExchangeRate(ctx, req.(*QueryLastExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregateVotes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_LastExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastExchangeRatesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).LastExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/LastExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastExchangeRates(ctx, req.(*QueryLastExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAggregateVotes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func _Query_PriceHalts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceHaltsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceHalts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Query/PriceHalts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceHalts(ctx, req.(*QueryPriceHaltsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (m *QueryAggregateVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "oracle.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExchangeRates",
+			Handler:    _Query_ExchangeRates_Handler,
+		},
+		{
+			MethodName: "ActiveExchangeRates",
+			Handler:    _Query_ActiveExchangeRates_Handler,
+		},
+		{
+			MethodName: "FeederDelegation",
+			Handler:    _Query_FeederDelegation_Handler,
+		},
+		{
+			MethodName: "MissCounter",
+			Handler:    _Query_MissCounter_Handler,
+		},
+		{
+			MethodName: "SlashWindow",
+			Handler:    _Query_SlashWindow_Handler,
+		},
+		{
+			MethodName: "AggregatePrevote",
+			Handler:    _Query_AggregatePrevote_Handler,
+		},
+		{
+			MethodName: "AggregatePrevotes",
+			Handler:    _Query_AggregatePrevotes_Handler,
+		},
+		{
+			MethodName: "AggregateVote",
+			Handler:    _Query_AggregateVote_Handler,
+		},
+		{
+			MethodName: "AggregateVotes",
+			Handler:    _Query_AggregateVotes_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Medians",
+			Handler:    _Query_Medians_Handler,
+		},
+		{
+			MethodName: "MedianDeviations",
+			Handler:    _Query_MedianDeviations_Handler,
+		},
+		{
+			MethodName: "ValidatorRewardSet",
+			Handler:    _Query_ValidatorRewardSet_Handler,
+		},
+		{
+			MethodName: "EMA",
+			Handler:    _Query_EMA_Handler,
+		},
+		{
+			MethodName: "WMA",
+			Handler:    _Query_WMA_Handler,
+		},
+		{
+			MethodName: "SMA",
+			Handler:    _Query_SMA_Handler,
+		},
+		{
+			MethodName: "TWAP",
+			Handler:    _Query_TWAP_Handler,
+		},
+		{
+			MethodName: "HistoricPrices",
+			Handler:    _Query_HistoricPrices_Handler,
+		},
+		{
+			MethodName: "Candles",
+			Handler:    _Query_Candles_Handler,
+		},
+		{
+			MethodName: "PriceStatistics",
+			Handler:    _Query_PriceStatistics_Handler,
+		},
+		{
+			MethodName: "CrossRate",
+			Handler:    _Query_CrossRate_Handler,
+		},
+		{
+			MethodName: "LastExchangeRate",
+			Handler:    _Query_LastExchangeRate_Handler,
+		},
+		{
+			MethodName: "LastExchangeRates",
+			Handler:    _Query_LastExchangeRates_Handler,
+		},
+		{
+			MethodName: "PriceHalts",
+			Handler:    _Query_PriceHalts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "oracle/v1/query.proto",
+}
+
+func (m *QueryExchangeRates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregateVotesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2587,20 +3155,20 @@ func (m *QueryAggregateVotesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregateVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregateVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AggregateVotes) > 0 {
-		for iNdEx := len(m.AggregateVotes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ExchangeRates) > 0 {
+		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AggregateVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2614,7 +3182,7 @@ func (m *QueryAggregateVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParams) Marshal() (dAtA []byte, err error) {
+func (m *QueryActiveExchangeRates) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2624,12 +3192,12 @@ func (m *QueryParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryActiveExchangeRates) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryActiveExchangeRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2637,7 +3205,7 @@ func (m *QueryParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryActiveExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2647,30 +3215,29 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryActiveExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryActiveExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.ActiveRates) > 0 {
+		for iNdEx := len(m.ActiveRates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActiveRates[iNdEx])
+			copy(dAtA[i:], m.ActiveRates[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ActiveRates[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryMedians) Marshal() (dAtA []byte, err error) {
+func (m *QueryFeederDelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2680,32 +3247,27 @@ func (m *QueryMedians) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryMedians) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryFeederDelegation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMedians) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryFeederDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NumStamps != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumStamps))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryMediansResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryFeederDelegationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2715,34 +3277,27 @@ func (m *QueryMediansResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryMediansResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryFeederDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMediansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryFeederDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Medians) > 0 {
-		for iNdEx := len(m.Medians) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Medians[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.FeederAddr) > 0 {
+		i -= len(m.FeederAddr)
+		copy(dAtA[i:], m.FeederAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeederAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryMedianDeviations) Marshal() (dAtA []byte, err error) {
+func (m *QueryMissCounter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2752,27 +3307,27 @@ func (m *QueryMedianDeviations) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryMedianDeviations) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryMissCounter) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMedianDeviations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryMissCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryMedianDeviationsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryMissCounterResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2782,34 +3337,25 @@ func (m *QueryMedianDeviationsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryMedianDeviationsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryMissCounterResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMedianDeviationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryMissCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MedianDeviations) > 0 {
-		for iNdEx := len(m.MedianDeviations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MedianDeviations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.MissCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissCounter))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorRewardSet) Marshal() (dAtA []byte, err error) {
+func (m *QuerySlashWindow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2819,12 +3365,12 @@ func (m *QueryValidatorRewardSet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorRewardSet) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySlashWindow) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorRewardSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySlashWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2832,7 +3378,7 @@ func (m *QueryValidatorRewardSet) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorRewardSetResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySlashWindowResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2842,30 +3388,25 @@ func (m *QueryValidatorRewardSetResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorRewardSetResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySlashWindowResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorRewardSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySlashWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Validators.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.WindowProgress != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowProgress))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryEMARequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregatePrevote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2875,27 +3416,27 @@ func (m *QueryEMARequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryEMARequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEMARequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryEMAResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2905,22 +3446,22 @@ func (m *QueryEMAResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryEMAResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEMAResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.AggregatePrevote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
@@ -2928,7 +3469,7 @@ func (m *QueryEMAResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryWMARequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregatePrevotes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2938,53 +3479,20 @@ func (m *QueryWMARequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryWMARequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevotes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryWMARequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CustomWeights) > 0 {
-		dAtA6 := make([]byte, len(m.CustomWeights)*10)
-		var j5 int
-		for _, num1 := range m.CustomWeights {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA6[j5] = uint8(num)
-			j5++
-		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintQuery(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Strategy) > 0 {
-		i -= len(m.Strategy)
-		copy(dAtA[i:], m.Strategy)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Strategy)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryWMAResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregatePrevotesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2994,30 +3502,34 @@ func (m *QueryWMAResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryWMAResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevotesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryWMAResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.AggregatePrevotes) > 0 {
+		for iNdEx := len(m.AggregatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySMARequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregateVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3027,27 +3539,27 @@ func (m *QuerySMARequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySMARequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregateVote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySMARequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySMAResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregateVoteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3057,22 +3569,22 @@ func (m *QuerySMAResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySMAResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySMAResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.AggregateVote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
@@ -3080,426 +3592,3322 @@ func (m *QuerySMAResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAggregateVotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryExchangeRates) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryAggregateVotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryExchangeRatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAggregateVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ExchangeRates) > 0 {
-		for _, e := range m.ExchangeRates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryActiveExchangeRates) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAggregateVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryActiveExchangeRatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAggregateVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ActiveRates) > 0 {
-		for _, s := range m.ActiveRates {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.AggregateVotes) > 0 {
+		for iNdEx := len(m.AggregateVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregateVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFeederDelegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryFeederDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FeederAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMissCounter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryMissCounterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MissCounter != 0 {
-		n += 1 + sovQuery(uint64(m.MissCounter))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySlashWindow) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMedians) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySlashWindowResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.WindowProgress != 0 {
-		n += 1 + sovQuery(uint64(m.WindowProgress))
-	}
-	return n
+func (m *QueryMedians) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregatePrevote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMedians) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.NumStamps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumStamps))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregatePrevoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMediansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.AggregatePrevote.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAggregatePrevotes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryMediansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregatePrevotesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMediansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.AggregatePrevotes) > 0 {
-		for _, e := range m.AggregatePrevotes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Medians) > 0 {
+		for iNdEx := len(m.Medians) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Medians[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregateVote) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMedianDeviations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMedianDeviations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMedianDeviations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregateVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMedianDeviationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.AggregateVote.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAggregateVotes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryMedianDeviationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregateVotesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMedianDeviationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.AggregateVotes) > 0 {
-		for _, e := range m.AggregateVotes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.MedianDeviations) > 0 {
+		for iNdEx := len(m.MedianDeviations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MedianDeviations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidatorRewardSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryValidatorRewardSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMedians) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryValidatorRewardSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.NumStamps != 0 {
-		n += 1 + sovQuery(uint64(m.NumStamps))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMediansResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidatorRewardSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRewardSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRewardSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Medians) > 0 {
-		for _, e := range m.Medians {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Validators.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMedianDeviations) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEMARequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEMARequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEMARequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMedianDeviationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEMAResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEMAResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEMAResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.MedianDeviations) > 0 {
-		for _, e := range m.MedianDeviations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorRewardSet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryWMARequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorRewardSetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryWMARequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWMARequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Validators.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.CustomWeights) > 0 {
+		dAtA6 := make([]byte, len(m.CustomWeights)*10)
+		var j5 int
+		for _, num1 := range m.CustomWeights {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintQuery(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Strategy) > 0 {
+		i -= len(m.Strategy)
+		copy(dAtA[i:], m.Strategy)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Strategy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEMARequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryWMAResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWMAResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWMAResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEMAResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySMARequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySMARequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySMARequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryWMARequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySMAResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySMAResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySMAResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTWAPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTWAPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTWAPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTWAPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTWAPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTWAPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HistoricPrices) > 0 {
+		for iNdEx := len(m.HistoricPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoricPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCandlesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCandlesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCandlesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Interval != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCandlesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCandlesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCandlesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Candles) > 0 {
+		for iNdEx := len(m.Candles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceStatisticsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceStatisticsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceStatisticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Percentiles) > 0 {
+		dAtA12 := make([]byte, len(m.Percentiles)*10)
+		var j11 int
+		for _, num := range m.Percentiles {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintQuery(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NumStamps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumStamps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceStatisticsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceStatisticsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceStatisticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Statistics.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCrossRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCrossRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCrossRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseDenoms {
+		i--
+		if m.BaseDenoms {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WindowSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Source != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCrossRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCrossRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCrossRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.QuoteRate.Size()
+		i -= size
+		if _, err := m.QuoteRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BaseRate.Size()
+		i -= size
+		if _, err := m.BaseRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastExchangeRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastExchangeRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastExchangeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastExchangeRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastExchangeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastExchangeRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastExchangeRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		for iNdEx := len(m.Statuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceHaltsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceHaltsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceHaltsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceHaltsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceHaltsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceHaltsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceHalts) > 0 {
+		for iNdEx := len(m.PriceHalts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceHalts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryExchangeRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExchangeRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeRates) > 0 {
+		for _, e := range m.ExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryActiveExchangeRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryActiveExchangeRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActiveRates) > 0 {
+		for _, s := range m.ActiveRates {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeederDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeederDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeederAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissCounterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MissCounter != 0 {
+		n += 1 + sovQuery(uint64(m.MissCounter))
+	}
+	return n
+}
+
+func (m *QuerySlashWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySlashWindowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowProgress != 0 {
+		n += 1 + sovQuery(uint64(m.WindowProgress))
+	}
+	return n
+}
+
+func (m *QueryAggregatePrevote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregatePrevoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AggregatePrevote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAggregatePrevotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAggregatePrevotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AggregatePrevotes) > 0 {
+		for _, e := range m.AggregatePrevotes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAggregateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AggregateVote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAggregateVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAggregateVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AggregateVotes) > 0 {
+		for _, e := range m.AggregateVotes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMedians) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumStamps != 0 {
+		n += 1 + sovQuery(uint64(m.NumStamps))
+	}
+	return n
+}
+
+func (m *QueryMediansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Medians) > 0 {
+		for _, e := range m.Medians {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMedianDeviations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMedianDeviationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MedianDeviations) > 0 {
+		for _, e := range m.MedianDeviations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorRewardSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryValidatorRewardSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Validators.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEMARequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEMAResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryWMARequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	l = len(m.Strategy)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if len(m.CustomWeights) > 0 {
-		l = 0
-		for _, e := range m.CustomWeights {
-			l += sovQuery(uint64(e))
+	if len(m.CustomWeights) > 0 {
+		l = 0
+		for _, e := range m.CustomWeights {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryWMAResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySMARequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySMAResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTWAPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WindowSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.WindowSeconds))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *QueryTWAPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHistoricPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartBlock != 0 {
+		n += 1 + sovQuery(uint64(m.StartBlock))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovQuery(uint64(m.EndBlock))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHistoricPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HistoricPrices) > 0 {
+		for _, e := range m.HistoricPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCandlesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovQuery(uint64(m.Interval))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCandlesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Candles) > 0 {
+		for _, e := range m.Candles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceStatisticsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumStamps != 0 {
+		n += 1 + sovQuery(uint64(m.NumStamps))
+	}
+	if len(m.Percentiles) > 0 {
+		l = 0
+		for _, e := range m.Percentiles {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryPriceStatisticsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Statistics.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCrossRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Source != 0 {
+		n += 1 + sovQuery(uint64(m.Source))
+	}
+	if m.WindowSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.WindowSeconds))
+	}
+	if m.BaseDenoms {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryCrossRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BaseRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.QuoteRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLastExchangeRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLastExchangeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Status.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLastExchangeRatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLastExchangeRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		for _, e := range m.Statuses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPriceHaltsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPriceHaltsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceHalts) > 0 {
+		for _, e := range m.PriceHalts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryExchangeRates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, types.DecCoin{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveExchangeRates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveExchangeRates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveExchangeRates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveExchangeRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveRates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveRates = append(m.ActiveRates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeederDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeederDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeederDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissCounterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+			}
+			m.MissCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashWindowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowProgress", wireType)
+			}
+			m.WindowProgress = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowProgress |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AggregatePrevote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregatePrevotes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatePrevotes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatePrevotes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatePrevotes = append(m.AggregatePrevotes, AggregateExchangeRatePrevote{})
+			if err := m.AggregatePrevotes[len(m.AggregatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregateVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AggregateVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregateVotes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregateVotes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregateVotes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregateVotesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregateVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregateVotes = append(m.AggregateVotes, AggregateExchangeRateVote{})
+			if err := m.AggregateVotes[len(m.AggregateVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		n += 1 + sovQuery(uint64(l)) + l
 	}
-	return n
-}
 
-func (m *QueryWMAResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
-
-func (m *QuerySMARequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QuerySMAResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryExchangeRates) Unmarshal(dAtA []byte) error {
+func (m *QueryMedians) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3522,10 +6930,10 @@ func (m *QueryExchangeRates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRates: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMedians: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMedians: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3560,6 +6968,25 @@ func (m *QueryExchangeRates) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumStamps", wireType)
+			}
+			m.NumStamps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumStamps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3581,7 +7008,7 @@ func (m *QueryExchangeRates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMediansResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3604,15 +7031,15 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMediansResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMediansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Medians", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3639,8 +7066,8 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRates = append(m.ExchangeRates, types.DecCoin{})
-			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Medians = append(m.Medians, PriceStamp{})
+			if err := m.Medians[len(m.Medians)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3665,7 +7092,7 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActiveExchangeRates) Unmarshal(dAtA []byte) error {
+func (m *QueryMedianDeviations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3688,12 +7115,44 @@ func (m *QueryActiveExchangeRates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveExchangeRates: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMedianDeviations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveExchangeRates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMedianDeviations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3715,7 +7174,7 @@ func (m *QueryActiveExchangeRates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMedianDeviationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3738,17 +7197,17 @@ func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveExchangeRatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMedianDeviationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMedianDeviationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianDeviations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3758,23 +7217,25 @@ func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActiveRates = append(m.ActiveRates, string(dAtA[iNdEx:postIndex]))
+			m.MedianDeviations = append(m.MedianDeviations, PriceStamp{})
+			if err := m.MedianDeviations[len(m.MedianDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3797,7 +7258,7 @@ func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegation) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorRewardSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3820,44 +7281,12 @@ func (m *QueryFeederDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorRewardSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorRewardSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3879,7 +7308,7 @@ func (m *QueryFeederDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorRewardSetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3902,17 +7331,17 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorRewardSetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorRewardSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3922,23 +7351,24 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			if err := m.Validators.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3961,7 +7391,7 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMissCounter) Unmarshal(dAtA []byte) error {
+func (m *QueryEMARequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3984,15 +7414,15 @@ func (m *QueryMissCounter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissCounter: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEMARequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEMARequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4020,7 +7450,7 @@ func (m *QueryMissCounter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4043,7 +7473,7 @@ func (m *QueryMissCounter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEMAResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4066,17 +7496,17 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissCounterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEMAResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEMAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			m.MissCounter = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4086,61 +7516,26 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissCounter |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySlashWindow) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySlashWindow: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySlashWindow: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4162,7 +7557,7 @@ func (m *QuerySlashWindow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWMARequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4185,30 +7580,151 @@ func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySlashWindowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWMARequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySlashWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWMARequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WindowProgress", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.WindowProgress = 0
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CustomWeights = append(m.CustomWeights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CustomWeights) == 0 {
+					m.CustomWeights = make([]int64, 0, elementCount)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WindowProgress |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CustomWeights = append(m.CustomWeights, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomWeights", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -4231,7 +7747,7 @@ func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevote) Unmarshal(dAtA []byte) error {
+func (m *QueryWMAResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4254,15 +7770,15 @@ func (m *QueryAggregatePrevote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevote: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWMAResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWMAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4290,7 +7806,9 @@ func (m *QueryAggregatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4313,7 +7831,7 @@ func (m *QueryAggregatePrevote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySMARequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4336,17 +7854,17 @@ func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySMARequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySMARequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4356,24 +7874,23 @@ func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AggregatePrevote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4396,7 +7913,7 @@ func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevotes) Unmarshal(dAtA []byte) error {
+func (m *QuerySMAResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4419,12 +7936,46 @@ func (m *QueryAggregatePrevotes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevotes: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySMAResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevotes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySMAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4446,7 +7997,7 @@ func (m *QueryAggregatePrevotes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTWAPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4469,17 +8020,17 @@ func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTWAPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTWAPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4489,26 +8040,62 @@ func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggregatePrevotes = append(m.AggregatePrevotes, AggregateExchangeRatePrevote{})
-			if err := m.AggregatePrevotes[len(m.AggregatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+			}
+			m.WindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4530,7 +8117,7 @@ func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVote) Unmarshal(dAtA []byte) error {
+func (m *QueryTWAPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4553,15 +8140,15 @@ func (m *QueryAggregateVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTWAPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTWAPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4589,7 +8176,9 @@ func (m *QueryAggregateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4612,7 +8201,7 @@ func (m *QueryAggregateVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHistoricPricesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4635,17 +8224,17 @@ func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHistoricPricesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHistoricPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4655,75 +8244,98 @@ func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AggregateVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			m.StartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAggregateVotes) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.EndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVotes: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVotes: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4745,7 +8357,7 @@ func (m *QueryAggregateVotes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHistoricPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4768,15 +8380,15 @@ func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVotesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHistoricPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHistoricPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricPrices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4803,8 +8415,44 @@ func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggregateVotes = append(m.AggregateVotes, AggregateExchangeRateVote{})
-			if err := m.AggregateVotes[len(m.AggregateVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HistoricPrices = append(m.HistoricPrices, PriceStamp{})
+			if err := m.HistoricPrices[len(m.HistoricPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4829,7 +8477,7 @@ func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParams) Unmarshal(dAtA []byte) error {
+func (m *QueryCandlesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4852,12 +8500,99 @@ func (m *QueryParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCandlesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCandlesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4879,7 +8614,7 @@ func (m *QueryParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCandlesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4902,15 +8637,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCandlesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCandlesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Candles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4937,7 +8672,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Candles = append(m.Candles, Candle{})
+			if err := m.Candles[len(m.Candles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4962,7 +8734,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMedians) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceStatisticsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4985,10 +8757,10 @@ func (m *QueryMedians) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMedians: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceStatisticsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMedians: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5009,38 +8781,114 @@ func (m *QueryMedians) Unmarshal(dAtA []byte) error {
 				if b < 0x80 {
 					break
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumStamps", wireType)
-			}
-			m.NumStamps = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumStamps", wireType)
+			}
+			m.NumStamps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumStamps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Percentiles = append(m.Percentiles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumStamps |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Percentiles) == 0 {
+					m.Percentiles = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Percentiles = append(m.Percentiles, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentiles", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -5063,7 +8911,7 @@ func (m *QueryMedians) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMediansResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceStatisticsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5086,15 +8934,15 @@ func (m *QueryMediansResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMediansResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceStatisticsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMediansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Medians", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5121,8 +8969,7 @@ func (m *QueryMediansResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Medians = append(m.Medians, PriceStamp{})
-			if err := m.Medians[len(m.Medians)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Statistics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5147,7 +8994,7 @@ func (m *QueryMediansResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMedianDeviations) Unmarshal(dAtA []byte) error {
+func (m *QueryCrossRateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5170,15 +9017,15 @@ func (m *QueryMedianDeviations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMedianDeviations: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCrossRateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMedianDeviations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCrossRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5206,8 +9053,98 @@ func (m *QueryMedianDeviations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= PriceSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+			}
+			m.WindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenoms", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BaseDenoms = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5229,7 +9166,7 @@ func (m *QueryMedianDeviations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMedianDeviationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCrossRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5252,17 +9189,17 @@ func (m *QueryMedianDeviationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMedianDeviationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCrossRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMedianDeviationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCrossRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MedianDeviations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5272,131 +9209,65 @@ func (m *QueryMedianDeviationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MedianDeviations = append(m.MedianDeviations, PriceStamp{})
-			if err := m.MedianDeviations[len(m.MedianDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryValidatorRewardSet) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRate", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorRewardSet: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorRewardSet: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryValidatorRewardSetResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.BaseRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorRewardSetResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorRewardSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5406,22 +9277,23 @@ func (m *QueryValidatorRewardSetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Validators.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QuoteRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5446,7 +9318,7 @@ func (m *QueryValidatorRewardSetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEMARequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLastExchangeRateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5469,10 +9341,10 @@ func (m *QueryEMARequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEMARequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastExchangeRateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEMARequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5528,7 +9400,7 @@ func (m *QueryEMARequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEMAResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLastExchangeRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5551,17 +9423,17 @@ func (m *QueryEMAResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEMAResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastExchangeRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEMAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5571,23 +9443,22 @@ func (m *QueryEMAResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5612,7 +9483,7 @@ func (m *QueryEMAResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWMARequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLastExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5635,152 +9506,12 @@ func (m *QueryWMARequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWMARequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastExchangeRatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWMARequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Strategy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.CustomWeights = append(m.CustomWeights, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.CustomWeights) == 0 {
-					m.CustomWeights = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.CustomWeights = append(m.CustomWeights, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field CustomWeights", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5802,7 +9533,7 @@ func (m *QueryWMARequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWMAResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLastExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5825,17 +9556,17 @@ func (m *QueryWMAResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWMAResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastExchangeRatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWMAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5845,23 +9576,23 @@ func (m *QueryWMAResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Statuses = append(m.Statuses, ExchangeRateStatus{})
+			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5886,7 +9617,7 @@ func (m *QueryWMAResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySMARequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceHaltsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5909,44 +9640,12 @@ func (m *QuerySMARequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySMARequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceHaltsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySMARequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceHaltsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5968,7 +9667,7 @@ func (m *QuerySMARequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySMAResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPriceHaltsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5991,17 +9690,17 @@ func (m *QuerySMAResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySMAResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPriceHaltsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySMAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPriceHaltsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHalts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6011,23 +9710,23 @@ func (m *QuerySMAResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PriceHalts = append(m.PriceHalts, PriceHalt{})
+			if err := m.PriceHalts[len(m.PriceHalts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,15 @@
+package types
+
+// AcceptsCommitReveal returns true if MsgAggregateExchangeRatePrevote and
+// MsgAggregateExchangeRateVote are accepted in the mode.
+func (m PriceSubmissionMode) AcceptsCommitReveal() bool {
+	return m == PriceSubmissionMode_PRICE_SUBMISSION_MODE_COMMIT_REVEAL ||
+		m == PriceSubmissionMode_PRICE_SUBMISSION_MODE_BOTH
+}
+
+// AcceptsVoteExtensions returns true if the exchange rates of the injected
+// vote extensions are accepted in the mode.
+func (m PriceSubmissionMode) AcceptsVoteExtensions() bool {
+	return m == PriceSubmissionMode_PRICE_SUBMISSION_MODE_VOTE_EXTENSIONS ||
+		m == PriceSubmissionMode_PRICE_SUBMISSION_MODE_BOTH
+}
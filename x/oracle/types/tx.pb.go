@@ -784,6 +784,172 @@ func (m *MsgGovCancelUpdateParamPlanResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgGovCancelUpdateParamPlanResponse proto.InternalMessageInfo
 
+// MsgGovResetCircuitBreakers defines the Msg/GovResetCircuitBreakers request type.
+type MsgGovResetCircuitBreakers struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// title of the proposal
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// description of the proposal
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// symbol denoms of the circuit breakers to reset
+	Denoms []string `protobuf:"bytes,4,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *MsgGovResetCircuitBreakers) Reset()      { *m = MsgGovResetCircuitBreakers{} }
+func (*MsgGovResetCircuitBreakers) ProtoMessage() {}
+func (*MsgGovResetCircuitBreakers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31571edce0094a5d, []int{18}
+}
+func (m *MsgGovResetCircuitBreakers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovResetCircuitBreakers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovResetCircuitBreakers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovResetCircuitBreakers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovResetCircuitBreakers.Merge(m, src)
+}
+func (m *MsgGovResetCircuitBreakers) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovResetCircuitBreakers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovResetCircuitBreakers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovResetCircuitBreakers proto.InternalMessageInfo
+
+// MsgGovResetCircuitBreakersResponse defines the Msg/GovResetCircuitBreakersResponse response type.
+type MsgGovResetCircuitBreakersResponse struct {
+}
+
+func (m *MsgGovResetCircuitBreakersResponse) Reset()         { *m = MsgGovResetCircuitBreakersResponse{} }
+func (m *MsgGovResetCircuitBreakersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGovResetCircuitBreakersResponse) ProtoMessage()    {}
+func (*MsgGovResetCircuitBreakersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31571edce0094a5d, []int{19}
+}
+func (m *MsgGovResetCircuitBreakersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovResetCircuitBreakersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovResetCircuitBreakersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovResetCircuitBreakersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovResetCircuitBreakersResponse.Merge(m, src)
+}
+func (m *MsgGovResetCircuitBreakersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovResetCircuitBreakersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovResetCircuitBreakersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovResetCircuitBreakersResponse proto.InternalMessageInfo
+
+// MsgGovUpdateDenomOverrides defines the Msg/GovUpdateDenomOverrides request type.
+type MsgGovUpdateDenomOverrides struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// title of the proposal
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// description of the proposal
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// height at which the param update must be performed
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// overrides to set, replacing the current overrides of their denoms
+	Overrides []DenomOverride `protobuf:"bytes,5,rep,name=overrides,proto3" json:"overrides"`
+	// symbol denoms of the overrides to remove
+	RemoveDenoms []string `protobuf:"bytes,6,rep,name=remove_denoms,json=removeDenoms,proto3" json:"remove_denoms,omitempty"`
+}
+
+func (m *MsgGovUpdateDenomOverrides) Reset()      { *m = MsgGovUpdateDenomOverrides{} }
+func (*MsgGovUpdateDenomOverrides) ProtoMessage() {}
+func (*MsgGovUpdateDenomOverrides) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31571edce0094a5d, []int{20}
+}
+func (m *MsgGovUpdateDenomOverrides) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovUpdateDenomOverrides) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovUpdateDenomOverrides.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovUpdateDenomOverrides) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovUpdateDenomOverrides.Merge(m, src)
+}
+func (m *MsgGovUpdateDenomOverrides) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovUpdateDenomOverrides) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovUpdateDenomOverrides.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovUpdateDenomOverrides proto.InternalMessageInfo
+
+// MsgGovUpdateDenomOverridesResponse defines the Msg/GovUpdateDenomOverridesResponse response type.
+type MsgGovUpdateDenomOverridesResponse struct {
+}
+
+func (m *MsgGovUpdateDenomOverridesResponse) Reset()         { *m = MsgGovUpdateDenomOverridesResponse{} }
+func (m *MsgGovUpdateDenomOverridesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGovUpdateDenomOverridesResponse) ProtoMessage()    {}
+func (*MsgGovUpdateDenomOverridesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31571edce0094a5d, []int{21}
+}
+func (m *MsgGovUpdateDenomOverridesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovUpdateDenomOverridesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovUpdateDenomOverridesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovUpdateDenomOverridesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovUpdateDenomOverridesResponse.Merge(m, src)
+}
+func (m *MsgGovUpdateDenomOverridesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovUpdateDenomOverridesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovUpdateDenomOverridesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovUpdateDenomOverridesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAggregateExchangeRatePrevote)(nil), "oracle.v1.MsgAggregateExchangeRatePrevote")
 	proto.RegisterType((*MsgAggregateExchangeRatePrevoteResponse)(nil), "oracle.v1.MsgAggregateExchangeRatePrevoteResponse")
@@ -803,86 +969,98 @@ func init() {
 	proto.RegisterType((*MsgGovRemoveCurrencyDeviationThresholdsResponse)(nil), "oracle.v1.MsgGovRemoveCurrencyDeviationThresholdsResponse")
 	proto.RegisterType((*MsgGovCancelUpdateParamPlan)(nil), "oracle.v1.MsgGovCancelUpdateParamPlan")
 	proto.RegisterType((*MsgGovCancelUpdateParamPlanResponse)(nil), "oracle.v1.MsgGovCancelUpdateParamPlanResponse")
+	proto.RegisterType((*MsgGovResetCircuitBreakers)(nil), "oracle.v1.MsgGovResetCircuitBreakers")
+	proto.RegisterType((*MsgGovResetCircuitBreakersResponse)(nil), "oracle.v1.MsgGovResetCircuitBreakersResponse")
+	proto.RegisterType((*MsgGovUpdateDenomOverrides)(nil), "oracle.v1.MsgGovUpdateDenomOverrides")
+	proto.RegisterType((*MsgGovUpdateDenomOverridesResponse)(nil), "oracle.v1.MsgGovUpdateDenomOverridesResponse")
 }
 
 func init() { proto.RegisterFile("oracle/v1/tx.proto", fileDescriptor_31571edce0094a5d) }
 
 var fileDescriptor_31571edce0094a5d = []byte{
-	// 1183 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0xe3, 0xc4,
-	0x1b, 0x8e, 0x37, 0x49, 0xb7, 0x79, 0xf3, 0xfb, 0x51, 0xd6, 0xdb, 0x0f, 0xd7, 0x6d, 0x9d, 0xd4,
-	0x6d, 0xb7, 0x1f, 0x4b, 0x13, 0x1a, 0x56, 0x1c, 0x2a, 0x10, 0x34, 0x2d, 0x70, 0x60, 0xb3, 0xaa,
-	0x0c, 0x2c, 0x12, 0x12, 0x8a, 0xa6, 0xf6, 0x60, 0x5b, 0xeb, 0xd8, 0xd1, 0xcc, 0x34, 0x34, 0x17,
-	0x84, 0x10, 0x07, 0x2e, 0x48, 0x7b, 0x42, 0x5c, 0x90, 0xf6, 0xbc, 0x12, 0xd2, 0x1e, 0xf6, 0xc2,
-	0x91, 0x5b, 0x4f, 0x68, 0xd5, 0x03, 0x42, 0x1c, 0xb2, 0xd0, 0x1e, 0xca, 0x85, 0x4b, 0xff, 0x02,
-	0xe4, 0x8f, 0x38, 0x49, 0xeb, 0x38, 0x59, 0x84, 0xd4, 0xde, 0xec, 0x79, 0x9f, 0xf7, 0x99, 0xe7,
-	0x79, 0x66, 0x32, 0x1e, 0x05, 0x78, 0x87, 0x20, 0xd5, 0xc2, 0xc5, 0xc6, 0x46, 0x91, 0x1d, 0x14,
-	0xea, 0xc4, 0x61, 0x0e, 0x9f, 0xf1, 0xc7, 0x0a, 0x8d, 0x0d, 0x71, 0x4a, 0x75, 0x68, 0xcd, 0xa1,
-	0xc5, 0x1a, 0xd5, 0x5d, 0x48, 0x8d, 0xea, 0x3e, 0x46, 0x9c, 0xec, 0xf4, 0x05, 0x68, 0x7f, 0x7c,
-	0x5c, 0x77, 0x74, 0xc7, 0x7b, 0x2c, 0xba, 0x4f, 0xc1, 0xe8, 0xb4, 0x4f, 0x53, 0xf5, 0x0b, 0xfe,
-	0x8b, 0x5f, 0x92, 0x7f, 0xe1, 0x20, 0x57, 0xa1, 0xfa, 0x96, 0xae, 0x13, 0xac, 0x23, 0x86, 0xdf,
-	0x39, 0x50, 0x0d, 0x64, 0xeb, 0x58, 0x41, 0x0c, 0xef, 0x12, 0xdc, 0x70, 0x18, 0xe6, 0x17, 0x20,
-	0x65, 0x20, 0x6a, 0x08, 0x5c, 0x9e, 0x5b, 0xc9, 0x94, 0xc7, 0xce, 0x5a, 0xb9, 0x6c, 0x13, 0xd5,
-	0xac, 0x4d, 0xd9, 0x1d, 0x95, 0x15, 0xaf, 0xc8, 0xbf, 0x0a, 0x23, 0x9f, 0x61, 0xac, 0x61, 0x22,
-	0x5c, 0xf3, 0x60, 0xc2, 0xd1, 0xd3, 0xf5, 0xf1, 0x60, 0xaa, 0x2d, 0x4d, 0x23, 0x98, 0xd2, 0x0f,
-	0x18, 0x31, 0x6d, 0x5d, 0x09, 0x70, 0xfc, 0x5b, 0x90, 0x69, 0x20, 0xcb, 0xd4, 0x10, 0x73, 0x88,
-	0x90, 0xf4, 0x9a, 0xe6, 0x8f, 0x9e, 0xae, 0xcf, 0x05, 0x4d, 0xf7, 0xdb, 0xb5, 0xde, 0xee, 0x4e,
-	0xcf, 0xe6, 0xcd, 0x6f, 0x1e, 0xe5, 0x12, 0x7f, 0x3d, 0xca, 0x25, 0xbe, 0x3a, 0x7d, 0xb2, 0x16,
-	0xb0, 0xca, 0xab, 0xb0, 0x3c, 0xc0, 0x8f, 0x82, 0x69, 0xdd, 0xb1, 0x29, 0x96, 0xbf, 0xbd, 0x06,
-	0xb3, 0xfd, 0xb0, 0xf7, 0x03, 0xe3, 0x14, 0x59, 0xec, 0xa2, 0x71, 0x77, 0x54, 0x56, 0xbc, 0x22,
-	0xff, 0x36, 0xbc, 0x84, 0x83, 0xc6, 0x2a, 0x41, 0x0c, 0xd3, 0x20, 0x80, 0xe9, 0xb3, 0x56, 0x6e,
-	0xc2, 0x87, 0xf7, 0xd6, 0x65, 0xe5, 0xff, 0xb8, 0x6b, 0x26, 0xda, 0x15, 0x5d, 0xf2, 0xdf, 0x44,
-	0x97, 0xfa, 0xaf, 0xa2, 0xbb, 0x05, 0x8b, 0x71, 0x71, 0x84, 0xb9, 0xfd, 0xc8, 0xc1, 0x64, 0x85,
-	0xea, 0x3b, 0xd8, 0xf2, 0x70, 0xef, 0x62, 0xac, 0x6d, 0xbb, 0x05, 0x9b, 0xf1, 0x6f, 0xc2, 0xa8,
-	0x53, 0xc7, 0xc4, 0xd3, 0xc5, 0x0d, 0xab, 0x2b, 0x6c, 0x71, 0xdb, 0xb5, 0x80, 0x35, 0x48, 0x71,
-	0x98, 0xf6, 0x76, 0xcb, 0xe6, 0x44, 0xb7, 0xab, 0x90, 0x55, 0xce, 0x83, 0x14, 0x2d, 0x37, 0x74,
-	0xf4, 0x37, 0x07, 0x42, 0x85, 0xea, 0x77, 0xb1, 0x8e, 0xd4, 0xe6, 0x7b, 0x4e, 0xe3, 0xa3, 0xba,
-	0xe6, 0xee, 0x17, 0x44, 0x50, 0x8d, 0xf2, 0xaf, 0x43, 0x06, 0xed, 0x33, 0xc3, 0x21, 0x26, 0x6b,
-	0x06, 0xa6, 0xfa, 0xaf, 0x50, 0x07, 0xca, 0x8f, 0x43, 0x9a, 0x99, 0xcc, 0x0a, 0x9c, 0x28, 0xfe,
-	0x0b, 0x9f, 0x87, 0xac, 0x86, 0xa9, 0x4a, 0xcc, 0x3a, 0x33, 0x1d, 0xdb, 0x5f, 0x71, 0xa5, 0x7b,
-	0x88, 0xe7, 0x21, 0xf5, 0x00, 0x37, 0xa9, 0x90, 0xca, 0x27, 0x57, 0x32, 0x8a, 0xf7, 0xcc, 0x6f,
-	0xc0, 0x75, 0x7f, 0x31, 0xa8, 0x90, 0xce, 0x73, 0x2b, 0xd9, 0xd2, 0x8d, 0x42, 0x78, 0x4a, 0x14,
-	0x7c, 0x9d, 0xe5, 0xd4, 0x61, 0x2b, 0x97, 0x50, 0xda, 0xb8, 0x4d, 0xd1, 0x0d, 0xe3, 0x7b, 0x3f,
-	0x10, 0xce, 0x0d, 0xa4, 0x23, 0x4d, 0x96, 0x21, 0xdf, 0xcf, 0x6e, 0x98, 0xc9, 0xaf, 0x1c, 0xf0,
-	0x15, 0xaa, 0x5f, 0x76, 0x1a, 0x77, 0x20, 0x55, 0xb7, 0x90, 0xed, 0xed, 0xf2, 0x6c, 0x49, 0x3c,
-	0x6f, 0x3b, 0xd0, 0x66, 0x21, 0x3b, 0xf0, 0xef, 0xa1, 0x63, 0xcd, 0xcf, 0x82, 0x78, 0xd1, 0x57,
-	0x68, 0xfb, 0x28, 0x0d, 0x63, 0x7e, 0x79, 0x4b, 0xd3, 0x76, 0xb0, 0xed, 0x5c, 0x82, 0xe7, 0x49,
-	0x18, 0x31, 0xb0, 0xa9, 0x1b, 0xcc, 0x73, 0x9d, 0x54, 0x82, 0x37, 0xbe, 0x0c, 0xa0, 0xb9, 0x8a,
-	0xaa, 0x96, 0x49, 0x99, 0x90, 0xce, 0x27, 0x57, 0xb2, 0xa5, 0x97, 0xbb, 0x12, 0xf1, 0xe4, 0x96,
-	0x6f, 0xb8, 0x39, 0x3c, 0x7e, 0x9e, 0xcb, 0x78, 0xaf, 0x77, 0x4d, 0xca, 0x94, 0x8c, 0xd6, 0x7e,
-	0xe4, 0x67, 0x21, 0x53, 0x43, 0xb6, 0xf7, 0x33, 0x6a, 0x0a, 0x23, 0x79, 0x6e, 0x65, 0x54, 0xe9,
-	0x0c, 0xf0, 0x9f, 0x42, 0x96, 0xe0, 0xcf, 0x11, 0xd1, 0xaa, 0x7b, 0xc8, 0xd6, 0x84, 0xeb, 0x9e,
-	0xd7, 0x37, 0x0e, 0x5b, 0x39, 0xee, 0xf7, 0x56, 0x6e, 0xc6, 0xf7, 0x4b, 0xb5, 0x07, 0x05, 0xd3,
-	0x29, 0xd6, 0x10, 0x33, 0x0a, 0xfe, 0x16, 0xda, 0xc1, 0x6a, 0xe7, 0xb0, 0x73, 0x8f, 0xd8, 0x2a,
-	0x33, 0x08, 0xa6, 0x86, 0x63, 0x69, 0xb2, 0x02, 0x3e, 0x61, 0x19, 0xd9, 0x1a, 0xff, 0x03, 0x07,
-	0x53, 0xea, 0x3e, 0x21, 0xd8, 0x56, 0x9b, 0xd5, 0x3a, 0x32, 0x89, 0xfb, 0x49, 0x6a, 0x98, 0x1a,
-	0x26, 0x54, 0x18, 0xf5, 0xec, 0xe4, 0xbb, 0xec, 0x6c, 0x07, 0xc8, 0x5d, 0x64, 0x92, 0xdd, 0x36,
-	0xae, 0xbc, 0xed, 0xda, 0x3b, 0x6b, 0xe5, 0x24, 0x7f, 0xba, 0x3e, 0x74, 0xf2, 0xe3, 0xe7, 0xb9,
-	0xe9, 0x48, 0x02, 0x2f, 0x90, 0x09, 0x35, 0xaa, 0xc4, 0xff, 0xc4, 0xc1, 0x5c, 0x48, 0xa8, 0xe1,
-	0x86, 0x89, 0xdc, 0xf5, 0xe8, 0xb8, 0xa1, 0x42, 0xc6, 0x53, 0xb9, 0x14, 0xa1, 0x72, 0xa7, 0x0d,
-	0xff, 0xb0, 0x8d, 0x2e, 0xdf, 0x0b, 0xa4, 0x2e, 0x9e, 0x93, 0x1a, 0xc5, 0xec, 0x0a, 0x96, 0xfa,
-	0x73, 0x79, 0xaa, 0x67, 0xd4, 0xbe, 0xf5, 0xf8, 0xdf, 0xfb, 0x34, 0x4c, 0x9d, 0xdb, 0xd3, 0xe1,
-	0x7e, 0x3f, 0xbd, 0x06, 0xf3, 0x7e, 0x4d, 0xc1, 0x35, 0xa7, 0x81, 0x23, 0x33, 0xbb, 0x32, 0xbf,
-	0x80, 0xb8, 0x0d, 0x94, 0xbe, 0xfc, 0x0d, 0x14, 0xbb, 0x08, 0xb7, 0x61, 0x75, 0x60, 0xd0, 0xdd,
-	0x5f, 0xa4, 0xe5, 0x28, 0x74, 0xc4, 0xca, 0x5f, 0x99, 0xc5, 0x91, 0x00, 0x82, 0x54, 0x4c, 0xec,
-	0x2f, 0x47, 0x46, 0xe9, 0x1a, 0x89, 0x0d, 0x67, 0x03, 0x8a, 0x43, 0xda, 0x0d, 0x23, 0xfa, 0x99,
-	0x83, 0x19, 0xbf, 0x67, 0x1b, 0xd9, 0x2a, 0xb6, 0xba, 0x8e, 0x73, 0xf7, 0x7b, 0x70, 0x55, 0x62,
-	0x89, 0xb5, 0xbd, 0x04, 0x0b, 0x31, 0x16, 0xda, 0x56, 0x4b, 0x0f, 0x47, 0x21, 0x59, 0xa1, 0x3a,
-	0xff, 0x05, 0xcc, 0xc6, 0xde, 0xd4, 0xd7, 0xba, 0x36, 0xff, 0x80, 0x5b, 0xb0, 0x58, 0x1a, 0x1e,
-	0xdb, 0xd6, 0xc1, 0xef, 0xc3, 0x74, 0xff, 0xdb, 0xf2, 0xf2, 0x10, 0x84, 0x2e, 0x50, 0x2c, 0x0e,
-	0x09, 0x0c, 0xa7, 0x55, 0xe1, 0x66, 0xd4, 0x65, 0x73, 0xbe, 0x97, 0x27, 0x02, 0x22, 0xae, 0x0e,
-	0x84, 0x84, 0x93, 0x98, 0x30, 0x11, 0x7d, 0xff, 0x5b, 0xe8, 0xe5, 0x88, 0x04, 0x89, 0xb7, 0x87,
-	0x00, 0x85, 0x53, 0x7d, 0x0c, 0x63, 0xe7, 0x27, 0x99, 0xeb, 0xed, 0x3f, 0x4f, 0xbf, 0x14, 0x5b,
-	0x0e, 0x89, 0xef, 0xc1, 0xff, 0x7a, 0x2e, 0x2e, 0xe2, 0x85, 0xb6, 0xb0, 0x26, 0xca, 0xfd, 0x6b,
-	0x21, 0xdf, 0xd7, 0x1c, 0x48, 0x03, 0xbe, 0x0c, 0xaf, 0x5c, 0xa0, 0x89, 0x41, 0x8b, 0x77, 0x5e,
-	0x04, 0x1d, 0xca, 0xf8, 0x8e, 0x83, 0xc5, 0xa1, 0x4e, 0xc2, 0xd2, 0x00, 0xfa, 0x88, 0x1e, 0x71,
-	0xf3, 0xc5, 0x7b, 0x42, 0x61, 0x04, 0x84, 0xbe, 0xc7, 0xcf, 0xad, 0x0b, 0xbc, 0x91, 0x38, 0xb1,
-	0x30, 0x1c, 0xae, 0x3d, 0xa7, 0x98, 0xfe, 0xf2, 0xf4, 0xc9, 0x1a, 0x57, 0x7e, 0xff, 0xf0, 0x4f,
-	0x29, 0x71, 0x78, 0x2c, 0x71, 0xcf, 0x8e, 0x25, 0xee, 0x8f, 0x63, 0x89, 0x7b, 0x78, 0x22, 0x25,
-	0x9e, 0x9d, 0x48, 0x89, 0xdf, 0x4e, 0xa4, 0xc4, 0x27, 0xeb, 0xba, 0xc9, 0x8c, 0xfd, 0xbd, 0x82,
-	0xea, 0xd4, 0x8a, 0x3b, 0x08, 0x37, 0x2a, 0x26, 0x33, 0x08, 0xb2, 0x8b, 0x5a, 0x4d, 0x35, 0x90,
-	0x69, 0x17, 0x0f, 0x82, 0xbf, 0x0d, 0x8a, 0xac, 0x59, 0xc7, 0x74, 0x6f, 0xc4, 0xfb, 0x33, 0xe0,
-	0xb5, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa3, 0xf3, 0xa3, 0xa5, 0x8f, 0x10, 0x00, 0x00,
+	// 1304 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0x8e, 0x77, 0x37, 0xdb, 0xfa, 0xa5, 0xa5, 0xd4, 0xed, 0x76, 0xbd, 0xee, 0xd6, 0x49, 0xbd,
+	0xbb, 0xed, 0xb6, 0x65, 0x13, 0x36, 0x54, 0x1c, 0x56, 0x45, 0xd0, 0x6c, 0x80, 0x03, 0x4d, 0xa9,
+	0x0c, 0x14, 0x09, 0x09, 0x45, 0x53, 0x7b, 0xb0, 0xad, 0x26, 0x76, 0x34, 0x33, 0x1b, 0x9a, 0x0b,
+	0x42, 0x88, 0x03, 0x17, 0x24, 0x4e, 0x88, 0x0b, 0x52, 0x25, 0x6e, 0x95, 0x90, 0x7a, 0xe8, 0x85,
+	0x1b, 0xdc, 0xf6, 0x84, 0xaa, 0x1e, 0x10, 0xe2, 0x90, 0x42, 0x7b, 0x28, 0x17, 0x2e, 0xfb, 0x17,
+	0x20, 0x8f, 0x7f, 0xe4, 0x97, 0xe3, 0xa4, 0x08, 0x69, 0xf7, 0x66, 0xcf, 0xfb, 0xde, 0x9b, 0xef,
+	0xfb, 0x66, 0x3c, 0xcf, 0x36, 0x48, 0x1e, 0x41, 0x46, 0x03, 0x97, 0xda, 0x9b, 0x25, 0x76, 0xa7,
+	0xd8, 0x22, 0x1e, 0xf3, 0x24, 0x31, 0x18, 0x2b, 0xb6, 0x37, 0x95, 0x45, 0xc3, 0xa3, 0x4d, 0x8f,
+	0x96, 0x9a, 0xd4, 0xf2, 0x21, 0x4d, 0x6a, 0x05, 0x18, 0xe5, 0x54, 0x2f, 0x2f, 0x44, 0x07, 0xe3,
+	0x27, 0x2d, 0xcf, 0xf2, 0xf8, 0x65, 0xc9, 0xbf, 0x0a, 0x47, 0x97, 0x82, 0x32, 0xf5, 0x20, 0x10,
+	0xdc, 0x04, 0x21, 0xed, 0x57, 0x01, 0xf2, 0x35, 0x6a, 0x5d, 0xb5, 0x2c, 0x82, 0x2d, 0xc4, 0xf0,
+	0x9b, 0x77, 0x0c, 0x1b, 0xb9, 0x16, 0xd6, 0x11, 0xc3, 0x37, 0x08, 0x6e, 0x7b, 0x0c, 0x4b, 0x2b,
+	0x30, 0x67, 0x23, 0x6a, 0xcb, 0x42, 0x41, 0x58, 0x17, 0x2b, 0xc7, 0xf6, 0xba, 0xf9, 0x5c, 0x07,
+	0x35, 0x1b, 0x5b, 0x9a, 0x3f, 0xaa, 0xe9, 0x3c, 0x28, 0xbd, 0x0c, 0xf3, 0x9f, 0x60, 0x6c, 0x62,
+	0x22, 0xcf, 0x70, 0x98, 0xfc, 0xe8, 0xc1, 0xc6, 0xc9, 0x70, 0xaa, 0xab, 0xa6, 0x49, 0x30, 0xa5,
+	0xef, 0x31, 0xe2, 0xb8, 0x96, 0x1e, 0xe2, 0xa4, 0xd7, 0x41, 0x6c, 0xa3, 0x86, 0x63, 0x22, 0xe6,
+	0x11, 0x79, 0x96, 0x27, 0x9d, 0x7d, 0xf4, 0x60, 0xe3, 0x4c, 0x98, 0x74, 0x33, 0x8a, 0x0d, 0x66,
+	0xf7, 0x72, 0xb6, 0x4e, 0x7c, 0x75, 0x37, 0x9f, 0xf9, 0xfb, 0x6e, 0x3e, 0xf3, 0xc5, 0xb3, 0xfb,
+	0x17, 0xc3, 0xaa, 0xda, 0x05, 0x38, 0x3f, 0x41, 0x8f, 0x8e, 0x69, 0xcb, 0x73, 0x29, 0xd6, 0xbe,
+	0x9e, 0x81, 0xe5, 0x71, 0xd8, 0x9b, 0xa1, 0x70, 0x8a, 0x1a, 0x6c, 0x54, 0xb8, 0x3f, 0xaa, 0xe9,
+	0x3c, 0x28, 0xbd, 0x01, 0x2f, 0xe0, 0x30, 0xb1, 0x4e, 0x10, 0xc3, 0x34, 0x34, 0x60, 0x69, 0xaf,
+	0x9b, 0x5f, 0x08, 0xe0, 0x83, 0x71, 0x4d, 0x3f, 0x8a, 0xfb, 0x66, 0xa2, 0x7d, 0xd6, 0xcd, 0xfe,
+	0x17, 0xeb, 0xe6, 0xfe, 0x2f, 0xeb, 0xce, 0xc1, 0x6a, 0x9a, 0x1d, 0xb1, 0x6f, 0x3f, 0x0a, 0x70,
+	0xaa, 0x46, 0xad, 0x2a, 0x6e, 0x70, 0xdc, 0x5b, 0x18, 0x9b, 0xdb, 0x7e, 0xc0, 0x65, 0xd2, 0x6b,
+	0x70, 0xd8, 0x6b, 0x61, 0xc2, 0x79, 0x09, 0xd3, 0xf2, 0x8a, 0x53, 0xfc, 0x74, 0x33, 0xac, 0x2a,
+	0xcf, 0x4c, 0x9d, 0x1e, 0xa5, 0x6c, 0x2d, 0xf4, 0xab, 0x8a, 0xab, 0x6a, 0x05, 0x50, 0x93, 0xe9,
+	0xc6, 0x8a, 0xfe, 0x11, 0x40, 0xae, 0x51, 0xeb, 0x1a, 0xb6, 0x90, 0xd1, 0x79, 0xdb, 0x6b, 0x7f,
+	0xd0, 0x32, 0xfd, 0xfd, 0x82, 0x08, 0x6a, 0x52, 0xe9, 0x55, 0x10, 0xd1, 0x0e, 0xb3, 0x3d, 0xe2,
+	0xb0, 0x8e, 0x2c, 0x4c, 0x58, 0xa1, 0x1e, 0x54, 0x3a, 0x09, 0x59, 0xe6, 0xb0, 0x46, 0xa8, 0x44,
+	0x0f, 0x6e, 0xa4, 0x02, 0xe4, 0x4c, 0x4c, 0x0d, 0xe2, 0xb4, 0x98, 0xe3, 0xb9, 0xc1, 0x8a, 0xeb,
+	0xfd, 0x43, 0x92, 0x04, 0x73, 0xb7, 0x71, 0x87, 0xca, 0x73, 0x85, 0xd9, 0x75, 0x51, 0xe7, 0xd7,
+	0xd2, 0x26, 0x1c, 0x0a, 0x16, 0x83, 0xca, 0xd9, 0x82, 0xb0, 0x9e, 0x2b, 0x1f, 0x2f, 0xc6, 0xa7,
+	0x44, 0x31, 0xe0, 0x59, 0x99, 0xdb, 0xed, 0xe6, 0x33, 0x7a, 0x84, 0xdb, 0x52, 0x7c, 0x33, 0xbe,
+	0x0b, 0x0c, 0x11, 0x7c, 0x43, 0x7a, 0xd4, 0x34, 0x0d, 0x0a, 0xe3, 0xe4, 0xc6, 0x9e, 0xfc, 0x26,
+	0x80, 0x54, 0xa3, 0xd6, 0x7e, 0xbb, 0x71, 0x19, 0xe6, 0x5a, 0x0d, 0xe4, 0xf2, 0x5d, 0x9e, 0x2b,
+	0x2b, 0xc3, 0xb2, 0x43, 0x6e, 0x0d, 0xe4, 0x86, 0xfa, 0x39, 0x3a, 0x55, 0xfc, 0x32, 0x28, 0xa3,
+	0xba, 0x62, 0xd9, 0x8f, 0xb2, 0x70, 0x2c, 0x08, 0x5f, 0x35, 0xcd, 0x2a, 0x76, 0xbd, 0x7d, 0xd0,
+	0x7c, 0x0a, 0xe6, 0x6d, 0xec, 0x58, 0x36, 0xe3, 0xaa, 0x67, 0xf5, 0xf0, 0x4e, 0xaa, 0x00, 0x98,
+	0x3e, 0xa3, 0x7a, 0xc3, 0xa1, 0x4c, 0xce, 0x16, 0x66, 0xd7, 0x73, 0xe5, 0x17, 0xfb, 0x1c, 0xe1,
+	0x74, 0x2b, 0xc7, 0x7d, 0x1f, 0xee, 0x3d, 0xce, 0x8b, 0xfc, 0xf6, 0x9a, 0x43, 0x99, 0x2e, 0x9a,
+	0xd1, 0xa5, 0xb4, 0x0c, 0x62, 0x13, 0xb9, 0xfc, 0x31, 0xea, 0xc8, 0xf3, 0x05, 0x61, 0xfd, 0xb0,
+	0xde, 0x1b, 0x90, 0x3e, 0x86, 0x1c, 0xc1, 0x9f, 0x22, 0x62, 0xd6, 0x6f, 0x21, 0xd7, 0x94, 0x0f,
+	0x71, 0xad, 0x57, 0x76, 0xbb, 0x79, 0xe1, 0x8f, 0x6e, 0xfe, 0x74, 0xa0, 0x97, 0x9a, 0xb7, 0x8b,
+	0x8e, 0x57, 0x6a, 0x22, 0x66, 0x17, 0x83, 0x2d, 0x54, 0xc5, 0x46, 0xef, 0xb0, 0xf3, 0x8f, 0xd8,
+	0x3a, 0xb3, 0x09, 0xa6, 0xb6, 0xd7, 0x30, 0x35, 0x1d, 0x82, 0x82, 0x15, 0xe4, 0x9a, 0xd2, 0xf7,
+	0x02, 0x2c, 0x1a, 0x3b, 0x84, 0x60, 0xd7, 0xe8, 0xd4, 0x5b, 0xc8, 0x21, 0x7e, 0x4b, 0x6a, 0x3b,
+	0x26, 0x26, 0x54, 0x3e, 0xcc, 0xe5, 0x14, 0xfa, 0xe4, 0x6c, 0x87, 0xc8, 0x1b, 0xc8, 0x21, 0x37,
+	0x22, 0x5c, 0x65, 0xdb, 0x97, 0xb7, 0xd7, 0xcd, 0xab, 0xc1, 0x74, 0x63, 0xca, 0x69, 0xf7, 0x1e,
+	0xe7, 0x97, 0x12, 0x0b, 0x70, 0x43, 0x16, 0x8c, 0xa4, 0x90, 0xf4, 0x93, 0x00, 0x67, 0xe2, 0x82,
+	0x26, 0x6e, 0x3b, 0xc8, 0x5f, 0x8f, 0x9e, 0x1a, 0x2a, 0x8b, 0x9c, 0xe5, 0x5a, 0x02, 0xcb, 0x6a,
+	0x04, 0x7f, 0x3f, 0x42, 0x57, 0xae, 0x87, 0x54, 0x57, 0x87, 0xa8, 0x26, 0x55, 0xf6, 0x09, 0xab,
+	0xe3, 0x6b, 0x71, 0xd6, 0xa7, 0x8d, 0xb1, 0xf1, 0xf4, 0xe7, 0x7d, 0x09, 0x16, 0x87, 0xf6, 0x74,
+	0xbc, 0xdf, 0x9f, 0xcd, 0xc0, 0xd9, 0x20, 0xa6, 0xe3, 0xa6, 0xd7, 0xc6, 0x89, 0x9e, 0x1d, 0x98,
+	0x27, 0x20, 0x6d, 0x03, 0x65, 0xf7, 0x7f, 0x03, 0xa5, 0x2e, 0xc2, 0x25, 0xb8, 0x30, 0xd1, 0xe8,
+	0xfe, 0x8e, 0x74, 0x3e, 0x09, 0x9d, 0xb0, 0xf2, 0x07, 0x66, 0x71, 0x54, 0x80, 0xd0, 0x15, 0x07,
+	0x07, 0xcb, 0x21, 0xea, 0x7d, 0x23, 0xa9, 0xe6, 0x6c, 0x42, 0x69, 0x4a, 0xb9, 0xb1, 0x45, 0xbf,
+	0x08, 0x70, 0x3a, 0xc8, 0xd9, 0x46, 0xae, 0x81, 0x1b, 0x7d, 0xc7, 0xb9, 0xdf, 0x0f, 0x0e, 0x8a,
+	0x2d, 0xa9, 0xb2, 0xd7, 0x60, 0x25, 0x45, 0x42, 0x2c, 0xf5, 0x67, 0x21, 0xea, 0x59, 0x3a, 0xa6,
+	0x98, 0x6d, 0x3b, 0xc4, 0xd8, 0x71, 0x58, 0x85, 0x60, 0x74, 0x7b, 0x9f, 0x9e, 0x4e, 0xde, 0x50,
+	0xa2, 0x77, 0x94, 0xf0, 0x2e, 0x55, 0xe9, 0x2a, 0x68, 0xe3, 0x15, 0xc4, 0x42, 0x7f, 0x98, 0x19,
+	0x6c, 0xce, 0xfc, 0xb0, 0x7a, 0xb7, 0x8d, 0x09, 0x71, 0x4c, 0x7c, 0x70, 0x76, 0xfa, 0x15, 0x10,
+	0xbd, 0x88, 0x54, 0x78, 0xee, 0xc8, 0xc3, 0x7d, 0x38, 0x62, 0x1d, 0xbe, 0x97, 0xf4, 0x12, 0xa4,
+	0x15, 0x38, 0x4a, 0xf8, 0x2e, 0xaf, 0x87, 0x2e, 0xce, 0x73, 0x17, 0x8f, 0x04, 0x83, 0xd5, 0xe7,
+	0xf0, 0x32, 0xc9, 0xa4, 0xc8, 0xcb, 0xf2, 0x9e, 0x08, 0xb3, 0x35, 0x6a, 0x49, 0x9f, 0xc1, 0x72,
+	0xea, 0xe7, 0xdd, 0xc5, 0x3e, 0xe6, 0x13, 0x3e, 0x9d, 0x94, 0xf2, 0xf4, 0xd8, 0x88, 0x87, 0xb4,
+	0x03, 0x4b, 0xe3, 0x3f, 0xb1, 0xce, 0x4f, 0x51, 0xd0, 0x07, 0x2a, 0xa5, 0x29, 0x81, 0xf1, 0xb4,
+	0x06, 0x9c, 0x48, 0xfa, 0x42, 0x39, 0x3b, 0x58, 0x27, 0x01, 0xa2, 0x5c, 0x98, 0x08, 0x89, 0x27,
+	0x71, 0x60, 0x21, 0xf9, 0xa3, 0x61, 0x65, 0xb0, 0x46, 0x22, 0x48, 0xb9, 0x34, 0x05, 0x28, 0x9e,
+	0xea, 0x43, 0x38, 0x36, 0x3c, 0xc9, 0x99, 0xc1, 0xfc, 0xe1, 0xf2, 0x6b, 0xa9, 0xe1, 0xb8, 0xf0,
+	0x75, 0x38, 0x32, 0xf0, 0xb6, 0xab, 0x8c, 0xa4, 0xc5, 0x31, 0x45, 0x1b, 0x1f, 0x8b, 0xeb, 0x7d,
+	0x29, 0x80, 0x3a, 0xe1, 0x75, 0xe2, 0xa5, 0x91, 0x32, 0x29, 0x68, 0xe5, 0xf2, 0xf3, 0xa0, 0x63,
+	0x1a, 0xdf, 0x0a, 0xb0, 0x3a, 0x55, 0xfb, 0x2c, 0x4f, 0x28, 0x9f, 0x90, 0xa3, 0x6c, 0x3d, 0x7f,
+	0x4e, 0x4c, 0x8c, 0x80, 0x3c, 0xb6, 0x67, 0x9d, 0x1b, 0xa9, 0x9b, 0x88, 0x53, 0x8a, 0xd3, 0xe1,
+	0xe2, 0x39, 0x3d, 0x58, 0x1c, 0xd7, 0x3c, 0xd6, 0x12, 0xa4, 0x8c, 0xc2, 0x94, 0x8d, 0xa9, 0x60,
+	0x43, 0x13, 0x26, 0x1e, 0xe2, 0xe3, 0xb6, 0xe5, 0x20, 0x4c, 0xd9, 0x98, 0x0a, 0x16, 0x4d, 0xa8,
+	0x64, 0x3f, 0x7f, 0x76, 0xff, 0xa2, 0x50, 0x79, 0x67, 0xf7, 0x2f, 0x35, 0xb3, 0xfb, 0x44, 0x15,
+	0x1e, 0x3e, 0x51, 0x85, 0x3f, 0x9f, 0xa8, 0xc2, 0x37, 0x4f, 0xd5, 0xcc, 0xc3, 0xa7, 0x6a, 0xe6,
+	0xf7, 0xa7, 0x6a, 0xe6, 0xa3, 0x0d, 0xcb, 0x61, 0xf6, 0xce, 0xad, 0xa2, 0xe1, 0x35, 0x4b, 0x55,
+	0x84, 0xdb, 0x35, 0x87, 0xd9, 0x04, 0xb9, 0x25, 0xb3, 0x69, 0xd8, 0xc8, 0x71, 0x4b, 0x77, 0xc2,
+	0xbf, 0x69, 0x25, 0xd6, 0x69, 0x61, 0x7a, 0x6b, 0x9e, 0xff, 0x23, 0x7b, 0xe5, 0xdf, 0x01, 0x00,
+	0xf3, 0xfb, 0xaf, 0x35, 0xa6, 0x13, 0x00, 0x00,
 }
 
 func (this *MsgLegacyGovUpdateParams) Equal(that interface{}) bool {
@@ -1141,6 +1319,93 @@ func (this *MsgGovCancelUpdateParamPlan) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgGovResetCircuitBreakers) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgGovResetCircuitBreakers)
+	if !ok {
+		that2, ok := that.(MsgGovResetCircuitBreakers)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Denoms) != len(that1.Denoms) {
+		return false
+	}
+	for i := range this.Denoms {
+		if this.Denoms[i] != that1.Denoms[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgGovUpdateDenomOverrides) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgGovUpdateDenomOverrides)
+	if !ok {
+		that2, ok := that.(MsgGovUpdateDenomOverrides)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if len(this.Overrides) != len(that1.Overrides) {
+		return false
+	}
+	for i := range this.Overrides {
+		if !this.Overrides[i].Equal(&that1.Overrides[i]) {
+			return false
+		}
+	}
+	if len(this.RemoveDenoms) != len(that1.RemoveDenoms) {
+		return false
+	}
+	for i := range this.RemoveDenoms {
+		if this.RemoveDenoms[i] != that1.RemoveDenoms[i] {
+			return false
+		}
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -1176,6 +1441,11 @@ type MsgClient interface {
 	GovRemoveCurrencyDeviationThresholds(ctx context.Context, in *MsgGovRemoveCurrencyDeviationThresholds, opts ...grpc.CallOption) (*MsgGovRemoveCurrencyDeviationThresholdsResponse, error)
 	// GovCancelUpdateParamPlan cancels a plan to update the oracle parameters.
 	GovCancelUpdateParamPlan(ctx context.Context, in *MsgGovCancelUpdateParamPlan, opts ...grpc.CallOption) (*MsgGovCancelUpdateParamPlanResponse, error)
+	// GovResetCircuitBreakers resets the tripped circuit breakers of denoms.
+	GovResetCircuitBreakers(ctx context.Context, in *MsgGovResetCircuitBreakers, opts ...grpc.CallOption) (*MsgGovResetCircuitBreakersResponse, error)
+	// GovUpdateDenomOverrides updates the oracle parameters to set or remove
+	// denom overrides.
+	GovUpdateDenomOverrides(ctx context.Context, in *MsgGovUpdateDenomOverrides, opts ...grpc.CallOption) (*MsgGovUpdateDenomOverridesResponse, error)
 }
 
 type msgClient struct {
@@ -1267,6 +1537,24 @@ func (c *msgClient) GovCancelUpdateParamPlan(ctx context.Context, in *MsgGovCanc
 	return out, nil
 }
 
+func (c *msgClient) GovResetCircuitBreakers(ctx context.Context, in *MsgGovResetCircuitBreakers, opts ...grpc.CallOption) (*MsgGovResetCircuitBreakersResponse, error) {
+	out := new(MsgGovResetCircuitBreakersResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Msg/GovResetCircuitBreakers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GovUpdateDenomOverrides(ctx context.Context, in *MsgGovUpdateDenomOverrides, opts ...grpc.CallOption) (*MsgGovUpdateDenomOverridesResponse, error) {
+	out := new(MsgGovUpdateDenomOverridesResponse)
+	err := c.cc.Invoke(ctx, "/oracle.v1.Msg/GovUpdateDenomOverrides", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AggregateExchangeRatePrevote defines a method for submitting an aggregate
@@ -1291,6 +1579,11 @@ type MsgServer interface {
 	GovRemoveCurrencyDeviationThresholds(context.Context, *MsgGovRemoveCurrencyDeviationThresholds) (*MsgGovRemoveCurrencyDeviationThresholdsResponse, error)
 	// GovCancelUpdateParamPlan cancels a plan to update the oracle parameters.
 	GovCancelUpdateParamPlan(context.Context, *MsgGovCancelUpdateParamPlan) (*MsgGovCancelUpdateParamPlanResponse, error)
+	// GovResetCircuitBreakers resets the tripped circuit breakers of denoms.
+	GovResetCircuitBreakers(context.Context, *MsgGovResetCircuitBreakers) (*MsgGovResetCircuitBreakersResponse, error)
+	// GovUpdateDenomOverrides updates the oracle parameters to set or remove
+	// denom overrides.
+	GovUpdateDenomOverrides(context.Context, *MsgGovUpdateDenomOverrides) (*MsgGovUpdateDenomOverridesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1324,6 +1617,12 @@ func (*UnimplementedMsgServer) GovRemoveCurrencyDeviationThresholds(ctx context.
 func (*UnimplementedMsgServer) GovCancelUpdateParamPlan(ctx context.Context, req *MsgGovCancelUpdateParamPlan) (*MsgGovCancelUpdateParamPlanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GovCancelUpdateParamPlan not implemented")
 }
+func (*UnimplementedMsgServer) GovResetCircuitBreakers(ctx context.Context, req *MsgGovResetCircuitBreakers) (*MsgGovResetCircuitBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovResetCircuitBreakers not implemented")
+}
+func (*UnimplementedMsgServer) GovUpdateDenomOverrides(ctx context.Context, req *MsgGovUpdateDenomOverrides) (*MsgGovUpdateDenomOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovUpdateDenomOverrides not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1491,6 +1790,43 @@ func _Msg_GovCancelUpdateParamPlan_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GovResetCircuitBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovResetCircuitBreakers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GovResetCircuitBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Msg/GovResetCircuitBreakers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovResetCircuitBreakers(ctx, req.(*MsgGovResetCircuitBreakers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GovUpdateDenomOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovUpdateDenomOverrides)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GovUpdateDenomOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/oracle.v1.Msg/GovUpdateDenomOverrides",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovUpdateDenomOverrides(ctx, req.(*MsgGovUpdateDenomOverrides))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "oracle.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1531,6 +1867,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GovCancelUpdateParamPlan",
 			Handler:    _Msg_GovCancelUpdateParamPlan_Handler,
 		},
+		{
+			MethodName: "GovResetCircuitBreakers",
+			Handler:    _Msg_GovResetCircuitBreakers_Handler,
+		},
+		{
+			MethodName: "GovUpdateDenomOverrides",
+			Handler:    _Msg_GovUpdateDenomOverrides_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "oracle/v1/tx.proto",
@@ -2275,32 +2619,203 @@ func (m *MsgGovCancelUpdateParamPlanResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgGovResetCircuitBreakers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAggregateExchangeRatePrevote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgGovResetCircuitBreakers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovResetCircuitBreakers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.Feeder)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Validator)
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovResetCircuitBreakersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovResetCircuitBreakersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovResetCircuitBreakersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovUpdateDenomOverrides) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovUpdateDenomOverrides) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovUpdateDenomOverrides) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemoveDenoms) > 0 {
+		for iNdEx := len(m.RemoveDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveDenoms[iNdEx])
+			copy(dAtA[i:], m.RemoveDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Overrides) > 0 {
+		for iNdEx := len(m.Overrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Overrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovUpdateDenomOverridesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovUpdateDenomOverridesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovUpdateDenomOverridesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAggregateExchangeRatePrevote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Validator)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -2615,6 +3130,87 @@ func (m *MsgGovCancelUpdateParamPlanResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgGovResetCircuitBreakers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGovResetCircuitBreakersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGovUpdateDenomOverrides) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if len(m.Overrides) > 0 {
+		for _, e := range m.Overrides {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveDenoms) > 0 {
+		for _, s := range m.RemoveDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGovUpdateDenomOverridesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4783,6 +5379,515 @@ func (m *MsgGovCancelUpdateParamPlanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgGovResetCircuitBreakers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGovResetCircuitBreakers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGovResetCircuitBreakers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGovResetCircuitBreakersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGovResetCircuitBreakersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGovResetCircuitBreakersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGovUpdateDenomOverrides) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGovUpdateDenomOverrides: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGovUpdateDenomOverrides: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides, DenomOverride{})
+			if err := m.Overrides[len(m.Overrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveDenoms = append(m.RemoveDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGovUpdateDenomOverridesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGovUpdateDenomOverridesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGovUpdateDenomOverridesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -43,6 +43,32 @@ func (v AggregateExchangeRateVote) String() string {
 	return string(out)
 }
 
+// ValidateExchangeRates checks that the vote extension only contains positive exchange rates
+// of distinct AcceptList denoms, and no more than maxRates of them.
+func (ve OracleVoteExtension) ValidateExchangeRates(acceptList DenomList, maxRates uint64) error {
+	if uint64(len(ve.ExchangeRates)) > maxRates {
+		return ErrInvalidVoteExt.Wrapf("%d exchange rates exceed the maximum of %d", len(ve.ExchangeRates), maxRates)
+	}
+
+	seen := make(map[string]struct{}, len(ve.ExchangeRates))
+	for _, rate := range ve.ExchangeRates {
+		denom := strings.ToUpper(rate.Denom)
+		if _, ok := seen[denom]; ok {
+			return ErrInvalidVoteExt.Wrapf("duplicated denom %s", rate.Denom)
+		}
+		seen[denom] = struct{}{}
+
+		if !acceptList.Contains(rate.Denom) {
+			return ErrUnknownDenom.Wrap(rate.Denom)
+		}
+		if rate.Amount.IsNil() || !rate.Amount.IsPositive() {
+			return ErrNegativeOrZeroRate.Wrapf("%s: %s", rate.Denom, rate.Amount)
+		}
+	}
+
+	return nil
+}
+
 // ParseExchangeRateDecCoins DecCoins parser
 func ParseExchangeRateDecCoins(tuplesStr string) (sdk.DecCoins, error) {
 	if len(tuplesStr) == 0 {
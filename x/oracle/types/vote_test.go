@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateExchangeRates(t *testing.T) {
+	// symbol denoms such as DM are too short for sdk.NewDecCoinFromDec
+	rate := func(denom string, amount int64) sdk.DecCoin {
+		return sdk.DecCoin{Denom: denom, Amount: math.LegacyNewDec(amount)}
+	}
+
+	acceptList := DenomList{
+		{BaseDenom: DmDenom, SymbolDenom: DmSymbol},
+		{BaseDenom: USDTDenom, SymbolDenom: USDTSymbol},
+		{BaseDenom: USDCDenom, SymbolDenom: USDCSymbol},
+	}
+
+	testCases := []struct {
+		name  string
+		rates sdk.DecCoins
+		err   error
+	}{
+		{"valid", sdk.DecCoins{rate(DmSymbol, 1), rate(USDTSymbol, 2)}, nil},
+		{"empty", nil, nil},
+		{"lowercase accept list denom", sdk.DecCoins{rate("usdt", 1)}, nil},
+		{"unknown denom", sdk.DecCoins{rate("FOO", 1)}, ErrUnknownDenom},
+		{"duplicated denom", sdk.DecCoins{rate(DmSymbol, 1), rate("dm", 2)}, ErrInvalidVoteExt},
+		{"zero rate", sdk.DecCoins{rate(DmSymbol, 0)}, ErrNegativeOrZeroRate},
+		{"negative rate", sdk.DecCoins{rate(DmSymbol, -1)}, ErrNegativeOrZeroRate},
+		{"nil rate", sdk.DecCoins{{Denom: DmSymbol}}, ErrNegativeOrZeroRate},
+		{"too many rates", sdk.DecCoins{rate(DmSymbol, 1), rate(USDTSymbol, 1), rate(USDCSymbol, 1)}, ErrInvalidVoteExt},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ve := OracleVoteExtension{Height: 1, ExchangeRates: tc.rates}
+			err := ve.ValidateExchangeRates(acceptList, 2)
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}